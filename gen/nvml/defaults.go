@@ -0,0 +1,492 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// functionPattern matches the declaration of a function in nvml.h.
+var functionPattern = regexp.MustCompile(`DECLDIR\s+(nvml\w+)\s*\(`)
+
+// defaultHandle describes the NVML functions that operate on a handle type.
+type defaultHandle struct {
+	// Prefix is the prefix of the names of the functions (after nvml).
+	Prefix string
+	// Interface is the interface implemented by the handle type.
+	Interface string
+	// Receiver is the name of the receiver of the methods of the handle type.
+	Receiver string
+}
+
+// defaultHandles maps the handle types to the functions that operate on them.
+var defaultHandles = map[string]defaultHandle{
+	"nvmlDevice":          {"Device", "Device", "device"},
+	"nvmlGpuInstance":     {"GpuInstance", "GpuInstance", "gpuInstance"},
+	"nvmlComputeInstance": {"ComputeInstance", "ComputeInstance", "computeInstance"},
+	"nvmlEventSet":        {"EventSet", "EventSet", "set"},
+	"nvmlGpmSample":       {"GpmSample", "GpmSample", "gpmSample"},
+	"nvmlUnit":            {"Unit", "Unit", "unit"},
+	"nvmlVgpuInstance":    {"VgpuInstance", "VgpuInstance", "vgpuInstance"},
+	"nvmlVgpuTypeId":      {"VgpuType", "VgpuTypeId", "vgpuTypeId"},
+}
+
+// Coverage statuses of the functions in nvml.h.
+const (
+	coverageManual    = "manual"
+	coverageGenerated = "generated"
+	coverageUnwrapped = "unwrapped"
+)
+
+// nvmlFunction is a function declared in nvml.h, along with the method of
+// Interface that wraps it.
+type nvmlFunction struct {
+	// Name is the name of the function. Versioned functions are named after
+	// the unversioned function (e.g. nvmlInit for nvmlInit_v2).
+	Name string
+	// Method is the method of Interface that wraps the function.
+	Method string
+	// Status is the coverage status of the function.
+	Status string
+	// Reason explains why an unwrapped function is not wrapped.
+	Reason string
+	// Wrapper is the default wrapper generated for the function.
+	Wrapper string
+}
+
+// writeDefaults generates a default wrapper for each function in nvml.h that
+// is not wrapped manually and has a straightforward get or set signature.
+func writeDefaults(sourceDir string, outputFile string, header string) error {
+	functions, err := extractCoverage(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	var wrappers []string
+	for _, f := range functions {
+		if f.Status == coverageGenerated {
+			wrappers = append(wrappers, f.Wrapper)
+		}
+	}
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, strings.Join(wrappers, "\n"))
+	return nil
+}
+
+// writeCoverage generates a markdown table listing the functions in nvml.h
+// along with the method that wraps each of these.
+func writeCoverage(sourceDir string, outputFile string) error {
+	functions, err := extractCoverage(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, generateCoverage(functions))
+	return nil
+}
+
+// extractCoverage returns the functions in nvml.h in the order in which they
+// are declared, along with their coverage status. Wrappers in generated files
+// are ignored so that the result does not depend on a previous run of the
+// generator.
+func extractCoverage(sourceDir string) ([]*nvmlFunction, error) {
+	headerFile := filepath.Join(sourceDir, "nvml.h")
+	contents, err := os.ReadFile(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	versioned, err := extractVersionedFunctions(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	isVersioned := make(map[string]bool)
+	for _, f := range versioned {
+		isVersioned[f.Name] = true
+	}
+
+	g, err := newDefaultsGenerator(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []*nvmlFunction
+	seen := make(map[string]bool)
+	for _, match := range functionPattern.FindAllStringSubmatch(string(contents), -1) {
+		name := match[1]
+		if unversioned := unversionedSymbol(name); isVersioned[unversioned] {
+			name = unversioned
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		f := &nvmlFunction{Name: name, Method: strings.TrimPrefix(name, "nvml")}
+		switch {
+		case g.methods["library"][f.Method]:
+			f.Status = coverageManual
+		case isVersioned[name]:
+			f.Status, f.Reason = coverageUnwrapped, "versioned function"
+		default:
+			f.Wrapper, f.Reason = g.generate(f)
+			f.Status = coverageGenerated
+			if f.Reason != "" {
+				f.Status = coverageUnwrapped
+			}
+		}
+		functions = append(functions, f)
+	}
+	if len(functions) == 0 {
+		return nil, fmt.Errorf("no functions found in %s", headerFile)
+	}
+	return functions, nil
+}
+
+// defaultsGenerator generates the default wrappers for the functions in
+// nvml.h.
+type defaultsGenerator struct {
+	// bindings holds the cgo bindings in nvml.go by the name of their gated
+	// function.
+	bindings map[string]*ast.FuncDecl
+	// methods holds the names of the hand-written methods of each type.
+	methods map[string]map[string]bool
+	// structs maps the structs to whether a constructor is generated for them.
+	structs map[string]bool
+	// values holds the named types that are not structs.
+	values map[string]bool
+}
+
+func newDefaultsGenerator(sourceDir string) (*defaultsGenerator, error) {
+	g := &defaultsGenerator{
+		bindings: make(map[string]*ast.FuncDecl),
+		methods:  make(map[string]map[string]bool),
+		structs:  make(map[string]bool),
+		values:   make(map[string]bool),
+	}
+
+	gofiles, err := getGoFiles(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		base := filepath.Base(file)
+		if strings.HasPrefix(base, "zz_generated.") || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range node.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					if base == "nvml.go" {
+						g.bindings[gatedName(decl.Name.Name)] = decl
+					}
+					continue
+				}
+				receiver := decl.Recv.List[0].Type
+				if star, ok := receiver.(*ast.StarExpr); ok {
+					receiver = star.X
+				}
+				if ident, ok := receiver.(*ast.Ident); ok {
+					if g.methods[ident.Name] == nil {
+						g.methods[ident.Name] = make(map[string]bool)
+					}
+					g.methods[ident.Name][decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					switch typeSpec.Type.(type) {
+					case *ast.StructType:
+						g.structs[typeSpec.Name.Name] = false
+					case *ast.InterfaceType:
+					default:
+						if _, isHandle := defaultHandles[typeSpec.Name.Name]; !isHandle {
+							g.values[typeSpec.Name.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	versions, err := extractStructVersions(filepath.Join(sourceDir, "nvml.h"), filepath.Join(sourceDir, "types_gen.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.HasVersion {
+			g.structs[v.Type] = true
+		}
+	}
+	return g, nil
+}
+
+// defaultParam is a parameter of a cgo binding.
+type defaultParam struct {
+	Name string
+	Type ast.Expr
+}
+
+// generate generates the default wrapper for the specified function. If the
+// function cannot be wrapped, the reason for this is returned instead.
+func (g *defaultsGenerator) generate(f *nvmlFunction) (string, string) {
+	binding, ok := g.bindings[f.Name]
+	if !ok {
+		return "", "no cgo binding"
+	}
+	if results := binding.Type.Results; results == nil || len(results.List) != 1 || !isIdent(results.List[0].Type, "Return") {
+		return "", "does not return a Return"
+	}
+
+	var params []defaultParam
+	for _, field := range binding.Type.Params.List {
+		for _, name := range field.Names {
+			params = append(params, defaultParam{lowerFirst(name.Name), field.Type})
+		}
+	}
+
+	var handle *defaultHandle
+	var handleType string
+	method := f.Method
+	if len(params) > 0 {
+		if ident, ok := params[0].Type.(*ast.Ident); ok {
+			if h, ok := defaultHandles[ident.Name]; ok {
+				if !strings.HasPrefix(method, h.Prefix) {
+					return "", fmt.Sprintf("takes a %s but is not named after it", h.Interface)
+				}
+				handle, handleType = &h, ident.Name
+				method = strings.TrimPrefix(method, h.Prefix)
+				params = params[1:]
+			}
+		}
+	}
+	for _, p := range params {
+		if ident, ok := p.Type.(*ast.Ident); ok {
+			if _, ok := defaultHandles[ident.Name]; ok {
+				return "", "takes a handle other than as its first parameter"
+			}
+		}
+		if token.IsKeyword(p.Name) || builtinTypes[p.Name] || p.Name == "l" || p.Name == "ret" || (handle != nil && p.Name == handle.Receiver) {
+			return "", fmt.Sprintf("parameter %s cannot be used as a Go identifier", p.Name)
+		}
+	}
+	if handle != nil && g.methods[handleType][method] {
+		return "", fmt.Sprintf("%s.%s is already defined", handle.Interface, method)
+	}
+
+	verb := method
+	if handle == nil {
+		// Functions that do not take a handle are named after the object
+		// they operate on (e.g. nvmlSystemGetDriverVersion).
+		verb = strings.TrimLeftFunc(method[1:], func(r rune) bool { return r < 'A' || r > 'Z' })
+	}
+
+	var inputs []defaultParam
+	var output *defaultParam
+	switch {
+	case strings.HasPrefix(verb, "Get"):
+		if len(params) == 0 {
+			return "", "get function without an output parameter"
+		}
+		last := params[len(params)-1]
+		star, ok := last.Type.(*ast.StarExpr)
+		if !ok || !g.isOutput(star.X) {
+			return "", "get function without a single output parameter"
+		}
+		inputs, output = params[:len(params)-1], &last
+		for _, p := range inputs {
+			// The output of functions such as nvmlDeviceGetCpuAffinity is an
+			// array, the length of which is an input.
+			name := strings.ToLower(p.Name)
+			if strings.HasSuffix(name, "size") || strings.HasSuffix(name, "count") || strings.HasSuffix(name, "length") {
+				return "", fmt.Sprintf("parameter %s is the length of an array", p.Name)
+			}
+		}
+	case strings.HasPrefix(verb, "Set"):
+		inputs = params
+		if len(params) > 0 {
+			if star, ok := params[len(params)-1].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); !ok || !g.isStruct(ident.Name) {
+					return "", "set function with a pointer to a non-struct parameter"
+				}
+				inputs = params[:len(params)-1]
+			}
+		}
+	default:
+		return "", "neither a get nor a set function"
+	}
+	for _, p := range inputs {
+		if !g.isValue(p.Type) {
+			return "", fmt.Sprintf("parameter %s is not passed by value", p.Name)
+		}
+	}
+
+	return g.format(f, handle, handleType, method, params, output), ""
+}
+
+// format formats the default wrapper for the specified function. Wrappers of
+// functions that take a handle forward to a method of the handle type, as is
+// done for the hand-written wrappers.
+func (g *defaultsGenerator) format(f *nvmlFunction, handle *defaultHandle, handleType string, method string, params []defaultParam, output *defaultParam) string {
+	var inputs, args []string
+	for _, p := range params {
+		if output != nil && p.Name == output.Name {
+			args = append(args, "&"+p.Name)
+			continue
+		}
+		inputs = append(inputs, fmt.Sprintf("%s %s", p.Name, formatFieldList(&ast.Field{Type: p.Type})))
+		args = append(args, p.Name)
+	}
+
+	results := "Return"
+	body := fmt.Sprintf("\treturn %s(%%s)\n", f.Name)
+	if output != nil {
+		outputType := formatFieldList(&ast.Field{Type: output.Type.(*ast.StarExpr).X})
+		results = fmt.Sprintf("(%s, Return)", outputType)
+		declaration := fmt.Sprintf("\tvar %s %s\n", output.Name, outputType)
+		if g.structs[outputType] {
+			declaration = fmt.Sprintf("\t%s := New%s()\n", output.Name, outputType)
+		}
+		body = declaration + fmt.Sprintf("\tret := %s(%%s)\n", f.Name) + fmt.Sprintf("\treturn %s, ret\n", output.Name)
+	}
+
+	var wrapper strings.Builder
+	wrapper.WriteString(fmt.Sprintf("// nvml.%s()\n", f.Method))
+	if handle == nil {
+		wrapper.WriteString(fmt.Sprintf("func (l *library) %s(%s) %s {\n", f.Method, strings.Join(inputs, ", "), results))
+		wrapper.WriteString(fmt.Sprintf(body, strings.Join(args, ", ")))
+		wrapper.WriteString("}\n")
+		return wrapper.String()
+	}
+
+	var names []string
+	for _, p := range params {
+		if output == nil || p.Name != output.Name {
+			names = append(names, p.Name)
+		}
+	}
+	handleParams := append([]string{fmt.Sprintf("%s %s", handle.Receiver, handle.Interface)}, inputs...)
+	wrapper.WriteString(fmt.Sprintf("func (l *library) %s(%s) %s {\n", f.Method, strings.Join(handleParams, ", "), results))
+	wrapper.WriteString(fmt.Sprintf("\treturn %s.%s(%s)\n", handle.Receiver, method, strings.Join(names, ", ")))
+	wrapper.WriteString("}\n")
+	wrapper.WriteString("\n")
+	wrapper.WriteString(fmt.Sprintf("func (%s %s) %s(%s) %s {\n", handle.Receiver, handleType, method, strings.Join(inputs, ", "), results))
+	wrapper.WriteString(fmt.Sprintf(body, strings.Join(append([]string{handle.Receiver}, args...), ", ")))
+	wrapper.WriteString("}\n")
+	return wrapper.String()
+}
+
+// isValue checks whether the specified type is a basic type or a named type
+// that is not a struct or a handle.
+func (g *defaultsGenerator) isValue(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	return builtinTypes[ident.Name] || g.values[ident.Name]
+}
+
+// isOutput checks whether a pointer to the specified type can be returned as
+// the output of a get function. Pointers to bytes are excluded since these
+// are used for buffers.
+func (g *defaultsGenerator) isOutput(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	switch ident.Name {
+	case "byte", "int8", "uint8", "string":
+		return false
+	}
+	return g.isValue(ident) || g.isStruct(ident.Name)
+}
+
+func (g *defaultsGenerator) isStruct(name string) bool {
+	if _, ok := defaultHandles[name]; ok {
+		return false
+	}
+	_, ok := g.structs[name]
+	return ok
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func generateCoverage(functions []*nvmlFunction) string {
+	counts := make(map[string]int)
+	for _, f := range functions {
+		counts[f.Status]++
+	}
+
+	var output strings.Builder
+	output.WriteString("<!-- Generated Code; DO NOT EDIT. -->\n")
+	output.WriteString("\n")
+	output.WriteString("# NVML API coverage\n")
+	output.WriteString("\n")
+	output.WriteString("The functions declared in `nvml.h`, along with the method of `Interface` that\n")
+	output.WriteString("wraps each of these. Functions are either wrapped manually, wrapped by a\n")
+	output.WriteString("default wrapper in `zz_generated.defaults.go`, or unwrapped.\n")
+	output.WriteString("\n")
+	output.WriteString("| Status | Functions |\n")
+	output.WriteString("|--------|-----------|\n")
+	for _, status := range []string{coverageManual, coverageGenerated, coverageUnwrapped} {
+		output.WriteString(fmt.Sprintf("| %s | %d |\n", status, counts[status]))
+	}
+	output.WriteString("\n")
+	output.WriteString("| NVML function | Method | Status |\n")
+	output.WriteString("|---------------|--------|--------|\n")
+	for _, f := range functions {
+		method, status := fmt.Sprintf("`%s`", f.Method), f.Status
+		if f.Status == coverageUnwrapped {
+			method, status = "", fmt.Sprintf("%s (%s)", f.Status, f.Reason)
+		}
+		output.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", f.Name, method, status))
+	}
+	return output.String()
+}
@@ -0,0 +1,330 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// encodingKind determines how a field of a struct in types_gen.go is
+// represented when the struct is encoded.
+type encodingKind int
+
+const (
+	// encodingValue fields are encoded as is.
+	encodingValue encodingKind = iota
+	// encodingString fields are C char arrays holding a NUL-terminated string.
+	encodingString
+	// encodingUUID fields are unsigned char arrays holding a binary UUID.
+	encodingUUID
+	// encodingBytes fields are other byte arrays, such as binary data and
+	// unions, and are encoded as base64.
+	encodingBytes
+	// encodingPadding fields are the padding inserted by cgo and are omitted.
+	encodingPadding
+)
+
+// encodedField represents a field of a struct in types_gen.go.
+type encodedField struct {
+	Name string
+	Type string
+	Kind encodingKind
+}
+
+// encodedStruct represents a struct in types_gen.go with at least one field
+// that is not encoded as is.
+type encodedStruct struct {
+	Type   string
+	Fields []encodedField
+}
+
+// charArrayPattern matches the declaration of a char or unsigned char array
+// as a member of a struct in nvml.h.
+var charArrayPattern = regexp.MustCompile(`^\s*(unsigned\s+)?char\s+(\w+)\s*\[`)
+
+// writeEncodings generates the MarshalJSON, UnmarshalJSON, MarshalYAML and
+// UnmarshalYAML methods of the structs in types_gen.go that have char arrays,
+// byte arrays, or padding.
+func writeEncodings(sourceDir string, outputFile string, header string) error {
+	charArrays, err := extractCharArrays(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return err
+	}
+	structs, err := extractEncodedStructs(filepath.Join(sourceDir, "types_gen.go"), charArrays)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateEncodings(structs))
+	return nil
+}
+
+// extractCharArrays returns whether each char array that is a member of a
+// struct in the specified nvml.h header is signed (char) or unsigned
+// (unsigned char), by member name. Members with the same name are required to
+// have the same signedness, which allows the Go fields to be mapped to the
+// members without resolving the C struct that they belong to.
+func extractCharArrays(headerFile string) (map[string]bool, error) {
+	file, err := os.Open(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	unsigned := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		match := charArrayPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		name, isUnsigned := match[2], match[1] != ""
+		if previous, ok := unsigned[name]; ok && previous != isUnsigned {
+			return nil, fmt.Errorf("char array %s is declared as both char and unsigned char", name)
+		}
+		unsigned[name] = isUnsigned
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(unsigned) == 0 {
+		return nil, fmt.Errorf("no char arrays found in %s", headerFile)
+	}
+	return unsigned, nil
+}
+
+// extractEncodedStructs returns the exported structs in the specified
+// types_gen.go file that have at least one field that is not encoded as is,
+// in the order in which they are defined.
+func extractEncodedStructs(typesFile string, charArrays map[string]bool) ([]encodedStruct, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), typesFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var structs []encodedStruct
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok || !typeSpec.Name.IsExported() {
+				continue
+			}
+			s := encodedStruct{Type: typeSpec.Name.Name}
+			encoded := false
+			for _, f := range structType.Fields.List {
+				for _, name := range f.Names {
+					kind, err := fieldEncoding(name.Name, f.Type, charArrays)
+					if err != nil {
+						return nil, fmt.Errorf("%s.%s: %w", s.Type, name.Name, err)
+					}
+					if kind != encodingValue {
+						encoded = true
+					}
+					s.Fields = append(s.Fields, encodedField{
+						Name: name.Name,
+						Type: types.ExprString(f.Type),
+						Kind: kind,
+					})
+				}
+			}
+			if encoded {
+				structs = append(structs, s)
+			}
+		}
+	}
+	return structs, nil
+}
+
+// fieldEncoding determines how a field is encoded. cgo translates char and
+// unsigned char arrays to uint8 arrays, and unions and padding to byte arrays.
+// The signedness of a uint8 array is looked up in nvml.h: char arrays are
+// strings, and unsigned char arrays are binary data, or UUIDs if the name of
+// the member says so.
+func fieldEncoding(name string, expr ast.Expr, charArrays map[string]bool) (encodingKind, error) {
+	if strings.HasPrefix(name, "Pad_cgo_") {
+		return encodingPadding, nil
+	}
+	array, ok := expr.(*ast.ArrayType)
+	if !ok || array.Len == nil {
+		return encodingValue, nil
+	}
+	elt, ok := array.Elt.(*ast.Ident)
+	if !ok {
+		return encodingValue, nil
+	}
+	switch elt.Name {
+	case "byte":
+		return encodingBytes, nil
+	case "uint8":
+	default:
+		return encodingValue, nil
+	}
+
+	unsigned, ok := charArrays[lowerFirst(name)]
+	if !ok {
+		return 0, fmt.Errorf("no char array named %s found in nvml.h", lowerFirst(name))
+	}
+	if !unsigned {
+		return encodingString, nil
+	}
+	length, ok := array.Len.(*ast.BasicLit)
+	if ok && length.Value == "16" && strings.Contains(strings.ToLower(name), "uuid") {
+		return encodingUUID, nil
+	}
+	return encodingBytes, nil
+}
+
+func generateEncodings(structs []encodedStruct) string {
+	var output strings.Builder
+	output.WriteString("import (\n")
+	output.WriteString("\t\"encoding/json\"\n")
+	output.WriteString("\t\"fmt\"\n")
+	output.WriteString("\n")
+	output.WriteString("\t\"github.com/google/uuid\"\n")
+	output.WriteString(")\n")
+	for _, s := range structs {
+		output.WriteString("\n")
+		output.WriteString(generateEncoding(s))
+	}
+	return output.String()
+}
+
+func generateEncoding(s encodedStruct) string {
+	var output strings.Builder
+	encoded := "encoded" + s.Type
+
+	output.WriteString(fmt.Sprintf("// %s is the JSON and YAML representation of %s.\n", encoded, s.Type))
+	output.WriteString(fmt.Sprintf("type %s struct {\n", encoded))
+	for _, f := range s.Fields {
+		switch f.Kind {
+		case encodingValue:
+			output.WriteString(fmt.Sprintf("\t%s %s\n", f.Name, f.Type))
+		case encodingString:
+			output.WriteString(fmt.Sprintf("\t%s string\n", f.Name))
+		case encodingUUID:
+			output.WriteString(fmt.Sprintf("\t%s uuid.UUID\n", f.Name))
+		case encodingBytes:
+			output.WriteString(fmt.Sprintf("\t%s byteArray\n", f.Name))
+		}
+	}
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("func (s %s) encode() %s {\n", s.Type, encoded))
+	output.WriteString(fmt.Sprintf("\treturn %s{\n", encoded))
+	for _, f := range s.Fields {
+		switch f.Kind {
+		case encodingValue:
+			output.WriteString(fmt.Sprintf("\t\t%s: s.%s,\n", f.Name, f.Name))
+		case encodingString:
+			output.WriteString(fmt.Sprintf("\t\t%s: cString(s.%s[:]),\n", f.Name, f.Name))
+		case encodingUUID:
+			output.WriteString(fmt.Sprintf("\t\t%s: uuid.UUID(s.%s),\n", f.Name, f.Name))
+		case encodingBytes:
+			output.WriteString(fmt.Sprintf("\t\t%s: s.%s[:],\n", f.Name, f.Name))
+		}
+	}
+	output.WriteString("\t}\n")
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("func (e %s) decode() (%s, error) {\n", encoded, s.Type))
+	output.WriteString(fmt.Sprintf("\tvar s %s\n", s.Type))
+	for _, f := range s.Fields {
+		switch f.Kind {
+		case encodingValue:
+			output.WriteString(fmt.Sprintf("\ts.%s = e.%s\n", f.Name, f.Name))
+		case encodingString, encodingBytes:
+			set := "setCString"
+			if f.Kind == encodingBytes {
+				set = "setBytes"
+			}
+			output.WriteString(fmt.Sprintf("\tif err := %s(s.%s[:], e.%s); err != nil {\n", set, f.Name, f.Name))
+			output.WriteString(fmt.Sprintf("\t\treturn s, fmt.Errorf(\"invalid %s.%s: %%w\", err)\n", s.Type, f.Name))
+			output.WriteString("\t}\n")
+		case encodingUUID:
+			output.WriteString(fmt.Sprintf("\ts.%s = %s(e.%s)\n", f.Name, f.Type, f.Name))
+		}
+	}
+	output.WriteString("\treturn s, nil\n")
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("// MarshalJSON encodes the %s as described in %s.\n", s.Type, encoded))
+	output.WriteString(fmt.Sprintf("func (s %s) MarshalJSON() ([]byte, error) {\n", s.Type))
+	output.WriteString("\treturn json.Marshal(s.encode())\n")
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString("// UnmarshalJSON decodes the representation returned by MarshalJSON.\n")
+	output.WriteString(fmt.Sprintf("func (s *%s) UnmarshalJSON(data []byte) error {\n", s.Type))
+	output.WriteString(fmt.Sprintf("\tvar e %s\n", encoded))
+	output.WriteString("\tif err := json.Unmarshal(data, &e); err != nil {\n")
+	output.WriteString("\t\treturn err\n")
+	output.WriteString("\t}\n")
+	output.WriteString("\tdecoded, err := e.decode()\n")
+	output.WriteString("\tif err != nil {\n")
+	output.WriteString("\t\treturn err\n")
+	output.WriteString("\t}\n")
+	output.WriteString("\t*s = decoded\n")
+	output.WriteString("\treturn nil\n")
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("// MarshalYAML encodes the %s as described in %s.\n", s.Type, encoded))
+	output.WriteString(fmt.Sprintf("func (s %s) MarshalYAML() (interface{}, error) {\n", s.Type))
+	output.WriteString("\treturn s.encode(), nil\n")
+	output.WriteString("}\n")
+
+	output.WriteString("\n")
+	output.WriteString("// UnmarshalYAML decodes the representation returned by MarshalYAML.\n")
+	output.WriteString(fmt.Sprintf("func (s *%s) UnmarshalYAML(unmarshal func(interface{}) error) error {\n", s.Type))
+	output.WriteString(fmt.Sprintf("\tvar e %s\n", encoded))
+	output.WriteString("\tif err := unmarshal(&e); err != nil {\n")
+	output.WriteString("\t\treturn err\n")
+	output.WriteString("\t}\n")
+	output.WriteString("\tdecoded, err := e.decode()\n")
+	output.WriteString("\tif err != nil {\n")
+	output.WriteString("\t\treturn err\n")
+	output.WriteString("\t}\n")
+	output.WriteString("\t*s = decoded\n")
+	output.WriteString("\treturn nil\n")
+	output.WriteString("}\n")
+
+	return output.String()
+}
@@ -0,0 +1,317 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// definedEnumPrefixes maps the enum types that are declared as a typedef of
+// an integer in nvml.h, with their values defined using #define, to the
+// prefix of the constants that define their values. The values of all other
+// enum types are taken from their typed constants in const.go.
+var definedEnumPrefixes = map[string]string{
+	"AffinityScope":      "AFFINITY_SCOPE_",
+	"BusType":            "BUS_TYPE_",
+	"DeviceArchitecture": "DEVICE_ARCH_",
+	"FanControlPolicy":   "FAN_POLICY_",
+	"PowerSource":        "POWER_SOURCE_",
+}
+
+// enum represents an enum type defined in const.go.
+type enum struct {
+	Type string
+	// Constants are the names of the constants that define the values of the
+	// enum, in the order in which they are defined.
+	Constants []string
+	// Prefix is the common prefix of the constants that is omitted from the
+	// name of each value.
+	Prefix string
+	// Existing are the methods (String, MarshalText, and UnmarshalText) and
+	// the Parse function that are already defined for the type and are
+	// therefore not generated.
+	Existing map[string]bool
+}
+
+func writeEnums(sourceDir string, outputFile string, header string) error {
+	enums, err := extractEnums(filepath.Join(sourceDir, "const.go"))
+	if err != nil {
+		return err
+	}
+	if err := resolveExistingEnumMethods(sourceDir, enums); err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateEnums(enums))
+	return nil
+}
+
+// extractEnums returns the enum types defined in the specified const.go file,
+// along with the enum types listed in definedEnumPrefixes.
+func extractEnums(constFile string) ([]*enum, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), constFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var enums []*enum
+	byType := make(map[string]*enum)
+	for name := range definedEnumPrefixes {
+		byType[name] = &enum{Type: name}
+	}
+	for _, decl := range node.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if _, ok := spec.Type.(*ast.Ident); !ok {
+					continue
+				}
+				e := &enum{Type: spec.Name.Name}
+				byType[e.Type] = e
+				enums = append(enums, e)
+			case *ast.ValueSpec:
+				for _, name := range spec.Names {
+					if e := enumOfConstant(byType, spec, name.Name); e != nil {
+						e.Constants = append(e.Constants, name.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for name := range definedEnumPrefixes {
+		if len(byType[name].Constants) == 0 {
+			return nil, fmt.Errorf("no constants found for %s with prefix %s", name, definedEnumPrefixes[name])
+		}
+		enums = append(enums, byType[name])
+	}
+	sort.SliceStable(enums, func(i, j int) bool {
+		return enums[i].Type < enums[j].Type
+	})
+
+	for _, e := range enums {
+		if len(e.Constants) == 0 {
+			return nil, fmt.Errorf("no constants found for %s", e.Type)
+		}
+		e.Prefix = enumPrefix(e.Constants)
+	}
+	return enums, nil
+}
+
+// enumOfConstant returns the enum type that the specified constant is a value
+// of, or nil if it is not a value of an enum type.
+func enumOfConstant(byType map[string]*enum, spec *ast.ValueSpec, name string) *enum {
+	if ident, ok := spec.Type.(*ast.Ident); ok {
+		return byType[ident.Name]
+	}
+	if spec.Type != nil {
+		return nil
+	}
+	for typeName, prefix := range definedEnumPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return byType[typeName]
+		}
+	}
+	return nil
+}
+
+// enumPrefix returns the longest prefix ending in an underscore that is
+// shared by the specified constants. If omitting this prefix would result in
+// a name that does not start with a letter (e.g. PSTATE_0), no prefix is
+// returned.
+func enumPrefix(constants []string) string {
+	prefix := constants[0]
+	for _, c := range constants[1:] {
+		for !strings.HasPrefix(c, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	if len(constants) == 1 {
+		prefix = strings.TrimSuffix(prefix, "_")
+	}
+	prefix = prefix[:strings.LastIndex(prefix, "_")+1]
+
+	for _, c := range constants {
+		name := strings.TrimPrefix(c, prefix)
+		if name == "" || !unicode.IsLetter(rune(name[0])) {
+			return ""
+		}
+	}
+	return prefix
+}
+
+// resolveExistingEnumMethods determines which of the generated methods and
+// Parse functions are already defined for the specified enum types in the
+// (non-generated) source files of the package.
+func resolveExistingEnumMethods(sourceDir string, enums []*enum) error {
+	gofiles, err := getGoFiles(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]map[string]bool)
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		base := filepath.Base(file)
+		if strings.HasPrefix(base, "zz_generated.") || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range node.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			typeName := ""
+			if funcDecl.Recv == nil {
+				typeName = strings.TrimPrefix(funcDecl.Name.Name, "Parse")
+			} else {
+				recv := funcDecl.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if ident, ok := recv.(*ast.Ident); ok {
+					typeName = ident.Name
+				}
+			}
+			if existing[typeName] == nil {
+				existing[typeName] = make(map[string]bool)
+			}
+			existing[typeName][funcDecl.Name.Name] = true
+		}
+	}
+
+	for _, e := range enums {
+		e.Existing = existing[e.Type]
+		if e.Existing == nil {
+			e.Existing = make(map[string]bool)
+		}
+	}
+	return nil
+}
+
+func generateEnums(enums []*enum) string {
+	var output strings.Builder
+	for i, e := range enums {
+		if i > 0 {
+			output.WriteString("\n")
+		}
+		output.WriteString(generateEnum(e))
+	}
+	return output.String()
+}
+
+func generateEnum(e *enum) string {
+	var output strings.Builder
+	values := lowerInitialism(e.Type) + "Values"
+	parse := "Parse" + e.Type
+
+	name := "the name of the value"
+	if e.Prefix != "" {
+		name = fmt.Sprintf("the name of the value without the %s prefix", e.Prefix)
+	}
+
+	output.WriteString(fmt.Sprintf("// %s are the named values of %s.\n", values, e.Type))
+	output.WriteString(fmt.Sprintf("var %s = []enumValue[%s]{\n", values, e.Type))
+	for _, c := range e.Constants {
+		output.WriteString(fmt.Sprintf("\t{%q, %q, %s},\n", strings.TrimPrefix(c, e.Prefix), c, c))
+	}
+	output.WriteString("}\n")
+
+	if !e.Existing["String"] {
+		output.WriteString("\n")
+		output.WriteString(fmt.Sprintf("// String returns %s.\n", name))
+		output.WriteString(fmt.Sprintf("func (e %s) String() string {\n", e.Type))
+		output.WriteString(fmt.Sprintf("\treturn formatEnum(%q, %s, e)\n", e.Type, values))
+		output.WriteString("}\n")
+	}
+
+	if !e.Existing["MarshalText"] {
+		output.WriteString("\n")
+		output.WriteString(fmt.Sprintf("// MarshalText returns %s,\n", name))
+		output.WriteString("// or its number if it has no name.\n")
+		output.WriteString(fmt.Sprintf("func (e %s) MarshalText() ([]byte, error) {\n", e.Type))
+		output.WriteString(fmt.Sprintf("\treturn []byte(formatEnumText(%s, e)), nil\n", values))
+		output.WriteString("}\n")
+	}
+
+	if !e.Existing["UnmarshalText"] {
+		output.WriteString("\n")
+		output.WriteString(fmt.Sprintf("// UnmarshalText parses the value as described for %s.\n", parse))
+		output.WriteString(fmt.Sprintf("func (e *%s) UnmarshalText(text []byte) error {\n", e.Type))
+		output.WriteString(fmt.Sprintf("\tvalue, err := %s(string(text))\n", parse))
+		output.WriteString("\tif err != nil {\n")
+		output.WriteString("\t\treturn err\n")
+		output.WriteString("\t}\n")
+		output.WriteString("\t*e = value\n")
+		output.WriteString("\treturn nil\n")
+		output.WriteString("}\n")
+	}
+
+	if !e.Existing[parse] {
+		output.WriteString("\n")
+		if e.Prefix != "" {
+			output.WriteString(fmt.Sprintf("// %s returns the %s with the specified name, with or\n", parse, e.Type))
+			output.WriteString(fmt.Sprintf("// without the %s prefix, or number. Names are not case-sensitive.\n", e.Prefix))
+		} else {
+			output.WriteString(fmt.Sprintf("// %s returns the %s with the specified name or number.\n", parse, e.Type))
+			output.WriteString("// Names are not case-sensitive.\n")
+		}
+		output.WriteString(fmt.Sprintf("func %s(s string) (%s, error) {\n", parse, e.Type))
+		output.WriteString(fmt.Sprintf("\treturn parseEnum(%q, %s, s)\n", e.Type, values))
+		output.WriteString("}\n")
+	}
+
+	return output.String()
+}
+
+// lowerInitialism returns the specified name with its first word in lower
+// case, treating a leading initialism (e.g. the UUID in UUIDType) as a single
+// word.
+func lowerInitialism(s string) string {
+	r := []rune(s)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
@@ -0,0 +1,125 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// resolveExcludedMethods returns the methods of a type that are excluded from
+// its generated interface. These are the methods in the Exclude list of the
+// type and, if ExcludeExtensions is set, the methods of its extension
+// interfaces. An error is raised if an excluded method is not implemented by
+// the type, or if ExcludeExtensions is set and an excluded method is not part
+// of an extension interface, since such a method would not be available
+// through any interface.
+func resolveExcludedMethods(sourceDir string, gofiles map[string][]byte, input GeneratableInterfacePoperties, methods []*ast.FuncDecl) (map[string]bool, error) {
+	implemented := make(map[string]bool)
+	for _, method := range methods {
+		implemented[method.Name.Name] = true
+	}
+
+	exclude := make(map[string]bool)
+	extensions := make(map[string]string)
+	if input.ExcludeExtensions {
+		var err error
+		extensions, err = extractExtensionMethods(sourceDir, gofiles, methods)
+		if err != nil {
+			return nil, err
+		}
+		for name, extension := range extensions {
+			if !implemented[name] {
+				return nil, fmt.Errorf("method %s of extension interface %s is not implemented by %s", name, extension, input.Type)
+			}
+			exclude[name] = true
+		}
+	}
+
+	for _, name := range input.Exclude {
+		if !implemented[name] {
+			return nil, fmt.Errorf("excluded method %s is not implemented by %s", name, input.Type)
+		}
+		if _, ok := extensions[name]; input.ExcludeExtensions && !ok {
+			return nil, fmt.Errorf("method %s of %s is neither part of %s nor of an extension interface", name, input.Type, input.Interface)
+		}
+		exclude[name] = true
+	}
+
+	return exclude, nil
+}
+
+// extractExtensionMethods returns the methods of the extension interfaces of
+// a type, mapped to the name of the interface that defines them. An extension
+// interface is an interface that is defined in a (non-generated) source file
+// of the package and is returned by a method of the type that takes no
+// arguments, such as ExtendedInterface which is returned by
+// library.Extensions().
+func extractExtensionMethods(sourceDir string, gofiles map[string][]byte, methods []*ast.FuncDecl) (map[string]string, error) {
+	interfaces := make(map[string]*ast.InterfaceType)
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		base := filepath.Base(file)
+		if strings.HasPrefix(base, "zz_generated.") || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				interfaces[typeSpec.Name.Name] = iface
+			}
+			return false
+		})
+	}
+
+	extensions := make(map[string]string)
+	for _, method := range methods {
+		if len(method.Type.Params.List) != 0 || method.Type.Results == nil || len(method.Type.Results.List) != 1 {
+			continue
+		}
+		result, ok := method.Type.Results.List[0].Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		iface, ok := interfaces[result.Name]
+		if !ok {
+			continue
+		}
+		for _, field := range iface.Methods.List {
+			if len(field.Names) == 0 {
+				return nil, fmt.Errorf("extension interface %s: embedded interfaces are not supported", result.Name)
+			}
+			for _, name := range field.Names {
+				extensions[name.Name] = result.Name
+			}
+		}
+	}
+	return extensions, nil
+}
@@ -0,0 +1,311 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// field represents a field identifier (NVML_FI_*) defined in nvml.h.
+type field struct {
+	Name        string
+	Id          int
+	Description string
+	Unit        string
+	Scope       string
+	ValueType   string
+	// group is the text of the comment block that precedes the group of
+	// defines that the field is part of.
+	group string
+}
+
+var fieldDefinePattern = regexp.MustCompile(`^#define\s+NVML_(FI_\w+)\s+(\w+)\s*(?://!<\s*(.*))?$`)
+
+// fieldIdListPattern matches comments that explicitly list the field IDs that
+// they apply to, such as "NVLink counter field id 201-225" or "field id 164,
+// 165, and 166". These apply to fields that are not part of the same group.
+var fieldIdListPattern = regexp.MustCompile(`field id ((?:\d+(?:\s*-\s*\d+)?(?:,\s*|\s+and\s+|,\s*and\s+)?)+)`)
+
+// fieldUnitPatterns maps the units used in the descriptions of the fields to
+// the unit that is reported. The first matching pattern is used.
+var fieldUnitPatterns = []struct {
+	pattern *regexp.Regexp
+	unit    string
+}{
+	{regexp.MustCompile(`\bin mJ\b`), "mJ"},
+	{regexp.MustCompile(`\bin milliwatts\b`), "mW"},
+	{regexp.MustCompile(`\bin mW/s\b`), "mW/s"},
+	{regexp.MustCompile(`\bin Watts\b`), "W"},
+	{regexp.MustCompile(`\bin MBps\b`), "MBps"},
+	{regexp.MustCompile(`\bin KiB\b`), "KiB"},
+	{regexp.MustCompile(`\bin (?:ns|nanoseconds)\b`), "ns"},
+	{regexp.MustCompile(`\bin ms\b`), "ms"},
+	{regexp.MustCompile(`\bin degree Celsius\b`), "C"},
+	{regexp.MustCompile(`\bbytes\b`), "B"},
+	{regexp.MustCompile(`%`), "%"},
+}
+
+// fieldUnitOverrides sets the unit of fields for which it cannot be derived
+// from nvml.h.
+var fieldUnitOverrides = map[string]string{
+	// The memory temperature is reported in degrees Celsius, as is the case
+	// for nvmlDeviceGetTemperature.
+	"FI_DEV_MEMORY_TEMP": "C",
+	// These fields are part of the group of clock event reason counters that
+	// are in nanoseconds, but are not counters themselves.
+	"FI_DEV_POWER_SYNC_BALANCING_FREQ": "",
+	"FI_DEV_POWER_SYNC_BALANCING_AF":   "",
+}
+
+// fieldCounterPattern matches the descriptions of fields that accumulate a
+// count, such as error or traffic counters.
+var fieldCounterPattern = regexp.MustCompile(`(?i)\bcounter\b|\berrors?\b|\bpackets\b|\bcount of\b|\bnumber of times\b|\baccumulated\b|\bthroughput\b|\bBER\b`)
+
+// fieldCounterUnits are the units of fields that accumulate a value, such as
+// an amount of energy or time.
+var fieldCounterUnits = []string{"mJ", "ns", "B", "KiB"}
+
+// writeFields generates the catalog of field identifiers from the nvml.h
+// header in the source directory.
+func writeFields(sourceDir string, outputFile string, header string) error {
+	fields, err := extractFields(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateFields(fields))
+	return nil
+}
+
+func generateFields(fields []*field) string {
+	var output strings.Builder
+	output.WriteString("// fieldInfos describes the field identifiers defined in nvml.h.\n")
+	output.WriteString("var fieldInfos = map[uint32]FieldInfo{\n")
+	for _, f := range fields {
+		output.WriteString(fmt.Sprintf("\t%s: {\n", f.Name))
+		output.WriteString(fmt.Sprintf("\t\tId:          %s,\n", f.Name))
+		output.WriteString(fmt.Sprintf("\t\tName:        %s,\n", strconv.Quote(f.Name)))
+		output.WriteString(fmt.Sprintf("\t\tDescription: %s,\n", strconv.Quote(f.Description)))
+		output.WriteString(fmt.Sprintf("\t\tUnit:        %s,\n", strconv.Quote(f.Unit)))
+		output.WriteString(fmt.Sprintf("\t\tScope:       %s,\n", f.Scope))
+		output.WriteString(fmt.Sprintf("\t\tValueType:   %s,\n", f.ValueType))
+		output.WriteString("\t},\n")
+	}
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// extractFields parses the field identifiers from the nvmlFieldValueEnums
+// group of the specified header. The description of each field is taken from
+// its trailing //!< comments, while its scope and unit are derived from its
+// description and from the comment that precedes its group of defines. A group
+// ends at the first blank line or comment following its defines. Aliases of
+// other fields and NVML_FI_MAX are skipped.
+func extractFields(headerFile string) ([]*field, error) {
+	file, err := os.Open(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var fields []*field
+	var last *field
+	var comment, group []string
+	var inEnums, inComment, inGroup bool
+	explicitScopes := make(map[int]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !inEnums {
+			inEnums = strings.Contains(line, "@defgroup nvmlFieldValueEnums")
+			continue
+		}
+		if inComment || strings.HasPrefix(line, "/*") {
+			if !inComment && inGroup {
+				group = nil
+				inGroup = false
+			}
+			inComment = !strings.HasSuffix(line, "*/")
+			comment = append(comment, strings.Trim(line, "/* "))
+			if inComment {
+				continue
+			}
+			// Consecutive comment blocks describe the same group.
+			group = append(group, comment...)
+			comment = nil
+			scope, err := fieldScope(strings.Join(group, " "))
+			if err != nil {
+				return nil, err
+			}
+			ids, err := parseFieldIdList(strings.Join(group, " "))
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				explicitScopes[id] = scope
+			}
+			continue
+		}
+		if last != nil && strings.HasPrefix(line, "//!<") {
+			last.Description = strings.TrimSpace(last.Description + " " + strings.TrimSpace(strings.TrimPrefix(line, "//!<")))
+			continue
+		}
+		last = nil
+		if line == "" {
+			group = nil
+			inGroup = false
+			continue
+		}
+		match := fieldDefinePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		inGroup = true
+		if match[1] == "FI_MAX" {
+			break
+		}
+		id, err := strconv.Atoi(match[2])
+		if err != nil {
+			// Aliases such as NVML_FI_DEV_CLOCKS_EVENT_REASON_SW_POWER_CAP are
+			// defined to the name of another field.
+			continue
+		}
+		last = &field{
+			Name:        match[1],
+			Id:          id,
+			Description: strings.TrimSpace(match[3]),
+			group:       strings.Join(group, " "),
+		}
+		fields = append(fields, last)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no field identifiers found in %s", headerFile)
+	}
+
+	for _, f := range fields {
+		scope, ok := explicitScopes[f.Id]
+		if !ok {
+			var err error
+			scope, err = fieldScope(f.group)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+		f.Scope = scope
+		f.Unit = fieldUnit(f.Description)
+		if f.Unit == "" {
+			f.Unit = fieldUnit(f.group)
+		}
+		if unit, ok := fieldUnitOverrides[f.Name]; ok {
+			f.Unit = unit
+		}
+		f.ValueType = fieldValueType(f)
+	}
+	return fields, nil
+}
+
+// fieldValueType returns the ValueType that a field is expected to be
+// reported as. This is not declared in nvml.h, so it is derived from the
+// name, description and unit of the field: counters and accumulated values
+// are reported as 64-bit values, while other values (e.g. modes, states,
+// limits and instantaneous readings) are reported as 32-bit values.
+func fieldValueType(f *field) string {
+	switch {
+	case strings.HasPrefix(f.Name, "FI_DEV_NVLINK_COUNT_"):
+		return "VALUE_TYPE_UNSIGNED_LONG_LONG"
+	case slices.Contains(fieldCounterUnits, f.Unit):
+		return "VALUE_TYPE_UNSIGNED_LONG_LONG"
+	case fieldCounterPattern.MatchString(f.Description):
+		return "VALUE_TYPE_UNSIGNED_LONG_LONG"
+	default:
+		return "VALUE_TYPE_UNSIGNED_INT"
+	}
+}
+
+// fieldScope returns the FieldScope described by the comment of a group of
+// fields. An error is raised if the comment mentions a scope that is not
+// recognized.
+func fieldScope(comment string) (string, error) {
+	switch {
+	case strings.Contains(comment, "Link ID needs to be specified in the scopeId field"):
+		return "FieldScopeLink", nil
+	case strings.Contains(comment, "Lane ID needs to be specified in the scopeId field"):
+		return "FieldScopeLane", nil
+	case strings.Contains(comment, "scopeId needs to be specified") && strings.Contains(comment, "Module scope"):
+		return "FieldScopePower", nil
+	case strings.Contains(comment, "scopeId"):
+		return "", fmt.Errorf("unrecognized field scope: %q", comment)
+	default:
+		return "FieldScopeDevice", nil
+	}
+}
+
+// fieldUnit returns the unit mentioned in the specified description.
+func fieldUnit(description string) string {
+	for _, u := range fieldUnitPatterns {
+		if u.pattern.MatchString(description) {
+			return u.unit
+		}
+	}
+	return ""
+}
+
+// parseFieldIdList returns the field IDs that are explicitly listed in the
+// specified comment.
+func parseFieldIdList(comment string) ([]int, error) {
+	match := fieldIdListPattern.FindStringSubmatch(comment)
+	if match == nil {
+		return nil, nil
+	}
+	var ids []int
+	list := strings.NewReplacer(",", " ", "and", " ").Replace(match[1])
+	for _, item := range strings.Fields(strings.ReplaceAll(list, " - ", "-")) {
+		first, last, isRange := strings.Cut(item, "-")
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field id list %q: %w", match[1], err)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(last)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field id list %q: %w", match[1], err)
+			}
+		}
+		for id := start; id <= end; id++ {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
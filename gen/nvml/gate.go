@@ -0,0 +1,145 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// bindingPrefix is prepended to the names of the cgo bindings generated by
+// c-for-go in nvml.go (see nvml.yml). The rest of the package calls these
+// bindings through the gated functions named after the NVML functions.
+const bindingPrefix = "cgo_"
+
+// gatedName returns the name of the gated function for a cgo binding.
+func gatedName(binding string) string {
+	return strings.TrimPrefix(binding, bindingPrefix)
+}
+
+// writeGate generates a gated function for each of the cgo bindings in
+// nvml.go. A gated function returns ERROR_UNINITIALIZED (or the zero value
+// for functions that do not return a Return) if no library is loaded, and
+// prevents the library from being closed while the call is in progress.
+func writeGate(sourceDir string, outputFile string) error {
+	header, err := generateHeader()
+	if err != nil {
+		return err
+	}
+
+	gated, err := generateGatedFunctions(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, "//go:build cgo\n\n")
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, gated)
+	return nil
+}
+
+// generateGatedFunctions generates the gated function for each of the cgo
+// bindings in nvml.go.
+func generateGatedFunctions(sourceDir string) (string, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filepath.Join(sourceDir, "nvml.go"), nil, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	for _, decl := range node.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil {
+			continue
+		}
+		binding := funcDecl.Name.Name
+		if !strings.HasPrefix(binding, bindingPrefix) {
+			return "", fmt.Errorf("cgo binding %s is not prefixed with %q", binding, bindingPrefix)
+		}
+
+		var signature bytes.Buffer
+		if err := printer.Fprint(&signature, fset, funcDecl.Type); err != nil {
+			return "", err
+		}
+		values, err := gateReturnValues(fset, funcDecl)
+		if err != nil {
+			return "", err
+		}
+		var args []string
+		for _, param := range funcDecl.Type.Params.List {
+			for _, name := range param.Names {
+				args = append(args, name.Name)
+			}
+		}
+		call := fmt.Sprintf("%s(%s)", binding, strings.Join(args, ", "))
+		if funcDecl.Type.Results != nil {
+			call = "return " + call
+		}
+
+		name := gatedName(binding)
+		output.WriteString(fmt.Sprintf("// %s calls %s through the call gate.\n", name, binding))
+		output.WriteString(fmt.Sprintf("func %s%s {\n", name, strings.TrimPrefix(signature.String(), "func")))
+		output.WriteString("\tgate := loadedLibraries.enter()\n")
+		output.WriteString("\tif gate == nil {\n")
+		output.WriteString(values)
+		output.WriteString("\t}\n")
+		output.WriteString("\tdefer gate.exit()\n")
+		output.WriteString(fmt.Sprintf("\t%s\n", call))
+		output.WriteString("}\n\n")
+	}
+
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+// gateReturnValues generates the statements that return from a gated function
+// if no library is loaded.
+func gateReturnValues(fset *token.FileSet, funcDecl *ast.FuncDecl) (string, error) {
+	if funcDecl.Type.Results == nil {
+		return "\t\treturn\n", nil
+	}
+
+	var output strings.Builder
+	var values []string
+	for i, result := range funcDecl.Type.Results.List {
+		if ident, ok := result.Type.(*ast.Ident); ok && ident.Name == "Return" {
+			values = append(values, "ERROR_UNINITIALIZED")
+			continue
+		}
+		var resultType bytes.Buffer
+		if err := printer.Fprint(&resultType, fset, result.Type); err != nil {
+			return "", err
+		}
+		name := fmt.Sprintf("__v%d", i)
+		output.WriteString(fmt.Sprintf("\t\tvar %s %s\n", name, resultType.String()))
+		values = append(values, name)
+	}
+	output.WriteString(fmt.Sprintf("\t\treturn %s\n", strings.Join(values, ", ")))
+	return output.String(), nil
+}
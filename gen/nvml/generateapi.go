@@ -33,9 +33,12 @@ import (
 )
 
 type GeneratableInterfacePoperties struct {
-	Type                      string
-	Interface                 string
-	Exclude                   []string
+	Type      string
+	Interface string
+	Exclude   []string
+	// ExcludeExtensions excludes the methods of the extension interfaces of
+	// the type from the generated interface. See extractExtensionMethods.
+	ExcludeExtensions         bool
 	PackageMethodsAliasedFrom string
 }
 
@@ -43,7 +46,7 @@ var GeneratableInterfaces = []GeneratableInterfacePoperties{
 	{
 		Type:                      "library",
 		Interface:                 "Interface",
-		Exclude:                   []string{"LookupSymbol"},
+		ExcludeExtensions:         true,
 		PackageMethodsAliasedFrom: "libnvml",
 	},
 	{
@@ -83,6 +86,21 @@ var GeneratableInterfaces = []GeneratableInterfacePoperties{
 func main() {
 	sourceDir := flag.String("sourceDir", "", "Path to the source directory for all go files")
 	output := flag.String("output", "", "Path to the output file (default: stdout)")
+	symbolsOutput := flag.String("symbolsOutput", "", "Path to the output file for the list of NVML symbols (default: not generated)")
+	errorsOutput := flag.String("errorsOutput", "", "Path to the output file for the nvmlerr package (default: not generated)")
+	returnsOutput := flag.String("returnsOutput", "", "Path to the output file for the catalog of return values (default: not generated)")
+	enumsOutput := flag.String("enumsOutput", "", "Path to the output file for the methods of the enum types (default: not generated)")
+	fieldsOutput := flag.String("fieldsOutput", "", "Path to the output file for the catalog of field identifiers (default: not generated)")
+	encodingOutput := flag.String("encodingOutput", "", "Path to the output file for the JSON and YAML encoding of the structs (default: not generated)")
+	structVersionsOutput := flag.String("structVersionsOutput", "", "Path to the output file for the versions of the versioned structs (default: not generated)")
+	methodsOutput := flag.String("methodsOutput", "", "Path to the output file for the registry of the methods of the generated interfaces (default: not generated)")
+	versionedOutput := flag.String("versionedOutput", "", "Path to the output file for the versioned symbols bound by a library (default: not generated)")
+	interceptOutput := flag.String("interceptOutput", "", "Path to the output file for the methods of the interceptor (default: not generated)")
+	reinitOutput := flag.String("reinitOutput", "", "Path to the output file for the methods of the reinit supervisor (default: not generated)")
+	defaultsOutput := flag.String("defaultsOutput", "", "Path to the output file for the default wrappers of the unwrapped NVML functions (default: not generated)")
+	coverageOutput := flag.String("coverageOutput", "", "Path to the output file for the coverage table of the NVML functions (default: not generated)")
+	nocgoOutput := flag.String("nocgoOutput", "", "Path to the output file for the definitions used without cgo (default: not generated)")
+	gateOutput := flag.String("gateOutput", "", "Path to the output file for the gated functions that call the cgo bindings (default: not generated)")
 	flag.Parse()
 
 	// Check if required flags are provided
@@ -103,6 +121,22 @@ func main() {
 		fmt.Printf("Error: %v", err)
 		return
 	}
+	// The default wrappers are generated first since these add methods to
+	// the generated interfaces.
+	if *defaultsOutput != "" {
+		if err := writeDefaults(*sourceDir, *defaultsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *coverageOutput != "" {
+		if err := writeCoverage(*sourceDir, *coverageOutput); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
 	fmt.Fprint(writer, header)
 
 	for i, p := range GeneratableInterfaces {
@@ -140,6 +174,114 @@ func main() {
 			fmt.Fprint(writer, "\n")
 		}
 	}
+
+	if *symbolsOutput != "" {
+		if err := writeSymbols(*sourceDir, *symbolsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *errorsOutput != "" {
+		if err := writeErrors(*sourceDir, *errorsOutput); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *returnsOutput != "" {
+		if err := writeReturns(*sourceDir, *returnsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *enumsOutput != "" {
+		if err := writeEnums(*sourceDir, *enumsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *fieldsOutput != "" {
+		if err := writeFields(*sourceDir, *fieldsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *encodingOutput != "" {
+		if err := writeEncodings(*sourceDir, *encodingOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *structVersionsOutput != "" {
+		if err := writeStructVersions(*sourceDir, *structVersionsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *methodsOutput != "" {
+		if err := writeMethods(*sourceDir, *methodsOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *versionedOutput != "" {
+		if err := writeVersionedSymbols(*sourceDir, *versionedOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *interceptOutput != "" {
+		if err := writeIntercept(*sourceDir, *interceptOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *reinitOutput != "" {
+		if err := writeReinit(*sourceDir, *reinitOutput, header); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *nocgoOutput != "" {
+		if err := writeNoCgo(*sourceDir, *nocgoOutput); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+
+	if *gateOutput != "" {
+		if err := writeGate(*sourceDir, *gateOutput); err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+	}
+}
+
+func writeSymbols(sourceDir string, outputFile string, header string) error {
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	output, err := generateSymbols(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, output)
+	return nil
 }
 
 func getWriter(outputFile string) (io.Writer, func() error, error) {
@@ -156,6 +298,10 @@ func getWriter(outputFile string) (io.Writer, func() error, error) {
 }
 
 func generateHeader() (string, error) {
+	return generateHeaderForPackage("nvml")
+}
+
+func generateHeaderForPackage(pkg string) (string, error) {
 	lines := []string{
 		"/**",
 		"# Copyright 2024 NVIDIA CORPORATION",
@@ -175,7 +321,7 @@ func generateHeader() (string, error) {
 		"",
 		"// Generated Code; DO NOT EDIT.",
 		"",
-		"package nvml",
+		"package " + pkg,
 		"",
 		"",
 	}
@@ -249,6 +395,62 @@ func generateInterface(sourceDir string, input GeneratableInterfacePoperties) (s
 	return signature.String(), nil
 }
 
+func generateSymbols(sourceDir string) (string, error) {
+	var signature strings.Builder
+
+	symbols, err := extractSymbolsFromBindings(sourceDir)
+	if err != nil {
+		return "", err
+	}
+
+	signature.WriteString("// nvmlSymbols lists the NVML symbols that are referenced by the bindings.\n")
+	signature.WriteString("var nvmlSymbols = []string{\n")
+	for _, symbol := range symbols {
+		signature.WriteString(fmt.Sprintf("\t%q,\n", symbol))
+	}
+	signature.WriteString("}\n")
+
+	return signature.String(), nil
+}
+
+// extractSymbolsFromBindings returns the sorted list of NVML functions that
+// are called through cgo in the bindings generated by c-for-go.
+func extractSymbolsFromBindings(sourceDir string) ([]string, error) {
+	bindings := filepath.Join(sourceDir, "nvml.go")
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, bindings, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if pkg, ok := selector.X.(*ast.Ident); !ok || pkg.Name != "C" {
+			return true
+		}
+		if strings.HasPrefix(selector.Sel.Name, "nvml") {
+			seen[selector.Sel.Name] = true
+		}
+		return true
+	})
+
+	var symbols []string
+	for symbol := range seen {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	return symbols, nil
+}
+
 func getGoFiles(sourceDir string) (map[string][]byte, error) {
 	gofiles := make(map[string][]byte)
 
@@ -291,6 +493,14 @@ func extractMethodsFromPackage(sourceDir string, input GeneratableInterfacePoper
 		methods = append(methods, m...)
 	}
 
+	exclude, err := resolveExcludedMethods(sourceDir, gofiles, input, methods)
+	if err != nil {
+		return nil, err
+	}
+	methods = slices.DeleteFunc(methods, func(method *ast.FuncDecl) bool {
+		return exclude[method.Name.Name]
+	})
+
 	sort.Slice(methods, func(i, j int) bool {
 		return methods[i].Name.Name < methods[j].Name.Name
 	})
@@ -345,11 +555,6 @@ func extractMethods(sourceFile string, sourceContent []byte, input GeneratableIn
 					continue
 				}
 
-				// Ignore method in the exclude list
-				if slices.Contains(input.Exclude, funcDecl.Name.Name) {
-					continue
-				}
-
 				methods = append(methods, funcDecl)
 			}
 		}
@@ -0,0 +1,297 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+)
+
+// interceptedMethods are the methods of the interceptor that are implemented
+// by hand since these pass handles to the library in a struct.
+var interceptedMethods = []string{"GpmMetricsGet", "GpmMetricsGetV"}
+
+// interceptedStructs are the structs that hold handles. If these are returned
+// by a call, the handles are wrapped using the wrap<Struct> method of the
+// interceptor, which is implemented by hand. Methods taking these structs (or
+// any other struct holding handles) as an argument must be implemented by
+// hand.
+var interceptedStructs = []string{"ComputeInstanceInfo", "EventData", "GpuInstanceInfo"}
+
+// handleStructs are the structs that hold handles that are passed to the
+// library.
+var handleStructs = []string{"GpmMetricsGetType"}
+
+// interceptGenerator generates the methods of the interceptor and of the
+// handles that it wraps.
+type interceptGenerator struct {
+	sourceDir string
+	// handles are the names of the handle interfaces (e.g. Device).
+	handles []string
+}
+
+func writeIntercept(sourceDir string, outputFile string, header string) error {
+	output, err := newInterceptGenerator(sourceDir).generate()
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, output)
+	return nil
+}
+
+func newInterceptGenerator(sourceDir string) *interceptGenerator {
+	g := &interceptGenerator{sourceDir: sourceDir}
+	for _, p := range GeneratableInterfaces {
+		if p.Interface != "Interface" {
+			g.handles = append(g.handles, p.Interface)
+		}
+	}
+	return g
+}
+
+func (g *interceptGenerator) generate() (string, error) {
+	var output strings.Builder
+	for _, handle := range g.handles {
+		output.WriteString(g.generateHandle(handle))
+		output.WriteString("\n")
+	}
+	output.WriteString(g.generateUnwrap())
+	output.WriteString("\n")
+
+	for _, p := range GeneratableInterfaces {
+		receiver, impl, wrapped, interceptor := "i", "interceptor", "i.lib", "i"
+		if p.Interface != "Interface" {
+			receiver, impl, wrapped, interceptor = "h", "intercepted"+p.Interface, "h.handle", "h.i"
+		}
+
+		methods, err := extractMethodsFromPackage(g.sourceDir, p)
+		if err != nil {
+			return "", err
+		}
+
+		output.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", p.Interface, impl))
+		for _, method := range methods {
+			if p.Interface == "Interface" && slices.Contains(interceptedMethods, method.Name.Name) {
+				continue
+			}
+			body, err := g.generateMethod(p.Interface, receiver, impl, wrapped, interceptor, method)
+			if err != nil {
+				return "", err
+			}
+			output.WriteString(body)
+			output.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+// generateHandle generates the type that wraps a handle, along with the
+// functions to wrap and unwrap it.
+func (g *interceptGenerator) generateHandle(handle string) string {
+	impl := "intercepted" + handle
+	name := lowerFirst(handle)
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("// %s is a %s returned by an interceptor.\n", impl, handle))
+	output.WriteString(fmt.Sprintf("type %s struct {\n", impl))
+	output.WriteString("\ti      *interceptor\n")
+	output.WriteString(fmt.Sprintf("\thandle %s\n", handle))
+	output.WriteString("}\n")
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("func (i *interceptor) wrap%s(%s %s) %s {\n", handle, name, handle, handle))
+	output.WriteString(fmt.Sprintf("\tif %s == nil {\n", name))
+	output.WriteString("\t\treturn nil\n")
+	output.WriteString("\t}\n")
+	output.WriteString(fmt.Sprintf("\treturn &%s{i: i, handle: %s}\n", impl, name))
+	output.WriteString("}\n")
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("// unwrap%s returns the handle to pass to the wrapped Interface.\n", handle))
+	output.WriteString(fmt.Sprintf("func unwrap%s(%s %s) %s {\n", handle, name, handle, handle))
+	output.WriteString(fmt.Sprintf("\tif h, ok := %s.(*%s); ok {\n", name, impl))
+	output.WriteString("\t\treturn h.handle\n")
+	output.WriteString("\t}\n")
+	output.WriteString(fmt.Sprintf("\treturn %s\n", name))
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// generateUnwrap generates the Unwrap function, which returns the handle
+// wrapped by any of the handle types.
+func (g *interceptGenerator) generateUnwrap() string {
+	var output strings.Builder
+	output.WriteString("// Unwrap returns the handle wrapped by a handle (e.g. a Device) that was\n")
+	output.WriteString("// returned by an Interface created by Intercept. Calls to the methods of the\n")
+	output.WriteString("// returned handle are not intercepted by that Interface. Any other value is\n")
+	output.WriteString("// returned as is.\n")
+	output.WriteString("func Unwrap(handle interface{}) interface{} {\n")
+	output.WriteString("\tswitch h := handle.(type) {\n")
+	for _, handle := range g.handles {
+		output.WriteString(fmt.Sprintf("\tcase *intercepted%s:\n", handle))
+		output.WriteString("\t\treturn h.handle\n")
+	}
+	output.WriteString("\t}\n")
+	output.WriteString("\treturn handle\n")
+	output.WriteString("}\n")
+	return output.String()
+}
+
+func (g *interceptGenerator) generateMethod(iface string, receiver string, impl string, wrapped string, interceptor string, method *ast.FuncDecl) (string, error) {
+	name := method.Name.Name
+
+	var params []string
+	var args []string
+	var callArgs []string
+	for i, param := range method.Type.Params.List {
+		names := param.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, n := range names {
+			if n.Name == receiver || n.Name == "ret" || n.Name == "call" || strings.HasPrefix(n.Name, "r") && isNumeric(n.Name[1:]) {
+				return "", fmt.Errorf("%s: parameter name %q conflicts with generated names", name, n.Name)
+			}
+			typeName := formatFieldList(param)
+			if typeName == "" {
+				return "", fmt.Errorf("%s: unsupported type for parameter %q", name, n.Name)
+			}
+			if g.holdsHandles(typeName) {
+				return "", fmt.Errorf("%s: parameter %q holds handles and must be handled in interceptedMethods", name, n.Name)
+			}
+			params = append(params, fmt.Sprintf("%s %s", n.Name, typeName))
+			callArgs = append(callArgs, n.Name)
+			args = append(args, g.unwrap(typeName, n.Name))
+		}
+	}
+
+	var types []string
+	if method.Type.Results != nil {
+		for _, result := range method.Type.Results.List {
+			if len(result.Names) > 1 {
+				return "", fmt.Errorf("%s: grouped results are not supported", name)
+			}
+			typeName := formatFieldList(result)
+			if typeName == "" {
+				return "", fmt.Errorf("%s: unsupported result type", name)
+			}
+			types = append(types, typeName)
+		}
+	}
+
+	call := fmt.Sprintf("%s.%s(%s)", wrapped, name, strings.Join(args, ", "))
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("func (%s *%s) %s(%s)", receiver, impl, name, strings.Join(params, ", ")))
+	switch len(types) {
+	case 0:
+		output.WriteString(fmt.Sprintf(" {\n\t%s\n}\n", call))
+		return output.String(), nil
+	case 1:
+		output.WriteString(fmt.Sprintf(" %s {\n", types[0]))
+	default:
+		output.WriteString(fmt.Sprintf(" (%s) {\n", strings.Join(types, ", ")))
+	}
+
+	// Methods that do not return a Return are forwarded without being
+	// intercepted.
+	if types[len(types)-1] != "Return" {
+		for _, t := range types {
+			if g.returnsHandles(t) {
+				return "", fmt.Errorf("%s: handles returned without a Return are not supported", name)
+			}
+		}
+		output.WriteString(fmt.Sprintf("\treturn %s\n}\n", call))
+		return output.String(), nil
+	}
+
+	label := name
+	receiverArg := ""
+	if receiver != "i" {
+		label = iface + "." + name
+		receiverArg = fmt.Sprintf(", Receiver: %s", receiver)
+	}
+	argList := ""
+	if len(callArgs) > 0 {
+		argList = fmt.Sprintf(", Args: []interface{}{%s}", strings.Join(callArgs, ", "))
+	}
+
+	var vars []string
+	var values []string
+	for i, t := range types[:len(types)-1] {
+		v := fmt.Sprintf("r%d", i)
+		output.WriteString(fmt.Sprintf("\tvar %s %s\n", v, t))
+		vars = append(vars, v)
+		if g.returnsHandles(t) {
+			v = g.wrap(t, interceptor, v)
+		}
+		values = append(values, v)
+	}
+	vars = append(vars, "ret")
+	values = append(values, "ret")
+
+	output.WriteString(fmt.Sprintf("\tcall := Call{Method: %q%s%s}\n", label, receiverArg, argList))
+	output.WriteString(fmt.Sprintf("\tret := %s.intercept(call, func() (ret Return) {\n", interceptor))
+	output.WriteString(fmt.Sprintf("\t\t%s = %s\n", strings.Join(vars, ", "), call))
+	output.WriteString("\t\treturn ret\n")
+	output.WriteString("\t})\n")
+	output.WriteString(fmt.Sprintf("\treturn %s\n", strings.Join(values, ", ")))
+	output.WriteString("}\n")
+	return output.String(), nil
+}
+
+// unwrap returns the expression that unwraps an argument of the specified
+// type to pass it to the wrapped Interface or handle.
+func (g *interceptGenerator) unwrap(typeName string, value string) string {
+	if slices.Contains(g.handles, typeName) {
+		return fmt.Sprintf("unwrap%s(%s)", typeName, value)
+	}
+	if element := strings.TrimPrefix(typeName, "[]"); element != typeName && slices.Contains(g.handles, element) {
+		return fmt.Sprintf("unwrapHandles(%s, unwrap%s)", value, element)
+	}
+	return value
+}
+
+// returnsHandles checks whether a result of the specified type holds handles
+// that must be wrapped.
+func (g *interceptGenerator) returnsHandles(typeName string) bool {
+	element := strings.TrimPrefix(typeName, "[]")
+	return slices.Contains(g.handles, element) || slices.Contains(interceptedStructs, typeName)
+}
+
+// wrap returns the expression that wraps a result of the specified type.
+func (g *interceptGenerator) wrap(typeName string, interceptor string, value string) string {
+	if element := strings.TrimPrefix(typeName, "[]"); element != typeName {
+		return fmt.Sprintf("wrapHandles(%s, %s.wrap%s)", value, interceptor, element)
+	}
+	return fmt.Sprintf("%s.wrap%s(%s)", interceptor, typeName, value)
+}
+
+// holdsHandles checks whether an argument of the specified type holds handles
+// in a struct, which cannot be unwrapped by the generated code.
+func (g *interceptGenerator) holdsHandles(typeName string) bool {
+	base := strings.TrimLeft(typeName, "[]*")
+	return slices.Contains(interceptedStructs, base) || slices.Contains(handleStructs, base)
+}
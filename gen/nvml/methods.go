@@ -0,0 +1,286 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// declarationPattern matches the declaration of a function in nvml.h along
+// with the doc comment preceding it.
+var declarationPattern = regexp.MustCompile(`(?s)(?:/\*\*((?:[^*]|\*+[^*/])*)\*+/\s*)?(?:const\s+)?\w+\s+DECLDIR\s+(?:char\s*\*\s*)?(nvml\w+)\s*\(([^)]*)\)`)
+
+// camelCasePattern matches the words of a function name.
+var camelCasePattern = regexp.MustCompile(`[A-Z][a-z0-9]*|[a-z0-9]+`)
+
+// functionObjects lists the objects that NVML functions are named after, e.g.
+// nvmlDeviceGetName operates on a Device. Longer names are listed first so
+// that e.g. nvmlEventSetCreate is not taken to set an Event.
+var functionObjects = []string{
+	"SystemEventSet",
+	"ComputeInstance",
+	"GpuInstance",
+	"VgpuInstance",
+	"VgpuType",
+	"EventSet",
+	"GpmSample",
+	"GpmMig",
+	"Device",
+	"System",
+	"Unit",
+	"Gpm",
+}
+
+// mutatingVerbs are the verbs of the NVML functions that modify the state of
+// the system or of a device.
+var mutatingVerbs = map[string]bool{
+	"Clear":    true,
+	"Create":   true,
+	"Destroy":  true,
+	"Discover": true,
+	"Freeze":   true,
+	"Modify":   true,
+	"Remove":   true,
+	"Reset":    true,
+	"Set":      true,
+}
+
+// mutatingFunctions overrides the verb-based detection of the functions that
+// modify the state of the system or of a device.
+var mutatingFunctions = map[string]bool{
+	// Register values may be written.
+	"nvmlDeviceReadWritePRM": true,
+	// Event sets are only allocated by the library.
+	"nvmlEventSetCreate":       false,
+	"nvmlSystemEventSetCreate": false,
+}
+
+// headerFunction holds the annotations of a function in nvml.h.
+type headerFunction struct {
+	// Doc is the doc comment of the function.
+	Doc string
+	// Device is the name of the device parameter of the function, if the
+	// function takes a device as its first parameter.
+	Device string
+}
+
+// methodInfo describes a method of a generated interface.
+type methodInfo struct {
+	Interface    string
+	Name         string
+	Symbol       string
+	Mutates      bool
+	RequiresRoot bool
+	Handles      []string
+}
+
+// writeMethods generates the registry describing the methods of Interface and
+// of the handle interfaces.
+func writeMethods(sourceDir string, outputFile string, header string) error {
+	functions, err := extractHeaderFunctions(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return err
+	}
+	methods, err := extractMethodInfos(sourceDir, functions)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateMethods(methods))
+	return nil
+}
+
+// extractHeaderFunctions returns the annotations of the functions in the
+// specified nvml.h header, keyed by their unversioned name. The doc comments
+// of the versions of a function are joined.
+func extractHeaderFunctions(headerFile string) (map[string]*headerFunction, error) {
+	contents, err := os.ReadFile(headerFile)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := make(map[string]*headerFunction)
+	for _, match := range declarationPattern.FindAllStringSubmatch(string(contents), -1) {
+		doc, name, params := match[1], unversionedSymbol(match[2]), match[3]
+		f, ok := functions[name]
+		if !ok {
+			f = &headerFunction{}
+			functions[name] = f
+		}
+		f.Doc += doc
+		if fields := strings.Fields(strings.Split(params, ",")[0]); len(fields) == 2 && fields[0] == "nvmlDevice_t" {
+			f.Device = fields[1]
+		}
+	}
+	if len(functions) == 0 {
+		return nil, fmt.Errorf("no functions found in %s", headerFile)
+	}
+	return functions, nil
+}
+
+// extractMethodInfos describes the methods of the generated interfaces. The
+// NVML function called by each method is resolved as for the nvmlerr package.
+func extractMethodInfos(sourceDir string, functions map[string]*headerFunction) ([]*methodInfo, error) {
+	g := newErrorsGenerator(sourceDir)
+	extracted := make(map[string][]*ast.FuncDecl)
+	methods := make(map[string]*ast.FuncDecl)
+	for _, p := range GeneratableInterfaces {
+		m, err := extractMethodsFromPackage(sourceDir, p)
+		if err != nil {
+			return nil, err
+		}
+		extracted[p.Interface] = m
+		for _, method := range m {
+			methods[p.Interface+"."+method.Name.Name] = method
+		}
+	}
+	if err := g.resolveFunctions(methods); err != nil {
+		return nil, err
+	}
+
+	var infos []*methodInfo
+	for _, p := range GeneratableInterfaces {
+		for _, method := range extracted[p.Interface] {
+			info := &methodInfo{Interface: p.Interface, Name: method.Name.Name}
+			// Methods that do not call an NVML function directly, such as
+			// those returning a handler, are named after the function. The
+			// name of a handler may have a V suffix (e.g. GpmMetricsGetV).
+			for _, symbol := range []string{g.functionName(p, info.Name), g.functionName(p, strings.TrimSuffix(info.Name, "V"))} {
+				if functions[symbol] != nil {
+					info.Symbol = symbol
+					break
+				}
+			}
+			if f := functions[info.Symbol]; f != nil {
+				info.Mutates = isMutating(info.Symbol)
+				info.RequiresRoot = strings.Contains(f.Doc, "Requires root")
+				info.Handles = deviceHandles(f)
+			}
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+// isMutating checks whether the specified function modifies the state of the
+// system or of a device. This is the case if the first verb in its name
+// after the object it operates on (e.g. Set in nvmlDeviceSetMigMode) is a
+// mutating verb.
+func isMutating(symbol string) bool {
+	if mutates, ok := mutatingFunctions[symbol]; ok {
+		return mutates
+	}
+	name := strings.TrimPrefix(symbol, "nvml")
+	for _, object := range functionObjects {
+		if strings.HasPrefix(name, object) {
+			name = strings.TrimPrefix(name, object)
+			break
+		}
+	}
+	for _, word := range camelCasePattern.FindAllString(name, -1) {
+		switch {
+		case mutatingVerbs[word]:
+			return true
+		case word == "Get" || word == "Query":
+			return false
+		}
+	}
+	return false
+}
+
+// migDeviceOnlyPhrases are the phrases in nvml.h that document that a
+// function applies to MIG device handles only.
+var migDeviceOnlyPhrases = []string{
+	"only supports MIG device handles",
+	"Target MIG device handle",
+}
+
+// migDevicePhrases are the phrases in nvml.h that document that a function
+// applies to MIG device handles as well as to full GPUs.
+var migDevicePhrases = []string{
+	"device handle or MIG device handle",
+	"When used with MIG device handles",
+	"using specific MIG device handles",
+	"Test if the given handle refers to a MIG device",
+}
+
+// deviceHandles returns the kinds of device handles that the specified
+// function applies to, as documented in nvml.h. Functions apply to full GPUs
+// only unless their documentation states that MIG device handles are
+// supported.
+func deviceHandles(f *headerFunction) []string {
+	if f.Device == "" {
+		return nil
+	}
+	doc := strings.Join(strings.Fields(strings.ReplaceAll(f.Doc, "*", " ")), " ")
+	if f.Device == "migDevice" || containsAny(doc, migDeviceOnlyPhrases) {
+		return []string{"MigDeviceHandle"}
+	}
+	if containsAny(doc, migDevicePhrases) {
+		return []string{"FullGpuHandle", "MigDeviceHandle"}
+	}
+	return []string{"FullGpuHandle"}
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func generateMethods(methods []*methodInfo) string {
+	var output strings.Builder
+	output.WriteString("// methodInfos describes the methods of Interface and of the handle\n")
+	output.WriteString("// interfaces, ordered by interface and method name.\n")
+	output.WriteString("var methodInfos = []MethodInfo{\n")
+	for _, m := range methods {
+		fields := []string{
+			fmt.Sprintf("Interface: %q", m.Interface),
+			fmt.Sprintf("Name: %q", m.Name),
+		}
+		if m.Symbol != "" {
+			fields = append(fields, fmt.Sprintf("Symbol: %q", m.Symbol))
+		}
+		if m.Mutates {
+			fields = append(fields, "Mutates: true")
+		}
+		if m.RequiresRoot {
+			fields = append(fields, "RequiresRoot: true")
+		}
+		if len(m.Handles) > 0 {
+			fields = append(fields, fmt.Sprintf("Handles: %s", strings.Join(m.Handles, " | ")))
+		}
+		output.WriteString(fmt.Sprintf("\t{%s},\n", strings.Join(fields, ", ")))
+	}
+	output.WriteString("}\n")
+	return output.String()
+}
@@ -0,0 +1,173 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// writeNoCgo generates the definitions that replace the cgo bindings when
+// building without cgo. This includes a stub for each of the functions in
+// nvml.go and placeholders for the C types referenced by the Go types.
+func writeNoCgo(sourceDir string, outputFile string) error {
+	header, err := generateHeader()
+	if err != nil {
+		return err
+	}
+
+	stubs, err := generateNoCgoStubs(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	ctypes, err := generateNoCgoTypes(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, "//go:build !cgo\n\n")
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, ctypes)
+	fmt.Fprint(writer, "\n")
+	fmt.Fprint(writer, stubs)
+	return nil
+}
+
+// generateNoCgoStubs generates a stub for the gated function of each of the
+// cgo bindings.
+// Functions returning a Return return ERROR_LIBRARY_NOT_FOUND.
+func generateNoCgoStubs(sourceDir string) (string, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filepath.Join(sourceDir, "nvml.go"), nil, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	for _, decl := range node.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil {
+			continue
+		}
+
+		var signature bytes.Buffer
+		if err := printer.Fprint(&signature, fset, funcDecl.Type); err != nil {
+			return "", err
+		}
+
+		name := gatedName(funcDecl.Name.Name)
+		output.WriteString(fmt.Sprintf("// %s is not available without cgo.\n", name))
+		output.WriteString(fmt.Sprintf("func %s%s {\n", name, strings.TrimPrefix(signature.String(), "func")))
+		if funcDecl.Type.Results != nil {
+			var values []string
+			for i, result := range funcDecl.Type.Results.List {
+				if ident, ok := result.Type.(*ast.Ident); ok && ident.Name == "Return" {
+					values = append(values, "ERROR_LIBRARY_NOT_FOUND")
+					continue
+				}
+				var resultType bytes.Buffer
+				if err := printer.Fprint(&resultType, fset, result.Type); err != nil {
+					return "", err
+				}
+				name := fmt.Sprintf("__v%d", i)
+				output.WriteString(fmt.Sprintf("\tvar %s %s\n", name, resultType.String()))
+				values = append(values, name)
+			}
+			output.WriteString(fmt.Sprintf("\treturn %s\n", strings.Join(values, ", ")))
+		}
+		output.WriteString("}\n\n")
+	}
+
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+// generateNoCgoTypes generates placeholders for the C types that are
+// referenced by the files in the source directory that do not import "C".
+// These types are only used for fields that are never passed to C when
+// building without cgo.
+func generateNoCgoTypes(sourceDir string) (string, error) {
+	entries, err := os.ReadDir(sourceDir)
+	if err != nil {
+		return "", err
+	}
+
+	ctypes := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, filepath.Join(sourceDir, entry.Name()), nil, parser.ImportsOnly)
+		if err != nil {
+			return "", err
+		}
+		if importsC(node) {
+			continue
+		}
+		node, err = parser.ParseFile(fset, filepath.Join(sourceDir, entry.Name()), nil, 0)
+		if err != nil {
+			return "", err
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && strings.HasPrefix(ident.Name, "_Ctype_") {
+				ctypes[ident.Name] = true
+			}
+			return true
+		})
+	}
+
+	var names []string
+	for name := range ctypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var output strings.Builder
+	output.WriteString("// The types below are placeholders for the C types defined by cgo.\n")
+	output.WriteString("type (\n")
+	for _, name := range names {
+		output.WriteString(fmt.Sprintf("\t%s struct{}\n", name))
+	}
+	output.WriteString(")\n")
+
+	return output.String(), nil
+}
+
+func importsC(node *ast.File) bool {
+	for _, spec := range node.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil && path == "C" {
+			return true
+		}
+	}
+	return false
+}
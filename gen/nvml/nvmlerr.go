@@ -0,0 +1,601 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// builtinTypes are the types that are not qualified with the nvml package
+// name when generating the nvmlerr package.
+var builtinTypes = map[string]bool{
+	"bool":    true,
+	"byte":    true,
+	"error":   true,
+	"float32": true,
+	"float64": true,
+	"int":     true,
+	"int16":   true,
+	"int32":   true,
+	"int64":   true,
+	"int8":    true,
+	"rune":    true,
+	"string":  true,
+	"uint":    true,
+	"uint16":  true,
+	"uint32":  true,
+	"uint64":  true,
+	"uint8":   true,
+	"uintptr": true,
+}
+
+// errorsGenerator generates the nvmlerr package. This wraps each of the
+// generated interfaces in an equivalent interface where the trailing Return
+// value of a method is replaced by an error.
+type errorsGenerator struct {
+	sourceDir string
+	// handles maps the interface name of a handle type (e.g. Device) to the
+	// properties used to generate it.
+	handles map[string]GeneratableInterfacePoperties
+	// functions maps a handle method (e.g. Device.GetName) to the name of the
+	// NVML function that it calls (e.g. nvmlDeviceGetName).
+	functions map[string]string
+	// helpers records the wrap / unwrap helpers that are required.
+	helpers map[string]bool
+}
+
+func newErrorsGenerator(sourceDir string) *errorsGenerator {
+	g := &errorsGenerator{
+		sourceDir: sourceDir,
+		handles:   make(map[string]GeneratableInterfacePoperties),
+		functions: make(map[string]string),
+		helpers:   make(map[string]bool),
+	}
+	for _, p := range GeneratableInterfaces {
+		if p.PackageMethodsAliasedFrom == "" {
+			g.handles[p.Interface] = p
+		}
+	}
+	return g
+}
+
+func writeErrors(sourceDir string, outputFile string) error {
+	header, err := generateHeaderForPackage("nvmlerr")
+	if err != nil {
+		return err
+	}
+
+	// The output is generated before the output file is created since the
+	// nvmlerr package is in a subdirectory of the source directory.
+	output, err := newErrorsGenerator(sourceDir).generate()
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, output)
+	return nil
+}
+
+func (g *errorsGenerator) generate() (string, error) {
+	extracted := make(map[string][]*ast.FuncDecl)
+	methods := make(map[string]*ast.FuncDecl)
+	for _, p := range GeneratableInterfaces {
+		m, err := extractMethodsFromPackage(g.sourceDir, p)
+		if err != nil {
+			return "", err
+		}
+		extracted[p.Interface] = m
+		for _, method := range m {
+			methods[p.Interface+"."+method.Name.Name] = method
+		}
+	}
+	if err := g.resolveFunctions(methods); err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+	output.WriteString("import (\n\t\"github.com/NVIDIA/go-nvml/pkg/nvml\"\n)\n\n")
+
+	for _, p := range GeneratableInterfaces {
+		s, err := g.generateType(p, extracted[p.Interface])
+		if err != nil {
+			return "", err
+		}
+		output.WriteString(s)
+		output.WriteString("\n")
+	}
+
+	output.WriteString(g.generateHelpers())
+
+	return strings.TrimSuffix(output.String(), "\n") + "\n", nil
+}
+
+// resolveFunctions determines the NVML function called by each method. This
+// is the first NVML function called through the versioned symbols in the body
+// of the method (e.g. l.loadedSymbols().nvmlInit), or the first NVML function called
+// if there is none. Preferring the versioned symbols means that special cases
+// handled before the main call (e.g. calling nvmlInitWithFlags if init flags
+// were specified) do not affect the result. If no NVML function is called
+// directly, a method that forwards its call to a method of a handle type
+// (e.g. DeviceGetName forwarding to Device.GetName) is resolved to the
+// function called by that method.
+func (g *errorsGenerator) resolveFunctions(methods map[string]*ast.FuncDecl) error {
+	symbols, err := extractSymbolsFromBindings(g.sourceDir)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool)
+	for _, symbol := range symbols {
+		known[unversionedSymbol(symbol)] = true
+	}
+
+	var resolve func(key string, depth int) string
+	resolve = func(key string, depth int) string {
+		if name, ok := g.functions[key]; ok {
+			return name
+		}
+		method, ok := methods[key]
+		if !ok || method.Body == nil || depth > len(methods) {
+			return ""
+		}
+
+		var function, versioned string
+		ast.Inspect(method.Body, func(n ast.Node) bool {
+			if versioned != "" {
+				return false
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			var name string
+			switch fn := call.Fun.(type) {
+			case *ast.Ident:
+				name = fn.Name
+			case *ast.SelectorExpr:
+				name = fn.Sel.Name
+			}
+			if name == "" || isPublic(name) {
+				return true
+			}
+			if !strings.HasPrefix(name, "nvml") {
+				name = "nvml" + strings.ToUpper(name[:1]) + name[1:]
+			}
+			if name = unversionedSymbol(name); !known[name] {
+				return true
+			}
+			if function == "" {
+				function = name
+			}
+			if isVersionedSymbolCall(call) {
+				versioned = name
+			}
+			return true
+		})
+		if versioned != "" {
+			function = versioned
+		}
+		if function == "" {
+			if forwarded := g.forwardedMethod(method); forwarded != "" {
+				function = resolve(forwarded, depth+1)
+			}
+		}
+		g.functions[key] = function
+		return function
+	}
+
+	for key := range methods {
+		resolve(key, 0)
+	}
+	return nil
+}
+
+// isVersionedSymbolCall checks whether a call is made through a set of
+// versioned symbols, such as l.loadedSymbols().nvmlInit() or
+// boundSymbols().nvmlDeviceGetPciInfo().
+func isVersionedSymbolCall(call *ast.CallExpr) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	switch x := selector.X.(type) {
+	case *ast.SelectorExpr:
+		return x.Sel.Name == "symbols"
+	case *ast.CallExpr:
+		switch fn := x.Fun.(type) {
+		case *ast.Ident:
+			return strings.HasSuffix(fn.Name, "Symbols")
+		case *ast.SelectorExpr:
+			return strings.HasSuffix(fn.Sel.Name, "Symbols")
+		}
+	}
+	return false
+}
+
+// forwardedMethod returns the handle method (e.g. Device.GetName) that the
+// specified method forwards its call to, if any.
+func (g *errorsGenerator) forwardedMethod(method *ast.FuncDecl) string {
+	if len(method.Body.List) != 1 {
+		return ""
+	}
+	ret, ok := method.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return ""
+	}
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	target, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	fields := method.Type.Params.List
+	if method.Recv != nil {
+		fields = append(append([]*ast.Field{}, method.Recv.List...), fields...)
+	}
+	for _, field := range fields {
+		typeName, ok := field.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		handle := typeName.Name
+		for _, p := range g.handles {
+			if p.Type == handle {
+				handle = p.Interface
+			}
+		}
+		if _, isHandle := g.handles[handle]; !isHandle {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name == target.Name {
+				return handle + "." + selector.Sel.Name
+			}
+		}
+	}
+	return ""
+}
+
+// unversionedSymbol strips the version suffix (e.g. _v2) from a symbol.
+func unversionedSymbol(symbol string) string {
+	if i := strings.LastIndex(symbol, "_v"); i > 0 && isNumeric(symbol[i+2:]) {
+		return symbol[:i]
+	}
+	return symbol
+}
+
+// functionName returns the name of the NVML function called by the specified method.
+func (g *errorsGenerator) functionName(p GeneratableInterfacePoperties, method string) string {
+	if name := g.functions[p.Interface+"."+method]; name != "" {
+		return name
+	}
+	if p.PackageMethodsAliasedFrom != "" {
+		return "nvml" + method
+	}
+	return "nvml" + p.Interface + method
+}
+
+func (g *errorsGenerator) generateType(p GeneratableInterfacePoperties, methods []*ast.FuncDecl) (string, error) {
+	var output strings.Builder
+
+	impl := lowerFirst(p.Interface)
+	receiver := "w"
+	wrapped := "w.Interface"
+	if p.PackageMethodsAliasedFrom != "" {
+		impl = "lib"
+	} else {
+		wrapped = "w.handle"
+	}
+
+	output.WriteString(fmt.Sprintf("// %s represents the interface for the nvml.%s type.\n", p.Interface, p.Interface))
+	output.WriteString("// Methods that return an nvml.Return return an error instead.\n")
+	output.WriteString(fmt.Sprintf("type %s interface {\n", p.Interface))
+	if p.PackageMethodsAliasedFrom == "" {
+		output.WriteString(fmt.Sprintf("\tUnwrap() nvml.%s\n", p.Interface))
+	}
+
+	var bodies strings.Builder
+	for _, method := range methods {
+		params, args, device, err := g.formatParams(p, method)
+		if err != nil {
+			return "", err
+		}
+		results, body, err := g.formatResults(p, method, wrapped, args, device)
+		if err != nil {
+			return "", err
+		}
+
+		signature := fmt.Sprintf("%s(%s)%s", method.Name.Name, params, results)
+		output.WriteString(fmt.Sprintf("\t%s\n", signature))
+
+		bodies.WriteString(fmt.Sprintf("func (%s *%s) %s {\n", receiver, impl, signature))
+		bodies.WriteString(body)
+		bodies.WriteString("}\n\n")
+	}
+	output.WriteString("}\n\n")
+
+	if p.PackageMethodsAliasedFrom != "" {
+		output.WriteString(fmt.Sprintf("type %s struct {\n\tnvml.%s\n}\n\n", impl, p.Interface))
+		output.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", p.Interface, impl))
+	} else {
+		output.WriteString(fmt.Sprintf("type %s struct {\n\thandle nvml.%s\n}\n\n", impl, p.Interface))
+		output.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", p.Interface, impl))
+		output.WriteString(fmt.Sprintf("func (%s *%s) Unwrap() nvml.%s {\n\treturn %s\n}\n\n", receiver, impl, p.Interface, wrapped))
+	}
+	output.WriteString(bodies.String())
+
+	return output.String(), nil
+}
+
+// formatParams returns the parameter list of the generated method and the
+// arguments used to call the wrapped method. If the method is called for a
+// device, the expression for the device is also returned.
+func (g *errorsGenerator) formatParams(p GeneratableInterfacePoperties, method *ast.FuncDecl) (string, string, string, error) {
+	var params []string
+	var args []string
+	var device string
+	if p.Interface == "Device" {
+		device = "w.handle"
+	}
+
+	for i, param := range method.Type.Params.List {
+		names := param.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, name := range names {
+			if name.Name == "w" || name.Name == "ret" || name.Name == "nvml" || strings.HasPrefix(name.Name, "r") && isNumeric(name.Name[1:]) {
+				return "", "", "", fmt.Errorf("%s: parameter name %q conflicts with generated names", method.Name.Name, name.Name)
+			}
+			typeName, err := g.formatType(param.Type)
+			if err != nil {
+				return "", "", "", fmt.Errorf("%s: %w", method.Name.Name, err)
+			}
+			params = append(params, fmt.Sprintf("%s %s", name.Name, typeName))
+
+			arg := name.Name
+			if handle, slice := g.handleType(param.Type); handle != "" {
+				arg = fmt.Sprintf("%s(%s)", g.helper("unwrap", handle, slice), name.Name)
+				if handle == "Device" && !slice && device == "" {
+					device = arg
+				}
+			}
+			args = append(args, arg)
+		}
+	}
+
+	return strings.Join(params, ", "), strings.Join(args, ", "), device, nil
+}
+
+// formatResults returns the result list of the generated method as well as
+// its body. A trailing nvml.Return is converted to an error.
+func (g *errorsGenerator) formatResults(p GeneratableInterfacePoperties, method *ast.FuncDecl, wrapped string, args string, device string) (string, string, error) {
+	call := fmt.Sprintf("%s.%s(%s)", wrapped, method.Name.Name, args)
+	if method.Type.Results == nil || len(method.Type.Results.List) == 0 {
+		return "", fmt.Sprintf("\t%s\n", call), nil
+	}
+
+	var results []*ast.Field
+	for _, result := range method.Type.Results.List {
+		if len(result.Names) > 1 {
+			return "", "", fmt.Errorf("%s: grouped results are not supported", method.Name.Name)
+		}
+		results = append(results, result)
+	}
+
+	last := results[len(results)-1]
+	returnsError := false
+	if ident, ok := last.Type.(*ast.Ident); ok && ident.Name == "Return" {
+		returnsError = true
+	}
+
+	var types []string
+	var vars []string
+	var values []string
+	for i, result := range results {
+		if returnsError && i == len(results)-1 {
+			types = append(types, "error")
+			vars = append(vars, "ret")
+			if device == "" {
+				device = "nil"
+			}
+			values = append(values, fmt.Sprintf("newError(%q, %s, ret)", g.functionName(p, method.Name.Name), device))
+			continue
+		}
+		typeName, err := g.formatType(result.Type)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %w", method.Name.Name, err)
+		}
+		types = append(types, typeName)
+
+		v := fmt.Sprintf("r%d", i)
+		vars = append(vars, v)
+		if handle, slice := g.handleType(result.Type); handle != "" {
+			v = fmt.Sprintf("%s(%s)", g.helper("wrap", handle, slice), v)
+		}
+		values = append(values, v)
+	}
+
+	resultList := " " + types[0]
+	if len(types) > 1 {
+		resultList = fmt.Sprintf(" (%s)", strings.Join(types, ", "))
+	}
+
+	var body strings.Builder
+	if strings.Join(vars, ", ") == strings.Join(values, ", ") {
+		body.WriteString(fmt.Sprintf("\treturn %s\n", call))
+		return resultList, body.String(), nil
+	}
+	body.WriteString(fmt.Sprintf("\t%s := %s\n", strings.Join(vars, ", "), call))
+	body.WriteString(fmt.Sprintf("\treturn %s\n", strings.Join(values, ", ")))
+
+	return resultList, body.String(), nil
+}
+
+// formatType formats the specified nvml type for use in the nvmlerr package.
+func (g *errorsGenerator) formatType(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, isHandle := g.handles[t.Name]; isHandle || builtinTypes[t.Name] {
+			return t.Name, nil
+		}
+		if isPublic(t.Name) {
+			return "nvml." + t.Name, nil
+		}
+		return "", fmt.Errorf("unexported type %v", t.Name)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", fmt.Errorf("unsupported array type")
+		}
+		elt, err := g.formatType(t.Elt)
+		if err != nil {
+			return "", err
+		}
+		if _, slice := g.handleType(t.Elt); slice {
+			return "", fmt.Errorf("unsupported nested slice of handles")
+		}
+		return "[]" + elt, nil
+	case *ast.StarExpr:
+		if handle, _ := g.handleType(t.X); handle != "" {
+			return "", fmt.Errorf("unsupported pointer to handle %v", handle)
+		}
+		x, err := g.formatType(t.X)
+		if err != nil {
+			return "", err
+		}
+		return "*" + x, nil
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+// handleType returns the name of the handle type for the specified type as
+// well as whether this is a slice of handles.
+func (g *errorsGenerator) handleType(expr ast.Expr) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if _, isHandle := g.handles[t.Name]; isHandle {
+			return t.Name, false
+		}
+	case *ast.ArrayType:
+		if ident, ok := t.Elt.(*ast.Ident); ok {
+			if _, isHandle := g.handles[ident.Name]; isHandle {
+				return ident.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// helper records that the specified wrap / unwrap helper is required and
+// returns its name.
+func (g *errorsGenerator) helper(kind string, handle string, slice bool) string {
+	name := kind + handle
+	if slice {
+		// The slice helpers call the helpers for the individual handles.
+		g.helpers[name] = true
+		name += "s"
+	}
+	g.helpers[name] = true
+	return name
+}
+
+func (g *errorsGenerator) generateHelpers() string {
+	var names []string
+	for name := range g.helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var output strings.Builder
+	for _, name := range names {
+		var kind, handle string
+		switch {
+		case strings.HasPrefix(name, "unwrap"):
+			kind, handle = "unwrap", strings.TrimPrefix(name, "unwrap")
+		default:
+			kind, handle = "wrap", strings.TrimPrefix(name, "wrap")
+		}
+		slice := false
+		if _, isHandle := g.handles[handle]; !isHandle {
+			handle = strings.TrimSuffix(handle, "s")
+			slice = true
+		}
+		impl := lowerFirst(handle)
+
+		switch {
+		case kind == "wrap" && !slice:
+			output.WriteString(fmt.Sprintf("func %s(h nvml.%s) %s {\n", name, handle, handle))
+			output.WriteString("\tif h == nil {\n\t\treturn nil\n\t}\n")
+			output.WriteString(fmt.Sprintf("\treturn &%s{handle: h}\n}\n\n", impl))
+		case kind == "unwrap" && !slice:
+			output.WriteString(fmt.Sprintf("func %s(h %s) nvml.%s {\n", name, handle, handle))
+			output.WriteString("\tif h == nil {\n\t\treturn nil\n\t}\n")
+			output.WriteString("\treturn h.Unwrap()\n}\n\n")
+		case kind == "wrap" && slice:
+			output.WriteString(fmt.Sprintf("func %s(hs []nvml.%s) []%s {\n", name, handle, handle))
+			output.WriteString("\tif hs == nil {\n\t\treturn nil\n\t}\n")
+			output.WriteString(fmt.Sprintf("\tout := make([]%s, len(hs))\n", handle))
+			output.WriteString(fmt.Sprintf("\tfor i, h := range hs {\n\t\tout[i] = wrap%s(h)\n\t}\n", handle))
+			output.WriteString("\treturn out\n}\n\n")
+		case kind == "unwrap" && slice:
+			output.WriteString(fmt.Sprintf("func %s(hs []%s) []nvml.%s {\n", name, handle, handle))
+			output.WriteString("\tif hs == nil {\n\t\treturn nil\n\t}\n")
+			output.WriteString(fmt.Sprintf("\tout := make([]nvml.%s, len(hs))\n", handle))
+			output.WriteString(fmt.Sprintf("\tfor i, h := range hs {\n\t\tout[i] = unwrap%s(h)\n\t}\n", handle))
+			output.WriteString("\treturn out\n}\n\n")
+		}
+	}
+	return output.String()
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+)
+
+// supervisedMethods are the methods of the supervisor that are implemented
+// by hand since these track the initialization of the library.
+var supervisedMethods = []string{"Init", "InitWithFlags", "Shutdown"}
+
+// reinitGenerator generates the methods of the supervisor that reinitializes
+// the library (and its supervisedDevice handles) when a call fails because
+// the library needs to be reinitialized.
+type reinitGenerator struct {
+	sourceDir string
+}
+
+func writeReinit(sourceDir string, outputFile string, header string) error {
+	output, err := (&reinitGenerator{sourceDir: sourceDir}).generate()
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, output)
+	return nil
+}
+
+func (g *reinitGenerator) generate() (string, error) {
+	var output strings.Builder
+	for _, p := range GeneratableInterfaces {
+		var receiver, impl, wrapped string
+		switch p.Interface {
+		case "Interface":
+			receiver, impl, wrapped = "s", "supervisor", "s.lib"
+		case "Device":
+			receiver, impl, wrapped = "d", "supervisedDevice", "d.handle()"
+		default:
+			continue
+		}
+
+		methods, err := extractMethodsFromPackage(g.sourceDir, p)
+		if err != nil {
+			return "", err
+		}
+
+		output.WriteString(fmt.Sprintf("var _ %s = (*%s)(nil)\n\n", p.Interface, impl))
+		for _, method := range methods {
+			if p.Interface == "Interface" && slices.Contains(supervisedMethods, method.Name.Name) {
+				continue
+			}
+			body, err := g.generateMethod(receiver, impl, wrapped, method)
+			if err != nil {
+				return "", err
+			}
+			output.WriteString(body)
+			output.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+func (g *reinitGenerator) generateMethod(receiver string, impl string, wrapped string, method *ast.FuncDecl) (string, error) {
+	supervisor := "s"
+	if receiver != "s" {
+		supervisor = receiver + ".s"
+	}
+
+	var params []string
+	var args []string
+	for i, param := range method.Type.Params.List {
+		names := param.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, name := range names {
+			if name.Name == receiver || name.Name == "ret" || name.Name == "attempt" || name.Name == "generation" || strings.HasPrefix(name.Name, "r") && isNumeric(name.Name[1:]) {
+				return "", fmt.Errorf("%s: parameter name %q conflicts with generated names", method.Name.Name, name.Name)
+			}
+			typeName := formatFieldList(param)
+			if typeName == "" {
+				return "", fmt.Errorf("%s: unsupported type for parameter %q", method.Name.Name, name.Name)
+			}
+			params = append(params, fmt.Sprintf("%s %s", name.Name, typeName))
+
+			arg := name.Name
+			switch typeName {
+			case "Device":
+				arg = fmt.Sprintf("%s.unwrapDevice(%s)", supervisor, name.Name)
+			case "[]Device":
+				arg = fmt.Sprintf("%s.unwrapDevices(%s)", supervisor, name.Name)
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var types []string
+	if method.Type.Results != nil {
+		for _, result := range method.Type.Results.List {
+			if len(result.Names) > 1 {
+				return "", fmt.Errorf("%s: grouped results are not supported", method.Name.Name)
+			}
+			typeName := formatFieldList(result)
+			if typeName == "" {
+				return "", fmt.Errorf("%s: unsupported result type", method.Name.Name)
+			}
+			types = append(types, typeName)
+		}
+	}
+
+	call := fmt.Sprintf("%s.%s(%s)", wrapped, method.Name.Name, strings.Join(args, ", "))
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("func (%s *%s) %s(%s)", receiver, impl, method.Name.Name, strings.Join(params, ", ")))
+	switch len(types) {
+	case 0:
+		output.WriteString(fmt.Sprintf(" {\n\t%s\n}\n", call))
+		return output.String(), nil
+	case 1:
+		output.WriteString(fmt.Sprintf(" %s {\n", types[0]))
+	default:
+		output.WriteString(fmt.Sprintf(" (%s) {\n", strings.Join(types, ", ")))
+	}
+
+	// Methods that do not return a Return are forwarded as is.
+	if types[len(types)-1] != "Return" {
+		output.WriteString(fmt.Sprintf("\treturn %s\n}\n", call))
+		return output.String(), nil
+	}
+
+	var vars []string
+	var values []string
+	for i, t := range types[:len(types)-1] {
+		v := fmt.Sprintf("r%d", i)
+		vars = append(vars, v)
+		switch t {
+		case "Device":
+			v = fmt.Sprintf("%s.wrapDevice(%s, ret, generation)", supervisor, v)
+		case "[]Device":
+			v = fmt.Sprintf("%s.wrapDevices(%s, ret, generation)", supervisor, v)
+		}
+		values = append(values, v)
+	}
+	vars = append(vars, "ret")
+	values = append(values, "ret")
+
+	output.WriteString("\tfor attempt := 0; ; attempt++ {\n")
+	output.WriteString(fmt.Sprintf("\t\tgeneration := %s.generation.Load()\n", supervisor))
+	output.WriteString(fmt.Sprintf("\t\t%s := %s\n", strings.Join(vars, ", "), call))
+	output.WriteString(fmt.Sprintf("\t\tif !%s.retry(ret, generation, attempt) {\n", supervisor))
+	output.WriteString(fmt.Sprintf("\t\t\treturn %s\n", strings.Join(values, ", ")))
+	output.WriteString("\t\t}\n")
+	output.WriteString("\t}\n")
+	output.WriteString("}\n")
+	return output.String(), nil
+}
@@ -0,0 +1,155 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// returnValue represents an entry of the nvmlReturn_t enum in nvml.h.
+type returnValue struct {
+	Name        string
+	Description string
+}
+
+// returnClasses classifies the Return values defined in nvml.h by name. The
+// generation fails for values that are added to nvml.h until they are added
+// here.
+var returnClasses = map[string]string{
+	"SUCCESS":                         "ReturnClassSuccess",
+	"ERROR_UNINITIALIZED":             "ReturnClassPermanent",
+	"ERROR_INVALID_ARGUMENT":          "ReturnClassPermanent",
+	"ERROR_NOT_SUPPORTED":             "ReturnClassPermanent",
+	"ERROR_NO_PERMISSION":             "ReturnClassPermission",
+	"ERROR_ALREADY_INITIALIZED":       "ReturnClassPermanent",
+	"ERROR_NOT_FOUND":                 "ReturnClassPermanent",
+	"ERROR_INSUFFICIENT_SIZE":         "ReturnClassPermanent",
+	"ERROR_INSUFFICIENT_POWER":        "ReturnClassPermanent",
+	"ERROR_DRIVER_NOT_LOADED":         "ReturnClassPermanent",
+	"ERROR_TIMEOUT":                   "ReturnClassTransient",
+	"ERROR_IRQ_ISSUE":                 "ReturnClassNeedsReset",
+	"ERROR_LIBRARY_NOT_FOUND":         "ReturnClassPermanent",
+	"ERROR_FUNCTION_NOT_FOUND":        "ReturnClassPermanent",
+	"ERROR_CORRUPTED_INFOROM":         "ReturnClassPermanent",
+	"ERROR_GPU_IS_LOST":               "ReturnClassNeedsReset",
+	"ERROR_RESET_REQUIRED":            "ReturnClassNeedsReset",
+	"ERROR_OPERATING_SYSTEM":          "ReturnClassPermission",
+	"ERROR_LIB_RM_VERSION_MISMATCH":   "ReturnClassPermanent",
+	"ERROR_IN_USE":                    "ReturnClassTransient",
+	"ERROR_MEMORY":                    "ReturnClassTransient",
+	"ERROR_NO_DATA":                   "ReturnClassTransient",
+	"ERROR_VGPU_ECC_NOT_SUPPORTED":    "ReturnClassPermanent",
+	"ERROR_INSUFFICIENT_RESOURCES":    "ReturnClassTransient",
+	"ERROR_FREQ_NOT_SUPPORTED":        "ReturnClassPermanent",
+	"ERROR_ARGUMENT_VERSION_MISMATCH": "ReturnClassPermanent",
+	"ERROR_DEPRECATED":                "ReturnClassPermanent",
+	"ERROR_NOT_READY":                 "ReturnClassTransient",
+	"ERROR_GPU_NOT_FOUND":             "ReturnClassPermanent",
+	"ERROR_INVALID_STATE":             "ReturnClassTransient",
+	"ERROR_RESET_TYPE_NOT_SUPPORTED":  "ReturnClassPermanent",
+	"ERROR_UNKNOWN":                   "ReturnClassUnknown",
+}
+
+var returnValuePattern = regexp.MustCompile(`^NVML_(\w+)\s*=\s*\d+\s*,?\s*(?://!<\s*(.*))?$`)
+
+// writeReturns generates the catalog of Return values from the nvml.h header
+// in the source directory.
+func writeReturns(sourceDir string, outputFile string, header string) error {
+	values, err := extractReturnValues(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return err
+	}
+
+	output, err := generateReturns(values)
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, output)
+	return nil
+}
+
+func generateReturns(values []returnValue) (string, error) {
+	var output strings.Builder
+	output.WriteString("// returnInfos describes the Return values defined in nvml.h.\n")
+	output.WriteString("var returnInfos = map[Return]returnInfo{\n")
+	for _, value := range values {
+		class, ok := returnClasses[value.Name]
+		if !ok {
+			return "", fmt.Errorf("no ReturnClass for NVML_%s: add it to returnClasses", value.Name)
+		}
+		output.WriteString(fmt.Sprintf("\t%s: {\n", value.Name))
+		output.WriteString(fmt.Sprintf("\t\tname:        %s,\n", strconv.Quote(value.Name)))
+		output.WriteString(fmt.Sprintf("\t\tdescription: %s,\n", strconv.Quote(value.Description)))
+		output.WriteString(fmt.Sprintf("\t\tclass:       %s,\n", class))
+		output.WriteString("\t},\n")
+	}
+	output.WriteString("}\n")
+	return output.String(), nil
+}
+
+// extractReturnValues parses the nvmlReturn_t enum from the specified header.
+// The description of each value is taken from its trailing //!< comment.
+func extractReturnValues(headerFile string) ([]returnValue, error) {
+	file, err := os.Open(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var values []returnValue
+	var inEnum bool
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !inEnum {
+			inEnum = line == "typedef enum nvmlReturn_enum"
+			continue
+		}
+		if strings.HasPrefix(line, "}") {
+			break
+		}
+		match := returnValuePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		values = append(values, returnValue{
+			Name:        match[1],
+			Description: strings.TrimSpace(match[2]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no return values found in %s", headerFile)
+	}
+	return values, nil
+}
@@ -0,0 +1,191 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// structVersionPattern matches the definition of a struct version in nvml.h,
+// e.g. "#define nvmlMemory_v2 NVML_STRUCT_VERSION(Memory, 2)". The definition
+// may be continued on the next line.
+var structVersionPattern = regexp.MustCompile(`#define\s+nvml(\w+)_v(\d+)\s+(?:\\\s*)?NVML_STRUCT_VERSION\(\s*(\w+)\s*,\s*(\d+)\s*\)`)
+
+// structAliasPattern matches a typedef of a version of a struct in nvml.h,
+// e.g. "typedef nvmlMemory_v2_t nvmlMemory_t;".
+var structAliasPattern = regexp.MustCompile(`typedef\s+nvml(\w+_v\d+)_t\s+nvml(\w+)_t\s*;`)
+
+// structVersion is the version of a struct in types_gen.go, as defined by a
+// macro in nvml.h.
+type structVersion struct {
+	// Type is the name of the struct in types_gen.go.
+	Type string
+	// Macro is the name of the macro that defines the version in nvml.h.
+	Macro string
+	// Version is the version number of the struct.
+	Version string
+	// HasVersion indicates whether the struct has a Version field. Some
+	// structs (e.g. C2cModeInfo_v1) have a version that is not stored in the
+	// struct.
+	HasVersion bool
+}
+
+// writeStructVersions generates a constant holding the version of each
+// versioned struct, along with a constructor that sets the Version field of
+// the struct.
+func writeStructVersions(sourceDir string, outputFile string, header string) error {
+	versions, err := extractStructVersions(filepath.Join(sourceDir, "nvml.h"), filepath.Join(sourceDir, "types_gen.go"))
+	if err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateStructVersions(versions))
+	return nil
+}
+
+// extractStructVersions returns the versions defined in the specified nvml.h
+// header, in the order in which they are defined. Each version is returned for
+// the versioned struct (e.g. Memory_v2) and for each struct defined as an
+// alias of it (e.g. Memory, if it were a typedef of nvmlMemory_v2_t).
+func extractStructVersions(headerFile string, typesFile string) ([]structVersion, error) {
+	contents, err := os.ReadFile(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	structs, err := extractVersionedStructs(typesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[string][]string)
+	for _, match := range structAliasPattern.FindAllStringSubmatch(string(contents), -1) {
+		aliases[match[1]] = append(aliases[match[1]], match[2])
+	}
+
+	var versions []structVersion
+	for _, match := range structVersionPattern.FindAllStringSubmatch(string(contents), -1) {
+		name, version := match[1], match[2]
+		macro := fmt.Sprintf("nvml%s_v%s", name, version)
+		if match[3] != name || match[4] != version {
+			return nil, fmt.Errorf("%s is defined as the version of %s_v%s", macro, match[3], match[4])
+		}
+		versioned := fmt.Sprintf("%s_v%s", name, version)
+		hasVersion, ok := structs[versioned]
+		if !ok {
+			return nil, fmt.Errorf("%s: no struct %s found in %s", macro, versioned, typesFile)
+		}
+		versions = append(versions, structVersion{Type: versioned, Macro: macro, Version: version, HasVersion: hasVersion})
+		for _, alias := range aliases[versioned] {
+			if hasVersion, ok := structs[alias]; ok {
+				versions = append(versions, structVersion{Type: alias, Macro: macro, Version: version, HasVersion: hasVersion})
+			}
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no struct versions found in %s", headerFile)
+	}
+	return versions, nil
+}
+
+// extractVersionedStructs returns the structs in the specified types_gen.go
+// file, along with whether each struct has a Version field.
+func extractVersionedStructs(typesFile string) (map[string]bool, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), typesFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := make(map[string]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		structs[typeSpec.Name.Name] = false
+		for _, f := range structType.Fields.List {
+			for _, name := range f.Names {
+				if name.Name == "Version" {
+					structs[typeSpec.Name.Name] = true
+				}
+			}
+		}
+		return false
+	})
+	return structs, nil
+}
+
+func generateStructVersions(versions []structVersion) string {
+	var output strings.Builder
+	output.WriteString("import \"unsafe\"\n")
+	output.WriteString("\n")
+
+	output.WriteString("// The versions of the versioned structs, as defined by the corresponding\n")
+	output.WriteString("// macros in nvml.h. These are computed from the size of the structs at\n")
+	output.WriteString("// compile time.\n")
+	output.WriteString("const (\n")
+	for i, v := range versions {
+		if i > 0 {
+			output.WriteString("\n")
+		}
+		output.WriteString(fmt.Sprintf("\t// %sVersion is the version of %s (%s).\n", v.Type, v.Type, v.Macro))
+		output.WriteString(fmt.Sprintf("\t%sVersion uint32 = uint32(unsafe.Sizeof(%s{})) | %s<<24\n", v.Type, v.Type, v.Version))
+	}
+	output.WriteString(")\n")
+
+	for _, v := range versions {
+		if !v.HasVersion {
+			continue
+		}
+		output.WriteString("\n")
+		output.WriteString(fmt.Sprintf("// New%s returns a %s with its Version set to %sVersion.\n", v.Type, v.Type, v.Type))
+		output.WriteString(fmt.Sprintf("func New%s() %s {\n", v.Type, v.Type))
+		output.WriteString(fmt.Sprintf("\treturn %s{Version: %sVersion}\n", v.Type, v.Type))
+		output.WriteString("}\n")
+	}
+
+	output.WriteString("\n")
+	output.WriteString("// structVersions lists the version constants along with the macros that\n")
+	output.WriteString("// define these in nvml.h.\n")
+	output.WriteString("var structVersions = []struct {\n")
+	output.WriteString("\tname    string\n")
+	output.WriteString("\tmacro   string\n")
+	output.WriteString("\tversion uint32\n")
+	output.WriteString("}{\n")
+	for _, v := range versions {
+		output.WriteString(fmt.Sprintf("\t{%q, %q, %sVersion},\n", v.Type+"Version", v.Macro, v.Type))
+	}
+	output.WriteString("}\n")
+	return output.String()
+}
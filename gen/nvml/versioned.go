@@ -0,0 +1,280 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionedSymbolExceptions lists the versioned NVML symbols that are not
+// bound in place of the v1 variant, along with the reason for this.
+var versionedSymbolExceptions = map[string]string{
+	"nvmlDeviceRemoveGpu_v2": "the v2 function takes a different set of parameters than the v1 function",
+}
+
+var versionedDefinePattern = regexp.MustCompile(`^#define\s+(nvml\w+)\s+(nvml\w+)_v(\d+)\s*$`)
+
+// versionedFunction represents an NVML function that is upgraded to its latest
+// version in the NVML_NO_UNVERSIONED_FUNC_DEFS block of nvml.h.
+type versionedFunction struct {
+	// Name is the unversioned name of the NVML function.
+	Name string
+	// Latest is the latest version of the function.
+	Latest int
+	// Binding is the prefix of the Go functions that are bound for each
+	// version. This is either the cgo binding (e.g. nvmlInit) or a manual
+	// wrapper (e.g. deviceGetComputeRunningProcesses) if one is defined.
+	Binding string
+	// Type is the signature of the Go functions that are bound.
+	Type string
+}
+
+func writeVersionedSymbols(sourceDir string, outputFile string, header string) error {
+	functions, err := extractVersionedFunctions(filepath.Join(sourceDir, "nvml.h"))
+	if err != nil {
+		return err
+	}
+	if err := resolveVersionedBindings(sourceDir, functions); err != nil {
+		return err
+	}
+
+	writer, closer, err := getWriter(outputFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	fmt.Fprint(writer, header)
+	fmt.Fprint(writer, generateVersionedSymbols(functions))
+	return nil
+}
+
+// extractVersionedFunctions returns the functions that are defined to a
+// versioned variant in the NVML_NO_UNVERSIONED_FUNC_DEFS block of the
+// specified header, in the order in which they are defined.
+func extractVersionedFunctions(headerFile string) ([]*versionedFunction, error) {
+	file, err := os.Open(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var functions []*versionedFunction
+	var inBlock bool
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !inBlock {
+			inBlock = line == "#ifndef NVML_NO_UNVERSIONED_FUNC_DEFS"
+			continue
+		}
+		if strings.HasPrefix(line, "#endif") {
+			break
+		}
+		match := versionedDefinePattern.FindStringSubmatch(line)
+		// Aliases such as nvmlGetBlacklistDeviceCount are not versions of
+		// the same function.
+		if match == nil || match[1] != match[2] {
+			continue
+		}
+		latest, err := strconv.Atoi(match[3])
+		if err != nil {
+			return nil, err
+		}
+		functions = append(functions, &versionedFunction{Name: match[1], Latest: latest})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(functions) == 0 {
+		return nil, fmt.Errorf("no versioned functions found in %s", headerFile)
+	}
+	return functions, nil
+}
+
+// resolveVersionedBindings determines the Go functions that are bound for
+// each version of the specified functions. All versions that are not listed
+// in versionedSymbolExceptions must have the same signature as the v1
+// variant.
+func resolveVersionedBindings(sourceDir string, functions []*versionedFunction) error {
+	funcs, err := extractFunctionsFromPackage(sourceDir)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range functions {
+		f.Binding = lowerFirst(strings.TrimPrefix(f.Name, "nvml"))
+		if _, ok := funcs[f.Binding+"_v1"]; !ok {
+			f.Binding = f.Name
+		}
+		v1, ok := funcs[f.Binding+"_v1"]
+		if !ok {
+			return fmt.Errorf("%s: no binding found for %s_v1", f.Name, f.Name)
+		}
+		f.Type, err = formatFuncType(v1)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+
+		for version := 2; version <= f.Latest; version++ {
+			symbol := fmt.Sprintf("%s_v%d", f.Name, version)
+			if _, ok := versionedSymbolExceptions[symbol]; ok {
+				continue
+			}
+			binding := fmt.Sprintf("%s_v%d", f.Binding, version)
+			decl, ok := funcs[binding]
+			if !ok {
+				return fmt.Errorf("%s: no binding found for %s", f.Name, binding)
+			}
+			funcType, err := formatFuncType(decl)
+			if err != nil {
+				return fmt.Errorf("%s: %w", binding, err)
+			}
+			if funcType != f.Type {
+				return fmt.Errorf("%s: %s does not match %s; add %s to versionedSymbolExceptions if it cannot be bound", binding, funcType, f.Type, symbol)
+			}
+		}
+	}
+	return nil
+}
+
+// extractFunctionsFromPackage returns the top-level functions defined in the
+// package. Generated files are skipped since these may define the same
+// functions for different build constraints. The cgo bindings are returned
+// under the name of their gated function.
+func extractFunctionsFromPackage(sourceDir string) (map[string]*ast.FuncDecl, error) {
+	gofiles, err := getGoFiles(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+	for file, content := range gofiles {
+		if filepath.Dir(file) != filepath.Clean(sourceDir) {
+			continue
+		}
+		base := filepath.Base(file)
+		if strings.HasPrefix(base, "zz_generated.") || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range node.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				funcs[gatedName(funcDecl.Name.Name)] = funcDecl
+			}
+		}
+	}
+	return funcs, nil
+}
+
+// formatFuncType formats the type of a function without parameter names.
+func formatFuncType(decl *ast.FuncDecl) (string, error) {
+	var params []string
+	for _, param := range decl.Type.Params.List {
+		typeName := formatFieldList(param)
+		if typeName == "" {
+			return "", fmt.Errorf("unsupported parameter type")
+		}
+		params = append(params, typeName)
+		for i := 1; i < len(param.Names); i++ {
+			params = append(params, typeName)
+		}
+	}
+
+	var results []string
+	if decl.Type.Results != nil {
+		for _, result := range decl.Type.Results.List {
+			typeName := formatFieldList(result)
+			if typeName == "" {
+				return "", fmt.Errorf("unsupported result type")
+			}
+			results = append(results, typeName)
+			for i := 1; i < len(result.Names); i++ {
+				results = append(results, typeName)
+			}
+		}
+	}
+
+	funcType := fmt.Sprintf("func(%s)", strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+	case 1:
+		funcType += " " + results[0]
+	default:
+		funcType += fmt.Sprintf(" (%s)", strings.Join(results, ", "))
+	}
+	return funcType, nil
+}
+
+func generateVersionedSymbols(functions []*versionedFunction) string {
+	var output strings.Builder
+
+	output.WriteString("// versionedSymbols holds the versioned APIs that are bound for a library.\n")
+	output.WriteString("// Each library instance maintains its own set so that loading one instance\n")
+	output.WriteString("// does not rebind the symbols used by another.\n")
+	output.WriteString("type versionedSymbols struct {\n")
+	for _, f := range functions {
+		output.WriteString(fmt.Sprintf("\t%s %s\n", f.Binding, f.Type))
+	}
+	output.WriteString("\n")
+	output.WriteString("\t// bound maps the unversioned name of an NVML symbol to the versioned\n")
+	output.WriteString("\t// variant that was bound if this is not the v1 variant.\n")
+	output.WriteString("\tbound map[string]string\n")
+	output.WriteString("}\n\n")
+
+	output.WriteString("// Default all versioned APIs to v1 (to infer the types)\n")
+	output.WriteString("var defaultVersionedSymbols = versionedSymbols{\n")
+	for _, f := range functions {
+		output.WriteString(fmt.Sprintf("\t%s: %s_v1,\n", f.Binding, f.Binding))
+	}
+	output.WriteString("}\n\n")
+
+	output.WriteString("// updateVersionedSymbols checks for versioned symbols in the loaded dynamic library.\n")
+	output.WriteString("// If newer versioned symbols exist, these replace the default `v1` symbols initialized above.\n")
+	output.WriteString("func (l *library) updateVersionedSymbols() {\n")
+	output.WriteString("\tl.symbols = defaultVersionedSymbols\n")
+	output.WriteString("\tl.symbols.bound = make(map[string]string)\n")
+	for _, f := range functions {
+		for version := 2; version <= f.Latest; version++ {
+			symbol := fmt.Sprintf("%s_v%d", f.Name, version)
+			output.WriteString("\n")
+			if reason, ok := versionedSymbolExceptions[symbol]; ok {
+				output.WriteString(fmt.Sprintf("\t// %s is not bound since %s.\n", symbol, reason))
+				continue
+			}
+			output.WriteString(fmt.Sprintf("\tif err := l.dl.Lookup(%q); err == nil {\n", symbol))
+			output.WriteString(fmt.Sprintf("\t\tl.symbols.%s = %s_v%d\n", f.Binding, f.Binding, version))
+			output.WriteString(fmt.Sprintf("\t\tl.symbols.bound[%q] = %q\n", f.Name, symbol))
+			output.WriteString("\t}\n")
+		}
+	}
+	output.WriteString("}\n")
+
+	return output.String()
+}
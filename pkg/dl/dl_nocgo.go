@@ -0,0 +1,63 @@
+//go:build !cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package dl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The flags below match the values defined by glibc. Without cgo these are
+// only provided so that code that references them still compiles.
+const (
+	RTLD_LAZY     = 0x00001
+	RTLD_NOW      = 0x00002
+	RTLD_GLOBAL   = 0x00100
+	RTLD_LOCAL    = 0x00000
+	RTLD_NODELETE = 0x01000
+	RTLD_NOLOAD   = 0x00004
+)
+
+// ErrNotSupported is returned when attempting to open a library in a binary
+// that was built without cgo.
+var ErrNotSupported = errors.New("dynamic libraries are not supported without cgo")
+
+type DynamicLibrary struct {
+	Name  string
+	Flags int
+}
+
+func New(name string, flags int) *DynamicLibrary {
+	return &DynamicLibrary{
+		Name:  name,
+		Flags: flags,
+	}
+}
+
+func (dl *DynamicLibrary) Open() error {
+	return fmt.Errorf("error opening %s: %w", dl.Name, ErrNotSupported)
+}
+
+func (dl *DynamicLibrary) Close() error {
+	return nil
+}
+
+func (dl *DynamicLibrary) Lookup(symbol string) error {
+	return fmt.Errorf("symbol %q not found: %w", symbol, ErrNotSupported)
+}
@@ -0,0 +1,36 @@
+//go:build !cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package dl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOpenNotSupported(t *testing.T) {
+	dl := New("libdl.so.2", RTLD_LAZY|RTLD_GLOBAL)
+
+	err := dl.Open()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected %v opening shared lib, got: %v", ErrNotSupported, err)
+	}
+	if err := dl.Close(); err != nil {
+		t.Errorf("Error closing shared lib: %v", err)
+	}
+}
@@ -1,3 +1,5 @@
+//go:build cgo
+
 // Copyright (c) 2020, NVIDIA CORPORATION.  All rights reserved.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,204 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package ldcache provides a reader for the ld.so.cache file maintained by
+// ldconfig. It supports both the new glibc format and the legacy combined
+// format in which the new format is preceded by a libc5 compatible section.
+package ldcache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+)
+
+// DefaultPath is the location of the ld.so.cache file relative to a root.
+const DefaultPath = "/etc/ld.so.cache"
+
+const (
+	magicOld     = "ld.so-1.7.0"
+	magicNew     = "glibc-ld.so.cache"
+	magicVersion = "1.1"
+)
+
+const (
+	flagTypeMask  = 0x00ff
+	flagTypeLibc6 = 0x0003
+
+	flagArchMask         = 0xff00
+	flagArchI386         = 0x0000
+	flagArchX8664        = 0x0300
+	flagArchPpc64le      = 0x0500
+	flagArchArmLibhf     = 0x0900
+	flagArchAArch64Lib64 = 0x0a00
+)
+
+const (
+	// sizeofOldHeader is the size of the libc5 header (magic + nlibs).
+	sizeofOldHeader = 16
+	// sizeofOldEntry is the size of a libc5 entry (flags, key, value).
+	sizeofOldEntry = 12
+	// sizeofNewHeader is the size of the glibc header.
+	sizeofNewHeader = 48
+	// sizeofNewEntry is the size of a glibc entry.
+	sizeofNewEntry = 24
+	// alignNew is the alignment of the glibc header in the combined format.
+	alignNew = 8
+)
+
+var errInvalidFormat = errors.New("invalid ld.so.cache format")
+
+// Entry represents a single library recorded in an ld.so.cache file.
+type Entry struct {
+	// Name is the soname of the library, e.g. libnvidia-ml.so.1.
+	Name string
+	// Path is the absolute path of the library as recorded by ldconfig.
+	Path string
+	// Flags holds the ldconfig type and architecture flags for the entry.
+	Flags int32
+}
+
+// Cache represents the contents of an ld.so.cache file.
+type Cache struct {
+	entries []Entry
+}
+
+// Open reads and parses the ld.so.cache file at the specified path.
+func Open(path string) (*Cache, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Parse parses the raw contents of an ld.so.cache file.
+func Parse(data []byte) (*Cache, error) {
+	le := binary.LittleEndian
+
+	offset := 0
+	if bytes.HasPrefix(data, []byte(magicOld)) {
+		if len(data) < sizeofOldHeader {
+			return nil, errInvalidFormat
+		}
+		// The number of entries is compared before it is multiplied so that
+		// the offset cannot overflow an int on 32-bit platforms.
+		nlibs := uint64(le.Uint32(data[12:]))
+		if nlibs > uint64(len(data)-sizeofOldHeader)/sizeofOldEntry {
+			return nil, errInvalidFormat
+		}
+		offset = sizeofOldHeader + int(nlibs)*sizeofOldEntry
+		offset = (offset + alignNew - 1) &^ (alignNew - 1)
+	}
+	if offset+sizeofNewHeader > len(data) {
+		return nil, errInvalidFormat
+	}
+
+	header := data[offset:]
+	if !bytes.HasPrefix(header, []byte(magicNew+magicVersion)) {
+		return nil, errInvalidFormat
+	}
+	nlibs := uint64(le.Uint32(header[20:]))
+	if nlibs > uint64(len(header)-sizeofNewHeader)/sizeofNewEntry {
+		return nil, errInvalidFormat
+	}
+
+	// String offsets in the glibc format are relative to the glibc header.
+	entries := make([]Entry, 0, nlibs)
+	for i := 0; i < int(nlibs); i++ {
+		raw := header[sizeofNewHeader+i*sizeofNewEntry:]
+		key, err := cstring(header, le.Uint32(raw[4:]))
+		if err != nil {
+			return nil, err
+		}
+		value, err := cstring(header, le.Uint32(raw[8:]))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, Entry{
+			Name:  key,
+			Path:  value,
+			Flags: int32(le.Uint32(raw[0:])),
+		})
+	}
+
+	return &Cache{entries: entries}, nil
+}
+
+// Entries returns all entries in the cache in the order they were recorded.
+func (c *Cache) Entries() []Entry {
+	return c.entries
+}
+
+// Lookup returns the paths of all libraries with the specified soname that
+// are compatible with the architecture of the running process. The paths are
+// returned in the order in which they appear in the cache, which matches the
+// order in which the dynamic linker would consider them.
+func (c *Cache) Lookup(name string) []string {
+	var paths []string
+	for _, e := range c.entries {
+		if e.Name != name {
+			continue
+		}
+		if !isCompatible(e.Flags) {
+			continue
+		}
+		paths = append(paths, e.Path)
+	}
+	return paths
+}
+
+// isCompatible checks whether the flags of an entry match the architecture of
+// the running process. Unknown architectures match any entry.
+func isCompatible(flags int32) bool {
+	if flags&flagTypeMask != flagTypeLibc6 {
+		return false
+	}
+	arch, ok := archFlags[runtime.GOARCH]
+	if !ok {
+		return true
+	}
+	return int(flags)&flagArchMask == arch
+}
+
+// archFlags maps a GOARCH value to the ldconfig architecture flag.
+var archFlags = map[string]int{
+	"386":     flagArchI386,
+	"amd64":   flagArchX8664,
+	"ppc64le": flagArchPpc64le,
+	"arm":     flagArchArmLibhf,
+	"arm64":   flagArchAArch64Lib64,
+}
+
+// cstring returns the NUL-terminated string at the specified offset.
+func cstring(data []byte, offset uint32) (string, error) {
+	if int(offset) >= len(data) {
+		return "", errInvalidFormat
+	}
+	s := data[offset:]
+	end := bytes.IndexByte(s, 0)
+	if end < 0 {
+		return "", errInvalidFormat
+	}
+	return string(s[:end]), nil
+}
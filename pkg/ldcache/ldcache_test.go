@@ -0,0 +1,181 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package ldcache
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// buildCache creates the contents of an ld.so.cache file for the specified
+// entries. If withOld is set, the legacy libc5 section is prepended.
+func buildCache(entries []Entry, withOld bool) []byte {
+	le := binary.LittleEndian
+
+	var strs []byte
+	stringsStart := sizeofNewHeader + len(entries)*sizeofNewEntry
+	offsets := make([][2]uint32, len(entries))
+	for i, e := range entries {
+		offsets[i][0] = uint32(stringsStart + len(strs))
+		strs = append(strs, append([]byte(e.Name), 0)...)
+		offsets[i][1] = uint32(stringsStart + len(strs))
+		strs = append(strs, append([]byte(e.Path), 0)...)
+	}
+
+	header := make([]byte, sizeofNewHeader)
+	copy(header, magicNew+magicVersion)
+	le.PutUint32(header[20:], uint32(len(entries)))
+	le.PutUint32(header[24:], uint32(len(strs)))
+
+	data := header
+	for i, e := range entries {
+		raw := make([]byte, sizeofNewEntry)
+		le.PutUint32(raw[0:], uint32(e.Flags))
+		le.PutUint32(raw[4:], offsets[i][0])
+		le.PutUint32(raw[8:], offsets[i][1])
+		data = append(data, raw...)
+	}
+	data = append(data, strs...)
+
+	if !withOld {
+		return data
+	}
+
+	old := make([]byte, sizeofOldHeader+len(entries)*sizeofOldEntry)
+	copy(old, magicOld)
+	le.PutUint32(old[12:], uint32(len(entries)))
+	for len(old)%alignNew != 0 {
+		old = append(old, 0)
+	}
+	return append(old, data...)
+}
+
+// withEntryCount overwrites the entry count at the specified offset of the
+// contents of an ld.so.cache file.
+func withEntryCount(data []byte, offset int, nlibs uint32) []byte {
+	binary.LittleEndian.PutUint32(data[offset:], nlibs)
+	return data
+}
+
+func nativeFlags() int32 {
+	return int32(flagTypeLibc6 | archFlags[runtime.GOARCH])
+}
+
+func TestParse(t *testing.T) {
+	entries := []Entry{
+		{Name: "libnvidia-ml.so.1", Path: "/usr/lib64/libnvidia-ml.so.1", Flags: nativeFlags()},
+		{Name: "libc.so.6", Path: "/usr/lib64/libc.so.6", Flags: nativeFlags()},
+		{Name: "libnvidia-ml.so.1", Path: "/usr/lib/libnvidia-ml.so.1", Flags: nativeFlags()},
+	}
+
+	testCases := []struct {
+		description string
+		withOld     bool
+	}{
+		{
+			description: "new format",
+		},
+		{
+			description: "combined format",
+			withOld:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			c, err := Parse(buildCache(entries, tc.withOld))
+			require.NoError(t, err)
+			require.Equal(t, entries, c.Entries())
+			require.Equal(t, []string{"/usr/lib64/libnvidia-ml.so.1", "/usr/lib/libnvidia-ml.so.1"}, c.Lookup("libnvidia-ml.so.1"))
+			require.Empty(t, c.Lookup("libcuda.so.1"))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	testCases := []struct {
+		description string
+		data        []byte
+	}{
+		{
+			description: "empty",
+			data:        []byte{},
+		},
+		{
+			description: "bad magic",
+			data:        make([]byte, sizeofNewHeader),
+		},
+		{
+			description: "truncated entries",
+			data:        buildCache([]Entry{{Name: "a", Path: "/a", Flags: nativeFlags()}}, false)[:sizeofNewHeader+1],
+		},
+		{
+			description: "entry count overflows the new format",
+			data:        withEntryCount(buildCache(nil, false), 20, 0xffffffff),
+		},
+		{
+			description: "entry count overflows the old format",
+			data:        withEntryCount(buildCache(nil, true), 12, 0xffffffff),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := Parse(tc.data)
+			require.ErrorIs(t, err, errInvalidFormat)
+		})
+	}
+}
+
+func TestLookupFiltersIncompatible(t *testing.T) {
+	if _, ok := archFlags[runtime.GOARCH]; !ok {
+		t.Skipf("architecture %v matches all entries", runtime.GOARCH)
+	}
+
+	foreign := int32(flagTypeLibc6 | flagArchPpc64le)
+	if runtime.GOARCH == "ppc64le" {
+		foreign = int32(flagTypeLibc6 | flagArchX8664)
+	}
+	entries := []Entry{
+		{Name: "libnvidia-ml.so.1", Path: "/foreign/libnvidia-ml.so.1", Flags: foreign},
+		{Name: "libnvidia-ml.so.1", Path: "/native/libnvidia-ml.so.1", Flags: nativeFlags()},
+	}
+
+	c, err := Parse(buildCache(entries, false))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/native/libnvidia-ml.so.1"}, c.Lookup("libnvidia-ml.so.1"))
+}
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ld.so.cache")
+	entries := []Entry{
+		{Name: "libnvidia-ml.so.1", Path: "/usr/lib64/libnvidia-ml.so.1", Flags: nativeFlags()},
+	}
+	require.NoError(t, os.WriteFile(path, buildCache(entries, false), 0600))
+
+	c, err := Open(path)
+	require.NoError(t, err)
+	require.Equal(t, entries, c.Entries())
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -18,19 +18,30 @@ package nvml
 
 // ExtendedInterface defines a set of extensions to the core NVML API.
 //
-// TODO: For now the list of methods in this interface need to be kept in sync
-// with the list of excluded methods for the Interface type in
-// gen/nvml/generateapi.go. In the future we should automate this.
+// The methods in this interface are excluded from the generated Interface type
+// by gen/nvml since ExtendedInterface is returned by library.Extensions().
 //
 //go:generate moq -out mock/extendedinterface.go -pkg mock . ExtendedInterface:ExtendedInterface
 type ExtendedInterface interface {
 	LookupSymbol(string) error
+	LibraryPath() (string, error)
+	Symbols() ([]SymbolInfo, error)
 }
 
 // libraryOptions hold the paramaters than can be set by a LibraryOption
 type libraryOptions struct {
-	path  string
-	flags int
+	path        string
+	flags       int
+	driverRoots []string
+	searchPaths []string
+	initFlags   uint32
+
+	implementation Interface
+
+	reinit         bool
+	reinitCallback func(ReinitEvent)
+
+	interceptors []Interceptor
 }
 
 // LibraryOption represents a functional option to configure the underlying NVML library
@@ -43,6 +54,83 @@ func WithLibraryPath(path string) LibraryOption {
 	}
 }
 
+// WithDriverRoot provides an option to add a root under which the NVML library
+// is searched for, e.g. /run/nvidia/driver for a driver container. Roots are
+// searched in the order in which they are added. If no roots are specified,
+// only the host root (/) is searched.
+func WithDriverRoot(root string) LibraryOption {
+	return func(o *libraryOptions) {
+		o.driverRoots = append(o.driverRoots, root)
+	}
+}
+
+// WithLibrarySearchPaths provides an option to add directories that are
+// searched for the NVML library under each driver root. These are checked in
+// order before the ld.so.cache under the root is consulted.
+func WithLibrarySearchPaths(paths ...string) LibraryOption {
+	return func(o *libraryOptions) {
+		o.searchPaths = append(o.searchPaths, paths...)
+	}
+}
+
+// WithLoadFlags provides an option to set the flags used to open the NVML
+// library with dlopen, e.g. dl.RTLD_NOW|dl.RTLD_GLOBAL to fail fast if the
+// library has unresolved symbols. If no flags are specified,
+// dl.RTLD_LAZY|dl.RTLD_GLOBAL is used. Note that the bindings resolve NVML
+// symbols from the global scope, so if dl.RTLD_LOCAL is used the library must
+// be made available globally by other means.
+func WithLoadFlags(flags int) LibraryOption {
+	return func(o *libraryOptions) {
+		o.flags = flags
+	}
+}
+
+// WithInitFlags provides an option to set the flags passed to nvmlInitWithFlags
+// when Init is called, e.g. INIT_FLAG_NO_GPUS to initialize the library on a
+// system without GPUs. Flags from multiple options are combined. If no flags
+// are specified, Init calls nvmlInit.
+func WithInitFlags(flags uint32) LibraryOption {
+	return func(o *libraryOptions) {
+		o.initFlags |= flags
+	}
+}
+
+// WithImplementation provides an option to use the specified implementation
+// instead of loading the NVML library. This allows the same code to use an
+// alternative implementation, such as the mocks in pkg/nvml/mock, when built
+// without cgo. This option only applies to New and is ignored by
+// SetLibraryOptions.
+func WithImplementation(implementation Interface) LibraryOption {
+	return func(o *libraryOptions) {
+		o.implementation = implementation
+	}
+}
+
+// WithReinit provides an option to reinitialize the library automatically if
+// a call returns ERROR_LIB_RM_VERSION_MISMATCH or ERROR_UNINITIALIZED while it
+// is initialized, for example after the driver has been upgraded. The library
+// is reopened and reinitialized, after which the call is retried once. Devices
+// returned by the library are resolved again by UUID on their next use. The
+// callback, if not nil, is called after each reinitialization. This option
+// only applies to New and is ignored by SetLibraryOptions.
+func WithReinit(callback func(ReinitEvent)) LibraryOption {
+	return func(o *libraryOptions) {
+		o.reinit = true
+		o.reinitCallback = callback
+	}
+}
+
+// WithInterceptor provides an option to call the specified interceptor for
+// each call to the returned Interface and to the handles that it returns. If
+// the option is repeated, the interceptors are called in the order in which
+// they are specified. See Intercept for details. This option only applies to
+// New and is ignored by SetLibraryOptions.
+func WithInterceptor(interceptor Interceptor) LibraryOption {
+	return func(o *libraryOptions) {
+		o.interceptors = append(o.interceptors, interceptor)
+	}
+}
+
 // SetLibraryOptions applies the specified options to the NVML library.
 // If this is called when a library is already loaded, an error is raised.
 func SetLibraryOptions(opts ...LibraryOption) error {
@@ -27,34 +27,6 @@ type stringHeader struct {
 	Len  int
 }
 
-func clen(n []byte) int {
-	for i := 0; i < len(n); i++ {
-		if n[i] == 0 {
-			return i
-		}
-	}
-	return len(n)
-}
-
-func uint32SliceToIntSlice(s []uint32) []int {
-	ret := make([]int, len(s))
-	for i := range s {
-		ret[i] = int(s[i])
-	}
-	return ret
-}
-
-func convertSlice[T any, I any](input []T) []I {
-	output := make([]I, len(input))
-	for i, obj := range input {
-		switch v := any(obj).(type) {
-		case I:
-			output[i] = v
-		}
-	}
-	return output
-}
-
 // packPCharString creates a Go string backed by *C.char and avoids copying.
 func packPCharString(p *C.char) (raw string) {
 	if p != nil && *p != 0 {
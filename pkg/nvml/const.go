@@ -17,16 +17,6 @@
 
 package nvml
 
-/*
-#cgo linux LDFLAGS: -Wl,--export-dynamic -Wl,--unresolved-symbols=ignore-in-object-files
-#cgo darwin LDFLAGS: -Wl,-undefined,dynamic_lookup
-#cgo CFLAGS: -DNVML_NO_UNVERSIONED_FUNC_DEFS=1
-#include "nvml.h"
-#include <stdlib.h>
-#include "cgo_helpers.h"
-*/
-import "C"
-
 const (
 	// NO_UNVERSIONED_FUNC_DEFS as defined in go-nvml/<predefine>:24
 	NO_UNVERSIONED_FUNC_DEFS = 1
@@ -22,6 +22,12 @@ const (
 	SYSTEM_PROCESS_NAME_BUFFER_SIZE = 256
 )
 
+// STRUCT_VERSION returns the version of the specified struct, as computed by
+// the NVML_STRUCT_VERSION macro in nvml.h. The size of the struct is looked up
+// using reflection on each call, and the struct is not checked against the
+// version. For the structs that are versioned in nvml.h, the generated version
+// constants (e.g. Memory_v2Version) and constructors (e.g. NewMemory_v2)
+// should be used instead.
 func STRUCT_VERSION(data interface{}, version uint32) uint32 {
 	return uint32(uint32(reflect.Indirect(reflect.ValueOf(data)).Type().Size()) | (version << uint32(24)))
 }
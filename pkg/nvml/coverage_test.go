@@ -0,0 +1,61 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCoverage checks that the coverage table lists each function declared in
+// nvml.h, and that the methods listed for the wrapped functions are part of
+// Interface.
+func TestCoverage(t *testing.T) {
+	header, err := os.ReadFile("nvml.h")
+	require.NoError(t, err)
+	table, err := os.ReadFile("zz_generated.coverage.md")
+	require.NoError(t, err)
+
+	listed := make(map[string]bool)
+	iface := reflect.TypeOf((*Interface)(nil)).Elem()
+	row := regexp.MustCompile("(?m)^\\| `(nvml\\w+)` \\| (?:`(\\w+)`)? \\| (\\w+)")
+	for _, match := range row.FindAllStringSubmatch(string(table), -1) {
+		function, method, status := match[1], match[2], match[3]
+		listed[function] = true
+		if status == "unwrapped" {
+			require.Empty(t, method, function)
+			continue
+		}
+		_, ok := iface.MethodByName(method)
+		require.True(t, ok, "%s is listed as wrapped by %s, which is not a method of Interface", function, method)
+	}
+	require.NotEmpty(t, listed)
+
+	declaration := regexp.MustCompile(`DECLDIR\s+(nvml\w+)\s*\(`)
+	for _, match := range declaration.FindAllStringSubmatch(string(header), -1) {
+		function := match[1]
+		if i := strings.LastIndex(function, "_v"); i > 0 && listed[function[:i]] {
+			continue
+		}
+		require.True(t, listed[function], "%s is not listed in the coverage table", function)
+	}
+}
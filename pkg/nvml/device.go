@@ -20,6 +20,12 @@ import (
 	"unsafe"
 )
 
+// deviceWrapper is implemented by the Device types that wrap another Device
+// without embedding it, such as the devices returned by a supervisor.
+type deviceWrapper interface {
+	Unwrap() Device
+}
+
 // nvmlDeviceHandle attempts to convert a device d to an nvmlDevice.
 // This is required for functions such as GetTopologyCommonAncestor which
 // accept Device arguments that need to be passed to internal nvml* functions
@@ -27,6 +33,12 @@ import (
 func nvmlDeviceHandle(d Device) nvmlDevice {
 	var helper func(val reflect.Value) nvmlDevice
 	helper = func(val reflect.Value) nvmlDevice {
+		if val.CanInterface() {
+			if w, ok := val.Interface().(deviceWrapper); ok {
+				return helper(reflect.ValueOf(w.Unwrap()))
+			}
+		}
+
 		if val.Kind() == reflect.Interface {
 			val = val.Elem()
 		}
@@ -101,14 +113,14 @@ func (c nvmlComputeInstanceInfo) convert() ComputeInstanceInfo {
 // nvml.DeviceGetCount()
 func (l *library) DeviceGetCount() (int, Return) {
 	var deviceCount uint32
-	ret := nvmlDeviceGetCount(&deviceCount)
+	ret := l.loadedSymbols().nvmlDeviceGetCount(&deviceCount)
 	return int(deviceCount), ret
 }
 
 // nvml.DeviceGetHandleByIndex()
 func (l *library) DeviceGetHandleByIndex(index int) (Device, Return) {
 	var device nvmlDevice
-	ret := nvmlDeviceGetHandleByIndex(uint32(index), &device)
+	ret := l.loadedSymbols().nvmlDeviceGetHandleByIndex(uint32(index), &device)
 	return device, ret
 }
 
@@ -138,7 +150,7 @@ func (l *library) DeviceGetHandleByUUIDV(uuid *UUID) (Device, Return) {
 // nvml.DeviceGetHandleByPciBusId()
 func (l *library) DeviceGetHandleByPciBusId(pciBusId string) (Device, Return) {
 	var device nvmlDevice
-	ret := nvmlDeviceGetHandleByPciBusId(pciBusId+string(rune(0)), &device)
+	ret := l.loadedSymbols().nvmlDeviceGetHandleByPciBusId(pciBusId+string(rune(0)), &device)
 	return device, ret
 }
 
@@ -399,7 +411,7 @@ func (l *library) DeviceGetPciInfo(device Device) (PciInfo, Return) {
 
 func (device nvmlDevice) GetPciInfo() (PciInfo, Return) {
 	var pci PciInfo
-	ret := nvmlDeviceGetPciInfo(device, &pci)
+	ret := boundSymbols().nvmlDeviceGetPciInfo(device, &pci)
 	return pci, ret
 }
 
@@ -844,8 +856,7 @@ func (l *library) DeviceGetMemoryInfo_v2(device Device) (Memory_v2, Return) {
 }
 
 func (device nvmlDevice) GetMemoryInfo_v2() (Memory_v2, Return) {
-	var memory Memory_v2
-	memory.Version = STRUCT_VERSION(memory, 2)
+	memory := NewMemory_v2()
 	ret := nvmlDeviceGetMemoryInfo_v2(device, &memory)
 	return memory, ret
 }
@@ -1054,7 +1065,7 @@ func (l *library) DeviceGetDriverModel(device Device) (DriverModel, DriverModel,
 
 func (device nvmlDevice) GetDriverModel() (DriverModel, DriverModel, Return) {
 	var current, pending DriverModel
-	ret := nvmlDeviceGetDriverModel(device, &current, &pending)
+	ret := boundSymbols().nvmlDeviceGetDriverModel(device, &current, &pending)
 	return current, pending, ret
 }
 
@@ -1131,7 +1142,7 @@ func (l *library) DeviceGetComputeRunningProcesses(device Device) ([]ProcessInfo
 }
 
 func (device nvmlDevice) GetComputeRunningProcesses() ([]ProcessInfo, Return) {
-	return deviceGetComputeRunningProcesses(device)
+	return boundSymbols().deviceGetComputeRunningProcesses(device)
 }
 
 // nvml.DeviceGetGraphicsRunningProcesses()
@@ -1185,7 +1196,7 @@ func (l *library) DeviceGetGraphicsRunningProcesses(device Device) ([]ProcessInf
 }
 
 func (device nvmlDevice) GetGraphicsRunningProcesses() ([]ProcessInfo, Return) {
-	return deviceGetGraphicsRunningProcesses(device)
+	return boundSymbols().deviceGetGraphicsRunningProcesses(device)
 }
 
 // nvml.DeviceGetMPSComputeRunningProcesses()
@@ -1239,7 +1250,7 @@ func (l *library) DeviceGetMPSComputeRunningProcesses(device Device) ([]ProcessI
 }
 
 func (device nvmlDevice) GetMPSComputeRunningProcesses() ([]ProcessInfo, Return) {
-	return deviceGetMPSComputeRunningProcesses(device)
+	return boundSymbols().deviceGetMPSComputeRunningProcesses(device)
 }
 
 // nvml.DeviceOnSameBoard()
@@ -1391,8 +1402,7 @@ func (l *library) DeviceGetPdi(device Device) (Pdi, Return) {
 }
 
 func (device nvmlDevice) GetPdi() (Pdi, Return) {
-	var pdi Pdi
-	pdi.Version = STRUCT_VERSION(pdi, 1)
+	pdi := NewPdi()
 	ret := nvmlDeviceGetPdi(device, &pdi)
 	return pdi, ret
 }
@@ -1689,7 +1699,7 @@ func (l *library) DeviceGetNvLinkRemotePciInfo(device Device, link int) (PciInfo
 
 func (device nvmlDevice) GetNvLinkRemotePciInfo(link int) (PciInfo, Return) {
 	var pci PciInfo
-	ret := nvmlDeviceGetNvLinkRemotePciInfo(device, uint32(link), &pci)
+	ret := boundSymbols().nvmlDeviceGetNvLinkRemotePciInfo(device, uint32(link), &pci)
 	return pci, ret
 }
 
@@ -1826,7 +1836,7 @@ func (l *library) DeviceQueryDrainState(pciInfo *PciInfo) (EnableState, Return)
 
 // nvml.DeviceRemoveGpu()
 func (l *library) DeviceRemoveGpu(pciInfo *PciInfo) Return {
-	return nvmlDeviceRemoveGpu(pciInfo)
+	return l.loadedSymbols().nvmlDeviceRemoveGpu(pciInfo)
 }
 
 // nvml.DeviceRemoveGpu_v2()
@@ -1889,7 +1899,7 @@ func (l *library) DeviceGetGridLicensableFeatures(device Device) (GridLicensable
 
 func (device nvmlDevice) GetGridLicensableFeatures() (GridLicensableFeatures, Return) {
 	var pGridLicensableFeatures GridLicensableFeatures
-	ret := nvmlDeviceGetGridLicensableFeatures(device, &pGridLicensableFeatures)
+	ret := boundSymbols().nvmlDeviceGetGridLicensableFeatures(device, &pGridLicensableFeatures)
 	return pGridLicensableFeatures, ret
 }
 
@@ -2046,7 +2056,7 @@ func (l *library) DeviceGetAttributes(device Device) (DeviceAttributes, Return)
 
 func (device nvmlDevice) GetAttributes() (DeviceAttributes, Return) {
 	var attributes DeviceAttributes
-	ret := nvmlDeviceGetAttributes(device, &attributes)
+	ret := boundSymbols().nvmlDeviceGetAttributes(device, &attributes)
 	return attributes, ret
 }
 
@@ -2165,23 +2175,31 @@ type GpuInstanceProfileInfoHandler struct {
 }
 
 func (handler GpuInstanceProfileInfoHandler) V1() (GpuInstanceProfileInfo, Return) {
-	return DeviceGetGpuInstanceProfileInfo(handler.device, handler.profile)
+	return handler.device.GetGpuInstanceProfileInfo(handler.profile)
 }
 
 func (handler GpuInstanceProfileInfoHandler) V2() (GpuInstanceProfileInfo_v2, Return) {
-	var info GpuInstanceProfileInfo_v2
-	info.Version = STRUCT_VERSION(info, 2)
+	info := NewGpuInstanceProfileInfo_v2()
 	ret := nvmlDeviceGetGpuInstanceProfileInfoV(handler.device, uint32(handler.profile), &info)
 	return info, ret
 }
 
 func (handler GpuInstanceProfileInfoHandler) V3() (GpuInstanceProfileInfo_v3, Return) {
-	var info GpuInstanceProfileInfo_v3
-	info.Version = STRUCT_VERSION(info, 3)
+	info := NewGpuInstanceProfileInfo_v3()
 	ret := nvmlDeviceGetGpuInstanceProfileInfoV(handler.device, uint32(handler.profile), (*GpuInstanceProfileInfo_v2)(unsafe.Pointer(&info)))
 	return info, ret
 }
 
+// Negotiate gets the profile info using the latest version of the struct that
+// is supported by the library.
+func (handler GpuInstanceProfileInfoHandler) Negotiate() (Negotiated[GpuInstanceProfileInfo_v3], Return) {
+	return negotiate(negotiationKey{"DeviceGetGpuInstanceProfileInfoV", handler.device},
+		withVersion[GpuInstanceProfileInfo_v3](3, handler.V3),
+		withVersion[GpuInstanceProfileInfo_v3](2, handler.V2),
+		withVersion[GpuInstanceProfileInfo_v3](1, handler.V1),
+	)
+}
+
 func (l *library) DeviceGetGpuInstanceProfileInfoV(device Device, profile int) GpuInstanceProfileInfoHandler {
 	return device.GetGpuInstanceProfileInfoV(profile)
 }
@@ -2196,19 +2214,26 @@ type GpuInstanceProfileInfoByIdHandler struct {
 }
 
 func (handler GpuInstanceProfileInfoByIdHandler) V2() (GpuInstanceProfileInfo_v2, Return) {
-	var info GpuInstanceProfileInfo_v2
-	info.Version = STRUCT_VERSION(info, 2)
+	info := NewGpuInstanceProfileInfo_v2()
 	ret := nvmlDeviceGetGpuInstanceProfileInfoByIdV(handler.device, uint32(handler.profileId), &info)
 	return info, ret
 }
 
 func (handler GpuInstanceProfileInfoByIdHandler) V3() (GpuInstanceProfileInfo_v3, Return) {
-	var info GpuInstanceProfileInfo_v3
-	info.Version = STRUCT_VERSION(info, 3)
-	ret := nvmlDeviceGetGpuInstanceProfileInfoV(handler.device, uint32(handler.profileId), (*GpuInstanceProfileInfo_v2)(unsafe.Pointer(&info)))
+	info := NewGpuInstanceProfileInfo_v3()
+	ret := nvmlDeviceGetGpuInstanceProfileInfoByIdV(handler.device, uint32(handler.profileId), (*GpuInstanceProfileInfo_v2)(unsafe.Pointer(&info)))
 	return info, ret
 }
 
+// Negotiate gets the profile info using the latest version of the struct that
+// is supported by the library.
+func (handler GpuInstanceProfileInfoByIdHandler) Negotiate() (Negotiated[GpuInstanceProfileInfo_v3], Return) {
+	return negotiate(negotiationKey{"DeviceGetGpuInstanceProfileInfoByIdV", handler.device},
+		withVersion[GpuInstanceProfileInfo_v3](3, handler.V3),
+		withVersion[GpuInstanceProfileInfo_v3](2, handler.V2),
+	)
+}
+
 func (l *library) DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) GpuInstanceProfileInfoByIdHandler {
 	return device.GetGpuInstanceProfileInfoByIdV(profileId)
 }
@@ -2227,7 +2252,7 @@ func (device nvmlDevice) GetGpuInstancePossiblePlacements(info *GpuInstanceProfi
 		return nil, ERROR_INVALID_ARGUMENT
 	}
 	var count uint32
-	ret := nvmlDeviceGetGpuInstancePossiblePlacements(device, info.Id, nil, &count)
+	ret := boundSymbols().nvmlDeviceGetGpuInstancePossiblePlacements(device, info.Id, nil, &count)
 	if ret != SUCCESS {
 		return nil, ret
 	}
@@ -2235,7 +2260,7 @@ func (device nvmlDevice) GetGpuInstancePossiblePlacements(info *GpuInstanceProfi
 		return []GpuInstancePlacement{}, ret
 	}
 	placements := make([]GpuInstancePlacement, count)
-	ret = nvmlDeviceGetGpuInstancePossiblePlacements(device, info.Id, &placements[0], &count)
+	ret = boundSymbols().nvmlDeviceGetGpuInstancePossiblePlacements(device, info.Id, &placements[0], &count)
 	return placements[:count], ret
 }
 
@@ -2346,23 +2371,31 @@ type ComputeInstanceProfileInfoHandler struct {
 }
 
 func (handler ComputeInstanceProfileInfoHandler) V1() (ComputeInstanceProfileInfo, Return) {
-	return GpuInstanceGetComputeInstanceProfileInfo(handler.gpuInstance, handler.profile, handler.engProfile)
+	return handler.gpuInstance.GetComputeInstanceProfileInfo(handler.profile, handler.engProfile)
 }
 
 func (handler ComputeInstanceProfileInfoHandler) V2() (ComputeInstanceProfileInfo_v2, Return) {
-	var info ComputeInstanceProfileInfo_v2
-	info.Version = STRUCT_VERSION(info, 2)
+	info := NewComputeInstanceProfileInfo_v2()
 	ret := nvmlGpuInstanceGetComputeInstanceProfileInfoV(handler.gpuInstance, uint32(handler.profile), uint32(handler.engProfile), &info)
 	return info, ret
 }
 
 func (handler ComputeInstanceProfileInfoHandler) V3() (ComputeInstanceProfileInfo_v3, Return) {
-	var info ComputeInstanceProfileInfo_v3
-	info.Version = STRUCT_VERSION(info, 3)
+	info := NewComputeInstanceProfileInfo_v3()
 	ret := nvmlGpuInstanceGetComputeInstanceProfileInfoV(handler.gpuInstance, uint32(handler.profile), uint32(handler.engProfile), (*ComputeInstanceProfileInfo_v2)(unsafe.Pointer(&info)))
 	return info, ret
 }
 
+// Negotiate gets the profile info using the latest version of the struct that
+// is supported by the library.
+func (handler ComputeInstanceProfileInfoHandler) Negotiate() (Negotiated[ComputeInstanceProfileInfo_v3], Return) {
+	return negotiate(negotiationKey{"GpuInstanceGetComputeInstanceProfileInfoV", handler.gpuInstance},
+		withVersion[ComputeInstanceProfileInfo_v3](3, handler.V3),
+		withVersion[ComputeInstanceProfileInfo_v3](2, handler.V2),
+		withVersion[ComputeInstanceProfileInfo_v3](1, handler.V1),
+	)
+}
+
 func (l *library) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) ComputeInstanceProfileInfoHandler {
 	return gpuInstance.GetComputeInstanceProfileInfoV(profile, engProfile)
 }
@@ -2441,7 +2474,7 @@ func (l *library) ComputeInstanceGetInfo(computeInstance ComputeInstance) (Compu
 
 func (computeInstance nvmlComputeInstance) GetInfo() (ComputeInstanceInfo, Return) {
 	var info nvmlComputeInstanceInfo
-	ret := nvmlComputeInstanceGetInfo(computeInstance, &info)
+	ret := boundSymbols().nvmlComputeInstanceGetInfo(computeInstance, &info)
 	return info.convert(), ret
 }
 
@@ -2931,8 +2964,7 @@ func (l *library) DeviceGetRunningProcessDetailList(device Device) (ProcessDetai
 }
 
 func (device nvmlDevice) GetRunningProcessDetailList() (ProcessDetailList, Return) {
-	var plist ProcessDetailList
-	plist.Version = STRUCT_VERSION(plist, 1)
+	plist := NewProcessDetailList()
 	ret := nvmlDeviceGetRunningProcessDetailList(device, &plist)
 	return plist, ret
 }
@@ -2999,6 +3031,14 @@ func (handler C2cModeInfoHandler) V1() (C2cModeInfo_v1, Return) {
 	return c2cModeInfo, ret
 }
 
+// Negotiate gets the C2C mode info using the latest version of the struct
+// that is supported by the library.
+func (handler C2cModeInfoHandler) Negotiate() (Negotiated[C2cModeInfo_v1], Return) {
+	return negotiate(negotiationKey{"DeviceGetC2cModeInfoV", handler.device},
+		withVersion[C2cModeInfo_v1](1, handler.V1),
+	)
+}
+
 func (l *library) DeviceGetC2cModeInfoV(device Device) C2cModeInfoHandler {
 	return device.GetC2cModeInfoV()
 }
@@ -3035,8 +3075,7 @@ func (l *library) DeviceGetAddressingMode(device Device) (DeviceAddressingMode,
 }
 
 func (device nvmlDevice) GetAddressingMode() (DeviceAddressingMode, Return) {
-	var deviceAddressingMode DeviceAddressingMode
-	deviceAddressingMode.Version = STRUCT_VERSION(deviceAddressingMode, 1)
+	deviceAddressingMode := NewDeviceAddressingMode()
 	ret := nvmlDeviceGetAddressingMode(device, &deviceAddressingMode)
 	return deviceAddressingMode, ret
 }
@@ -3046,8 +3085,7 @@ func (l *library) DeviceGetRepairStatus(device Device) (RepairStatus, Return) {
 }
 
 func (device nvmlDevice) GetRepairStatus() (RepairStatus, Return) {
-	var repairStatus RepairStatus
-	repairStatus.Version = STRUCT_VERSION(repairStatus, 1)
+	repairStatus := NewRepairStatus()
 	ret := nvmlDeviceGetRepairStatus(device, &repairStatus)
 	return repairStatus, ret
 }
@@ -3058,8 +3096,7 @@ func (l *library) DeviceGetPciInfoExt(device Device) (PciInfoExt, Return) {
 }
 
 func (device nvmlDevice) GetPciInfoExt() (PciInfoExt, Return) {
-	var pciInfo PciInfoExt
-	pciInfo.Version = STRUCT_VERSION(pciInfo, 1)
+	pciInfo := NewPciInfoExt()
 	ret := nvmlDeviceGetPciInfoExt(device, &pciInfo)
 	return pciInfo, ret
 }
@@ -3074,19 +3111,27 @@ func (handler GpuFabricInfoHandler) V1() (GpuFabricInfo, Return) {
 }
 
 func (handler GpuFabricInfoHandler) V2() (GpuFabricInfo_v2, Return) {
-	var info GpuFabricInfo_v2
-	info.Version = STRUCT_VERSION(info, 2)
+	info := NewGpuFabricInfo_v2()
 	ret := nvmlDeviceGetGpuFabricInfoV(handler.device, (*GpuFabricInfoV)(unsafe.Pointer(&info)))
 	return info, ret
 }
 
 func (handler GpuFabricInfoHandler) V3() (GpuFabricInfo_v3, Return) {
-	var info GpuFabricInfo_v3
-	info.Version = STRUCT_VERSION(info, 3)
+	info := NewGpuFabricInfo_v3()
 	ret := nvmlDeviceGetGpuFabricInfoV(handler.device, (*GpuFabricInfoV)(unsafe.Pointer(&info)))
 	return info, ret
 }
 
+// Negotiate gets the fabric info using the latest version of the struct that
+// is supported by the library.
+func (handler GpuFabricInfoHandler) Negotiate() (Negotiated[GpuFabricInfo_v3], Return) {
+	return negotiate(negotiationKey{"DeviceGetGpuFabricInfoV", handler.device},
+		withVersion[GpuFabricInfo_v3](3, handler.V3),
+		withVersion[GpuFabricInfo_v3](2, handler.V2),
+		withVersion[GpuFabricInfo_v3](1, handler.V1),
+	)
+}
+
 func (l *library) DeviceGetGpuFabricInfoV(device Device) GpuFabricInfoHandler {
 	return device.GetGpuFabricInfoV()
 }
@@ -3112,8 +3157,7 @@ func (l *library) DeviceGetVgpuHeterogeneousMode(device Device) (VgpuHeterogeneo
 }
 
 func (device nvmlDevice) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
-	var heterogeneousMode VgpuHeterogeneousMode
-	heterogeneousMode.Version = STRUCT_VERSION(heterogeneousMode, 1)
+	heterogeneousMode := NewVgpuHeterogeneousMode()
 	ret := nvmlDeviceGetVgpuHeterogeneousMode(device, &heterogeneousMode)
 	return heterogeneousMode, ret
 }
@@ -3138,8 +3182,10 @@ func (device nvmlDevice) GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (
 }
 
 func (vgpuTypeId nvmlVgpuTypeId) GetSupportedPlacements(device Device) (VgpuPlacementList, Return) {
+	// The mode that is an input of version 2 of the struct cannot be
+	// specified, so version 1 is requested.
 	var placementList VgpuPlacementList
-	placementList.Version = STRUCT_VERSION(placementList, 1)
+	placementList.Version = VgpuPlacementList_v1Version
 	ret := nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDeviceHandle(device), vgpuTypeId, &placementList)
 	return placementList, ret
 }
@@ -3154,8 +3200,10 @@ func (device nvmlDevice) GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (
 }
 
 func (vgpuTypeId nvmlVgpuTypeId) GetCreatablePlacements(device Device) (VgpuPlacementList, Return) {
+	// The mode that is an input of version 2 of the struct cannot be
+	// specified, so version 1 is requested.
 	var placementList VgpuPlacementList
-	placementList.Version = STRUCT_VERSION(placementList, 1)
+	placementList.Version = VgpuPlacementList_v1Version
 	ret := nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDeviceHandle(device), vgpuTypeId, &placementList)
 	return placementList, ret
 }
@@ -3187,8 +3235,7 @@ func (l *library) DeviceGetVgpuProcessesUtilizationInfo(device Device) (VgpuProc
 }
 
 func (device nvmlDevice) GetVgpuProcessesUtilizationInfo() (VgpuProcessesUtilizationInfo, Return) {
-	var vgpuProcUtilInfo VgpuProcessesUtilizationInfo
-	vgpuProcUtilInfo.Version = STRUCT_VERSION(vgpuProcUtilInfo, 1)
+	vgpuProcUtilInfo := NewVgpuProcessesUtilizationInfo()
 	ret := nvmlDeviceGetVgpuProcessesUtilizationInfo(device, &vgpuProcUtilInfo)
 	return vgpuProcUtilInfo, ret
 }
@@ -3199,8 +3246,7 @@ func (l *library) DeviceGetSramEccErrorStatus(device Device) (EccSramErrorStatus
 }
 
 func (device nvmlDevice) GetSramEccErrorStatus() (EccSramErrorStatus, Return) {
-	var status EccSramErrorStatus
-	status.Version = STRUCT_VERSION(status, 1)
+	status := NewEccSramErrorStatus()
 	ret := nvmlDeviceGetSramEccErrorStatus(device, &status)
 	return status, ret
 }
@@ -3211,8 +3257,7 @@ func (l *library) DeviceGetClockOffsets(device Device) (ClockOffset, Return) {
 }
 
 func (device nvmlDevice) GetClockOffsets() (ClockOffset, Return) {
-	var info ClockOffset
-	info.Version = STRUCT_VERSION(info, 1)
+	info := NewClockOffset()
 	ret := nvmlDeviceGetClockOffsets(device, &info)
 	return info, ret
 }
@@ -3243,8 +3288,7 @@ func (l *library) DeviceGetCapabilities(device Device) (DeviceCapabilities, Retu
 }
 
 func (device nvmlDevice) GetCapabilities() (DeviceCapabilities, Return) {
-	var caps DeviceCapabilities
-	caps.Version = STRUCT_VERSION(caps, 1)
+	caps := NewDeviceCapabilities()
 	ret := nvmlDeviceGetCapabilities(device, &caps)
 	return caps, ret
 }
@@ -3255,8 +3299,7 @@ func (l *library) DeviceGetFanSpeedRPM(device Device) (FanSpeedInfo, Return) {
 }
 
 func (device nvmlDevice) GetFanSpeedRPM() (FanSpeedInfo, Return) {
-	var fanSpeed FanSpeedInfo
-	fanSpeed.Version = STRUCT_VERSION(fanSpeed, 1)
+	fanSpeed := NewFanSpeedInfo()
 	ret := nvmlDeviceGetFanSpeedRPM(device, &fanSpeed)
 	return fanSpeed, ret
 }
@@ -3267,8 +3310,7 @@ func (l *library) DeviceGetCoolerInfo(device Device) (CoolerInfo, Return) {
 }
 
 func (device nvmlDevice) GetCoolerInfo() (CoolerInfo, Return) {
-	var coolerInfo CoolerInfo
-	coolerInfo.Version = STRUCT_VERSION(coolerInfo, 1)
+	coolerInfo := NewCoolerInfo()
 	ret := nvmlDeviceGetCoolerInfo(device, &coolerInfo)
 	return coolerInfo, ret
 }
@@ -3279,12 +3321,19 @@ type TemperatureHandler struct {
 }
 
 func (handler TemperatureHandler) V1() (Temperature, Return) {
-	var temperature Temperature
-	temperature.Version = STRUCT_VERSION(temperature, 1)
+	temperature := NewTemperature()
 	ret := nvmlDeviceGetTemperatureV(handler.device, &temperature)
 	return temperature, ret
 }
 
+// Negotiate gets the temperature using the latest version of the struct that
+// is supported by the library.
+func (handler TemperatureHandler) Negotiate() (Negotiated[Temperature], Return) {
+	return negotiate(negotiationKey{"DeviceGetTemperatureV", handler.device},
+		withVersion[Temperature](1, handler.V1),
+	)
+}
+
 func (l *library) DeviceGetTemperatureV(device Device) TemperatureHandler {
 	return device.GetTemperatureV()
 }
@@ -3299,8 +3348,7 @@ func (l *library) DeviceGetMarginTemperature(device Device) (MarginTemperature,
 }
 
 func (device nvmlDevice) GetMarginTemperature() (MarginTemperature, Return) {
-	var marginTemp MarginTemperature
-	marginTemp.Version = STRUCT_VERSION(marginTemp, 1)
+	marginTemp := NewMarginTemperature()
 	ret := nvmlDeviceGetMarginTemperature(device, &marginTemp)
 	return marginTemp, ret
 }
@@ -3311,8 +3359,7 @@ func (l *library) DeviceGetPerformanceModes(device Device) (DevicePerfModes, Ret
 }
 
 func (device nvmlDevice) GetPerformanceModes() (DevicePerfModes, Return) {
-	var perfModes DevicePerfModes
-	perfModes.Version = STRUCT_VERSION(perfModes, 1)
+	perfModes := NewDevicePerfModes()
 	ret := nvmlDeviceGetPerformanceModes(device, &perfModes)
 	return perfModes, ret
 }
@@ -3323,8 +3370,7 @@ func (l *library) DeviceGetCurrentClockFreqs(device Device) (DeviceCurrentClockF
 }
 
 func (device nvmlDevice) GetCurrentClockFreqs() (DeviceCurrentClockFreqs, Return) {
-	var currentClockFreqs DeviceCurrentClockFreqs
-	currentClockFreqs.Version = STRUCT_VERSION(currentClockFreqs, 1)
+	currentClockFreqs := NewDeviceCurrentClockFreqs()
 	ret := nvmlDeviceGetCurrentClockFreqs(device, &currentClockFreqs)
 	return currentClockFreqs, ret
 }
@@ -3335,9 +3381,7 @@ func (l *library) DeviceGetDramEncryptionMode(device Device) (DramEncryptionInfo
 }
 
 func (device nvmlDevice) GetDramEncryptionMode() (DramEncryptionInfo, DramEncryptionInfo, Return) {
-	var current, pending DramEncryptionInfo
-	current.Version = STRUCT_VERSION(current, 1)
-	pending.Version = STRUCT_VERSION(pending, 1)
+	current, pending := NewDramEncryptionInfo(), NewDramEncryptionInfo()
 	ret := nvmlDeviceGetDramEncryptionMode(device, &current, &pending)
 	return current, pending, ret
 }
@@ -3357,8 +3401,10 @@ func (l *library) DeviceGetPlatformInfo(device Device) (PlatformInfo, Return) {
 }
 
 func (device nvmlDevice) GetPlatformInfo() (PlatformInfo, Return) {
+	// PlatformInfo is version 2 of the struct. Version 1, which has the same
+	// size, is requested for compatibility with older drivers.
 	var platformInfo PlatformInfo
-	platformInfo.Version = STRUCT_VERSION(platformInfo, 1)
+	platformInfo.Version = PlatformInfo_v1Version
 	ret := nvmlDeviceGetPlatformInfo(device, &platformInfo)
 	return platformInfo, ret
 }
@@ -3369,8 +3415,7 @@ func (l *library) DeviceGetNvlinkSupportedBwModes(device Device) (NvlinkSupporte
 }
 
 func (device nvmlDevice) GetNvlinkSupportedBwModes() (NvlinkSupportedBwModes, Return) {
-	var supportedBwMode NvlinkSupportedBwModes
-	supportedBwMode.Version = STRUCT_VERSION(supportedBwMode, 1)
+	supportedBwMode := NewNvlinkSupportedBwModes()
 	ret := nvmlDeviceGetNvlinkSupportedBwModes(device, &supportedBwMode)
 	return supportedBwMode, ret
 }
@@ -3381,8 +3426,7 @@ func (l *library) DeviceGetNvlinkBwMode(device Device) (NvlinkGetBwMode, Return)
 }
 
 func (device nvmlDevice) GetNvlinkBwMode() (NvlinkGetBwMode, Return) {
-	var getBwMode NvlinkGetBwMode
-	getBwMode.Version = STRUCT_VERSION(getBwMode, 1)
+	getBwMode := NewNvlinkGetBwMode()
 	ret := nvmlDeviceGetNvlinkBwMode(device, &getBwMode)
 	return getBwMode, ret
 }
@@ -3409,29 +3453,35 @@ type NvLinkInfoHandler struct {
 }
 
 func (handler NvLinkInfoHandler) V1() (NvLinkInfo_v1, Return) {
-	var info NvLinkInfo_v1
-	info.Version = STRUCT_VERSION(info, 1)
+	info := NewNvLinkInfo_v1()
 	ret := nvmlDeviceGetNvLinkInfo(handler.device, (*NvLinkInfo)(unsafe.Pointer(&info)))
 
 	return info, ret
 }
 
 func (handler NvLinkInfoHandler) V2() (NvLinkInfo_v2, Return) {
-	var info NvLinkInfo_v2
-	info.Version = STRUCT_VERSION(info, 2)
+	info := NewNvLinkInfo_v2()
 	ret := nvmlDeviceGetNvLinkInfo(handler.device, (*NvLinkInfo)(unsafe.Pointer(&info)))
 
 	return info, ret
 }
 
+// Negotiate gets the NvLink info using the latest version of the struct that
+// is supported by the library.
+func (handler NvLinkInfoHandler) Negotiate() (Negotiated[NvLinkInfo_v2], Return) {
+	return negotiate(negotiationKey{"DeviceGetNvLinkInfo", handler.device},
+		withVersion[NvLinkInfo_v2](2, handler.V2),
+		withVersion[NvLinkInfo_v2](1, handler.V1),
+	)
+}
+
 // nvml.DeviceWorkloadPowerProfileGetProfilesInfo()
 func (l *library) DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (WorkloadPowerProfileProfilesInfo, Return) {
 	return device.WorkloadPowerProfileGetProfilesInfo()
 }
 
 func (device nvmlDevice) WorkloadPowerProfileGetProfilesInfo() (WorkloadPowerProfileProfilesInfo, Return) {
-	var profilesInfo WorkloadPowerProfileProfilesInfo
-	profilesInfo.Version = STRUCT_VERSION(profilesInfo, 1)
+	profilesInfo := NewWorkloadPowerProfileProfilesInfo()
 	ret := nvmlDeviceWorkloadPowerProfileGetProfilesInfo(device, &profilesInfo)
 	return profilesInfo, ret
 }
@@ -3442,8 +3492,7 @@ func (l *library) DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (W
 }
 
 func (device nvmlDevice) WorkloadPowerProfileGetCurrentProfiles() (WorkloadPowerProfileCurrentProfiles, Return) {
-	var currentProfiles WorkloadPowerProfileCurrentProfiles
-	currentProfiles.Version = STRUCT_VERSION(currentProfiles, 1)
+	currentProfiles := NewWorkloadPowerProfileCurrentProfiles()
 	ret := nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(device, &currentProfiles)
 	return currentProfiles, ret
 }
@@ -3507,8 +3556,7 @@ func (l *library) GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (VgpuTyp
 }
 
 func (gpuInstance nvmlGpuInstance) GetCreatableVgpus() (VgpuTypeIdInfo, Return) {
-	var vgpuTypeIdInfo VgpuTypeIdInfo
-	vgpuTypeIdInfo.Version = STRUCT_VERSION(vgpuTypeIdInfo, 1)
+	vgpuTypeIdInfo := NewVgpuTypeIdInfo()
 	ret := nvmlGpuInstanceGetCreatableVgpus(gpuInstance, &vgpuTypeIdInfo)
 	return vgpuTypeIdInfo, ret
 }
@@ -3519,8 +3567,7 @@ func (l *library) GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (ActiveVgpu
 }
 
 func (gpuInstance nvmlGpuInstance) GetActiveVgpus() (ActiveVgpuInstanceInfo, Return) {
-	var activeVgpuInstanceInfo ActiveVgpuInstanceInfo
-	activeVgpuInstanceInfo.Version = STRUCT_VERSION(activeVgpuInstanceInfo, 1)
+	activeVgpuInstanceInfo := NewActiveVgpuInstanceInfo()
 	ret := nvmlGpuInstanceGetActiveVgpus(gpuInstance, &activeVgpuInstanceInfo)
 	return activeVgpuInstanceInfo, ret
 }
@@ -3540,8 +3587,7 @@ func (l *library) GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (Vgp
 }
 
 func (gpuInstance nvmlGpuInstance) GetVgpuSchedulerState() (VgpuSchedulerStateInfo, Return) {
-	var schedulerStateInfo VgpuSchedulerStateInfo
-	schedulerStateInfo.Version = STRUCT_VERSION(schedulerStateInfo, 1)
+	schedulerStateInfo := NewVgpuSchedulerStateInfo()
 	ret := nvmlGpuInstanceGetVgpuSchedulerState(gpuInstance, &schedulerStateInfo)
 	return schedulerStateInfo, ret
 }
@@ -3552,8 +3598,7 @@ func (l *library) GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (VgpuS
 }
 
 func (gpuInstance nvmlGpuInstance) GetVgpuSchedulerLog() (VgpuSchedulerLogInfo, Return) {
-	var schedulerLogInfo VgpuSchedulerLogInfo
-	schedulerLogInfo.Version = STRUCT_VERSION(schedulerLogInfo, 1)
+	schedulerLogInfo := NewVgpuSchedulerLogInfo()
 	ret := nvmlGpuInstanceGetVgpuSchedulerLog(gpuInstance, &schedulerLogInfo)
 	return schedulerLogInfo, ret
 }
@@ -3564,8 +3609,7 @@ func (l *library) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInsta
 }
 
 func (gpuInstance nvmlGpuInstance) GetVgpuTypeCreatablePlacements() (VgpuCreatablePlacementInfo, Return) {
-	var creatablePlacementInfo VgpuCreatablePlacementInfo
-	creatablePlacementInfo.Version = STRUCT_VERSION(creatablePlacementInfo, 1)
+	creatablePlacementInfo := NewVgpuCreatablePlacementInfo()
 	ret := nvmlGpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance, &creatablePlacementInfo)
 	return creatablePlacementInfo, ret
 }
@@ -3576,8 +3620,7 @@ func (l *library) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (
 }
 
 func (gpuInstance nvmlGpuInstance) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
-	var heterogeneousMode VgpuHeterogeneousMode
-	heterogeneousMode.Version = STRUCT_VERSION(heterogeneousMode, 1)
+	heterogeneousMode := NewVgpuHeterogeneousMode()
 	ret := nvmlGpuInstanceGetVgpuHeterogeneousMode(gpuInstance, &heterogeneousMode)
 	return heterogeneousMode, ret
 }
@@ -0,0 +1,81 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// The structs in types_gen.go that have char arrays, byte arrays, or padding
+// have MarshalJSON, UnmarshalJSON, MarshalYAML, and UnmarshalYAML methods that
+// are generated in zz_generated.encoding.go. These encode char arrays as
+// strings, UUIDs in their canonical form, and other byte arrays (binary data
+// and unions) as base64, and omit the padding. All other fields are encoded as
+// is. Decoding an encoded struct results in the original C layout, with the
+// padding set to zero.
+
+// byteArray is the encoding of a byte array as base64.
+type byteArray []byte
+
+// MarshalText encodes the byte array as base64.
+func (b byteArray) MarshalText() ([]byte, error) {
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+	base64.StdEncoding.Encode(text, b)
+	return text, nil
+}
+
+// UnmarshalText decodes a byte array encoded as base64.
+func (b *byteArray) UnmarshalText(text []byte) error {
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(decoded, text)
+	if err != nil {
+		return err
+	}
+	*b = decoded[:n]
+	return nil
+}
+
+// cString returns the NUL-terminated string in a C char array.
+func cString(b []uint8) string {
+	return string(b[:clen(b)])
+}
+
+// setCString stores a string in a C char array. A string that fills the
+// array completely is stored without a NUL terminator, as cString reads it
+// back in full.
+func setCString(dst []uint8, s string) error {
+	if strings.IndexByte(s, 0) >= 0 {
+		return fmt.Errorf("string %q contains a NUL character", s)
+	}
+	if len(s) > len(dst) {
+		return fmt.Errorf("string %q does not fit in char[%d]", s, len(dst))
+	}
+	copy(dst, s)
+	return nil
+}
+
+// setBytes stores bytes in a byte array. Arrays that are longer than the
+// specified bytes are padded with zeros.
+func setBytes(dst []uint8, b []byte) error {
+	if len(b) > len(dst) {
+		return fmt.Errorf("%d bytes do not fit in [%d]byte", len(b), len(dst))
+	}
+	copy(dst, b)
+	return nil
+}
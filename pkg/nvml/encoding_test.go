@@ -0,0 +1,191 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStructJSON(t *testing.T) {
+	var pciInfo PciInfo
+	pciInfo.Domain = 1
+	pciInfo.Bus = 0x3b
+	pciInfo.PciDeviceId = 0x20b010de
+	copy(pciInfo.BusId[:], "00000001:3B:00.0")
+	copy(pciInfo.BusIdLegacy[:], "0001:3B:00.0")
+
+	var filledPciInfo PciInfo
+	copy(filledPciInfo.BusId[:], strings.Repeat("1", len(filledPciInfo.BusId)))
+	copy(filledPciInfo.BusIdLegacy[:], strings.Repeat("0", len(filledPciInfo.BusIdLegacy)))
+
+	fabricInfo := GpuFabricInfo{
+		ClusterUuid: [16]uint8{0x5b, 0x2c, 0x1b, 0x3a, 0x42, 0x2f, 0x4e, 0x6c, 0x9f, 0x51, 0x3e, 0x4d, 0x12, 0xa8, 0x77, 0x01},
+		CliqueId:    7,
+		State:       GPU_FABRIC_STATE_COMPLETED,
+		Pad_cgo_0:   [3]byte{1, 2, 3},
+	}
+
+	platformInfo := PlatformInfo{
+		Version:    STRUCT_VERSION(PlatformInfo{}, 2),
+		IbGuid:     [16]uint8{0xde, 0xad, 0xbe, 0xef},
+		SlotNumber: 3,
+	}
+
+	testCases := []struct {
+		description string
+		input       interface{}
+		output      interface{}
+		expected    string
+	}{
+		{
+			description: "char arrays are strings",
+			input:       pciInfo,
+			output:      &PciInfo{},
+			expected: `{
+				"BusIdLegacy": "0001:3B:00.0",
+				"Domain": 1,
+				"Bus": 59,
+				"Device": 0,
+				"PciDeviceId": 548409566,
+				"PciSubSystemId": 0,
+				"BusId": "00000001:3B:00.0"
+			}`,
+		},
+		{
+			description: "char arrays without a NUL terminator are strings",
+			input:       filledPciInfo,
+			output:      &PciInfo{},
+			expected: `{
+				"BusIdLegacy": "` + strings.Repeat("0", 16) + `",
+				"Domain": 0,
+				"Bus": 0,
+				"Device": 0,
+				"PciDeviceId": 0,
+				"PciSubSystemId": 0,
+				"BusId": "` + strings.Repeat("1", 32) + `"
+			}`,
+		},
+		{
+			description: "UUIDs are canonical and padding is omitted",
+			input:       fabricInfo,
+			output:      &GpuFabricInfo{},
+			expected: `{
+				"ClusterUuid": "5b2c1b3a-422f-4e6c-9f51-3e4d12a87701",
+				"Status": 0,
+				"CliqueId": 7,
+				"State": 3
+			}`,
+		},
+		{
+			description: "unsigned char arrays are base64",
+			input:       platformInfo,
+			output:      &PlatformInfo{},
+			expected: `{
+				"Version": 33554476,
+				"IbGuid": "3q2+7wAAAAAAAAAAAAAAAA==",
+				"ChassisSerialNumber": "AAAAAAAAAAAAAAAAAAAAAA==",
+				"SlotNumber": 3,
+				"TrayIndex": 0,
+				"HostId": 0,
+				"PeerType": 0,
+				"ModuleId": 0
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(data))
+
+			require.NoError(t, json.Unmarshal(data, tc.output))
+			expected := tc.input
+			if fabricInfo, ok := expected.(GpuFabricInfo); ok {
+				fabricInfo.Pad_cgo_0 = [3]byte{}
+				expected = fabricInfo
+			}
+			require.Equal(t, expected, reflect.ValueOf(tc.output).Elem().Interface())
+		})
+	}
+}
+
+func TestStructJSONErrors(t *testing.T) {
+	testCases := []struct {
+		description string
+		input       string
+		output      interface{}
+	}{
+		{
+			description: "string longer than the char array",
+			input:       `{"BusIdLegacy": "` + strings.Repeat("0", 17) + `"}`,
+			output:      &PciInfo{},
+		},
+		{
+			description: "string with a NUL character",
+			input:       `{"BusId": "0000\u0000"}`,
+			output:      &PciInfo{},
+		},
+		{
+			description: "too many bytes",
+			input:       `{"IbGuid": "` + strings.Repeat("A", 24) + `"}`,
+			output:      &PlatformInfo{},
+		},
+		{
+			description: "invalid base64",
+			input:       `{"IbGuid": "not base64"}`,
+			output:      &PlatformInfo{},
+		},
+		{
+			description: "invalid UUID",
+			input:       `{"ClusterUuid": "5b2c1b3a"}`,
+			output:      &GpuFabricInfo{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Error(t, json.Unmarshal([]byte(tc.input), tc.output))
+		})
+	}
+}
+
+func TestStructYAML(t *testing.T) {
+	type config struct {
+		Pci PciInfo `yaml:"pci"`
+	}
+
+	var input config
+	input.Pci.Bus = 7
+	copy(input.Pci.BusId[:], "00000000:07:00.0")
+
+	data, err := yaml.Marshal(input)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `busid: "00000000:07:00.0"`)
+
+	var output config
+	require.NoError(t, yaml.Unmarshal(data, &output))
+	require.Equal(t, input, output)
+
+	require.Error(t, yaml.Unmarshal([]byte("pci:\n  busid: "+strings.Repeat("0", 33)), &output))
+}
@@ -0,0 +1,72 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// enumValue is a named value of an enum type. The String, MarshalText, and
+// UnmarshalText methods and the Parse functions of the enum types are
+// generated in zz_generated.enums.go.
+type enumValue[T ~int32 | ~uint32] struct {
+	// name is the name of the value without the prefix that is shared by all
+	// values of the enum type.
+	name string
+	// constant is the name of the constant that defines the value.
+	constant string
+	value    T
+}
+
+// formatEnum returns the name of the specified value. If a value has more
+// than one name, the first one is returned. Values without a name are
+// formatted as the name of the enum type followed by the value.
+func formatEnum[T ~int32 | ~uint32](typeName string, values []enumValue[T], value T) string {
+	for _, v := range values {
+		if v.value == value {
+			return v.name
+		}
+	}
+	return fmt.Sprintf("%s(%d)", typeName, value)
+}
+
+// formatEnumText returns the name of the specified value, or its number if it
+// has no name. The result can be parsed using parseEnum.
+func formatEnumText[T ~int32 | ~uint32](values []enumValue[T], value T) string {
+	for _, v := range values {
+		if v.value == value {
+			return v.name
+		}
+	}
+	return strconv.FormatInt(int64(value), 10)
+}
+
+// parseEnum parses a value from either its name, the name of its constant, or
+// its number. Names are not case-sensitive.
+func parseEnum[T ~int32 | ~uint32](typeName string, values []enumValue[T], s string) (T, error) {
+	for _, v := range values {
+		if strings.EqualFold(s, v.name) || strings.EqualFold(s, v.constant) {
+			return v.value, nil
+		}
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil && int64(T(n)) == n {
+		return T(n), nil
+	}
+	return 0, fmt.Errorf("invalid %s: %q", typeName, s)
+}
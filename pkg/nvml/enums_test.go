@@ -0,0 +1,117 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumString(t *testing.T) {
+	testCases := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{value: COMPUTEMODE_EXCLUSIVE_PROCESS, expected: "EXCLUSIVE_PROCESS"},
+		{value: DeviceArchitecture(DEVICE_ARCH_AMPERE), expected: "AMPERE"},
+		{value: DeviceArchitecture(DEVICE_ARCH_UNKNOWN), expected: "UNKNOWN"},
+		{value: TOPOLOGY_HOSTBRIDGE, expected: "HOSTBRIDGE"},
+		{value: FEATURE_ENABLED, expected: "ENABLED"},
+		// Omitting the prefix of Pstates would result in names such as "0".
+		{value: PSTATE_0, expected: "PSTATE_0"},
+		// Values with more than one name use the first one.
+		{value: BRAND_NVIDIA_VGAMING, expected: "NVIDIA_CLOUD_GAMING"},
+		{value: ClockType(42), expected: "ClockType(42)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.value.String())
+		})
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	testCases := []struct {
+		description   string
+		input         string
+		expected      ComputeMode
+		expectedError bool
+	}{
+		{description: "name", input: "EXCLUSIVE_PROCESS", expected: COMPUTEMODE_EXCLUSIVE_PROCESS},
+		{description: "name with prefix", input: "COMPUTEMODE_PROHIBITED", expected: COMPUTEMODE_PROHIBITED},
+		{description: "lower case name", input: "exclusive_thread", expected: COMPUTEMODE_EXCLUSIVE_THREAD},
+		{description: "number", input: "3", expected: COMPUTEMODE_EXCLUSIVE_PROCESS},
+		{description: "unnamed number", input: "42", expected: ComputeMode(42)},
+		{description: "number out of range", input: "4294967296", expectedError: true},
+		{description: "invalid name", input: "SHARED", expectedError: true},
+		{description: "empty", input: "", expectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			mode, err := ParseComputeMode(tc.input)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, mode)
+		})
+	}
+
+	arch, err := ParseDeviceArchitecture("ampere")
+	require.NoError(t, err)
+	require.Equal(t, DeviceArchitecture(DEVICE_ARCH_AMPERE), arch)
+
+	ret, err := ParseReturn("ERROR_NOT_SUPPORTED")
+	require.NoError(t, err)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestEnumJSON(t *testing.T) {
+	type config struct {
+		ComputeMode ComputeMode        `json:"computeMode"`
+		Arch        DeviceArchitecture `json:"arch"`
+		Clocks      []ClockType        `json:"clocks"`
+		Return      Return             `json:"return"`
+	}
+
+	input := config{
+		ComputeMode: COMPUTEMODE_EXCLUSIVE_PROCESS,
+		Arch:        DEVICE_ARCH_HOPPER,
+		Clocks:      []ClockType{CLOCK_SM, ClockType(42)},
+		Return:      ERROR_GPU_IS_LOST,
+	}
+	data, err := json.Marshal(input)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"computeMode": "EXCLUSIVE_PROCESS",
+		"arch": "HOPPER",
+		"clocks": ["SM", "42"],
+		"return": "ERROR_GPU_IS_LOST"
+	}`, string(data))
+
+	var output config
+	require.NoError(t, json.Unmarshal(data, &output))
+	require.Equal(t, input, output)
+
+	require.Error(t, json.Unmarshal([]byte(`{"computeMode": "SHARED"}`), &output))
+}
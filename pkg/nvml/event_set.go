@@ -48,7 +48,7 @@ func (l *library) EventSetWait(set EventSet, timeoutms uint32) (EventData, Retur
 
 func (set nvmlEventSet) Wait(timeoutms uint32) (EventData, Return) {
 	var data nvmlEventData
-	ret := nvmlEventSetWait(set, &data, timeoutms)
+	ret := boundSymbols().nvmlEventSetWait(set, &data, timeoutms)
 	return data.convert(), ret
 }
 
@@ -0,0 +1,145 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package faults injects faults (i.e. failed Returns) into the calls made
+// through an nvml.Interface, and through the handles that it returns.
+package faults
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Config describes the faults to inject. Configs are written in YAML or JSON
+// (see testdata/faults.yaml for an example).
+type Config struct {
+	// Seed seeds the random number generator used for the rules with a
+	// Probability, so that the same calls fail each time the same sequence
+	// of calls is made.
+	Seed int64 `json:"seed,omitempty" yaml:"seed,omitempty"`
+	// Rules are evaluated in order for each call. The first rule that
+	// injects a fault determines the Return of the call.
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Rule describes a fault to inject into the matching calls. The call is not
+// made when a fault is injected, and the values returned along with the
+// Return are zero.
+type Rule struct {
+	// Method is the name of the method, as in nvml.Call (e.g.
+	// "DeviceGetHandleByIndex" or "Device.GetTemperature"). The method of a
+	// handle also matches the corresponding method of the Interface (e.g.
+	// "Device.GetTemperature" matches "DeviceGetTemperature"), and a name
+	// without a handle type matches the method of any handle (e.g.
+	// "GetTemperature"). All methods match if Method is empty.
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+	// UUID restricts the rule to the calls on the device with the specified
+	// UUID: calls to the methods of the device, or of its GPU and compute
+	// instances, and calls to the Interface taking one of these as their
+	// first argument.
+	UUID string `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	// After is the number of matching calls that are made before faults are
+	// injected.
+	After int `json:"after,omitempty" yaml:"after,omitempty"`
+	// Times is the maximum number of faults injected by the rule. The
+	// number is not limited if Times is 0.
+	Times int `json:"times,omitempty" yaml:"times,omitempty"`
+	// Probability is the probability of injecting a fault into each
+	// matching call, from 0 to 1. A fault is injected into each matching
+	// call if Probability is 0.
+	Probability float64 `json:"probability,omitempty" yaml:"probability,omitempty"`
+	// Return is the Return of the calls that a fault is injected into.
+	Return nvml.Return `json:"return" yaml:"return"`
+}
+
+// Parse parses a config in YAML or JSON. Unknown fields are rejected.
+func Parse(data []byte) (*Config, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("error parsing config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// LoadFile reads and parses the config in the specified file.
+func LoadFile(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Validate checks that the rules of the config are valid.
+func (c *Config) Validate() error {
+	for i, r := range c.Rules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if r.Return == nvml.SUCCESS {
+		return fmt.Errorf("no return")
+	}
+	if r.After < 0 {
+		return fmt.Errorf("negative after")
+	}
+	if r.Times < 0 {
+		return fmt.Errorf("negative times")
+	}
+	if r.Probability < 0 || r.Probability > 1 {
+		return fmt.Errorf("probability %v is not between 0 and 1", r.Probability)
+	}
+	return nil
+}
+
+// interfacePrefixes holds the prefix of the methods of the Interface for the
+// handle types whose name is not used as the prefix.
+var interfacePrefixes = map[string]string{
+	"VgpuTypeId": "VgpuType",
+}
+
+// matches checks whether the rule applies to the specified method.
+func (r *Rule) matches(method string) bool {
+	if r.Method == "" || r.Method == method {
+		return true
+	}
+	if _, name, ok := strings.Cut(method, "."); ok {
+		return r.Method == name
+	}
+	if handle, name, ok := strings.Cut(r.Method, "."); ok {
+		if prefix, ok := interfacePrefixes[handle]; ok {
+			handle = prefix
+		}
+		return handle+name == method
+	}
+	return false
+}
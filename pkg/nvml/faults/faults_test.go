@@ -0,0 +1,251 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package faults
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/dgxh100"
+)
+
+func TestDeviceRule(t *testing.T) {
+	server := dgxh100.New()
+	lost := server.Devices[1].(*dgxh100.Device).UUID
+	lib, err := New(server, &Config{
+		Rules: []Rule{
+			{Method: "Device.GetMemoryInfo", UUID: lost, After: 2, Return: nvml.ERROR_GPU_IS_LOST},
+		},
+	})
+	require.NoError(t, err)
+
+	device0, ret := lib.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	device1, ret := lib.DeviceGetHandleByIndex(1)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	for call := 0; call < 4; call++ {
+		_, ret = device0.GetMemoryInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+
+		memory, ret := device1.GetMemoryInfo()
+		if call < 2 {
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.NotZero(t, memory.Total)
+		} else {
+			require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+			require.Zero(t, memory)
+		}
+	}
+
+	// The rule also matches the corresponding method of the Interface, which
+	// is not implemented by the mock server but is not called either.
+	_, ret = lib.DeviceGetMemoryInfo(device1)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+
+	// The calls made to get the UUID of the devices are not affected.
+	uuid, ret := device1.GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, lost, uuid)
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	server := dgxh100.New()
+	mockDevice := server.Devices[0].(*dgxh100.Device)
+	injector, err := NewInjector(&Config{
+		Rules: []Rule{
+			{Method: "Device.GetMemoryInfo", UUID: mockDevice.UUID, Return: nvml.ERROR_GPU_IS_LOST},
+		},
+	})
+	require.NoError(t, err)
+	lib := injector.Wrap(server)
+
+	// Getting the UUID is slowed down so that calls from other goroutines are
+	// made while the UUID of the device is being resolved.
+	getUUID := mockDevice.GetUUIDFunc
+	mockDevice.GetUUIDFunc = func() (string, nvml.Return) {
+		time.Sleep(time.Millisecond)
+		return getUUID()
+	}
+
+	device, ret := lib.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	// Faults are injected into the calls made on a device while its UUID is
+	// being resolved for calls made from other goroutines.
+	const goroutines, calls = 8, 20
+	rets := make(chan nvml.Return, goroutines*calls)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for call := 0; call < calls; call++ {
+				_, ret := device.GetMemoryInfo()
+				rets <- ret
+				_, _ = device.GetUUID()
+			}
+		}()
+	}
+	wg.Wait()
+	close(rets)
+
+	for ret := range rets {
+		require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+	}
+	require.Equal(t, []int{goroutines * calls}, injector.Injected())
+}
+
+func TestGpuInstanceRule(t *testing.T) {
+	server := dgxh100.New()
+	injector, err := NewInjector(&Config{
+		Rules: []Rule{
+			{Method: "GetInfo", UUID: server.Devices[0].(*dgxh100.Device).UUID, Return: nvml.ERROR_RESET_REQUIRED},
+		},
+	})
+	require.NoError(t, err)
+	lib := injector.Wrap(server)
+
+	for i, expected := range []nvml.Return{nvml.ERROR_RESET_REQUIRED, nvml.SUCCESS} {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		require.Equal(t, nvml.SUCCESS, ret)
+		info, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+		require.Equal(t, nvml.SUCCESS, ret)
+		gi, ret := device.CreateGpuInstance(&info)
+		require.Equal(t, nvml.SUCCESS, ret)
+
+		_, ret = gi.GetInfo()
+		require.Equal(t, expected, ret)
+	}
+	require.Equal(t, []int{1}, injector.Injected())
+}
+
+func TestTimes(t *testing.T) {
+	lib, err := New(dgxh100.New(), &Config{
+		Rules: []Rule{
+			{Method: "DeviceGetHandleByIndex", Times: 2, Return: nvml.ERROR_TIMEOUT},
+		},
+	})
+	require.NoError(t, err)
+
+	var rets []nvml.Return
+	for call := 0; call < 4; call++ {
+		_, ret := lib.DeviceGetHandleByIndex(0)
+		rets = append(rets, ret)
+	}
+	require.Equal(t, []nvml.Return{nvml.ERROR_TIMEOUT, nvml.ERROR_TIMEOUT, nvml.SUCCESS, nvml.SUCCESS}, rets)
+}
+
+func TestProbability(t *testing.T) {
+	returns := func(seed int64) []nvml.Return {
+		lib, err := New(dgxh100.New(), &Config{
+			Seed: seed,
+			Rules: []Rule{
+				{Method: "DeviceGetCount", Probability: 0.25, Return: nvml.ERROR_TIMEOUT},
+			},
+		})
+		require.NoError(t, err)
+
+		var rets []nvml.Return
+		for call := 0; call < 1000; call++ {
+			_, ret := lib.DeviceGetCount()
+			rets = append(rets, ret)
+		}
+		return rets
+	}
+
+	rets := returns(1)
+	require.Equal(t, rets, returns(1))
+	require.NotEqual(t, rets, returns(2))
+
+	failed := 0
+	for _, ret := range rets {
+		if ret != nvml.SUCCESS {
+			failed++
+		}
+	}
+	require.InDelta(t, 250, failed, 50)
+}
+
+func TestLoadFile(t *testing.T) {
+	config, err := LoadFile("testdata/faults.yaml")
+	require.NoError(t, err)
+	require.Equal(t, &Config{
+		Seed: 42,
+		Rules: []Rule{
+			{
+				Method: "Device.GetTemperature",
+				UUID:   "GPU-7c1d9e2a-5b4f-4e0a-9c3d-2e6f8a1b0c94",
+				After:  10,
+				Return: nvml.ERROR_GPU_IS_LOST,
+			},
+			{
+				Method:      "DeviceGetHandleByIndex",
+				Probability: 0.33,
+				Return:      nvml.ERROR_TIMEOUT,
+			},
+		},
+	}, config)
+
+	config, err = Parse([]byte(`{"rules": [{"method": "DeviceGetCount", "return": "ERROR_UNKNOWN"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, nvml.ERROR_UNKNOWN, config.Rules[0].Return)
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := map[string]string{
+		"no return":             `rules: [{method: DeviceGetCount}]`,
+		"probability":           `rules: [{probability: 2, return: ERROR_TIMEOUT}]`,
+		"invalid Return":        `rules: [{return: ERROR_BROKEN}]`,
+		"field count not found": `rules: [{count: 2, return: ERROR_TIMEOUT}]`,
+		"negative after":        `rules: [{after: -1, return: ERROR_TIMEOUT}]`,
+		"negative times":        `rules: [{times: -1, return: ERROR_TIMEOUT}]`,
+	}
+	for expected, config := range testCases {
+		t.Run(expected, func(t *testing.T) {
+			_, err := Parse([]byte(config))
+			require.ErrorContains(t, err, expected)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	testCases := []struct {
+		rule    string
+		method  string
+		matches bool
+	}{
+		{"", "DeviceGetCount", true},
+		{"DeviceGetCount", "DeviceGetCount", true},
+		{"Device.GetName", "Device.GetName", true},
+		{"Device.GetName", "DeviceGetName", true},
+		{"GetName", "Device.GetName", true},
+		{"GetName", "VgpuTypeId.GetName", true},
+		{"VgpuTypeId.GetName", "VgpuTypeGetName", true},
+		{"GetName", "DeviceGetName", false},
+		{"Device.GetName", "VgpuTypeId.GetName", false},
+		{"DeviceGetName", "Device.GetName", false},
+	}
+	for _, tc := range testCases {
+		r := Rule{Method: tc.rule}
+		require.Equal(t, tc.matches, r.matches(tc.method), "%q matches %q", tc.rule, tc.method)
+	}
+}
@@ -0,0 +1,165 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package faults
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Injector injects the faults described by a config. Its Intercept method is
+// an nvml.Interceptor, which can be combined with other interceptors.
+//
+// To match the UUID of a rule, the injector gets the UUID of the device that
+// a call is made on by calling GetUUID on the device (and GetInfo on GPU and
+// compute instances). These calls are made on the handles unwrapped using
+// nvml.Unwrap, so they are not intercepted and no faults are injected into
+// them.
+type Injector struct {
+	mu     sync.Mutex
+	random *rand.Rand
+	rules  []*rule
+}
+
+// rule is a rule along with the number of calls that it matched and the
+// number of faults that it injected.
+type rule struct {
+	Rule
+	calls    int
+	injected int
+}
+
+// NewInjector returns an Injector that injects the faults described by the
+// specified config.
+func NewInjector(config *Config) (*Injector, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	i := &Injector{
+		random: rand.New(rand.NewSource(config.Seed)),
+	}
+	for _, r := range config.Rules {
+		i.rules = append(i.rules, &rule{Rule: r})
+	}
+	return i, nil
+}
+
+// New returns an Interface that injects the faults described by the
+// specified config into the calls made through the specified Interface.
+func New(lib nvml.Interface, config *Config) (nvml.Interface, error) {
+	i, err := NewInjector(config)
+	if err != nil {
+		return nil, err
+	}
+	return i.Wrap(lib), nil
+}
+
+// Wrap returns an Interface that injects faults into the calls made through
+// the specified Interface, and through the handles that it returns.
+func (i *Injector) Wrap(lib nvml.Interface) nvml.Interface {
+	return nvml.Intercept(lib, i.Intercept)
+}
+
+// Intercept injects a fault into the specified call if a rule matches it, and
+// makes the call otherwise.
+func (i *Injector) Intercept(call nvml.Call, invoke func() nvml.Return) nvml.Return {
+	var uuid string
+	for _, r := range i.rules {
+		if r.UUID != "" && r.matches(call.Method) {
+			uuid = i.uuidOf(call)
+			break
+		}
+	}
+
+	if ret, ok := i.inject(call.Method, uuid); ok {
+		return ret
+	}
+	return invoke()
+}
+
+// inject evaluates the rules for a call to the specified method on the
+// device with the specified UUID, returning the Return of the fault to
+// inject if any.
+func (i *Injector) inject(method string, uuid string) (nvml.Return, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, r := range i.rules {
+		if !r.matches(method) || (r.UUID != "" && r.UUID != uuid) {
+			continue
+		}
+		r.calls++
+		if r.calls <= r.After || (r.Times > 0 && r.injected >= r.Times) {
+			continue
+		}
+		if r.Probability > 0 && r.Probability < 1 && i.random.Float64() >= r.Probability {
+			continue
+		}
+		r.injected++
+		return r.Return, true
+	}
+	return nvml.SUCCESS, false
+}
+
+// Injected returns the number of faults injected by each rule.
+func (i *Injector) Injected() []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var injected []int
+	for _, r := range i.rules {
+		injected = append(injected, r.injected)
+	}
+	return injected
+}
+
+// uuidOf returns the UUID of the device that the specified call is made on,
+// or an empty string if it is not made on a device or its UUID cannot be
+// returned.
+func (i *Injector) uuidOf(call nvml.Call) string {
+	handle := call.Receiver
+	if handle == nil && len(call.Args) > 0 {
+		handle = call.Args[0]
+	}
+
+	var device nvml.Device
+	switch h := nvml.Unwrap(handle).(type) {
+	case nvml.Device:
+		device = h
+	case nvml.GpuInstance:
+		info, ret := h.GetInfo()
+		if ret != nvml.SUCCESS {
+			return ""
+		}
+		device = info.Device
+	case nvml.ComputeInstance:
+		info, ret := h.GetInfo()
+		if ret != nvml.SUCCESS {
+			return ""
+		}
+		device = info.Device
+	}
+	if device == nil {
+		return ""
+	}
+
+	uuid, ret := device.GetUUID()
+	if ret != nvml.SUCCESS {
+		return ""
+	}
+	return uuid
+}
@@ -0,0 +1,204 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"math"
+	"sort"
+	"unsafe"
+)
+
+// FieldScope indicates how the ScopeId of a FieldValue is interpreted for a
+// field.
+type FieldScope int
+
+// The supported FieldScope values.
+const (
+	// FieldScopeDevice indicates that the field pertains to the device as a
+	// whole and that the ScopeId is ignored.
+	FieldScopeDevice FieldScope = iota
+	// FieldScopeLink indicates that the ScopeId is the ID of an NVLink.
+	FieldScopeLink
+	// FieldScopeLane indicates that the ScopeId is the ID of an NVLink lane.
+	FieldScopeLane
+	// FieldScopePower indicates that the ScopeId is a power scope such as
+	// POWER_SCOPE_GPU or POWER_SCOPE_MODULE.
+	FieldScopePower
+)
+
+// String returns the string representation of a FieldScope.
+func (s FieldScope) String() string {
+	switch s {
+	case FieldScopeDevice:
+		return "device"
+	case FieldScopeLink:
+		return "link"
+	case FieldScopeLane:
+		return "lane"
+	case FieldScopePower:
+		return "power"
+	default:
+		return "unknown"
+	}
+}
+
+// FieldInfo describes a field identifier (FI_*) as defined in nvml.h.
+type FieldInfo struct {
+	// Id is the field identifier.
+	Id uint32
+	// Name is the name of the constant that defines the field identifier.
+	Name string
+	// Description is the description of the field from nvml.h.
+	Description string
+	// Unit is the unit of the value of the field (e.g. "mW" or "ns"). This is
+	// empty if the value has no unit or if the unit is not documented.
+	Unit string
+	// Scope indicates how the ScopeId is interpreted for the field.
+	Scope FieldScope
+	// ValueType is the type that the value of the field is expected to be
+	// reported as. This is not declared in nvml.h and is derived from the
+	// name, description, and unit of the field, so the ValueType of each
+	// FieldValue that is returned remains authoritative.
+	ValueType ValueType
+}
+
+// LookupField returns the FieldInfo of a field identifier. This does not
+// require the NVML library to be loaded.
+func LookupField(id uint32) (FieldInfo, bool) {
+	info, ok := fieldInfos[id]
+	return info, ok
+}
+
+// Fields returns the FieldInfo of all field identifiers defined in nvml.h,
+// ordered by identifier.
+func Fields() []FieldInfo {
+	var fields []FieldInfo
+	for _, info := range fieldInfos {
+		fields = append(fields, info)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Id < fields[j].Id
+	})
+	return fields
+}
+
+// FieldRequest identifies a field to query using QueryFields.
+type FieldRequest struct {
+	FieldId uint32
+	ScopeId uint32
+}
+
+// QueryFields queries the specified fields of a device with a single call to
+// nvmlDeviceGetFieldValues. A FieldValue is returned for each request, in the
+// order of the requests. Even if the call succeeds, each field can fail
+// individually, so the Return of each FieldValue must be checked before its
+// value is used.
+func QueryFields(device Device, requests ...FieldRequest) ([]FieldValue, Return) {
+	if len(requests) == 0 {
+		return nil, SUCCESS
+	}
+	values := make([]FieldValue, len(requests))
+	for i, request := range requests {
+		values[i].FieldId = request.FieldId
+		values[i].ScopeId = request.ScopeId
+	}
+	ret := device.GetFieldValues(values)
+	return values, ret
+}
+
+// Return returns the result of querying the field.
+func (v FieldValue) Return() Return {
+	return Return(v.NvmlReturn)
+}
+
+// Float64 returns the value of the field as a float64. Integer values are
+// converted, which may lose precision for values larger than 2^53.
+//
+// If the field could not be queried, its Return is returned. ERROR_UNKNOWN is
+// returned if the ValueType is not recognized.
+func (v FieldValue) Float64() (float64, Return) {
+	if ret := v.Return(); ret != SUCCESS {
+		return 0, ret
+	}
+	switch ValueType(v.ValueType) {
+	case VALUE_TYPE_DOUBLE:
+		return *(*float64)(unsafe.Pointer(&v.Value[0])), SUCCESS
+	case VALUE_TYPE_SIGNED_INT, VALUE_TYPE_SIGNED_LONG_LONG:
+		i, ret := v.Int64()
+		return float64(i), ret
+	default:
+		u, ret := v.Uint64()
+		return float64(u), ret
+	}
+}
+
+// Uint64 returns the value of the field as a uint64.
+//
+// If the field could not be queried, its Return is returned. ERROR_NOT_SUPPORTED
+// is returned if the value is a double or a negative signed integer, and
+// ERROR_UNKNOWN is returned if the ValueType is not recognized.
+func (v FieldValue) Uint64() (uint64, Return) {
+	if ret := v.Return(); ret != SUCCESS {
+		return 0, ret
+	}
+	switch ValueType(v.ValueType) {
+	case VALUE_TYPE_UNSIGNED_INT:
+		return uint64(*(*uint32)(unsafe.Pointer(&v.Value[0]))), SUCCESS
+	case VALUE_TYPE_UNSIGNED_LONG, VALUE_TYPE_UNSIGNED_LONG_LONG:
+		return *(*uint64)(unsafe.Pointer(&v.Value[0])), SUCCESS
+	case VALUE_TYPE_UNSIGNED_SHORT:
+		return uint64(*(*uint16)(unsafe.Pointer(&v.Value[0]))), SUCCESS
+	case VALUE_TYPE_SIGNED_INT, VALUE_TYPE_SIGNED_LONG_LONG:
+		i, _ := v.Int64()
+		if i < 0 {
+			return 0, ERROR_NOT_SUPPORTED
+		}
+		return uint64(i), SUCCESS
+	case VALUE_TYPE_DOUBLE:
+		return 0, ERROR_NOT_SUPPORTED
+	default:
+		return 0, ERROR_UNKNOWN
+	}
+}
+
+// Int64 returns the value of the field as an int64.
+//
+// If the field could not be queried, its Return is returned. ERROR_NOT_SUPPORTED
+// is returned if the value is a double or an unsigned integer larger than
+// math.MaxInt64, and ERROR_UNKNOWN is returned if the ValueType is not
+// recognized.
+func (v FieldValue) Int64() (int64, Return) {
+	if ret := v.Return(); ret != SUCCESS {
+		return 0, ret
+	}
+	switch ValueType(v.ValueType) {
+	case VALUE_TYPE_SIGNED_INT:
+		return int64(*(*int32)(unsafe.Pointer(&v.Value[0]))), SUCCESS
+	case VALUE_TYPE_SIGNED_LONG_LONG:
+		return *(*int64)(unsafe.Pointer(&v.Value[0])), SUCCESS
+	case VALUE_TYPE_UNSIGNED_INT, VALUE_TYPE_UNSIGNED_LONG, VALUE_TYPE_UNSIGNED_LONG_LONG, VALUE_TYPE_UNSIGNED_SHORT:
+		u, _ := v.Uint64()
+		if u > math.MaxInt64 {
+			return 0, ERROR_NOT_SUPPORTED
+		}
+		return int64(u), SUCCESS
+	case VALUE_TYPE_DOUBLE:
+		return 0, ERROR_NOT_SUPPORTED
+	default:
+		return 0, ERROR_UNKNOWN
+	}
+}
@@ -0,0 +1,259 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldCatalog(t *testing.T) {
+	testCases := []struct {
+		id       uint32
+		expected FieldInfo
+	}{
+		{
+			id: FI_DEV_ECC_CURRENT,
+			expected: FieldInfo{
+				Id:          FI_DEV_ECC_CURRENT,
+				Name:        "FI_DEV_ECC_CURRENT",
+				Description: "Current ECC mode. 1=Active. 0=Inactive",
+				Scope:       FieldScopeDevice,
+				ValueType:   VALUE_TYPE_UNSIGNED_INT,
+			},
+		},
+		{
+			id: FI_DEV_NVLINK_THROUGHPUT_DATA_TX,
+			expected: FieldInfo{
+				Id:          FI_DEV_NVLINK_THROUGHPUT_DATA_TX,
+				Name:        "FI_DEV_NVLINK_THROUGHPUT_DATA_TX",
+				Description: "NVLink TX Data throughput in KiB",
+				Unit:        "KiB",
+				Scope:       FieldScopeLink,
+				ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+			},
+		},
+		{
+			id: FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL,
+			expected: FieldInfo{
+				Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL,
+				Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL",
+				Description: "NVLink data ECC Error Counter total for all Links",
+				Scope:       FieldScopeLane,
+				ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+			},
+		},
+		{
+			id: FI_DEV_NVLINK_GET_POWER_THRESHOLD,
+			expected: FieldInfo{
+				Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD,
+				Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD",
+				Description: "NVLink length of idle period (units can be found from NVML_FI_DEV_NVLINK_GET_POWER_THRESHOLD_UNITS) before transitioning links to sleep state",
+				Scope:       FieldScopeDevice,
+				ValueType:   VALUE_TYPE_UNSIGNED_INT,
+			},
+		},
+		{
+			id: FI_DEV_POWER_INSTANT,
+			expected: FieldInfo{
+				Id:          FI_DEV_POWER_INSTANT,
+				Name:        "FI_DEV_POWER_INSTANT",
+				Description: "Current GPU power, supported on all architectures.",
+				Unit:        "mW",
+				Scope:       FieldScopePower,
+				ValueType:   VALUE_TYPE_UNSIGNED_INT,
+			},
+		},
+		{
+			id: FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS,
+			expected: FieldInfo{
+				Id:          FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS,
+				Name:        "FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS",
+				Description: "Number of times link went from Up to recovery, irrespective of the result",
+				Scope:       FieldScopeLink,
+				ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+			},
+		},
+		{
+			id: FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN,
+			expected: FieldInfo{
+				Id:          FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN,
+				Name:        "FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN",
+				Description: "Throttling due to temperature being too high (reducing core clocks by a factor of 2 or more) in ns",
+				Unit:        "ns",
+				Scope:       FieldScopeDevice,
+				ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+			},
+		},
+		{
+			id: FI_DEV_POWER_SYNC_BALANCING_FREQ,
+			expected: FieldInfo{
+				Id:          FI_DEV_POWER_SYNC_BALANCING_FREQ,
+				Name:        "FI_DEV_POWER_SYNC_BALANCING_FREQ",
+				Description: "Accumulated frequency of the GPU to be used for averaging",
+				Scope:       FieldScopeDevice,
+				ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected.Name, func(t *testing.T) {
+			info, ok := LookupField(tc.id)
+			require.True(t, ok)
+			require.Equal(t, tc.expected, info)
+		})
+	}
+
+	_, ok := LookupField(FI_MAX)
+	require.False(t, ok)
+}
+
+func TestFieldsCoverAllIdentifiers(t *testing.T) {
+	fields := Fields()
+	require.Len(t, fields, FI_MAX-1)
+	for i, info := range fields {
+		require.Equal(t, uint32(i+1), info.Id)
+	}
+}
+
+func TestFieldValueDecoding(t *testing.T) {
+	newValue := func(valueType ValueType, value interface{}) FieldValue {
+		v := FieldValue{ValueType: uint32(valueType)}
+		switch value := value.(type) {
+		case float64:
+			*(*float64)(unsafe.Pointer(&v.Value[0])) = value
+		case uint32:
+			*(*uint32)(unsafe.Pointer(&v.Value[0])) = value
+		case uint64:
+			*(*uint64)(unsafe.Pointer(&v.Value[0])) = value
+		case int64:
+			*(*int64)(unsafe.Pointer(&v.Value[0])) = value
+		case int32:
+			*(*int32)(unsafe.Pointer(&v.Value[0])) = value
+		case uint16:
+			*(*uint16)(unsafe.Pointer(&v.Value[0])) = value
+		}
+		return v
+	}
+
+	type decoded struct {
+		float64 float64
+		uint64  uint64
+		int64   int64
+		returns [3]Return
+	}
+
+	testCases := []struct {
+		description string
+		value       FieldValue
+		expected    decoded
+	}{
+		{
+			description: "double",
+			value:       newValue(VALUE_TYPE_DOUBLE, 1.5),
+			expected:    decoded{float64: 1.5, returns: [3]Return{SUCCESS, ERROR_NOT_SUPPORTED, ERROR_NOT_SUPPORTED}},
+		},
+		{
+			description: "unsigned int",
+			value:       newValue(VALUE_TYPE_UNSIGNED_INT, uint32(math.MaxUint32)),
+			expected:    decoded{float64: math.MaxUint32, uint64: math.MaxUint32, int64: math.MaxUint32},
+		},
+		{
+			description: "unsigned long",
+			value:       newValue(VALUE_TYPE_UNSIGNED_LONG, uint64(42)),
+			expected:    decoded{float64: 42, uint64: 42, int64: 42},
+		},
+		{
+			description: "unsigned long long larger than max int64",
+			value:       newValue(VALUE_TYPE_UNSIGNED_LONG_LONG, uint64(math.MaxUint64)),
+			expected:    decoded{float64: math.MaxUint64, uint64: math.MaxUint64, returns: [3]Return{SUCCESS, SUCCESS, ERROR_NOT_SUPPORTED}},
+		},
+		{
+			description: "negative signed long long",
+			value:       newValue(VALUE_TYPE_SIGNED_LONG_LONG, int64(-7)),
+			expected:    decoded{float64: -7, int64: -7, returns: [3]Return{SUCCESS, ERROR_NOT_SUPPORTED, SUCCESS}},
+		},
+		{
+			description: "signed int",
+			value:       newValue(VALUE_TYPE_SIGNED_INT, int32(7)),
+			expected:    decoded{float64: 7, uint64: 7, int64: 7},
+		},
+		{
+			description: "unsigned short",
+			value:       newValue(VALUE_TYPE_UNSIGNED_SHORT, uint16(math.MaxUint16)),
+			expected:    decoded{float64: math.MaxUint16, uint64: math.MaxUint16, int64: math.MaxUint16},
+		},
+		{
+			description: "unknown value type",
+			value:       newValue(VALUE_TYPE_COUNT, uint64(1)),
+			expected:    decoded{returns: [3]Return{ERROR_UNKNOWN, ERROR_UNKNOWN, ERROR_UNKNOWN}},
+		},
+		{
+			description: "field not supported",
+			value: func() FieldValue {
+				v := newValue(VALUE_TYPE_UNSIGNED_INT, uint32(1))
+				v.NvmlReturn = uint32(ERROR_NOT_SUPPORTED)
+				return v
+			}(),
+			expected: decoded{returns: [3]Return{ERROR_NOT_SUPPORTED, ERROR_NOT_SUPPORTED, ERROR_NOT_SUPPORTED}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var d decoded
+			d.float64, d.returns[0] = tc.value.Float64()
+			d.uint64, d.returns[1] = tc.value.Uint64()
+			d.int64, d.returns[2] = tc.value.Int64()
+			require.Equal(t, tc.expected, d)
+		})
+	}
+}
+
+func TestQueryFields(t *testing.T) {
+	device := &countingDevice{}
+	values, ret := QueryFields(device,
+		FieldRequest{FieldId: FI_DEV_ECC_CURRENT},
+		FieldRequest{FieldId: FI_DEV_NVLINK_GET_SPEED, ScopeId: 3},
+	)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 1, device.calls)
+	require.Len(t, values, 2)
+	require.Equal(t, uint32(FI_DEV_ECC_CURRENT), values[0].FieldId)
+	require.Equal(t, uint32(FI_DEV_NVLINK_GET_SPEED), values[1].FieldId)
+	require.Equal(t, uint32(3), values[1].ScopeId)
+
+	values, ret = QueryFields(device)
+	require.Equal(t, SUCCESS, ret)
+	require.Empty(t, values)
+	require.Equal(t, 1, device.calls)
+}
+
+// countingDevice counts the calls to GetFieldValues.
+type countingDevice struct {
+	nvmlDevice
+	calls int
+}
+
+func (d *countingDevice) GetFieldValues(values []FieldValue) Return {
+	d.calls++
+	return SUCCESS
+}
@@ -0,0 +1,94 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// callGateOpen is set in the state of a callGate while calls are allowed.
+const callGateOpen = 1 << 62
+
+// callGate ensures that calls into the NVML library are only made while a
+// library is loaded, and that a library is not closed while calls into it are
+// in progress. Each library has its own call gate, which is entered by the
+// gated functions in zz_generated.gate.go.
+type callGate struct {
+	// state holds the number of calls in progress, with callGateOpen set
+	// while the library is loaded.
+	state atomic.Int64
+	// idle is signalled once the last call in progress returns after the
+	// gate was closed.
+	mu   sync.Mutex
+	idle sync.Cond
+}
+
+// open allows calls to be made through the gate.
+func (g *callGate) open() {
+	g.state.Add(callGateOpen)
+}
+
+// enter is called before calling into the NVML library. If this returns true,
+// exit must be called once the call returns. If the gate is closed, this
+// returns false without waiting and the call must not be made.
+func (g *callGate) enter() bool {
+	for {
+		state := g.state.Load()
+		if state&callGateOpen == 0 {
+			return false
+		}
+		if g.state.CompareAndSwap(state, state+1) {
+			return true
+		}
+	}
+}
+
+// exit is called once a call into the NVML library has returned.
+func (g *callGate) exit() {
+	if g.state.Add(-1) == 0 {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.idle.Broadcast()
+	}
+}
+
+// close stops new calls from being made through the gate and waits for the
+// calls in progress to return.
+func (g *callGate) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.idle.L == nil {
+		g.idle.L = &g.mu
+	}
+	for state := g.state.Add(-callGateOpen); state != 0; state = g.state.Load() {
+		g.idle.Wait()
+	}
+}
+
+// enter enters the call gate of the earliest loaded library that is not being
+// closed. This returns nil if there is no such library.
+func (r *libraryRegistry) enter() *callGate {
+	r.RLock()
+	defer r.RUnlock()
+	for _, l := range r.libraries {
+		if l.gate.enter() {
+			return &l.gate
+		}
+	}
+	return nil
+}
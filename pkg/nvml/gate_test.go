@@ -0,0 +1,105 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallGate(t *testing.T) {
+	defer setLoadedLibrariesForTest()()
+
+	require.Nil(t, loadedLibraries.enter(), "calls are not allowed without a loaded library")
+
+	newDl := func() *dynamicLibraryMock {
+		return &dynamicLibraryMock{
+			OpenFunc: func() error {
+				return nil
+			},
+			LookupFunc: func(s string) error {
+				return nil
+			},
+			CloseFunc: func() error {
+				return nil
+			},
+		}
+	}
+
+	dl := newDl()
+	l := newTestLibrary(dl)
+	require.NoError(t, l.load())
+
+	gate := loadedLibraries.enter()
+	require.Same(t, &l.gate, gate)
+
+	closed := make(chan error)
+	go func() {
+		closed <- l.close()
+	}()
+
+	// Calls made while the library is being closed fail without waiting for
+	// the calls in progress.
+	require.Eventually(t, func() bool {
+		gate := loadedLibraries.enter()
+		if gate != nil {
+			defer gate.exit()
+		}
+		return gate == nil
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-closed:
+		t.Fatal("library closed while a call was in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Empty(t, dl.CloseCalls())
+
+	gate.exit()
+	require.NoError(t, <-closed)
+	require.Len(t, dl.CloseCalls(), 1)
+
+	require.Nil(t, loadedLibraries.enter(), "calls are not allowed once the library is closed")
+
+	// Calls are allowed again once the library is loaded again.
+	require.NoError(t, l.load())
+	require.Same(t, &l.gate, loadedLibraries.enter())
+	l.gate.exit()
+
+	// Calls made while a library is being closed use another loaded library.
+	other := newTestLibrary(newDl())
+	require.NoError(t, other.load())
+	defer other.close()
+
+	gate = loadedLibraries.enter()
+	require.Same(t, &l.gate, gate)
+	go func() {
+		closed <- l.close()
+	}()
+	require.Eventually(t, func() bool {
+		gate := loadedLibraries.enter()
+		if gate != nil {
+			defer gate.exit()
+		}
+		return gate == &other.gate
+	}, time.Second, time.Millisecond)
+
+	gate.exit()
+	require.NoError(t, <-closed)
+}
@@ -27,8 +27,8 @@ func (g *GpmMetricsGetType) convert() *nvmlGpmMetricsGetType {
 	out := &nvmlGpmMetricsGetType{
 		Version:    g.Version,
 		NumMetrics: g.NumMetrics,
-		Sample1:    g.Sample1.(nvmlGpmSample),
-		Sample2:    g.Sample2.(nvmlGpmSample),
+		Sample1:    unwrapGpmSample(g.Sample1).(nvmlGpmSample),
+		Sample2:    unwrapGpmSample(g.Sample2).(nvmlGpmSample),
 	}
 	copy(out.Metrics[:], g.Metrics[:])
 
@@ -14,14 +14,15 @@
 
 package nvml
 
-import "C"
-
 // nvml.Init()
 func (l *library) Init() Return {
 	if err := l.load(); err != nil {
 		return ERROR_LIBRARY_NOT_FOUND
 	}
-	return nvmlInit()
+	if l.initFlags != 0 {
+		return nvmlInitWithFlags(l.initFlags)
+	}
+	return l.loadedSymbols().nvmlInit()
 }
 
 // nvml.InitWithFlags()
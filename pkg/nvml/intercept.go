@@ -0,0 +1,142 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// Call describes a call to a method of an Interface or of one of its handles
+// that is passed to an Interceptor.
+type Call struct {
+	// Method is the name of the method. Methods of handles are qualified by
+	// the name of the handle type, e.g. "DeviceGetHandleByIndex" for a call
+	// to the Interface and "Device.GetName" for a call to a Device.
+	Method string
+	// Receiver is the handle that the method is called on, or nil for calls
+	// to the Interface.
+	Receiver interface{}
+	// Args are the arguments of the call, as passed by the caller.
+	Args []interface{}
+}
+
+// Interceptor is called for each call to a method that returns a Return.
+// The call is made by calling invoke, which returns the Return of the call.
+// The Return that is returned by the Interceptor is returned to the caller.
+//
+// An Interceptor can therefore inspect (e.g. log or time) a call, and can
+// also replace its Return or not make the call at all, in which case the
+// other values returned to the caller are zero.
+type Interceptor func(call Call, invoke func() Return) Return
+
+// Intercept returns an Interface that calls the specified interceptors for
+// each call to a method of the Interface that returns a Return. Handles (e.g.
+// a Device) returned by the Interface, or by its handles, are wrapped so that
+// calls to their methods are also intercepted. The interceptors are called
+// in the order in which they are specified, with each interceptor calling
+// the next one when it invokes the call.
+//
+// Methods that do not return a Return, such as ErrorString or the methods
+// returning a handler for a versioned call (e.g. DeviceGetTemperatureV), are
+// not intercepted.
+func Intercept(lib Interface, interceptors ...Interceptor) Interface {
+	if len(interceptors) == 0 {
+		return lib
+	}
+	return &interceptor{
+		lib:       lib,
+		intercept: chainInterceptors(interceptors),
+	}
+}
+
+// chainInterceptors returns an Interceptor that calls each of the specified
+// interceptors in order.
+func chainInterceptors(interceptors []Interceptor) Interceptor {
+	if len(interceptors) == 1 {
+		return interceptors[0]
+	}
+	first, rest := interceptors[0], chainInterceptors(interceptors[1:])
+	return func(call Call, invoke func() Return) Return {
+		return first(call, func() Return {
+			return rest(call, invoke)
+		})
+	}
+}
+
+// interceptor wraps an Interface and calls an Interceptor for each call. The
+// methods of the interceptor and of the intercepted handles are generated in
+// zz_generated.intercept.go.
+type interceptor struct {
+	lib       Interface
+	intercept Interceptor
+}
+
+// nvml.GpmMetricsGet()
+//
+// The samples in metricsGet are passed to the library unwrapped and restored
+// after the call.
+func (i *interceptor) GpmMetricsGet(metricsGet *GpmMetricsGetType) Return {
+	call := Call{Method: "GpmMetricsGet", Args: []interface{}{metricsGet}}
+	return i.intercept(call, func() Return {
+		sample1, sample2 := metricsGet.Sample1, metricsGet.Sample2
+		metricsGet.Sample1, metricsGet.Sample2 = unwrapGpmSample(sample1), unwrapGpmSample(sample2)
+		ret := i.lib.GpmMetricsGet(metricsGet)
+		metricsGet.Sample1, metricsGet.Sample2 = sample1, sample2
+		return ret
+	})
+}
+
+// nvml.GpmMetricsGetV()
+//
+// The call made using the returned GpmMetricsGetVType is not intercepted. The
+// samples in metricsGet are unwrapped when it is made.
+func (i *interceptor) GpmMetricsGetV(metricsGet *GpmMetricsGetType) GpmMetricsGetVType {
+	return i.lib.GpmMetricsGetV(metricsGet)
+}
+
+func (i *interceptor) wrapGpuInstanceInfo(info GpuInstanceInfo) GpuInstanceInfo {
+	info.Device = i.wrapDevice(info.Device)
+	return info
+}
+
+func (i *interceptor) wrapComputeInstanceInfo(info ComputeInstanceInfo) ComputeInstanceInfo {
+	info.Device = i.wrapDevice(info.Device)
+	info.GpuInstance = i.wrapGpuInstance(info.GpuInstance)
+	return info
+}
+
+func (i *interceptor) wrapEventData(data EventData) EventData {
+	data.Device = i.wrapDevice(data.Device)
+	return data
+}
+
+// wrapHandles wraps each of the specified handles in place.
+func wrapHandles[T any](handles []T, wrap func(T) T) []T {
+	for j, handle := range handles {
+		handles[j] = wrap(handle)
+	}
+	return handles
+}
+
+// unwrapHandles returns the handles to pass to the wrapped Interface. The
+// specified handles are not modified.
+func unwrapHandles[T any](handles []T, unwrap func(T) T) []T {
+	if handles == nil {
+		return nil
+	}
+	unwrapped := make([]T, len(handles))
+	for j, handle := range handles {
+		unwrapped[j] = unwrap(handle)
+	}
+	return unwrapped
+}
@@ -0,0 +1,165 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// interceptedInterface returns a device and records the devices passed to it.
+type interceptedInterface struct {
+	Interface
+	device   *interceptedTestDevice
+	received []Device
+}
+
+func (l *interceptedInterface) DeviceGetHandleByIndex(index int) (Device, Return) {
+	return l.device, SUCCESS
+}
+
+func (l *interceptedInterface) DeviceGetName(device Device) (string, Return) {
+	l.received = append(l.received, device)
+	return "Name", SUCCESS
+}
+
+func (l *interceptedInterface) GpuInstanceGetInfo(gpuInstance GpuInstance) (GpuInstanceInfo, Return) {
+	return GpuInstanceInfo{Device: l.device, Id: 1}, SUCCESS
+}
+
+type interceptedTestDevice struct {
+	Device
+	peer     *interceptedTestDevice
+	received []Device
+}
+
+func (d *interceptedTestDevice) GetName() (string, Return) {
+	return "Name", SUCCESS
+}
+
+func (d *interceptedTestDevice) GetTopologyNearestGpus(level GpuTopologyLevel) ([]Device, Return) {
+	return []Device{d.peer}, SUCCESS
+}
+
+func (d *interceptedTestDevice) GetP2PStatus(device Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	d.received = append(d.received, device)
+	return P2P_STATUS_OK, SUCCESS
+}
+
+func TestIntercept(t *testing.T) {
+	peer := &interceptedTestDevice{}
+	impl := &interceptedInterface{device: &interceptedTestDevice{peer: peer}}
+
+	var calls []Call
+	lib := Intercept(impl, func(call Call, invoke func() Return) Return {
+		calls = append(calls, call)
+		return invoke()
+	})
+
+	device, ret := lib.DeviceGetHandleByIndex(0)
+	require.Equal(t, SUCCESS, ret)
+	require.IsType(t, &interceptedDevice{}, device)
+	require.Equal(t, []Call{{Method: "DeviceGetHandleByIndex", Args: []interface{}{0}}}, calls)
+
+	name, ret := device.GetName()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, "Name", name)
+	require.Equal(t, Call{Method: "Device.GetName", Receiver: device}, calls[1])
+
+	// Handles are unwrapped when these are passed to the Interface.
+	_, ret = lib.DeviceGetName(device)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []Device{impl.device}, impl.received)
+	require.Equal(t, Call{Method: "DeviceGetName", Args: []interface{}{device}}, calls[2])
+
+	// Handles returned by handles are wrapped, and unwrapped when these are
+	// passed to a handle.
+	peers, ret := device.GetTopologyNearestGpus(TOPOLOGY_INTERNAL)
+	require.Equal(t, SUCCESS, ret)
+	require.Len(t, peers, 1)
+	require.IsType(t, &interceptedDevice{}, peers[0])
+
+	status, ret := device.GetP2PStatus(peers[0], P2P_CAPS_INDEX_READ)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, P2P_STATUS_OK, status)
+	require.Equal(t, []Device{peer}, impl.device.received)
+	require.Equal(t, "Device.GetP2PStatus", calls[4].Method)
+	require.Equal(t, []interface{}{peers[0], P2P_CAPS_INDEX_READ}, calls[4].Args)
+
+	// Handles in returned structs are wrapped.
+	info, ret := lib.GpuInstanceGetInfo(nil)
+	require.Equal(t, SUCCESS, ret)
+	require.IsType(t, &interceptedDevice{}, info.Device)
+	require.Len(t, calls, 6)
+
+	// Calls made on unwrapped handles are not intercepted.
+	require.Same(t, impl.device, Unwrap(device))
+	require.Equal(t, 1, Unwrap(1))
+	_, ret = Unwrap(device).(Device).GetName()
+	require.Equal(t, SUCCESS, ret)
+	require.Len(t, calls, 6)
+}
+
+func TestInterceptorChain(t *testing.T) {
+	impl := &interceptedInterface{device: &interceptedTestDevice{}}
+
+	var log []string
+	logger := func(name string) Interceptor {
+		return func(call Call, invoke func() Return) Return {
+			log = append(log, name+" before "+call.Method)
+			ret := invoke()
+			log = append(log, name+" after "+ret.String())
+			return ret
+		}
+	}
+	injector := func(call Call, invoke func() Return) Return {
+		if call.Method == "Device.GetName" {
+			return ERROR_GPU_IS_LOST
+		}
+		return invoke()
+	}
+
+	lib := New(
+		WithImplementation(impl),
+		WithInterceptor(logger("first")),
+		WithInterceptor(logger("second")),
+		WithInterceptor(injector),
+	)
+
+	device, ret := lib.DeviceGetHandleByIndex(0)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []string{
+		"first before DeviceGetHandleByIndex",
+		"second before DeviceGetHandleByIndex",
+		"second after SUCCESS",
+		"first after SUCCESS",
+	}, log)
+
+	log = nil
+	name, ret := device.GetName()
+	require.Equal(t, ERROR_GPU_IS_LOST, ret)
+	require.Equal(t, "", name)
+	require.Equal(t, []string{
+		"first before Device.GetName",
+		"second before Device.GetName",
+		"second after ERROR_GPU_IS_LOST",
+		"first after ERROR_GPU_IS_LOST",
+	}, log)
+
+	require.Same(t, impl, New(WithImplementation(impl)))
+}
@@ -24,8 +24,6 @@ import (
 	"github.com/NVIDIA/go-nvml/pkg/dl"
 )
 
-import "C"
-
 const (
 	defaultNvmlLibraryName      = "libnvidia-ml.so.1"
 	defaultNvmlLibraryLoadFlags = dl.RTLD_LAZY | dl.RTLD_GLOBAL
@@ -46,11 +44,27 @@ type dynamicLibrary interface {
 
 // library represents an nvml library.
 // This includes a reference to the underlying DynamicLibrary
+//
+// Only one libnvidia-ml.so can be loaded at a time. Since the library is
+// loaded with RTLD_GLOBAL and the cgo bindings resolve the NVML symbols
+// process-wide, all calls are made to the first libnvidia-ml.so that was
+// loaded, even if another library instance loaded a different one. Multiple
+// library instances that load the same libnvidia-ml.so can be used at the
+// same time.
 type library struct {
 	sync.Mutex
-	path     string
-	refcount refcount
-	dl       dynamicLibrary
+	path      string
+	initFlags uint32
+	refcount  refcount
+	dl        dynamicLibrary
+	// gate is open while the library is loaded.
+	gate callGate
+	// negotiatedVersions caches the struct versions used by the handles while
+	// the library is loaded.
+	negotiatedVersions versionCache
+	// symbols is updated when the library is loaded. This is guarded by the
+	// mutex, so the loadedSymbols method is used to read it.
+	symbols versionedSymbols
 }
 
 var _ Interface = (*library)(nil)
@@ -59,11 +73,27 @@ var _ Interface = (*library)(nil)
 var libnvml = newLibrary()
 
 func New(opts ...LibraryOption) Interface {
+	o := libraryOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return Intercept(newImplementation(o, opts...), o.interceptors...)
+}
+
+func newImplementation(o libraryOptions, opts ...LibraryOption) Interface {
+	if o.implementation != nil {
+		return o.implementation
+	}
+	if o.reinit {
+		return newSupervisor(newLibrary(opts...), o.reinitCallback)
+	}
 	return newLibrary(opts...)
 }
 
 func newLibrary(opts ...LibraryOption) *library {
-	l := &library{}
+	l := &library{
+		symbols: defaultVersionedSymbols,
+	}
 	l.init(opts...)
 	return l
 }
@@ -82,7 +112,8 @@ func (l *library) init(opts ...LibraryOption) {
 	}
 
 	l.path = o.path
-	l.dl = dl.New(o.path, o.flags)
+	l.initFlags = o.initFlags
+	l.dl = newCandidateLibrary(o)
 }
 
 func (l *library) Extensions() ExtendedInterface {
@@ -98,6 +129,19 @@ func (l *library) LookupSymbol(name string) error {
 	return l.dl.Lookup(name)
 }
 
+// LibraryPath returns the path of the library that was opened. If driver
+// roots or search paths were specified, this is the candidate that was
+// successfully loaded. Note that this requires that the library be loaded.
+func (l *library) LibraryPath() (string, error) {
+	if l == nil || l.refcount == 0 {
+		return "", fmt.Errorf("error getting library path: %w", errLibraryNotLoaded)
+	}
+	if c, ok := l.dl.(interface{ Path() string }); ok {
+		return c.Path(), nil
+	}
+	return l.path, nil
+}
+
 // load initializes the library and updates the versioned symbols.
 // Multiple calls to an already loaded library will return without error.
 func (l *library) load() (rerr error) {
@@ -113,12 +157,13 @@ func (l *library) load() (rerr error) {
 		return fmt.Errorf("error opening %s: %w", l.path, err)
 	}
 
-	// Update the errorStringFunc to point to nvml.ErrorString
-	errorStringFunc = nvmlErrorString
-
 	// Update all versioned symbols
 	l.updateVersionedSymbols()
 
+	// Make the symbols of this library available to the handle types
+	l.gate.open()
+	loadedLibraries.add(l)
+
 	return nil
 }
 
@@ -134,36 +179,94 @@ func (l *library) close() (rerr error) {
 		return nil
 	}
 
+	// Wait for in-flight calls to return before closing the library. Calls
+	// made while the library is being closed are made through another loaded
+	// library, or return ERROR_UNINITIALIZED if there is none.
+	l.gate.close()
 	if err := l.dl.Close(); err != nil {
+		l.gate.open()
 		return fmt.Errorf("error closing %s: %w", l.path, err)
 	}
+	loadedLibraries.remove(l)
+	l.negotiatedVersions.clear()
+	return nil
+}
+
+// loadedSymbols returns the versioned symbols bound for the library.
+func (l *library) loadedSymbols() versionedSymbols {
+	l.Lock()
+	defer l.Unlock()
+	return l.symbols
+}
 
-	// Update the errorStringFunc to point to defaultErrorStringFunc
-	errorStringFunc = defaultErrorStringFunc
+// loadedLibraries tracks the library instances that are currently loaded.
+var loadedLibraries libraryRegistry
 
-	return nil
+// libraryRegistry tracks loaded libraries in the order in which they were loaded.
+type libraryRegistry struct {
+	sync.RWMutex
+	libraries []*library
+}
+
+// add registers a loaded library and updates the errorStringFunc to call
+// nvml.ErrorString.
+func (r *libraryRegistry) add(l *library) {
+	r.Lock()
+	defer r.Unlock()
+	r.libraries = append(r.libraries, l)
+	errorStringFunc = libraryErrorStringFunc
+}
+
+// remove unregisters a library. Once no libraries are loaded the
+// errorStringFunc is reset to point to defaultErrorStringFunc.
+func (r *libraryRegistry) remove(l *library) {
+	r.Lock()
+	defer r.Unlock()
+	for i, loaded := range r.libraries {
+		if loaded == l {
+			r.libraries = append(r.libraries[:i], r.libraries[i+1:]...)
+			break
+		}
+	}
+	if len(r.libraries) == 0 {
+		errorStringFunc = defaultErrorStringFunc
+	}
+}
+
+// isLoaded checks whether any library is loaded.
+func (r *libraryRegistry) isLoaded() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.libraries) > 0
+}
+
+// first returns the earliest loaded library that is still loaded, or nil if
+// no library is loaded.
+func (r *libraryRegistry) first() *library {
+	r.RLock()
+	defer r.RUnlock()
+	if len(r.libraries) == 0 {
+		return nil
+	}
+	return r.libraries[0]
+}
+
+// boundSymbols returns the versioned symbols used by the handle types (e.g.
+// Device, EventSet, etc.). These are not associated with a specific library
+// instance. Since the NVML symbols referenced by the bindings are resolved
+// process-wide, with the first library loaded taking precedence, the symbols
+// of the earliest loaded library are used.
+func boundSymbols() versionedSymbols {
+	// The lock of the registry is released before the symbols are read,
+	// since a library holds its own lock when it is added to the registry.
+	if l := loadedLibraries.first(); l != nil {
+		return l.loadedSymbols()
+	}
+	return defaultVersionedSymbols
 }
 
-// Default all versioned APIs to v1 (to infer the types)
-var nvmlInit = nvmlInit_v1
-var nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v1
-var nvmlDeviceGetCount = nvmlDeviceGetCount_v1
-var nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v1
-var nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v1
-var nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v1
-var nvmlDeviceRemoveGpu = nvmlDeviceRemoveGpu_v1
-var nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v1
-var nvmlEventSetWait = nvmlEventSetWait_v1
-var nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v1
-var nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v1
-var deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v1
-var deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v1
-var deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v1
 var GetBlacklistDeviceCount = GetExcludedDeviceCount
 var GetBlacklistDeviceInfoByIndex = GetExcludedDeviceInfoByIndex
-var nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v1
-var nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v1
-var nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v1
 
 // BlacklistDeviceInfo was replaced by ExcludedDeviceInfo
 type BlacklistDeviceInfo = ExcludedDeviceInfo
@@ -193,104 +296,3 @@ func (pis ProcessInfo_v2Slice) ToProcessInfoSlice() []ProcessInfo {
 	}
 	return newInfos
 }
-
-// updateVersionedSymbols checks for versioned symbols in the loaded dynamic library.
-// If newer versioned symbols exist, these replace the default `v1` symbols initialized above.
-// When new versioned symbols are added, these would have to be initialized above and have
-// corresponding checks and subsequent assignments added below.
-func (l *library) updateVersionedSymbols() {
-	err := l.dl.Lookup("nvmlInit_v2")
-	if err == nil {
-		nvmlInit = nvmlInit_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetPciInfo_v2")
-	if err == nil {
-		nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetPciInfo_v3")
-	if err == nil {
-		nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetCount_v2")
-	if err == nil {
-		nvmlDeviceGetCount = nvmlDeviceGetCount_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetHandleByIndex_v2")
-	if err == nil {
-		nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetHandleByPciBusId_v2")
-	if err == nil {
-		nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetNvLinkRemotePciInfo_v2")
-	if err == nil {
-		nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v2
-	}
-	// Unable to overwrite nvmlDeviceRemoveGpu() because the v2 function takes
-	// a different set of parameters than the v1 function.
-	//err = l.dl.Lookup("nvmlDeviceRemoveGpu_v2")
-	//if err == nil {
-	//    nvmlDeviceRemoveGpu = nvmlDeviceRemoveGpu_v2
-	//}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v2")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v3")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v4")
-	if err == nil {
-		nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v4
-	}
-	err = l.dl.Lookup("nvmlEventSetWait_v2")
-	if err == nil {
-		nvmlEventSetWait = nvmlEventSetWait_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetAttributes_v2")
-	if err == nil {
-		nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v2
-	}
-	err = l.dl.Lookup("nvmlComputeInstanceGetInfo_v2")
-	if err == nil {
-		nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v2")
-	if err == nil {
-		deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v3")
-	if err == nil {
-		deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v2")
-	if err == nil {
-		deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v3")
-	if err == nil {
-		deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v2")
-	if err == nil {
-		deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v3")
-	if err == nil {
-		deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v3
-	}
-	err = l.dl.Lookup("nvmlDeviceGetGpuInstancePossiblePlacements_v2")
-	if err == nil {
-		nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v2
-	}
-	err = l.dl.Lookup("nvmlVgpuInstanceGetLicenseInfo_v2")
-	if err == nil {
-		nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v2
-	}
-	err = l.dl.Lookup("nvmlDeviceGetDriverModel_v2")
-	if err == nil {
-		nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v2
-	}
-}
@@ -19,9 +19,12 @@ package nvml
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/dl"
 )
 
 func newTestLibrary(dl dynamicLibrary) *library {
@@ -243,3 +246,95 @@ func TestLoadAndCloseWithErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionedSymbolsPerInstance(t *testing.T) {
+	newDl := func(available map[string]bool) dynamicLibrary {
+		return &dynamicLibraryMock{
+			OpenFunc: func() error {
+				return nil
+			},
+			LookupFunc: func(s string) error {
+				if !available[s] {
+					return errors.New("not found")
+				}
+				return nil
+			},
+			CloseFunc: func() error {
+				return nil
+			},
+		}
+	}
+
+	pointer := func(f interface{}) uintptr {
+		return reflect.ValueOf(f).Pointer()
+	}
+
+	defer setLoadedLibrariesForTest()()
+
+	l1 := newTestLibrary(newDl(nil))
+	l2 := newTestLibrary(newDl(map[string]bool{
+		"nvmlInit_v2":                             true,
+		"nvmlDeviceGetPciInfo_v2":                 true,
+		"nvmlDeviceGetPciInfo_v3":                 true,
+		"nvmlDeviceGetComputeRunningProcesses_v2": true,
+	}))
+
+	require.Nil(t, l1.load())
+	defer l1.close()
+	require.Equal(t, pointer(nvmlInit_v1), pointer(l1.symbols.nvmlInit))
+	require.Equal(t, pointer(nvmlDeviceGetPciInfo_v1), pointer(l1.symbols.nvmlDeviceGetPciInfo))
+
+	// Loading a second library does not rebind the symbols of the first.
+	require.Nil(t, l2.load())
+	require.Equal(t, pointer(nvmlInit_v2), pointer(l2.symbols.nvmlInit))
+	require.Equal(t, pointer(nvmlDeviceGetPciInfo_v3), pointer(l2.symbols.nvmlDeviceGetPciInfo))
+	require.Equal(t, pointer(deviceGetComputeRunningProcesses_v2), pointer(l2.symbols.deviceGetComputeRunningProcesses))
+	require.Equal(t, pointer(nvmlInit_v1), pointer(l1.symbols.nvmlInit))
+	require.Equal(t, pointer(nvmlDeviceGetPciInfo_v1), pointer(l1.symbols.nvmlDeviceGetPciInfo))
+
+	// The handle types use the symbols of the earliest loaded library.
+	require.Equal(t, pointer(nvmlInit_v1), pointer(boundSymbols().nvmlInit))
+
+	// Closing the first library makes the second one's symbols bound.
+	require.Nil(t, l1.close())
+	require.Equal(t, pointer(nvmlInit_v2), pointer(boundSymbols().nvmlInit))
+
+	require.Nil(t, l2.close())
+	require.Equal(t, pointer(defaultVersionedSymbols.nvmlInit), pointer(boundSymbols().nvmlInit))
+}
+
+func TestNewWithImplementation(t *testing.T) {
+	type implementation struct {
+		Interface
+	}
+	impl := &implementation{}
+
+	require.Same(t, impl, New(WithImplementation(impl)))
+
+	l, ok := New().(*library)
+	require.True(t, ok)
+	require.NotNil(t, l)
+}
+
+func TestLoadAndInitFlags(t *testing.T) {
+	l := newLibrary()
+	require.Equal(t, uint32(0), l.initFlags)
+	require.Equal(t, defaultNvmlLibraryLoadFlags, l.dl.(*candidateLibrary).flags)
+
+	l = newLibrary(
+		WithLoadFlags(dl.RTLD_NOW|dl.RTLD_LOCAL),
+		WithInitFlags(INIT_FLAG_NO_GPUS),
+		WithInitFlags(INIT_FLAG_NO_ATTACH),
+	)
+	require.Equal(t, uint32(INIT_FLAG_NO_GPUS|INIT_FLAG_NO_ATTACH), l.initFlags)
+	require.Equal(t, dl.RTLD_NOW|dl.RTLD_LOCAL, l.dl.(*candidateLibrary).flags)
+}
+
+func setLoadedLibrariesForTest(libraries ...*library) func() {
+	original := loadedLibraries.libraries
+
+	loadedLibraries.libraries = libraries
+	return func() {
+		loadedLibraries.libraries = original
+	}
+}
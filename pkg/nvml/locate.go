@@ -0,0 +1,180 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/go-nvml/pkg/dl"
+	"github.com/NVIDIA/go-nvml/pkg/ldcache"
+)
+
+const hostRoot = "/"
+
+// candidateLibrary is a dynamicLibrary that resolves a library name to a set
+// of candidate paths and opens the first of these that can be loaded.
+// The candidates are resolved each time the library is opened so that a
+// driver root that is populated after startup is still found.
+type candidateLibrary struct {
+	name        string
+	flags       int
+	driverRoots []string
+	searchPaths []string
+
+	// newDynamicLibrary and lookupLdCache allow for testing.
+	newDynamicLibrary func(string, int) dynamicLibrary
+	lookupLdCache     func(string, string) ([]string, error)
+
+	path   string
+	opened dynamicLibrary
+}
+
+var _ dynamicLibrary = (*candidateLibrary)(nil)
+
+func newCandidateLibrary(o libraryOptions) *candidateLibrary {
+	return &candidateLibrary{
+		name:        o.path,
+		flags:       o.flags,
+		driverRoots: o.driverRoots,
+		searchPaths: o.searchPaths,
+		newDynamicLibrary: func(path string, flags int) dynamicLibrary {
+			return dl.New(path, flags)
+		},
+		lookupLdCache: lookupLdCache,
+	}
+}
+
+// lookupLdCache returns the paths for the named library from the ld.so.cache
+// at the specified path.
+func lookupLdCache(path string, name string) ([]string, error) {
+	cache, err := ldcache.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return cache.Lookup(name), nil
+}
+
+// Open attempts to open each candidate in turn and records the first one that
+// succeeds. If all candidates fail, the errors for each are returned.
+func (c *candidateLibrary) Open() error {
+	var errs []error
+	for _, candidate := range c.candidates() {
+		lib := c.newDynamicLibrary(candidate, c.flags)
+		if err := lib.Open(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.path = candidate
+		c.opened = lib
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("no candidates found for %s", c.name)
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the opened candidate, if any.
+func (c *candidateLibrary) Close() error {
+	if c.opened == nil {
+		return nil
+	}
+	if err := c.opened.Close(); err != nil {
+		return err
+	}
+	c.path = ""
+	c.opened = nil
+	return nil
+}
+
+// Lookup checks whether the specified symbol exists in the opened candidate.
+func (c *candidateLibrary) Lookup(symbol string) error {
+	if c.opened == nil {
+		return errLibraryNotLoaded
+	}
+	return c.opened.Lookup(symbol)
+}
+
+// Path returns the candidate that was opened.
+func (c *candidateLibrary) Path() string {
+	return c.path
+}
+
+// candidates returns the ordered list of paths that should be passed to dlopen.
+//
+// A name containing a path separator is used as is. Otherwise, for each driver
+// root the configured search paths are checked for the library, followed by
+// the entries in the ld.so.cache under that root. For the host root the bare
+// name is used instead of the ld.so.cache since dlopen already consults the
+// cache, as well as LD_LIBRARY_PATH, for bare names.
+func (c *candidateLibrary) candidates() []string {
+	if strings.Contains(c.name, "/") {
+		return []string{c.name}
+	}
+
+	roots := c.driverRoots
+	if len(roots) == 0 {
+		roots = []string{hostRoot}
+	}
+
+	var candidates []string
+	seen := make(map[string]bool)
+	add := func(candidate string) {
+		if seen[candidate] {
+			return
+		}
+		seen[candidate] = true
+		candidates = append(candidates, candidate)
+	}
+
+	for _, root := range roots {
+		for _, dir := range c.searchPaths {
+			if path := filepath.Join(root, dir, c.name); isFile(path) {
+				add(path)
+			}
+		}
+		if filepath.Clean(root) == hostRoot {
+			add(c.name)
+			continue
+		}
+		paths, err := c.lookupLdCache(filepath.Join(root, ldcache.DefaultPath), c.name)
+		if err != nil {
+			continue
+		}
+		for _, p := range paths {
+			if path := filepath.Join(root, p); isFile(path) {
+				add(path)
+			}
+		}
+	}
+
+	return candidates
+}
+
+// isFile checks whether the specified path exists and is not a directory.
+// Symlinks are followed.
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return !info.IsDir()
+}
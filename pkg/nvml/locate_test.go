@@ -0,0 +1,208 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createFakeRoot creates the specified (dummy) files under a new temporary root.
+func createFakeRoot(t *testing.T, files ...string) string {
+	root := t.TempDir()
+	for _, f := range files {
+		path := filepath.Join(root, f)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte("dummy"), 0644))
+	}
+	return root
+}
+
+func TestCandidates(t *testing.T) {
+	driverRoot := createFakeRoot(t,
+		"usr/lib64/libnvidia-ml.so.1",
+		"usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1",
+	)
+	customRoot := createFakeRoot(t,
+		"opt/nvidia/lib/libnvidia-ml.so.1",
+	)
+
+	ldcaches := map[string][]string{
+		filepath.Join(driverRoot, "/etc/ld.so.cache"): {
+			"/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1",
+			"/usr/lib/missing/libnvidia-ml.so.1",
+		},
+	}
+	lookup := func(path string, name string) ([]string, error) {
+		paths, ok := ldcaches[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return paths, nil
+	}
+
+	testCases := []struct {
+		description        string
+		options            []LibraryOption
+		expectedCandidates []string
+	}{
+		{
+			description:        "default uses the bare name",
+			expectedCandidates: []string{"libnvidia-ml.so.1"},
+		},
+		{
+			description: "library path is used as is",
+			options: []LibraryOption{
+				WithLibraryPath("/some/path/libnvidia-ml.so.1"),
+				WithDriverRoot(driverRoot),
+			},
+			expectedCandidates: []string{"/some/path/libnvidia-ml.so.1"},
+		},
+		{
+			description: "driver root uses ldcache",
+			options: []LibraryOption{
+				WithDriverRoot(driverRoot),
+			},
+			expectedCandidates: []string{
+				filepath.Join(driverRoot, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+			},
+		},
+		{
+			description: "search paths are checked before ldcache",
+			options: []LibraryOption{
+				WithDriverRoot(driverRoot),
+				WithLibrarySearchPaths("/usr/lib64", "/usr/lib/x86_64-linux-gnu"),
+			},
+			expectedCandidates: []string{
+				filepath.Join(driverRoot, "usr/lib64/libnvidia-ml.so.1"),
+				filepath.Join(driverRoot, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+			},
+		},
+		{
+			description: "roots are searched in order",
+			options: []LibraryOption{
+				WithDriverRoot(customRoot),
+				WithDriverRoot(driverRoot),
+				WithDriverRoot("/"),
+				WithLibrarySearchPaths("/opt/nvidia/lib"),
+			},
+			expectedCandidates: []string{
+				filepath.Join(customRoot, "opt/nvidia/lib/libnvidia-ml.so.1"),
+				filepath.Join(driverRoot, "usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1"),
+				"libnvidia-ml.so.1",
+			},
+		},
+		{
+			description: "missing root yields no candidates",
+			options: []LibraryOption{
+				WithDriverRoot(filepath.Join(customRoot, "missing")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			l := newLibrary(tc.options...)
+			c := l.dl.(*candidateLibrary)
+			c.lookupLdCache = lookup
+
+			require.Equal(t, tc.expectedCandidates, c.candidates())
+		})
+	}
+}
+
+func TestCandidateLibraryOpen(t *testing.T) {
+	root := createFakeRoot(t,
+		"lib/a/libnvidia-ml.so.1",
+		"lib/b/libnvidia-ml.so.1",
+	)
+	errOpen := errors.New("open error")
+
+	testCases := []struct {
+		description   string
+		failing       map[string]bool
+		expectedError error
+		expectedPath  string
+	}{
+		{
+			description:  "first candidate is opened",
+			expectedPath: filepath.Join(root, "lib/a/libnvidia-ml.so.1"),
+		},
+		{
+			description: "failing candidate is skipped",
+			failing: map[string]bool{
+				filepath.Join(root, "lib/a/libnvidia-ml.so.1"): true,
+			},
+			expectedPath: filepath.Join(root, "lib/b/libnvidia-ml.so.1"),
+		},
+		{
+			description: "all candidates failing returns error",
+			failing: map[string]bool{
+				filepath.Join(root, "lib/a/libnvidia-ml.so.1"): true,
+				filepath.Join(root, "lib/b/libnvidia-ml.so.1"): true,
+			},
+			expectedError: errOpen,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			l := newLibrary(
+				WithDriverRoot(root),
+				WithLibrarySearchPaths("/lib/a", "/lib/b"),
+			)
+			c := l.dl.(*candidateLibrary)
+			c.newDynamicLibrary = func(path string, flags int) dynamicLibrary {
+				return &dynamicLibraryMock{
+					OpenFunc: func() error {
+						if tc.failing[path] {
+							return errOpen
+						}
+						return nil
+					},
+					LookupFunc: func(s string) error {
+						return nil
+					},
+					CloseFunc: func() error {
+						return nil
+					},
+				}
+			}
+
+			_, err := l.LibraryPath()
+			require.ErrorIs(t, err, errLibraryNotLoaded)
+
+			err = l.load()
+			require.ErrorIs(t, err, tc.expectedError)
+			if tc.expectedError != nil {
+				return
+			}
+
+			path, err := l.LibraryPath()
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPath, path)
+
+			require.NoError(t, l.close())
+			_, err = l.LibraryPath()
+			require.ErrorIs(t, err, errLibraryNotLoaded)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+)
+
+// HandleKind identifies the kinds of device handles that a method applies to.
+type HandleKind int
+
+const (
+	// FullGpuHandle is the handle of a full GPU, as returned by
+	// DeviceGetHandleByIndex.
+	FullGpuHandle HandleKind = 1 << iota
+	// MigDeviceHandle is the handle of a MIG device, as returned by
+	// DeviceGetMigDeviceHandleByIndex.
+	MigDeviceHandle
+)
+
+// MethodInfo describes a method of Interface or of one of the handle
+// interfaces (e.g. Device). This is generated from nvml.h, and can be used to
+// determine what a given set of methods touches without calling these.
+type MethodInfo struct {
+	// Interface is the name of the interface (e.g. Interface or Device).
+	Interface string
+	// Name is the name of the method.
+	Name string
+	// Symbol is the unversioned name of the NVML function called by the
+	// method (e.g. nvmlDeviceGetPciInfo). This is empty for methods that do
+	// not call an NVML function (e.g. Extensions).
+	Symbol string
+	// Variants lists the symbols of the NVML function that are referenced by
+	// the bindings, ordered from the oldest to the newest version.
+	Variants []string
+	// Mutates indicates whether the method modifies the state of the system
+	// or of a device.
+	Mutates bool
+	// RequiresRoot indicates whether nvml.h documents that the function
+	// requires root or admin permissions.
+	RequiresRoot bool
+	// Handles holds the kinds of device handles that the method applies to.
+	// This is zero for methods that do not operate on a device.
+	Handles HandleKind
+}
+
+var describedMethodsOnce sync.Once
+var describedMethodInfos []MethodInfo
+
+// describedMethods returns the descriptions of the methods, along with the
+// variants of the NVML functions called by these.
+func describedMethods() []MethodInfo {
+	describedMethodsOnce.Do(func() {
+		variants := symbolVariants()
+		describedMethodInfos = make([]MethodInfo, len(methodInfos))
+		for i, m := range methodInfos {
+			m.Variants = variants[m.Symbol]
+			describedMethodInfos[i] = m
+		}
+	})
+	return describedMethodInfos
+}
+
+// Methods returns the description of each method of Interface and of the
+// handle interfaces, ordered by interface and method name.
+func Methods() []MethodInfo {
+	methods := describedMethods()
+	result := make([]MethodInfo, len(methods))
+	for i, m := range methods {
+		result[i] = m.copy()
+	}
+	return result
+}
+
+// LookupMethod returns the description of the specified method of the
+// specified interface (e.g. "Device" and "GetName").
+func LookupMethod(iface string, name string) (MethodInfo, bool) {
+	for _, m := range describedMethods() {
+		if m.Interface == iface && m.Name == name {
+			return m.copy(), true
+		}
+	}
+	return MethodInfo{}, false
+}
+
+func (m MethodInfo) copy() MethodInfo {
+	m.Variants = append([]string(nil), m.Variants...)
+	return m
+}
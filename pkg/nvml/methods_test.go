@@ -0,0 +1,147 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethods(t *testing.T) {
+	interfaces := map[string]reflect.Type{
+		"Interface":       reflect.TypeOf((*Interface)(nil)).Elem(),
+		"Device":          reflect.TypeOf((*Device)(nil)).Elem(),
+		"GpuInstance":     reflect.TypeOf((*GpuInstance)(nil)).Elem(),
+		"ComputeInstance": reflect.TypeOf((*ComputeInstance)(nil)).Elem(),
+		"EventSet":        reflect.TypeOf((*EventSet)(nil)).Elem(),
+		"GpmSample":       reflect.TypeOf((*GpmSample)(nil)).Elem(),
+		"Unit":            reflect.TypeOf((*Unit)(nil)).Elem(),
+		"VgpuInstance":    reflect.TypeOf((*VgpuInstance)(nil)).Elem(),
+		"VgpuTypeId":      reflect.TypeOf((*VgpuTypeId)(nil)).Elem(),
+	}
+
+	// Each method of the interfaces is described exactly once.
+	described := make(map[string]bool)
+	for _, m := range Methods() {
+		iface, ok := interfaces[m.Interface]
+		require.True(t, ok, "unexpected interface %s", m.Interface)
+		_, ok = iface.MethodByName(m.Name)
+		require.True(t, ok, "%s.%s is not a method", m.Interface, m.Name)
+		key := m.Interface + "." + m.Name
+		require.False(t, described[key], "%s is described more than once", key)
+		described[key] = true
+	}
+	for name, iface := range interfaces {
+		for i := 0; i < iface.NumMethod(); i++ {
+			require.True(t, described[name+"."+iface.Method(i).Name], "%s.%s is not described", name, iface.Method(i).Name)
+		}
+	}
+
+	testCases := []struct {
+		iface    string
+		method   string
+		expected MethodInfo
+	}{
+		{
+			iface:  "Interface",
+			method: "DeviceGetPciInfo",
+			expected: MethodInfo{
+				Interface: "Interface",
+				Name:      "DeviceGetPciInfo",
+				Symbol:    "nvmlDeviceGetPciInfo",
+				Variants:  []string{"nvmlDeviceGetPciInfo", "nvmlDeviceGetPciInfo_v2", "nvmlDeviceGetPciInfo_v3"},
+				Handles:   FullGpuHandle,
+			},
+		},
+		{
+			iface:  "Device",
+			method: "SetMigMode",
+			expected: MethodInfo{
+				Interface:    "Device",
+				Name:         "SetMigMode",
+				Symbol:       "nvmlDeviceSetMigMode",
+				Variants:     []string{"nvmlDeviceSetMigMode"},
+				Mutates:      true,
+				RequiresRoot: true,
+				Handles:      FullGpuHandle,
+			},
+		},
+		{
+			iface:  "Device",
+			method: "GetUUID",
+			expected: MethodInfo{
+				Interface: "Device",
+				Name:      "GetUUID",
+				Symbol:    "nvmlDeviceGetUUID",
+				Variants:  []string{"nvmlDeviceGetUUID"},
+				Handles:   FullGpuHandle | MigDeviceHandle,
+			},
+		},
+		{
+			iface:  "Device",
+			method: "GetGpuInstanceId",
+			expected: MethodInfo{
+				Interface: "Device",
+				Name:      "GetGpuInstanceId",
+				Symbol:    "nvmlDeviceGetGpuInstanceId",
+				Variants:  []string{"nvmlDeviceGetGpuInstanceId"},
+				Handles:   MigDeviceHandle,
+			},
+		},
+		{
+			iface:  "GpuInstance",
+			method: "Destroy",
+			expected: MethodInfo{
+				Interface: "GpuInstance",
+				Name:      "Destroy",
+				Symbol:    "nvmlGpuInstanceDestroy",
+				Variants:  []string{"nvmlGpuInstanceDestroy"},
+				Mutates:   true,
+			},
+		},
+		{
+			iface:  "Interface",
+			method: "EventSetCreate",
+			expected: MethodInfo{
+				Interface: "Interface",
+				Name:      "EventSetCreate",
+				Symbol:    "nvmlEventSetCreate",
+				Variants:  []string{"nvmlEventSetCreate"},
+			},
+		},
+		{
+			iface:  "Interface",
+			method: "Extensions",
+			expected: MethodInfo{
+				Interface: "Interface",
+				Name:      "Extensions",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.iface+"."+tc.method, func(t *testing.T) {
+			info, ok := LookupMethod(tc.iface, tc.method)
+			require.True(t, ok)
+			require.Equal(t, tc.expected, info)
+		})
+	}
+
+	_, ok := LookupMethod("Device", "GetNonExistent")
+	require.False(t, ok)
+}
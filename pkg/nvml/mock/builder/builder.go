@@ -0,0 +1,491 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+type Server struct {
+	mock.Interface
+	mock.ExtendedInterface
+	Devices           []nvml.Device
+	DriverVersion     string
+	NvmlVersion       string
+	CudaDriverVersion int
+}
+
+type Device struct {
+	mock.Device
+	sync.RWMutex
+	UUID                  string
+	Name                  string
+	Brand                 nvml.BrandType
+	Architecture          nvml.DeviceArchitecture
+	PciBusID              string
+	PciDeviceId           uint32
+	Minor                 int
+	Index                 int
+	CudaComputeCapability CudaComputeCapability
+	MigMode               int
+	// MigProfile holds the MIG profile table of the device. MIG is not
+	// supported if this is nil.
+	MigProfile         *MigProfile
+	GpuInstances       map[*GpuInstance]struct{}
+	GpuInstanceCounter uint32
+	MemoryInfo         nvml.Memory
+}
+
+type GpuInstance struct {
+	mock.GpuInstance
+	sync.RWMutex
+	Info                   nvml.GpuInstanceInfo
+	Profile                *GpuInstanceProfile
+	ComputeInstances       map[*ComputeInstance]struct{}
+	ComputeInstanceCounter uint32
+}
+
+type ComputeInstance struct {
+	mock.ComputeInstance
+	Info nvml.ComputeInstanceInfo
+}
+
+var _ nvml.Interface = (*Server)(nil)
+var _ nvml.Device = (*Device)(nil)
+var _ nvml.GpuInstance = (*GpuInstance)(nil)
+var _ nvml.ComputeInstance = (*ComputeInstance)(nil)
+
+// New builds a mock server with the hardware described by the specified
+// profile.
+func New(profile *Profile) (*Server, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	server := &Server{
+		DriverVersion:     profile.DriverVersion,
+		NvmlVersion:       profile.NvmlVersion,
+		CudaDriverVersion: profile.CudaDriverVersion,
+	}
+	for _, d := range profile.Devices {
+		var migProfile *MigProfile
+		if d.MigProfile != "" {
+			m := profile.MigProfiles[d.MigProfile]
+			migProfile = &m
+		}
+		for i := 0; i < d.count(); i++ {
+			index := len(server.Devices)
+			device := &Device{
+				UUID:                  "GPU-" + uuid.New().String(),
+				Name:                  d.Name,
+				Brand:                 d.Brand,
+				Architecture:          d.Architecture,
+				PciBusID:              fmt.Sprintf("0000:%02x:00.0", index),
+				PciDeviceId:           d.PciDeviceId,
+				Minor:                 index,
+				Index:                 index,
+				CudaComputeCapability: d.CudaComputeCapability,
+				MigProfile:            migProfile,
+				GpuInstances:          make(map[*GpuInstance]struct{}),
+				GpuInstanceCounter:    0,
+				MemoryInfo:            nvml.Memory{Total: d.Memory, Free: d.Memory, Used: 0},
+			}
+			if len(d.UUIDs) > 0 {
+				device.UUID = d.UUIDs[i]
+			}
+			if len(d.PciBusIds) > 0 {
+				device.PciBusID = d.PciBusIds[i]
+			}
+			device.setMockFuncs()
+			server.Devices = append(server.Devices, device)
+		}
+	}
+	server.setMockFuncs()
+	return server, nil
+}
+
+// NewFromFile builds a mock server with the hardware described by the profile
+// in the specified file.
+func NewFromFile(path string) (*Server, error) {
+	profile, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(profile)
+}
+
+func NewGpuInstance(info nvml.GpuInstanceInfo, profile *GpuInstanceProfile) *GpuInstance {
+	gi := &GpuInstance{
+		Info:                   info,
+		Profile:                profile,
+		ComputeInstances:       make(map[*ComputeInstance]struct{}),
+		ComputeInstanceCounter: 0,
+	}
+	gi.setMockFuncs()
+	return gi
+}
+
+func NewComputeInstance(info nvml.ComputeInstanceInfo) *ComputeInstance {
+	ci := &ComputeInstance{
+		Info: info,
+	}
+	ci.setMockFuncs()
+	return ci
+}
+
+func (s *Server) setMockFuncs() {
+	s.ExtensionsFunc = func() nvml.ExtendedInterface {
+		return s
+	}
+
+	s.LookupSymbolFunc = func(symbol string) error {
+		return nil
+	}
+
+	s.InitFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.ShutdownFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.SystemGetDriverVersionFunc = func() (string, nvml.Return) {
+		return s.DriverVersion, nvml.SUCCESS
+	}
+
+	s.SystemGetNVMLVersionFunc = func() (string, nvml.Return) {
+		return s.NvmlVersion, nvml.SUCCESS
+	}
+
+	s.SystemGetCudaDriverVersionFunc = func() (int, nvml.Return) {
+		return s.CudaDriverVersion, nvml.SUCCESS
+	}
+
+	s.DeviceGetCountFunc = func() (int, nvml.Return) {
+		return len(s.Devices), nvml.SUCCESS
+	}
+
+	s.DeviceGetHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		if index < 0 || index >= len(s.Devices) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return s.Devices[index], nvml.SUCCESS
+	}
+
+	s.DeviceGetHandleByUUIDFunc = func(uuid string) (nvml.Device, nvml.Return) {
+		for _, d := range s.Devices {
+			if uuid == d.(*Device).UUID {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	s.DeviceGetHandleByPciBusIdFunc = func(busID string) (nvml.Device, nvml.Return) {
+		for _, d := range s.Devices {
+			if busID == d.(*Device).PciBusID {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+}
+
+func (d *Device) setMockFuncs() {
+	d.GetMinorNumberFunc = func() (int, nvml.Return) {
+		return d.Minor, nvml.SUCCESS
+	}
+
+	d.GetIndexFunc = func() (int, nvml.Return) {
+		return d.Index, nvml.SUCCESS
+	}
+
+	d.GetCudaComputeCapabilityFunc = func() (int, int, nvml.Return) {
+		return d.CudaComputeCapability.Major, d.CudaComputeCapability.Minor, nvml.SUCCESS
+	}
+
+	d.GetUUIDFunc = func() (string, nvml.Return) {
+		return d.UUID, nvml.SUCCESS
+	}
+
+	d.GetNameFunc = func() (string, nvml.Return) {
+		return d.Name, nvml.SUCCESS
+	}
+
+	d.GetBrandFunc = func() (nvml.BrandType, nvml.Return) {
+		return d.Brand, nvml.SUCCESS
+	}
+
+	d.GetArchitectureFunc = func() (nvml.DeviceArchitecture, nvml.Return) {
+		return d.Architecture, nvml.SUCCESS
+	}
+
+	d.GetMemoryInfoFunc = func() (nvml.Memory, nvml.Return) {
+		return d.MemoryInfo, nvml.SUCCESS
+	}
+
+	d.GetPciInfoFunc = func() (nvml.PciInfo, nvml.Return) {
+		p := nvml.PciInfo{
+			PciDeviceId: d.PciDeviceId,
+		}
+		// The bus IDs are NUL-terminated.
+		copy(p.BusIdLegacy[:len(p.BusIdLegacy)-1], d.PciBusID)
+		copy(p.BusId[:len(p.BusId)-1], d.PciBusID)
+		_, _ = fmt.Sscanf(d.PciBusID, "%x:%x:%x", &p.Domain, &p.Bus, &p.Device)
+		return p, nvml.SUCCESS
+	}
+
+	d.SetMigModeFunc = func(mode int) (nvml.Return, nvml.Return) {
+		if d.MigProfile == nil {
+			return nvml.ERROR_NOT_SUPPORTED, nvml.ERROR_NOT_SUPPORTED
+		}
+		d.MigMode = mode
+		return nvml.SUCCESS, nvml.SUCCESS
+	}
+
+	d.GetMigModeFunc = func() (int, int, nvml.Return) {
+		if d.MigProfile == nil {
+			return 0, 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return d.MigMode, d.MigMode, nvml.SUCCESS
+	}
+
+	d.GetGpuInstanceProfileInfoFunc = func(giProfileId int) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+		if giProfileId < 0 || giProfileId >= nvml.GPU_INSTANCE_PROFILE_COUNT {
+			return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+
+		profile := d.MigProfile.gpuInstanceProfile(giProfileId)
+		if profile == nil {
+			return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		return profile.info(), nvml.SUCCESS
+	}
+
+	d.GetGpuInstancePossiblePlacementsFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, nvml.Return) {
+		profile := d.MigProfile.gpuInstanceProfile(int(info.Id))
+		if profile == nil {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		var placements []nvml.GpuInstancePlacement
+		for _, p := range profile.Placements {
+			placements = append(placements, nvml.GpuInstancePlacement{Start: p.Start, Size: p.Size})
+		}
+		return placements, nvml.SUCCESS
+	}
+
+	d.CreateGpuInstanceFunc = func(info *nvml.GpuInstanceProfileInfo) (nvml.GpuInstance, nvml.Return) {
+		return d.createGpuInstance(info, nil)
+	}
+
+	d.CreateGpuInstanceWithPlacementFunc = func(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (nvml.GpuInstance, nvml.Return) {
+		return d.createGpuInstance(info, placement)
+	}
+
+	d.GetGpuInstancesFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
+		d.RLock()
+		defer d.RUnlock()
+		var gis []nvml.GpuInstance
+		for gi := range d.GpuInstances {
+			if gi.Info.ProfileId == info.Id {
+				gis = append(gis, gi)
+			}
+		}
+		return gis, nvml.SUCCESS
+	}
+}
+
+func (d *Device) createGpuInstance(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (nvml.GpuInstance, nvml.Return) {
+	profile := d.MigProfile.gpuInstanceProfile(int(info.Id))
+	if profile == nil {
+		return nil, nvml.ERROR_NOT_SUPPORTED
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	giInfo := nvml.GpuInstanceInfo{
+		Device:    d,
+		Id:        d.GpuInstanceCounter,
+		ProfileId: info.Id,
+	}
+	if placement != nil {
+		giInfo.Placement = *placement
+	}
+	d.GpuInstanceCounter++
+	gi := NewGpuInstance(giInfo, profile)
+	d.GpuInstances[gi] = struct{}{}
+	return gi, nvml.SUCCESS
+}
+
+func (gi *GpuInstance) setMockFuncs() {
+	gi.GetInfoFunc = func() (nvml.GpuInstanceInfo, nvml.Return) {
+		return gi.Info, nvml.SUCCESS
+	}
+
+	gi.GetComputeInstanceProfileInfoFunc = func(ciProfileId int, ciEngProfileId int) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+		if ciProfileId < 0 || ciProfileId >= nvml.COMPUTE_INSTANCE_PROFILE_COUNT {
+			return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+
+		if ciEngProfileId != nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED {
+			return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		profile := gi.Profile.computeInstanceProfile(ciProfileId)
+		if profile == nil {
+			return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		return profile.info(), nvml.SUCCESS
+	}
+
+	gi.GetComputeInstancePossiblePlacementsFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, nvml.Return) {
+		profile := gi.Profile.computeInstanceProfile(int(info.Id))
+		if profile == nil {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		var placements []nvml.ComputeInstancePlacement
+		for _, p := range profile.Placements {
+			placements = append(placements, nvml.ComputeInstancePlacement{Start: p.Start, Size: p.Size})
+		}
+		return placements, nvml.SUCCESS
+	}
+
+	gi.CreateComputeInstanceFunc = func(info *nvml.ComputeInstanceProfileInfo) (nvml.ComputeInstance, nvml.Return) {
+		if gi.Profile.computeInstanceProfile(int(info.Id)) == nil {
+			return nil, nvml.ERROR_NOT_SUPPORTED
+		}
+
+		gi.Lock()
+		defer gi.Unlock()
+		ciInfo := nvml.ComputeInstanceInfo{
+			Device:      gi.Info.Device,
+			GpuInstance: gi,
+			Id:          gi.ComputeInstanceCounter,
+			ProfileId:   info.Id,
+		}
+		gi.ComputeInstanceCounter++
+		ci := NewComputeInstance(ciInfo)
+		gi.ComputeInstances[ci] = struct{}{}
+		return ci, nvml.SUCCESS
+	}
+
+	gi.GetComputeInstancesFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstance, nvml.Return) {
+		gi.RLock()
+		defer gi.RUnlock()
+		var cis []nvml.ComputeInstance
+		for ci := range gi.ComputeInstances {
+			if ci.Info.ProfileId == info.Id {
+				cis = append(cis, ci)
+			}
+		}
+		return cis, nvml.SUCCESS
+	}
+
+	gi.DestroyFunc = func() nvml.Return {
+		d := gi.Info.Device.(*Device)
+		d.Lock()
+		defer d.Unlock()
+		delete(d.GpuInstances, gi)
+		return nvml.SUCCESS
+	}
+}
+
+func (ci *ComputeInstance) setMockFuncs() {
+	ci.GetInfoFunc = func() (nvml.ComputeInstanceInfo, nvml.Return) {
+		return ci.Info, nvml.SUCCESS
+	}
+
+	ci.DestroyFunc = func() nvml.Return {
+		gi := ci.Info.GpuInstance.(*GpuInstance)
+		gi.Lock()
+		defer gi.Unlock()
+		delete(gi.ComputeInstances, ci)
+		return nvml.SUCCESS
+	}
+}
+
+// gpuInstanceProfile returns the GPU instance profile with the specified ID,
+// or nil if it is not supported.
+func (m *MigProfile) gpuInstanceProfile(id int) *GpuInstanceProfile {
+	if m == nil {
+		return nil
+	}
+	for i := range m.GpuInstanceProfiles {
+		if m.GpuInstanceProfiles[i].Id == id {
+			return &m.GpuInstanceProfiles[i]
+		}
+	}
+	return nil
+}
+
+// computeInstanceProfile returns the compute instance profile with the
+// specified ID, or nil if it is not supported.
+func (p *GpuInstanceProfile) computeInstanceProfile(id int) *ComputeInstanceProfile {
+	if p == nil {
+		return nil
+	}
+	for i := range p.ComputeInstanceProfiles {
+		if p.ComputeInstanceProfiles[i].Id == id {
+			return &p.ComputeInstanceProfiles[i]
+		}
+	}
+	return nil
+}
+
+func (p *GpuInstanceProfile) info() nvml.GpuInstanceProfileInfo {
+	info := nvml.GpuInstanceProfileInfo{
+		Id:                  uint32(p.Id),
+		SliceCount:          p.SliceCount,
+		InstanceCount:       p.InstanceCount,
+		MultiprocessorCount: p.MultiprocessorCount,
+		CopyEngineCount:     p.CopyEngineCount,
+		DecoderCount:        p.DecoderCount,
+		EncoderCount:        p.EncoderCount,
+		JpegCount:           p.JpegCount,
+		OfaCount:            p.OfaCount,
+		MemorySizeMB:        p.MemorySizeMB,
+	}
+	if p.IsP2pSupported {
+		info.IsP2pSupported = 1
+	}
+	return info
+}
+
+func (p *ComputeInstanceProfile) info() nvml.ComputeInstanceProfileInfo {
+	return nvml.ComputeInstanceProfileInfo{
+		Id:                    uint32(p.Id),
+		SliceCount:            p.SliceCount,
+		InstanceCount:         p.InstanceCount,
+		MultiprocessorCount:   p.MultiprocessorCount,
+		SharedCopyEngineCount: p.SharedCopyEngineCount,
+		SharedDecoderCount:    p.SharedDecoderCount,
+		SharedEncoderCount:    p.SharedEncoderCount,
+		SharedJpegCount:       p.SharedJpegCount,
+		SharedOfaCount:        p.SharedOfaCount,
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/dgxa100"
+)
+
+func TestDGXA100Profile(t *testing.T) {
+	server, err := NewFromFile("testdata/dgxa100.yaml")
+	require.NoError(t, err)
+	reference := dgxa100.New()
+
+	count, ret := server.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, len(reference.Devices), count)
+
+	version, ret := server.SystemGetDriverVersion()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, reference.DriverVersion, version)
+
+	for i := 0; i < count; i++ {
+		device, ret := server.DeviceGetHandleByIndex(i)
+		require.Equal(t, nvml.SUCCESS, ret)
+		expected := reference.Devices[i].(*dgxa100.Device)
+
+		name, _ := device.GetName()
+		require.Equal(t, expected.Name, name)
+		memory, _ := device.GetMemoryInfo()
+		require.Equal(t, expected.MemoryInfo.Total, memory.Total)
+		major, minor, _ := device.GetCudaComputeCapability()
+		require.Equal(t, expected.CudaComputeCapability.Major, major)
+		require.Equal(t, expected.CudaComputeCapability.Minor, minor)
+		architecture, _ := device.GetArchitecture()
+		require.Equal(t, expected.Architecture, architecture)
+
+		pciInfo, ret := device.GetPciInfo()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, uint32(0x20B010DE), pciInfo.PciDeviceId)
+		require.Equal(t, uint32(i), pciInfo.Bus)
+
+		uuid, _ := device.GetUUID()
+		byUUID, ret := server.DeviceGetHandleByUUID(uuid)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, device, byUUID)
+	}
+
+	device, _ := server.DeviceGetHandleByIndex(0)
+	ret, _ = device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	for giProfileId := 0; giProfileId < nvml.GPU_INSTANCE_PROFILE_COUNT; giProfileId++ {
+		expected, exists := dgxa100.MIGProfiles.GpuInstanceProfiles[giProfileId]
+		giProfileInfo, ret := device.GetGpuInstanceProfileInfo(giProfileId)
+		if !exists {
+			require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+			continue
+		}
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, expected, giProfileInfo)
+
+		placements, ret := device.GetGpuInstancePossiblePlacements(&giProfileInfo)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, dgxa100.MIGPlacements.GpuInstancePossiblePlacements[giProfileId], placements)
+
+		gi, ret := device.CreateGpuInstanceWithPlacement(&giProfileInfo, &placements[0])
+		require.Equal(t, nvml.SUCCESS, ret)
+		for ciProfileId := 0; ciProfileId < nvml.COMPUTE_INSTANCE_PROFILE_COUNT; ciProfileId++ {
+			expected, exists := dgxa100.MIGProfiles.ComputeInstanceProfiles[giProfileId][ciProfileId]
+			ciProfileInfo, ret := gi.GetComputeInstanceProfileInfo(ciProfileId, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+			if !exists {
+				require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+				continue
+			}
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.Equal(t, expected, ciProfileInfo)
+
+			placements, ret := gi.GetComputeInstancePossiblePlacements(&ciProfileInfo)
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.ElementsMatch(t, dgxa100.MIGPlacements.ComputeInstancePossiblePlacements[giProfileId][ciProfileId], placements)
+
+			ci, ret := gi.CreateComputeInstance(&ciProfileInfo)
+			require.Equal(t, nvml.SUCCESS, ret)
+			cis, _ := gi.GetComputeInstances(&ciProfileInfo)
+			require.Equal(t, []nvml.ComputeInstance{ci}, cis)
+			require.Equal(t, nvml.SUCCESS, ci.Destroy())
+		}
+		require.Equal(t, nvml.SUCCESS, gi.Destroy())
+	}
+}
+
+func TestNew(t *testing.T) {
+	profile, err := Parse([]byte(`{
+		"driverVersion": "550.54.15",
+		"devices": [
+			{"name": "Mock NVIDIA T4", "architecture": "TURING", "memory": 16106127360},
+			{"count": 2, "name": "Mock NVIDIA A10", "architecture": "AMPERE",
+			 "pciBusIds": ["0000:3B:00.0", "0000:86:00.0"],
+			 "uuids": ["GPU-a", "GPU-b"]}
+		]
+	}`))
+	require.NoError(t, err)
+	server, err := New(profile)
+	require.NoError(t, err)
+
+	require.Len(t, server.Devices, 3)
+	t4 := server.Devices[0].(*Device)
+	require.Equal(t, "Mock NVIDIA T4", t4.Name)
+	require.Equal(t, nvml.DeviceArchitecture(nvml.DEVICE_ARCH_TURING), t4.Architecture)
+	require.Equal(t, "0000:00:00.0", t4.PciBusID)
+	_, _, ret := t4.GetMigMode()
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	_, ret = t4.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_1_SLICE)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	device, ret := server.DeviceGetHandleByPciBusId("0000:86:00.0")
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "GPU-b", device.(*Device).UUID)
+	require.Equal(t, 2, device.(*Device).Index)
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		description string
+		profile     string
+	}{
+		{
+			description: "no devices",
+			profile:     `driverVersion: "550.54.15"`,
+		},
+		{
+			description: "unknown field",
+			profile:     `devices: [{name: A100, memroy: 1}]`,
+		},
+		{
+			description: "unknown architecture",
+			profile:     `devices: [{name: A100, architecture: MOCK}]`,
+		},
+		{
+			description: "bus IDs do not match count",
+			profile:     `devices: [{count: 2, pciBusIds: ["0000:00:00.0"]}]`,
+		},
+		{
+			description: "unknown MIG profile",
+			profile:     `devices: [{migProfile: A100}]`,
+		},
+		{
+			description: "duplicate GPU instance profile",
+			profile:     `{devices: [{migProfile: A100}], migProfiles: {A100: {gpuInstanceProfiles: [{id: 0}, {id: 0}]}}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := Parse([]byte(tc.profile))
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Profile describes the hardware of a mock server. Profiles are written in
+// YAML or JSON (see testdata/dgxa100.yaml for an example).
+type Profile struct {
+	DriverVersion     string `json:"driverVersion" yaml:"driverVersion"`
+	NvmlVersion       string `json:"nvmlVersion" yaml:"nvmlVersion"`
+	CudaDriverVersion int    `json:"cudaDriverVersion" yaml:"cudaDriverVersion"`
+	// Devices describes the devices of the server. Each entry describes one
+	// or more identical devices, which are indexed in order.
+	Devices []DeviceProfile `json:"devices" yaml:"devices"`
+	// MigProfiles holds the MIG profile tables that can be referenced by the
+	// devices, by name.
+	MigProfiles map[string]MigProfile `json:"migProfiles,omitempty" yaml:"migProfiles,omitempty"`
+}
+
+// DeviceProfile describes one or more identical devices.
+type DeviceProfile struct {
+	// Count is the number of devices. This defaults to 1.
+	Count        int                     `json:"count,omitempty" yaml:"count,omitempty"`
+	Name         string                  `json:"name" yaml:"name"`
+	Brand        nvml.BrandType          `json:"brand" yaml:"brand"`
+	Architecture nvml.DeviceArchitecture `json:"architecture" yaml:"architecture"`
+	// CudaComputeCapability is the compute capability (e.g. 8.0).
+	CudaComputeCapability CudaComputeCapability `json:"cudaComputeCapability" yaml:"cudaComputeCapability"`
+	// Memory is the total memory of a device in bytes.
+	Memory uint64 `json:"memory" yaml:"memory"`
+	// PciDeviceId is the combined device and vendor ID (e.g. 0x20B010DE).
+	PciDeviceId uint32 `json:"pciDeviceId" yaml:"pciDeviceId"`
+	// PciBusIds holds the PCI bus ID of each device. If this is not set, the
+	// bus IDs are "0000:XX:00.0", where XX is the index of the device.
+	PciBusIds []string `json:"pciBusIds,omitempty" yaml:"pciBusIds,omitempty"`
+	// UUIDs holds the UUID of each device. If this is not set, random UUIDs
+	// are generated.
+	UUIDs []string `json:"uuids,omitempty" yaml:"uuids,omitempty"`
+	// MigProfile is the name of the MIG profile table of the devices in
+	// Profile.MigProfiles. MIG is not supported if this is not set.
+	MigProfile string `json:"migProfile,omitempty" yaml:"migProfile,omitempty"`
+}
+
+// CudaComputeCapability is the CUDA compute capability of a device.
+type CudaComputeCapability struct {
+	Major int `json:"major" yaml:"major"`
+	Minor int `json:"minor" yaml:"minor"`
+}
+
+// MigProfile describes the GPU instance profiles supported by a device.
+type MigProfile struct {
+	GpuInstanceProfiles []GpuInstanceProfile `json:"gpuInstanceProfiles" yaml:"gpuInstanceProfiles"`
+}
+
+// GpuInstanceProfile describes a GPU instance profile, along with its possible
+// placements and the compute instance profiles it supports. The Id is one of
+// the nvml.GPU_INSTANCE_PROFILE_* values.
+type GpuInstanceProfile struct {
+	Id                      int                      `json:"id" yaml:"id"`
+	IsP2pSupported          bool                     `json:"isP2pSupported,omitempty" yaml:"isP2pSupported,omitempty"`
+	SliceCount              uint32                   `json:"sliceCount" yaml:"sliceCount"`
+	InstanceCount           uint32                   `json:"instanceCount" yaml:"instanceCount"`
+	MultiprocessorCount     uint32                   `json:"multiprocessorCount" yaml:"multiprocessorCount"`
+	CopyEngineCount         uint32                   `json:"copyEngineCount" yaml:"copyEngineCount"`
+	DecoderCount            uint32                   `json:"decoderCount" yaml:"decoderCount"`
+	EncoderCount            uint32                   `json:"encoderCount" yaml:"encoderCount"`
+	JpegCount               uint32                   `json:"jpegCount" yaml:"jpegCount"`
+	OfaCount                uint32                   `json:"ofaCount" yaml:"ofaCount"`
+	MemorySizeMB            uint64                   `json:"memorySizeMB" yaml:"memorySizeMB"`
+	Placements              []Placement              `json:"placements" yaml:"placements"`
+	ComputeInstanceProfiles []ComputeInstanceProfile `json:"computeInstanceProfiles" yaml:"computeInstanceProfiles"`
+}
+
+// ComputeInstanceProfile describes a compute instance profile along with its
+// possible placements. The Id is one of the nvml.COMPUTE_INSTANCE_PROFILE_*
+// values.
+type ComputeInstanceProfile struct {
+	Id                    int         `json:"id" yaml:"id"`
+	SliceCount            uint32      `json:"sliceCount" yaml:"sliceCount"`
+	InstanceCount         uint32      `json:"instanceCount" yaml:"instanceCount"`
+	MultiprocessorCount   uint32      `json:"multiprocessorCount" yaml:"multiprocessorCount"`
+	SharedCopyEngineCount uint32      `json:"sharedCopyEngineCount" yaml:"sharedCopyEngineCount"`
+	SharedDecoderCount    uint32      `json:"sharedDecoderCount" yaml:"sharedDecoderCount"`
+	SharedEncoderCount    uint32      `json:"sharedEncoderCount" yaml:"sharedEncoderCount"`
+	SharedJpegCount       uint32      `json:"sharedJpegCount" yaml:"sharedJpegCount"`
+	SharedOfaCount        uint32      `json:"sharedOfaCount" yaml:"sharedOfaCount"`
+	Placements            []Placement `json:"placements,omitempty" yaml:"placements,omitempty"`
+}
+
+// Placement is the placement of a GPU or compute instance.
+type Placement struct {
+	Start uint32 `json:"start" yaml:"start"`
+	Size  uint32 `json:"size" yaml:"size"`
+}
+
+// Parse parses a profile in YAML or JSON. Unknown fields are rejected.
+func Parse(data []byte) (*Profile, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var profile Profile
+	if err := decoder.Decode(&profile); err != nil {
+		return nil, fmt.Errorf("error parsing profile: %w", err)
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// LoadFile reads and parses the profile in the specified file.
+func LoadFile(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Validate checks that the devices of the profile can be built.
+func (p *Profile) Validate() error {
+	if len(p.Devices) == 0 {
+		return fmt.Errorf("invalid profile: no devices")
+	}
+	for i, d := range p.Devices {
+		count := d.count()
+		if count < 1 {
+			return fmt.Errorf("invalid profile: devices[%d]: invalid count %d", i, d.Count)
+		}
+		if len(d.PciBusIds) > 0 && len(d.PciBusIds) != count {
+			return fmt.Errorf("invalid profile: devices[%d]: %d PCI bus IDs for %d devices", i, len(d.PciBusIds), count)
+		}
+		if len(d.UUIDs) > 0 && len(d.UUIDs) != count {
+			return fmt.Errorf("invalid profile: devices[%d]: %d UUIDs for %d devices", i, len(d.UUIDs), count)
+		}
+		if d.MigProfile == "" {
+			continue
+		}
+		if _, ok := p.MigProfiles[d.MigProfile]; !ok {
+			return fmt.Errorf("invalid profile: devices[%d]: unknown MIG profile %q", i, d.MigProfile)
+		}
+	}
+	for name, m := range p.MigProfiles {
+		seen := make(map[int]bool)
+		for _, gi := range m.GpuInstanceProfiles {
+			if gi.Id < 0 || gi.Id >= nvml.GPU_INSTANCE_PROFILE_COUNT || seen[gi.Id] {
+				return fmt.Errorf("invalid profile: MIG profile %q: invalid or duplicate GPU instance profile %d", name, gi.Id)
+			}
+			seen[gi.Id] = true
+			seenCi := make(map[int]bool)
+			for _, ci := range gi.ComputeInstanceProfiles {
+				if ci.Id < 0 || ci.Id >= nvml.COMPUTE_INSTANCE_PROFILE_COUNT || seenCi[ci.Id] {
+					return fmt.Errorf("invalid profile: MIG profile %q: GPU instance profile %d: invalid or duplicate compute instance profile %d", name, gi.Id, ci.Id)
+				}
+				seenCi[ci.Id] = true
+			}
+		}
+	}
+	return nil
+}
+
+func (d DeviceProfile) count() int {
+	if d.Count == 0 {
+		return 1
+	}
+	return d.Count
+}
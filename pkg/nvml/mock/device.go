@@ -759,6 +759,9 @@ var _ nvml.Device = &Device{}
 //			SetPowerManagementLimit_v2Func: func(powerValue_v2 *nvml.PowerValue_v2) nvml.Return {
 //				panic("mock out the SetPowerManagementLimit_v2 method")
 //			},
+//			SetPowerMizerMode_v1Func: func(devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return {
+//				panic("mock out the SetPowerMizerMode_v1 method")
+//			},
 //			SetTemperatureThresholdFunc: func(temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return {
 //				panic("mock out the SetTemperatureThreshold method")
 //			},
@@ -1540,6 +1543,9 @@ type Device struct {
 	// SetPowerManagementLimit_v2Func mocks the SetPowerManagementLimit_v2 method.
 	SetPowerManagementLimit_v2Func func(powerValue_v2 *nvml.PowerValue_v2) nvml.Return
 
+	// SetPowerMizerMode_v1Func mocks the SetPowerMizerMode_v1 method.
+	SetPowerMizerMode_v1Func func(devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return
+
 	// SetTemperatureThresholdFunc mocks the SetTemperatureThreshold method.
 	SetTemperatureThresholdFunc func(temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return
 
@@ -2576,6 +2582,11 @@ type Device struct {
 			// PowerValue_v2 is the powerValue_v2 argument value.
 			PowerValue_v2 *nvml.PowerValue_v2
 		}
+		// SetPowerMizerMode_v1 holds details about calls to the SetPowerMizerMode_v1 method.
+		SetPowerMizerMode_v1 []struct {
+			// DevicePowerMizerModes_v1 is the devicePowerMizerModes_v1 argument value.
+			DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+		}
 		// SetTemperatureThreshold holds details about calls to the SetTemperatureThreshold method.
 		SetTemperatureThreshold []struct {
 			// TemperatureThresholds is the temperatureThresholds argument value.
@@ -2877,6 +2888,7 @@ type Device struct {
 	lockSetPersistenceMode                         sync.RWMutex
 	lockSetPowerManagementLimit                    sync.RWMutex
 	lockSetPowerManagementLimit_v2                 sync.RWMutex
+	lockSetPowerMizerMode_v1                       sync.RWMutex
 	lockSetTemperatureThreshold                    sync.RWMutex
 	lockSetVgpuCapabilities                        sync.RWMutex
 	lockSetVgpuHeterogeneousMode                   sync.RWMutex
@@ -10178,6 +10190,38 @@ func (mock *Device) SetPowerManagementLimit_v2Calls() []struct {
 	return calls
 }
 
+// SetPowerMizerMode_v1 calls SetPowerMizerMode_v1Func.
+func (mock *Device) SetPowerMizerMode_v1(devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return {
+	if mock.SetPowerMizerMode_v1Func == nil {
+		panic("Device.SetPowerMizerMode_v1Func: method is nil but Device.SetPowerMizerMode_v1 was just called")
+	}
+	callInfo := struct {
+		DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+	}{
+		DevicePowerMizerModes_v1: devicePowerMizerModes_v1,
+	}
+	mock.lockSetPowerMizerMode_v1.Lock()
+	mock.calls.SetPowerMizerMode_v1 = append(mock.calls.SetPowerMizerMode_v1, callInfo)
+	mock.lockSetPowerMizerMode_v1.Unlock()
+	return mock.SetPowerMizerMode_v1Func(devicePowerMizerModes_v1)
+}
+
+// SetPowerMizerMode_v1Calls gets all the calls that were made to SetPowerMizerMode_v1.
+// Check the length with:
+//
+//	len(mockedDevice.SetPowerMizerMode_v1Calls())
+func (mock *Device) SetPowerMizerMode_v1Calls() []struct {
+	DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+} {
+	var calls []struct {
+		DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+	}
+	mock.lockSetPowerMizerMode_v1.RLock()
+	calls = mock.calls.SetPowerMizerMode_v1
+	mock.lockSetPowerMizerMode_v1.RUnlock()
+	return calls
+}
+
 // SetTemperatureThreshold calls SetTemperatureThresholdFunc.
 func (mock *Device) SetTemperatureThreshold(temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return {
 	if mock.SetTemperatureThresholdFunc == nil {
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgxh100
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+func TestNvLinks(t *testing.T) {
+	server := New()
+	device, ret := server.DeviceGetHandleByPciBusId("0000:9d:00.0")
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	links := make(map[string]int)
+	for link := 0; link < nvml.NVLINK_MAX_LINKS; link++ {
+		state, ret := device.GetNvLinkState(link)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.FEATURE_ENABLED, state)
+
+		version, ret := device.GetNvLinkVersion(link)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, uint32(nvml.NVLINK_VERSION_4_0), version)
+
+		remoteType, ret := device.GetNvLinkRemoteDeviceType(link)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.NVLINK_DEVICE_TYPE_SWITCH, remoteType)
+
+		pciInfo, ret := device.GetNvLinkRemotePciInfo(link)
+		require.Equal(t, nvml.SUCCESS, ret)
+		links[string(pciInfo.BusId[:12])]++
+	}
+	require.Equal(t, map[string]int{
+		"0000:05:00.0": 5,
+		"0000:06:00.0": 4,
+		"0000:07:00.0": 4,
+		"0000:08:00.0": 5,
+	}, links)
+
+	_, ret = device.GetNvLinkState(nvml.NVLINK_MAX_LINKS)
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+}
+
+func TestGpuFabricInfo(t *testing.T) {
+	server := New()
+	device, _ := server.DeviceGetHandleByIndex(3)
+
+	info, ret := device.(*Device).GpuFabricInfoV().V3()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GpuFabricInfo_v3Version, info.Version)
+	require.Equal(t, [16]uint8(ClusterUUID), info.ClusterUuid)
+	require.Equal(t, uint8(nvml.GPU_FABRIC_STATE_COMPLETED), info.State)
+	require.Equal(t, uint8(nvml.GPU_FABRIC_HEALTH_SUMMARY_HEALTHY), info.HealthSummary)
+
+	v1, ret := device.GetGpuFabricInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, info.ClusterUuid, v1.ClusterUuid)
+	require.Equal(t, info.State, v1.State)
+
+	negotiated, ret := device.(*Device).GpuFabricInfoV().Negotiate()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 3, negotiated.Version)
+	require.Equal(t, info, negotiated.Value)
+}
+
+func TestMIGProfiles(t *testing.T) {
+	server := New()
+	device, _ := server.DeviceGetHandleByIndex(0)
+
+	major, minor, ret := device.GetCudaComputeCapability()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 9, major)
+	require.Equal(t, 0, minor)
+
+	giProfileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(60), giProfileInfo.MultiprocessorCount)
+
+	placements, ret := device.GetGpuInstancePossiblePlacements(&giProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, placements, int(giProfileInfo.InstanceCount))
+	gi, ret := device.CreateGpuInstanceWithPlacement(&giProfileInfo, &placements[1])
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	ciProfileInfo, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ciPlacements, ret := gi.GetComputeInstancePossiblePlacements(&ciProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, ciPlacements, int(ciProfileInfo.InstanceCount))
+
+	_, ret = gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_7_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
@@ -18,9 +18,15 @@ var _ nvml.ExtendedInterface = &ExtendedInterface{}
 //
 //		// make and configure a mocked nvml.ExtendedInterface
 //		mockedExtendedInterface := &ExtendedInterface{
+//			LibraryPathFunc: func() (string, error) {
+//				panic("mock out the LibraryPath method")
+//			},
 //			LookupSymbolFunc: func(s string) error {
 //				panic("mock out the LookupSymbol method")
 //			},
+//			SymbolsFunc: func() ([]nvml.SymbolInfo, error) {
+//				panic("mock out the Symbols method")
+//			},
 //		}
 //
 //		// use mockedExtendedInterface in code that requires nvml.ExtendedInterface
@@ -28,18 +34,59 @@ var _ nvml.ExtendedInterface = &ExtendedInterface{}
 //
 //	}
 type ExtendedInterface struct {
+	// LibraryPathFunc mocks the LibraryPath method.
+	LibraryPathFunc func() (string, error)
+
 	// LookupSymbolFunc mocks the LookupSymbol method.
 	LookupSymbolFunc func(s string) error
 
+	// SymbolsFunc mocks the Symbols method.
+	SymbolsFunc func() ([]nvml.SymbolInfo, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// LibraryPath holds details about calls to the LibraryPath method.
+		LibraryPath []struct {
+		}
 		// LookupSymbol holds details about calls to the LookupSymbol method.
 		LookupSymbol []struct {
 			// S is the s argument value.
 			S string
 		}
+		// Symbols holds details about calls to the Symbols method.
+		Symbols []struct {
+		}
 	}
+	lockLibraryPath  sync.RWMutex
 	lockLookupSymbol sync.RWMutex
+	lockSymbols      sync.RWMutex
+}
+
+// LibraryPath calls LibraryPathFunc.
+func (mock *ExtendedInterface) LibraryPath() (string, error) {
+	if mock.LibraryPathFunc == nil {
+		panic("ExtendedInterface.LibraryPathFunc: method is nil but ExtendedInterface.LibraryPath was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockLibraryPath.Lock()
+	mock.calls.LibraryPath = append(mock.calls.LibraryPath, callInfo)
+	mock.lockLibraryPath.Unlock()
+	return mock.LibraryPathFunc()
+}
+
+// LibraryPathCalls gets all the calls that were made to LibraryPath.
+// Check the length with:
+//
+//	len(mockedExtendedInterface.LibraryPathCalls())
+func (mock *ExtendedInterface) LibraryPathCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockLibraryPath.RLock()
+	calls = mock.calls.LibraryPath
+	mock.lockLibraryPath.RUnlock()
+	return calls
 }
 
 // LookupSymbol calls LookupSymbolFunc.
@@ -73,3 +120,30 @@ func (mock *ExtendedInterface) LookupSymbolCalls() []struct {
 	mock.lockLookupSymbol.RUnlock()
 	return calls
 }
+
+// Symbols calls SymbolsFunc.
+func (mock *ExtendedInterface) Symbols() ([]nvml.SymbolInfo, error) {
+	if mock.SymbolsFunc == nil {
+		panic("ExtendedInterface.SymbolsFunc: method is nil but ExtendedInterface.Symbols was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSymbols.Lock()
+	mock.calls.Symbols = append(mock.calls.Symbols, callInfo)
+	mock.lockSymbols.Unlock()
+	return mock.SymbolsFunc()
+}
+
+// SymbolsCalls gets all the calls that were made to Symbols.
+// Check the length with:
+//
+//	len(mockedExtendedInterface.SymbolsCalls())
+func (mock *ExtendedInterface) SymbolsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSymbols.RLock()
+	calls = mock.calls.Symbols
+	mock.lockSymbols.RUnlock()
+	return calls
+}
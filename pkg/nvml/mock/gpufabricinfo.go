@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// GpuFabricInfoHandler gets the fabric info of a mock device for each version
+// of the struct. Since an nvml.GpuFabricInfoHandler can only be returned for
+// the devices of an NVML library, mock devices that report their fabric info
+// return this handler from their GpuFabricInfoV method instead (see
+// GpuFabricInfoVGetter).
+type GpuFabricInfoHandler struct {
+	// Get fills in the fabric info for the Version of the specified struct.
+	// The Version is zero if the unversioned struct is requested.
+	Get func(*nvml.GpuFabricInfoV) nvml.Return
+}
+
+// GpuFabricInfoVGetter is implemented by the mock devices that report their
+// fabric info using a GpuFabricInfoHandler.
+type GpuFabricInfoVGetter interface {
+	GpuFabricInfoV() GpuFabricInfoHandler
+}
+
+func (handler GpuFabricInfoHandler) V1() (nvml.GpuFabricInfo, nvml.Return) {
+	var info nvml.GpuFabricInfoV
+	ret := handler.Get(&info)
+	return nvml.GpuFabricInfo{
+		ClusterUuid: info.ClusterUuid,
+		Status:      info.Status,
+		CliqueId:    info.CliqueId,
+		State:       info.State,
+	}, ret
+}
+
+func (handler GpuFabricInfoHandler) V2() (nvml.GpuFabricInfo_v2, nvml.Return) {
+	info := nvml.NewGpuFabricInfo_v2()
+	infoV := nvml.GpuFabricInfoV{Version: info.Version}
+	ret := handler.Get(&infoV)
+	info.ClusterUuid = infoV.ClusterUuid
+	info.Status = infoV.Status
+	info.CliqueId = infoV.CliqueId
+	info.State = infoV.State
+	info.HealthMask = infoV.HealthMask
+	return info, ret
+}
+
+func (handler GpuFabricInfoHandler) V3() (nvml.GpuFabricInfo_v3, nvml.Return) {
+	infoV := nvml.GpuFabricInfoV(nvml.NewGpuFabricInfo_v3())
+	ret := handler.Get(&infoV)
+	return nvml.GpuFabricInfo_v3(infoV), ret
+}
+
+// Negotiate gets the fabric info using the latest version of the struct that
+// is supported by the mock device, like nvml.GpuFabricInfoHandler.Negotiate.
+func (handler GpuFabricInfoHandler) Negotiate() (nvml.Negotiated[nvml.GpuFabricInfo_v3], nvml.Return) {
+	v3, ret := handler.V3()
+	if ret != nvml.ERROR_ARGUMENT_VERSION_MISMATCH {
+		return nvml.Negotiated[nvml.GpuFabricInfo_v3]{Value: v3, Version: 3}, ret
+	}
+
+	v2, ret := handler.V2()
+	if ret != nvml.ERROR_ARGUMENT_VERSION_MISMATCH {
+		return nvml.Negotiated[nvml.GpuFabricInfo_v3]{
+			Value: nvml.GpuFabricInfo_v3{
+				Version:     v2.Version,
+				ClusterUuid: v2.ClusterUuid,
+				Status:      v2.Status,
+				CliqueId:    v2.CliqueId,
+				State:       v2.State,
+				HealthMask:  v2.HealthMask,
+			},
+			Version:     2,
+			Unavailable: []string{"HealthSummary"},
+		}, ret
+	}
+
+	v1, ret := handler.V1()
+	return nvml.Negotiated[nvml.GpuFabricInfo_v3]{
+		Value: nvml.GpuFabricInfo_v3{
+			ClusterUuid: v1.ClusterUuid,
+			Status:      v1.Status,
+			CliqueId:    v1.CliqueId,
+			State:       v1.State,
+		},
+		Version:     1,
+		Unavailable: []string{"HealthMask", "HealthSummary"},
+	}, ret
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+func TestGpuFabricInfoHandler(t *testing.T) {
+	// The handler simulates a device that supports GpuFabricInfo_v2.
+	var versions []uint32
+	handler := GpuFabricInfoHandler{
+		Get: func(info *nvml.GpuFabricInfoV) nvml.Return {
+			versions = append(versions, info.Version)
+			if info.Version == nvml.GpuFabricInfo_v3Version {
+				return nvml.ERROR_ARGUMENT_VERSION_MISMATCH
+			}
+			info.CliqueId = 5
+			info.State = nvml.GPU_FABRIC_STATE_COMPLETED
+			info.HealthMask = 8
+			return nvml.SUCCESS
+		},
+	}
+
+	v1, ret := handler.V1()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.GpuFabricInfo{CliqueId: 5, State: nvml.GPU_FABRIC_STATE_COMPLETED}, v1)
+
+	negotiated, ret := handler.Negotiate()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, negotiated.Version)
+	require.Equal(t, nvml.GpuFabricInfo_v3{Version: nvml.GpuFabricInfo_v2Version, CliqueId: 5, State: nvml.GPU_FABRIC_STATE_COMPLETED, HealthMask: 8}, negotiated.Value)
+	require.False(t, negotiated.IsAvailable("HealthSummary"))
+	require.Equal(t, []uint32{0, nvml.GpuFabricInfo_v3Version, nvml.GpuFabricInfo_v2Version}, versions)
+}
@@ -780,6 +780,9 @@ var _ nvml.Interface = &Interface{}
 //			DeviceSetPowerManagementLimit_v2Func: func(device nvml.Device, powerValue_v2 *nvml.PowerValue_v2) nvml.Return {
 //				panic("mock out the DeviceSetPowerManagementLimit_v2 method")
 //			},
+//			DeviceSetPowerMizerMode_v1Func: func(device nvml.Device, devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return {
+//				panic("mock out the DeviceSetPowerMizerMode_v1 method")
+//			},
 //			DeviceSetTemperatureThresholdFunc: func(device nvml.Device, temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return {
 //				panic("mock out the DeviceSetTemperatureThreshold method")
 //			},
@@ -1080,6 +1083,9 @@ var _ nvml.Interface = &Interface{}
 //			VgpuInstanceGetMetadataFunc: func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuMetadata, nvml.Return) {
 //				panic("mock out the VgpuInstanceGetMetadata method")
 //			},
+//			VgpuInstanceGetPlacementIdFunc: func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuPlacementId, nvml.Return) {
+//				panic("mock out the VgpuInstanceGetPlacementId method")
+//			},
 //			VgpuInstanceGetRuntimeStateSizeFunc: func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuRuntimeState, nvml.Return) {
 //				panic("mock out the VgpuInstanceGetRuntimeStateSize method")
 //			},
@@ -1110,6 +1116,9 @@ var _ nvml.Interface = &Interface{}
 //			VgpuTypeGetDeviceIDFunc: func(vgpuTypeId nvml.VgpuTypeId) (uint64, uint64, nvml.Return) {
 //				panic("mock out the VgpuTypeGetDeviceID method")
 //			},
+//			VgpuTypeGetFbReservationFunc: func(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return) {
+//				panic("mock out the VgpuTypeGetFbReservation method")
+//			},
 //			VgpuTypeGetFrameRateLimitFunc: func(vgpuTypeId nvml.VgpuTypeId) (uint32, nvml.Return) {
 //				panic("mock out the VgpuTypeGetFrameRateLimit method")
 //			},
@@ -1119,6 +1128,9 @@ var _ nvml.Interface = &Interface{}
 //			VgpuTypeGetGpuInstanceProfileIdFunc: func(vgpuTypeId nvml.VgpuTypeId) (uint32, nvml.Return) {
 //				panic("mock out the VgpuTypeGetGpuInstanceProfileId method")
 //			},
+//			VgpuTypeGetGspHeapSizeFunc: func(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return) {
+//				panic("mock out the VgpuTypeGetGspHeapSize method")
+//			},
 //			VgpuTypeGetLicenseFunc: func(vgpuTypeId nvml.VgpuTypeId) (string, nvml.Return) {
 //				panic("mock out the VgpuTypeGetLicense method")
 //			},
@@ -1909,6 +1921,9 @@ type Interface struct {
 	// DeviceSetPowerManagementLimit_v2Func mocks the DeviceSetPowerManagementLimit_v2 method.
 	DeviceSetPowerManagementLimit_v2Func func(device nvml.Device, powerValue_v2 *nvml.PowerValue_v2) nvml.Return
 
+	// DeviceSetPowerMizerMode_v1Func mocks the DeviceSetPowerMizerMode_v1 method.
+	DeviceSetPowerMizerMode_v1Func func(device nvml.Device, devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return
+
 	// DeviceSetTemperatureThresholdFunc mocks the DeviceSetTemperatureThreshold method.
 	DeviceSetTemperatureThresholdFunc func(device nvml.Device, temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return
 
@@ -2209,6 +2224,9 @@ type Interface struct {
 	// VgpuInstanceGetMetadataFunc mocks the VgpuInstanceGetMetadata method.
 	VgpuInstanceGetMetadataFunc func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuMetadata, nvml.Return)
 
+	// VgpuInstanceGetPlacementIdFunc mocks the VgpuInstanceGetPlacementId method.
+	VgpuInstanceGetPlacementIdFunc func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuPlacementId, nvml.Return)
+
 	// VgpuInstanceGetRuntimeStateSizeFunc mocks the VgpuInstanceGetRuntimeStateSize method.
 	VgpuInstanceGetRuntimeStateSizeFunc func(vgpuInstance nvml.VgpuInstance) (nvml.VgpuRuntimeState, nvml.Return)
 
@@ -2239,6 +2257,9 @@ type Interface struct {
 	// VgpuTypeGetDeviceIDFunc mocks the VgpuTypeGetDeviceID method.
 	VgpuTypeGetDeviceIDFunc func(vgpuTypeId nvml.VgpuTypeId) (uint64, uint64, nvml.Return)
 
+	// VgpuTypeGetFbReservationFunc mocks the VgpuTypeGetFbReservation method.
+	VgpuTypeGetFbReservationFunc func(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return)
+
 	// VgpuTypeGetFrameRateLimitFunc mocks the VgpuTypeGetFrameRateLimit method.
 	VgpuTypeGetFrameRateLimitFunc func(vgpuTypeId nvml.VgpuTypeId) (uint32, nvml.Return)
 
@@ -2248,6 +2269,9 @@ type Interface struct {
 	// VgpuTypeGetGpuInstanceProfileIdFunc mocks the VgpuTypeGetGpuInstanceProfileId method.
 	VgpuTypeGetGpuInstanceProfileIdFunc func(vgpuTypeId nvml.VgpuTypeId) (uint32, nvml.Return)
 
+	// VgpuTypeGetGspHeapSizeFunc mocks the VgpuTypeGetGspHeapSize method.
+	VgpuTypeGetGspHeapSizeFunc func(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return)
+
 	// VgpuTypeGetLicenseFunc mocks the VgpuTypeGetLicense method.
 	VgpuTypeGetLicenseFunc func(vgpuTypeId nvml.VgpuTypeId) (string, nvml.Return)
 
@@ -3795,6 +3819,13 @@ type Interface struct {
 			// PowerValue_v2 is the powerValue_v2 argument value.
 			PowerValue_v2 *nvml.PowerValue_v2
 		}
+		// DeviceSetPowerMizerMode_v1 holds details about calls to the DeviceSetPowerMizerMode_v1 method.
+		DeviceSetPowerMizerMode_v1 []struct {
+			// Device is the device argument value.
+			Device nvml.Device
+			// DevicePowerMizerModes_v1 is the devicePowerMizerModes_v1 argument value.
+			DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+		}
 		// DeviceSetTemperatureThreshold holds details about calls to the DeviceSetTemperatureThreshold method.
 		DeviceSetTemperatureThreshold []struct {
 			// Device is the device argument value.
@@ -4317,6 +4348,11 @@ type Interface struct {
 			// VgpuInstance is the vgpuInstance argument value.
 			VgpuInstance nvml.VgpuInstance
 		}
+		// VgpuInstanceGetPlacementId holds details about calls to the VgpuInstanceGetPlacementId method.
+		VgpuInstanceGetPlacementId []struct {
+			// VgpuInstance is the vgpuInstance argument value.
+			VgpuInstance nvml.VgpuInstance
+		}
 		// VgpuInstanceGetRuntimeStateSize holds details about calls to the VgpuInstanceGetRuntimeStateSize method.
 		VgpuInstanceGetRuntimeStateSize []struct {
 			// VgpuInstance is the vgpuInstance argument value.
@@ -4371,6 +4407,11 @@ type Interface struct {
 			// VgpuTypeId is the vgpuTypeId argument value.
 			VgpuTypeId nvml.VgpuTypeId
 		}
+		// VgpuTypeGetFbReservation holds details about calls to the VgpuTypeGetFbReservation method.
+		VgpuTypeGetFbReservation []struct {
+			// VgpuTypeId is the vgpuTypeId argument value.
+			VgpuTypeId nvml.VgpuTypeId
+		}
 		// VgpuTypeGetFrameRateLimit holds details about calls to the VgpuTypeGetFrameRateLimit method.
 		VgpuTypeGetFrameRateLimit []struct {
 			// VgpuTypeId is the vgpuTypeId argument value.
@@ -4386,6 +4427,11 @@ type Interface struct {
 			// VgpuTypeId is the vgpuTypeId argument value.
 			VgpuTypeId nvml.VgpuTypeId
 		}
+		// VgpuTypeGetGspHeapSize holds details about calls to the VgpuTypeGetGspHeapSize method.
+		VgpuTypeGetGspHeapSize []struct {
+			// VgpuTypeId is the vgpuTypeId argument value.
+			VgpuTypeId nvml.VgpuTypeId
+		}
 		// VgpuTypeGetLicense holds details about calls to the VgpuTypeGetLicense method.
 		VgpuTypeGetLicense []struct {
 			// VgpuTypeId is the vgpuTypeId argument value.
@@ -4680,6 +4726,7 @@ type Interface struct {
 	lockDeviceSetPersistenceMode                         sync.RWMutex
 	lockDeviceSetPowerManagementLimit                    sync.RWMutex
 	lockDeviceSetPowerManagementLimit_v2                 sync.RWMutex
+	lockDeviceSetPowerMizerMode_v1                       sync.RWMutex
 	lockDeviceSetTemperatureThreshold                    sync.RWMutex
 	lockDeviceSetVgpuCapabilities                        sync.RWMutex
 	lockDeviceSetVgpuHeterogeneousMode                   sync.RWMutex
@@ -4780,6 +4827,7 @@ type Interface struct {
 	lockVgpuInstanceGetLicenseStatus                     sync.RWMutex
 	lockVgpuInstanceGetMdevUUID                          sync.RWMutex
 	lockVgpuInstanceGetMetadata                          sync.RWMutex
+	lockVgpuInstanceGetPlacementId                       sync.RWMutex
 	lockVgpuInstanceGetRuntimeStateSize                  sync.RWMutex
 	lockVgpuInstanceGetType                              sync.RWMutex
 	lockVgpuInstanceGetUUID                              sync.RWMutex
@@ -4790,9 +4838,11 @@ type Interface struct {
 	lockVgpuTypeGetCapabilities                          sync.RWMutex
 	lockVgpuTypeGetClass                                 sync.RWMutex
 	lockVgpuTypeGetDeviceID                              sync.RWMutex
+	lockVgpuTypeGetFbReservation                         sync.RWMutex
 	lockVgpuTypeGetFrameRateLimit                        sync.RWMutex
 	lockVgpuTypeGetFramebufferSize                       sync.RWMutex
 	lockVgpuTypeGetGpuInstanceProfileId                  sync.RWMutex
+	lockVgpuTypeGetGspHeapSize                           sync.RWMutex
 	lockVgpuTypeGetLicense                               sync.RWMutex
 	lockVgpuTypeGetMaxInstances                          sync.RWMutex
 	lockVgpuTypeGetMaxInstancesPerGpuInstance            sync.RWMutex
@@ -13436,6 +13486,42 @@ func (mock *Interface) DeviceSetPowerManagementLimit_v2Calls() []struct {
 	return calls
 }
 
+// DeviceSetPowerMizerMode_v1 calls DeviceSetPowerMizerMode_v1Func.
+func (mock *Interface) DeviceSetPowerMizerMode_v1(device nvml.Device, devicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1) nvml.Return {
+	if mock.DeviceSetPowerMizerMode_v1Func == nil {
+		panic("Interface.DeviceSetPowerMizerMode_v1Func: method is nil but Interface.DeviceSetPowerMizerMode_v1 was just called")
+	}
+	callInfo := struct {
+		Device                   nvml.Device
+		DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+	}{
+		Device:                   device,
+		DevicePowerMizerModes_v1: devicePowerMizerModes_v1,
+	}
+	mock.lockDeviceSetPowerMizerMode_v1.Lock()
+	mock.calls.DeviceSetPowerMizerMode_v1 = append(mock.calls.DeviceSetPowerMizerMode_v1, callInfo)
+	mock.lockDeviceSetPowerMizerMode_v1.Unlock()
+	return mock.DeviceSetPowerMizerMode_v1Func(device, devicePowerMizerModes_v1)
+}
+
+// DeviceSetPowerMizerMode_v1Calls gets all the calls that were made to DeviceSetPowerMizerMode_v1.
+// Check the length with:
+//
+//	len(mockedInterface.DeviceSetPowerMizerMode_v1Calls())
+func (mock *Interface) DeviceSetPowerMizerMode_v1Calls() []struct {
+	Device                   nvml.Device
+	DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+} {
+	var calls []struct {
+		Device                   nvml.Device
+		DevicePowerMizerModes_v1 *nvml.DevicePowerMizerModes_v1
+	}
+	mock.lockDeviceSetPowerMizerMode_v1.RLock()
+	calls = mock.calls.DeviceSetPowerMizerMode_v1
+	mock.lockDeviceSetPowerMizerMode_v1.RUnlock()
+	return calls
+}
+
 // DeviceSetTemperatureThreshold calls DeviceSetTemperatureThresholdFunc.
 func (mock *Interface) DeviceSetTemperatureThreshold(device nvml.Device, temperatureThresholds nvml.TemperatureThresholds, n int) nvml.Return {
 	if mock.DeviceSetTemperatureThresholdFunc == nil {
@@ -16660,6 +16746,38 @@ func (mock *Interface) VgpuInstanceGetMetadataCalls() []struct {
 	return calls
 }
 
+// VgpuInstanceGetPlacementId calls VgpuInstanceGetPlacementIdFunc.
+func (mock *Interface) VgpuInstanceGetPlacementId(vgpuInstance nvml.VgpuInstance) (nvml.VgpuPlacementId, nvml.Return) {
+	if mock.VgpuInstanceGetPlacementIdFunc == nil {
+		panic("Interface.VgpuInstanceGetPlacementIdFunc: method is nil but Interface.VgpuInstanceGetPlacementId was just called")
+	}
+	callInfo := struct {
+		VgpuInstance nvml.VgpuInstance
+	}{
+		VgpuInstance: vgpuInstance,
+	}
+	mock.lockVgpuInstanceGetPlacementId.Lock()
+	mock.calls.VgpuInstanceGetPlacementId = append(mock.calls.VgpuInstanceGetPlacementId, callInfo)
+	mock.lockVgpuInstanceGetPlacementId.Unlock()
+	return mock.VgpuInstanceGetPlacementIdFunc(vgpuInstance)
+}
+
+// VgpuInstanceGetPlacementIdCalls gets all the calls that were made to VgpuInstanceGetPlacementId.
+// Check the length with:
+//
+//	len(mockedInterface.VgpuInstanceGetPlacementIdCalls())
+func (mock *Interface) VgpuInstanceGetPlacementIdCalls() []struct {
+	VgpuInstance nvml.VgpuInstance
+} {
+	var calls []struct {
+		VgpuInstance nvml.VgpuInstance
+	}
+	mock.lockVgpuInstanceGetPlacementId.RLock()
+	calls = mock.calls.VgpuInstanceGetPlacementId
+	mock.lockVgpuInstanceGetPlacementId.RUnlock()
+	return calls
+}
+
 // VgpuInstanceGetRuntimeStateSize calls VgpuInstanceGetRuntimeStateSizeFunc.
 func (mock *Interface) VgpuInstanceGetRuntimeStateSize(vgpuInstance nvml.VgpuInstance) (nvml.VgpuRuntimeState, nvml.Return) {
 	if mock.VgpuInstanceGetRuntimeStateSizeFunc == nil {
@@ -16988,6 +17106,38 @@ func (mock *Interface) VgpuTypeGetDeviceIDCalls() []struct {
 	return calls
 }
 
+// VgpuTypeGetFbReservation calls VgpuTypeGetFbReservationFunc.
+func (mock *Interface) VgpuTypeGetFbReservation(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return) {
+	if mock.VgpuTypeGetFbReservationFunc == nil {
+		panic("Interface.VgpuTypeGetFbReservationFunc: method is nil but Interface.VgpuTypeGetFbReservation was just called")
+	}
+	callInfo := struct {
+		VgpuTypeId nvml.VgpuTypeId
+	}{
+		VgpuTypeId: vgpuTypeId,
+	}
+	mock.lockVgpuTypeGetFbReservation.Lock()
+	mock.calls.VgpuTypeGetFbReservation = append(mock.calls.VgpuTypeGetFbReservation, callInfo)
+	mock.lockVgpuTypeGetFbReservation.Unlock()
+	return mock.VgpuTypeGetFbReservationFunc(vgpuTypeId)
+}
+
+// VgpuTypeGetFbReservationCalls gets all the calls that were made to VgpuTypeGetFbReservation.
+// Check the length with:
+//
+//	len(mockedInterface.VgpuTypeGetFbReservationCalls())
+func (mock *Interface) VgpuTypeGetFbReservationCalls() []struct {
+	VgpuTypeId nvml.VgpuTypeId
+} {
+	var calls []struct {
+		VgpuTypeId nvml.VgpuTypeId
+	}
+	mock.lockVgpuTypeGetFbReservation.RLock()
+	calls = mock.calls.VgpuTypeGetFbReservation
+	mock.lockVgpuTypeGetFbReservation.RUnlock()
+	return calls
+}
+
 // VgpuTypeGetFrameRateLimit calls VgpuTypeGetFrameRateLimitFunc.
 func (mock *Interface) VgpuTypeGetFrameRateLimit(vgpuTypeId nvml.VgpuTypeId) (uint32, nvml.Return) {
 	if mock.VgpuTypeGetFrameRateLimitFunc == nil {
@@ -17084,6 +17234,38 @@ func (mock *Interface) VgpuTypeGetGpuInstanceProfileIdCalls() []struct {
 	return calls
 }
 
+// VgpuTypeGetGspHeapSize calls VgpuTypeGetGspHeapSizeFunc.
+func (mock *Interface) VgpuTypeGetGspHeapSize(vgpuTypeId nvml.VgpuTypeId) (uint64, nvml.Return) {
+	if mock.VgpuTypeGetGspHeapSizeFunc == nil {
+		panic("Interface.VgpuTypeGetGspHeapSizeFunc: method is nil but Interface.VgpuTypeGetGspHeapSize was just called")
+	}
+	callInfo := struct {
+		VgpuTypeId nvml.VgpuTypeId
+	}{
+		VgpuTypeId: vgpuTypeId,
+	}
+	mock.lockVgpuTypeGetGspHeapSize.Lock()
+	mock.calls.VgpuTypeGetGspHeapSize = append(mock.calls.VgpuTypeGetGspHeapSize, callInfo)
+	mock.lockVgpuTypeGetGspHeapSize.Unlock()
+	return mock.VgpuTypeGetGspHeapSizeFunc(vgpuTypeId)
+}
+
+// VgpuTypeGetGspHeapSizeCalls gets all the calls that were made to VgpuTypeGetGspHeapSize.
+// Check the length with:
+//
+//	len(mockedInterface.VgpuTypeGetGspHeapSizeCalls())
+func (mock *Interface) VgpuTypeGetGspHeapSizeCalls() []struct {
+	VgpuTypeId nvml.VgpuTypeId
+} {
+	var calls []struct {
+		VgpuTypeId nvml.VgpuTypeId
+	}
+	mock.lockVgpuTypeGetGspHeapSize.RLock()
+	calls = mock.calls.VgpuTypeGetGspHeapSize
+	mock.lockVgpuTypeGetGspHeapSize.RUnlock()
+	return calls
+}
+
 // VgpuTypeGetLicense calls VgpuTypeGetLicenseFunc.
 func (mock *Interface) VgpuTypeGetLicense(vgpuTypeId nvml.VgpuTypeId) (string, nvml.Return) {
 	if mock.VgpuTypeGetLicenseFunc == nil {
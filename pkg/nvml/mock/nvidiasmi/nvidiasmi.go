@@ -0,0 +1,679 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvidiasmi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// Server is a mock server with the devices of a report. The methods returning
+// a value of the report return ERROR_NOT_SUPPORTED if it is reported as N/A
+// (or ERROR_NO_PERMISSION, ERROR_RESET_REQUIRED, ERROR_GPU_IS_LOST or
+// ERROR_UNKNOWN if nvidia-smi reports the corresponding error instead). As for
+// the other mock servers, the remaining methods are not implemented.
+type Server struct {
+	mock.Interface
+	mock.ExtendedInterface
+	Log     *Log
+	Devices []nvml.Device
+}
+
+// Device is a device of a report.
+type Device struct {
+	mock.Device
+	Gpu        *Gpu
+	MigDevices []*MigDevice
+	processes  []process
+}
+
+// MigDevice is a MIG device of a device of a report. The report does not
+// include the UUIDs of MIG devices, which are derived from the UUID of their
+// parent device and their GPU and compute instance IDs instead.
+type MigDevice struct {
+	mock.Device
+	MigDevice         *GpuMigDevice
+	Parent            *Device
+	UUID              string
+	GpuInstanceID     uint32
+	ComputeInstanceID uint32
+}
+
+// process is a process running on a device.
+type process struct {
+	info     nvml.ProcessInfo
+	name     string
+	compute  bool
+	graphics bool
+}
+
+var _ nvml.Interface = (*Server)(nil)
+var _ nvml.Device = (*Device)(nil)
+var _ nvml.Device = (*MigDevice)(nil)
+
+// New builds a mock server with the devices of the specified report. An error
+// is returned if a value of the report cannot be converted to the type that
+// NVML returns it as.
+func New(log *Log) (*Server, error) {
+	if len(log.Gpus) == 0 {
+		return nil, fmt.Errorf("invalid nvidia-smi report: no GPUs")
+	}
+
+	c := &converter{}
+	server := &Server{Log: log}
+	for i := range log.Gpus {
+		c.prefix = fmt.Sprintf("gpu %d: ", i)
+		server.Devices = append(server.Devices, newDevice(c, i, &log.Gpus[i]))
+	}
+	c.prefix = ""
+	server.setMockFuncs(c)
+	if len(c.errs) > 0 {
+		return nil, fmt.Errorf("invalid nvidia-smi report: %w", errors.Join(c.errs...))
+	}
+	return server, nil
+}
+
+// NewFromFile builds a mock server with the devices of the report in the
+// specified file.
+func NewFromFile(path string) (*Server, error) {
+	log, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(log)
+}
+
+func newDevice(c *converter, index int, gpu *Gpu) *Device {
+	device := &Device{
+		Gpu: gpu,
+	}
+	device.setMockFuncs(c, index)
+	for i := range gpu.MigDevices {
+		c.prefix = fmt.Sprintf("gpu %d: mig device %d: ", index, i)
+		device.MigDevices = append(device.MigDevices, newMigDevice(c, device, &gpu.MigDevices[i]))
+	}
+	return device
+}
+
+func newMigDevice(c *converter, parent *Device, migDevice *GpuMigDevice) *MigDevice {
+	m := &MigDevice{
+		MigDevice:         migDevice,
+		Parent:            parent,
+		GpuInstanceID:     instanceID(c, "gpu_instance_id", migDevice.GpuInstanceID),
+		ComputeInstanceID: instanceID(c, "compute_instance_id", migDevice.ComputeInstanceID),
+	}
+	name := fmt.Sprintf("%s/%d/%d", parent.Gpu.UUID, m.GpuInstanceID, m.ComputeInstanceID)
+	m.UUID = "MIG-" + uuid.NewSHA1(uuid.Nil, []byte(name)).String()
+	m.setMockFuncs(c)
+	return m
+}
+
+// instanceID converts the ID of a GPU or compute instance, which is reported
+// as N/A for processes that do not run in one. Such IDs are returned as
+// 0xFFFFFFFF, as NVML does.
+func instanceID(c *converter, field string, s string) uint32 {
+	id := convert(c, field, s, parseUint32)
+	if id.ret != nvml.SUCCESS {
+		return math.MaxUint32
+	}
+	return id.value
+}
+
+func (s *Server) setMockFuncs(c *converter) {
+	s.ExtensionsFunc = func() nvml.ExtendedInterface {
+		return s
+	}
+
+	s.LookupSymbolFunc = func(symbol string) error {
+		return nil
+	}
+
+	s.InitFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.ShutdownFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.SystemGetDriverVersionFunc = convert(c, "driver_version", s.Log.DriverVersion, parseString).get
+	s.SystemGetCudaDriverVersionFunc = convert(c, "cuda_version", s.Log.CudaVersion, parseCudaVersion).get
+
+	s.SystemGetProcessNameFunc = func(pid int) (string, nvml.Return) {
+		for _, d := range s.Devices {
+			for _, p := range d.(*Device).processes {
+				if int(p.info.Pid) == pid {
+					return p.name, nvml.SUCCESS
+				}
+			}
+		}
+		return "", nvml.ERROR_NOT_FOUND
+	}
+
+	s.DeviceGetCountFunc = func() (int, nvml.Return) {
+		return len(s.Devices), nvml.SUCCESS
+	}
+
+	s.DeviceGetHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		if index < 0 || index >= len(s.Devices) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return s.Devices[index], nvml.SUCCESS
+	}
+
+	s.DeviceGetHandleByUUIDFunc = func(uuid string) (nvml.Device, nvml.Return) {
+		for _, d := range s.Devices {
+			if uuid == d.(*Device).Gpu.UUID {
+				return d, nvml.SUCCESS
+			}
+			for _, m := range d.(*Device).MigDevices {
+				if uuid == m.UUID {
+					return m, nvml.SUCCESS
+				}
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	s.DeviceGetHandleByPciBusIdFunc = func(busID string) (nvml.Device, nvml.Return) {
+		address, ok := parsePciAddress(busID)
+		if !ok {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		for _, d := range s.Devices {
+			if other, ok := parsePciAddress(d.(*Device).Gpu.Pci.PciBusID); ok && address == other {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	s.DeviceGetHandleBySerialFunc = func(serial string) (nvml.Device, nvml.Return) {
+		for _, d := range s.Devices {
+			if serial == d.(*Device).Gpu.Serial {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+}
+
+func (d *Device) setMockFuncs(c *converter, index int) {
+	gpu := d.Gpu
+
+	d.GetIndexFunc = func() (int, nvml.Return) {
+		return index, nvml.SUCCESS
+	}
+
+	d.IsMigDeviceHandleFunc = func() (bool, nvml.Return) {
+		return false, nvml.SUCCESS
+	}
+
+	d.GetNameFunc = convert(c, "product_name", gpu.ProductName, parseString).get
+	d.GetBrandFunc = convert(c, "product_brand", gpu.ProductBrand, parseBrand).get
+	d.GetArchitectureFunc = convert(c, "product_architecture", gpu.ProductArchitecture, parseArchitecture).get
+	d.GetPersistenceModeFunc = convert(c, "persistence_mode", gpu.PersistenceMode, parseEnableState).get
+	d.GetSerialFunc = convert(c, "serial", gpu.Serial, parseString).get
+	d.GetUUIDFunc = convert(c, "uuid", gpu.UUID, parseString).get
+	d.GetMinorNumberFunc = convert(c, "minor_number", gpu.MinorNumber, parseInt).get
+	d.GetVbiosVersionFunc = convert(c, "vbios_version", gpu.VbiosVersion, parseString).get
+	d.GetMultiGpuBoardFunc = convert(c, "multigpu_board", gpu.MultiGpuBoard, parseYesNo).get
+	d.GetBoardIdFunc = convert(c, "board_id", gpu.BoardID, parseHex).get
+	d.GetBoardPartNumberFunc = convert(c, "board_part_number", gpu.BoardPartNumber, parseString).get
+	d.GetInforomImageVersionFunc = convert(c, "inforom_version.img_version", gpu.InforomImageVersion, parseString).get
+	d.GetPerformanceStateFunc = convert(c, "performance_state", gpu.PerformanceState, parsePstate).get
+	d.GetComputeModeFunc = convert(c, "compute_mode", gpu.ComputeMode, parseComputeMode).get
+
+	d.setPciFuncs(c)
+	d.setMemoryFuncs(c)
+	d.setEccFuncs(c)
+	d.setTemperatureFuncs(c)
+	d.setPowerFuncs(c)
+	d.setClockFuncs(c)
+	d.setProcessFuncs(c)
+
+	currentMig := convert(c, "mig_mode.current_mig", gpu.MigMode.CurrentMig, parseMigMode)
+	pendingMig := convert(c, "mig_mode.pending_mig", gpu.MigMode.PendingMig, parseMigMode)
+	d.GetMigModeFunc = func() (int, int, nvml.Return) {
+		if currentMig.ret != nvml.SUCCESS {
+			return 0, 0, currentMig.ret
+		}
+		if pendingMig.ret != nvml.SUCCESS {
+			return 0, 0, pendingMig.ret
+		}
+		return currentMig.value, pendingMig.value, nvml.SUCCESS
+	}
+
+	d.GetMigDeviceHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		for _, m := range d.MigDevices {
+			if m.MigDevice.Index == strconv.Itoa(index) {
+				return m, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+}
+
+func (d *Device) setPciFuncs(c *converter) {
+	pci := d.Gpu.Pci
+
+	domain := convert(c, "pci.pci_domain", pci.PciDomain, parseHex)
+	bus := convert(c, "pci.pci_bus", pci.PciBus, parseHex)
+	device := convert(c, "pci.pci_device", pci.PciDevice, parseHex)
+	deviceID := convert(c, "pci.pci_device_id", pci.PciDeviceID, parseHex)
+	subSystemID := convert(c, "pci.pci_sub_system_id", pci.PciSubSystemID, parseHex)
+	busID := convert(c, "pci.pci_bus_id", pci.PciBusID, parseString)
+	d.GetPciInfoFunc = func() (nvml.PciInfo, nvml.Return) {
+		for _, r := range []reading[uint32]{domain, bus, device, deviceID, subSystemID} {
+			if r.ret != nvml.SUCCESS {
+				return nvml.PciInfo{}, r.ret
+			}
+		}
+		if busID.ret != nvml.SUCCESS {
+			return nvml.PciInfo{}, busID.ret
+		}
+		p := nvml.PciInfo{
+			Domain:         domain.value,
+			Bus:            bus.value,
+			Device:         device.value,
+			PciDeviceId:    deviceID.value,
+			PciSubSystemId: subSystemID.value,
+		}
+		// The bus IDs are NUL-terminated.
+		legacy := fmt.Sprintf("%04X:%02X:%02X.0", domain.value, bus.value, device.value)
+		copy(p.BusIdLegacy[:len(p.BusIdLegacy)-1], legacy)
+		copy(p.BusId[:len(p.BusId)-1], busID.value)
+		return p, nvml.SUCCESS
+	}
+
+	d.GetMaxPcieLinkGenerationFunc = convert(c, "pcie_gen.max_link_gen", pci.MaxLinkGen, parseInt).get
+	d.GetCurrPcieLinkGenerationFunc = convert(c, "pcie_gen.current_link_gen", pci.CurrentLinkGen, parseInt).get
+	d.GetMaxPcieLinkWidthFunc = convert(c, "link_widths.max_link_width", pci.MaxLinkWidth, parseLinkWidth).get
+	d.GetCurrPcieLinkWidthFunc = convert(c, "link_widths.current_link_width", pci.CurrentLinkWidth, parseLinkWidth).get
+}
+
+func (d *Device) setMemoryFuncs(c *converter) {
+	memoryInfo, bar1MemoryInfo := convertMemoryUsage(c, d.Gpu.FbMemoryUsage, d.Gpu.Bar1MemoryUsage)
+	d.GetMemoryInfoFunc = func() (nvml.Memory, nvml.Return) {
+		memory, ret := memoryInfo.get()
+		return nvml.Memory{Total: memory.Total, Free: memory.Free, Used: memory.Used}, ret
+	}
+	d.GetMemoryInfo_v2Func = memoryInfo.get
+	d.GetBAR1MemoryInfoFunc = bar1MemoryInfo.get
+}
+
+// convertMemoryUsage converts the framebuffer and BAR1 memory usage of a
+// device or MIG device.
+func convertMemoryUsage(c *converter, fb MemoryUsage, bar1 MemoryUsage) (reading[nvml.Memory_v2], reading[nvml.BAR1Memory]) {
+	total := convert(c, "fb_memory_usage.total", fb.Total, parseMiB)
+	reserved := convert(c, "fb_memory_usage.reserved", fb.Reserved, parseMiB)
+	used := convert(c, "fb_memory_usage.used", fb.Used, parseMiB)
+	free := convert(c, "fb_memory_usage.free", fb.Free, parseMiB)
+	memoryInfo := reading[nvml.Memory_v2]{ret: nvml.SUCCESS}
+	for _, r := range []reading[uint64]{total, used, free} {
+		if r.ret != nvml.SUCCESS {
+			memoryInfo.ret = r.ret
+		}
+	}
+	if memoryInfo.ret == nvml.SUCCESS {
+		memoryInfo.value = nvml.NewMemory_v2()
+		memoryInfo.value.Total = total.value
+		memoryInfo.value.Reserved = reserved.value
+		memoryInfo.value.Used = used.value
+		memoryInfo.value.Free = free.value
+	}
+
+	bar1Total := convert(c, "bar1_memory_usage.total", bar1.Total, parseMiB)
+	bar1Used := convert(c, "bar1_memory_usage.used", bar1.Used, parseMiB)
+	bar1Free := convert(c, "bar1_memory_usage.free", bar1.Free, parseMiB)
+	bar1MemoryInfo := reading[nvml.BAR1Memory]{ret: nvml.SUCCESS}
+	for _, r := range []reading[uint64]{bar1Total, bar1Used, bar1Free} {
+		if r.ret != nvml.SUCCESS {
+			bar1MemoryInfo.ret = r.ret
+		}
+	}
+	if bar1MemoryInfo.ret == nvml.SUCCESS {
+		bar1MemoryInfo.value = nvml.BAR1Memory{
+			Bar1Total: bar1Total.value,
+			Bar1Used:  bar1Used.value,
+			Bar1Free:  bar1Free.value,
+		}
+	}
+	return memoryInfo, bar1MemoryInfo
+}
+
+// eccCounter identifies an ECC error counter of a device. The location is
+// only set for the counters returned by GetMemoryErrorCounter.
+type eccCounter struct {
+	errorType   nvml.MemoryErrorType
+	counterType nvml.EccCounterType
+	location    nvml.MemoryLocation
+}
+
+// anyLocation is the location of the counters returned by GetTotalEccErrors.
+const anyLocation = nvml.MEMORY_LOCATION_COUNT
+
+func (d *Device) setEccFuncs(c *converter) {
+	currentEcc := convert(c, "ecc_mode.current_ecc", d.Gpu.EccMode.CurrentEcc, parseEnableState)
+	pendingEcc := convert(c, "ecc_mode.pending_ecc", d.Gpu.EccMode.PendingEcc, parseEnableState)
+	d.GetEccModeFunc = func() (nvml.EnableState, nvml.EnableState, nvml.Return) {
+		if currentEcc.ret != nvml.SUCCESS {
+			return 0, 0, currentEcc.ret
+		}
+		if pendingEcc.ret != nvml.SUCCESS {
+			return 0, 0, pendingEcc.ret
+		}
+		return currentEcc.value, pendingEcc.value, nvml.SUCCESS
+	}
+
+	counters := make(map[eccCounter]reading[uint64])
+	convertEccErrorCounts(c, nvml.VOLATILE_ECC, d.Gpu.EccErrors.Volatile, counters)
+	convertEccErrorCounts(c, nvml.AGGREGATE_ECC, d.Gpu.EccErrors.Aggregate, counters)
+
+	d.GetTotalEccErrorsFunc = func(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, nvml.Return) {
+		counter, ok := counters[eccCounter{errorType, counterType, anyLocation}]
+		if !ok {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return counter.get()
+	}
+
+	d.GetMemoryErrorCounterFunc = func(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, location nvml.MemoryLocation) (uint64, nvml.Return) {
+		counter, ok := counters[eccCounter{errorType, counterType, location}]
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return counter.get()
+	}
+}
+
+// convertEccErrorCounts converts the volatile or aggregate ECC error counters
+// of a device into the specified counters.
+func convertEccErrorCounts(c *converter, counterType nvml.EccCounterType, counts EccErrorCounts, counters map[eccCounter]reading[uint64]) {
+	element := "ecc_errors.volatile"
+	if counterType == nvml.AGGREGATE_ECC {
+		element = "ecc_errors.aggregate"
+	}
+	count := func(field string, s string) reading[uint64] {
+		return convert(c, element+"."+field, s, parseUint64)
+	}
+	set := func(errorType nvml.MemoryErrorType, location nvml.MemoryLocation, r reading[uint64]) {
+		counters[eccCounter{errorType, counterType, location}] = r
+	}
+
+	if counts.SingleBit != nil || counts.DoubleBit != nil {
+		for errorType, locations := range []*EccLocationCounts{
+			nvml.MEMORY_ERROR_TYPE_CORRECTED:   counts.SingleBit,
+			nvml.MEMORY_ERROR_TYPE_UNCORRECTED: counts.DoubleBit,
+		} {
+			if locations == nil {
+				continue
+			}
+			errorType := nvml.MemoryErrorType(errorType)
+			set(errorType, nvml.MEMORY_LOCATION_DEVICE_MEMORY, count("device_memory", locations.DeviceMemory))
+			set(errorType, nvml.MEMORY_LOCATION_REGISTER_FILE, count("register_file", locations.RegisterFile))
+			set(errorType, nvml.MEMORY_LOCATION_L1_CACHE, count("l1_cache", locations.L1Cache))
+			set(errorType, nvml.MEMORY_LOCATION_L2_CACHE, count("l2_cache", locations.L2Cache))
+			set(errorType, nvml.MEMORY_LOCATION_TEXTURE_MEMORY, count("texture_memory", locations.TextureMemory))
+			set(errorType, nvml.MEMORY_LOCATION_TEXTURE_SHM, count("texture_shm", locations.TextureShm))
+			set(errorType, nvml.MEMORY_LOCATION_CBU, count("cbu", locations.Cbu))
+			set(errorType, anyLocation, count("total", locations.Total))
+		}
+		return
+	}
+
+	sramCorrectable := count("sram_correctable", counts.SramCorrectable)
+	dramCorrectable := count("dram_correctable", counts.DramCorrectable)
+	sramUncorrectable := count("sram_uncorrectable", counts.SramUncorrectable)
+	if counts.SramUncorrectable == "" && (counts.SramUncorrectableParity != "" || counts.SramUncorrectableSecded != "") {
+		sramUncorrectable = sum(
+			count("sram_uncorrectable_parity", counts.SramUncorrectableParity),
+			count("sram_uncorrectable_secded", counts.SramUncorrectableSecded),
+		)
+	}
+	dramUncorrectable := count("dram_uncorrectable", counts.DramUncorrectable)
+
+	set(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.MEMORY_LOCATION_SRAM, sramCorrectable)
+	set(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.MEMORY_LOCATION_DRAM, dramCorrectable)
+	set(nvml.MEMORY_ERROR_TYPE_CORRECTED, anyLocation, sum(sramCorrectable, dramCorrectable))
+	set(nvml.MEMORY_ERROR_TYPE_UNCORRECTED, nvml.MEMORY_LOCATION_SRAM, sramUncorrectable)
+	set(nvml.MEMORY_ERROR_TYPE_UNCORRECTED, nvml.MEMORY_LOCATION_DRAM, dramUncorrectable)
+	set(nvml.MEMORY_ERROR_TYPE_UNCORRECTED, anyLocation, sum(sramUncorrectable, dramUncorrectable))
+}
+
+func (d *Device) setTemperatureFuncs(c *converter) {
+	temperature := d.Gpu.Temperature
+
+	gpuTemp := convert(c, "temperature.gpu_temp", temperature.GpuTemp, parseCelsius)
+	d.GetTemperatureFunc = func(sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+		if sensor != nvml.TEMPERATURE_GPU {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return gpuTemp.get()
+	}
+
+	thresholds := map[nvml.TemperatureThresholds]reading[uint32]{
+		nvml.TEMPERATURE_THRESHOLD_SHUTDOWN: convert(c, "temperature.gpu_temp_max_threshold", temperature.GpuTempMaxThreshold, parseCelsius),
+		nvml.TEMPERATURE_THRESHOLD_SLOWDOWN: convert(c, "temperature.gpu_temp_slow_threshold", temperature.GpuTempSlowThreshold, parseCelsius),
+		nvml.TEMPERATURE_THRESHOLD_GPU_MAX:  convert(c, "temperature.gpu_temp_max_gpu_threshold", temperature.GpuTempMaxGpuThreshold, parseCelsius),
+		nvml.TEMPERATURE_THRESHOLD_MEM_MAX:  convert(c, "temperature.gpu_temp_max_mem_threshold", temperature.GpuTempMaxMemThreshold, parseCelsius),
+	}
+	d.GetTemperatureThresholdFunc = func(threshold nvml.TemperatureThresholds) (uint32, nvml.Return) {
+		t, ok := thresholds[threshold]
+		if !ok {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return t.get()
+	}
+}
+
+func (d *Device) setPowerFuncs(c *converter) {
+	power := d.Gpu.GpuPowerReadings
+	if power == nil {
+		power = d.Gpu.PowerReadings
+	}
+	if power == nil {
+		power = &PowerReadings{}
+	}
+
+	d.GetPowerStateFunc = convert(c, "power_readings.power_state", power.PowerState, parsePstate).get
+	d.GetPowerUsageFunc = convert(c, "power_readings.power_draw", power.PowerDraw, parseMilliwatts).get
+	d.GetPowerManagementLimitFunc = convert(c, "power_readings.requested_power_limit", firstOf(power.RequestedPowerLimit, power.PowerLimit), parseMilliwatts).get
+	d.GetEnforcedPowerLimitFunc = convert(c, "power_readings.current_power_limit", firstOf(power.CurrentPowerLimit, power.EnforcedPowerLimit), parseMilliwatts).get
+	d.GetPowerManagementDefaultLimitFunc = convert(c, "power_readings.default_power_limit", power.DefaultPowerLimit, parseMilliwatts).get
+
+	minLimit := convert(c, "power_readings.min_power_limit", power.MinPowerLimit, parseMilliwatts)
+	maxLimit := convert(c, "power_readings.max_power_limit", power.MaxPowerLimit, parseMilliwatts)
+	d.GetPowerManagementLimitConstraintsFunc = func() (uint32, uint32, nvml.Return) {
+		if minLimit.ret != nvml.SUCCESS {
+			return 0, 0, minLimit.ret
+		}
+		if maxLimit.ret != nvml.SUCCESS {
+			return 0, 0, maxLimit.ret
+		}
+		return minLimit.value, maxLimit.value, nvml.SUCCESS
+	}
+}
+
+func (d *Device) setClockFuncs(c *converter) {
+	convertClocks := func(element string, clocks Clocks) map[nvml.ClockType]reading[uint32] {
+		return map[nvml.ClockType]reading[uint32]{
+			nvml.CLOCK_GRAPHICS: convert(c, element+".graphics_clock", clocks.GraphicsClock, parseMHz),
+			nvml.CLOCK_SM:       convert(c, element+".sm_clock", clocks.SmClock, parseMHz),
+			nvml.CLOCK_MEM:      convert(c, element+".mem_clock", clocks.MemClock, parseMHz),
+			nvml.CLOCK_VIDEO:    convert(c, element+".video_clock", clocks.VideoClock, parseMHz),
+		}
+	}
+	getClock := func(clocks map[nvml.ClockType]reading[uint32]) func(nvml.ClockType) (uint32, nvml.Return) {
+		return func(clockType nvml.ClockType) (uint32, nvml.Return) {
+			clock, ok := clocks[clockType]
+			if !ok {
+				return 0, nvml.ERROR_INVALID_ARGUMENT
+			}
+			return clock.get()
+		}
+	}
+
+	d.GetClockInfoFunc = getClock(convertClocks("clocks", d.Gpu.Clocks))
+	d.GetMaxClockInfoFunc = getClock(convertClocks("max_clocks", d.Gpu.MaxClocks))
+	d.GetApplicationsClockFunc = getClock(convertClocks("applications_clocks", d.Gpu.ApplicationsClocks))
+	d.GetDefaultApplicationsClockFunc = getClock(convertClocks("default_applications_clocks", d.Gpu.DefaultApplicationsClocks))
+}
+
+func (d *Device) setProcessFuncs(c *converter) {
+	for _, p := range d.Gpu.Processes {
+		pid := convert(c, "process_info.pid", p.Pid, parseUint32)
+		usedMemory := convert(c, "process_info.used_memory", p.UsedMemory, parseMiB)
+		if usedMemory.ret != nvml.SUCCESS {
+			usedMemory.value = math.MaxUint64
+		}
+		d.processes = append(d.processes, process{
+			info: nvml.ProcessInfo{
+				Pid:               pid.value,
+				UsedGpuMemory:     usedMemory.value,
+				GpuInstanceId:     instanceID(c, "process_info.gpu_instance_id", p.GpuInstanceID),
+				ComputeInstanceId: instanceID(c, "process_info.compute_instance_id", p.ComputeInstanceID),
+			},
+			name:     p.ProcessName,
+			compute:  strings.Contains(p.Type, "C"),
+			graphics: strings.Contains(p.Type, "G"),
+		})
+	}
+
+	d.GetComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		return d.runningProcesses(func(p process) bool { return p.compute }), nvml.SUCCESS
+	}
+
+	d.GetGraphicsRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		return d.runningProcesses(func(p process) bool { return p.graphics }), nvml.SUCCESS
+	}
+}
+
+// runningProcesses returns the info of the processes running on the device
+// that match the specified filter.
+func (d *Device) runningProcesses(filter func(process) bool) []nvml.ProcessInfo {
+	var infos []nvml.ProcessInfo
+	for _, p := range d.processes {
+		if filter(p) {
+			infos = append(infos, p.info)
+		}
+	}
+	return infos
+}
+
+func (m *MigDevice) setMockFuncs(c *converter) {
+	m.IsMigDeviceHandleFunc = func() (bool, nvml.Return) {
+		return true, nvml.SUCCESS
+	}
+
+	m.GetDeviceHandleFromMigDeviceHandleFunc = func() (nvml.Device, nvml.Return) {
+		return m.Parent, nvml.SUCCESS
+	}
+
+	m.GetUUIDFunc = func() (string, nvml.Return) {
+		return m.UUID, nvml.SUCCESS
+	}
+
+	m.GetIndexFunc = convert(c, "index", m.MigDevice.Index, parseInt).get
+
+	m.GetGpuInstanceIdFunc = func() (int, nvml.Return) {
+		return int(m.GpuInstanceID), nvml.SUCCESS
+	}
+
+	m.GetComputeInstanceIdFunc = func() (int, nvml.Return) {
+		return int(m.ComputeInstanceID), nvml.SUCCESS
+	}
+
+	memoryInfo, bar1MemoryInfo := convertMemoryUsage(c, m.MigDevice.FbMemoryUsage, m.MigDevice.Bar1MemoryUsage)
+	m.GetMemoryInfoFunc = func() (nvml.Memory, nvml.Return) {
+		memory, ret := memoryInfo.get()
+		return nvml.Memory{Total: memory.Total, Free: memory.Free, Used: memory.Used}, ret
+	}
+	m.GetMemoryInfo_v2Func = memoryInfo.get
+	m.GetBAR1MemoryInfoFunc = bar1MemoryInfo.get
+
+	attributes := m.MigDevice.DeviceAttributes
+	counts := []reading[uint32]{
+		convert(c, "device_attributes.shared.multiprocessor_count", attributes.MultiprocessorCount, parseUint32),
+		convert(c, "device_attributes.shared.copy_engine_count", attributes.CopyEngineCount, parseUint32),
+		convert(c, "device_attributes.shared.decoder_count", attributes.DecoderCount, parseUint32),
+		convert(c, "device_attributes.shared.encoder_count", attributes.EncoderCount, parseUint32),
+		convert(c, "device_attributes.shared.jpg_count", attributes.JpgCount, parseUint32),
+		convert(c, "device_attributes.shared.ofa_count", attributes.OfaCount, parseUint32),
+	}
+	m.GetAttributesFunc = func() (nvml.DeviceAttributes, nvml.Return) {
+		for _, r := range counts {
+			if r.ret != nvml.SUCCESS {
+				return nvml.DeviceAttributes{}, r.ret
+			}
+		}
+		return nvml.DeviceAttributes{
+			MultiprocessorCount:   counts[0].value,
+			SharedCopyEngineCount: counts[1].value,
+			SharedDecoderCount:    counts[2].value,
+			SharedEncoderCount:    counts[3].value,
+			SharedJpegCount:       counts[4].value,
+			SharedOfaCount:        counts[5].value,
+			MemorySizeMB:          memoryInfo.value.Total >> 20,
+		}, nvml.SUCCESS
+	}
+
+	sramUncorrectable := convert(c, "ecc_error_count.volatile_count.sram_uncorrectable", m.MigDevice.SramUncorrectable, parseUint64)
+	m.GetTotalEccErrorsFunc = func(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, nvml.Return) {
+		if errorType != nvml.MEMORY_ERROR_TYPE_UNCORRECTED || counterType != nvml.VOLATILE_ECC {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return sramUncorrectable.get()
+	}
+
+	inInstance := func(p process) bool {
+		return p.info.GpuInstanceId == m.GpuInstanceID && p.info.ComputeInstanceId == m.ComputeInstanceID
+	}
+	m.GetComputeRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		return m.Parent.runningProcesses(func(p process) bool { return p.compute && inInstance(p) }), nvml.SUCCESS
+	}
+	m.GetGraphicsRunningProcessesFunc = func() ([]nvml.ProcessInfo, nvml.Return) {
+		return m.Parent.runningProcesses(func(p process) bool { return p.graphics && inInstance(p) }), nvml.SUCCESS
+	}
+}
+
+// pciAddress is the address of a PCI device.
+type pciAddress struct {
+	domain, bus, device, function uint32
+}
+
+// parsePciAddress parses a PCI bus ID, with or without its domain (e.g.
+// "00000000:1B:00.0", "0000:1b:00.0" or "1b:00.0").
+func parsePciAddress(busID string) (pciAddress, bool) {
+	var a pciAddress
+	if n, _ := fmt.Sscanf(busID, "%x:%x:%x.%x", &a.domain, &a.bus, &a.device, &a.function); n == 4 {
+		return a, true
+	}
+	a = pciAddress{}
+	if n, _ := fmt.Sscanf(busID, "%x:%x.%x", &a.bus, &a.device, &a.function); n == 3 {
+		return a, true
+	}
+	return pciAddress{}, false
+}
@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvidiasmi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+func TestDGXH100Report(t *testing.T) {
+	server, err := NewFromFile("testdata/dgxh100.xml")
+	require.NoError(t, err)
+
+	driverVersion, ret := server.SystemGetDriverVersion()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "550.54.15", driverVersion)
+
+	cudaVersion, ret := server.SystemGetCudaDriverVersion()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 12040, cudaVersion)
+
+	count, ret := server.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, count)
+
+	device, ret := server.DeviceGetHandleByPciBusId("0000:43:00.0")
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	uuid, ret := device.GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "GPU-7c1d9e2a-5b4f-4e0a-9c3d-2e6f8a1b0c94", uuid)
+
+	index, ret := device.GetIndex()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 1, index)
+
+	name, ret := device.GetName()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "NVIDIA H100 80GB HBM3", name)
+
+	arch, ret := device.GetArchitecture()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DeviceArchitecture(nvml.DEVICE_ARCH_HOPPER), arch)
+
+	pciInfo, ret := device.GetPciInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(0x43), pciInfo.Bus)
+	require.Equal(t, uint32(0x233010DE), pciInfo.PciDeviceId)
+	require.Equal(t, "0000:43:00.0", string(pciInfo.BusIdLegacy[:12]))
+
+	memory, ret := device.GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.Memory{Total: 81559 << 20, Used: 20491 << 20, Free: 60740 << 20}, memory)
+
+	computeMode, ret := device.GetComputeMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.COMPUTEMODE_EXCLUSIVE_PROCESS, computeMode)
+
+	clock, ret := device.GetClockInfo(nvml.CLOCK_SM)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(1755), clock)
+	_, ret = device.GetApplicationsClock(nvml.CLOCK_SM)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	temperature, ret := device.GetTemperature(nvml.TEMPERATURE_GPU)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(61), temperature)
+	threshold, ret := device.GetTemperatureThreshold(nvml.TEMPERATURE_THRESHOLD_SLOWDOWN)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(89), threshold)
+	_, ret = device.GetTemperatureThreshold(nvml.TEMPERATURE_THRESHOLD_MEM_MAX)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	power, ret := device.GetPowerUsage()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(512370), power)
+	limit, ret := device.GetEnforcedPowerLimit()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(500000), limit)
+	minLimit, maxLimit, ret := device.GetPowerManagementLimitConstraints()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []uint32{200000, 700000}, []uint32{minLimit, maxLimit})
+
+	errors, ret := device.GetTotalEccErrors(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.VOLATILE_ECC)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(15), errors)
+	errors, ret = device.GetTotalEccErrors(nvml.MEMORY_ERROR_TYPE_UNCORRECTED, nvml.AGGREGATE_ECC)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(3), errors)
+	errors, ret = device.GetMemoryErrorCounter(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.AGGREGATE_ECC, nvml.MEMORY_LOCATION_DRAM)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(40), errors)
+
+	processes, ret := device.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.ProcessInfo{
+		{
+			Pid:               51877,
+			UsedGpuMemory:     20480 << 20,
+			GpuInstanceId:     math.MaxUint32,
+			ComputeInstanceId: math.MaxUint32,
+		},
+	}, processes)
+	processName, ret := server.SystemGetProcessName(51877)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, "/opt/app/bin/trainer", processName)
+
+	current, _, ret := device.GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_DISABLE, current)
+	_, ret = device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.ERROR_NOT_FOUND, ret)
+}
+
+func TestMigDevices(t *testing.T) {
+	server, err := NewFromFile("testdata/dgxh100.xml")
+	require.NoError(t, err)
+
+	device, ret := server.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	current, pending, ret := device.GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []int{nvml.DEVICE_MIG_ENABLE, nvml.DEVICE_MIG_ENABLE}, []int{current, pending})
+
+	migDevice, ret := device.GetMigDeviceHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	isMig, ret := migDevice.IsMigDeviceHandle()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.True(t, isMig)
+
+	parent, ret := migDevice.GetDeviceHandleFromMigDeviceHandle()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, device, parent)
+
+	uuid, ret := migDevice.GetUUID()
+	require.Equal(t, nvml.SUCCESS, ret)
+	byUUID, ret := server.DeviceGetHandleByUUID(uuid)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, migDevice, byUUID)
+
+	// The UUIDs of MIG devices do not change between reports.
+	other, err := NewFromFile("testdata/dgxh100.xml")
+	require.NoError(t, err)
+	require.Equal(t, uuid, other.Devices[0].(*Device).MigDevices[0].UUID)
+
+	gi, ret := migDevice.GetGpuInstanceId()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 2, gi)
+
+	attributes, ret := migDevice.GetAttributes()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(60), attributes.MultiprocessorCount)
+	require.Equal(t, uint64(40192), attributes.MemorySizeMB)
+
+	memory, ret := migDevice.GetMemoryInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(1069<<20), memory.Used)
+
+	processes, ret := migDevice.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, processes, 1)
+	require.Equal(t, uint32(48213), processes[0].Pid)
+
+	migDevice, ret = device.GetMigDeviceHandleByIndex(1)
+	require.Equal(t, nvml.SUCCESS, ret)
+	processes, ret = migDevice.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, processes)
+}
+
+func TestLegacyReport(t *testing.T) {
+	log, err := Parse([]byte(`
+<nvidia_smi_log>
+	<driver_version>470.82.01</driver_version>
+	<cuda_version>11.4</cuda_version>
+	<gpu id="00000000:07:00.0">
+		<product_name>A100-SXM4-40GB</product_name>
+		<product_brand>NVIDIA</product_brand>
+		<uuid>GPU-1b8a1e4f-ff54-4d16-9b2b-1c9d2f3e4a5b</uuid>
+		<ecc_errors>
+			<volatile>
+				<single_bit>
+					<device_memory>4</device_memory>
+					<register_file>0</register_file>
+					<l1_cache>N/A</l1_cache>
+					<l2_cache>0</l2_cache>
+					<texture_memory>N/A</texture_memory>
+					<texture_shm>N/A</texture_shm>
+					<cbu>N/A</cbu>
+					<total>4</total>
+				</single_bit>
+				<double_bit>
+					<device_memory>0</device_memory>
+					<register_file>0</register_file>
+					<l1_cache>N/A</l1_cache>
+					<l2_cache>0</l2_cache>
+					<texture_memory>N/A</texture_memory>
+					<texture_shm>N/A</texture_shm>
+					<cbu>0</cbu>
+					<total>0</total>
+				</double_bit>
+			</volatile>
+		</ecc_errors>
+		<power_readings>
+			<power_state>P0</power_state>
+			<power_management>Supported</power_management>
+			<power_draw>[Insufficient Permissions]</power_draw>
+			<power_limit>350.00 W</power_limit>
+			<default_power_limit>400.00 W</default_power_limit>
+			<enforced_power_limit>350.00 W</enforced_power_limit>
+		</power_readings>
+	</gpu>
+</nvidia_smi_log>`))
+	require.NoError(t, err)
+	server, err := New(log)
+	require.NoError(t, err)
+
+	device, ret := server.DeviceGetHandleByUUID("GPU-1b8a1e4f-ff54-4d16-9b2b-1c9d2f3e4a5b")
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	errors, ret := device.GetTotalEccErrors(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.VOLATILE_ECC)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint64(4), errors)
+	_, ret = device.GetMemoryErrorCounter(nvml.MEMORY_ERROR_TYPE_CORRECTED, nvml.VOLATILE_ECC, nvml.MEMORY_LOCATION_L1_CACHE)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+
+	_, ret = device.GetPowerUsage()
+	require.Equal(t, nvml.ERROR_NO_PERMISSION, ret)
+	limit, ret := device.GetPowerManagementLimit()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(350000), limit)
+
+	// Missing elements are reported as not supported.
+	_, ret = device.GetSerial()
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestInvalidReport(t *testing.T) {
+	log, err := Parse([]byte(`
+<nvidia_smi_log>
+	<gpu id="00000000:07:00.0">
+		<fb_memory_usage>
+			<total>40 GB</total>
+		</fb_memory_usage>
+		<temperature>
+			<gpu_temp>hot</gpu_temp>
+		</temperature>
+	</gpu>
+</nvidia_smi_log>`))
+	require.NoError(t, err)
+
+	_, err = New(log)
+	require.ErrorContains(t, err, `gpu 0: invalid fb_memory_usage.total "40 GB"`)
+	require.ErrorContains(t, err, `gpu 0: invalid temperature.gpu_temp "hot"`)
+
+	_, err = Parse([]byte(`<nvidia_smi_log><gpu>`))
+	require.Error(t, err)
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package nvidiasmi builds mock servers from the XML reports written by
+// `nvidia-smi -q -x`.
+package nvidiasmi
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+// Log is the report written by `nvidia-smi -q -x`. Only the elements that are
+// returned by the mock server are parsed, and their values are kept as
+// reported (e.g. "81559 MiB" or "N/A").
+type Log struct {
+	XMLName       xml.Name `xml:"nvidia_smi_log"`
+	Timestamp     string   `xml:"timestamp"`
+	DriverVersion string   `xml:"driver_version"`
+	CudaVersion   string   `xml:"cuda_version"`
+	AttachedGpus  string   `xml:"attached_gpus"`
+	Gpus          []Gpu    `xml:"gpu"`
+}
+
+// Gpu is the report of a device.
+type Gpu struct {
+	ID                        string         `xml:"id,attr"`
+	ProductName               string         `xml:"product_name"`
+	ProductBrand              string         `xml:"product_brand"`
+	ProductArchitecture       string         `xml:"product_architecture"`
+	PersistenceMode           string         `xml:"persistence_mode"`
+	MigMode                   MigMode        `xml:"mig_mode"`
+	MigDevices                []GpuMigDevice `xml:"mig_devices>mig_device"`
+	Serial                    string         `xml:"serial"`
+	UUID                      string         `xml:"uuid"`
+	MinorNumber               string         `xml:"minor_number"`
+	VbiosVersion              string         `xml:"vbios_version"`
+	MultiGpuBoard             string         `xml:"multigpu_board"`
+	BoardID                   string         `xml:"board_id"`
+	BoardPartNumber           string         `xml:"board_part_number"`
+	InforomImageVersion       string         `xml:"inforom_version>img_version"`
+	Pci                       Pci            `xml:"pci"`
+	PerformanceState          string         `xml:"performance_state"`
+	FbMemoryUsage             MemoryUsage    `xml:"fb_memory_usage"`
+	Bar1MemoryUsage           MemoryUsage    `xml:"bar1_memory_usage"`
+	ComputeMode               string         `xml:"compute_mode"`
+	EccMode                   EccMode        `xml:"ecc_mode"`
+	EccErrors                 EccErrors      `xml:"ecc_errors"`
+	Temperature               Temperature    `xml:"temperature"`
+	GpuPowerReadings          *PowerReadings `xml:"gpu_power_readings"`
+	PowerReadings             *PowerReadings `xml:"power_readings"`
+	Clocks                    Clocks         `xml:"clocks"`
+	ApplicationsClocks        Clocks         `xml:"applications_clocks"`
+	DefaultApplicationsClocks Clocks         `xml:"default_applications_clocks"`
+	MaxClocks                 Clocks         `xml:"max_clocks"`
+	Processes                 []Process      `xml:"processes>process_info"`
+}
+
+// MigMode is the MIG mode of a device.
+type MigMode struct {
+	CurrentMig string `xml:"current_mig"`
+	PendingMig string `xml:"pending_mig"`
+}
+
+// GpuMigDevice is the report of a MIG device of a device.
+type GpuMigDevice struct {
+	Index             string              `xml:"index"`
+	GpuInstanceID     string              `xml:"gpu_instance_id"`
+	ComputeInstanceID string              `xml:"compute_instance_id"`
+	DeviceAttributes  MigDeviceAttributes `xml:"device_attributes>shared"`
+	SramUncorrectable string              `xml:"ecc_error_count>volatile_count>sram_uncorrectable"`
+	FbMemoryUsage     MemoryUsage         `xml:"fb_memory_usage"`
+	Bar1MemoryUsage   MemoryUsage         `xml:"bar1_memory_usage"`
+}
+
+// MigDeviceAttributes are the attributes of a MIG device.
+type MigDeviceAttributes struct {
+	MultiprocessorCount string `xml:"multiprocessor_count"`
+	CopyEngineCount     string `xml:"copy_engine_count"`
+	EncoderCount        string `xml:"encoder_count"`
+	DecoderCount        string `xml:"decoder_count"`
+	OfaCount            string `xml:"ofa_count"`
+	JpgCount            string `xml:"jpg_count"`
+}
+
+// Pci is the PCI info of a device.
+type Pci struct {
+	PciBus           string `xml:"pci_bus"`
+	PciDevice        string `xml:"pci_device"`
+	PciDomain        string `xml:"pci_domain"`
+	PciDeviceID      string `xml:"pci_device_id"`
+	PciBusID         string `xml:"pci_bus_id"`
+	PciSubSystemID   string `xml:"pci_sub_system_id"`
+	MaxLinkGen       string `xml:"pci_gpu_link_info>pcie_gen>max_link_gen"`
+	CurrentLinkGen   string `xml:"pci_gpu_link_info>pcie_gen>current_link_gen"`
+	MaxLinkWidth     string `xml:"pci_gpu_link_info>link_widths>max_link_width"`
+	CurrentLinkWidth string `xml:"pci_gpu_link_info>link_widths>current_link_width"`
+}
+
+// MemoryUsage is the usage of the framebuffer or BAR1 memory of a device.
+type MemoryUsage struct {
+	Total    string `xml:"total"`
+	Reserved string `xml:"reserved"`
+	Used     string `xml:"used"`
+	Free     string `xml:"free"`
+}
+
+// EccMode is the ECC mode of a device.
+type EccMode struct {
+	CurrentEcc string `xml:"current_ecc"`
+	PendingEcc string `xml:"pending_ecc"`
+}
+
+// EccErrors holds the ECC error counters of a device.
+type EccErrors struct {
+	Volatile  EccErrorCounts `xml:"volatile"`
+	Aggregate EccErrorCounts `xml:"aggregate"`
+}
+
+// EccErrorCounts holds the volatile or aggregate ECC error counters of a
+// device. Recent drivers report the counters for SRAM and DRAM, with the
+// uncorrectable SRAM errors split into parity and SEC-DED errors by some of
+// them. Older drivers report single and double bit errors by location.
+type EccErrorCounts struct {
+	SramCorrectable         string             `xml:"sram_correctable"`
+	SramUncorrectable       string             `xml:"sram_uncorrectable"`
+	SramUncorrectableParity string             `xml:"sram_uncorrectable_parity"`
+	SramUncorrectableSecded string             `xml:"sram_uncorrectable_secded"`
+	DramCorrectable         string             `xml:"dram_correctable"`
+	DramUncorrectable       string             `xml:"dram_uncorrectable"`
+	SingleBit               *EccLocationCounts `xml:"single_bit"`
+	DoubleBit               *EccLocationCounts `xml:"double_bit"`
+}
+
+// EccLocationCounts holds the single or double bit ECC error counters of a
+// device by location, as reported by older drivers.
+type EccLocationCounts struct {
+	DeviceMemory  string `xml:"device_memory"`
+	RegisterFile  string `xml:"register_file"`
+	L1Cache       string `xml:"l1_cache"`
+	L2Cache       string `xml:"l2_cache"`
+	TextureMemory string `xml:"texture_memory"`
+	TextureShm    string `xml:"texture_shm"`
+	Cbu           string `xml:"cbu"`
+	Total         string `xml:"total"`
+}
+
+// Temperature holds the temperature and the temperature thresholds of a
+// device.
+type Temperature struct {
+	GpuTemp                string `xml:"gpu_temp"`
+	GpuTempMaxThreshold    string `xml:"gpu_temp_max_threshold"`
+	GpuTempSlowThreshold   string `xml:"gpu_temp_slow_threshold"`
+	GpuTempMaxGpuThreshold string `xml:"gpu_temp_max_gpu_threshold"`
+	MemoryTemp             string `xml:"memory_temp"`
+	GpuTempMaxMemThreshold string `xml:"gpu_temp_max_mem_threshold"`
+}
+
+// PowerReadings holds the power readings of a device. Recent drivers report
+// these as gpu_power_readings, with the enforced and requested limits as
+// current_power_limit and requested_power_limit. Older drivers report these
+// as power_readings, with the enforced and requested limits as
+// enforced_power_limit and power_limit.
+type PowerReadings struct {
+	PowerState          string `xml:"power_state"`
+	PowerDraw           string `xml:"power_draw"`
+	CurrentPowerLimit   string `xml:"current_power_limit"`
+	RequestedPowerLimit string `xml:"requested_power_limit"`
+	EnforcedPowerLimit  string `xml:"enforced_power_limit"`
+	PowerLimit          string `xml:"power_limit"`
+	DefaultPowerLimit   string `xml:"default_power_limit"`
+	MinPowerLimit       string `xml:"min_power_limit"`
+	MaxPowerLimit       string `xml:"max_power_limit"`
+}
+
+// Clocks holds the current, applications or maximum clocks of a device.
+type Clocks struct {
+	GraphicsClock string `xml:"graphics_clock"`
+	SmClock       string `xml:"sm_clock"`
+	MemClock      string `xml:"mem_clock"`
+	VideoClock    string `xml:"video_clock"`
+}
+
+// Process is a process running on a device.
+type Process struct {
+	GpuInstanceID     string `xml:"gpu_instance_id"`
+	ComputeInstanceID string `xml:"compute_instance_id"`
+	Pid               string `xml:"pid"`
+	Type              string `xml:"type"`
+	ProcessName       string `xml:"process_name"`
+	UsedMemory        string `xml:"used_memory"`
+}
+
+// Parse parses a report written by `nvidia-smi -q -x`.
+func Parse(data []byte) (*Log, error) {
+	var log Log
+	if err := xml.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("error parsing nvidia-smi report: %w", err)
+	}
+	return &log, nil
+}
+
+// LoadFile reads and parses the report in the specified file.
+func LoadFile(path string) (*Log, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nvidiasmi
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// reading holds a value of a report converted to the type returned by NVML,
+// or the Return of the methods returning it if the value is not available.
+type reading[T any] struct {
+	value T
+	ret   nvml.Return
+}
+
+func (r reading[T]) get() (T, nvml.Return) {
+	return r.value, r.ret
+}
+
+// converter converts the values of a report, collecting the errors for the
+// values that cannot be converted.
+type converter struct {
+	// prefix identifies the element of the report that is being converted.
+	prefix string
+	errs   []error
+}
+
+// convert converts the specified value of a report using parse. Values that
+// are not available (e.g. "N/A") are returned with the corresponding Return.
+func convert[T any](c *converter, field string, s string, parse func(string) (T, error)) reading[T] {
+	s = strings.TrimSpace(s)
+	if ret := unavailable(s); ret != nvml.SUCCESS {
+		return reading[T]{ret: ret}
+	}
+	value, err := parse(s)
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("%sinvalid %s %q: %w", c.prefix, field, s, err))
+		return reading[T]{ret: nvml.ERROR_UNKNOWN}
+	}
+	return reading[T]{value: value, ret: nvml.SUCCESS}
+}
+
+// unavailable returns the Return for a value that nvidia-smi reports as not
+// available, or SUCCESS if the value is available. Missing elements are
+// reported as not supported.
+func unavailable(s string) nvml.Return {
+	switch strings.Trim(s, "[]") {
+	case "", "N/A", "Not Supported", "Requested functionality has been deprecated":
+		return nvml.ERROR_NOT_SUPPORTED
+	case "Insufficient Permissions":
+		return nvml.ERROR_NO_PERMISSION
+	case "GPU requires reset":
+		return nvml.ERROR_RESET_REQUIRED
+	case "GPU is lost":
+		return nvml.ERROR_GPU_IS_LOST
+	}
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		return nvml.ERROR_UNKNOWN
+	}
+	return nvml.SUCCESS
+}
+
+// sum returns the sum of the specified readings, or the Return of the first
+// of these that is not available.
+func sum(readings ...reading[uint64]) reading[uint64] {
+	var total uint64
+	for _, r := range readings {
+		if r.ret != nvml.SUCCESS {
+			return reading[uint64]{ret: r.ret}
+		}
+		total += r.value
+	}
+	return reading[uint64]{value: total, ret: nvml.SUCCESS}
+}
+
+// firstOf returns the first of the specified values that is reported.
+func firstOf(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func parseInt(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+func parseUint32(s string) (uint32, error) {
+	value, err := strconv.ParseUint(s, 10, 32)
+	return uint32(value), err
+}
+
+func parseUint64(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// parseHex parses a hexadecimal value, with or without a 0x prefix.
+func parseHex(s string) (uint32, error) {
+	s = strings.TrimPrefix(strings.ToLower(s), "0x")
+	value, err := strconv.ParseUint(s, 16, 32)
+	return uint32(value), err
+}
+
+// parseYesNo parses a boolean reported as Yes or No, as an int.
+func parseYesNo(s string) (int, error) {
+	switch s {
+	case "Yes":
+		return 1, nil
+	case "No":
+		return 0, nil
+	}
+	return 0, fmt.Errorf("expected Yes or No")
+}
+
+// parseQuantity returns a function that parses a value followed by the
+// specified unit, returning the value multiplied by scale.
+func parseQuantity[T uint32 | uint64 | int](unit string, scale float64) func(string) (T, error) {
+	return func(s string) (T, error) {
+		number, ok := strings.CutSuffix(s, unit)
+		if !ok {
+			return 0, fmt.Errorf("expected a value in %s", unit)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil {
+			return 0, err
+		}
+		if value < 0 {
+			return 0, fmt.Errorf("negative value")
+		}
+		return T(math.Round(value * scale)), nil
+	}
+}
+
+var (
+	parseMiB         = parseQuantity[uint64]("MiB", 1<<20)
+	parseMHz         = parseQuantity[uint32]("MHz", 1)
+	parseCelsius     = parseQuantity[uint32]("C", 1)
+	parseMilliwatts  = parseQuantity[uint32]("W", 1000)
+	parseLinkWidth   = parseQuantity[int]("x", 1)
+	parseEnableState = nvml.ParseEnableState
+	parseComputeMode = nvml.ParseComputeMode
+)
+
+// parseBrand parses a brand such as "NVIDIA RTX". Brands that are not known
+// are returned as BRAND_UNKNOWN, as NVML does.
+func parseBrand(s string) (nvml.BrandType, error) {
+	brand, err := nvml.ParseBrandType(strings.ReplaceAll(s, " ", "_"))
+	if err != nil {
+		return nvml.BRAND_UNKNOWN, nil
+	}
+	return brand, nil
+}
+
+// parseArchitecture parses an architecture such as "Ada Lovelace".
+// Architectures that are not known are returned as DEVICE_ARCH_UNKNOWN, as
+// NVML does.
+func parseArchitecture(s string) (nvml.DeviceArchitecture, error) {
+	architecture, err := nvml.ParseDeviceArchitecture(strings.Fields(s)[0])
+	if err != nil {
+		return nvml.DEVICE_ARCH_UNKNOWN, nil
+	}
+	return architecture, nil
+}
+
+// parsePstate parses a performance state such as "P0".
+func parsePstate(s string) (nvml.Pstates, error) {
+	n, ok := strings.CutPrefix(s, "P")
+	if !ok {
+		return 0, fmt.Errorf("expected a performance state")
+	}
+	return nvml.ParsePstates("PSTATE_" + n)
+}
+
+// parseMigMode parses a MIG mode reported as Enabled or Disabled.
+func parseMigMode(s string) (int, error) {
+	state, err := nvml.ParseEnableState(s)
+	if err != nil {
+		return 0, err
+	}
+	if state == nvml.FEATURE_ENABLED {
+		return nvml.DEVICE_MIG_ENABLE, nil
+	}
+	return nvml.DEVICE_MIG_DISABLE, nil
+}
+
+// parseCudaVersion parses a CUDA version such as "12.4" as returned by
+// SystemGetCudaDriverVersion.
+func parseCudaVersion(s string) (int, error) {
+	var major, minor int
+	if _, err := fmt.Sscanf(s, "%d.%d", &major, &minor); err != nil {
+		return 0, err
+	}
+	return major*1000 + minor*10, nil
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// capturedClocks are the clocks whose maximum is captured.
+var capturedClocks = []nvml.ClockType{
+	nvml.CLOCK_GRAPHICS,
+	nvml.CLOCK_SM,
+	nvml.CLOCK_MEM,
+	nvml.CLOCK_VIDEO,
+}
+
+// capturedP2PCaps are the P2P capabilities whose status is captured.
+var capturedP2PCaps = []nvml.GpuP2PCapsIndex{
+	nvml.P2P_CAPS_INDEX_READ,
+	nvml.P2P_CAPS_INDEX_WRITE,
+	nvml.P2P_CAPS_INDEX_NVLINK,
+	nvml.P2P_CAPS_INDEX_ATOMICS,
+	nvml.P2P_CAPS_INDEX_PROP,
+}
+
+// capturer holds the state of a capture.
+type capturer struct {
+	snapshot  *Snapshot
+	vgpuTypes map[nvml.VgpuTypeId]int
+}
+
+// Capture returns a snapshot of the system as returned by the specified
+// Interface, which must be initialized. Each call that is made is recorded
+// along with its Return, so that failed calls can be replayed.
+func Capture(lib nvml.Interface) *Snapshot {
+	c := &capturer{
+		snapshot:  &Snapshot{Version: Version},
+		vgpuTypes: make(map[nvml.VgpuTypeId]int),
+	}
+
+	s := &c.snapshot.System
+	s.DriverVersion = valueOf(lib.SystemGetDriverVersion())
+	s.NvmlVersion = valueOf(lib.SystemGetNVMLVersion())
+	s.CudaDriverVersion = valueOf(lib.SystemGetCudaDriverVersion())
+	s.DeviceCount = valueOf(lib.DeviceGetCount())
+
+	devices := make([]nvml.Device, s.DeviceCount.Value)
+	for i := range devices {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			c.snapshot.Devices = append(c.snapshot.Devices, Device{Return: ret})
+			continue
+		}
+		devices[i] = device
+		c.snapshot.Devices = append(c.snapshot.Devices, c.captureDevice(device))
+	}
+	for i, device := range devices {
+		if device == nil {
+			continue
+		}
+		for j, other := range devices {
+			if j == i || other == nil {
+				continue
+			}
+			c.snapshot.Devices[i].Topology = append(c.snapshot.Devices[i].Topology, captureTopology(device, other, j))
+		}
+	}
+	return c.snapshot
+}
+
+func (c *capturer) captureDevice(device nvml.Device) Device {
+	d := Device{
+		Index:                       valueOf(device.GetIndex()),
+		MinorNumber:                 valueOf(device.GetMinorNumber()),
+		UUID:                        valueOf(device.GetUUID()),
+		Name:                        valueOf(device.GetName()),
+		Serial:                      valueOf(device.GetSerial()),
+		BoardPartNumber:             valueOf(device.GetBoardPartNumber()),
+		BoardId:                     valueOf(device.GetBoardId()),
+		MultiGpuBoard:               valueOf(device.GetMultiGpuBoard()),
+		VbiosVersion:                valueOf(device.GetVbiosVersion()),
+		InforomImageVersion:         valueOf(device.GetInforomImageVersion()),
+		Brand:                       valueOf(device.GetBrand()),
+		Architecture:                valueOf(device.GetArchitecture()),
+		PciInfo:                     valueOf(device.GetPciInfo()),
+		MemoryInfo:                  valueOf(device.GetMemoryInfo()),
+		BAR1MemoryInfo:              valueOf(device.GetBAR1MemoryInfo()),
+		NumGpuCores:                 valueOf(device.GetNumGpuCores()),
+		PersistenceMode:             valueOf(device.GetPersistenceMode()),
+		ComputeMode:                 valueOf(device.GetComputeMode()),
+		PowerManagementLimit:        valueOf(device.GetPowerManagementLimit()),
+		PowerManagementDefaultLimit: valueOf(device.GetPowerManagementDefaultLimit()),
+		EnforcedPowerLimit:          valueOf(device.GetEnforcedPowerLimit()),
+		MaxPcieLinkGeneration:       valueOf(device.GetMaxPcieLinkGeneration()),
+		MaxPcieLinkWidth:            valueOf(device.GetMaxPcieLinkWidth()),
+		MaxClockInfo:                make(map[nvml.ClockType]Value[uint32]),
+		IsMigDeviceHandle:           valueOf(device.IsMigDeviceHandle()),
+		MaxMigDeviceCount:           valueOf(device.GetMaxMigDeviceCount()),
+	}
+
+	major, minor, ret := device.GetCudaComputeCapability()
+	d.CudaComputeCapability = valueOf(CudaComputeCapability{Major: major, Minor: minor}, ret)
+
+	for _, clock := range capturedClocks {
+		d.MaxClockInfo[clock] = valueOf(device.GetMaxClockInfo(clock))
+	}
+
+	fabricInfo, ret := negotiateGpuFabricInfo(device)
+	d.FabricInfo = valueOf(FabricInfo{Version: fabricInfo.Version, Info: fabricInfo.Value}, ret)
+
+	for link := 0; link < nvml.NVLINK_MAX_LINKS; link++ {
+		d.NvLinks = append(d.NvLinks, NvLink{
+			State:            valueOf(device.GetNvLinkState(link)),
+			Version:          valueOf(device.GetNvLinkVersion(link)),
+			RemoteDeviceType: valueOf(device.GetNvLinkRemoteDeviceType(link)),
+			RemotePciInfo:    valueOf(device.GetNvLinkRemotePciInfo(link)),
+		})
+	}
+
+	current, pending, ret := device.GetMigMode()
+	d.MigMode = valueOf(MigMode{Current: current, Pending: pending}, ret)
+	for profile := 0; profile < nvml.GPU_INSTANCE_PROFILE_COUNT; profile++ {
+		d.GpuInstanceProfiles = append(d.GpuInstanceProfiles, captureGpuInstanceProfile(device, profile))
+	}
+	for i := 0; i < d.MaxMigDeviceCount.Value; i++ {
+		d.MigDevices = append(d.MigDevices, captureMigDevice(device, i))
+	}
+
+	supported, ret := device.GetSupportedVgpus()
+	d.SupportedVgpus = valueOf(c.vgpuTypeIndices(supported), ret)
+	creatable, ret := device.GetCreatableVgpus()
+	d.CreatableVgpus = valueOf(c.vgpuTypeIndices(creatable), ret)
+	for i, vgpuType := range supported {
+		if i == 0 {
+			d.VgpuMaxInstances = make(map[int]Value[int])
+		}
+		d.VgpuMaxInstances[c.vgpuTypes[vgpuType]] = valueOf(vgpuType.GetMaxInstances(device))
+	}
+
+	return d
+}
+
+// negotiateGpuFabricInfo gets the fabric info of a device using the latest
+// version of the struct that it supports. Mock devices (e.g. those of a
+// dgxh100 or replayed server) report their fabric info using their own handler,
+// since they cannot return an nvml.GpuFabricInfoHandler.
+func negotiateGpuFabricInfo(device nvml.Device) (nvml.Negotiated[nvml.GpuFabricInfo_v3], nvml.Return) {
+	if d, ok := nvml.Unwrap(device).(mock.GpuFabricInfoVGetter); ok {
+		return d.GpuFabricInfoV().Negotiate()
+	}
+	return device.GetGpuFabricInfoV().Negotiate()
+}
+
+func captureTopology(device nvml.Device, other nvml.Device, index int) Topology {
+	t := Topology{
+		Device:         index,
+		CommonAncestor: valueOf(device.GetTopologyCommonAncestor(other)),
+		P2PStatus:      make(map[nvml.GpuP2PCapsIndex]Value[nvml.GpuP2PStatus]),
+	}
+	for _, caps := range capturedP2PCaps {
+		t.P2PStatus[caps] = valueOf(device.GetP2PStatus(other, caps))
+	}
+	return t
+}
+
+func captureGpuInstanceProfile(device nvml.Device, profile int) GpuInstanceProfile {
+	info, ret := device.GetGpuInstanceProfileInfo(profile)
+	p := GpuInstanceProfile{
+		Profile: profile,
+		Info:    valueOf(info, ret),
+	}
+	if ret != nvml.SUCCESS {
+		return p
+	}
+
+	placements := valueOf(device.GetGpuInstancePossiblePlacements(&info))
+	p.Placements = &placements
+
+	gpuInstances, ret := device.GetGpuInstances(&info)
+	var captured []GpuInstance
+	for _, gi := range gpuInstances {
+		captured = append(captured, captureGpuInstance(gi))
+	}
+	p.GpuInstances = &Value[[]GpuInstance]{Value: captured, Return: ret}
+	return p
+}
+
+func captureGpuInstance(gi nvml.GpuInstance) GpuInstance {
+	info, ret := gi.GetInfo()
+	g := GpuInstance{
+		Info: valueOf(GpuInstanceInfo{Id: info.Id, ProfileId: info.ProfileId, Placement: info.Placement}, ret),
+	}
+	for profile := 0; profile < nvml.COMPUTE_INSTANCE_PROFILE_COUNT; profile++ {
+		g.ComputeInstanceProfiles = append(g.ComputeInstanceProfiles, captureComputeInstanceProfile(gi, profile))
+	}
+	return g
+}
+
+func captureComputeInstanceProfile(gi nvml.GpuInstance, profile int) ComputeInstanceProfile {
+	info, ret := gi.GetComputeInstanceProfileInfo(profile, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	p := ComputeInstanceProfile{
+		Profile: profile,
+		Info:    valueOf(info, ret),
+	}
+	if ret != nvml.SUCCESS {
+		return p
+	}
+
+	placements := valueOf(gi.GetComputeInstancePossiblePlacements(&info))
+	p.Placements = &placements
+
+	computeInstances, ret := gi.GetComputeInstances(&info)
+	var captured []ComputeInstance
+	for _, ci := range computeInstances {
+		info, ret := ci.GetInfo()
+		captured = append(captured, ComputeInstance{
+			Info: valueOf(ComputeInstanceInfo{Id: info.Id, ProfileId: info.ProfileId, Placement: info.Placement}, ret),
+		})
+	}
+	p.ComputeInstances = &Value[[]ComputeInstance]{Value: captured, Return: ret}
+	return p
+}
+
+func captureMigDevice(device nvml.Device, index int) MigDevice {
+	migDevice, ret := device.GetMigDeviceHandleByIndex(index)
+	if ret != nvml.SUCCESS {
+		return MigDevice{Index: index, Return: ret}
+	}
+	return MigDevice{
+		Index:             index,
+		UUID:              valueOf(migDevice.GetUUID()),
+		Name:              valueOf(migDevice.GetName()),
+		MemoryInfo:        valueOf(migDevice.GetMemoryInfo()),
+		GpuInstanceId:     valueOf(migDevice.GetGpuInstanceId()),
+		ComputeInstanceId: valueOf(migDevice.GetComputeInstanceId()),
+		Attributes:        valueOf(migDevice.GetAttributes()),
+	}
+}
+
+// vgpuTypeIndices returns the indices of the specified vGPU types in the
+// snapshot, capturing the types that were not captured yet.
+func (c *capturer) vgpuTypeIndices(vgpuTypes []nvml.VgpuTypeId) []int {
+	var indices []int
+	for _, vgpuType := range vgpuTypes {
+		index, ok := c.vgpuTypes[vgpuType]
+		if !ok {
+			index = len(c.snapshot.VgpuTypes)
+			c.vgpuTypes[vgpuType] = index
+			c.snapshot.VgpuTypes = append(c.snapshot.VgpuTypes, captureVgpuType(vgpuType))
+		}
+		indices = append(indices, index)
+	}
+	return indices
+}
+
+func captureVgpuType(vgpuType nvml.VgpuTypeId) VgpuType {
+	deviceID, subsystemID, ret := vgpuType.GetDeviceID()
+	return VgpuType{
+		Name:                 valueOf(vgpuType.GetName()),
+		Class:                valueOf(vgpuType.GetClass()),
+		License:              valueOf(vgpuType.GetLicense()),
+		GpuInstanceProfileId: valueOf(vgpuType.GetGpuInstanceProfileId()),
+		DeviceID:             valueOf(VgpuDeviceID{DeviceID: deviceID, SubsystemID: subsystemID}, ret),
+		FramebufferSize:      valueOf(vgpuType.GetFramebufferSize()),
+		NumDisplayHeads:      valueOf(vgpuType.GetNumDisplayHeads()),
+		FrameRateLimit:       valueOf(vgpuType.GetFrameRateLimit()),
+		MaxInstancesPerVm:    valueOf(vgpuType.GetMaxInstancesPerVm()),
+	}
+}
@@ -0,0 +1,678 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+// Server is a mock Interface that replays a snapshot. The methods that are
+// captured return the values and Return that were captured. As for the other
+// mock servers, the remaining methods are not implemented.
+//
+// The methods of the Interface that take a handle as their first argument
+// (e.g. DeviceGetName) call the corresponding method of the handle.
+type Server struct {
+	mock.Interface
+	mock.ExtendedInterface
+	Snapshot *Snapshot
+
+	devices   []*device
+	vgpuTypes []*vgpuType
+}
+
+type device struct {
+	mock.Device
+	snapshot     *Device
+	migDevices   []*migDevice
+	gpuInstances []*gpuInstance
+}
+
+type migDevice struct {
+	mock.Device
+	snapshot *MigDevice
+	parent   *device
+}
+
+type gpuInstance struct {
+	mock.GpuInstance
+	snapshot         *GpuInstance
+	device           *device
+	computeInstances []*computeInstance
+}
+
+type computeInstance struct {
+	mock.ComputeInstance
+	snapshot    *ComputeInstance
+	gpuInstance *gpuInstance
+}
+
+type vgpuType struct {
+	mock.VgpuTypeId
+	snapshot *VgpuType
+	server   *Server
+}
+
+var _ nvml.Interface = (*Server)(nil)
+var _ mock.GpuFabricInfoVGetter = (*device)(nil)
+
+// New returns a mock Interface that replays the specified snapshot.
+func New(snapshot *Snapshot) (*Server, error) {
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %d (expected %d)", snapshot.Version, Version)
+	}
+
+	s := &Server{Snapshot: snapshot}
+	for i := range snapshot.VgpuTypes {
+		s.vgpuTypes = append(s.vgpuTypes, s.newVgpuType(&snapshot.VgpuTypes[i]))
+	}
+	for i := range snapshot.Devices {
+		d, err := s.newDevice(&snapshot.Devices[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid device %d: %w", i, err)
+		}
+		s.devices = append(s.devices, d)
+	}
+	for i, d := range s.devices {
+		for _, t := range snapshot.Devices[i].Topology {
+			if t.Device < 0 || t.Device >= len(s.devices) {
+				return nil, fmt.Errorf("invalid device %d: invalid topology device %d", i, t.Device)
+			}
+		}
+		if d != nil {
+			d.setMockFuncs(s)
+		}
+	}
+	s.setMockFuncs()
+	return s, nil
+}
+
+// NewFromFile returns a mock Interface that replays the snapshot in the
+// specified file.
+func NewFromFile(path string) (*Server, error) {
+	snapshot, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(snapshot)
+}
+
+func (s *Server) setMockFuncs() {
+	s.ExtensionsFunc = func() nvml.ExtendedInterface {
+		return s
+	}
+
+	s.LookupSymbolFunc = func(symbol string) error {
+		return nil
+	}
+
+	s.InitFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.ShutdownFunc = func() nvml.Return {
+		return nvml.SUCCESS
+	}
+
+	s.SystemGetDriverVersionFunc = s.Snapshot.System.DriverVersion.get
+	s.SystemGetNVMLVersionFunc = s.Snapshot.System.NvmlVersion.get
+	s.SystemGetCudaDriverVersionFunc = s.Snapshot.System.CudaDriverVersion.get
+	s.DeviceGetCountFunc = s.Snapshot.System.DeviceCount.get
+
+	s.DeviceGetHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		if index < 0 || index >= len(s.devices) {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if ret := s.Snapshot.Devices[index].Return; ret != nvml.SUCCESS {
+			return nil, ret
+		}
+		return s.devices[index], nvml.SUCCESS
+	}
+
+	s.DeviceGetHandleByUUIDFunc = func(uuid string) (nvml.Device, nvml.Return) {
+		for _, d := range s.devices {
+			if d == nil {
+				continue
+			}
+			if d.snapshot.UUID.Return == nvml.SUCCESS && d.snapshot.UUID.Value == uuid {
+				return d, nvml.SUCCESS
+			}
+			for _, m := range d.migDevices {
+				if m != nil && m.snapshot.UUID.Return == nvml.SUCCESS && m.snapshot.UUID.Value == uuid {
+					return m, nvml.SUCCESS
+				}
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	s.DeviceGetHandleByPciBusIdFunc = func(busID string) (nvml.Device, nvml.Return) {
+		for _, d := range s.devices {
+			if d == nil || d.snapshot.PciInfo.Return != nvml.SUCCESS {
+				continue
+			}
+			if strings.EqualFold(busID, busIDString(d.snapshot.PciInfo.Value.BusId[:])) ||
+				strings.EqualFold(busID, busIDString(d.snapshot.PciInfo.Value.BusIdLegacy[:])) {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	s.DeviceGetHandleBySerialFunc = func(serial string) (nvml.Device, nvml.Return) {
+		for _, d := range s.devices {
+			if d != nil && d.snapshot.Serial.Return == nvml.SUCCESS && d.snapshot.Serial.Value == serial {
+				return d, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	forwardHandleMethods(&s.Interface)
+}
+
+func (s *Server) newDevice(snapshot *Device) (*device, error) {
+	if snapshot.Return != nvml.SUCCESS {
+		return nil, nil
+	}
+
+	d := &device{snapshot: snapshot}
+	for i := range snapshot.MigDevices {
+		m := &migDevice{snapshot: &snapshot.MigDevices[i], parent: d}
+		m.setMockFuncs()
+		d.migDevices = append(d.migDevices, m)
+	}
+	for _, p := range snapshot.GpuInstanceProfiles {
+		if p.GpuInstances == nil {
+			continue
+		}
+		for i := range p.GpuInstances.Value {
+			gi := &gpuInstance{snapshot: &p.GpuInstances.Value[i], device: d}
+			for _, cp := range gi.snapshot.ComputeInstanceProfiles {
+				if cp.ComputeInstances == nil {
+					continue
+				}
+				for j := range cp.ComputeInstances.Value {
+					ci := &computeInstance{snapshot: &cp.ComputeInstances.Value[j], gpuInstance: gi}
+					ci.setMockFuncs()
+					gi.computeInstances = append(gi.computeInstances, ci)
+				}
+			}
+			gi.setMockFuncs()
+			d.gpuInstances = append(d.gpuInstances, gi)
+		}
+	}
+	for _, indices := range [][]int{snapshot.SupportedVgpus.Value, snapshot.CreatableVgpus.Value} {
+		for _, index := range indices {
+			if index < 0 || index >= len(s.vgpuTypes) {
+				return nil, fmt.Errorf("invalid vGPU type %d", index)
+			}
+		}
+	}
+	return d, nil
+}
+
+func (d *device) setMockFuncs(s *Server) {
+	snapshot := d.snapshot
+
+	d.GetIndexFunc = snapshot.Index.get
+	d.GetMinorNumberFunc = snapshot.MinorNumber.get
+	d.GetUUIDFunc = snapshot.UUID.get
+	d.GetNameFunc = snapshot.Name.get
+	d.GetSerialFunc = snapshot.Serial.get
+	d.GetBoardPartNumberFunc = snapshot.BoardPartNumber.get
+	d.GetBoardIdFunc = snapshot.BoardId.get
+	d.GetMultiGpuBoardFunc = snapshot.MultiGpuBoard.get
+	d.GetVbiosVersionFunc = snapshot.VbiosVersion.get
+	d.GetInforomImageVersionFunc = snapshot.InforomImageVersion.get
+	d.GetBrandFunc = snapshot.Brand.get
+	d.GetArchitectureFunc = snapshot.Architecture.get
+	d.GetPciInfoFunc = snapshot.PciInfo.get
+	d.GetMemoryInfoFunc = snapshot.MemoryInfo.get
+	d.GetBAR1MemoryInfoFunc = snapshot.BAR1MemoryInfo.get
+	d.GetNumGpuCoresFunc = snapshot.NumGpuCores.get
+	d.GetPersistenceModeFunc = snapshot.PersistenceMode.get
+	d.GetComputeModeFunc = snapshot.ComputeMode.get
+	d.GetPowerManagementLimitFunc = snapshot.PowerManagementLimit.get
+	d.GetPowerManagementDefaultLimitFunc = snapshot.PowerManagementDefaultLimit.get
+	d.GetEnforcedPowerLimitFunc = snapshot.EnforcedPowerLimit.get
+	d.GetMaxPcieLinkGenerationFunc = snapshot.MaxPcieLinkGeneration.get
+	d.GetMaxPcieLinkWidthFunc = snapshot.MaxPcieLinkWidth.get
+	d.IsMigDeviceHandleFunc = snapshot.IsMigDeviceHandle.get
+	d.GetMaxMigDeviceCountFunc = snapshot.MaxMigDeviceCount.get
+
+	d.GetCudaComputeCapabilityFunc = func() (int, int, nvml.Return) {
+		c := snapshot.CudaComputeCapability
+		return c.Value.Major, c.Value.Minor, c.Return
+	}
+
+	d.GetMaxClockInfoFunc = func(clock nvml.ClockType) (uint32, nvml.Return) {
+		v, ok := snapshot.MaxClockInfo[clock]
+		if !ok {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return v.get()
+	}
+
+	d.GetGpuFabricInfoFunc = func() (nvml.GpuFabricInfo, nvml.Return) {
+		return d.GpuFabricInfoV().V1()
+	}
+
+	d.GetNvLinkStateFunc = func(link int) (nvml.EnableState, nvml.Return) {
+		if link < 0 || link >= len(snapshot.NvLinks) {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return snapshot.NvLinks[link].State.get()
+	}
+
+	d.GetNvLinkVersionFunc = func(link int) (uint32, nvml.Return) {
+		if link < 0 || link >= len(snapshot.NvLinks) {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return snapshot.NvLinks[link].Version.get()
+	}
+
+	d.GetNvLinkRemoteDeviceTypeFunc = func(link int) (nvml.IntNvLinkDeviceType, nvml.Return) {
+		if link < 0 || link >= len(snapshot.NvLinks) {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return snapshot.NvLinks[link].RemoteDeviceType.get()
+	}
+
+	d.GetNvLinkRemotePciInfoFunc = func(link int) (nvml.PciInfo, nvml.Return) {
+		if link < 0 || link >= len(snapshot.NvLinks) {
+			return nvml.PciInfo{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return snapshot.NvLinks[link].RemotePciInfo.get()
+	}
+
+	d.GetTopologyCommonAncestorFunc = func(other nvml.Device) (nvml.GpuTopologyLevel, nvml.Return) {
+		t := d.topology(s, other)
+		if t == nil {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return t.CommonAncestor.get()
+	}
+
+	d.GetP2PStatusFunc = func(other nvml.Device, caps nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, nvml.Return) {
+		t := d.topology(s, other)
+		if t == nil {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		v, ok := t.P2PStatus[caps]
+		if !ok {
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return v.get()
+	}
+
+	d.GetMigModeFunc = func() (int, int, nvml.Return) {
+		m := snapshot.MigMode
+		return m.Value.Current, m.Value.Pending, m.Return
+	}
+
+	d.GetGpuInstanceProfileInfoFunc = func(profile int) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+		for _, p := range snapshot.GpuInstanceProfiles {
+			if p.Profile == profile {
+				return p.Info.get()
+			}
+		}
+		return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.GetGpuInstancePossiblePlacementsFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, nvml.Return) {
+		p := d.gpuInstanceProfile(info.Id)
+		if p == nil || p.Placements == nil {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return p.Placements.get()
+	}
+
+	d.GetGpuInstancesFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
+		p := d.gpuInstanceProfile(info.Id)
+		if p == nil || p.GpuInstances == nil {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if p.GpuInstances.Return != nvml.SUCCESS {
+			return nil, p.GpuInstances.Return
+		}
+		var gis []nvml.GpuInstance
+		for _, gi := range d.gpuInstances {
+			if gi.snapshot.Info.Return == nvml.SUCCESS && gi.snapshot.Info.Value.ProfileId == info.Id {
+				gis = append(gis, gi)
+			}
+		}
+		return gis, nvml.SUCCESS
+	}
+
+	d.GetGpuInstanceByIdFunc = func(id int) (nvml.GpuInstance, nvml.Return) {
+		for _, gi := range d.gpuInstances {
+			if gi.snapshot.Info.Return == nvml.SUCCESS && int(gi.snapshot.Info.Value.Id) == id {
+				return gi, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+
+	d.GetMigDeviceHandleByIndexFunc = func(index int) (nvml.Device, nvml.Return) {
+		for _, m := range d.migDevices {
+			if m.snapshot.Index != index {
+				continue
+			}
+			if m.snapshot.Return != nvml.SUCCESS {
+				return nil, m.snapshot.Return
+			}
+			return m, nvml.SUCCESS
+		}
+		return nil, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	d.GetSupportedVgpusFunc = func() ([]nvml.VgpuTypeId, nvml.Return) {
+		return s.vgpuTypeIds(snapshot.SupportedVgpus)
+	}
+
+	d.GetCreatableVgpusFunc = func() ([]nvml.VgpuTypeId, nvml.Return) {
+		return s.vgpuTypeIds(snapshot.CreatableVgpus)
+	}
+
+	d.VgpuTypeGetMaxInstancesFunc = func(vgpuTypeId nvml.VgpuTypeId) (int, nvml.Return) {
+		return vgpuTypeId.GetMaxInstances(d)
+	}
+}
+
+// topology returns the topology between the device and the specified device,
+// or nil if it was not captured.
+// GpuFabricInfoV returns a handler for the captured fabric info of the device.
+// Versions of the struct that are later than the captured one are not
+// supported.
+func (d *device) GpuFabricInfoV() mock.GpuFabricInfoHandler {
+	return mock.GpuFabricInfoHandler{
+		Get: func(info *nvml.GpuFabricInfoV) nvml.Return {
+			captured := d.snapshot.FabricInfo
+			if captured.Return != nvml.SUCCESS {
+				return captured.Return
+			}
+			// The unversioned struct is requested with a zero version.
+			version := int(info.Version >> 24)
+			if version == 0 {
+				version = 1
+			}
+			if version > captured.Value.Version {
+				return nvml.ERROR_ARGUMENT_VERSION_MISMATCH
+			}
+			requested := info.Version
+			*info = nvml.GpuFabricInfoV(captured.Value.Info)
+			info.Version = requested
+			return nvml.SUCCESS
+		},
+	}
+}
+
+func (d *device) topology(s *Server, other nvml.Device) *Topology {
+	for i := range d.snapshot.Topology {
+		t := &d.snapshot.Topology[i]
+		if nvml.Device(s.devices[t.Device]) == other {
+			return t
+		}
+	}
+	return nil
+}
+
+// gpuInstanceProfile returns the GPU instance profile with the specified ID
+// (as opposed to the GPU_INSTANCE_PROFILE_* value), or nil if its info was
+// not captured.
+func (d *device) gpuInstanceProfile(id uint32) *GpuInstanceProfile {
+	for i := range d.snapshot.GpuInstanceProfiles {
+		p := &d.snapshot.GpuInstanceProfiles[i]
+		if p.Info.Return == nvml.SUCCESS && p.Info.Value.Id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (m *migDevice) setMockFuncs() {
+	snapshot := m.snapshot
+
+	m.GetUUIDFunc = snapshot.UUID.get
+	m.GetNameFunc = snapshot.Name.get
+	m.GetMemoryInfoFunc = snapshot.MemoryInfo.get
+	m.GetGpuInstanceIdFunc = snapshot.GpuInstanceId.get
+	m.GetComputeInstanceIdFunc = snapshot.ComputeInstanceId.get
+	m.GetAttributesFunc = snapshot.Attributes.get
+
+	m.IsMigDeviceHandleFunc = func() (bool, nvml.Return) {
+		return true, nvml.SUCCESS
+	}
+
+	m.GetDeviceHandleFromMigDeviceHandleFunc = func() (nvml.Device, nvml.Return) {
+		return m.parent, nvml.SUCCESS
+	}
+}
+
+func (gi *gpuInstance) setMockFuncs() {
+	gi.GetInfoFunc = func() (nvml.GpuInstanceInfo, nvml.Return) {
+		info := gi.snapshot.Info
+		if info.Return != nvml.SUCCESS {
+			return nvml.GpuInstanceInfo{}, info.Return
+		}
+		return nvml.GpuInstanceInfo{
+			Device:    gi.device,
+			Id:        info.Value.Id,
+			ProfileId: info.Value.ProfileId,
+			Placement: info.Value.Placement,
+		}, nvml.SUCCESS
+	}
+
+	gi.GetComputeInstanceProfileInfoFunc = func(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+		if engProfile != nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED {
+			return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		for _, p := range gi.snapshot.ComputeInstanceProfiles {
+			if p.Profile == profile {
+				return p.Info.get()
+			}
+		}
+		return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+	}
+
+	gi.GetComputeInstancePossiblePlacementsFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, nvml.Return) {
+		p := gi.computeInstanceProfile(info.Id)
+		if p == nil || p.Placements == nil {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		return p.Placements.get()
+	}
+
+	gi.GetComputeInstancesFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstance, nvml.Return) {
+		p := gi.computeInstanceProfile(info.Id)
+		if p == nil || p.ComputeInstances == nil {
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		}
+		if p.ComputeInstances.Return != nvml.SUCCESS {
+			return nil, p.ComputeInstances.Return
+		}
+		var cis []nvml.ComputeInstance
+		for _, ci := range gi.computeInstances {
+			if ci.snapshot.Info.Return == nvml.SUCCESS && ci.snapshot.Info.Value.ProfileId == info.Id {
+				cis = append(cis, ci)
+			}
+		}
+		return cis, nvml.SUCCESS
+	}
+
+	gi.GetComputeInstanceByIdFunc = func(id int) (nvml.ComputeInstance, nvml.Return) {
+		for _, ci := range gi.computeInstances {
+			if ci.snapshot.Info.Return == nvml.SUCCESS && int(ci.snapshot.Info.Value.Id) == id {
+				return ci, nvml.SUCCESS
+			}
+		}
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+}
+
+// computeInstanceProfile returns the compute instance profile with the
+// specified ID, or nil if its info was not captured.
+func (gi *gpuInstance) computeInstanceProfile(id uint32) *ComputeInstanceProfile {
+	for i := range gi.snapshot.ComputeInstanceProfiles {
+		p := &gi.snapshot.ComputeInstanceProfiles[i]
+		if p.Info.Return == nvml.SUCCESS && p.Info.Value.Id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (ci *computeInstance) setMockFuncs() {
+	ci.GetInfoFunc = func() (nvml.ComputeInstanceInfo, nvml.Return) {
+		info := ci.snapshot.Info
+		if info.Return != nvml.SUCCESS {
+			return nvml.ComputeInstanceInfo{}, info.Return
+		}
+		return nvml.ComputeInstanceInfo{
+			Device:      ci.gpuInstance.device,
+			GpuInstance: ci.gpuInstance,
+			Id:          info.Value.Id,
+			ProfileId:   info.Value.ProfileId,
+			Placement:   info.Value.Placement,
+		}, nvml.SUCCESS
+	}
+}
+
+func (s *Server) newVgpuType(snapshot *VgpuType) *vgpuType {
+	v := &vgpuType{snapshot: snapshot, server: s}
+	v.GetNameFunc = snapshot.Name.get
+	v.GetClassFunc = snapshot.Class.get
+	v.GetLicenseFunc = snapshot.License.get
+	v.GetGpuInstanceProfileIdFunc = snapshot.GpuInstanceProfileId.get
+	v.GetFramebufferSizeFunc = snapshot.FramebufferSize.get
+	v.GetNumDisplayHeadsFunc = snapshot.NumDisplayHeads.get
+	v.GetFrameRateLimitFunc = snapshot.FrameRateLimit.get
+	v.GetMaxInstancesPerVmFunc = snapshot.MaxInstancesPerVm.get
+
+	v.GetDeviceIDFunc = func() (uint64, uint64, nvml.Return) {
+		id := snapshot.DeviceID
+		return id.Value.DeviceID, id.Value.SubsystemID, id.Return
+	}
+
+	v.GetMaxInstancesFunc = func(d nvml.Device) (int, nvml.Return) {
+		index := -1
+		for i, t := range s.vgpuTypes {
+			if t == v {
+				index = i
+			}
+		}
+		for _, device := range s.devices {
+			if device == nil || nvml.Device(device) != d {
+				continue
+			}
+			if instances, ok := device.snapshot.VgpuMaxInstances[index]; ok {
+				return instances.get()
+			}
+		}
+		return 0, nvml.ERROR_INVALID_ARGUMENT
+	}
+	return v
+}
+
+func (s *Server) vgpuTypeIds(indices Value[[]int]) ([]nvml.VgpuTypeId, nvml.Return) {
+	if indices.Return != nvml.SUCCESS {
+		return nil, indices.Return
+	}
+	var vgpuTypeIds []nvml.VgpuTypeId
+	for _, index := range indices.Value {
+		vgpuTypeIds = append(vgpuTypeIds, s.vgpuTypes[index])
+	}
+	return vgpuTypeIds, nvml.SUCCESS
+}
+
+// busIDString returns the NUL-terminated bus ID in the specified char array.
+func busIDString(chars []uint8) string {
+	for i, c := range chars {
+		if c == 0 {
+			return string(chars[:i])
+		}
+	}
+	return string(chars)
+}
+
+// handleTypes are the handles whose methods are forwarded to by the methods
+// of the Interface, along with the prefix of the methods of the Interface.
+var handleTypes = []struct {
+	prefix string
+	handle reflect.Type
+}{
+	{"Device", reflect.TypeOf((*nvml.Device)(nil)).Elem()},
+	{"GpuInstance", reflect.TypeOf((*nvml.GpuInstance)(nil)).Elem()},
+	{"ComputeInstance", reflect.TypeOf((*nvml.ComputeInstance)(nil)).Elem()},
+	{"VgpuType", reflect.TypeOf((*nvml.VgpuTypeId)(nil)).Elem()},
+}
+
+// forwardHandleMethods sets each function of the mock Interface that is not
+// set, and that corresponds to a method of a handle (e.g. DeviceGetName and
+// Device.GetName), to call the method of the handle that is passed as its
+// first argument.
+func forwardHandleMethods(lib *mock.Interface) {
+	v := reflect.ValueOf(lib).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field, fieldType := v.Field(i), v.Type().Field(i)
+		name, ok := strings.CutSuffix(fieldType.Name, "Func")
+		if !ok || !fieldType.IsExported() || field.Kind() != reflect.Func || !field.IsNil() {
+			continue
+		}
+		for _, h := range handleTypes {
+			methodName, ok := strings.CutPrefix(name, h.prefix)
+			if !ok {
+				continue
+			}
+			method, ok := h.handle.MethodByName(methodName)
+			if !ok || !forwards(fieldType.Type, h.handle, method.Type) {
+				continue
+			}
+			field.Set(reflect.MakeFunc(fieldType.Type, func(args []reflect.Value) []reflect.Value {
+				return args[0].MethodByName(methodName).Call(args[1:])
+			}))
+			break
+		}
+	}
+}
+
+// forwards checks whether a function of the specified type can forward its
+// arguments to the method of a handle of the specified type: it takes the
+// handle followed by the arguments of the method, and returns its results.
+func forwards(function reflect.Type, handle reflect.Type, method reflect.Type) bool {
+	if function.NumIn() != method.NumIn()+1 || function.In(0) != handle ||
+		function.NumOut() != method.NumOut() || function.IsVariadic() != method.IsVariadic() {
+		return false
+	}
+	for i := 0; i < method.NumIn(); i++ {
+		if function.In(i+1) != method.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < method.NumOut(); i++ {
+		if function.Out(i) != method.Out(i) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package snapshot captures the state of a system through an nvml.Interface
+// into a JSON document, and replays such a document as a mock nvml.Interface.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Version is the version of the snapshot format written by Capture. It is
+// incremented whenever the format changes in a way that older versions of
+// this package cannot read.
+const Version = 1
+
+// Snapshot holds the state of a system as returned by NVML.
+type Snapshot struct {
+	Version int      `json:"version"`
+	System  System   `json:"system"`
+	Devices []Device `json:"devices"`
+	// VgpuTypes holds the vGPU types supported by the devices, which refer to
+	// these by index.
+	VgpuTypes []VgpuType `json:"vgpuTypes,omitempty"`
+}
+
+// Value holds the value returned by a call along with its Return. The value
+// is not set if the call failed.
+type Value[T any] struct {
+	Value  T           `json:"value"`
+	Return nvml.Return `json:"return,omitempty"`
+}
+
+// System holds the state of the system.
+type System struct {
+	DriverVersion     Value[string] `json:"driverVersion"`
+	NvmlVersion       Value[string] `json:"nvmlVersion"`
+	CudaDriverVersion Value[int]    `json:"cudaDriverVersion"`
+	DeviceCount       Value[int]    `json:"deviceCount"`
+}
+
+// Device holds the state of a device, as returned by DeviceGetHandleByIndex
+// for the index of the device.
+type Device struct {
+	// Return is the Return of DeviceGetHandleByIndex. The other fields are
+	// not set if the call failed.
+	Return                      nvml.Return                      `json:"return,omitempty"`
+	Index                       Value[int]                       `json:"index"`
+	MinorNumber                 Value[int]                       `json:"minorNumber"`
+	UUID                        Value[string]                    `json:"uuid"`
+	Name                        Value[string]                    `json:"name"`
+	Serial                      Value[string]                    `json:"serial"`
+	BoardPartNumber             Value[string]                    `json:"boardPartNumber"`
+	BoardId                     Value[uint32]                    `json:"boardId"`
+	MultiGpuBoard               Value[int]                       `json:"multiGpuBoard"`
+	VbiosVersion                Value[string]                    `json:"vbiosVersion"`
+	InforomImageVersion         Value[string]                    `json:"inforomImageVersion"`
+	Brand                       Value[nvml.BrandType]            `json:"brand"`
+	Architecture                Value[nvml.DeviceArchitecture]   `json:"architecture"`
+	PciInfo                     Value[nvml.PciInfo]              `json:"pciInfo"`
+	CudaComputeCapability       Value[CudaComputeCapability]     `json:"cudaComputeCapability"`
+	MemoryInfo                  Value[nvml.Memory]               `json:"memoryInfo"`
+	BAR1MemoryInfo              Value[nvml.BAR1Memory]           `json:"bar1MemoryInfo"`
+	NumGpuCores                 Value[int]                       `json:"numGpuCores"`
+	PersistenceMode             Value[nvml.EnableState]          `json:"persistenceMode"`
+	ComputeMode                 Value[nvml.ComputeMode]          `json:"computeMode"`
+	PowerManagementLimit        Value[uint32]                    `json:"powerManagementLimit"`
+	PowerManagementDefaultLimit Value[uint32]                    `json:"powerManagementDefaultLimit"`
+	EnforcedPowerLimit          Value[uint32]                    `json:"enforcedPowerLimit"`
+	MaxPcieLinkGeneration       Value[int]                       `json:"maxPcieLinkGeneration"`
+	MaxPcieLinkWidth            Value[int]                       `json:"maxPcieLinkWidth"`
+	MaxClockInfo                map[nvml.ClockType]Value[uint32] `json:"maxClockInfo"`
+	IsMigDeviceHandle           Value[bool]                      `json:"isMigDeviceHandle"`
+	FabricInfo                  Value[FabricInfo]                `json:"fabricInfo"`
+	NvLinks                     []NvLink                         `json:"nvLinks"`
+	Topology                    []Topology                       `json:"topology,omitempty"`
+	MigMode                     Value[MigMode]                   `json:"migMode"`
+	MaxMigDeviceCount           Value[int]                       `json:"maxMigDeviceCount"`
+	GpuInstanceProfiles         []GpuInstanceProfile             `json:"gpuInstanceProfiles"`
+	MigDevices                  []MigDevice                      `json:"migDevices,omitempty"`
+	SupportedVgpus              Value[[]int]                     `json:"supportedVgpus"`
+	CreatableVgpus              Value[[]int]                     `json:"creatableVgpus"`
+	VgpuMaxInstances            map[int]Value[int]               `json:"vgpuMaxInstances,omitempty"`
+}
+
+// CudaComputeCapability is the CUDA compute capability of a device.
+type CudaComputeCapability struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+// MigMode holds the current and pending MIG modes of a device.
+type MigMode struct {
+	Current int `json:"current"`
+	Pending int `json:"pending"`
+}
+
+// FabricInfo holds the fabric info of a device, as returned by
+// GetGpuFabricInfoV using the latest version of the struct supported by the
+// library.
+type FabricInfo struct {
+	Version int                   `json:"version"`
+	Info    nvml.GpuFabricInfo_v3 `json:"info"`
+}
+
+// NvLink holds the state of an NVLink of a device.
+type NvLink struct {
+	State            Value[nvml.EnableState]         `json:"state"`
+	Version          Value[uint32]                   `json:"version"`
+	RemoteDeviceType Value[nvml.IntNvLinkDeviceType] `json:"remoteDeviceType"`
+	RemotePciInfo    Value[nvml.PciInfo]             `json:"remotePciInfo"`
+}
+
+// Topology holds the topology between a device and another device.
+type Topology struct {
+	// Device is the index of the other device.
+	Device         int                                               `json:"device"`
+	CommonAncestor Value[nvml.GpuTopologyLevel]                      `json:"commonAncestor"`
+	P2PStatus      map[nvml.GpuP2PCapsIndex]Value[nvml.GpuP2PStatus] `json:"p2pStatus"`
+}
+
+// GpuInstanceProfile holds a GPU instance profile of a device along with its
+// placements and the GPU instances created with the profile.
+type GpuInstanceProfile struct {
+	// Profile is the GPU_INSTANCE_PROFILE_* value that the info is returned
+	// for. The other fields are only set if the info is returned.
+	Profile      int                                 `json:"profile"`
+	Info         Value[nvml.GpuInstanceProfileInfo]  `json:"info"`
+	Placements   *Value[[]nvml.GpuInstancePlacement] `json:"placements,omitempty"`
+	GpuInstances *Value[[]GpuInstance]               `json:"gpuInstances,omitempty"`
+}
+
+// GpuInstance holds a GPU instance and its compute instance profiles.
+type GpuInstance struct {
+	Info                    Value[GpuInstanceInfo]   `json:"info"`
+	ComputeInstanceProfiles []ComputeInstanceProfile `json:"computeInstanceProfiles"`
+}
+
+// GpuInstanceInfo holds the info of a GPU instance, except for its device.
+type GpuInstanceInfo struct {
+	Id        uint32                    `json:"id"`
+	ProfileId uint32                    `json:"profileId"`
+	Placement nvml.GpuInstancePlacement `json:"placement"`
+}
+
+// ComputeInstanceProfile holds a compute instance profile of a GPU instance,
+// for the shared engine profile, along with its placements and the compute
+// instances created with the profile.
+type ComputeInstanceProfile struct {
+	// Profile is the COMPUTE_INSTANCE_PROFILE_* value that the info is
+	// returned for. The other fields are only set if the info is returned.
+	Profile          int                                     `json:"profile"`
+	Info             Value[nvml.ComputeInstanceProfileInfo]  `json:"info"`
+	Placements       *Value[[]nvml.ComputeInstancePlacement] `json:"placements,omitempty"`
+	ComputeInstances *Value[[]ComputeInstance]               `json:"computeInstances,omitempty"`
+}
+
+// ComputeInstance holds a compute instance.
+type ComputeInstance struct {
+	Info Value[ComputeInstanceInfo] `json:"info"`
+}
+
+// ComputeInstanceInfo holds the info of a compute instance, except for its
+// device and GPU instance.
+type ComputeInstanceInfo struct {
+	Id        uint32                        `json:"id"`
+	ProfileId uint32                        `json:"profileId"`
+	Placement nvml.ComputeInstancePlacement `json:"placement"`
+}
+
+// MigDevice holds the state of a MIG device, as returned by
+// GetMigDeviceHandleByIndex for the index of the MIG device.
+type MigDevice struct {
+	Index int `json:"index"`
+	// Return is the Return of GetMigDeviceHandleByIndex. The other fields
+	// are not set if the call failed.
+	Return            nvml.Return                  `json:"return,omitempty"`
+	UUID              Value[string]                `json:"uuid"`
+	Name              Value[string]                `json:"name"`
+	MemoryInfo        Value[nvml.Memory]           `json:"memoryInfo"`
+	GpuInstanceId     Value[int]                   `json:"gpuInstanceId"`
+	ComputeInstanceId Value[int]                   `json:"computeInstanceId"`
+	Attributes        Value[nvml.DeviceAttributes] `json:"attributes"`
+}
+
+// VgpuType holds the properties of a vGPU type.
+type VgpuType struct {
+	Name                 Value[string]       `json:"name"`
+	Class                Value[string]       `json:"class"`
+	License              Value[string]       `json:"license"`
+	GpuInstanceProfileId Value[uint32]       `json:"gpuInstanceProfileId"`
+	DeviceID             Value[VgpuDeviceID] `json:"deviceID"`
+	FramebufferSize      Value[uint64]       `json:"framebufferSize"`
+	NumDisplayHeads      Value[int]          `json:"numDisplayHeads"`
+	FrameRateLimit       Value[uint32]       `json:"frameRateLimit"`
+	MaxInstancesPerVm    Value[int]          `json:"maxInstancesPerVm"`
+}
+
+// VgpuDeviceID holds the device and subsystem IDs of a vGPU type.
+type VgpuDeviceID struct {
+	DeviceID    uint64 `json:"deviceID"`
+	SubsystemID uint64 `json:"subsystemID"`
+}
+
+// Write writes the snapshot as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Read reads a snapshot written by Write. An error is returned if the
+// snapshot has a different version than the one written by this package.
+func Read(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %d (expected %d)", snapshot.Version, Version)
+	}
+	return &snapshot, nil
+}
+
+// ReadFile reads the snapshot in the specified file.
+func ReadFile(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Read(file)
+}
+
+func valueOf[T any](value T, ret nvml.Return) Value[T] {
+	if ret != nvml.SUCCESS {
+		var zero T
+		value = zero
+	}
+	return Value[T]{Value: value, Return: ret}
+}
+
+func (v Value[T]) get() (T, nvml.Return) {
+	return v.Value, v.Return
+}
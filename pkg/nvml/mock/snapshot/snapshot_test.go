@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package snapshot
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock/dgxh100"
+)
+
+// notSupported returns ERROR_NOT_SUPPORTED for the calls to the methods that
+// are not implemented by a mock server, which panic instead.
+func notSupported(call nvml.Call, invoke func() nvml.Return) (ret nvml.Return) {
+	defer func() {
+		if recover() != nil {
+			ret = nvml.ERROR_NOT_SUPPORTED
+		}
+	}()
+	return invoke()
+}
+
+// lostDevice fails to return the handle of the last device of a DGX H100.
+func lostDevice(call nvml.Call, invoke func() nvml.Return) nvml.Return {
+	if call.Method == "DeviceGetHandleByIndex" && call.Args[0] == 7 {
+		return nvml.ERROR_GPU_IS_LOST
+	}
+	return invoke()
+}
+
+func newCapturedServer(t *testing.T) (*dgxh100.Server, *Server) {
+	server := dgxh100.New()
+	device := server.Devices[0]
+	ret, _ := device.SetMigMode(nvml.DEVICE_MIG_ENABLE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	giProfileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	gi, ret := device.CreateGpuInstance(&giProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	ciProfileInfo, ret := gi.GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	_, ret = gi.CreateComputeInstance(&ciProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	snapshot := Capture(nvml.Intercept(server, notSupported, lostDevice))
+
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Write(&buf))
+	snapshot, err := Read(&buf)
+	require.NoError(t, err)
+
+	replayed, err := New(snapshot)
+	require.NoError(t, err)
+	return server, replayed
+}
+
+func TestReplay(t *testing.T) {
+	server, replayed := newCapturedServer(t)
+
+	driverVersion, ret := replayed.SystemGetDriverVersion()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, server.DriverVersion, driverVersion)
+
+	count, ret := replayed.DeviceGetCount()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 8, count)
+
+	_, ret = replayed.DeviceGetHandleByIndex(7)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+
+	for i, expected := range server.Devices[:7] {
+		device, ret := replayed.DeviceGetHandleByIndex(i)
+		require.Equal(t, nvml.SUCCESS, ret)
+
+		uuid, ret := device.GetUUID()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, expected.(*dgxh100.Device).UUID, uuid)
+
+		byUUID, ret := replayed.DeviceGetHandleByUUID(uuid)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, device, byUUID)
+
+		byBusID, ret := replayed.DeviceGetHandleByPciBusId(dgxh100.PciBusIDs[i])
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, device, byBusID)
+
+		name, ret := replayed.DeviceGetName(device)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, "Mock NVIDIA H100 80GB HBM3", name)
+
+		arch, ret := device.GetArchitecture()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.DeviceArchitecture(nvml.DEVICE_ARCH_HOPPER), arch)
+
+		major, minor, ret := device.GetCudaComputeCapability()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, []int{9, 0}, []int{major, minor})
+
+		pciInfo, ret := device.GetNvLinkRemotePciInfo(0)
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, dgxh100.NvSwitchPciBusIDs[0], string(pciInfo.BusId[:12]))
+
+		fabricInfo, ret := device.(mock.GpuFabricInfoVGetter).GpuFabricInfoV().V3()
+		require.Equal(t, nvml.SUCCESS, ret)
+		require.Equal(t, nvml.GPU_FABRIC_STATE_COMPLETED, int(fabricInfo.State))
+		require.Equal(t, dgxh100.ClusterUUID[:], fabricInfo.ClusterUuid[:])
+
+		// The methods that failed during the capture fail in the same way.
+		_, ret = device.GetSerial()
+		require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+		_, ret = device.GetTopologyCommonAncestor(device)
+		require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+	}
+}
+
+func TestReplayMIG(t *testing.T) {
+	_, replayed := newCapturedServer(t)
+	device, ret := replayed.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	current, _, ret := device.GetMigMode()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_ENABLE, current)
+
+	giProfileInfo, ret := device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_3_SLICE)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, dgxh100.MIGProfiles.GpuInstanceProfiles[nvml.GPU_INSTANCE_PROFILE_3_SLICE], giProfileInfo)
+
+	placements, ret := device.GetGpuInstancePossiblePlacements(&giProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, dgxh100.MIGPlacements.GpuInstancePossiblePlacements[nvml.GPU_INSTANCE_PROFILE_3_SLICE], placements)
+
+	gis, ret := device.GetGpuInstances(&giProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, gis, 1)
+
+	giInfo, ret := gis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, device, giInfo.Device)
+	require.Equal(t, giProfileInfo.Id, giInfo.ProfileId)
+
+	ciProfileInfo, ret := gis[0].GetComputeInstanceProfileInfo(nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, nvml.COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED)
+	require.Equal(t, nvml.SUCCESS, ret)
+	cis, ret := gis[0].GetComputeInstances(&ciProfileInfo)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, cis, 1)
+
+	ciInfo, ret := cis[0].GetInfo()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, gis[0], ciInfo.GpuInstance)
+
+	_, ret = device.GetGpuInstanceProfileInfo(nvml.GPU_INSTANCE_PROFILE_6_SLICE)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestReadVersion(t *testing.T) {
+	_, err := Read(strings.NewReader(`{"version": 2}`))
+	require.ErrorContains(t, err, "unsupported snapshot version 2")
+}
@@ -72,6 +72,9 @@ var _ nvml.VgpuInstance = &VgpuInstance{}
 //			GetMetadataFunc: func() (nvml.VgpuMetadata, nvml.Return) {
 //				panic("mock out the GetMetadata method")
 //			},
+//			GetPlacementIdFunc: func() (nvml.VgpuPlacementId, nvml.Return) {
+//				panic("mock out the GetPlacementId method")
+//			},
 //			GetRuntimeStateSizeFunc: func() (nvml.VgpuRuntimeState, nvml.Return) {
 //				panic("mock out the GetRuntimeStateSize method")
 //			},
@@ -151,6 +154,9 @@ type VgpuInstance struct {
 	// GetMetadataFunc mocks the GetMetadata method.
 	GetMetadataFunc func() (nvml.VgpuMetadata, nvml.Return)
 
+	// GetPlacementIdFunc mocks the GetPlacementId method.
+	GetPlacementIdFunc func() (nvml.VgpuPlacementId, nvml.Return)
+
 	// GetRuntimeStateSizeFunc mocks the GetRuntimeStateSize method.
 	GetRuntimeStateSizeFunc func() (nvml.VgpuRuntimeState, nvml.Return)
 
@@ -227,6 +233,9 @@ type VgpuInstance struct {
 		// GetMetadata holds details about calls to the GetMetadata method.
 		GetMetadata []struct {
 		}
+		// GetPlacementId holds details about calls to the GetPlacementId method.
+		GetPlacementId []struct {
+		}
 		// GetRuntimeStateSize holds details about calls to the GetRuntimeStateSize method.
 		GetRuntimeStateSize []struct {
 		}
@@ -266,6 +275,7 @@ type VgpuInstance struct {
 	lockGetLicenseStatus    sync.RWMutex
 	lockGetMdevUUID         sync.RWMutex
 	lockGetMetadata         sync.RWMutex
+	lockGetPlacementId      sync.RWMutex
 	lockGetRuntimeStateSize sync.RWMutex
 	lockGetType             sync.RWMutex
 	lockGetUUID             sync.RWMutex
@@ -765,6 +775,33 @@ func (mock *VgpuInstance) GetMetadataCalls() []struct {
 	return calls
 }
 
+// GetPlacementId calls GetPlacementIdFunc.
+func (mock *VgpuInstance) GetPlacementId() (nvml.VgpuPlacementId, nvml.Return) {
+	if mock.GetPlacementIdFunc == nil {
+		panic("VgpuInstance.GetPlacementIdFunc: method is nil but VgpuInstance.GetPlacementId was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetPlacementId.Lock()
+	mock.calls.GetPlacementId = append(mock.calls.GetPlacementId, callInfo)
+	mock.lockGetPlacementId.Unlock()
+	return mock.GetPlacementIdFunc()
+}
+
+// GetPlacementIdCalls gets all the calls that were made to GetPlacementId.
+// Check the length with:
+//
+//	len(mockedVgpuInstance.GetPlacementIdCalls())
+func (mock *VgpuInstance) GetPlacementIdCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetPlacementId.RLock()
+	calls = mock.calls.GetPlacementId
+	mock.lockGetPlacementId.RUnlock()
+	return calls
+}
+
 // GetRuntimeStateSize calls GetRuntimeStateSizeFunc.
 func (mock *VgpuInstance) GetRuntimeStateSize() (nvml.VgpuRuntimeState, nvml.Return) {
 	if mock.GetRuntimeStateSizeFunc == nil {
@@ -33,6 +33,9 @@ var _ nvml.VgpuTypeId = &VgpuTypeId{}
 //			GetDeviceIDFunc: func() (uint64, uint64, nvml.Return) {
 //				panic("mock out the GetDeviceID method")
 //			},
+//			GetFbReservationFunc: func() (uint64, nvml.Return) {
+//				panic("mock out the GetFbReservation method")
+//			},
 //			GetFrameRateLimitFunc: func() (uint32, nvml.Return) {
 //				panic("mock out the GetFrameRateLimit method")
 //			},
@@ -42,6 +45,9 @@ var _ nvml.VgpuTypeId = &VgpuTypeId{}
 //			GetGpuInstanceProfileIdFunc: func() (uint32, nvml.Return) {
 //				panic("mock out the GetGpuInstanceProfileId method")
 //			},
+//			GetGspHeapSizeFunc: func() (uint64, nvml.Return) {
+//				panic("mock out the GetGspHeapSize method")
+//			},
 //			GetLicenseFunc: func() (string, nvml.Return) {
 //				panic("mock out the GetLicense method")
 //			},
@@ -85,6 +91,9 @@ type VgpuTypeId struct {
 	// GetDeviceIDFunc mocks the GetDeviceID method.
 	GetDeviceIDFunc func() (uint64, uint64, nvml.Return)
 
+	// GetFbReservationFunc mocks the GetFbReservation method.
+	GetFbReservationFunc func() (uint64, nvml.Return)
+
 	// GetFrameRateLimitFunc mocks the GetFrameRateLimit method.
 	GetFrameRateLimitFunc func() (uint32, nvml.Return)
 
@@ -94,6 +103,9 @@ type VgpuTypeId struct {
 	// GetGpuInstanceProfileIdFunc mocks the GetGpuInstanceProfileId method.
 	GetGpuInstanceProfileIdFunc func() (uint32, nvml.Return)
 
+	// GetGspHeapSizeFunc mocks the GetGspHeapSize method.
+	GetGspHeapSizeFunc func() (uint64, nvml.Return)
+
 	// GetLicenseFunc mocks the GetLicense method.
 	GetLicenseFunc func() (string, nvml.Return)
 
@@ -136,6 +148,9 @@ type VgpuTypeId struct {
 		// GetDeviceID holds details about calls to the GetDeviceID method.
 		GetDeviceID []struct {
 		}
+		// GetFbReservation holds details about calls to the GetFbReservation method.
+		GetFbReservation []struct {
+		}
 		// GetFrameRateLimit holds details about calls to the GetFrameRateLimit method.
 		GetFrameRateLimit []struct {
 		}
@@ -145,6 +160,9 @@ type VgpuTypeId struct {
 		// GetGpuInstanceProfileId holds details about calls to the GetGpuInstanceProfileId method.
 		GetGpuInstanceProfileId []struct {
 		}
+		// GetGspHeapSize holds details about calls to the GetGspHeapSize method.
+		GetGspHeapSize []struct {
+		}
 		// GetLicense holds details about calls to the GetLicense method.
 		GetLicense []struct {
 		}
@@ -178,9 +196,11 @@ type VgpuTypeId struct {
 	lockGetClass                sync.RWMutex
 	lockGetCreatablePlacements  sync.RWMutex
 	lockGetDeviceID             sync.RWMutex
+	lockGetFbReservation        sync.RWMutex
 	lockGetFrameRateLimit       sync.RWMutex
 	lockGetFramebufferSize      sync.RWMutex
 	lockGetGpuInstanceProfileId sync.RWMutex
+	lockGetGspHeapSize          sync.RWMutex
 	lockGetLicense              sync.RWMutex
 	lockGetMaxInstances         sync.RWMutex
 	lockGetMaxInstancesPerVm    sync.RWMutex
@@ -335,6 +355,33 @@ func (mock *VgpuTypeId) GetDeviceIDCalls() []struct {
 	return calls
 }
 
+// GetFbReservation calls GetFbReservationFunc.
+func (mock *VgpuTypeId) GetFbReservation() (uint64, nvml.Return) {
+	if mock.GetFbReservationFunc == nil {
+		panic("VgpuTypeId.GetFbReservationFunc: method is nil but VgpuTypeId.GetFbReservation was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetFbReservation.Lock()
+	mock.calls.GetFbReservation = append(mock.calls.GetFbReservation, callInfo)
+	mock.lockGetFbReservation.Unlock()
+	return mock.GetFbReservationFunc()
+}
+
+// GetFbReservationCalls gets all the calls that were made to GetFbReservation.
+// Check the length with:
+//
+//	len(mockedVgpuTypeId.GetFbReservationCalls())
+func (mock *VgpuTypeId) GetFbReservationCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetFbReservation.RLock()
+	calls = mock.calls.GetFbReservation
+	mock.lockGetFbReservation.RUnlock()
+	return calls
+}
+
 // GetFrameRateLimit calls GetFrameRateLimitFunc.
 func (mock *VgpuTypeId) GetFrameRateLimit() (uint32, nvml.Return) {
 	if mock.GetFrameRateLimitFunc == nil {
@@ -416,6 +463,33 @@ func (mock *VgpuTypeId) GetGpuInstanceProfileIdCalls() []struct {
 	return calls
 }
 
+// GetGspHeapSize calls GetGspHeapSizeFunc.
+func (mock *VgpuTypeId) GetGspHeapSize() (uint64, nvml.Return) {
+	if mock.GetGspHeapSizeFunc == nil {
+		panic("VgpuTypeId.GetGspHeapSizeFunc: method is nil but VgpuTypeId.GetGspHeapSize was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetGspHeapSize.Lock()
+	mock.calls.GetGspHeapSize = append(mock.calls.GetGspHeapSize, callInfo)
+	mock.lockGetGspHeapSize.Unlock()
+	return mock.GetGspHeapSizeFunc()
+}
+
+// GetGspHeapSizeCalls gets all the calls that were made to GetGspHeapSize.
+// Check the length with:
+//
+//	len(mockedVgpuTypeId.GetGspHeapSizeCalls())
+func (mock *VgpuTypeId) GetGspHeapSizeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetGspHeapSize.RLock()
+	calls = mock.calls.GetGspHeapSize
+	mock.lockGetGspHeapSize.RUnlock()
+	return calls
+}
+
 // GetLicense calls GetLicenseFunc.
 func (mock *VgpuTypeId) GetLicense() (string, nvml.Return) {
 	if mock.GetLicenseFunc == nil {
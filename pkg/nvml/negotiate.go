@@ -0,0 +1,161 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Negotiated is the result of a versioned call made by the Negotiate method of
+// a handler (e.g. GpuFabricInfoHandler). The result of the call is returned
+// as the latest version of the struct known to the bindings, regardless of
+// the version that is supported by the library.
+type Negotiated[T any] struct {
+	// Value holds the fields returned by the library.
+	Value T
+	// Version is the version of the struct that was used for the call.
+	Version int
+	// Unavailable lists the fields of Value that are not part of the version
+	// of the struct that was used for the call, and are therefore not set.
+	Unavailable []string
+}
+
+// IsAvailable checks whether the specified field of Value was set by the
+// library.
+func (n Negotiated[T]) IsAvailable(field string) bool {
+	for _, f := range n.Unavailable {
+		if f == field {
+			return false
+		}
+	}
+	return true
+}
+
+// versionCache caches the struct version that was successfully used for a
+// versioned call on a given handle. Each library has its own cache, which is
+// cleared when the library is closed (e.g. by the final call to Shutdown) and
+// when a supervisor reinitializes it, since a different driver may support
+// different versions and the cached handles are no longer valid.
+type versionCache struct {
+	sync.Map
+}
+
+// clear clears the cached struct versions.
+func (c *versionCache) clear() {
+	c.Range(func(key, _ interface{}) bool {
+		c.Delete(key)
+		return true
+	})
+}
+
+// boundVersionCache returns the cache of the library that the calls made by
+// the handle types go to (see boundSymbols). If no library is loaded, an empty
+// cache is returned.
+func boundVersionCache() *versionCache {
+	if l := loadedLibraries.first(); l != nil {
+		return &l.negotiatedVersions
+	}
+	return &versionCache{}
+}
+
+// negotiationKey identifies a versioned call on a given handle.
+type negotiationKey struct {
+	call   string
+	handle interface{}
+}
+
+// versionedCall is a call using a specific version of a struct, the result of
+// which is converted to the latest version T.
+type versionedCall[T any] struct {
+	version int
+	call    func() (Negotiated[T], Return)
+}
+
+// withVersion returns a versionedCall for a call that returns the specified
+// version V of the struct T.
+func withVersion[T any, V any](version int, call func() (V, Return)) versionedCall[T] {
+	return versionedCall[T]{
+		version: version,
+		call: func() (Negotiated[T], Return) {
+			value, ret := call()
+			latest, unavailable := upgradeStruct[T](value)
+			return Negotiated[T]{Value: latest, Version: version, Unavailable: unavailable}, ret
+		},
+	}
+}
+
+// negotiate makes the first of the specified calls, ordered from the newest
+// to the oldest version, that does not return ERROR_ARGUMENT_VERSION_MISMATCH.
+// The version for which the call succeeds is cached for the key so that
+// subsequent calls are made using that version directly.
+func negotiate[T any](key negotiationKey, calls ...versionedCall[T]) (Negotiated[T], Return) {
+	negotiatedVersions := boundVersionCache()
+	if cached, ok := negotiatedVersions.Load(key); ok {
+		for _, c := range calls {
+			if c.version != cached.(int) {
+				continue
+			}
+			result, ret := c.call()
+			if ret != ERROR_ARGUMENT_VERSION_MISMATCH {
+				return result, ret
+			}
+			negotiatedVersions.Delete(key)
+			break
+		}
+	}
+
+	var result Negotiated[T]
+	ret := ERROR_ARGUMENT_VERSION_MISMATCH
+	for _, c := range calls {
+		result, ret = c.call()
+		if ret == ERROR_ARGUMENT_VERSION_MISMATCH {
+			continue
+		}
+		if ret == SUCCESS {
+			negotiatedVersions.Store(key, c.version)
+		}
+		break
+	}
+	return result, ret
+}
+
+// upgradeStruct copies the fields of value to the struct T, which is a later
+// version of the same struct. The names of the fields of T that are not part
+// of value (or that have a different type) are returned, except for the
+// Version and padding fields.
+func upgradeStruct[T any](value interface{}) (T, []string) {
+	var latest T
+	dst := reflect.ValueOf(&latest).Elem()
+	src := reflect.ValueOf(value)
+
+	var unavailable []string
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		from := src.FieldByName(field.Name)
+		if from.IsValid() && from.Type() == field.Type {
+			dst.Field(i).Set(from)
+			continue
+		}
+		if field.Name == "Version" || strings.HasPrefix(field.Name, "Pad_cgo_") {
+			continue
+		}
+		unavailable = append(unavailable, field.Name)
+	}
+	return latest, unavailable
+}
@@ -0,0 +1,171 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// negotiationTestLibrary simulates a library that supports a single version
+// of GpuFabricInfo.
+type negotiationTestLibrary struct {
+	supported int
+	calls     []int
+}
+
+func (l *negotiationTestLibrary) calls3() []versionedCall[GpuFabricInfo_v3] {
+	call := func(version int, info GpuFabricInfo_v3) versionedCall[GpuFabricInfo_v3] {
+		return withVersion[GpuFabricInfo_v3](version, func() (GpuFabricInfo_v3, Return) {
+			l.calls = append(l.calls, version)
+			if version != l.supported {
+				return GpuFabricInfo_v3{}, ERROR_ARGUMENT_VERSION_MISMATCH
+			}
+			return info, SUCCESS
+		})
+	}
+	return []versionedCall[GpuFabricInfo_v3]{
+		call(3, GpuFabricInfo_v3{CliqueId: 3, HealthSummary: 1}),
+		call(2, GpuFabricInfo_v3{CliqueId: 2}),
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	defer setLoadedLibrariesForTest()()
+
+	loaded := newTestLibrary(&dynamicLibraryMock{
+		OpenFunc: func() error {
+			return nil
+		},
+		LookupFunc: func(s string) error {
+			return nil
+		},
+		CloseFunc: func() error {
+			return nil
+		},
+	})
+	require.NoError(t, loaded.load())
+	defer loaded.close()
+
+	lib := &negotiationTestLibrary{supported: 2}
+	key := negotiationKey{"DeviceGetGpuFabricInfoV", nvmlDevice{}}
+
+	info, ret := negotiate(key, lib.calls3()...)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 2, info.Version)
+	require.Equal(t, uint32(2), info.Value.CliqueId)
+	require.Equal(t, []int{3, 2}, lib.calls)
+
+	// The negotiated version is used directly.
+	lib.calls = nil
+	_, ret = negotiate(key, lib.calls3()...)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []int{2}, lib.calls)
+
+	// The version is negotiated again if the cached version is no longer
+	// supported.
+	lib.calls, lib.supported = nil, 3
+	info, ret = negotiate(key, lib.calls3()...)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 3, info.Version)
+	require.Equal(t, []int{2, 3}, lib.calls)
+
+	// The cache is cleared when the library is closed.
+	require.Equal(t, 1, negotiatedVersionCount(loaded))
+	require.NoError(t, loaded.close())
+	require.Equal(t, 0, negotiatedVersionCount(loaded))
+	require.NoError(t, loaded.load())
+	lib.calls = nil
+	_, ret = negotiate(key, lib.calls3()...)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []int{3}, lib.calls)
+
+	// The mismatch is returned, and nothing is cached, if no version is
+	// supported.
+	loaded.negotiatedVersions.clear()
+	lib.calls, lib.supported = nil, 1
+	_, ret = negotiate(key, lib.calls3()...)
+	require.Equal(t, ERROR_ARGUMENT_VERSION_MISMATCH, ret)
+	require.Equal(t, []int{3, 2}, lib.calls)
+	_, ok := loaded.negotiatedVersions.Load(key)
+	require.False(t, ok)
+}
+
+func TestNegotiatedVersionsPerLibrary(t *testing.T) {
+	defer setLoadedLibrariesForTest()()
+
+	newLoadedLibrary := func() *library {
+		l := newTestLibrary(&dynamicLibraryMock{
+			OpenFunc: func() error {
+				return nil
+			},
+			LookupFunc: func(s string) error {
+				return nil
+			},
+			CloseFunc: func() error {
+				return nil
+			},
+		})
+		require.NoError(t, l.load())
+		return l
+	}
+	l1 := newLoadedLibrary()
+	defer l1.close()
+	l2 := newLoadedLibrary()
+
+	// The versions are cached by the library that the calls are made through.
+	lib := &negotiationTestLibrary{supported: 2}
+	_, ret := negotiate(negotiationKey{"DeviceGetGpuFabricInfoV", nvmlDevice{}}, lib.calls3()...)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 1, negotiatedVersionCount(l1))
+	require.Equal(t, 0, negotiatedVersionCount(l2))
+
+	// Closing another library does not clear the cache.
+	require.NoError(t, l2.close())
+	require.Equal(t, 1, negotiatedVersionCount(l1))
+}
+
+// negotiatedVersionCount returns the number of struct versions cached by the
+// library underlying the specified Interface.
+func negotiatedVersionCount(l Interface) int {
+	if s, ok := l.(*supervisor); ok {
+		l = s.lib
+	}
+	var count int
+	l.(*library).negotiatedVersions.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func TestUpgradeStruct(t *testing.T) {
+	v1 := GpuInstanceProfileInfo{Id: 1, IsP2pSupported: 1, SliceCount: 7}
+	v3, unavailable := upgradeStruct[GpuInstanceProfileInfo_v3](v1)
+	require.Equal(t, GpuInstanceProfileInfo_v3{Id: 1, SliceCount: 7}, v3)
+	require.Equal(t, []string{"Name", "Capabilities"}, unavailable)
+
+	negotiated := Negotiated[GpuInstanceProfileInfo_v3]{Value: v3, Version: 1, Unavailable: unavailable}
+	require.True(t, negotiated.IsAvailable("SliceCount"))
+	require.False(t, negotiated.IsAvailable("Name"))
+
+	v2 := GpuFabricInfo_v2{Version: STRUCT_VERSION(GpuFabricInfo_v2{}, 2), CliqueId: 4, HealthMask: 8}
+	fabricInfo, unavailable := upgradeStruct[GpuFabricInfo_v3](v2)
+	require.Equal(t, GpuFabricInfo_v3{Version: v2.Version, CliqueId: 4, HealthMask: 8}, fabricInfo)
+	require.Equal(t, []string{"HealthSummary"}, unavailable)
+}
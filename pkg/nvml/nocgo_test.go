@@ -0,0 +1,38 @@
+//go:build !cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoCgo(t *testing.T) {
+	require.Equal(t, ERROR_LIBRARY_NOT_FOUND, Init())
+
+	lib := New()
+	require.Equal(t, ERROR_LIBRARY_NOT_FOUND, lib.Init())
+
+	_, ret := lib.DeviceGetCount()
+	require.Equal(t, ERROR_LIBRARY_NOT_FOUND, ret)
+
+	_, ret = lib.SystemGetDriverVersion()
+	require.Equal(t, ERROR_LIBRARY_NOT_FOUND, ret)
+}
@@ -28,38 +28,38 @@ package nvml
 import "C"
 import "unsafe"
 
-// nvmlInit_v2 function as declared in nvml/nvml.h
-func nvmlInit_v2() Return {
+// cgo_nvmlInit_v2 function as declared in nvml/nvml.h
+func cgo_nvmlInit_v2() Return {
 	__ret := C.nvmlInit_v2()
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlInitWithFlags function as declared in nvml/nvml.h
-func nvmlInitWithFlags(Flags uint32) Return {
+// cgo_nvmlInitWithFlags function as declared in nvml/nvml.h
+func cgo_nvmlInitWithFlags(Flags uint32) Return {
 	cFlags, _ := (C.uint)(Flags), cgoAllocsUnknown
 	__ret := C.nvmlInitWithFlags(cFlags)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlShutdown function as declared in nvml/nvml.h
-func nvmlShutdown() Return {
+// cgo_nvmlShutdown function as declared in nvml/nvml.h
+func cgo_nvmlShutdown() Return {
 	__ret := C.nvmlShutdown()
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlErrorString function as declared in nvml/nvml.h
-func nvmlErrorString(Result Return) string {
+// cgo_nvmlErrorString function as declared in nvml/nvml.h
+func cgo_nvmlErrorString(Result Return) string {
 	cResult, _ := (C.nvmlReturn_t)(Result), cgoAllocsUnknown
 	__ret := C.nvmlErrorString(cResult)
 	__v := packPCharString(__ret)
 	return __v
 }
 
-// nvmlSystemGetDriverVersion function as declared in nvml/nvml.h
-func nvmlSystemGetDriverVersion(Version *byte, Length uint32) Return {
+// cgo_nvmlSystemGetDriverVersion function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetDriverVersion(Version *byte, Length uint32) Return {
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetDriverVersion(cVersion, cLength)
@@ -67,8 +67,8 @@ func nvmlSystemGetDriverVersion(Version *byte, Length uint32) Return {
 	return __v
 }
 
-// nvmlSystemGetNVMLVersion function as declared in nvml/nvml.h
-func nvmlSystemGetNVMLVersion(Version *byte, Length uint32) Return {
+// cgo_nvmlSystemGetNVMLVersion function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetNVMLVersion(Version *byte, Length uint32) Return {
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetNVMLVersion(cVersion, cLength)
@@ -76,24 +76,24 @@ func nvmlSystemGetNVMLVersion(Version *byte, Length uint32) Return {
 	return __v
 }
 
-// nvmlSystemGetCudaDriverVersion function as declared in nvml/nvml.h
-func nvmlSystemGetCudaDriverVersion(CudaDriverVersion *int32) Return {
+// cgo_nvmlSystemGetCudaDriverVersion function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetCudaDriverVersion(CudaDriverVersion *int32) Return {
 	cCudaDriverVersion, _ := (*C.int)(unsafe.Pointer(CudaDriverVersion)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetCudaDriverVersion(cCudaDriverVersion)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemGetCudaDriverVersion_v2 function as declared in nvml/nvml.h
-func nvmlSystemGetCudaDriverVersion_v2(CudaDriverVersion *int32) Return {
+// cgo_nvmlSystemGetCudaDriverVersion_v2 function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetCudaDriverVersion_v2(CudaDriverVersion *int32) Return {
 	cCudaDriverVersion, _ := (*C.int)(unsafe.Pointer(CudaDriverVersion)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetCudaDriverVersion_v2(cCudaDriverVersion)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemGetProcessName function as declared in nvml/nvml.h
-func nvmlSystemGetProcessName(Pid uint32, Name *byte, Length uint32) Return {
+// cgo_nvmlSystemGetProcessName function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetProcessName(Pid uint32, Name *byte, Length uint32) Return {
 	cPid, _ := (C.uint)(Pid), cgoAllocsUnknown
 	cName, _ := (*C.char)(unsafe.Pointer(Name)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -102,8 +102,8 @@ func nvmlSystemGetProcessName(Pid uint32, Name *byte, Length uint32) Return {
 	return __v
 }
 
-// nvmlSystemGetHicVersion function as declared in nvml/nvml.h
-func nvmlSystemGetHicVersion(HwbcCount *uint32, HwbcEntries *HwbcEntry) Return {
+// cgo_nvmlSystemGetHicVersion function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetHicVersion(HwbcCount *uint32, HwbcEntries *HwbcEntry) Return {
 	cHwbcCount, _ := (*C.uint)(unsafe.Pointer(HwbcCount)), cgoAllocsUnknown
 	cHwbcEntries, _ := (*C.nvmlHwbcEntry_t)(unsafe.Pointer(HwbcEntries)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetHicVersion(cHwbcCount, cHwbcEntries)
@@ -111,8 +111,8 @@ func nvmlSystemGetHicVersion(HwbcCount *uint32, HwbcEntries *HwbcEntry) Return {
 	return __v
 }
 
-// nvmlSystemGetTopologyGpuSet function as declared in nvml/nvml.h
-func nvmlSystemGetTopologyGpuSet(CpuNumber uint32, Count *uint32, DeviceArray *nvmlDevice) Return {
+// cgo_nvmlSystemGetTopologyGpuSet function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetTopologyGpuSet(CpuNumber uint32, Count *uint32, DeviceArray *nvmlDevice) Return {
 	cCpuNumber, _ := (C.uint)(CpuNumber), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
 	cDeviceArray, _ := (*C.nvmlDevice_t)(unsafe.Pointer(DeviceArray)), cgoAllocsUnknown
@@ -121,8 +121,8 @@ func nvmlSystemGetTopologyGpuSet(CpuNumber uint32, Count *uint32, DeviceArray *n
 	return __v
 }
 
-// nvmlSystemGetDriverBranch function as declared in nvml/nvml.h
-func nvmlSystemGetDriverBranch(BranchInfo *SystemDriverBranchInfo, Length uint32) Return {
+// cgo_nvmlSystemGetDriverBranch function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetDriverBranch(BranchInfo *SystemDriverBranchInfo, Length uint32) Return {
 	cBranchInfo, _ := (*C.nvmlSystemDriverBranchInfo_t)(unsafe.Pointer(BranchInfo)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetDriverBranch(cBranchInfo, cLength)
@@ -130,16 +130,16 @@ func nvmlSystemGetDriverBranch(BranchInfo *SystemDriverBranchInfo, Length uint32
 	return __v
 }
 
-// nvmlUnitGetCount function as declared in nvml/nvml.h
-func nvmlUnitGetCount(UnitCount *uint32) Return {
+// cgo_nvmlUnitGetCount function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetCount(UnitCount *uint32) Return {
 	cUnitCount, _ := (*C.uint)(unsafe.Pointer(UnitCount)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetCount(cUnitCount)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlUnitGetHandleByIndex function as declared in nvml/nvml.h
-func nvmlUnitGetHandleByIndex(Index uint32, nvmlUnit *nvmlUnit) Return {
+// cgo_nvmlUnitGetHandleByIndex function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetHandleByIndex(Index uint32, nvmlUnit *nvmlUnit) Return {
 	cIndex, _ := (C.uint)(Index), cgoAllocsUnknown
 	cnvmlUnit, _ := (*C.nvmlUnit_t)(unsafe.Pointer(nvmlUnit)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetHandleByIndex(cIndex, cnvmlUnit)
@@ -147,8 +147,8 @@ func nvmlUnitGetHandleByIndex(Index uint32, nvmlUnit *nvmlUnit) Return {
 	return __v
 }
 
-// nvmlUnitGetUnitInfo function as declared in nvml/nvml.h
-func nvmlUnitGetUnitInfo(nvmlUnit nvmlUnit, Info *UnitInfo) Return {
+// cgo_nvmlUnitGetUnitInfo function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetUnitInfo(nvmlUnit nvmlUnit, Info *UnitInfo) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlUnitInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetUnitInfo(cnvmlUnit, cInfo)
@@ -156,8 +156,8 @@ func nvmlUnitGetUnitInfo(nvmlUnit nvmlUnit, Info *UnitInfo) Return {
 	return __v
 }
 
-// nvmlUnitGetLedState function as declared in nvml/nvml.h
-func nvmlUnitGetLedState(nvmlUnit nvmlUnit, State *LedState) Return {
+// cgo_nvmlUnitGetLedState function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetLedState(nvmlUnit nvmlUnit, State *LedState) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cState, _ := (*C.nvmlLedState_t)(unsafe.Pointer(State)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetLedState(cnvmlUnit, cState)
@@ -165,8 +165,8 @@ func nvmlUnitGetLedState(nvmlUnit nvmlUnit, State *LedState) Return {
 	return __v
 }
 
-// nvmlUnitGetPsuInfo function as declared in nvml/nvml.h
-func nvmlUnitGetPsuInfo(nvmlUnit nvmlUnit, Psu *PSUInfo) Return {
+// cgo_nvmlUnitGetPsuInfo function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetPsuInfo(nvmlUnit nvmlUnit, Psu *PSUInfo) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cPsu, _ := (*C.nvmlPSUInfo_t)(unsafe.Pointer(Psu)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetPsuInfo(cnvmlUnit, cPsu)
@@ -174,8 +174,8 @@ func nvmlUnitGetPsuInfo(nvmlUnit nvmlUnit, Psu *PSUInfo) Return {
 	return __v
 }
 
-// nvmlUnitGetTemperature function as declared in nvml/nvml.h
-func nvmlUnitGetTemperature(nvmlUnit nvmlUnit, _type uint32, Temp *uint32) Return {
+// cgo_nvmlUnitGetTemperature function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetTemperature(nvmlUnit nvmlUnit, _type uint32, Temp *uint32) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	c_type, _ := (C.uint)(_type), cgoAllocsUnknown
 	cTemp, _ := (*C.uint)(unsafe.Pointer(Temp)), cgoAllocsUnknown
@@ -184,8 +184,8 @@ func nvmlUnitGetTemperature(nvmlUnit nvmlUnit, _type uint32, Temp *uint32) Retur
 	return __v
 }
 
-// nvmlUnitGetFanSpeedInfo function as declared in nvml/nvml.h
-func nvmlUnitGetFanSpeedInfo(nvmlUnit nvmlUnit, FanSpeeds *UnitFanSpeeds) Return {
+// cgo_nvmlUnitGetFanSpeedInfo function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetFanSpeedInfo(nvmlUnit nvmlUnit, FanSpeeds *UnitFanSpeeds) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cFanSpeeds, _ := (*C.nvmlUnitFanSpeeds_t)(unsafe.Pointer(FanSpeeds)), cgoAllocsUnknown
 	__ret := C.nvmlUnitGetFanSpeedInfo(cnvmlUnit, cFanSpeeds)
@@ -193,8 +193,8 @@ func nvmlUnitGetFanSpeedInfo(nvmlUnit nvmlUnit, FanSpeeds *UnitFanSpeeds) Return
 	return __v
 }
 
-// nvmlUnitGetDevices function as declared in nvml/nvml.h
-func nvmlUnitGetDevices(nvmlUnit nvmlUnit, DeviceCount *uint32, Devices *nvmlDevice) Return {
+// cgo_nvmlUnitGetDevices function as declared in nvml/nvml.h
+func cgo_nvmlUnitGetDevices(nvmlUnit nvmlUnit, DeviceCount *uint32, Devices *nvmlDevice) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cDeviceCount, _ := (*C.uint)(unsafe.Pointer(DeviceCount)), cgoAllocsUnknown
 	cDevices, _ := (*C.nvmlDevice_t)(unsafe.Pointer(Devices)), cgoAllocsUnknown
@@ -203,16 +203,16 @@ func nvmlUnitGetDevices(nvmlUnit nvmlUnit, DeviceCount *uint32, Devices *nvmlDev
 	return __v
 }
 
-// nvmlDeviceGetCount_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetCount_v2(DeviceCount *uint32) Return {
+// cgo_nvmlDeviceGetCount_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCount_v2(DeviceCount *uint32) Return {
 	cDeviceCount, _ := (*C.uint)(unsafe.Pointer(DeviceCount)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCount_v2(cDeviceCount)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetAttributes_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetAttributes_v2(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+// cgo_nvmlDeviceGetAttributes_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAttributes_v2(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cAttributes, _ := (*C.nvmlDeviceAttributes_t)(unsafe.Pointer(Attributes)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAttributes_v2(cnvmlDevice, cAttributes)
@@ -220,8 +220,8 @@ func nvmlDeviceGetAttributes_v2(nvmlDevice nvmlDevice, Attributes *DeviceAttribu
 	return __v
 }
 
-// nvmlDeviceGetHandleByIndex_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByIndex_v2(Index uint32, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByIndex_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByIndex_v2(Index uint32, nvmlDevice *nvmlDevice) Return {
 	cIndex, _ := (C.uint)(Index), cgoAllocsUnknown
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByIndex_v2(cIndex, cnvmlDevice)
@@ -229,8 +229,8 @@ func nvmlDeviceGetHandleByIndex_v2(Index uint32, nvmlDevice *nvmlDevice) Return
 	return __v
 }
 
-// nvmlDeviceGetHandleBySerial function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleBySerial(Serial string, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleBySerial function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleBySerial(Serial string, nvmlDevice *nvmlDevice) Return {
 	cSerial, _ := unpackPCharString(Serial)
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleBySerial(cSerial, cnvmlDevice)
@@ -238,8 +238,8 @@ func nvmlDeviceGetHandleBySerial(Serial string, nvmlDevice *nvmlDevice) Return {
 	return __v
 }
 
-// nvmlDeviceGetHandleByUUID function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByUUID(Uuid string, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByUUID function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByUUID(Uuid string, nvmlDevice *nvmlDevice) Return {
 	cUuid, _ := unpackPCharString(Uuid)
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByUUID(cUuid, cnvmlDevice)
@@ -247,8 +247,8 @@ func nvmlDeviceGetHandleByUUID(Uuid string, nvmlDevice *nvmlDevice) Return {
 	return __v
 }
 
-// nvmlDeviceGetHandleByUUIDV function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByUUIDV(Uuid *UUID, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByUUIDV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByUUIDV(Uuid *UUID, nvmlDevice *nvmlDevice) Return {
 	cUuid, _ := (*C.nvmlUUID_t)(unsafe.Pointer(Uuid)), cgoAllocsUnknown
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByUUIDV(cUuid, cnvmlDevice)
@@ -256,8 +256,8 @@ func nvmlDeviceGetHandleByUUIDV(Uuid *UUID, nvmlDevice *nvmlDevice) Return {
 	return __v
 }
 
-// nvmlDeviceGetHandleByPciBusId_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByPciBusId_v2(PciBusId string, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByPciBusId_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByPciBusId_v2(PciBusId string, nvmlDevice *nvmlDevice) Return {
 	cPciBusId, _ := unpackPCharString(PciBusId)
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByPciBusId_v2(cPciBusId, cnvmlDevice)
@@ -265,8 +265,8 @@ func nvmlDeviceGetHandleByPciBusId_v2(PciBusId string, nvmlDevice *nvmlDevice) R
 	return __v
 }
 
-// nvmlDeviceGetName function as declared in nvml/nvml.h
-func nvmlDeviceGetName(nvmlDevice nvmlDevice, Name *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetName function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetName(nvmlDevice nvmlDevice, Name *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cName, _ := (*C.char)(unsafe.Pointer(Name)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -275,8 +275,8 @@ func nvmlDeviceGetName(nvmlDevice nvmlDevice, Name *byte, Length uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetBrand function as declared in nvml/nvml.h
-func nvmlDeviceGetBrand(nvmlDevice nvmlDevice, _type *BrandType) Return {
+// cgo_nvmlDeviceGetBrand function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBrand(nvmlDevice nvmlDevice, _type *BrandType) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (*C.nvmlBrandType_t)(unsafe.Pointer(_type)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetBrand(cnvmlDevice, c_type)
@@ -284,8 +284,8 @@ func nvmlDeviceGetBrand(nvmlDevice nvmlDevice, _type *BrandType) Return {
 	return __v
 }
 
-// nvmlDeviceGetIndex function as declared in nvml/nvml.h
-func nvmlDeviceGetIndex(nvmlDevice nvmlDevice, Index *uint32) Return {
+// cgo_nvmlDeviceGetIndex function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetIndex(nvmlDevice nvmlDevice, Index *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIndex, _ := (*C.uint)(unsafe.Pointer(Index)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetIndex(cnvmlDevice, cIndex)
@@ -293,8 +293,8 @@ func nvmlDeviceGetIndex(nvmlDevice nvmlDevice, Index *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetSerial function as declared in nvml/nvml.h
-func nvmlDeviceGetSerial(nvmlDevice nvmlDevice, Serial *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetSerial function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSerial(nvmlDevice nvmlDevice, Serial *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSerial, _ := (*C.char)(unsafe.Pointer(Serial)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -303,8 +303,8 @@ func nvmlDeviceGetSerial(nvmlDevice nvmlDevice, Serial *byte, Length uint32) Ret
 	return __v
 }
 
-// nvmlDeviceGetModuleId function as declared in nvml/nvml.h
-func nvmlDeviceGetModuleId(nvmlDevice nvmlDevice, ModuleId *uint32) Return {
+// cgo_nvmlDeviceGetModuleId function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetModuleId(nvmlDevice nvmlDevice, ModuleId *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cModuleId, _ := (*C.uint)(unsafe.Pointer(ModuleId)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetModuleId(cnvmlDevice, cModuleId)
@@ -312,8 +312,8 @@ func nvmlDeviceGetModuleId(nvmlDevice nvmlDevice, ModuleId *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetC2cModeInfoV function as declared in nvml/nvml.h
-func nvmlDeviceGetC2cModeInfoV(nvmlDevice nvmlDevice, C2cModeInfo *C2cModeInfo_v1) Return {
+// cgo_nvmlDeviceGetC2cModeInfoV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetC2cModeInfoV(nvmlDevice nvmlDevice, C2cModeInfo *C2cModeInfo_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cC2cModeInfo, _ := (*C.nvmlC2cModeInfo_v1_t)(unsafe.Pointer(C2cModeInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetC2cModeInfoV(cnvmlDevice, cC2cModeInfo)
@@ -321,8 +321,8 @@ func nvmlDeviceGetC2cModeInfoV(nvmlDevice nvmlDevice, C2cModeInfo *C2cModeInfo_v
 	return __v
 }
 
-// nvmlDeviceGetMemoryAffinity function as declared in nvml/nvml.h
-func nvmlDeviceGetMemoryAffinity(nvmlDevice nvmlDevice, NodeSetSize uint32, NodeSet *uint, Scope AffinityScope) Return {
+// cgo_nvmlDeviceGetMemoryAffinity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemoryAffinity(nvmlDevice nvmlDevice, NodeSetSize uint32, NodeSet *uint, Scope AffinityScope) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cNodeSetSize, _ := (C.uint)(NodeSetSize), cgoAllocsUnknown
 	cNodeSet, _ := (*C.ulong)(unsafe.Pointer(NodeSet)), cgoAllocsUnknown
@@ -332,8 +332,8 @@ func nvmlDeviceGetMemoryAffinity(nvmlDevice nvmlDevice, NodeSetSize uint32, Node
 	return __v
 }
 
-// nvmlDeviceGetCpuAffinityWithinScope function as declared in nvml/nvml.h
-func nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint, Scope AffinityScope) Return {
+// cgo_nvmlDeviceGetCpuAffinityWithinScope function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint, Scope AffinityScope) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCpuSetSize, _ := (C.uint)(CpuSetSize), cgoAllocsUnknown
 	cCpuSet, _ := (*C.ulong)(unsafe.Pointer(CpuSet)), cgoAllocsUnknown
@@ -343,8 +343,8 @@ func nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice nvmlDevice, CpuSetSize uint3
 	return __v
 }
 
-// nvmlDeviceGetCpuAffinity function as declared in nvml/nvml.h
-func nvmlDeviceGetCpuAffinity(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint) Return {
+// cgo_nvmlDeviceGetCpuAffinity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCpuAffinity(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCpuSetSize, _ := (C.uint)(CpuSetSize), cgoAllocsUnknown
 	cCpuSet, _ := (*C.ulong)(unsafe.Pointer(CpuSet)), cgoAllocsUnknown
@@ -353,24 +353,24 @@ func nvmlDeviceGetCpuAffinity(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *
 	return __v
 }
 
-// nvmlDeviceSetCpuAffinity function as declared in nvml/nvml.h
-func nvmlDeviceSetCpuAffinity(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceSetCpuAffinity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetCpuAffinity(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetCpuAffinity(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceClearCpuAffinity function as declared in nvml/nvml.h
-func nvmlDeviceClearCpuAffinity(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceClearCpuAffinity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceClearCpuAffinity(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceClearCpuAffinity(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetNumaNodeId function as declared in nvml/nvml.h
-func nvmlDeviceGetNumaNodeId(nvmlDevice nvmlDevice, Node *uint32) Return {
+// cgo_nvmlDeviceGetNumaNodeId function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNumaNodeId(nvmlDevice nvmlDevice, Node *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cNode, _ := (*C.uint)(unsafe.Pointer(Node)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNumaNodeId(cnvmlDevice, cNode)
@@ -378,8 +378,8 @@ func nvmlDeviceGetNumaNodeId(nvmlDevice nvmlDevice, Node *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetAddressingMode function as declared in nvml/nvml.h
-func nvmlDeviceGetAddressingMode(nvmlDevice nvmlDevice, Mode *DeviceAddressingMode) Return {
+// cgo_nvmlDeviceGetAddressingMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAddressingMode(nvmlDevice nvmlDevice, Mode *DeviceAddressingMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlDeviceAddressingMode_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAddressingMode(cnvmlDevice, cMode)
@@ -387,8 +387,8 @@ func nvmlDeviceGetAddressingMode(nvmlDevice nvmlDevice, Mode *DeviceAddressingMo
 	return __v
 }
 
-// nvmlDeviceGetRepairStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetRepairStatus(nvmlDevice nvmlDevice, RepairStatus *RepairStatus) Return {
+// cgo_nvmlDeviceGetRepairStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRepairStatus(nvmlDevice nvmlDevice, RepairStatus *RepairStatus) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cRepairStatus, _ := (*C.nvmlRepairStatus_t)(unsafe.Pointer(RepairStatus)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetRepairStatus(cnvmlDevice, cRepairStatus)
@@ -396,8 +396,8 @@ func nvmlDeviceGetRepairStatus(nvmlDevice nvmlDevice, RepairStatus *RepairStatus
 	return __v
 }
 
-// nvmlDeviceGetTopologyCommonAncestor function as declared in nvml/nvml.h
-func nvmlDeviceGetTopologyCommonAncestor(Device1 nvmlDevice, Device2 nvmlDevice, PathInfo *GpuTopologyLevel) Return {
+// cgo_nvmlDeviceGetTopologyCommonAncestor function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTopologyCommonAncestor(Device1 nvmlDevice, Device2 nvmlDevice, PathInfo *GpuTopologyLevel) Return {
 	cDevice1, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device1)), cgoAllocsUnknown
 	cDevice2, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device2)), cgoAllocsUnknown
 	cPathInfo, _ := (*C.nvmlGpuTopologyLevel_t)(unsafe.Pointer(PathInfo)), cgoAllocsUnknown
@@ -406,8 +406,8 @@ func nvmlDeviceGetTopologyCommonAncestor(Device1 nvmlDevice, Device2 nvmlDevice,
 	return __v
 }
 
-// nvmlDeviceGetTopologyNearestGpus function as declared in nvml/nvml.h
-func nvmlDeviceGetTopologyNearestGpus(nvmlDevice nvmlDevice, Level GpuTopologyLevel, Count *uint32, DeviceArray *nvmlDevice) Return {
+// cgo_nvmlDeviceGetTopologyNearestGpus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTopologyNearestGpus(nvmlDevice nvmlDevice, Level GpuTopologyLevel, Count *uint32, DeviceArray *nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLevel, _ := (C.nvmlGpuTopologyLevel_t)(Level), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
@@ -417,8 +417,8 @@ func nvmlDeviceGetTopologyNearestGpus(nvmlDevice nvmlDevice, Level GpuTopologyLe
 	return __v
 }
 
-// nvmlDeviceGetP2PStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetP2PStatus(Device1 nvmlDevice, Device2 nvmlDevice, P2pIndex GpuP2PCapsIndex, P2pStatus *GpuP2PStatus) Return {
+// cgo_nvmlDeviceGetP2PStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetP2PStatus(Device1 nvmlDevice, Device2 nvmlDevice, P2pIndex GpuP2PCapsIndex, P2pStatus *GpuP2PStatus) Return {
 	cDevice1, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device1)), cgoAllocsUnknown
 	cDevice2, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device2)), cgoAllocsUnknown
 	cP2pIndex, _ := (C.nvmlGpuP2PCapsIndex_t)(P2pIndex), cgoAllocsUnknown
@@ -428,8 +428,8 @@ func nvmlDeviceGetP2PStatus(Device1 nvmlDevice, Device2 nvmlDevice, P2pIndex Gpu
 	return __v
 }
 
-// nvmlDeviceGetUUID function as declared in nvml/nvml.h
-func nvmlDeviceGetUUID(nvmlDevice nvmlDevice, Uuid *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetUUID function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetUUID(nvmlDevice nvmlDevice, Uuid *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUuid, _ := (*C.char)(unsafe.Pointer(Uuid)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -438,8 +438,8 @@ func nvmlDeviceGetUUID(nvmlDevice nvmlDevice, Uuid *byte, Length uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetMinorNumber function as declared in nvml/nvml.h
-func nvmlDeviceGetMinorNumber(nvmlDevice nvmlDevice, MinorNumber *uint32) Return {
+// cgo_nvmlDeviceGetMinorNumber function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMinorNumber(nvmlDevice nvmlDevice, MinorNumber *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinorNumber, _ := (*C.uint)(unsafe.Pointer(MinorNumber)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMinorNumber(cnvmlDevice, cMinorNumber)
@@ -447,8 +447,8 @@ func nvmlDeviceGetMinorNumber(nvmlDevice nvmlDevice, MinorNumber *uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetBoardPartNumber function as declared in nvml/nvml.h
-func nvmlDeviceGetBoardPartNumber(nvmlDevice nvmlDevice, PartNumber *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetBoardPartNumber function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBoardPartNumber(nvmlDevice nvmlDevice, PartNumber *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPartNumber, _ := (*C.char)(unsafe.Pointer(PartNumber)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -457,8 +457,8 @@ func nvmlDeviceGetBoardPartNumber(nvmlDevice nvmlDevice, PartNumber *byte, Lengt
 	return __v
 }
 
-// nvmlDeviceGetInforomVersion function as declared in nvml/nvml.h
-func nvmlDeviceGetInforomVersion(nvmlDevice nvmlDevice, Object InforomObject, Version *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetInforomVersion function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetInforomVersion(nvmlDevice nvmlDevice, Object InforomObject, Version *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cObject, _ := (C.nvmlInforomObject_t)(Object), cgoAllocsUnknown
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
@@ -468,8 +468,8 @@ func nvmlDeviceGetInforomVersion(nvmlDevice nvmlDevice, Object InforomObject, Ve
 	return __v
 }
 
-// nvmlDeviceGetInforomImageVersion function as declared in nvml/nvml.h
-func nvmlDeviceGetInforomImageVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetInforomImageVersion function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetInforomImageVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -478,8 +478,8 @@ func nvmlDeviceGetInforomImageVersion(nvmlDevice nvmlDevice, Version *byte, Leng
 	return __v
 }
 
-// nvmlDeviceGetInforomConfigurationChecksum function as declared in nvml/nvml.h
-func nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice nvmlDevice, Checksum *uint32) Return {
+// cgo_nvmlDeviceGetInforomConfigurationChecksum function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice nvmlDevice, Checksum *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cChecksum, _ := (*C.uint)(unsafe.Pointer(Checksum)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetInforomConfigurationChecksum(cnvmlDevice, cChecksum)
@@ -487,16 +487,16 @@ func nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice nvmlDevice, Checksum *
 	return __v
 }
 
-// nvmlDeviceValidateInforom function as declared in nvml/nvml.h
-func nvmlDeviceValidateInforom(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceValidateInforom function as declared in nvml/nvml.h
+func cgo_nvmlDeviceValidateInforom(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceValidateInforom(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetLastBBXFlushTime function as declared in nvml/nvml.h
-func nvmlDeviceGetLastBBXFlushTime(nvmlDevice nvmlDevice, Timestamp *uint64, DurationUs *uint) Return {
+// cgo_nvmlDeviceGetLastBBXFlushTime function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetLastBBXFlushTime(nvmlDevice nvmlDevice, Timestamp *uint64, DurationUs *uint) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cTimestamp, _ := (*C.ulonglong)(unsafe.Pointer(Timestamp)), cgoAllocsUnknown
 	cDurationUs, _ := (*C.ulong)(unsafe.Pointer(DurationUs)), cgoAllocsUnknown
@@ -505,8 +505,8 @@ func nvmlDeviceGetLastBBXFlushTime(nvmlDevice nvmlDevice, Timestamp *uint64, Dur
 	return __v
 }
 
-// nvmlDeviceGetDisplayMode function as declared in nvml/nvml.h
-func nvmlDeviceGetDisplayMode(nvmlDevice nvmlDevice, Display *EnableState) Return {
+// cgo_nvmlDeviceGetDisplayMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDisplayMode(nvmlDevice nvmlDevice, Display *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cDisplay, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Display)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetDisplayMode(cnvmlDevice, cDisplay)
@@ -514,8 +514,8 @@ func nvmlDeviceGetDisplayMode(nvmlDevice nvmlDevice, Display *EnableState) Retur
 	return __v
 }
 
-// nvmlDeviceGetDisplayActive function as declared in nvml/nvml.h
-func nvmlDeviceGetDisplayActive(nvmlDevice nvmlDevice, IsActive *EnableState) Return {
+// cgo_nvmlDeviceGetDisplayActive function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDisplayActive(nvmlDevice nvmlDevice, IsActive *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIsActive, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(IsActive)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetDisplayActive(cnvmlDevice, cIsActive)
@@ -523,8 +523,8 @@ func nvmlDeviceGetDisplayActive(nvmlDevice nvmlDevice, IsActive *EnableState) Re
 	return __v
 }
 
-// nvmlDeviceGetPersistenceMode function as declared in nvml/nvml.h
-func nvmlDeviceGetPersistenceMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+// cgo_nvmlDeviceGetPersistenceMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPersistenceMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPersistenceMode(cnvmlDevice, cMode)
@@ -532,8 +532,8 @@ func nvmlDeviceGetPersistenceMode(nvmlDevice nvmlDevice, Mode *EnableState) Retu
 	return __v
 }
 
-// nvmlDeviceGetPciInfoExt function as declared in nvml/nvml.h
-func nvmlDeviceGetPciInfoExt(nvmlDevice nvmlDevice, Pci *PciInfoExt) Return {
+// cgo_nvmlDeviceGetPciInfoExt function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPciInfoExt(nvmlDevice nvmlDevice, Pci *PciInfoExt) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfoExt_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPciInfoExt(cnvmlDevice, cPci)
@@ -541,8 +541,8 @@ func nvmlDeviceGetPciInfoExt(nvmlDevice nvmlDevice, Pci *PciInfoExt) Return {
 	return __v
 }
 
-// nvmlDeviceGetPciInfo_v3 function as declared in nvml/nvml.h
-func nvmlDeviceGetPciInfo_v3(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+// cgo_nvmlDeviceGetPciInfo_v3 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPciInfo_v3(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPciInfo_v3(cnvmlDevice, cPci)
@@ -550,8 +550,8 @@ func nvmlDeviceGetPciInfo_v3(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	return __v
 }
 
-// nvmlDeviceGetMaxPcieLinkGeneration function as declared in nvml/nvml.h
-func nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGen *uint32) Return {
+// cgo_nvmlDeviceGetMaxPcieLinkGeneration function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGen *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMaxLinkGen, _ := (*C.uint)(unsafe.Pointer(MaxLinkGen)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMaxPcieLinkGeneration(cnvmlDevice, cMaxLinkGen)
@@ -559,8 +559,8 @@ func nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGen *uint3
 	return __v
 }
 
-// nvmlDeviceGetGpuMaxPcieLinkGeneration function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGenDevice *uint32) Return {
+// cgo_nvmlDeviceGetGpuMaxPcieLinkGeneration function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGenDevice *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMaxLinkGenDevice, _ := (*C.uint)(unsafe.Pointer(MaxLinkGenDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGpuMaxPcieLinkGeneration(cnvmlDevice, cMaxLinkGenDevice)
@@ -568,8 +568,8 @@ func nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGenDevi
 	return __v
 }
 
-// nvmlDeviceGetMaxPcieLinkWidth function as declared in nvml/nvml.h
-func nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice nvmlDevice, MaxLinkWidth *uint32) Return {
+// cgo_nvmlDeviceGetMaxPcieLinkWidth function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice nvmlDevice, MaxLinkWidth *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMaxLinkWidth, _ := (*C.uint)(unsafe.Pointer(MaxLinkWidth)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMaxPcieLinkWidth(cnvmlDevice, cMaxLinkWidth)
@@ -577,8 +577,8 @@ func nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice nvmlDevice, MaxLinkWidth *uint32)
 	return __v
 }
 
-// nvmlDeviceGetCurrPcieLinkGeneration function as declared in nvml/nvml.h
-func nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice nvmlDevice, CurrLinkGen *uint32) Return {
+// cgo_nvmlDeviceGetCurrPcieLinkGeneration function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice nvmlDevice, CurrLinkGen *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrLinkGen, _ := (*C.uint)(unsafe.Pointer(CurrLinkGen)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCurrPcieLinkGeneration(cnvmlDevice, cCurrLinkGen)
@@ -586,8 +586,8 @@ func nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice nvmlDevice, CurrLinkGen *uin
 	return __v
 }
 
-// nvmlDeviceGetCurrPcieLinkWidth function as declared in nvml/nvml.h
-func nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice nvmlDevice, CurrLinkWidth *uint32) Return {
+// cgo_nvmlDeviceGetCurrPcieLinkWidth function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice nvmlDevice, CurrLinkWidth *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrLinkWidth, _ := (*C.uint)(unsafe.Pointer(CurrLinkWidth)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCurrPcieLinkWidth(cnvmlDevice, cCurrLinkWidth)
@@ -595,8 +595,8 @@ func nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice nvmlDevice, CurrLinkWidth *uint32
 	return __v
 }
 
-// nvmlDeviceGetPcieThroughput function as declared in nvml/nvml.h
-func nvmlDeviceGetPcieThroughput(nvmlDevice nvmlDevice, Counter PcieUtilCounter, Value *uint32) Return {
+// cgo_nvmlDeviceGetPcieThroughput function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPcieThroughput(nvmlDevice nvmlDevice, Counter PcieUtilCounter, Value *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCounter, _ := (C.nvmlPcieUtilCounter_t)(Counter), cgoAllocsUnknown
 	cValue, _ := (*C.uint)(unsafe.Pointer(Value)), cgoAllocsUnknown
@@ -605,8 +605,8 @@ func nvmlDeviceGetPcieThroughput(nvmlDevice nvmlDevice, Counter PcieUtilCounter,
 	return __v
 }
 
-// nvmlDeviceGetPcieReplayCounter function as declared in nvml/nvml.h
-func nvmlDeviceGetPcieReplayCounter(nvmlDevice nvmlDevice, Value *uint32) Return {
+// cgo_nvmlDeviceGetPcieReplayCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPcieReplayCounter(nvmlDevice nvmlDevice, Value *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cValue, _ := (*C.uint)(unsafe.Pointer(Value)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPcieReplayCounter(cnvmlDevice, cValue)
@@ -614,8 +614,8 @@ func nvmlDeviceGetPcieReplayCounter(nvmlDevice nvmlDevice, Value *uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetClockInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+// cgo_nvmlDeviceGetClockInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (C.nvmlClockType_t)(_type), cgoAllocsUnknown
 	cClock, _ := (*C.uint)(unsafe.Pointer(Clock)), cgoAllocsUnknown
@@ -624,8 +624,8 @@ func nvmlDeviceGetClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint3
 	return __v
 }
 
-// nvmlDeviceGetMaxClockInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetMaxClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+// cgo_nvmlDeviceGetMaxClockInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMaxClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (C.nvmlClockType_t)(_type), cgoAllocsUnknown
 	cClock, _ := (*C.uint)(unsafe.Pointer(Clock)), cgoAllocsUnknown
@@ -634,8 +634,8 @@ func nvmlDeviceGetMaxClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *ui
 	return __v
 }
 
-// nvmlDeviceGetGpcClkVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceGetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+// cgo_nvmlDeviceGetGpcClkVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cOffset, _ := (*C.int)(unsafe.Pointer(Offset)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGpcClkVfOffset(cnvmlDevice, cOffset)
@@ -643,8 +643,8 @@ func nvmlDeviceGetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
 	return __v
 }
 
-// nvmlDeviceGetApplicationsClock function as declared in nvml/nvml.h
-func nvmlDeviceGetApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+// cgo_nvmlDeviceGetApplicationsClock function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClockType, _ := (C.nvmlClockType_t)(ClockType), cgoAllocsUnknown
 	cClockMHz, _ := (*C.uint)(unsafe.Pointer(ClockMHz)), cgoAllocsUnknown
@@ -653,8 +653,8 @@ func nvmlDeviceGetApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType,
 	return __v
 }
 
-// nvmlDeviceGetDefaultApplicationsClock function as declared in nvml/nvml.h
-func nvmlDeviceGetDefaultApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+// cgo_nvmlDeviceGetDefaultApplicationsClock function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDefaultApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClockType, _ := (C.nvmlClockType_t)(ClockType), cgoAllocsUnknown
 	cClockMHz, _ := (*C.uint)(unsafe.Pointer(ClockMHz)), cgoAllocsUnknown
@@ -663,8 +663,8 @@ func nvmlDeviceGetDefaultApplicationsClock(nvmlDevice nvmlDevice, ClockType Cloc
 	return __v
 }
 
-// nvmlDeviceGetClock function as declared in nvml/nvml.h
-func nvmlDeviceGetClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockId ClockId, ClockMHz *uint32) Return {
+// cgo_nvmlDeviceGetClock function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockId ClockId, ClockMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClockType, _ := (C.nvmlClockType_t)(ClockType), cgoAllocsUnknown
 	cClockId, _ := (C.nvmlClockId_t)(ClockId), cgoAllocsUnknown
@@ -674,8 +674,8 @@ func nvmlDeviceGetClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockId Cloc
 	return __v
 }
 
-// nvmlDeviceGetMaxCustomerBoostClock function as declared in nvml/nvml.h
-func nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+// cgo_nvmlDeviceGetMaxCustomerBoostClock function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClockType, _ := (C.nvmlClockType_t)(ClockType), cgoAllocsUnknown
 	cClockMHz, _ := (*C.uint)(unsafe.Pointer(ClockMHz)), cgoAllocsUnknown
@@ -684,8 +684,8 @@ func nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice nvmlDevice, ClockType ClockTy
 	return __v
 }
 
-// nvmlDeviceGetSupportedMemoryClocks function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedMemoryClocks(nvmlDevice nvmlDevice, Count *uint32, ClocksMHz *uint32) Return {
+// cgo_nvmlDeviceGetSupportedMemoryClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedMemoryClocks(nvmlDevice nvmlDevice, Count *uint32, ClocksMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
 	cClocksMHz, _ := (*C.uint)(unsafe.Pointer(ClocksMHz)), cgoAllocsUnknown
@@ -694,8 +694,8 @@ func nvmlDeviceGetSupportedMemoryClocks(nvmlDevice nvmlDevice, Count *uint32, Cl
 	return __v
 }
 
-// nvmlDeviceGetSupportedGraphicsClocks function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice nvmlDevice, MemoryClockMHz uint32, Count *uint32, ClocksMHz *uint32) Return {
+// cgo_nvmlDeviceGetSupportedGraphicsClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice nvmlDevice, MemoryClockMHz uint32, Count *uint32, ClocksMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemoryClockMHz, _ := (C.uint)(MemoryClockMHz), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
@@ -705,8 +705,8 @@ func nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice nvmlDevice, MemoryClockMHz
 	return __v
 }
 
-// nvmlDeviceGetAutoBoostedClocksEnabled function as declared in nvml/nvml.h
-func nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, IsEnabled *EnableState, DefaultIsEnabled *EnableState) Return {
+// cgo_nvmlDeviceGetAutoBoostedClocksEnabled function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, IsEnabled *EnableState, DefaultIsEnabled *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIsEnabled, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(IsEnabled)), cgoAllocsUnknown
 	cDefaultIsEnabled, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(DefaultIsEnabled)), cgoAllocsUnknown
@@ -715,8 +715,8 @@ func nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, IsEnabled *Ena
 	return __v
 }
 
-// nvmlDeviceGetFanSpeed function as declared in nvml/nvml.h
-func nvmlDeviceGetFanSpeed(nvmlDevice nvmlDevice, Speed *uint32) Return {
+// cgo_nvmlDeviceGetFanSpeed function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFanSpeed(nvmlDevice nvmlDevice, Speed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSpeed, _ := (*C.uint)(unsafe.Pointer(Speed)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetFanSpeed(cnvmlDevice, cSpeed)
@@ -724,8 +724,8 @@ func nvmlDeviceGetFanSpeed(nvmlDevice nvmlDevice, Speed *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetFanSpeed_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed *uint32) Return {
+// cgo_nvmlDeviceGetFanSpeed_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	cSpeed, _ := (*C.uint)(unsafe.Pointer(Speed)), cgoAllocsUnknown
@@ -734,8 +734,8 @@ func nvmlDeviceGetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed *uint32)
 	return __v
 }
 
-// nvmlDeviceGetFanSpeedRPM function as declared in nvml/nvml.h
-func nvmlDeviceGetFanSpeedRPM(nvmlDevice nvmlDevice, FanSpeed *FanSpeedInfo) Return {
+// cgo_nvmlDeviceGetFanSpeedRPM function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFanSpeedRPM(nvmlDevice nvmlDevice, FanSpeed *FanSpeedInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFanSpeed, _ := (*C.nvmlFanSpeedInfo_t)(unsafe.Pointer(FanSpeed)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetFanSpeedRPM(cnvmlDevice, cFanSpeed)
@@ -743,8 +743,8 @@ func nvmlDeviceGetFanSpeedRPM(nvmlDevice nvmlDevice, FanSpeed *FanSpeedInfo) Ret
 	return __v
 }
 
-// nvmlDeviceGetTargetFanSpeed function as declared in nvml/nvml.h
-func nvmlDeviceGetTargetFanSpeed(nvmlDevice nvmlDevice, Fan uint32, TargetSpeed *uint32) Return {
+// cgo_nvmlDeviceGetTargetFanSpeed function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTargetFanSpeed(nvmlDevice nvmlDevice, Fan uint32, TargetSpeed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	cTargetSpeed, _ := (*C.uint)(unsafe.Pointer(TargetSpeed)), cgoAllocsUnknown
@@ -753,8 +753,8 @@ func nvmlDeviceGetTargetFanSpeed(nvmlDevice nvmlDevice, Fan uint32, TargetSpeed
 	return __v
 }
 
-// nvmlDeviceGetMinMaxFanSpeed function as declared in nvml/nvml.h
-func nvmlDeviceGetMinMaxFanSpeed(nvmlDevice nvmlDevice, MinSpeed *uint32, MaxSpeed *uint32) Return {
+// cgo_nvmlDeviceGetMinMaxFanSpeed function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMinMaxFanSpeed(nvmlDevice nvmlDevice, MinSpeed *uint32, MaxSpeed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinSpeed, _ := (*C.uint)(unsafe.Pointer(MinSpeed)), cgoAllocsUnknown
 	cMaxSpeed, _ := (*C.uint)(unsafe.Pointer(MaxSpeed)), cgoAllocsUnknown
@@ -763,8 +763,8 @@ func nvmlDeviceGetMinMaxFanSpeed(nvmlDevice nvmlDevice, MinSpeed *uint32, MaxSpe
 	return __v
 }
 
-// nvmlDeviceGetFanControlPolicy_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetFanControlPolicy_v2(nvmlDevice nvmlDevice, Fan uint32, Policy *FanControlPolicy) Return {
+// cgo_nvmlDeviceGetFanControlPolicy_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFanControlPolicy_v2(nvmlDevice nvmlDevice, Fan uint32, Policy *FanControlPolicy) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	cPolicy, _ := (*C.nvmlFanControlPolicy_t)(unsafe.Pointer(Policy)), cgoAllocsUnknown
@@ -773,8 +773,8 @@ func nvmlDeviceGetFanControlPolicy_v2(nvmlDevice nvmlDevice, Fan uint32, Policy
 	return __v
 }
 
-// nvmlDeviceGetNumFans function as declared in nvml/nvml.h
-func nvmlDeviceGetNumFans(nvmlDevice nvmlDevice, NumFans *uint32) Return {
+// cgo_nvmlDeviceGetNumFans function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNumFans(nvmlDevice nvmlDevice, NumFans *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cNumFans, _ := (*C.uint)(unsafe.Pointer(NumFans)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNumFans(cnvmlDevice, cNumFans)
@@ -782,8 +782,8 @@ func nvmlDeviceGetNumFans(nvmlDevice nvmlDevice, NumFans *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetTemperature function as declared in nvml/nvml.h
-func nvmlDeviceGetTemperature(nvmlDevice nvmlDevice, SensorType TemperatureSensors, Temp *uint32) Return {
+// cgo_nvmlDeviceGetTemperature function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTemperature(nvmlDevice nvmlDevice, SensorType TemperatureSensors, Temp *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSensorType, _ := (C.nvmlTemperatureSensors_t)(SensorType), cgoAllocsUnknown
 	cTemp, _ := (*C.uint)(unsafe.Pointer(Temp)), cgoAllocsUnknown
@@ -792,8 +792,8 @@ func nvmlDeviceGetTemperature(nvmlDevice nvmlDevice, SensorType TemperatureSenso
 	return __v
 }
 
-// nvmlDeviceGetCoolerInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetCoolerInfo(nvmlDevice nvmlDevice, CoolerInfo *CoolerInfo) Return {
+// cgo_nvmlDeviceGetCoolerInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCoolerInfo(nvmlDevice nvmlDevice, CoolerInfo *CoolerInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCoolerInfo, _ := (*C.nvmlCoolerInfo_t)(unsafe.Pointer(CoolerInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCoolerInfo(cnvmlDevice, cCoolerInfo)
@@ -801,8 +801,8 @@ func nvmlDeviceGetCoolerInfo(nvmlDevice nvmlDevice, CoolerInfo *CoolerInfo) Retu
 	return __v
 }
 
-// nvmlDeviceGetTemperatureV function as declared in nvml/nvml.h
-func nvmlDeviceGetTemperatureV(nvmlDevice nvmlDevice, Temperature *Temperature) Return {
+// cgo_nvmlDeviceGetTemperatureV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTemperatureV(nvmlDevice nvmlDevice, Temperature *Temperature) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cTemperature, _ := (*C.nvmlTemperature_t)(unsafe.Pointer(Temperature)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetTemperatureV(cnvmlDevice, cTemperature)
@@ -810,8 +810,8 @@ func nvmlDeviceGetTemperatureV(nvmlDevice nvmlDevice, Temperature *Temperature)
 	return __v
 }
 
-// nvmlDeviceGetTemperatureThreshold function as declared in nvml/nvml.h
-func nvmlDeviceGetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *uint32) Return {
+// cgo_nvmlDeviceGetTemperatureThreshold function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cThresholdType, _ := (C.nvmlTemperatureThresholds_t)(ThresholdType), cgoAllocsUnknown
 	cTemp, _ := (*C.uint)(unsafe.Pointer(Temp)), cgoAllocsUnknown
@@ -820,8 +820,8 @@ func nvmlDeviceGetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType Temp
 	return __v
 }
 
-// nvmlDeviceGetMarginTemperature function as declared in nvml/nvml.h
-func nvmlDeviceGetMarginTemperature(nvmlDevice nvmlDevice, MarginTempInfo *MarginTemperature) Return {
+// cgo_nvmlDeviceGetMarginTemperature function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMarginTemperature(nvmlDevice nvmlDevice, MarginTempInfo *MarginTemperature) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMarginTempInfo, _ := (*C.nvmlMarginTemperature_t)(unsafe.Pointer(MarginTempInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMarginTemperature(cnvmlDevice, cMarginTempInfo)
@@ -829,8 +829,8 @@ func nvmlDeviceGetMarginTemperature(nvmlDevice nvmlDevice, MarginTempInfo *Margi
 	return __v
 }
 
-// nvmlDeviceGetThermalSettings function as declared in nvml/nvml.h
-func nvmlDeviceGetThermalSettings(nvmlDevice nvmlDevice, SensorIndex uint32, PThermalSettings *GpuThermalSettings) Return {
+// cgo_nvmlDeviceGetThermalSettings function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetThermalSettings(nvmlDevice nvmlDevice, SensorIndex uint32, PThermalSettings *GpuThermalSettings) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSensorIndex, _ := (C.uint)(SensorIndex), cgoAllocsUnknown
 	cPThermalSettings, _ := (*C.nvmlGpuThermalSettings_t)(unsafe.Pointer(PThermalSettings)), cgoAllocsUnknown
@@ -839,8 +839,8 @@ func nvmlDeviceGetThermalSettings(nvmlDevice nvmlDevice, SensorIndex uint32, PTh
 	return __v
 }
 
-// nvmlDeviceGetPerformanceState function as declared in nvml/nvml.h
-func nvmlDeviceGetPerformanceState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+// cgo_nvmlDeviceGetPerformanceState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPerformanceState(nvmlDevice nvmlDevice, PState *Pstates) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPState, _ := (*C.nvmlPstates_t)(unsafe.Pointer(PState)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPerformanceState(cnvmlDevice, cPState)
@@ -848,8 +848,8 @@ func nvmlDeviceGetPerformanceState(nvmlDevice nvmlDevice, PState *Pstates) Retur
 	return __v
 }
 
-// nvmlDeviceGetCurrentClocksEventReasons function as declared in nvml/nvml.h
-func nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice nvmlDevice, ClocksEventReasons *uint64) Return {
+// cgo_nvmlDeviceGetCurrentClocksEventReasons function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice nvmlDevice, ClocksEventReasons *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClocksEventReasons, _ := (*C.ulonglong)(unsafe.Pointer(ClocksEventReasons)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCurrentClocksEventReasons(cnvmlDevice, cClocksEventReasons)
@@ -857,8 +857,8 @@ func nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice nvmlDevice, ClocksEventRe
 	return __v
 }
 
-// nvmlDeviceGetCurrentClocksThrottleReasons function as declared in nvml/nvml.h
-func nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice nvmlDevice, ClocksThrottleReasons *uint64) Return {
+// cgo_nvmlDeviceGetCurrentClocksThrottleReasons function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice nvmlDevice, ClocksThrottleReasons *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cClocksThrottleReasons, _ := (*C.ulonglong)(unsafe.Pointer(ClocksThrottleReasons)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCurrentClocksThrottleReasons(cnvmlDevice, cClocksThrottleReasons)
@@ -866,8 +866,8 @@ func nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice nvmlDevice, ClocksThro
 	return __v
 }
 
-// nvmlDeviceGetSupportedClocksEventReasons function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice nvmlDevice, SupportedClocksEventReasons *uint64) Return {
+// cgo_nvmlDeviceGetSupportedClocksEventReasons function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice nvmlDevice, SupportedClocksEventReasons *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSupportedClocksEventReasons, _ := (*C.ulonglong)(unsafe.Pointer(SupportedClocksEventReasons)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetSupportedClocksEventReasons(cnvmlDevice, cSupportedClocksEventReasons)
@@ -875,8 +875,8 @@ func nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice nvmlDevice, SupportedCl
 	return __v
 }
 
-// nvmlDeviceGetSupportedClocksThrottleReasons function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice nvmlDevice, SupportedClocksThrottleReasons *uint64) Return {
+// cgo_nvmlDeviceGetSupportedClocksThrottleReasons function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice nvmlDevice, SupportedClocksThrottleReasons *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSupportedClocksThrottleReasons, _ := (*C.ulonglong)(unsafe.Pointer(SupportedClocksThrottleReasons)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetSupportedClocksThrottleReasons(cnvmlDevice, cSupportedClocksThrottleReasons)
@@ -884,8 +884,8 @@ func nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice nvmlDevice, Supporte
 	return __v
 }
 
-// nvmlDeviceGetPowerState function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+// cgo_nvmlDeviceGetPowerState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerState(nvmlDevice nvmlDevice, PState *Pstates) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPState, _ := (*C.nvmlPstates_t)(unsafe.Pointer(PState)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerState(cnvmlDevice, cPState)
@@ -893,8 +893,8 @@ func nvmlDeviceGetPowerState(nvmlDevice nvmlDevice, PState *Pstates) Return {
 	return __v
 }
 
-// nvmlDeviceGetDynamicPstatesInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetDynamicPstatesInfo(nvmlDevice nvmlDevice, PDynamicPstatesInfo *GpuDynamicPstatesInfo) Return {
+// cgo_nvmlDeviceGetDynamicPstatesInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDynamicPstatesInfo(nvmlDevice nvmlDevice, PDynamicPstatesInfo *GpuDynamicPstatesInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPDynamicPstatesInfo, _ := (*C.nvmlGpuDynamicPstatesInfo_t)(unsafe.Pointer(PDynamicPstatesInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetDynamicPstatesInfo(cnvmlDevice, cPDynamicPstatesInfo)
@@ -902,8 +902,8 @@ func nvmlDeviceGetDynamicPstatesInfo(nvmlDevice nvmlDevice, PDynamicPstatesInfo
 	return __v
 }
 
-// nvmlDeviceGetMemClkVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceGetMemClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+// cgo_nvmlDeviceGetMemClkVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cOffset, _ := (*C.int)(unsafe.Pointer(Offset)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMemClkVfOffset(cnvmlDevice, cOffset)
@@ -911,8 +911,8 @@ func nvmlDeviceGetMemClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
 	return __v
 }
 
-// nvmlDeviceGetMinMaxClockOfPState function as declared in nvml/nvml.h
-func nvmlDeviceGetMinMaxClockOfPState(nvmlDevice nvmlDevice, _type ClockType, Pstate Pstates, MinClockMHz *uint32, MaxClockMHz *uint32) Return {
+// cgo_nvmlDeviceGetMinMaxClockOfPState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMinMaxClockOfPState(nvmlDevice nvmlDevice, _type ClockType, Pstate Pstates, MinClockMHz *uint32, MaxClockMHz *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (C.nvmlClockType_t)(_type), cgoAllocsUnknown
 	cPstate, _ := (C.nvmlPstates_t)(Pstate), cgoAllocsUnknown
@@ -923,8 +923,8 @@ func nvmlDeviceGetMinMaxClockOfPState(nvmlDevice nvmlDevice, _type ClockType, Ps
 	return __v
 }
 
-// nvmlDeviceGetSupportedPerformanceStates function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedPerformanceStates(nvmlDevice nvmlDevice, Pstates *Pstates, Size uint32) Return {
+// cgo_nvmlDeviceGetSupportedPerformanceStates function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedPerformanceStates(nvmlDevice nvmlDevice, Pstates *Pstates, Size uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPstates, _ := (*C.nvmlPstates_t)(unsafe.Pointer(Pstates)), cgoAllocsUnknown
 	cSize, _ := (C.uint)(Size), cgoAllocsUnknown
@@ -933,8 +933,8 @@ func nvmlDeviceGetSupportedPerformanceStates(nvmlDevice nvmlDevice, Pstates *Pst
 	return __v
 }
 
-// nvmlDeviceGetGpcClkMinMaxVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+// cgo_nvmlDeviceGetGpcClkMinMaxVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinOffset, _ := (*C.int)(unsafe.Pointer(MinOffset)), cgoAllocsUnknown
 	cMaxOffset, _ := (*C.int)(unsafe.Pointer(MaxOffset)), cgoAllocsUnknown
@@ -943,8 +943,8 @@ func nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32,
 	return __v
 }
 
-// nvmlDeviceGetMemClkMinMaxVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+// cgo_nvmlDeviceGetMemClkMinMaxVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinOffset, _ := (*C.int)(unsafe.Pointer(MinOffset)), cgoAllocsUnknown
 	cMaxOffset, _ := (*C.int)(unsafe.Pointer(MaxOffset)), cgoAllocsUnknown
@@ -953,8 +953,8 @@ func nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32,
 	return __v
 }
 
-// nvmlDeviceGetClockOffsets function as declared in nvml/nvml.h
-func nvmlDeviceGetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+// cgo_nvmlDeviceGetClockOffsets function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlClockOffset_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetClockOffsets(cnvmlDevice, cInfo)
@@ -962,8 +962,8 @@ func nvmlDeviceGetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return
 	return __v
 }
 
-// nvmlDeviceSetClockOffsets function as declared in nvml/nvml.h
-func nvmlDeviceSetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+// cgo_nvmlDeviceSetClockOffsets function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlClockOffset_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetClockOffsets(cnvmlDevice, cInfo)
@@ -971,8 +971,8 @@ func nvmlDeviceSetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return
 	return __v
 }
 
-// nvmlDeviceGetPerformanceModes function as declared in nvml/nvml.h
-func nvmlDeviceGetPerformanceModes(nvmlDevice nvmlDevice, PerfModes *DevicePerfModes) Return {
+// cgo_nvmlDeviceGetPerformanceModes function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPerformanceModes(nvmlDevice nvmlDevice, PerfModes *DevicePerfModes) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPerfModes, _ := (*C.nvmlDevicePerfModes_t)(unsafe.Pointer(PerfModes)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPerformanceModes(cnvmlDevice, cPerfModes)
@@ -980,8 +980,8 @@ func nvmlDeviceGetPerformanceModes(nvmlDevice nvmlDevice, PerfModes *DevicePerfM
 	return __v
 }
 
-// nvmlDeviceGetCurrentClockFreqs function as declared in nvml/nvml.h
-func nvmlDeviceGetCurrentClockFreqs(nvmlDevice nvmlDevice, CurrentClockFreqs *DeviceCurrentClockFreqs) Return {
+// cgo_nvmlDeviceGetCurrentClockFreqs function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCurrentClockFreqs(nvmlDevice nvmlDevice, CurrentClockFreqs *DeviceCurrentClockFreqs) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrentClockFreqs, _ := (*C.nvmlDeviceCurrentClockFreqs_t)(unsafe.Pointer(CurrentClockFreqs)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCurrentClockFreqs(cnvmlDevice, cCurrentClockFreqs)
@@ -989,8 +989,8 @@ func nvmlDeviceGetCurrentClockFreqs(nvmlDevice nvmlDevice, CurrentClockFreqs *De
 	return __v
 }
 
-// nvmlDeviceGetPowerManagementMode function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerManagementMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+// cgo_nvmlDeviceGetPowerManagementMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerManagementMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerManagementMode(cnvmlDevice, cMode)
@@ -998,8 +998,8 @@ func nvmlDeviceGetPowerManagementMode(nvmlDevice nvmlDevice, Mode *EnableState)
 	return __v
 }
 
-// nvmlDeviceGetPowerManagementLimit function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerManagementLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+// cgo_nvmlDeviceGetPowerManagementLimit function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerManagementLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLimit, _ := (*C.uint)(unsafe.Pointer(Limit)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerManagementLimit(cnvmlDevice, cLimit)
@@ -1007,8 +1007,8 @@ func nvmlDeviceGetPowerManagementLimit(nvmlDevice nvmlDevice, Limit *uint32) Ret
 	return __v
 }
 
-// nvmlDeviceGetPowerManagementLimitConstraints function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice nvmlDevice, MinLimit *uint32, MaxLimit *uint32) Return {
+// cgo_nvmlDeviceGetPowerManagementLimitConstraints function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice nvmlDevice, MinLimit *uint32, MaxLimit *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinLimit, _ := (*C.uint)(unsafe.Pointer(MinLimit)), cgoAllocsUnknown
 	cMaxLimit, _ := (*C.uint)(unsafe.Pointer(MaxLimit)), cgoAllocsUnknown
@@ -1017,8 +1017,8 @@ func nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice nvmlDevice, MinLimi
 	return __v
 }
 
-// nvmlDeviceGetPowerManagementDefaultLimit function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice nvmlDevice, DefaultLimit *uint32) Return {
+// cgo_nvmlDeviceGetPowerManagementDefaultLimit function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice nvmlDevice, DefaultLimit *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cDefaultLimit, _ := (*C.uint)(unsafe.Pointer(DefaultLimit)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerManagementDefaultLimit(cnvmlDevice, cDefaultLimit)
@@ -1026,8 +1026,8 @@ func nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice nvmlDevice, DefaultLimi
 	return __v
 }
 
-// nvmlDeviceGetPowerUsage function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerUsage(nvmlDevice nvmlDevice, Power *uint32) Return {
+// cgo_nvmlDeviceGetPowerUsage function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerUsage(nvmlDevice nvmlDevice, Power *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPower, _ := (*C.uint)(unsafe.Pointer(Power)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerUsage(cnvmlDevice, cPower)
@@ -1035,8 +1035,8 @@ func nvmlDeviceGetPowerUsage(nvmlDevice nvmlDevice, Power *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetPowerMizerMode_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+// cgo_nvmlDeviceGetPowerMizerMode_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPowerMizerMode, _ := (*C.nvmlDevicePowerMizerModes_v1_t)(unsafe.Pointer(PowerMizerMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerMizerMode_v1(cnvmlDevice, cPowerMizerMode)
@@ -1044,8 +1044,8 @@ func nvmlDeviceGetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *Devic
 	return __v
 }
 
-// nvmlDeviceSetPowerMizerMode_v1 function as declared in nvml/nvml.h
-func nvmlDeviceSetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+// cgo_nvmlDeviceSetPowerMizerMode_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPowerMizerMode, _ := (*C.nvmlDevicePowerMizerModes_v1_t)(unsafe.Pointer(PowerMizerMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetPowerMizerMode_v1(cnvmlDevice, cPowerMizerMode)
@@ -1053,8 +1053,8 @@ func nvmlDeviceSetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *Devic
 	return __v
 }
 
-// nvmlDeviceGetTotalEnergyConsumption function as declared in nvml/nvml.h
-func nvmlDeviceGetTotalEnergyConsumption(nvmlDevice nvmlDevice, Energy *uint64) Return {
+// cgo_nvmlDeviceGetTotalEnergyConsumption function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTotalEnergyConsumption(nvmlDevice nvmlDevice, Energy *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEnergy, _ := (*C.ulonglong)(unsafe.Pointer(Energy)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetTotalEnergyConsumption(cnvmlDevice, cEnergy)
@@ -1062,8 +1062,8 @@ func nvmlDeviceGetTotalEnergyConsumption(nvmlDevice nvmlDevice, Energy *uint64)
 	return __v
 }
 
-// nvmlDeviceGetEnforcedPowerLimit function as declared in nvml/nvml.h
-func nvmlDeviceGetEnforcedPowerLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+// cgo_nvmlDeviceGetEnforcedPowerLimit function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEnforcedPowerLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLimit, _ := (*C.uint)(unsafe.Pointer(Limit)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetEnforcedPowerLimit(cnvmlDevice, cLimit)
@@ -1071,8 +1071,8 @@ func nvmlDeviceGetEnforcedPowerLimit(nvmlDevice nvmlDevice, Limit *uint32) Retur
 	return __v
 }
 
-// nvmlDeviceGetGpuOperationMode function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuOperationMode(nvmlDevice nvmlDevice, Current *GpuOperationMode, Pending *GpuOperationMode) Return {
+// cgo_nvmlDeviceGetGpuOperationMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuOperationMode(nvmlDevice nvmlDevice, Current *GpuOperationMode, Pending *GpuOperationMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlGpuOperationMode_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	cPending, _ := (*C.nvmlGpuOperationMode_t)(unsafe.Pointer(Pending)), cgoAllocsUnknown
@@ -1081,8 +1081,8 @@ func nvmlDeviceGetGpuOperationMode(nvmlDevice nvmlDevice, Current *GpuOperationM
 	return __v
 }
 
-// nvmlDeviceGetMemoryInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetMemoryInfo(nvmlDevice nvmlDevice, Memory *Memory) Return {
+// cgo_nvmlDeviceGetMemoryInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemoryInfo(nvmlDevice nvmlDevice, Memory *Memory) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemory, _ := (*C.nvmlMemory_t)(unsafe.Pointer(Memory)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMemoryInfo(cnvmlDevice, cMemory)
@@ -1090,8 +1090,8 @@ func nvmlDeviceGetMemoryInfo(nvmlDevice nvmlDevice, Memory *Memory) Return {
 	return __v
 }
 
-// nvmlDeviceGetMemoryInfo_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetMemoryInfo_v2(nvmlDevice nvmlDevice, Memory *Memory_v2) Return {
+// cgo_nvmlDeviceGetMemoryInfo_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemoryInfo_v2(nvmlDevice nvmlDevice, Memory *Memory_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemory, _ := (*C.nvmlMemory_v2_t)(unsafe.Pointer(Memory)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMemoryInfo_v2(cnvmlDevice, cMemory)
@@ -1099,8 +1099,8 @@ func nvmlDeviceGetMemoryInfo_v2(nvmlDevice nvmlDevice, Memory *Memory_v2) Return
 	return __v
 }
 
-// nvmlDeviceGetComputeMode function as declared in nvml/nvml.h
-func nvmlDeviceGetComputeMode(nvmlDevice nvmlDevice, Mode *ComputeMode) Return {
+// cgo_nvmlDeviceGetComputeMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetComputeMode(nvmlDevice nvmlDevice, Mode *ComputeMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlComputeMode_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetComputeMode(cnvmlDevice, cMode)
@@ -1108,8 +1108,8 @@ func nvmlDeviceGetComputeMode(nvmlDevice nvmlDevice, Mode *ComputeMode) Return {
 	return __v
 }
 
-// nvmlDeviceGetCudaComputeCapability function as declared in nvml/nvml.h
-func nvmlDeviceGetCudaComputeCapability(nvmlDevice nvmlDevice, Major *int32, Minor *int32) Return {
+// cgo_nvmlDeviceGetCudaComputeCapability function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCudaComputeCapability(nvmlDevice nvmlDevice, Major *int32, Minor *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMajor, _ := (*C.int)(unsafe.Pointer(Major)), cgoAllocsUnknown
 	cMinor, _ := (*C.int)(unsafe.Pointer(Minor)), cgoAllocsUnknown
@@ -1118,8 +1118,8 @@ func nvmlDeviceGetCudaComputeCapability(nvmlDevice nvmlDevice, Major *int32, Min
 	return __v
 }
 
-// nvmlDeviceGetDramEncryptionMode function as declared in nvml/nvml.h
-func nvmlDeviceGetDramEncryptionMode(nvmlDevice nvmlDevice, Current *DramEncryptionInfo, Pending *DramEncryptionInfo) Return {
+// cgo_nvmlDeviceGetDramEncryptionMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDramEncryptionMode(nvmlDevice nvmlDevice, Current *DramEncryptionInfo, Pending *DramEncryptionInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlDramEncryptionInfo_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	cPending, _ := (*C.nvmlDramEncryptionInfo_t)(unsafe.Pointer(Pending)), cgoAllocsUnknown
@@ -1128,8 +1128,8 @@ func nvmlDeviceGetDramEncryptionMode(nvmlDevice nvmlDevice, Current *DramEncrypt
 	return __v
 }
 
-// nvmlDeviceSetDramEncryptionMode function as declared in nvml/nvml.h
-func nvmlDeviceSetDramEncryptionMode(nvmlDevice nvmlDevice, DramEncryption *DramEncryptionInfo) Return {
+// cgo_nvmlDeviceSetDramEncryptionMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetDramEncryptionMode(nvmlDevice nvmlDevice, DramEncryption *DramEncryptionInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cDramEncryption, _ := (*C.nvmlDramEncryptionInfo_t)(unsafe.Pointer(DramEncryption)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetDramEncryptionMode(cnvmlDevice, cDramEncryption)
@@ -1137,8 +1137,8 @@ func nvmlDeviceSetDramEncryptionMode(nvmlDevice nvmlDevice, DramEncryption *Dram
 	return __v
 }
 
-// nvmlDeviceGetEccMode function as declared in nvml/nvml.h
-func nvmlDeviceGetEccMode(nvmlDevice nvmlDevice, Current *EnableState, Pending *EnableState) Return {
+// cgo_nvmlDeviceGetEccMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEccMode(nvmlDevice nvmlDevice, Current *EnableState, Pending *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	cPending, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Pending)), cgoAllocsUnknown
@@ -1147,8 +1147,8 @@ func nvmlDeviceGetEccMode(nvmlDevice nvmlDevice, Current *EnableState, Pending *
 	return __v
 }
 
-// nvmlDeviceGetDefaultEccMode function as declared in nvml/nvml.h
-func nvmlDeviceGetDefaultEccMode(nvmlDevice nvmlDevice, DefaultMode *EnableState) Return {
+// cgo_nvmlDeviceGetDefaultEccMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDefaultEccMode(nvmlDevice nvmlDevice, DefaultMode *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cDefaultMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(DefaultMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetDefaultEccMode(cnvmlDevice, cDefaultMode)
@@ -1156,8 +1156,8 @@ func nvmlDeviceGetDefaultEccMode(nvmlDevice nvmlDevice, DefaultMode *EnableState
 	return __v
 }
 
-// nvmlDeviceGetBoardId function as declared in nvml/nvml.h
-func nvmlDeviceGetBoardId(nvmlDevice nvmlDevice, BoardId *uint32) Return {
+// cgo_nvmlDeviceGetBoardId function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBoardId(nvmlDevice nvmlDevice, BoardId *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBoardId, _ := (*C.uint)(unsafe.Pointer(BoardId)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetBoardId(cnvmlDevice, cBoardId)
@@ -1165,8 +1165,8 @@ func nvmlDeviceGetBoardId(nvmlDevice nvmlDevice, BoardId *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetMultiGpuBoard function as declared in nvml/nvml.h
-func nvmlDeviceGetMultiGpuBoard(nvmlDevice nvmlDevice, MultiGpuBool *uint32) Return {
+// cgo_nvmlDeviceGetMultiGpuBoard function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMultiGpuBoard(nvmlDevice nvmlDevice, MultiGpuBool *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMultiGpuBool, _ := (*C.uint)(unsafe.Pointer(MultiGpuBool)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMultiGpuBoard(cnvmlDevice, cMultiGpuBool)
@@ -1174,8 +1174,8 @@ func nvmlDeviceGetMultiGpuBoard(nvmlDevice nvmlDevice, MultiGpuBool *uint32) Ret
 	return __v
 }
 
-// nvmlDeviceGetTotalEccErrors function as declared in nvml/nvml.h
-func nvmlDeviceGetTotalEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *uint64) Return {
+// cgo_nvmlDeviceGetTotalEccErrors function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetTotalEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cErrorType, _ := (C.nvmlMemoryErrorType_t)(ErrorType), cgoAllocsUnknown
 	cCounterType, _ := (C.nvmlEccCounterType_t)(CounterType), cgoAllocsUnknown
@@ -1185,8 +1185,8 @@ func nvmlDeviceGetTotalEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorTyp
 	return __v
 }
 
-// nvmlDeviceGetDetailedEccErrors function as declared in nvml/nvml.h
-func nvmlDeviceGetDetailedEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *EccErrorCounts) Return {
+// cgo_nvmlDeviceGetDetailedEccErrors function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDetailedEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *EccErrorCounts) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cErrorType, _ := (C.nvmlMemoryErrorType_t)(ErrorType), cgoAllocsUnknown
 	cCounterType, _ := (C.nvmlEccCounterType_t)(CounterType), cgoAllocsUnknown
@@ -1196,8 +1196,8 @@ func nvmlDeviceGetDetailedEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryError
 	return __v
 }
 
-// nvmlDeviceGetMemoryErrorCounter function as declared in nvml/nvml.h
-func nvmlDeviceGetMemoryErrorCounter(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, LocationType MemoryLocation, Count *uint64) Return {
+// cgo_nvmlDeviceGetMemoryErrorCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemoryErrorCounter(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, LocationType MemoryLocation, Count *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cErrorType, _ := (C.nvmlMemoryErrorType_t)(ErrorType), cgoAllocsUnknown
 	cCounterType, _ := (C.nvmlEccCounterType_t)(CounterType), cgoAllocsUnknown
@@ -1208,8 +1208,8 @@ func nvmlDeviceGetMemoryErrorCounter(nvmlDevice nvmlDevice, ErrorType MemoryErro
 	return __v
 }
 
-// nvmlDeviceGetUtilizationRates function as declared in nvml/nvml.h
-func nvmlDeviceGetUtilizationRates(nvmlDevice nvmlDevice, Utilization *Utilization) Return {
+// cgo_nvmlDeviceGetUtilizationRates function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetUtilizationRates(nvmlDevice nvmlDevice, Utilization *Utilization) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.nvmlUtilization_t)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetUtilizationRates(cnvmlDevice, cUtilization)
@@ -1217,8 +1217,8 @@ func nvmlDeviceGetUtilizationRates(nvmlDevice nvmlDevice, Utilization *Utilizati
 	return __v
 }
 
-// nvmlDeviceGetEncoderUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetEncoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+// cgo_nvmlDeviceGetEncoderUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEncoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.uint)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	cSamplingPeriodUs, _ := (*C.uint)(unsafe.Pointer(SamplingPeriodUs)), cgoAllocsUnknown
@@ -1227,8 +1227,8 @@ func nvmlDeviceGetEncoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32,
 	return __v
 }
 
-// nvmlDeviceGetEncoderCapacity function as declared in nvml/nvml.h
-func nvmlDeviceGetEncoderCapacity(nvmlDevice nvmlDevice, EncoderQueryType EncoderType, EncoderCapacity *uint32) Return {
+// cgo_nvmlDeviceGetEncoderCapacity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEncoderCapacity(nvmlDevice nvmlDevice, EncoderQueryType EncoderType, EncoderCapacity *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEncoderQueryType, _ := (C.nvmlEncoderType_t)(EncoderQueryType), cgoAllocsUnknown
 	cEncoderCapacity, _ := (*C.uint)(unsafe.Pointer(EncoderCapacity)), cgoAllocsUnknown
@@ -1237,8 +1237,8 @@ func nvmlDeviceGetEncoderCapacity(nvmlDevice nvmlDevice, EncoderQueryType Encode
 	return __v
 }
 
-// nvmlDeviceGetEncoderStats function as declared in nvml/nvml.h
-func nvmlDeviceGetEncoderStats(nvmlDevice nvmlDevice, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+// cgo_nvmlDeviceGetEncoderStats function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEncoderStats(nvmlDevice nvmlDevice, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cAverageFps, _ := (*C.uint)(unsafe.Pointer(AverageFps)), cgoAllocsUnknown
@@ -1248,8 +1248,8 @@ func nvmlDeviceGetEncoderStats(nvmlDevice nvmlDevice, SessionCount *uint32, Aver
 	return __v
 }
 
-// nvmlDeviceGetEncoderSessions function as declared in nvml/nvml.h
-func nvmlDeviceGetEncoderSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfos *EncoderSessionInfo) Return {
+// cgo_nvmlDeviceGetEncoderSessions function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetEncoderSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfos *EncoderSessionInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cSessionInfos, _ := (*C.nvmlEncoderSessionInfo_t)(unsafe.Pointer(SessionInfos)), cgoAllocsUnknown
@@ -1258,8 +1258,8 @@ func nvmlDeviceGetEncoderSessions(nvmlDevice nvmlDevice, SessionCount *uint32, S
 	return __v
 }
 
-// nvmlDeviceGetDecoderUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetDecoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+// cgo_nvmlDeviceGetDecoderUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDecoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.uint)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	cSamplingPeriodUs, _ := (*C.uint)(unsafe.Pointer(SamplingPeriodUs)), cgoAllocsUnknown
@@ -1268,8 +1268,8 @@ func nvmlDeviceGetDecoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32,
 	return __v
 }
 
-// nvmlDeviceGetJpgUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetJpgUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+// cgo_nvmlDeviceGetJpgUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetJpgUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.uint)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	cSamplingPeriodUs, _ := (*C.uint)(unsafe.Pointer(SamplingPeriodUs)), cgoAllocsUnknown
@@ -1278,8 +1278,8 @@ func nvmlDeviceGetJpgUtilization(nvmlDevice nvmlDevice, Utilization *uint32, Sam
 	return __v
 }
 
-// nvmlDeviceGetOfaUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetOfaUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+// cgo_nvmlDeviceGetOfaUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetOfaUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.uint)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	cSamplingPeriodUs, _ := (*C.uint)(unsafe.Pointer(SamplingPeriodUs)), cgoAllocsUnknown
@@ -1288,8 +1288,8 @@ func nvmlDeviceGetOfaUtilization(nvmlDevice nvmlDevice, Utilization *uint32, Sam
 	return __v
 }
 
-// nvmlDeviceGetFBCStats function as declared in nvml/nvml.h
-func nvmlDeviceGetFBCStats(nvmlDevice nvmlDevice, FbcStats *FBCStats) Return {
+// cgo_nvmlDeviceGetFBCStats function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFBCStats(nvmlDevice nvmlDevice, FbcStats *FBCStats) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFbcStats, _ := (*C.nvmlFBCStats_t)(unsafe.Pointer(FbcStats)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetFBCStats(cnvmlDevice, cFbcStats)
@@ -1297,8 +1297,8 @@ func nvmlDeviceGetFBCStats(nvmlDevice nvmlDevice, FbcStats *FBCStats) Return {
 	return __v
 }
 
-// nvmlDeviceGetFBCSessions function as declared in nvml/nvml.h
-func nvmlDeviceGetFBCSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+// cgo_nvmlDeviceGetFBCSessions function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFBCSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cSessionInfo, _ := (*C.nvmlFBCSessionInfo_t)(unsafe.Pointer(SessionInfo)), cgoAllocsUnknown
@@ -1307,8 +1307,8 @@ func nvmlDeviceGetFBCSessions(nvmlDevice nvmlDevice, SessionCount *uint32, Sessi
 	return __v
 }
 
-// nvmlDeviceGetDriverModel_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetDriverModel_v2(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+// cgo_nvmlDeviceGetDriverModel_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDriverModel_v2(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlDriverModel_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	cPending, _ := (*C.nvmlDriverModel_t)(unsafe.Pointer(Pending)), cgoAllocsUnknown
@@ -1317,8 +1317,8 @@ func nvmlDeviceGetDriverModel_v2(nvmlDevice nvmlDevice, Current *DriverModel, Pe
 	return __v
 }
 
-// nvmlDeviceGetVbiosVersion function as declared in nvml/nvml.h
-func nvmlDeviceGetVbiosVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+// cgo_nvmlDeviceGetVbiosVersion function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVbiosVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -1327,8 +1327,8 @@ func nvmlDeviceGetVbiosVersion(nvmlDevice nvmlDevice, Version *byte, Length uint
 	return __v
 }
 
-// nvmlDeviceGetBridgeChipInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetBridgeChipInfo(nvmlDevice nvmlDevice, BridgeHierarchy *BridgeChipHierarchy) Return {
+// cgo_nvmlDeviceGetBridgeChipInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBridgeChipInfo(nvmlDevice nvmlDevice, BridgeHierarchy *BridgeChipHierarchy) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBridgeHierarchy, _ := (*C.nvmlBridgeChipHierarchy_t)(unsafe.Pointer(BridgeHierarchy)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetBridgeChipInfo(cnvmlDevice, cBridgeHierarchy)
@@ -1336,8 +1336,8 @@ func nvmlDeviceGetBridgeChipInfo(nvmlDevice nvmlDevice, BridgeHierarchy *BridgeC
 	return __v
 }
 
-// nvmlDeviceGetComputeRunningProcesses_v3 function as declared in nvml/nvml.h
-func nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+// cgo_nvmlDeviceGetComputeRunningProcesses_v3 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -1346,8 +1346,8 @@ func nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *u
 	return __v
 }
 
-// nvmlDeviceGetGraphicsRunningProcesses_v3 function as declared in nvml/nvml.h
-func nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+// cgo_nvmlDeviceGetGraphicsRunningProcesses_v3 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -1356,8 +1356,8 @@ func nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *
 	return __v
 }
 
-// nvmlDeviceGetMPSComputeRunningProcesses_v3 function as declared in nvml/nvml.h
-func nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+// cgo_nvmlDeviceGetMPSComputeRunningProcesses_v3 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -1366,8 +1366,8 @@ func nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount
 	return __v
 }
 
-// nvmlDeviceGetRunningProcessDetailList function as declared in nvml/nvml.h
-func nvmlDeviceGetRunningProcessDetailList(nvmlDevice nvmlDevice, Plist *ProcessDetailList) Return {
+// cgo_nvmlDeviceGetRunningProcessDetailList function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRunningProcessDetailList(nvmlDevice nvmlDevice, Plist *ProcessDetailList) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPlist, _ := (*C.nvmlProcessDetailList_t)(unsafe.Pointer(Plist)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetRunningProcessDetailList(cnvmlDevice, cPlist)
@@ -1375,8 +1375,8 @@ func nvmlDeviceGetRunningProcessDetailList(nvmlDevice nvmlDevice, Plist *Process
 	return __v
 }
 
-// nvmlDeviceOnSameBoard function as declared in nvml/nvml.h
-func nvmlDeviceOnSameBoard(Device1 nvmlDevice, Device2 nvmlDevice, OnSameBoard *int32) Return {
+// cgo_nvmlDeviceOnSameBoard function as declared in nvml/nvml.h
+func cgo_nvmlDeviceOnSameBoard(Device1 nvmlDevice, Device2 nvmlDevice, OnSameBoard *int32) Return {
 	cDevice1, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device1)), cgoAllocsUnknown
 	cDevice2, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&Device2)), cgoAllocsUnknown
 	cOnSameBoard, _ := (*C.int)(unsafe.Pointer(OnSameBoard)), cgoAllocsUnknown
@@ -1385,8 +1385,8 @@ func nvmlDeviceOnSameBoard(Device1 nvmlDevice, Device2 nvmlDevice, OnSameBoard *
 	return __v
 }
 
-// nvmlDeviceGetAPIRestriction function as declared in nvml/nvml.h
-func nvmlDeviceGetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted *EnableState) Return {
+// cgo_nvmlDeviceGetAPIRestriction function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cApiType, _ := (C.nvmlRestrictedAPI_t)(ApiType), cgoAllocsUnknown
 	cIsRestricted, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(IsRestricted)), cgoAllocsUnknown
@@ -1395,8 +1395,8 @@ func nvmlDeviceGetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, I
 	return __v
 }
 
-// nvmlDeviceGetSamples function as declared in nvml/nvml.h
-func nvmlDeviceGetSamples(nvmlDevice nvmlDevice, _type SamplingType, LastSeenTimeStamp uint64, SampleValType *ValueType, SampleCount *uint32, Samples *Sample) Return {
+// cgo_nvmlDeviceGetSamples function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSamples(nvmlDevice nvmlDevice, _type SamplingType, LastSeenTimeStamp uint64, SampleValType *ValueType, SampleCount *uint32, Samples *Sample) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (C.nvmlSamplingType_t)(_type), cgoAllocsUnknown
 	cLastSeenTimeStamp, _ := (C.ulonglong)(LastSeenTimeStamp), cgoAllocsUnknown
@@ -1408,8 +1408,8 @@ func nvmlDeviceGetSamples(nvmlDevice nvmlDevice, _type SamplingType, LastSeenTim
 	return __v
 }
 
-// nvmlDeviceGetBAR1MemoryInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetBAR1MemoryInfo(nvmlDevice nvmlDevice, Bar1Memory *BAR1Memory) Return {
+// cgo_nvmlDeviceGetBAR1MemoryInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBAR1MemoryInfo(nvmlDevice nvmlDevice, Bar1Memory *BAR1Memory) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBar1Memory, _ := (*C.nvmlBAR1Memory_t)(unsafe.Pointer(Bar1Memory)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetBAR1MemoryInfo(cnvmlDevice, cBar1Memory)
@@ -1417,8 +1417,8 @@ func nvmlDeviceGetBAR1MemoryInfo(nvmlDevice nvmlDevice, Bar1Memory *BAR1Memory)
 	return __v
 }
 
-// nvmlDeviceGetViolationStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetViolationStatus(nvmlDevice nvmlDevice, PerfPolicyType PerfPolicyType, ViolTime *ViolationTime) Return {
+// cgo_nvmlDeviceGetViolationStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetViolationStatus(nvmlDevice nvmlDevice, PerfPolicyType PerfPolicyType, ViolTime *ViolationTime) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPerfPolicyType, _ := (C.nvmlPerfPolicyType_t)(PerfPolicyType), cgoAllocsUnknown
 	cViolTime, _ := (*C.nvmlViolationTime_t)(unsafe.Pointer(ViolTime)), cgoAllocsUnknown
@@ -1427,8 +1427,8 @@ func nvmlDeviceGetViolationStatus(nvmlDevice nvmlDevice, PerfPolicyType PerfPoli
 	return __v
 }
 
-// nvmlDeviceGetIrqNum function as declared in nvml/nvml.h
-func nvmlDeviceGetIrqNum(nvmlDevice nvmlDevice, IrqNum *uint32) Return {
+// cgo_nvmlDeviceGetIrqNum function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetIrqNum(nvmlDevice nvmlDevice, IrqNum *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIrqNum, _ := (*C.uint)(unsafe.Pointer(IrqNum)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetIrqNum(cnvmlDevice, cIrqNum)
@@ -1436,8 +1436,8 @@ func nvmlDeviceGetIrqNum(nvmlDevice nvmlDevice, IrqNum *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetNumGpuCores function as declared in nvml/nvml.h
-func nvmlDeviceGetNumGpuCores(nvmlDevice nvmlDevice, NumCores *uint32) Return {
+// cgo_nvmlDeviceGetNumGpuCores function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNumGpuCores(nvmlDevice nvmlDevice, NumCores *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cNumCores, _ := (*C.uint)(unsafe.Pointer(NumCores)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNumGpuCores(cnvmlDevice, cNumCores)
@@ -1445,8 +1445,8 @@ func nvmlDeviceGetNumGpuCores(nvmlDevice nvmlDevice, NumCores *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetPowerSource function as declared in nvml/nvml.h
-func nvmlDeviceGetPowerSource(nvmlDevice nvmlDevice, PowerSource *PowerSource) Return {
+// cgo_nvmlDeviceGetPowerSource function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPowerSource(nvmlDevice nvmlDevice, PowerSource *PowerSource) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPowerSource, _ := (*C.nvmlPowerSource_t)(unsafe.Pointer(PowerSource)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPowerSource(cnvmlDevice, cPowerSource)
@@ -1454,8 +1454,8 @@ func nvmlDeviceGetPowerSource(nvmlDevice nvmlDevice, PowerSource *PowerSource) R
 	return __v
 }
 
-// nvmlDeviceGetMemoryBusWidth function as declared in nvml/nvml.h
-func nvmlDeviceGetMemoryBusWidth(nvmlDevice nvmlDevice, BusWidth *uint32) Return {
+// cgo_nvmlDeviceGetMemoryBusWidth function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMemoryBusWidth(nvmlDevice nvmlDevice, BusWidth *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBusWidth, _ := (*C.uint)(unsafe.Pointer(BusWidth)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMemoryBusWidth(cnvmlDevice, cBusWidth)
@@ -1463,8 +1463,8 @@ func nvmlDeviceGetMemoryBusWidth(nvmlDevice nvmlDevice, BusWidth *uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetPcieLinkMaxSpeed function as declared in nvml/nvml.h
-func nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice nvmlDevice, MaxSpeed *uint32) Return {
+// cgo_nvmlDeviceGetPcieLinkMaxSpeed function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice nvmlDevice, MaxSpeed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMaxSpeed, _ := (*C.uint)(unsafe.Pointer(MaxSpeed)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPcieLinkMaxSpeed(cnvmlDevice, cMaxSpeed)
@@ -1472,8 +1472,8 @@ func nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice nvmlDevice, MaxSpeed *uint32) Retu
 	return __v
 }
 
-// nvmlDeviceGetPcieSpeed function as declared in nvml/nvml.h
-func nvmlDeviceGetPcieSpeed(nvmlDevice nvmlDevice, PcieSpeed *uint32) Return {
+// cgo_nvmlDeviceGetPcieSpeed function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPcieSpeed(nvmlDevice nvmlDevice, PcieSpeed *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPcieSpeed, _ := (*C.uint)(unsafe.Pointer(PcieSpeed)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPcieSpeed(cnvmlDevice, cPcieSpeed)
@@ -1481,8 +1481,8 @@ func nvmlDeviceGetPcieSpeed(nvmlDevice nvmlDevice, PcieSpeed *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetAdaptiveClockInfoStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice nvmlDevice, AdaptiveClockStatus *uint32) Return {
+// cgo_nvmlDeviceGetAdaptiveClockInfoStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice nvmlDevice, AdaptiveClockStatus *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cAdaptiveClockStatus, _ := (*C.uint)(unsafe.Pointer(AdaptiveClockStatus)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAdaptiveClockInfoStatus(cnvmlDevice, cAdaptiveClockStatus)
@@ -1490,8 +1490,8 @@ func nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice nvmlDevice, AdaptiveClockSt
 	return __v
 }
 
-// nvmlDeviceGetBusType function as declared in nvml/nvml.h
-func nvmlDeviceGetBusType(nvmlDevice nvmlDevice, _type *BusType) Return {
+// cgo_nvmlDeviceGetBusType function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetBusType(nvmlDevice nvmlDevice, _type *BusType) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	c_type, _ := (*C.nvmlBusType_t)(unsafe.Pointer(_type)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetBusType(cnvmlDevice, c_type)
@@ -1499,8 +1499,8 @@ func nvmlDeviceGetBusType(nvmlDevice nvmlDevice, _type *BusType) Return {
 	return __v
 }
 
-// nvmlDeviceGetGpuFabricInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuFabricInfo(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfo) Return {
+// cgo_nvmlDeviceGetGpuFabricInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuFabricInfo(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpuFabricInfo, _ := (*C.nvmlGpuFabricInfo_t)(unsafe.Pointer(GpuFabricInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGpuFabricInfo(cnvmlDevice, cGpuFabricInfo)
@@ -1508,8 +1508,8 @@ func nvmlDeviceGetGpuFabricInfo(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricI
 	return __v
 }
 
-// nvmlDeviceGetGpuFabricInfoV function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuFabricInfoV(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfoV) Return {
+// cgo_nvmlDeviceGetGpuFabricInfoV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuFabricInfoV(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfoV) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpuFabricInfo, _ := (*C.nvmlGpuFabricInfoV_t)(unsafe.Pointer(GpuFabricInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGpuFabricInfoV(cnvmlDevice, cGpuFabricInfo)
@@ -1517,24 +1517,24 @@ func nvmlDeviceGetGpuFabricInfoV(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabric
 	return __v
 }
 
-// nvmlSystemGetConfComputeCapabilities function as declared in nvml/nvml.h
-func nvmlSystemGetConfComputeCapabilities(Capabilities *ConfComputeSystemCaps) Return {
+// cgo_nvmlSystemGetConfComputeCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetConfComputeCapabilities(Capabilities *ConfComputeSystemCaps) Return {
 	cCapabilities, _ := (*C.nvmlConfComputeSystemCaps_t)(unsafe.Pointer(Capabilities)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetConfComputeCapabilities(cCapabilities)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemGetConfComputeState function as declared in nvml/nvml.h
-func nvmlSystemGetConfComputeState(State *ConfComputeSystemState) Return {
+// cgo_nvmlSystemGetConfComputeState function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetConfComputeState(State *ConfComputeSystemState) Return {
 	cState, _ := (*C.nvmlConfComputeSystemState_t)(unsafe.Pointer(State)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetConfComputeState(cState)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetConfComputeMemSizeInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice nvmlDevice, MemInfo *ConfComputeMemSizeInfo) Return {
+// cgo_nvmlDeviceGetConfComputeMemSizeInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice nvmlDevice, MemInfo *ConfComputeMemSizeInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemInfo, _ := (*C.nvmlConfComputeMemSizeInfo_t)(unsafe.Pointer(MemInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetConfComputeMemSizeInfo(cnvmlDevice, cMemInfo)
@@ -1542,16 +1542,16 @@ func nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice nvmlDevice, MemInfo *ConfCom
 	return __v
 }
 
-// nvmlSystemGetConfComputeGpusReadyState function as declared in nvml/nvml.h
-func nvmlSystemGetConfComputeGpusReadyState(IsAcceptingWork *uint32) Return {
+// cgo_nvmlSystemGetConfComputeGpusReadyState function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetConfComputeGpusReadyState(IsAcceptingWork *uint32) Return {
 	cIsAcceptingWork, _ := (*C.uint)(unsafe.Pointer(IsAcceptingWork)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetConfComputeGpusReadyState(cIsAcceptingWork)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetConfComputeProtectedMemoryUsage function as declared in nvml/nvml.h
-func nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice nvmlDevice, Memory *Memory) Return {
+// cgo_nvmlDeviceGetConfComputeProtectedMemoryUsage function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice nvmlDevice, Memory *Memory) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemory, _ := (*C.nvmlMemory_t)(unsafe.Pointer(Memory)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetConfComputeProtectedMemoryUsage(cnvmlDevice, cMemory)
@@ -1559,8 +1559,8 @@ func nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice nvmlDevice, Memory
 	return __v
 }
 
-// nvmlDeviceGetConfComputeGpuCertificate function as declared in nvml/nvml.h
-func nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice nvmlDevice, GpuCert *ConfComputeGpuCertificate) Return {
+// cgo_nvmlDeviceGetConfComputeGpuCertificate function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice nvmlDevice, GpuCert *ConfComputeGpuCertificate) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpuCert, _ := (*C.nvmlConfComputeGpuCertificate_t)(unsafe.Pointer(GpuCert)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetConfComputeGpuCertificate(cnvmlDevice, cGpuCert)
@@ -1568,8 +1568,8 @@ func nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice nvmlDevice, GpuCert *Conf
 	return __v
 }
 
-// nvmlDeviceGetConfComputeGpuAttestationReport function as declared in nvml/nvml.h
-func nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice nvmlDevice, GpuAtstReport *ConfComputeGpuAttestationReport) Return {
+// cgo_nvmlDeviceGetConfComputeGpuAttestationReport function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice nvmlDevice, GpuAtstReport *ConfComputeGpuAttestationReport) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpuAtstReport, _ := (*C.nvmlConfComputeGpuAttestationReport_t)(unsafe.Pointer(GpuAtstReport)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetConfComputeGpuAttestationReport(cnvmlDevice, cGpuAtstReport)
@@ -1577,16 +1577,16 @@ func nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice nvmlDevice, GpuAtst
 	return __v
 }
 
-// nvmlSystemGetConfComputeKeyRotationThresholdInfo function as declared in nvml/nvml.h
-func nvmlSystemGetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeGetKeyRotationThresholdInfo) Return {
+// cgo_nvmlSystemGetConfComputeKeyRotationThresholdInfo function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeGetKeyRotationThresholdInfo) Return {
 	cPKeyRotationThrInfo, _ := (*C.nvmlConfComputeGetKeyRotationThresholdInfo_t)(unsafe.Pointer(PKeyRotationThrInfo)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetConfComputeKeyRotationThresholdInfo(cPKeyRotationThrInfo)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceSetConfComputeUnprotectedMemSize function as declared in nvml/nvml.h
-func nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice nvmlDevice, SizeKiB uint64) Return {
+// cgo_nvmlDeviceSetConfComputeUnprotectedMemSize function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice nvmlDevice, SizeKiB uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSizeKiB, _ := (C.ulonglong)(SizeKiB), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetConfComputeUnprotectedMemSize(cnvmlDevice, cSizeKiB)
@@ -1594,32 +1594,32 @@ func nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice nvmlDevice, SizeKiB u
 	return __v
 }
 
-// nvmlSystemSetConfComputeGpusReadyState function as declared in nvml/nvml.h
-func nvmlSystemSetConfComputeGpusReadyState(IsAcceptingWork uint32) Return {
+// cgo_nvmlSystemSetConfComputeGpusReadyState function as declared in nvml/nvml.h
+func cgo_nvmlSystemSetConfComputeGpusReadyState(IsAcceptingWork uint32) Return {
 	cIsAcceptingWork, _ := (C.uint)(IsAcceptingWork), cgoAllocsUnknown
 	__ret := C.nvmlSystemSetConfComputeGpusReadyState(cIsAcceptingWork)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemSetConfComputeKeyRotationThresholdInfo function as declared in nvml/nvml.h
-func nvmlSystemSetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeSetKeyRotationThresholdInfo) Return {
+// cgo_nvmlSystemSetConfComputeKeyRotationThresholdInfo function as declared in nvml/nvml.h
+func cgo_nvmlSystemSetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeSetKeyRotationThresholdInfo) Return {
 	cPKeyRotationThrInfo, _ := (*C.nvmlConfComputeSetKeyRotationThresholdInfo_t)(unsafe.Pointer(PKeyRotationThrInfo)), cgoAllocsUnknown
 	__ret := C.nvmlSystemSetConfComputeKeyRotationThresholdInfo(cPKeyRotationThrInfo)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemGetConfComputeSettings function as declared in nvml/nvml.h
-func nvmlSystemGetConfComputeSettings(Settings *SystemConfComputeSettings) Return {
+// cgo_nvmlSystemGetConfComputeSettings function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetConfComputeSettings(Settings *SystemConfComputeSettings) Return {
 	cSettings, _ := (*C.nvmlSystemConfComputeSettings_t)(unsafe.Pointer(Settings)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetConfComputeSettings(cSettings)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetGspFirmwareVersion function as declared in nvml/nvml.h
-func nvmlDeviceGetGspFirmwareVersion(nvmlDevice nvmlDevice, Version *byte) Return {
+// cgo_nvmlDeviceGetGspFirmwareVersion function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGspFirmwareVersion(nvmlDevice nvmlDevice, Version *byte) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGspFirmwareVersion(cnvmlDevice, cVersion)
@@ -1627,8 +1627,8 @@ func nvmlDeviceGetGspFirmwareVersion(nvmlDevice nvmlDevice, Version *byte) Retur
 	return __v
 }
 
-// nvmlDeviceGetGspFirmwareMode function as declared in nvml/nvml.h
-func nvmlDeviceGetGspFirmwareMode(nvmlDevice nvmlDevice, IsEnabled *uint32, DefaultMode *uint32) Return {
+// cgo_nvmlDeviceGetGspFirmwareMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGspFirmwareMode(nvmlDevice nvmlDevice, IsEnabled *uint32, DefaultMode *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIsEnabled, _ := (*C.uint)(unsafe.Pointer(IsEnabled)), cgoAllocsUnknown
 	cDefaultMode, _ := (*C.uint)(unsafe.Pointer(DefaultMode)), cgoAllocsUnknown
@@ -1637,8 +1637,8 @@ func nvmlDeviceGetGspFirmwareMode(nvmlDevice nvmlDevice, IsEnabled *uint32, Defa
 	return __v
 }
 
-// nvmlDeviceGetSramEccErrorStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetSramEccErrorStatus(nvmlDevice nvmlDevice, Status *EccSramErrorStatus) Return {
+// cgo_nvmlDeviceGetSramEccErrorStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSramEccErrorStatus(nvmlDevice nvmlDevice, Status *EccSramErrorStatus) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cStatus, _ := (*C.nvmlEccSramErrorStatus_t)(unsafe.Pointer(Status)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetSramEccErrorStatus(cnvmlDevice, cStatus)
@@ -1646,8 +1646,8 @@ func nvmlDeviceGetSramEccErrorStatus(nvmlDevice nvmlDevice, Status *EccSramError
 	return __v
 }
 
-// nvmlDeviceSetPowerManagementLimit_v2 function as declared in nvml/nvml.h
-func nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice nvmlDevice, PowerValue *PowerValue_v2) Return {
+// cgo_nvmlDeviceSetPowerManagementLimit_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice nvmlDevice, PowerValue *PowerValue_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPowerValue, _ := (*C.nvmlPowerValue_v2_t)(unsafe.Pointer(PowerValue)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetPowerManagementLimit_v2(cnvmlDevice, cPowerValue)
@@ -1655,8 +1655,8 @@ func nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice nvmlDevice, PowerValue *Pow
 	return __v
 }
 
-// nvmlDeviceGetAccountingMode function as declared in nvml/nvml.h
-func nvmlDeviceGetAccountingMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+// cgo_nvmlDeviceGetAccountingMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAccountingMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAccountingMode(cnvmlDevice, cMode)
@@ -1664,8 +1664,8 @@ func nvmlDeviceGetAccountingMode(nvmlDevice nvmlDevice, Mode *EnableState) Retur
 	return __v
 }
 
-// nvmlDeviceGetAccountingStats function as declared in nvml/nvml.h
-func nvmlDeviceGetAccountingStats(nvmlDevice nvmlDevice, Pid uint32, Stats *AccountingStats) Return {
+// cgo_nvmlDeviceGetAccountingStats function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAccountingStats(nvmlDevice nvmlDevice, Pid uint32, Stats *AccountingStats) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPid, _ := (C.uint)(Pid), cgoAllocsUnknown
 	cStats, _ := (*C.nvmlAccountingStats_t)(unsafe.Pointer(Stats)), cgoAllocsUnknown
@@ -1674,8 +1674,8 @@ func nvmlDeviceGetAccountingStats(nvmlDevice nvmlDevice, Pid uint32, Stats *Acco
 	return __v
 }
 
-// nvmlDeviceGetAccountingPids function as declared in nvml/nvml.h
-func nvmlDeviceGetAccountingPids(nvmlDevice nvmlDevice, Count *uint32, Pids *uint32) Return {
+// cgo_nvmlDeviceGetAccountingPids function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAccountingPids(nvmlDevice nvmlDevice, Count *uint32, Pids *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
 	cPids, _ := (*C.uint)(unsafe.Pointer(Pids)), cgoAllocsUnknown
@@ -1684,8 +1684,8 @@ func nvmlDeviceGetAccountingPids(nvmlDevice nvmlDevice, Count *uint32, Pids *uin
 	return __v
 }
 
-// nvmlDeviceGetAccountingBufferSize function as declared in nvml/nvml.h
-func nvmlDeviceGetAccountingBufferSize(nvmlDevice nvmlDevice, BufferSize *uint32) Return {
+// cgo_nvmlDeviceGetAccountingBufferSize function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAccountingBufferSize(nvmlDevice nvmlDevice, BufferSize *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBufferSize, _ := (*C.uint)(unsafe.Pointer(BufferSize)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAccountingBufferSize(cnvmlDevice, cBufferSize)
@@ -1693,8 +1693,8 @@ func nvmlDeviceGetAccountingBufferSize(nvmlDevice nvmlDevice, BufferSize *uint32
 	return __v
 }
 
-// nvmlDeviceGetRetiredPages function as declared in nvml/nvml.h
-func nvmlDeviceGetRetiredPages(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64) Return {
+// cgo_nvmlDeviceGetRetiredPages function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRetiredPages(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCause, _ := (C.nvmlPageRetirementCause_t)(Cause), cgoAllocsUnknown
 	cPageCount, _ := (*C.uint)(unsafe.Pointer(PageCount)), cgoAllocsUnknown
@@ -1704,8 +1704,8 @@ func nvmlDeviceGetRetiredPages(nvmlDevice nvmlDevice, Cause PageRetirementCause,
 	return __v
 }
 
-// nvmlDeviceGetRetiredPages_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetRetiredPages_v2(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64, Timestamps *uint64) Return {
+// cgo_nvmlDeviceGetRetiredPages_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRetiredPages_v2(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64, Timestamps *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCause, _ := (C.nvmlPageRetirementCause_t)(Cause), cgoAllocsUnknown
 	cPageCount, _ := (*C.uint)(unsafe.Pointer(PageCount)), cgoAllocsUnknown
@@ -1716,8 +1716,8 @@ func nvmlDeviceGetRetiredPages_v2(nvmlDevice nvmlDevice, Cause PageRetirementCau
 	return __v
 }
 
-// nvmlDeviceGetRetiredPagesPendingStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice nvmlDevice, IsPending *EnableState) Return {
+// cgo_nvmlDeviceGetRetiredPagesPendingStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice nvmlDevice, IsPending *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIsPending, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(IsPending)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetRetiredPagesPendingStatus(cnvmlDevice, cIsPending)
@@ -1725,8 +1725,8 @@ func nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice nvmlDevice, IsPending *En
 	return __v
 }
 
-// nvmlDeviceGetRemappedRows function as declared in nvml/nvml.h
-func nvmlDeviceGetRemappedRows(nvmlDevice nvmlDevice, CorrRows *uint32, UncRows *uint32, IsPending *uint32, FailureOccurred *uint32) Return {
+// cgo_nvmlDeviceGetRemappedRows function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRemappedRows(nvmlDevice nvmlDevice, CorrRows *uint32, UncRows *uint32, IsPending *uint32, FailureOccurred *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCorrRows, _ := (*C.uint)(unsafe.Pointer(CorrRows)), cgoAllocsUnknown
 	cUncRows, _ := (*C.uint)(unsafe.Pointer(UncRows)), cgoAllocsUnknown
@@ -1737,8 +1737,8 @@ func nvmlDeviceGetRemappedRows(nvmlDevice nvmlDevice, CorrRows *uint32, UncRows
 	return __v
 }
 
-// nvmlDeviceGetRowRemapperHistogram function as declared in nvml/nvml.h
-func nvmlDeviceGetRowRemapperHistogram(nvmlDevice nvmlDevice, Values *RowRemapperHistogramValues) Return {
+// cgo_nvmlDeviceGetRowRemapperHistogram function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetRowRemapperHistogram(nvmlDevice nvmlDevice, Values *RowRemapperHistogramValues) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cValues, _ := (*C.nvmlRowRemapperHistogramValues_t)(unsafe.Pointer(Values)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetRowRemapperHistogram(cnvmlDevice, cValues)
@@ -1746,8 +1746,8 @@ func nvmlDeviceGetRowRemapperHistogram(nvmlDevice nvmlDevice, Values *RowRemappe
 	return __v
 }
 
-// nvmlDeviceGetArchitecture function as declared in nvml/nvml.h
-func nvmlDeviceGetArchitecture(nvmlDevice nvmlDevice, Arch *DeviceArchitecture) Return {
+// cgo_nvmlDeviceGetArchitecture function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetArchitecture(nvmlDevice nvmlDevice, Arch *DeviceArchitecture) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cArch, _ := (*C.nvmlDeviceArchitecture_t)(unsafe.Pointer(Arch)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetArchitecture(cnvmlDevice, cArch)
@@ -1755,8 +1755,8 @@ func nvmlDeviceGetArchitecture(nvmlDevice nvmlDevice, Arch *DeviceArchitecture)
 	return __v
 }
 
-// nvmlDeviceGetClkMonStatus function as declared in nvml/nvml.h
-func nvmlDeviceGetClkMonStatus(nvmlDevice nvmlDevice, Status *ClkMonStatus) Return {
+// cgo_nvmlDeviceGetClkMonStatus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetClkMonStatus(nvmlDevice nvmlDevice, Status *ClkMonStatus) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cStatus, _ := (*C.nvmlClkMonStatus_t)(unsafe.Pointer(Status)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetClkMonStatus(cnvmlDevice, cStatus)
@@ -1764,8 +1764,8 @@ func nvmlDeviceGetClkMonStatus(nvmlDevice nvmlDevice, Status *ClkMonStatus) Retu
 	return __v
 }
 
-// nvmlDeviceGetProcessUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetProcessUtilization(nvmlDevice nvmlDevice, Utilization *ProcessUtilizationSample, ProcessSamplesCount *uint32, LastSeenTimeStamp uint64) Return {
+// cgo_nvmlDeviceGetProcessUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetProcessUtilization(nvmlDevice nvmlDevice, Utilization *ProcessUtilizationSample, ProcessSamplesCount *uint32, LastSeenTimeStamp uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cUtilization, _ := (*C.nvmlProcessUtilizationSample_t)(unsafe.Pointer(Utilization)), cgoAllocsUnknown
 	cProcessSamplesCount, _ := (*C.uint)(unsafe.Pointer(ProcessSamplesCount)), cgoAllocsUnknown
@@ -1775,8 +1775,8 @@ func nvmlDeviceGetProcessUtilization(nvmlDevice nvmlDevice, Utilization *Process
 	return __v
 }
 
-// nvmlDeviceGetProcessesUtilizationInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice nvmlDevice, ProcesesUtilInfo *ProcessesUtilizationInfo) Return {
+// cgo_nvmlDeviceGetProcessesUtilizationInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice nvmlDevice, ProcesesUtilInfo *ProcessesUtilizationInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProcesesUtilInfo, _ := (*C.nvmlProcessesUtilizationInfo_t)(unsafe.Pointer(ProcesesUtilInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetProcessesUtilizationInfo(cnvmlDevice, cProcesesUtilInfo)
@@ -1784,8 +1784,8 @@ func nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice nvmlDevice, ProcesesUtilIn
 	return __v
 }
 
-// nvmlDeviceGetPlatformInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetPlatformInfo(nvmlDevice nvmlDevice, PlatformInfo *PlatformInfo) Return {
+// cgo_nvmlDeviceGetPlatformInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPlatformInfo(nvmlDevice nvmlDevice, PlatformInfo *PlatformInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPlatformInfo, _ := (*C.nvmlPlatformInfo_t)(unsafe.Pointer(PlatformInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPlatformInfo(cnvmlDevice, cPlatformInfo)
@@ -1793,8 +1793,8 @@ func nvmlDeviceGetPlatformInfo(nvmlDevice nvmlDevice, PlatformInfo *PlatformInfo
 	return __v
 }
 
-// nvmlDeviceGetPdi function as declared in nvml/nvml.h
-func nvmlDeviceGetPdi(nvmlDevice nvmlDevice, Pdi *Pdi) Return {
+// cgo_nvmlDeviceGetPdi function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPdi(nvmlDevice nvmlDevice, Pdi *Pdi) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPdi, _ := (*C.nvmlPdi_t)(unsafe.Pointer(Pdi)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPdi(cnvmlDevice, cPdi)
@@ -1802,8 +1802,8 @@ func nvmlDeviceGetPdi(nvmlDevice nvmlDevice, Pdi *Pdi) Return {
 	return __v
 }
 
-// nvmlUnitSetLedState function as declared in nvml/nvml.h
-func nvmlUnitSetLedState(nvmlUnit nvmlUnit, Color LedColor) Return {
+// cgo_nvmlUnitSetLedState function as declared in nvml/nvml.h
+func cgo_nvmlUnitSetLedState(nvmlUnit nvmlUnit, Color LedColor) Return {
 	cnvmlUnit, _ := *(*C.nvmlUnit_t)(unsafe.Pointer(&nvmlUnit)), cgoAllocsUnknown
 	cColor, _ := (C.nvmlLedColor_t)(Color), cgoAllocsUnknown
 	__ret := C.nvmlUnitSetLedState(cnvmlUnit, cColor)
@@ -1811,8 +1811,8 @@ func nvmlUnitSetLedState(nvmlUnit nvmlUnit, Color LedColor) Return {
 	return __v
 }
 
-// nvmlDeviceSetPersistenceMode function as declared in nvml/nvml.h
-func nvmlDeviceSetPersistenceMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+// cgo_nvmlDeviceSetPersistenceMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetPersistenceMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (C.nvmlEnableState_t)(Mode), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetPersistenceMode(cnvmlDevice, cMode)
@@ -1820,8 +1820,8 @@ func nvmlDeviceSetPersistenceMode(nvmlDevice nvmlDevice, Mode EnableState) Retur
 	return __v
 }
 
-// nvmlDeviceSetComputeMode function as declared in nvml/nvml.h
-func nvmlDeviceSetComputeMode(nvmlDevice nvmlDevice, Mode ComputeMode) Return {
+// cgo_nvmlDeviceSetComputeMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetComputeMode(nvmlDevice nvmlDevice, Mode ComputeMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (C.nvmlComputeMode_t)(Mode), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetComputeMode(cnvmlDevice, cMode)
@@ -1829,8 +1829,8 @@ func nvmlDeviceSetComputeMode(nvmlDevice nvmlDevice, Mode ComputeMode) Return {
 	return __v
 }
 
-// nvmlDeviceSetEccMode function as declared in nvml/nvml.h
-func nvmlDeviceSetEccMode(nvmlDevice nvmlDevice, Ecc EnableState) Return {
+// cgo_nvmlDeviceSetEccMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetEccMode(nvmlDevice nvmlDevice, Ecc EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEcc, _ := (C.nvmlEnableState_t)(Ecc), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetEccMode(cnvmlDevice, cEcc)
@@ -1838,8 +1838,8 @@ func nvmlDeviceSetEccMode(nvmlDevice nvmlDevice, Ecc EnableState) Return {
 	return __v
 }
 
-// nvmlDeviceClearEccErrorCounts function as declared in nvml/nvml.h
-func nvmlDeviceClearEccErrorCounts(nvmlDevice nvmlDevice, CounterType EccCounterType) Return {
+// cgo_nvmlDeviceClearEccErrorCounts function as declared in nvml/nvml.h
+func cgo_nvmlDeviceClearEccErrorCounts(nvmlDevice nvmlDevice, CounterType EccCounterType) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCounterType, _ := (C.nvmlEccCounterType_t)(CounterType), cgoAllocsUnknown
 	__ret := C.nvmlDeviceClearEccErrorCounts(cnvmlDevice, cCounterType)
@@ -1847,8 +1847,8 @@ func nvmlDeviceClearEccErrorCounts(nvmlDevice nvmlDevice, CounterType EccCounter
 	return __v
 }
 
-// nvmlDeviceSetDriverModel function as declared in nvml/nvml.h
-func nvmlDeviceSetDriverModel(nvmlDevice nvmlDevice, DriverModel DriverModel, Flags uint32) Return {
+// cgo_nvmlDeviceSetDriverModel function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetDriverModel(nvmlDevice nvmlDevice, DriverModel DriverModel, Flags uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cDriverModel, _ := (C.nvmlDriverModel_t)(DriverModel), cgoAllocsUnknown
 	cFlags, _ := (C.uint)(Flags), cgoAllocsUnknown
@@ -1857,8 +1857,8 @@ func nvmlDeviceSetDriverModel(nvmlDevice nvmlDevice, DriverModel DriverModel, Fl
 	return __v
 }
 
-// nvmlDeviceSetGpuLockedClocks function as declared in nvml/nvml.h
-func nvmlDeviceSetGpuLockedClocks(nvmlDevice nvmlDevice, MinGpuClockMHz uint32, MaxGpuClockMHz uint32) Return {
+// cgo_nvmlDeviceSetGpuLockedClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetGpuLockedClocks(nvmlDevice nvmlDevice, MinGpuClockMHz uint32, MaxGpuClockMHz uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinGpuClockMHz, _ := (C.uint)(MinGpuClockMHz), cgoAllocsUnknown
 	cMaxGpuClockMHz, _ := (C.uint)(MaxGpuClockMHz), cgoAllocsUnknown
@@ -1867,16 +1867,16 @@ func nvmlDeviceSetGpuLockedClocks(nvmlDevice nvmlDevice, MinGpuClockMHz uint32,
 	return __v
 }
 
-// nvmlDeviceResetGpuLockedClocks function as declared in nvml/nvml.h
-func nvmlDeviceResetGpuLockedClocks(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceResetGpuLockedClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceResetGpuLockedClocks(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceResetGpuLockedClocks(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceSetMemoryLockedClocks function as declared in nvml/nvml.h
-func nvmlDeviceSetMemoryLockedClocks(nvmlDevice nvmlDevice, MinMemClockMHz uint32, MaxMemClockMHz uint32) Return {
+// cgo_nvmlDeviceSetMemoryLockedClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetMemoryLockedClocks(nvmlDevice nvmlDevice, MinMemClockMHz uint32, MaxMemClockMHz uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMinMemClockMHz, _ := (C.uint)(MinMemClockMHz), cgoAllocsUnknown
 	cMaxMemClockMHz, _ := (C.uint)(MaxMemClockMHz), cgoAllocsUnknown
@@ -1885,16 +1885,16 @@ func nvmlDeviceSetMemoryLockedClocks(nvmlDevice nvmlDevice, MinMemClockMHz uint3
 	return __v
 }
 
-// nvmlDeviceResetMemoryLockedClocks function as declared in nvml/nvml.h
-func nvmlDeviceResetMemoryLockedClocks(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceResetMemoryLockedClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceResetMemoryLockedClocks(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceResetMemoryLockedClocks(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceSetApplicationsClocks function as declared in nvml/nvml.h
-func nvmlDeviceSetApplicationsClocks(nvmlDevice nvmlDevice, MemClockMHz uint32, GraphicsClockMHz uint32) Return {
+// cgo_nvmlDeviceSetApplicationsClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetApplicationsClocks(nvmlDevice nvmlDevice, MemClockMHz uint32, GraphicsClockMHz uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMemClockMHz, _ := (C.uint)(MemClockMHz), cgoAllocsUnknown
 	cGraphicsClockMHz, _ := (C.uint)(GraphicsClockMHz), cgoAllocsUnknown
@@ -1903,16 +1903,16 @@ func nvmlDeviceSetApplicationsClocks(nvmlDevice nvmlDevice, MemClockMHz uint32,
 	return __v
 }
 
-// nvmlDeviceResetApplicationsClocks function as declared in nvml/nvml.h
-func nvmlDeviceResetApplicationsClocks(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceResetApplicationsClocks function as declared in nvml/nvml.h
+func cgo_nvmlDeviceResetApplicationsClocks(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceResetApplicationsClocks(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceSetAutoBoostedClocksEnabled function as declared in nvml/nvml.h
-func nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState) Return {
+// cgo_nvmlDeviceSetAutoBoostedClocksEnabled function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEnabled, _ := (C.nvmlEnableState_t)(Enabled), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetAutoBoostedClocksEnabled(cnvmlDevice, cEnabled)
@@ -1920,8 +1920,8 @@ func nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled Enable
 	return __v
 }
 
-// nvmlDeviceSetDefaultAutoBoostedClocksEnabled function as declared in nvml/nvml.h
-func nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState, Flags uint32) Return {
+// cgo_nvmlDeviceSetDefaultAutoBoostedClocksEnabled function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState, Flags uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEnabled, _ := (C.nvmlEnableState_t)(Enabled), cgoAllocsUnknown
 	cFlags, _ := (C.uint)(Flags), cgoAllocsUnknown
@@ -1930,8 +1930,8 @@ func nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled
 	return __v
 }
 
-// nvmlDeviceSetDefaultFanSpeed_v2 function as declared in nvml/nvml.h
-func nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32) Return {
+// cgo_nvmlDeviceSetDefaultFanSpeed_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetDefaultFanSpeed_v2(cnvmlDevice, cFan)
@@ -1939,8 +1939,8 @@ func nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32) Return {
 	return __v
 }
 
-// nvmlDeviceSetFanControlPolicy function as declared in nvml/nvml.h
-func nvmlDeviceSetFanControlPolicy(nvmlDevice nvmlDevice, Fan uint32, Policy FanControlPolicy) Return {
+// cgo_nvmlDeviceSetFanControlPolicy function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetFanControlPolicy(nvmlDevice nvmlDevice, Fan uint32, Policy FanControlPolicy) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	cPolicy, _ := (C.nvmlFanControlPolicy_t)(Policy), cgoAllocsUnknown
@@ -1949,8 +1949,8 @@ func nvmlDeviceSetFanControlPolicy(nvmlDevice nvmlDevice, Fan uint32, Policy Fan
 	return __v
 }
 
-// nvmlDeviceSetTemperatureThreshold function as declared in nvml/nvml.h
-func nvmlDeviceSetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *int32) Return {
+// cgo_nvmlDeviceSetTemperatureThreshold function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cThresholdType, _ := (C.nvmlTemperatureThresholds_t)(ThresholdType), cgoAllocsUnknown
 	cTemp, _ := (*C.int)(unsafe.Pointer(Temp)), cgoAllocsUnknown
@@ -1959,8 +1959,8 @@ func nvmlDeviceSetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType Temp
 	return __v
 }
 
-// nvmlDeviceSetPowerManagementLimit function as declared in nvml/nvml.h
-func nvmlDeviceSetPowerManagementLimit(nvmlDevice nvmlDevice, Limit uint32) Return {
+// cgo_nvmlDeviceSetPowerManagementLimit function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetPowerManagementLimit(nvmlDevice nvmlDevice, Limit uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLimit, _ := (C.uint)(Limit), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetPowerManagementLimit(cnvmlDevice, cLimit)
@@ -1968,8 +1968,8 @@ func nvmlDeviceSetPowerManagementLimit(nvmlDevice nvmlDevice, Limit uint32) Retu
 	return __v
 }
 
-// nvmlDeviceSetGpuOperationMode function as declared in nvml/nvml.h
-func nvmlDeviceSetGpuOperationMode(nvmlDevice nvmlDevice, Mode GpuOperationMode) Return {
+// cgo_nvmlDeviceSetGpuOperationMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetGpuOperationMode(nvmlDevice nvmlDevice, Mode GpuOperationMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (C.nvmlGpuOperationMode_t)(Mode), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetGpuOperationMode(cnvmlDevice, cMode)
@@ -1977,8 +1977,8 @@ func nvmlDeviceSetGpuOperationMode(nvmlDevice nvmlDevice, Mode GpuOperationMode)
 	return __v
 }
 
-// nvmlDeviceSetAPIRestriction function as declared in nvml/nvml.h
-func nvmlDeviceSetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted EnableState) Return {
+// cgo_nvmlDeviceSetAPIRestriction function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cApiType, _ := (C.nvmlRestrictedAPI_t)(ApiType), cgoAllocsUnknown
 	cIsRestricted, _ := (C.nvmlEnableState_t)(IsRestricted), cgoAllocsUnknown
@@ -1987,8 +1987,8 @@ func nvmlDeviceSetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, I
 	return __v
 }
 
-// nvmlDeviceSetFanSpeed_v2 function as declared in nvml/nvml.h
-func nvmlDeviceSetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed uint32) Return {
+// cgo_nvmlDeviceSetFanSpeed_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cFan, _ := (C.uint)(Fan), cgoAllocsUnknown
 	cSpeed, _ := (C.uint)(Speed), cgoAllocsUnknown
@@ -1997,8 +1997,8 @@ func nvmlDeviceSetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed uint32) R
 	return __v
 }
 
-// nvmlDeviceSetGpcClkVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceSetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+// cgo_nvmlDeviceSetGpcClkVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cOffset, _ := (C.int)(Offset), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetGpcClkVfOffset(cnvmlDevice, cOffset)
@@ -2006,8 +2006,8 @@ func nvmlDeviceSetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
 	return __v
 }
 
-// nvmlDeviceSetMemClkVfOffset function as declared in nvml/nvml.h
-func nvmlDeviceSetMemClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+// cgo_nvmlDeviceSetMemClkVfOffset function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetMemClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cOffset, _ := (C.int)(Offset), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetMemClkVfOffset(cnvmlDevice, cOffset)
@@ -2015,8 +2015,8 @@ func nvmlDeviceSetMemClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
 	return __v
 }
 
-// nvmlDeviceSetAccountingMode function as declared in nvml/nvml.h
-func nvmlDeviceSetAccountingMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+// cgo_nvmlDeviceSetAccountingMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetAccountingMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (C.nvmlEnableState_t)(Mode), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetAccountingMode(cnvmlDevice, cMode)
@@ -2024,16 +2024,16 @@ func nvmlDeviceSetAccountingMode(nvmlDevice nvmlDevice, Mode EnableState) Return
 	return __v
 }
 
-// nvmlDeviceClearAccountingPids function as declared in nvml/nvml.h
-func nvmlDeviceClearAccountingPids(nvmlDevice nvmlDevice) Return {
+// cgo_nvmlDeviceClearAccountingPids function as declared in nvml/nvml.h
+func cgo_nvmlDeviceClearAccountingPids(nvmlDevice nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceClearAccountingPids(cnvmlDevice)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetNvLinkState function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkState(nvmlDevice nvmlDevice, Link uint32, IsActive *EnableState) Return {
+// cgo_nvmlDeviceGetNvLinkState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkState(nvmlDevice nvmlDevice, Link uint32, IsActive *EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cIsActive, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(IsActive)), cgoAllocsUnknown
@@ -2042,8 +2042,8 @@ func nvmlDeviceGetNvLinkState(nvmlDevice nvmlDevice, Link uint32, IsActive *Enab
 	return __v
 }
 
-// nvmlDeviceGetNvLinkVersion function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkVersion(nvmlDevice nvmlDevice, Link uint32, Version *uint32) Return {
+// cgo_nvmlDeviceGetNvLinkVersion function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkVersion(nvmlDevice nvmlDevice, Link uint32, Version *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cVersion, _ := (*C.uint)(unsafe.Pointer(Version)), cgoAllocsUnknown
@@ -2052,8 +2052,8 @@ func nvmlDeviceGetNvLinkVersion(nvmlDevice nvmlDevice, Link uint32, Version *uin
 	return __v
 }
 
-// nvmlDeviceGetNvLinkCapability function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkCapability(nvmlDevice nvmlDevice, Link uint32, Capability NvLinkCapability, CapResult *uint32) Return {
+// cgo_nvmlDeviceGetNvLinkCapability function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkCapability(nvmlDevice nvmlDevice, Link uint32, Capability NvLinkCapability, CapResult *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCapability, _ := (C.nvmlNvLinkCapability_t)(Capability), cgoAllocsUnknown
@@ -2063,8 +2063,8 @@ func nvmlDeviceGetNvLinkCapability(nvmlDevice nvmlDevice, Link uint32, Capabilit
 	return __v
 }
 
-// nvmlDeviceGetNvLinkRemotePciInfo_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+// cgo_nvmlDeviceGetNvLinkRemotePciInfo_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
@@ -2073,8 +2073,8 @@ func nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice nvmlDevice, Link uint32, Pci
 	return __v
 }
 
-// nvmlDeviceGetNvLinkErrorCounter function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkErrorCounter(nvmlDevice nvmlDevice, Link uint32, Counter NvLinkErrorCounter, CounterValue *uint64) Return {
+// cgo_nvmlDeviceGetNvLinkErrorCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkErrorCounter(nvmlDevice nvmlDevice, Link uint32, Counter NvLinkErrorCounter, CounterValue *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.nvmlNvLinkErrorCounter_t)(Counter), cgoAllocsUnknown
@@ -2084,8 +2084,8 @@ func nvmlDeviceGetNvLinkErrorCounter(nvmlDevice nvmlDevice, Link uint32, Counter
 	return __v
 }
 
-// nvmlDeviceResetNvLinkErrorCounters function as declared in nvml/nvml.h
-func nvmlDeviceResetNvLinkErrorCounters(nvmlDevice nvmlDevice, Link uint32) Return {
+// cgo_nvmlDeviceResetNvLinkErrorCounters function as declared in nvml/nvml.h
+func cgo_nvmlDeviceResetNvLinkErrorCounters(nvmlDevice nvmlDevice, Link uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	__ret := C.nvmlDeviceResetNvLinkErrorCounters(cnvmlDevice, cLink)
@@ -2093,8 +2093,8 @@ func nvmlDeviceResetNvLinkErrorCounters(nvmlDevice nvmlDevice, Link uint32) Retu
 	return __v
 }
 
-// nvmlDeviceSetNvLinkUtilizationControl function as declared in nvml/nvml.h
-func nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl, Reset uint32) Return {
+// cgo_nvmlDeviceSetNvLinkUtilizationControl function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl, Reset uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.uint)(Counter), cgoAllocsUnknown
@@ -2105,8 +2105,8 @@ func nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, C
 	return __v
 }
 
-// nvmlDeviceGetNvLinkUtilizationControl function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl) Return {
+// cgo_nvmlDeviceGetNvLinkUtilizationControl function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.uint)(Counter), cgoAllocsUnknown
@@ -2116,8 +2116,8 @@ func nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, C
 	return __v
 }
 
-// nvmlDeviceGetNvLinkUtilizationCounter function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Rxcounter *uint64, Txcounter *uint64) Return {
+// cgo_nvmlDeviceGetNvLinkUtilizationCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Rxcounter *uint64, Txcounter *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.uint)(Counter), cgoAllocsUnknown
@@ -2128,8 +2128,8 @@ func nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, C
 	return __v
 }
 
-// nvmlDeviceFreezeNvLinkUtilizationCounter function as declared in nvml/nvml.h
-func nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Freeze EnableState) Return {
+// cgo_nvmlDeviceFreezeNvLinkUtilizationCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Freeze EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.uint)(Counter), cgoAllocsUnknown
@@ -2139,8 +2139,8 @@ func nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32
 	return __v
 }
 
-// nvmlDeviceResetNvLinkUtilizationCounter function as declared in nvml/nvml.h
-func nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32) Return {
+// cgo_nvmlDeviceResetNvLinkUtilizationCounter function as declared in nvml/nvml.h
+func cgo_nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cCounter, _ := (C.uint)(Counter), cgoAllocsUnknown
@@ -2149,8 +2149,8 @@ func nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32,
 	return __v
 }
 
-// nvmlDeviceGetNvLinkRemoteDeviceType function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice nvmlDevice, Link uint32, PNvLinkDeviceType *IntNvLinkDeviceType) Return {
+// cgo_nvmlDeviceGetNvLinkRemoteDeviceType function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice nvmlDevice, Link uint32, PNvLinkDeviceType *IntNvLinkDeviceType) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cPNvLinkDeviceType, _ := (*C.nvmlIntNvLinkDeviceType_t)(unsafe.Pointer(PNvLinkDeviceType)), cgoAllocsUnknown
@@ -2159,8 +2159,8 @@ func nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice nvmlDevice, Link uint32, PNv
 	return __v
 }
 
-// nvmlDeviceSetNvLinkDeviceLowPowerThreshold function as declared in nvml/nvml.h
-func nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice nvmlDevice, Info *NvLinkPowerThres) Return {
+// cgo_nvmlDeviceSetNvLinkDeviceLowPowerThreshold function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice nvmlDevice, Info *NvLinkPowerThres) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlNvLinkPowerThres_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetNvLinkDeviceLowPowerThreshold(cnvmlDevice, cInfo)
@@ -2168,24 +2168,24 @@ func nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice nvmlDevice, Info *NvL
 	return __v
 }
 
-// nvmlSystemSetNvlinkBwMode function as declared in nvml/nvml.h
-func nvmlSystemSetNvlinkBwMode(NvlinkBwMode uint32) Return {
+// cgo_nvmlSystemSetNvlinkBwMode function as declared in nvml/nvml.h
+func cgo_nvmlSystemSetNvlinkBwMode(NvlinkBwMode uint32) Return {
 	cNvlinkBwMode, _ := (C.uint)(NvlinkBwMode), cgoAllocsUnknown
 	__ret := C.nvmlSystemSetNvlinkBwMode(cNvlinkBwMode)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemGetNvlinkBwMode function as declared in nvml/nvml.h
-func nvmlSystemGetNvlinkBwMode(NvlinkBwMode *uint32) Return {
+// cgo_nvmlSystemGetNvlinkBwMode function as declared in nvml/nvml.h
+func cgo_nvmlSystemGetNvlinkBwMode(NvlinkBwMode *uint32) Return {
 	cNvlinkBwMode, _ := (*C.uint)(unsafe.Pointer(NvlinkBwMode)), cgoAllocsUnknown
 	__ret := C.nvmlSystemGetNvlinkBwMode(cNvlinkBwMode)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetNvlinkSupportedBwModes function as declared in nvml/nvml.h
-func nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice nvmlDevice, SupportedBwMode *NvlinkSupportedBwModes) Return {
+// cgo_nvmlDeviceGetNvlinkSupportedBwModes function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice nvmlDevice, SupportedBwMode *NvlinkSupportedBwModes) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSupportedBwMode, _ := (*C.nvmlNvlinkSupportedBwModes_t)(unsafe.Pointer(SupportedBwMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNvlinkSupportedBwModes(cnvmlDevice, cSupportedBwMode)
@@ -2193,8 +2193,8 @@ func nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice nvmlDevice, SupportedBwMode
 	return __v
 }
 
-// nvmlDeviceGetNvlinkBwMode function as declared in nvml/nvml.h
-func nvmlDeviceGetNvlinkBwMode(nvmlDevice nvmlDevice, GetBwMode *NvlinkGetBwMode) Return {
+// cgo_nvmlDeviceGetNvlinkBwMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvlinkBwMode(nvmlDevice nvmlDevice, GetBwMode *NvlinkGetBwMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGetBwMode, _ := (*C.nvmlNvlinkGetBwMode_t)(unsafe.Pointer(GetBwMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNvlinkBwMode(cnvmlDevice, cGetBwMode)
@@ -2202,8 +2202,8 @@ func nvmlDeviceGetNvlinkBwMode(nvmlDevice nvmlDevice, GetBwMode *NvlinkGetBwMode
 	return __v
 }
 
-// nvmlDeviceSetNvlinkBwMode function as declared in nvml/nvml.h
-func nvmlDeviceSetNvlinkBwMode(nvmlDevice nvmlDevice, SetBwMode *NvlinkSetBwMode) Return {
+// cgo_nvmlDeviceSetNvlinkBwMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetNvlinkBwMode(nvmlDevice nvmlDevice, SetBwMode *NvlinkSetBwMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cSetBwMode, _ := (*C.nvmlNvlinkSetBwMode_t)(unsafe.Pointer(SetBwMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetNvlinkBwMode(cnvmlDevice, cSetBwMode)
@@ -2211,8 +2211,8 @@ func nvmlDeviceSetNvlinkBwMode(nvmlDevice nvmlDevice, SetBwMode *NvlinkSetBwMode
 	return __v
 }
 
-// nvmlDeviceGetNvLinkInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkInfo(nvmlDevice nvmlDevice, Info *NvLinkInfo) Return {
+// cgo_nvmlDeviceGetNvLinkInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkInfo(nvmlDevice nvmlDevice, Info *NvLinkInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlNvLinkInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetNvLinkInfo(cnvmlDevice, cInfo)
@@ -2220,16 +2220,16 @@ func nvmlDeviceGetNvLinkInfo(nvmlDevice nvmlDevice, Info *NvLinkInfo) Return {
 	return __v
 }
 
-// nvmlEventSetCreate function as declared in nvml/nvml.h
-func nvmlEventSetCreate(Set *nvmlEventSet) Return {
+// cgo_nvmlEventSetCreate function as declared in nvml/nvml.h
+func cgo_nvmlEventSetCreate(Set *nvmlEventSet) Return {
 	cSet, _ := (*C.nvmlEventSet_t)(unsafe.Pointer(Set)), cgoAllocsUnknown
 	__ret := C.nvmlEventSetCreate(cSet)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceRegisterEvents function as declared in nvml/nvml.h
-func nvmlDeviceRegisterEvents(nvmlDevice nvmlDevice, EventTypes uint64, Set nvmlEventSet) Return {
+// cgo_nvmlDeviceRegisterEvents function as declared in nvml/nvml.h
+func cgo_nvmlDeviceRegisterEvents(nvmlDevice nvmlDevice, EventTypes uint64, Set nvmlEventSet) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEventTypes, _ := (C.ulonglong)(EventTypes), cgoAllocsUnknown
 	cSet, _ := *(*C.nvmlEventSet_t)(unsafe.Pointer(&Set)), cgoAllocsUnknown
@@ -2238,8 +2238,8 @@ func nvmlDeviceRegisterEvents(nvmlDevice nvmlDevice, EventTypes uint64, Set nvml
 	return __v
 }
 
-// nvmlDeviceGetSupportedEventTypes function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedEventTypes(nvmlDevice nvmlDevice, EventTypes *uint64) Return {
+// cgo_nvmlDeviceGetSupportedEventTypes function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedEventTypes(nvmlDevice nvmlDevice, EventTypes *uint64) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cEventTypes, _ := (*C.ulonglong)(unsafe.Pointer(EventTypes)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetSupportedEventTypes(cnvmlDevice, cEventTypes)
@@ -2247,8 +2247,8 @@ func nvmlDeviceGetSupportedEventTypes(nvmlDevice nvmlDevice, EventTypes *uint64)
 	return __v
 }
 
-// nvmlEventSetWait_v2 function as declared in nvml/nvml.h
-func nvmlEventSetWait_v2(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+// cgo_nvmlEventSetWait_v2 function as declared in nvml/nvml.h
+func cgo_nvmlEventSetWait_v2(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
 	cSet, _ := *(*C.nvmlEventSet_t)(unsafe.Pointer(&Set)), cgoAllocsUnknown
 	cData, _ := (*C.nvmlEventData_t)(unsafe.Pointer(Data)), cgoAllocsUnknown
 	cTimeoutms, _ := (C.uint)(Timeoutms), cgoAllocsUnknown
@@ -2257,48 +2257,48 @@ func nvmlEventSetWait_v2(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32
 	return __v
 }
 
-// nvmlEventSetFree function as declared in nvml/nvml.h
-func nvmlEventSetFree(Set nvmlEventSet) Return {
+// cgo_nvmlEventSetFree function as declared in nvml/nvml.h
+func cgo_nvmlEventSetFree(Set nvmlEventSet) Return {
 	cSet, _ := *(*C.nvmlEventSet_t)(unsafe.Pointer(&Set)), cgoAllocsUnknown
 	__ret := C.nvmlEventSetFree(cSet)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemEventSetCreate function as declared in nvml/nvml.h
-func nvmlSystemEventSetCreate(Request *SystemEventSetCreateRequest) Return {
+// cgo_nvmlSystemEventSetCreate function as declared in nvml/nvml.h
+func cgo_nvmlSystemEventSetCreate(Request *SystemEventSetCreateRequest) Return {
 	cRequest, _ := (*C.nvmlSystemEventSetCreateRequest_t)(unsafe.Pointer(Request)), cgoAllocsUnknown
 	__ret := C.nvmlSystemEventSetCreate(cRequest)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemEventSetFree function as declared in nvml/nvml.h
-func nvmlSystemEventSetFree(Request *SystemEventSetFreeRequest) Return {
+// cgo_nvmlSystemEventSetFree function as declared in nvml/nvml.h
+func cgo_nvmlSystemEventSetFree(Request *SystemEventSetFreeRequest) Return {
 	cRequest, _ := (*C.nvmlSystemEventSetFreeRequest_t)(unsafe.Pointer(Request)), cgoAllocsUnknown
 	__ret := C.nvmlSystemEventSetFree(cRequest)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemRegisterEvents function as declared in nvml/nvml.h
-func nvmlSystemRegisterEvents(Request *SystemRegisterEventRequest) Return {
+// cgo_nvmlSystemRegisterEvents function as declared in nvml/nvml.h
+func cgo_nvmlSystemRegisterEvents(Request *SystemRegisterEventRequest) Return {
 	cRequest, _ := (*C.nvmlSystemRegisterEventRequest_t)(unsafe.Pointer(Request)), cgoAllocsUnknown
 	__ret := C.nvmlSystemRegisterEvents(cRequest)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlSystemEventSetWait function as declared in nvml/nvml.h
-func nvmlSystemEventSetWait(Request *SystemEventSetWaitRequest) Return {
+// cgo_nvmlSystemEventSetWait function as declared in nvml/nvml.h
+func cgo_nvmlSystemEventSetWait(Request *SystemEventSetWaitRequest) Return {
 	cRequest, _ := (*C.nvmlSystemEventSetWaitRequest_t)(unsafe.Pointer(Request)), cgoAllocsUnknown
 	__ret := C.nvmlSystemEventSetWait(cRequest)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceModifyDrainState function as declared in nvml/nvml.h
-func nvmlDeviceModifyDrainState(PciInfo *PciInfo, NewState EnableState) Return {
+// cgo_nvmlDeviceModifyDrainState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceModifyDrainState(PciInfo *PciInfo, NewState EnableState) Return {
 	cPciInfo, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(PciInfo)), cgoAllocsUnknown
 	cNewState, _ := (C.nvmlEnableState_t)(NewState), cgoAllocsUnknown
 	__ret := C.nvmlDeviceModifyDrainState(cPciInfo, cNewState)
@@ -2306,8 +2306,8 @@ func nvmlDeviceModifyDrainState(PciInfo *PciInfo, NewState EnableState) Return {
 	return __v
 }
 
-// nvmlDeviceQueryDrainState function as declared in nvml/nvml.h
-func nvmlDeviceQueryDrainState(PciInfo *PciInfo, CurrentState *EnableState) Return {
+// cgo_nvmlDeviceQueryDrainState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceQueryDrainState(PciInfo *PciInfo, CurrentState *EnableState) Return {
 	cPciInfo, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(PciInfo)), cgoAllocsUnknown
 	cCurrentState, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(CurrentState)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceQueryDrainState(cPciInfo, cCurrentState)
@@ -2315,8 +2315,8 @@ func nvmlDeviceQueryDrainState(PciInfo *PciInfo, CurrentState *EnableState) Retu
 	return __v
 }
 
-// nvmlDeviceRemoveGpu_v2 function as declared in nvml/nvml.h
-func nvmlDeviceRemoveGpu_v2(PciInfo *PciInfo, GpuState DetachGpuState, LinkState PcieLinkState) Return {
+// cgo_nvmlDeviceRemoveGpu_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceRemoveGpu_v2(PciInfo *PciInfo, GpuState DetachGpuState, LinkState PcieLinkState) Return {
 	cPciInfo, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(PciInfo)), cgoAllocsUnknown
 	cGpuState, _ := (C.nvmlDetachGpuState_t)(GpuState), cgoAllocsUnknown
 	cLinkState, _ := (C.nvmlPcieLinkState_t)(LinkState), cgoAllocsUnknown
@@ -2325,16 +2325,16 @@ func nvmlDeviceRemoveGpu_v2(PciInfo *PciInfo, GpuState DetachGpuState, LinkState
 	return __v
 }
 
-// nvmlDeviceDiscoverGpus function as declared in nvml/nvml.h
-func nvmlDeviceDiscoverGpus(PciInfo *PciInfo) Return {
+// cgo_nvmlDeviceDiscoverGpus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceDiscoverGpus(PciInfo *PciInfo) Return {
 	cPciInfo, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(PciInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceDiscoverGpus(cPciInfo)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetFieldValues function as declared in nvml/nvml.h
-func nvmlDeviceGetFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+// cgo_nvmlDeviceGetFieldValues function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cValuesCount, _ := (C.int)(ValuesCount), cgoAllocsUnknown
 	cValues, _ := (*C.nvmlFieldValue_t)(unsafe.Pointer(Values)), cgoAllocsUnknown
@@ -2343,8 +2343,8 @@ func nvmlDeviceGetFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *
 	return __v
 }
 
-// nvmlDeviceClearFieldValues function as declared in nvml/nvml.h
-func nvmlDeviceClearFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+// cgo_nvmlDeviceClearFieldValues function as declared in nvml/nvml.h
+func cgo_nvmlDeviceClearFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cValuesCount, _ := (C.int)(ValuesCount), cgoAllocsUnknown
 	cValues, _ := (*C.nvmlFieldValue_t)(unsafe.Pointer(Values)), cgoAllocsUnknown
@@ -2353,8 +2353,8 @@ func nvmlDeviceClearFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values
 	return __v
 }
 
-// nvmlDeviceGetVirtualizationMode function as declared in nvml/nvml.h
-func nvmlDeviceGetVirtualizationMode(nvmlDevice nvmlDevice, PVirtualMode *GpuVirtualizationMode) Return {
+// cgo_nvmlDeviceGetVirtualizationMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVirtualizationMode(nvmlDevice nvmlDevice, PVirtualMode *GpuVirtualizationMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPVirtualMode, _ := (*C.nvmlGpuVirtualizationMode_t)(unsafe.Pointer(PVirtualMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVirtualizationMode(cnvmlDevice, cPVirtualMode)
@@ -2362,8 +2362,8 @@ func nvmlDeviceGetVirtualizationMode(nvmlDevice nvmlDevice, PVirtualMode *GpuVir
 	return __v
 }
 
-// nvmlDeviceGetHostVgpuMode function as declared in nvml/nvml.h
-func nvmlDeviceGetHostVgpuMode(nvmlDevice nvmlDevice, PHostVgpuMode *HostVgpuMode) Return {
+// cgo_nvmlDeviceGetHostVgpuMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHostVgpuMode(nvmlDevice nvmlDevice, PHostVgpuMode *HostVgpuMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPHostVgpuMode, _ := (*C.nvmlHostVgpuMode_t)(unsafe.Pointer(PHostVgpuMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHostVgpuMode(cnvmlDevice, cPHostVgpuMode)
@@ -2371,8 +2371,8 @@ func nvmlDeviceGetHostVgpuMode(nvmlDevice nvmlDevice, PHostVgpuMode *HostVgpuMod
 	return __v
 }
 
-// nvmlDeviceSetVirtualizationMode function as declared in nvml/nvml.h
-func nvmlDeviceSetVirtualizationMode(nvmlDevice nvmlDevice, VirtualMode GpuVirtualizationMode) Return {
+// cgo_nvmlDeviceSetVirtualizationMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetVirtualizationMode(nvmlDevice nvmlDevice, VirtualMode GpuVirtualizationMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVirtualMode, _ := (C.nvmlGpuVirtualizationMode_t)(VirtualMode), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetVirtualizationMode(cnvmlDevice, cVirtualMode)
@@ -2380,8 +2380,8 @@ func nvmlDeviceSetVirtualizationMode(nvmlDevice nvmlDevice, VirtualMode GpuVirtu
 	return __v
 }
 
-// nvmlDeviceGetVgpuHeterogeneousMode function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+// cgo_nvmlDeviceGetVgpuHeterogeneousMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPHeterogeneousMode, _ := (*C.nvmlVgpuHeterogeneousMode_t)(unsafe.Pointer(PHeterogeneousMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuHeterogeneousMode(cnvmlDevice, cPHeterogeneousMode)
@@ -2389,8 +2389,8 @@ func nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMod
 	return __v
 }
 
-// nvmlDeviceSetVgpuHeterogeneousMode function as declared in nvml/nvml.h
-func nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+// cgo_nvmlDeviceSetVgpuHeterogeneousMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPHeterogeneousMode, _ := (*C.nvmlVgpuHeterogeneousMode_t)(unsafe.Pointer(PHeterogeneousMode)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetVgpuHeterogeneousMode(cnvmlDevice, cPHeterogeneousMode)
@@ -2398,8 +2398,8 @@ func nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMod
 	return __v
 }
 
-// nvmlVgpuInstanceGetPlacementId function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance nvmlVgpuInstance, PPlacement *VgpuPlacementId) Return {
+// cgo_nvmlVgpuInstanceGetPlacementId function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance nvmlVgpuInstance, PPlacement *VgpuPlacementId) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cPPlacement, _ := (*C.nvmlVgpuPlacementId_t)(unsafe.Pointer(PPlacement)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetPlacementId(cnvmlVgpuInstance, cPPlacement)
@@ -2407,8 +2407,8 @@ func nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance nvmlVgpuInstance, PPlacemen
 	return __v
 }
 
-// nvmlDeviceGetVgpuTypeSupportedPlacements function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+// cgo_nvmlDeviceGetVgpuTypeSupportedPlacements function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cPPlacementList, _ := (*C.nvmlVgpuPlacementList_t)(unsafe.Pointer(PPlacementList)), cgoAllocsUnknown
@@ -2417,8 +2417,8 @@ func nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice nvmlDevice, nvmlVgpuTyp
 	return __v
 }
 
-// nvmlDeviceGetVgpuTypeCreatablePlacements function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+// cgo_nvmlDeviceGetVgpuTypeCreatablePlacements function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cPPlacementList, _ := (*C.nvmlVgpuPlacementList_t)(unsafe.Pointer(PPlacementList)), cgoAllocsUnknown
@@ -2427,8 +2427,8 @@ func nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice nvmlDevice, nvmlVgpuTyp
 	return __v
 }
 
-// nvmlVgpuTypeGetGspHeapSize function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId nvmlVgpuTypeId, GspHeapSize *uint64) Return {
+// cgo_nvmlVgpuTypeGetGspHeapSize function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId nvmlVgpuTypeId, GspHeapSize *uint64) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cGspHeapSize, _ := (*C.ulonglong)(unsafe.Pointer(GspHeapSize)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetGspHeapSize(cnvmlVgpuTypeId, cGspHeapSize)
@@ -2436,8 +2436,8 @@ func nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId nvmlVgpuTypeId, GspHeapSize *uint
 	return __v
 }
 
-// nvmlVgpuTypeGetFbReservation function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId nvmlVgpuTypeId, FbReservation *uint64) Return {
+// cgo_nvmlVgpuTypeGetFbReservation function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId nvmlVgpuTypeId, FbReservation *uint64) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cFbReservation, _ := (*C.ulonglong)(unsafe.Pointer(FbReservation)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetFbReservation(cnvmlVgpuTypeId, cFbReservation)
@@ -2445,8 +2445,8 @@ func nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId nvmlVgpuTypeId, FbReservation *
 	return __v
 }
 
-// nvmlVgpuInstanceGetRuntimeStateSize function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance nvmlVgpuInstance, PState *VgpuRuntimeState) Return {
+// cgo_nvmlVgpuInstanceGetRuntimeStateSize function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance nvmlVgpuInstance, PState *VgpuRuntimeState) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cPState, _ := (*C.nvmlVgpuRuntimeState_t)(unsafe.Pointer(PState)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetRuntimeStateSize(cnvmlVgpuInstance, cPState)
@@ -2454,8 +2454,8 @@ func nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance nvmlVgpuInstance, PSta
 	return __v
 }
 
-// nvmlDeviceSetVgpuCapabilities function as declared in nvml/nvml.h
-func nvmlDeviceSetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, State EnableState) Return {
+// cgo_nvmlDeviceSetVgpuCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, State EnableState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCapability, _ := (C.nvmlDeviceVgpuCapability_t)(Capability), cgoAllocsUnknown
 	cState, _ := (C.nvmlEnableState_t)(State), cgoAllocsUnknown
@@ -2464,8 +2464,8 @@ func nvmlDeviceSetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuC
 	return __v
 }
 
-// nvmlDeviceGetGridLicensableFeatures_v4 function as declared in nvml/nvml.h
-func nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+// cgo_nvmlDeviceGetGridLicensableFeatures_v4 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPGridLicensableFeatures, _ := (*C.nvmlGridLicensableFeatures_t)(unsafe.Pointer(PGridLicensableFeatures)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGridLicensableFeatures_v4(cnvmlDevice, cPGridLicensableFeatures)
@@ -2473,8 +2473,8 @@ func nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice nvmlDevice, PGridLicensab
 	return __v
 }
 
-// nvmlGetVgpuDriverCapabilities function as declared in nvml/nvml.h
-func nvmlGetVgpuDriverCapabilities(Capability VgpuDriverCapability, CapResult *uint32) Return {
+// cgo_nvmlGetVgpuDriverCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlGetVgpuDriverCapabilities(Capability VgpuDriverCapability, CapResult *uint32) Return {
 	cCapability, _ := (C.nvmlVgpuDriverCapability_t)(Capability), cgoAllocsUnknown
 	cCapResult, _ := (*C.uint)(unsafe.Pointer(CapResult)), cgoAllocsUnknown
 	__ret := C.nvmlGetVgpuDriverCapabilities(cCapability, cCapResult)
@@ -2482,8 +2482,8 @@ func nvmlGetVgpuDriverCapabilities(Capability VgpuDriverCapability, CapResult *u
 	return __v
 }
 
-// nvmlDeviceGetVgpuCapabilities function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, CapResult *uint32) Return {
+// cgo_nvmlDeviceGetVgpuCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, CapResult *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCapability, _ := (C.nvmlDeviceVgpuCapability_t)(Capability), cgoAllocsUnknown
 	cCapResult, _ := (*C.uint)(unsafe.Pointer(CapResult)), cgoAllocsUnknown
@@ -2492,8 +2492,8 @@ func nvmlDeviceGetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuC
 	return __v
 }
 
-// nvmlDeviceGetSupportedVgpus function as declared in nvml/nvml.h
-func nvmlDeviceGetSupportedVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+// cgo_nvmlDeviceGetSupportedVgpus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSupportedVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVgpuCount, _ := (*C.uint)(unsafe.Pointer(VgpuCount)), cgoAllocsUnknown
 	cVgpuTypeIds, _ := (*C.nvmlVgpuTypeId_t)(unsafe.Pointer(VgpuTypeIds)), cgoAllocsUnknown
@@ -2502,8 +2502,8 @@ func nvmlDeviceGetSupportedVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuT
 	return __v
 }
 
-// nvmlDeviceGetCreatableVgpus function as declared in nvml/nvml.h
-func nvmlDeviceGetCreatableVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+// cgo_nvmlDeviceGetCreatableVgpus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCreatableVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVgpuCount, _ := (*C.uint)(unsafe.Pointer(VgpuCount)), cgoAllocsUnknown
 	cVgpuTypeIds, _ := (*C.nvmlVgpuTypeId_t)(unsafe.Pointer(VgpuTypeIds)), cgoAllocsUnknown
@@ -2512,8 +2512,8 @@ func nvmlDeviceGetCreatableVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuT
 	return __v
 }
 
-// nvmlVgpuTypeGetClass function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetClass(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeClass *byte, Size *uint32) Return {
+// cgo_nvmlVgpuTypeGetClass function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetClass(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeClass *byte, Size *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cVgpuTypeClass, _ := (*C.char)(unsafe.Pointer(VgpuTypeClass)), cgoAllocsUnknown
 	cSize, _ := (*C.uint)(unsafe.Pointer(Size)), cgoAllocsUnknown
@@ -2522,8 +2522,8 @@ func nvmlVgpuTypeGetClass(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeClass *byte, Si
 	return __v
 }
 
-// nvmlVgpuTypeGetName function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetName(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeName *byte, Size *uint32) Return {
+// cgo_nvmlVgpuTypeGetName function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetName(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeName *byte, Size *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cVgpuTypeName, _ := (*C.char)(unsafe.Pointer(VgpuTypeName)), cgoAllocsUnknown
 	cSize, _ := (*C.uint)(unsafe.Pointer(Size)), cgoAllocsUnknown
@@ -2532,8 +2532,8 @@ func nvmlVgpuTypeGetName(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeName *byte, Size
 	return __v
 }
 
-// nvmlVgpuTypeGetGpuInstanceProfileId function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId nvmlVgpuTypeId, GpuInstanceProfileId *uint32) Return {
+// cgo_nvmlVgpuTypeGetGpuInstanceProfileId function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId nvmlVgpuTypeId, GpuInstanceProfileId *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cGpuInstanceProfileId, _ := (*C.uint)(unsafe.Pointer(GpuInstanceProfileId)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetGpuInstanceProfileId(cnvmlVgpuTypeId, cGpuInstanceProfileId)
@@ -2541,8 +2541,8 @@ func nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId nvmlVgpuTypeId, GpuInsta
 	return __v
 }
 
-// nvmlVgpuTypeGetDeviceID function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId nvmlVgpuTypeId, DeviceID *uint64, SubsystemID *uint64) Return {
+// cgo_nvmlVgpuTypeGetDeviceID function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId nvmlVgpuTypeId, DeviceID *uint64, SubsystemID *uint64) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cDeviceID, _ := (*C.ulonglong)(unsafe.Pointer(DeviceID)), cgoAllocsUnknown
 	cSubsystemID, _ := (*C.ulonglong)(unsafe.Pointer(SubsystemID)), cgoAllocsUnknown
@@ -2551,8 +2551,8 @@ func nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId nvmlVgpuTypeId, DeviceID *uint64, Su
 	return __v
 }
 
-// nvmlVgpuTypeGetFramebufferSize function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId nvmlVgpuTypeId, FbSize *uint64) Return {
+// cgo_nvmlVgpuTypeGetFramebufferSize function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId nvmlVgpuTypeId, FbSize *uint64) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cFbSize, _ := (*C.ulonglong)(unsafe.Pointer(FbSize)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetFramebufferSize(cnvmlVgpuTypeId, cFbSize)
@@ -2560,8 +2560,8 @@ func nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId nvmlVgpuTypeId, FbSize *uint6
 	return __v
 }
 
-// nvmlVgpuTypeGetNumDisplayHeads function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId nvmlVgpuTypeId, NumDisplayHeads *uint32) Return {
+// cgo_nvmlVgpuTypeGetNumDisplayHeads function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId nvmlVgpuTypeId, NumDisplayHeads *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cNumDisplayHeads, _ := (*C.uint)(unsafe.Pointer(NumDisplayHeads)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetNumDisplayHeads(cnvmlVgpuTypeId, cNumDisplayHeads)
@@ -2569,8 +2569,8 @@ func nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId nvmlVgpuTypeId, NumDisplayHea
 	return __v
 }
 
-// nvmlVgpuTypeGetResolution function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetResolution(nvmlVgpuTypeId nvmlVgpuTypeId, DisplayIndex uint32, Xdim *uint32, Ydim *uint32) Return {
+// cgo_nvmlVgpuTypeGetResolution function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetResolution(nvmlVgpuTypeId nvmlVgpuTypeId, DisplayIndex uint32, Xdim *uint32, Ydim *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cDisplayIndex, _ := (C.uint)(DisplayIndex), cgoAllocsUnknown
 	cXdim, _ := (*C.uint)(unsafe.Pointer(Xdim)), cgoAllocsUnknown
@@ -2580,8 +2580,8 @@ func nvmlVgpuTypeGetResolution(nvmlVgpuTypeId nvmlVgpuTypeId, DisplayIndex uint3
 	return __v
 }
 
-// nvmlVgpuTypeGetLicense function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetLicense(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeLicenseString *byte, Size uint32) Return {
+// cgo_nvmlVgpuTypeGetLicense function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetLicense(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeLicenseString *byte, Size uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cVgpuTypeLicenseString, _ := (*C.char)(unsafe.Pointer(VgpuTypeLicenseString)), cgoAllocsUnknown
 	cSize, _ := (C.uint)(Size), cgoAllocsUnknown
@@ -2590,8 +2590,8 @@ func nvmlVgpuTypeGetLicense(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeLicenseString
 	return __v
 }
 
-// nvmlVgpuTypeGetFrameRateLimit function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId nvmlVgpuTypeId, FrameRateLimit *uint32) Return {
+// cgo_nvmlVgpuTypeGetFrameRateLimit function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId nvmlVgpuTypeId, FrameRateLimit *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cFrameRateLimit, _ := (*C.uint)(unsafe.Pointer(FrameRateLimit)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetFrameRateLimit(cnvmlVgpuTypeId, cFrameRateLimit)
@@ -2599,8 +2599,8 @@ func nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId nvmlVgpuTypeId, FrameRateLimit
 	return __v
 }
 
-// nvmlVgpuTypeGetMaxInstances function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetMaxInstances(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCount *uint32) Return {
+// cgo_nvmlVgpuTypeGetMaxInstances function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetMaxInstances(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCount *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cVgpuInstanceCount, _ := (*C.uint)(unsafe.Pointer(VgpuInstanceCount)), cgoAllocsUnknown
@@ -2609,8 +2609,8 @@ func nvmlVgpuTypeGetMaxInstances(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuT
 	return __v
 }
 
-// nvmlVgpuTypeGetMaxInstancesPerVm function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCountPerVm *uint32) Return {
+// cgo_nvmlVgpuTypeGetMaxInstancesPerVm function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCountPerVm *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cVgpuInstanceCountPerVm, _ := (*C.uint)(unsafe.Pointer(VgpuInstanceCountPerVm)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetMaxInstancesPerVm(cnvmlVgpuTypeId, cVgpuInstanceCountPerVm)
@@ -2618,8 +2618,8 @@ func nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanc
 	return __v
 }
 
-// nvmlVgpuTypeGetBAR1Info function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId nvmlVgpuTypeId, Bar1Info *VgpuTypeBar1Info) Return {
+// cgo_nvmlVgpuTypeGetBAR1Info function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId nvmlVgpuTypeId, Bar1Info *VgpuTypeBar1Info) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cBar1Info, _ := (*C.nvmlVgpuTypeBar1Info_t)(unsafe.Pointer(Bar1Info)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetBAR1Info(cnvmlVgpuTypeId, cBar1Info)
@@ -2627,8 +2627,8 @@ func nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId nvmlVgpuTypeId, Bar1Info *VgpuTypeBa
 	return __v
 }
 
-// nvmlDeviceGetActiveVgpus function as declared in nvml/nvml.h
-func nvmlDeviceGetActiveVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuInstances *nvmlVgpuInstance) Return {
+// cgo_nvmlDeviceGetActiveVgpus function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetActiveVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuInstances *nvmlVgpuInstance) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVgpuCount, _ := (*C.uint)(unsafe.Pointer(VgpuCount)), cgoAllocsUnknown
 	cVgpuInstances, _ := (*C.nvmlVgpuInstance_t)(unsafe.Pointer(VgpuInstances)), cgoAllocsUnknown
@@ -2637,8 +2637,8 @@ func nvmlDeviceGetActiveVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuInst
 	return __v
 }
 
-// nvmlVgpuInstanceGetVmID function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetVmID(nvmlVgpuInstance nvmlVgpuInstance, VmId *byte, Size uint32, VmIdType *VgpuVmIdType) Return {
+// cgo_nvmlVgpuInstanceGetVmID function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetVmID(nvmlVgpuInstance nvmlVgpuInstance, VmId *byte, Size uint32, VmIdType *VgpuVmIdType) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cVmId, _ := (*C.char)(unsafe.Pointer(VmId)), cgoAllocsUnknown
 	cSize, _ := (C.uint)(Size), cgoAllocsUnknown
@@ -2648,8 +2648,8 @@ func nvmlVgpuInstanceGetVmID(nvmlVgpuInstance nvmlVgpuInstance, VmId *byte, Size
 	return __v
 }
 
-// nvmlVgpuInstanceGetUUID function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetUUID(nvmlVgpuInstance nvmlVgpuInstance, Uuid *byte, Size uint32) Return {
+// cgo_nvmlVgpuInstanceGetUUID function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetUUID(nvmlVgpuInstance nvmlVgpuInstance, Uuid *byte, Size uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cUuid, _ := (*C.char)(unsafe.Pointer(Uuid)), cgoAllocsUnknown
 	cSize, _ := (C.uint)(Size), cgoAllocsUnknown
@@ -2658,8 +2658,8 @@ func nvmlVgpuInstanceGetUUID(nvmlVgpuInstance nvmlVgpuInstance, Uuid *byte, Size
 	return __v
 }
 
-// nvmlVgpuInstanceGetVmDriverVersion function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance nvmlVgpuInstance, Version *byte, Length uint32) Return {
+// cgo_nvmlVgpuInstanceGetVmDriverVersion function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance nvmlVgpuInstance, Version *byte, Length uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cVersion, _ := (*C.char)(unsafe.Pointer(Version)), cgoAllocsUnknown
 	cLength, _ := (C.uint)(Length), cgoAllocsUnknown
@@ -2668,8 +2668,8 @@ func nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance nvmlVgpuInstance, Versi
 	return __v
 }
 
-// nvmlVgpuInstanceGetFbUsage function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance nvmlVgpuInstance, FbUsage *uint64) Return {
+// cgo_nvmlVgpuInstanceGetFbUsage function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance nvmlVgpuInstance, FbUsage *uint64) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cFbUsage, _ := (*C.ulonglong)(unsafe.Pointer(FbUsage)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetFbUsage(cnvmlVgpuInstance, cFbUsage)
@@ -2677,8 +2677,8 @@ func nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance nvmlVgpuInstance, FbUsage *uint
 	return __v
 }
 
-// nvmlVgpuInstanceGetLicenseStatus function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance nvmlVgpuInstance, Licensed *uint32) Return {
+// cgo_nvmlVgpuInstanceGetLicenseStatus function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance nvmlVgpuInstance, Licensed *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cLicensed, _ := (*C.uint)(unsafe.Pointer(Licensed)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetLicenseStatus(cnvmlVgpuInstance, cLicensed)
@@ -2686,8 +2686,8 @@ func nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance nvmlVgpuInstance, License
 	return __v
 }
 
-// nvmlVgpuInstanceGetType function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetType(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuTypeId *nvmlVgpuTypeId) Return {
+// cgo_nvmlVgpuInstanceGetType function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetType(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuTypeId *nvmlVgpuTypeId) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cnvmlVgpuTypeId, _ := (*C.nvmlVgpuTypeId_t)(unsafe.Pointer(nvmlVgpuTypeId)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetType(cnvmlVgpuInstance, cnvmlVgpuTypeId)
@@ -2695,8 +2695,8 @@ func nvmlVgpuInstanceGetType(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuTypeId *
 	return __v
 }
 
-// nvmlVgpuInstanceGetFrameRateLimit function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance nvmlVgpuInstance, FrameRateLimit *uint32) Return {
+// cgo_nvmlVgpuInstanceGetFrameRateLimit function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance nvmlVgpuInstance, FrameRateLimit *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cFrameRateLimit, _ := (*C.uint)(unsafe.Pointer(FrameRateLimit)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetFrameRateLimit(cnvmlVgpuInstance, cFrameRateLimit)
@@ -2704,8 +2704,8 @@ func nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance nvmlVgpuInstance, FrameR
 	return __v
 }
 
-// nvmlVgpuInstanceGetEccMode function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance nvmlVgpuInstance, EccMode *EnableState) Return {
+// cgo_nvmlVgpuInstanceGetEccMode function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance nvmlVgpuInstance, EccMode *EnableState) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cEccMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(EccMode)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetEccMode(cnvmlVgpuInstance, cEccMode)
@@ -2713,8 +2713,8 @@ func nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance nvmlVgpuInstance, EccMode *Enab
 	return __v
 }
 
-// nvmlVgpuInstanceGetEncoderCapacity function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity *uint32) Return {
+// cgo_nvmlVgpuInstanceGetEncoderCapacity function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cEncoderCapacity, _ := (*C.uint)(unsafe.Pointer(EncoderCapacity)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetEncoderCapacity(cnvmlVgpuInstance, cEncoderCapacity)
@@ -2722,8 +2722,8 @@ func nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, Encod
 	return __v
 }
 
-// nvmlVgpuInstanceSetEncoderCapacity function as declared in nvml/nvml.h
-func nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity uint32) Return {
+// cgo_nvmlVgpuInstanceSetEncoderCapacity function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cEncoderCapacity, _ := (C.uint)(EncoderCapacity), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceSetEncoderCapacity(cnvmlVgpuInstance, cEncoderCapacity)
@@ -2731,8 +2731,8 @@ func nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, Encod
 	return __v
 }
 
-// nvmlVgpuInstanceGetEncoderStats function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+// cgo_nvmlVgpuInstanceGetEncoderStats function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cAverageFps, _ := (*C.uint)(unsafe.Pointer(AverageFps)), cgoAllocsUnknown
@@ -2742,8 +2742,8 @@ func nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance nvmlVgpuInstance, SessionC
 	return __v
 }
 
-// nvmlVgpuInstanceGetEncoderSessions function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *EncoderSessionInfo) Return {
+// cgo_nvmlVgpuInstanceGetEncoderSessions function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *EncoderSessionInfo) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cSessionInfo, _ := (*C.nvmlEncoderSessionInfo_t)(unsafe.Pointer(SessionInfo)), cgoAllocsUnknown
@@ -2752,8 +2752,8 @@ func nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance nvmlVgpuInstance, Sessi
 	return __v
 }
 
-// nvmlVgpuInstanceGetFBCStats function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance nvmlVgpuInstance, FbcStats *FBCStats) Return {
+// cgo_nvmlVgpuInstanceGetFBCStats function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance nvmlVgpuInstance, FbcStats *FBCStats) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cFbcStats, _ := (*C.nvmlFBCStats_t)(unsafe.Pointer(FbcStats)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetFBCStats(cnvmlVgpuInstance, cFbcStats)
@@ -2761,8 +2761,8 @@ func nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance nvmlVgpuInstance, FbcStats *FB
 	return __v
 }
 
-// nvmlVgpuInstanceGetFBCSessions function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+// cgo_nvmlVgpuInstanceGetFBCSessions function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cSessionCount, _ := (*C.uint)(unsafe.Pointer(SessionCount)), cgoAllocsUnknown
 	cSessionInfo, _ := (*C.nvmlFBCSessionInfo_t)(unsafe.Pointer(SessionInfo)), cgoAllocsUnknown
@@ -2771,8 +2771,8 @@ func nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCo
 	return __v
 }
 
-// nvmlVgpuInstanceGetGpuInstanceId function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance nvmlVgpuInstance, GpuInstanceId *uint32) Return {
+// cgo_nvmlVgpuInstanceGetGpuInstanceId function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance nvmlVgpuInstance, GpuInstanceId *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cGpuInstanceId, _ := (*C.uint)(unsafe.Pointer(GpuInstanceId)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetGpuInstanceId(cnvmlVgpuInstance, cGpuInstanceId)
@@ -2780,8 +2780,8 @@ func nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance nvmlVgpuInstance, GpuInst
 	return __v
 }
 
-// nvmlVgpuInstanceGetGpuPciId function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance nvmlVgpuInstance, VgpuPciId *byte, Length *uint32) Return {
+// cgo_nvmlVgpuInstanceGetGpuPciId function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance nvmlVgpuInstance, VgpuPciId *byte, Length *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cVgpuPciId, _ := (*C.char)(unsafe.Pointer(VgpuPciId)), cgoAllocsUnknown
 	cLength, _ := (*C.uint)(unsafe.Pointer(Length)), cgoAllocsUnknown
@@ -2790,8 +2790,8 @@ func nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance nvmlVgpuInstance, VgpuPciId *b
 	return __v
 }
 
-// nvmlVgpuTypeGetCapabilities function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId nvmlVgpuTypeId, Capability VgpuCapability, CapResult *uint32) Return {
+// cgo_nvmlVgpuTypeGetCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId nvmlVgpuTypeId, Capability VgpuCapability, CapResult *uint32) Return {
 	cnvmlVgpuTypeId, _ := (C.nvmlVgpuTypeId_t)(nvmlVgpuTypeId), cgoAllocsUnknown
 	cCapability, _ := (C.nvmlVgpuCapability_t)(Capability), cgoAllocsUnknown
 	cCapResult, _ := (*C.uint)(unsafe.Pointer(CapResult)), cgoAllocsUnknown
@@ -2800,8 +2800,8 @@ func nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId nvmlVgpuTypeId, Capability VgpuC
 	return __v
 }
 
-// nvmlVgpuInstanceGetMdevUUID function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance nvmlVgpuInstance, MdevUuid *byte, Size uint32) Return {
+// cgo_nvmlVgpuInstanceGetMdevUUID function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance nvmlVgpuInstance, MdevUuid *byte, Size uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cMdevUuid, _ := (*C.char)(unsafe.Pointer(MdevUuid)), cgoAllocsUnknown
 	cSize, _ := (C.uint)(Size), cgoAllocsUnknown
@@ -2810,8 +2810,8 @@ func nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance nvmlVgpuInstance, MdevUuid *by
 	return __v
 }
 
-// nvmlGpuInstanceGetCreatableVgpus function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpus *VgpuTypeIdInfo) Return {
+// cgo_nvmlGpuInstanceGetCreatableVgpus function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpus *VgpuTypeIdInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPVgpus, _ := (*C.nvmlVgpuTypeIdInfo_t)(unsafe.Pointer(PVgpus)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetCreatableVgpus(cnvmlGpuInstance, cPVgpus)
@@ -2819,16 +2819,16 @@ func nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpus *V
 	return __v
 }
 
-// nvmlVgpuTypeGetMaxInstancesPerGpuInstance function as declared in nvml/nvml.h
-func nvmlVgpuTypeGetMaxInstancesPerGpuInstance(PMaxInstance *VgpuTypeMaxInstance) Return {
+// cgo_nvmlVgpuTypeGetMaxInstancesPerGpuInstance function as declared in nvml/nvml.h
+func cgo_nvmlVgpuTypeGetMaxInstancesPerGpuInstance(PMaxInstance *VgpuTypeMaxInstance) Return {
 	cPMaxInstance, _ := (*C.nvmlVgpuTypeMaxInstance_t)(unsafe.Pointer(PMaxInstance)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuTypeGetMaxInstancesPerGpuInstance(cPMaxInstance)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGpuInstanceGetActiveVgpus function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpuInstanceInfo *ActiveVgpuInstanceInfo) Return {
+// cgo_nvmlGpuInstanceGetActiveVgpus function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpuInstanceInfo *ActiveVgpuInstanceInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPVgpuInstanceInfo, _ := (*C.nvmlActiveVgpuInstanceInfo_t)(unsafe.Pointer(PVgpuInstanceInfo)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetActiveVgpus(cnvmlGpuInstance, cPVgpuInstanceInfo)
@@ -2836,8 +2836,8 @@ func nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpuInstanc
 	return __v
 }
 
-// nvmlGpuInstanceSetVgpuSchedulerState function as declared in nvml/nvml.h
-func nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PScheduler *VgpuSchedulerState) Return {
+// cgo_nvmlGpuInstanceSetVgpuSchedulerState function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PScheduler *VgpuSchedulerState) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPScheduler, _ := (*C.nvmlVgpuSchedulerState_t)(unsafe.Pointer(PScheduler)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceSetVgpuSchedulerState(cnvmlGpuInstance, cPScheduler)
@@ -2845,8 +2845,8 @@ func nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSche
 	return __v
 }
 
-// nvmlGpuInstanceGetVgpuSchedulerState function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSchedulerStateInfo *VgpuSchedulerStateInfo) Return {
+// cgo_nvmlGpuInstanceGetVgpuSchedulerState function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSchedulerStateInfo *VgpuSchedulerStateInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPSchedulerStateInfo, _ := (*C.nvmlVgpuSchedulerStateInfo_t)(unsafe.Pointer(PSchedulerStateInfo)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetVgpuSchedulerState(cnvmlGpuInstance, cPSchedulerStateInfo)
@@ -2854,8 +2854,8 @@ func nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSche
 	return __v
 }
 
-// nvmlGpuInstanceGetVgpuSchedulerLog function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance nvmlGpuInstance, PSchedulerLogInfo *VgpuSchedulerLogInfo) Return {
+// cgo_nvmlGpuInstanceGetVgpuSchedulerLog function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance nvmlGpuInstance, PSchedulerLogInfo *VgpuSchedulerLogInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPSchedulerLogInfo, _ := (*C.nvmlVgpuSchedulerLogInfo_t)(unsafe.Pointer(PSchedulerLogInfo)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetVgpuSchedulerLog(cnvmlGpuInstance, cPSchedulerLogInfo)
@@ -2863,8 +2863,8 @@ func nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance nvmlGpuInstance, PSchedu
 	return __v
 }
 
-// nvmlGpuInstanceGetVgpuTypeCreatablePlacements function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance nvmlGpuInstance, PCreatablePlacementInfo *VgpuCreatablePlacementInfo) Return {
+// cgo_nvmlGpuInstanceGetVgpuTypeCreatablePlacements function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance nvmlGpuInstance, PCreatablePlacementInfo *VgpuCreatablePlacementInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPCreatablePlacementInfo, _ := (*C.nvmlVgpuCreatablePlacementInfo_t)(unsafe.Pointer(PCreatablePlacementInfo)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetVgpuTypeCreatablePlacements(cnvmlGpuInstance, cPCreatablePlacementInfo)
@@ -2872,8 +2872,8 @@ func nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance nvmlGpuInstan
 	return __v
 }
 
-// nvmlGpuInstanceGetVgpuHeterogeneousMode function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+// cgo_nvmlGpuInstanceGetVgpuHeterogeneousMode function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPHeterogeneousMode, _ := (*C.nvmlVgpuHeterogeneousMode_t)(unsafe.Pointer(PHeterogeneousMode)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetVgpuHeterogeneousMode(cnvmlGpuInstance, cPHeterogeneousMode)
@@ -2881,8 +2881,8 @@ func nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PH
 	return __v
 }
 
-// nvmlGpuInstanceSetVgpuHeterogeneousMode function as declared in nvml/nvml.h
-func nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+// cgo_nvmlGpuInstanceSetVgpuHeterogeneousMode function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cPHeterogeneousMode, _ := (*C.nvmlVgpuHeterogeneousMode_t)(unsafe.Pointer(PHeterogeneousMode)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceSetVgpuHeterogeneousMode(cnvmlGpuInstance, cPHeterogeneousMode)
@@ -2890,8 +2890,8 @@ func nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PH
 	return __v
 }
 
-// nvmlVgpuInstanceGetMetadata function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuMetadata *nvmlVgpuMetadata, BufferSize *uint32) Return {
+// cgo_nvmlVgpuInstanceGetMetadata function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuMetadata *nvmlVgpuMetadata, BufferSize *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cnvmlVgpuMetadata, _ := (*C.nvmlVgpuMetadata_t)(unsafe.Pointer(nvmlVgpuMetadata)), cgoAllocsUnknown
 	cBufferSize, _ := (*C.uint)(unsafe.Pointer(BufferSize)), cgoAllocsUnknown
@@ -2900,8 +2900,8 @@ func nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuMeta
 	return __v
 }
 
-// nvmlDeviceGetVgpuMetadata function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuMetadata(nvmlDevice nvmlDevice, PgpuMetadata *nvmlVgpuPgpuMetadata, BufferSize *uint32) Return {
+// cgo_nvmlDeviceGetVgpuMetadata function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuMetadata(nvmlDevice nvmlDevice, PgpuMetadata *nvmlVgpuPgpuMetadata, BufferSize *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPgpuMetadata, _ := (*C.nvmlVgpuPgpuMetadata_t)(unsafe.Pointer(PgpuMetadata)), cgoAllocsUnknown
 	cBufferSize, _ := (*C.uint)(unsafe.Pointer(BufferSize)), cgoAllocsUnknown
@@ -2910,8 +2910,8 @@ func nvmlDeviceGetVgpuMetadata(nvmlDevice nvmlDevice, PgpuMetadata *nvmlVgpuPgpu
 	return __v
 }
 
-// nvmlGetVgpuCompatibility function as declared in nvml/nvml.h
-func nvmlGetVgpuCompatibility(nvmlVgpuMetadata *nvmlVgpuMetadata, PgpuMetadata *nvmlVgpuPgpuMetadata, CompatibilityInfo *VgpuPgpuCompatibility) Return {
+// cgo_nvmlGetVgpuCompatibility function as declared in nvml/nvml.h
+func cgo_nvmlGetVgpuCompatibility(nvmlVgpuMetadata *nvmlVgpuMetadata, PgpuMetadata *nvmlVgpuPgpuMetadata, CompatibilityInfo *VgpuPgpuCompatibility) Return {
 	cnvmlVgpuMetadata, _ := (*C.nvmlVgpuMetadata_t)(unsafe.Pointer(nvmlVgpuMetadata)), cgoAllocsUnknown
 	cPgpuMetadata, _ := (*C.nvmlVgpuPgpuMetadata_t)(unsafe.Pointer(PgpuMetadata)), cgoAllocsUnknown
 	cCompatibilityInfo, _ := (*C.nvmlVgpuPgpuCompatibility_t)(unsafe.Pointer(CompatibilityInfo)), cgoAllocsUnknown
@@ -2920,8 +2920,8 @@ func nvmlGetVgpuCompatibility(nvmlVgpuMetadata *nvmlVgpuMetadata, PgpuMetadata *
 	return __v
 }
 
-// nvmlDeviceGetPgpuMetadataString function as declared in nvml/nvml.h
-func nvmlDeviceGetPgpuMetadataString(nvmlDevice nvmlDevice, PgpuMetadata *byte, BufferSize *uint32) Return {
+// cgo_nvmlDeviceGetPgpuMetadataString function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPgpuMetadataString(nvmlDevice nvmlDevice, PgpuMetadata *byte, BufferSize *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPgpuMetadata, _ := (*C.char)(unsafe.Pointer(PgpuMetadata)), cgoAllocsUnknown
 	cBufferSize, _ := (*C.uint)(unsafe.Pointer(BufferSize)), cgoAllocsUnknown
@@ -2930,8 +2930,8 @@ func nvmlDeviceGetPgpuMetadataString(nvmlDevice nvmlDevice, PgpuMetadata *byte,
 	return __v
 }
 
-// nvmlDeviceGetVgpuSchedulerLog function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuSchedulerLog(nvmlDevice nvmlDevice, PSchedulerLog *VgpuSchedulerLog) Return {
+// cgo_nvmlDeviceGetVgpuSchedulerLog function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuSchedulerLog(nvmlDevice nvmlDevice, PSchedulerLog *VgpuSchedulerLog) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPSchedulerLog, _ := (*C.nvmlVgpuSchedulerLog_t)(unsafe.Pointer(PSchedulerLog)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuSchedulerLog(cnvmlDevice, cPSchedulerLog)
@@ -2939,8 +2939,8 @@ func nvmlDeviceGetVgpuSchedulerLog(nvmlDevice nvmlDevice, PSchedulerLog *VgpuSch
 	return __v
 }
 
-// nvmlDeviceGetVgpuSchedulerState function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerGetState) Return {
+// cgo_nvmlDeviceGetVgpuSchedulerState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerGetState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPSchedulerState, _ := (*C.nvmlVgpuSchedulerGetState_t)(unsafe.Pointer(PSchedulerState)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuSchedulerState(cnvmlDevice, cPSchedulerState)
@@ -2948,8 +2948,8 @@ func nvmlDeviceGetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *Vgp
 	return __v
 }
 
-// nvmlDeviceGetVgpuSchedulerCapabilities function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice nvmlDevice, PCapabilities *VgpuSchedulerCapabilities) Return {
+// cgo_nvmlDeviceGetVgpuSchedulerCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice nvmlDevice, PCapabilities *VgpuSchedulerCapabilities) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPCapabilities, _ := (*C.nvmlVgpuSchedulerCapabilities_t)(unsafe.Pointer(PCapabilities)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuSchedulerCapabilities(cnvmlDevice, cPCapabilities)
@@ -2957,8 +2957,8 @@ func nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice nvmlDevice, PCapabilities
 	return __v
 }
 
-// nvmlDeviceSetVgpuSchedulerState function as declared in nvml/nvml.h
-func nvmlDeviceSetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerSetState) Return {
+// cgo_nvmlDeviceSetVgpuSchedulerState function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerSetState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPSchedulerState, _ := (*C.nvmlVgpuSchedulerSetState_t)(unsafe.Pointer(PSchedulerState)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceSetVgpuSchedulerState(cnvmlDevice, cPSchedulerState)
@@ -2966,8 +2966,8 @@ func nvmlDeviceSetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *Vgp
 	return __v
 }
 
-// nvmlGetVgpuVersion function as declared in nvml/nvml.h
-func nvmlGetVgpuVersion(Supported *VgpuVersion, Current *VgpuVersion) Return {
+// cgo_nvmlGetVgpuVersion function as declared in nvml/nvml.h
+func cgo_nvmlGetVgpuVersion(Supported *VgpuVersion, Current *VgpuVersion) Return {
 	cSupported, _ := (*C.nvmlVgpuVersion_t)(unsafe.Pointer(Supported)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlVgpuVersion_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	__ret := C.nvmlGetVgpuVersion(cSupported, cCurrent)
@@ -2975,16 +2975,16 @@ func nvmlGetVgpuVersion(Supported *VgpuVersion, Current *VgpuVersion) Return {
 	return __v
 }
 
-// nvmlSetVgpuVersion function as declared in nvml/nvml.h
-func nvmlSetVgpuVersion(VgpuVersion *VgpuVersion) Return {
+// cgo_nvmlSetVgpuVersion function as declared in nvml/nvml.h
+func cgo_nvmlSetVgpuVersion(VgpuVersion *VgpuVersion) Return {
 	cVgpuVersion, _ := (*C.nvmlVgpuVersion_t)(unsafe.Pointer(VgpuVersion)), cgoAllocsUnknown
 	__ret := C.nvmlSetVgpuVersion(cVgpuVersion)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetVgpuUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, SampleValType *ValueType, VgpuInstanceSamplesCount *uint32, UtilizationSamples *VgpuInstanceUtilizationSample) Return {
+// cgo_nvmlDeviceGetVgpuUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, SampleValType *ValueType, VgpuInstanceSamplesCount *uint32, UtilizationSamples *VgpuInstanceUtilizationSample) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLastSeenTimeStamp, _ := (C.ulonglong)(LastSeenTimeStamp), cgoAllocsUnknown
 	cSampleValType, _ := (*C.nvmlValueType_t)(unsafe.Pointer(SampleValType)), cgoAllocsUnknown
@@ -2995,8 +2995,8 @@ func nvmlDeviceGetVgpuUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint6
 	return __v
 }
 
-// nvmlDeviceGetVgpuInstancesUtilizationInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice nvmlDevice, VgpuUtilInfo *VgpuInstancesUtilizationInfo) Return {
+// cgo_nvmlDeviceGetVgpuInstancesUtilizationInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice nvmlDevice, VgpuUtilInfo *VgpuInstancesUtilizationInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVgpuUtilInfo, _ := (*C.nvmlVgpuInstancesUtilizationInfo_t)(unsafe.Pointer(VgpuUtilInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuInstancesUtilizationInfo(cnvmlDevice, cVgpuUtilInfo)
@@ -3004,8 +3004,8 @@ func nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice nvmlDevice, VgpuUtilIn
 	return __v
 }
 
-// nvmlDeviceGetVgpuProcessUtilization function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuProcessUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, VgpuProcessSamplesCount *uint32, UtilizationSamples *VgpuProcessUtilizationSample) Return {
+// cgo_nvmlDeviceGetVgpuProcessUtilization function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuProcessUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, VgpuProcessSamplesCount *uint32, UtilizationSamples *VgpuProcessUtilizationSample) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLastSeenTimeStamp, _ := (C.ulonglong)(LastSeenTimeStamp), cgoAllocsUnknown
 	cVgpuProcessSamplesCount, _ := (*C.uint)(unsafe.Pointer(VgpuProcessSamplesCount)), cgoAllocsUnknown
@@ -3015,8 +3015,8 @@ func nvmlDeviceGetVgpuProcessUtilization(nvmlDevice nvmlDevice, LastSeenTimeStam
 	return __v
 }
 
-// nvmlDeviceGetVgpuProcessesUtilizationInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice nvmlDevice, VgpuProcUtilInfo *VgpuProcessesUtilizationInfo) Return {
+// cgo_nvmlDeviceGetVgpuProcessesUtilizationInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice nvmlDevice, VgpuProcUtilInfo *VgpuProcessesUtilizationInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cVgpuProcUtilInfo, _ := (*C.nvmlVgpuProcessesUtilizationInfo_t)(unsafe.Pointer(VgpuProcUtilInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetVgpuProcessesUtilizationInfo(cnvmlDevice, cVgpuProcUtilInfo)
@@ -3024,8 +3024,8 @@ func nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice nvmlDevice, VgpuProcUt
 	return __v
 }
 
-// nvmlVgpuInstanceGetAccountingMode function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance nvmlVgpuInstance, Mode *EnableState) Return {
+// cgo_nvmlVgpuInstanceGetAccountingMode function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance nvmlVgpuInstance, Mode *EnableState) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cMode, _ := (*C.nvmlEnableState_t)(unsafe.Pointer(Mode)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetAccountingMode(cnvmlVgpuInstance, cMode)
@@ -3033,8 +3033,8 @@ func nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance nvmlVgpuInstance, Mode *
 	return __v
 }
 
-// nvmlVgpuInstanceGetAccountingPids function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance nvmlVgpuInstance, Count *uint32, Pids *uint32) Return {
+// cgo_nvmlVgpuInstanceGetAccountingPids function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance nvmlVgpuInstance, Count *uint32, Pids *uint32) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
 	cPids, _ := (*C.uint)(unsafe.Pointer(Pids)), cgoAllocsUnknown
@@ -3043,8 +3043,8 @@ func nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance nvmlVgpuInstance, Count
 	return __v
 }
 
-// nvmlVgpuInstanceGetAccountingStats function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance nvmlVgpuInstance, Pid uint32, Stats *AccountingStats) Return {
+// cgo_nvmlVgpuInstanceGetAccountingStats function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance nvmlVgpuInstance, Pid uint32, Stats *AccountingStats) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cPid, _ := (C.uint)(Pid), cgoAllocsUnknown
 	cStats, _ := (*C.nvmlAccountingStats_t)(unsafe.Pointer(Stats)), cgoAllocsUnknown
@@ -3053,16 +3053,16 @@ func nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance nvmlVgpuInstance, Pid u
 	return __v
 }
 
-// nvmlVgpuInstanceClearAccountingPids function as declared in nvml/nvml.h
-func nvmlVgpuInstanceClearAccountingPids(nvmlVgpuInstance nvmlVgpuInstance) Return {
+// cgo_nvmlVgpuInstanceClearAccountingPids function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceClearAccountingPids(nvmlVgpuInstance nvmlVgpuInstance) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceClearAccountingPids(cnvmlVgpuInstance)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlVgpuInstanceGetLicenseInfo_v2 function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+// cgo_nvmlVgpuInstanceGetLicenseInfo_v2 function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cLicenseInfo, _ := (*C.nvmlVgpuLicenseInfo_t)(unsafe.Pointer(LicenseInfo)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetLicenseInfo_v2(cnvmlVgpuInstance, cLicenseInfo)
@@ -3070,16 +3070,16 @@ func nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance nvmlVgpuInstance, Licens
 	return __v
 }
 
-// nvmlGetExcludedDeviceCount function as declared in nvml/nvml.h
-func nvmlGetExcludedDeviceCount(DeviceCount *uint32) Return {
+// cgo_nvmlGetExcludedDeviceCount function as declared in nvml/nvml.h
+func cgo_nvmlGetExcludedDeviceCount(DeviceCount *uint32) Return {
 	cDeviceCount, _ := (*C.uint)(unsafe.Pointer(DeviceCount)), cgoAllocsUnknown
 	__ret := C.nvmlGetExcludedDeviceCount(cDeviceCount)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGetExcludedDeviceInfoByIndex function as declared in nvml/nvml.h
-func nvmlGetExcludedDeviceInfoByIndex(Index uint32, Info *ExcludedDeviceInfo) Return {
+// cgo_nvmlGetExcludedDeviceInfoByIndex function as declared in nvml/nvml.h
+func cgo_nvmlGetExcludedDeviceInfoByIndex(Index uint32, Info *ExcludedDeviceInfo) Return {
 	cIndex, _ := (C.uint)(Index), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlExcludedDeviceInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlGetExcludedDeviceInfoByIndex(cIndex, cInfo)
@@ -3087,8 +3087,8 @@ func nvmlGetExcludedDeviceInfoByIndex(Index uint32, Info *ExcludedDeviceInfo) Re
 	return __v
 }
 
-// nvmlDeviceReadWritePRM_v1 function as declared in nvml/nvml.h
-func nvmlDeviceReadWritePRM_v1(nvmlDevice nvmlDevice, Buffer *PRMTLV_v1) Return {
+// cgo_nvmlDeviceReadWritePRM_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceReadWritePRM_v1(nvmlDevice nvmlDevice, Buffer *PRMTLV_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cBuffer, _ := (*C.nvmlPRMTLV_v1_t)(unsafe.Pointer(Buffer)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceReadWritePRM_v1(cnvmlDevice, cBuffer)
@@ -3096,8 +3096,8 @@ func nvmlDeviceReadWritePRM_v1(nvmlDevice nvmlDevice, Buffer *PRMTLV_v1) Return
 	return __v
 }
 
-// nvmlDeviceSetMigMode function as declared in nvml/nvml.h
-func nvmlDeviceSetMigMode(nvmlDevice nvmlDevice, Mode uint32, ActivationStatus *Return) Return {
+// cgo_nvmlDeviceSetMigMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceSetMigMode(nvmlDevice nvmlDevice, Mode uint32, ActivationStatus *Return) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cMode, _ := (C.uint)(Mode), cgoAllocsUnknown
 	cActivationStatus, _ := (*C.nvmlReturn_t)(unsafe.Pointer(ActivationStatus)), cgoAllocsUnknown
@@ -3106,8 +3106,8 @@ func nvmlDeviceSetMigMode(nvmlDevice nvmlDevice, Mode uint32, ActivationStatus *
 	return __v
 }
 
-// nvmlDeviceGetMigMode function as declared in nvml/nvml.h
-func nvmlDeviceGetMigMode(nvmlDevice nvmlDevice, CurrentMode *uint32, PendingMode *uint32) Return {
+// cgo_nvmlDeviceGetMigMode function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMigMode(nvmlDevice nvmlDevice, CurrentMode *uint32, PendingMode *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrentMode, _ := (*C.uint)(unsafe.Pointer(CurrentMode)), cgoAllocsUnknown
 	cPendingMode, _ := (*C.uint)(unsafe.Pointer(PendingMode)), cgoAllocsUnknown
@@ -3116,8 +3116,8 @@ func nvmlDeviceGetMigMode(nvmlDevice nvmlDevice, CurrentMode *uint32, PendingMod
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceProfileInfo function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo) Return {
+// cgo_nvmlDeviceGetGpuInstanceProfileInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfile, _ := (C.uint)(Profile), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlGpuInstanceProfileInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
@@ -3126,8 +3126,8 @@ func nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice nvmlDevice, Profile uint32,
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceProfileInfoV function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo_v2) Return {
+// cgo_nvmlDeviceGetGpuInstanceProfileInfoV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfile, _ := (C.uint)(Profile), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlGpuInstanceProfileInfo_v2_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
@@ -3136,8 +3136,8 @@ func nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice nvmlDevice, Profile uint32,
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceProfileInfoByIdV function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice nvmlDevice, ProfileId uint32, Info *GpuInstanceProfileInfo_v2) Return {
+// cgo_nvmlDeviceGetGpuInstanceProfileInfoByIdV function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice nvmlDevice, ProfileId uint32, Info *GpuInstanceProfileInfo_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlGpuInstanceProfileInfo_v2_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
@@ -3146,8 +3146,8 @@ func nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice nvmlDevice, ProfileId u
 	return __v
 }
 
-// nvmlDeviceGetGpuInstancePossiblePlacements_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+// cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cPlacements, _ := (*C.nvmlGpuInstancePlacement_t)(unsafe.Pointer(Placements)), cgoAllocsUnknown
@@ -3157,8 +3157,8 @@ func nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice nvmlDevice, Profil
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceRemainingCapacity function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice nvmlDevice, ProfileId uint32, Count *uint32) Return {
+// cgo_nvmlDeviceGetGpuInstanceRemainingCapacity function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice nvmlDevice, ProfileId uint32, Count *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
@@ -3167,8 +3167,8 @@ func nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice nvmlDevice, ProfileId
 	return __v
 }
 
-// nvmlDeviceCreateGpuInstance function as declared in nvml/nvml.h
-func nvmlDeviceCreateGpuInstance(nvmlDevice nvmlDevice, ProfileId uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+// cgo_nvmlDeviceCreateGpuInstance function as declared in nvml/nvml.h
+func cgo_nvmlDeviceCreateGpuInstance(nvmlDevice nvmlDevice, ProfileId uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cnvmlGpuInstance, _ := (*C.nvmlGpuInstance_t)(unsafe.Pointer(nvmlGpuInstance)), cgoAllocsUnknown
@@ -3177,8 +3177,8 @@ func nvmlDeviceCreateGpuInstance(nvmlDevice nvmlDevice, ProfileId uint32, nvmlGp
 	return __v
 }
 
-// nvmlDeviceCreateGpuInstanceWithPlacement function as declared in nvml/nvml.h
-func nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice nvmlDevice, ProfileId uint32, Placement *GpuInstancePlacement, nvmlGpuInstance *nvmlGpuInstance) Return {
+// cgo_nvmlDeviceCreateGpuInstanceWithPlacement function as declared in nvml/nvml.h
+func cgo_nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice nvmlDevice, ProfileId uint32, Placement *GpuInstancePlacement, nvmlGpuInstance *nvmlGpuInstance) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cPlacement, _ := (*C.nvmlGpuInstancePlacement_t)(unsafe.Pointer(Placement)), cgoAllocsUnknown
@@ -3188,16 +3188,16 @@ func nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice nvmlDevice, ProfileId u
 	return __v
 }
 
-// nvmlGpuInstanceDestroy function as declared in nvml/nvml.h
-func nvmlGpuInstanceDestroy(nvmlGpuInstance nvmlGpuInstance) Return {
+// cgo_nvmlGpuInstanceDestroy function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceDestroy(nvmlGpuInstance nvmlGpuInstance) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceDestroy(cnvmlGpuInstance)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetGpuInstances function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstances(nvmlDevice nvmlDevice, ProfileId uint32, GpuInstances *nvmlGpuInstance, Count *uint32) Return {
+// cgo_nvmlDeviceGetGpuInstances function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstances(nvmlDevice nvmlDevice, ProfileId uint32, GpuInstances *nvmlGpuInstance, Count *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cGpuInstances, _ := (*C.nvmlGpuInstance_t)(unsafe.Pointer(GpuInstances)), cgoAllocsUnknown
@@ -3207,8 +3207,8 @@ func nvmlDeviceGetGpuInstances(nvmlDevice nvmlDevice, ProfileId uint32, GpuInsta
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceById function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceById(nvmlDevice nvmlDevice, Id uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+// cgo_nvmlDeviceGetGpuInstanceById function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceById(nvmlDevice nvmlDevice, Id uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cId, _ := (C.uint)(Id), cgoAllocsUnknown
 	cnvmlGpuInstance, _ := (*C.nvmlGpuInstance_t)(unsafe.Pointer(nvmlGpuInstance)), cgoAllocsUnknown
@@ -3217,8 +3217,8 @@ func nvmlDeviceGetGpuInstanceById(nvmlDevice nvmlDevice, Id uint32, nvmlGpuInsta
 	return __v
 }
 
-// nvmlGpuInstanceGetInfo function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetInfo(nvmlGpuInstance nvmlGpuInstance, Info *nvmlGpuInstanceInfo) Return {
+// cgo_nvmlGpuInstanceGetInfo function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetInfo(nvmlGpuInstance nvmlGpuInstance, Info *nvmlGpuInstanceInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlGpuInstanceInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlGpuInstanceGetInfo(cnvmlGpuInstance, cInfo)
@@ -3226,8 +3226,8 @@ func nvmlGpuInstanceGetInfo(nvmlGpuInstance nvmlGpuInstance, Info *nvmlGpuInstan
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstanceProfileInfo function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo) Return {
+// cgo_nvmlGpuInstanceGetComputeInstanceProfileInfo function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfile, _ := (C.uint)(Profile), cgoAllocsUnknown
 	cEngProfile, _ := (C.uint)(EngProfile), cgoAllocsUnknown
@@ -3237,8 +3237,8 @@ func nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance nvmlGpuInstanc
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstanceProfileInfoV function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo_v2) Return {
+// cgo_nvmlGpuInstanceGetComputeInstanceProfileInfoV function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo_v2) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfile, _ := (C.uint)(Profile), cgoAllocsUnknown
 	cEngProfile, _ := (C.uint)(EngProfile), cgoAllocsUnknown
@@ -3248,8 +3248,8 @@ func nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance nvmlGpuInstan
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstanceRemainingCapacity function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Count *uint32) Return {
+// cgo_nvmlGpuInstanceGetComputeInstanceRemainingCapacity function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Count *uint32) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
@@ -3258,8 +3258,8 @@ func nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance nvmlGpuI
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstancePossiblePlacements function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placements *ComputeInstancePlacement, Count *uint32) Return {
+// cgo_nvmlGpuInstanceGetComputeInstancePossiblePlacements function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placements *ComputeInstancePlacement, Count *uint32) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cPlacements, _ := (*C.nvmlComputeInstancePlacement_t)(unsafe.Pointer(Placements)), cgoAllocsUnknown
@@ -3269,8 +3269,8 @@ func nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance nvmlGpu
 	return __v
 }
 
-// nvmlGpuInstanceCreateComputeInstance function as declared in nvml/nvml.h
-func nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+// cgo_nvmlGpuInstanceCreateComputeInstance function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cnvmlComputeInstance, _ := (*C.nvmlComputeInstance_t)(unsafe.Pointer(nvmlComputeInstance)), cgoAllocsUnknown
@@ -3279,8 +3279,8 @@ func nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance nvmlGpuInstance, Profi
 	return __v
 }
 
-// nvmlGpuInstanceCreateComputeInstanceWithPlacement function as declared in nvml/nvml.h
-func nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placement *ComputeInstancePlacement, nvmlComputeInstance *nvmlComputeInstance) Return {
+// cgo_nvmlGpuInstanceCreateComputeInstanceWithPlacement function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placement *ComputeInstancePlacement, nvmlComputeInstance *nvmlComputeInstance) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cPlacement, _ := (*C.nvmlComputeInstancePlacement_t)(unsafe.Pointer(Placement)), cgoAllocsUnknown
@@ -3290,16 +3290,16 @@ func nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance nvmlGpuIn
 	return __v
 }
 
-// nvmlComputeInstanceDestroy function as declared in nvml/nvml.h
-func nvmlComputeInstanceDestroy(nvmlComputeInstance nvmlComputeInstance) Return {
+// cgo_nvmlComputeInstanceDestroy function as declared in nvml/nvml.h
+func cgo_nvmlComputeInstanceDestroy(nvmlComputeInstance nvmlComputeInstance) Return {
 	cnvmlComputeInstance, _ := *(*C.nvmlComputeInstance_t)(unsafe.Pointer(&nvmlComputeInstance)), cgoAllocsUnknown
 	__ret := C.nvmlComputeInstanceDestroy(cnvmlComputeInstance)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstances function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, ComputeInstances *nvmlComputeInstance, Count *uint32) Return {
+// cgo_nvmlGpuInstanceGetComputeInstances function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, ComputeInstances *nvmlComputeInstance, Count *uint32) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cComputeInstances, _ := (*C.nvmlComputeInstance_t)(unsafe.Pointer(ComputeInstances)), cgoAllocsUnknown
@@ -3309,8 +3309,8 @@ func nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance nvmlGpuInstance, Profile
 	return __v
 }
 
-// nvmlGpuInstanceGetComputeInstanceById function as declared in nvml/nvml.h
-func nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance nvmlGpuInstance, Id uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+// cgo_nvmlGpuInstanceGetComputeInstanceById function as declared in nvml/nvml.h
+func cgo_nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance nvmlGpuInstance, Id uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
 	cnvmlGpuInstance, _ := *(*C.nvmlGpuInstance_t)(unsafe.Pointer(&nvmlGpuInstance)), cgoAllocsUnknown
 	cId, _ := (C.uint)(Id), cgoAllocsUnknown
 	cnvmlComputeInstance, _ := (*C.nvmlComputeInstance_t)(unsafe.Pointer(nvmlComputeInstance)), cgoAllocsUnknown
@@ -3319,8 +3319,8 @@ func nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance nvmlGpuInstance, Id u
 	return __v
 }
 
-// nvmlComputeInstanceGetInfo_v2 function as declared in nvml/nvml.h
-func nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+// cgo_nvmlComputeInstanceGetInfo_v2 function as declared in nvml/nvml.h
+func cgo_nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
 	cnvmlComputeInstance, _ := *(*C.nvmlComputeInstance_t)(unsafe.Pointer(&nvmlComputeInstance)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlComputeInstanceInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlComputeInstanceGetInfo_v2(cnvmlComputeInstance, cInfo)
@@ -3328,8 +3328,8 @@ func nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance nvmlComputeInstance, Info
 	return __v
 }
 
-// nvmlDeviceIsMigDeviceHandle function as declared in nvml/nvml.h
-func nvmlDeviceIsMigDeviceHandle(nvmlDevice nvmlDevice, IsMigDevice *uint32) Return {
+// cgo_nvmlDeviceIsMigDeviceHandle function as declared in nvml/nvml.h
+func cgo_nvmlDeviceIsMigDeviceHandle(nvmlDevice nvmlDevice, IsMigDevice *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIsMigDevice, _ := (*C.uint)(unsafe.Pointer(IsMigDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceIsMigDeviceHandle(cnvmlDevice, cIsMigDevice)
@@ -3337,8 +3337,8 @@ func nvmlDeviceIsMigDeviceHandle(nvmlDevice nvmlDevice, IsMigDevice *uint32) Ret
 	return __v
 }
 
-// nvmlDeviceGetGpuInstanceId function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+// cgo_nvmlDeviceGetGpuInstanceId function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cId, _ := (*C.uint)(unsafe.Pointer(Id)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGpuInstanceId(cnvmlDevice, cId)
@@ -3346,8 +3346,8 @@ func nvmlDeviceGetGpuInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetComputeInstanceId function as declared in nvml/nvml.h
-func nvmlDeviceGetComputeInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+// cgo_nvmlDeviceGetComputeInstanceId function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetComputeInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cId, _ := (*C.uint)(unsafe.Pointer(Id)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetComputeInstanceId(cnvmlDevice, cId)
@@ -3355,8 +3355,8 @@ func nvmlDeviceGetComputeInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetMaxMigDeviceCount function as declared in nvml/nvml.h
-func nvmlDeviceGetMaxMigDeviceCount(nvmlDevice nvmlDevice, Count *uint32) Return {
+// cgo_nvmlDeviceGetMaxMigDeviceCount function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMaxMigDeviceCount(nvmlDevice nvmlDevice, Count *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCount, _ := (*C.uint)(unsafe.Pointer(Count)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetMaxMigDeviceCount(cnvmlDevice, cCount)
@@ -3364,8 +3364,8 @@ func nvmlDeviceGetMaxMigDeviceCount(nvmlDevice nvmlDevice, Count *uint32) Return
 	return __v
 }
 
-// nvmlDeviceGetMigDeviceHandleByIndex function as declared in nvml/nvml.h
-func nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice nvmlDevice, Index uint32, MigDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetMigDeviceHandleByIndex function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice nvmlDevice, Index uint32, MigDevice *nvmlDevice) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cIndex, _ := (C.uint)(Index), cgoAllocsUnknown
 	cMigDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(MigDevice)), cgoAllocsUnknown
@@ -3374,8 +3374,8 @@ func nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice nvmlDevice, Index uint32, Mi
 	return __v
 }
 
-// nvmlDeviceGetDeviceHandleFromMigDeviceHandle function as declared in nvml/nvml.h
-func nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice nvmlDevice, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetDeviceHandleFromMigDeviceHandle function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice nvmlDevice, nvmlDevice *nvmlDevice) Return {
 	cMigDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&MigDevice)), cgoAllocsUnknown
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetDeviceHandleFromMigDeviceHandle(cMigDevice, cnvmlDevice)
@@ -3383,32 +3383,32 @@ func nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice nvmlDevice, nvmlDevi
 	return __v
 }
 
-// nvmlGpmMetricsGet function as declared in nvml/nvml.h
-func nvmlGpmMetricsGet(MetricsGet *nvmlGpmMetricsGetType) Return {
+// cgo_nvmlGpmMetricsGet function as declared in nvml/nvml.h
+func cgo_nvmlGpmMetricsGet(MetricsGet *nvmlGpmMetricsGetType) Return {
 	cMetricsGet, _ := (*C.nvmlGpmMetricsGet_t)(unsafe.Pointer(MetricsGet)), cgoAllocsUnknown
 	__ret := C.nvmlGpmMetricsGet(cMetricsGet)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGpmSampleFree function as declared in nvml/nvml.h
-func nvmlGpmSampleFree(nvmlGpmSample nvmlGpmSample) Return {
+// cgo_nvmlGpmSampleFree function as declared in nvml/nvml.h
+func cgo_nvmlGpmSampleFree(nvmlGpmSample nvmlGpmSample) Return {
 	cnvmlGpmSample, _ := *(*C.nvmlGpmSample_t)(unsafe.Pointer(&nvmlGpmSample)), cgoAllocsUnknown
 	__ret := C.nvmlGpmSampleFree(cnvmlGpmSample)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGpmSampleAlloc function as declared in nvml/nvml.h
-func nvmlGpmSampleAlloc(nvmlGpmSample *nvmlGpmSample) Return {
+// cgo_nvmlGpmSampleAlloc function as declared in nvml/nvml.h
+func cgo_nvmlGpmSampleAlloc(nvmlGpmSample *nvmlGpmSample) Return {
 	cnvmlGpmSample, _ := (*C.nvmlGpmSample_t)(unsafe.Pointer(nvmlGpmSample)), cgoAllocsUnknown
 	__ret := C.nvmlGpmSampleAlloc(cnvmlGpmSample)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlGpmSampleGet function as declared in nvml/nvml.h
-func nvmlGpmSampleGet(nvmlDevice nvmlDevice, nvmlGpmSample nvmlGpmSample) Return {
+// cgo_nvmlGpmSampleGet function as declared in nvml/nvml.h
+func cgo_nvmlGpmSampleGet(nvmlDevice nvmlDevice, nvmlGpmSample nvmlGpmSample) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cnvmlGpmSample, _ := *(*C.nvmlGpmSample_t)(unsafe.Pointer(&nvmlGpmSample)), cgoAllocsUnknown
 	__ret := C.nvmlGpmSampleGet(cnvmlDevice, cnvmlGpmSample)
@@ -3416,8 +3416,8 @@ func nvmlGpmSampleGet(nvmlDevice nvmlDevice, nvmlGpmSample nvmlGpmSample) Return
 	return __v
 }
 
-// nvmlGpmMigSampleGet function as declared in nvml/nvml.h
-func nvmlGpmMigSampleGet(nvmlDevice nvmlDevice, GpuInstanceId uint32, nvmlGpmSample nvmlGpmSample) Return {
+// cgo_nvmlGpmMigSampleGet function as declared in nvml/nvml.h
+func cgo_nvmlGpmMigSampleGet(nvmlDevice nvmlDevice, GpuInstanceId uint32, nvmlGpmSample nvmlGpmSample) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpuInstanceId, _ := (C.uint)(GpuInstanceId), cgoAllocsUnknown
 	cnvmlGpmSample, _ := *(*C.nvmlGpmSample_t)(unsafe.Pointer(&nvmlGpmSample)), cgoAllocsUnknown
@@ -3426,8 +3426,8 @@ func nvmlGpmMigSampleGet(nvmlDevice nvmlDevice, GpuInstanceId uint32, nvmlGpmSam
 	return __v
 }
 
-// nvmlGpmQueryDeviceSupport function as declared in nvml/nvml.h
-func nvmlGpmQueryDeviceSupport(nvmlDevice nvmlDevice, GpmSupport *GpmSupport) Return {
+// cgo_nvmlGpmQueryDeviceSupport function as declared in nvml/nvml.h
+func cgo_nvmlGpmQueryDeviceSupport(nvmlDevice nvmlDevice, GpmSupport *GpmSupport) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cGpmSupport, _ := (*C.nvmlGpmSupport_t)(unsafe.Pointer(GpmSupport)), cgoAllocsUnknown
 	__ret := C.nvmlGpmQueryDeviceSupport(cnvmlDevice, cGpmSupport)
@@ -3435,8 +3435,8 @@ func nvmlGpmQueryDeviceSupport(nvmlDevice nvmlDevice, GpmSupport *GpmSupport) Re
 	return __v
 }
 
-// nvmlGpmQueryIfStreamingEnabled function as declared in nvml/nvml.h
-func nvmlGpmQueryIfStreamingEnabled(nvmlDevice nvmlDevice, State *uint32) Return {
+// cgo_nvmlGpmQueryIfStreamingEnabled function as declared in nvml/nvml.h
+func cgo_nvmlGpmQueryIfStreamingEnabled(nvmlDevice nvmlDevice, State *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cState, _ := (*C.uint)(unsafe.Pointer(State)), cgoAllocsUnknown
 	__ret := C.nvmlGpmQueryIfStreamingEnabled(cnvmlDevice, cState)
@@ -3444,8 +3444,8 @@ func nvmlGpmQueryIfStreamingEnabled(nvmlDevice nvmlDevice, State *uint32) Return
 	return __v
 }
 
-// nvmlGpmSetStreamingEnabled function as declared in nvml/nvml.h
-func nvmlGpmSetStreamingEnabled(nvmlDevice nvmlDevice, State uint32) Return {
+// cgo_nvmlGpmSetStreamingEnabled function as declared in nvml/nvml.h
+func cgo_nvmlGpmSetStreamingEnabled(nvmlDevice nvmlDevice, State uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cState, _ := (C.uint)(State), cgoAllocsUnknown
 	__ret := C.nvmlGpmSetStreamingEnabled(cnvmlDevice, cState)
@@ -3453,8 +3453,8 @@ func nvmlGpmSetStreamingEnabled(nvmlDevice nvmlDevice, State uint32) Return {
 	return __v
 }
 
-// nvmlDeviceGetCapabilities function as declared in nvml/nvml.h
-func nvmlDeviceGetCapabilities(nvmlDevice nvmlDevice, Caps *DeviceCapabilities) Return {
+// cgo_nvmlDeviceGetCapabilities function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCapabilities(nvmlDevice nvmlDevice, Caps *DeviceCapabilities) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCaps, _ := (*C.nvmlDeviceCapabilities_t)(unsafe.Pointer(Caps)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCapabilities(cnvmlDevice, cCaps)
@@ -3462,8 +3462,8 @@ func nvmlDeviceGetCapabilities(nvmlDevice nvmlDevice, Caps *DeviceCapabilities)
 	return __v
 }
 
-// nvmlDeviceWorkloadPowerProfileGetProfilesInfo function as declared in nvml/nvml.h
-func nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice nvmlDevice, ProfilesInfo *WorkloadPowerProfileProfilesInfo) Return {
+// cgo_nvmlDeviceWorkloadPowerProfileGetProfilesInfo function as declared in nvml/nvml.h
+func cgo_nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice nvmlDevice, ProfilesInfo *WorkloadPowerProfileProfilesInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfilesInfo, _ := (*C.nvmlWorkloadPowerProfileProfilesInfo_t)(unsafe.Pointer(ProfilesInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceWorkloadPowerProfileGetProfilesInfo(cnvmlDevice, cProfilesInfo)
@@ -3471,8 +3471,8 @@ func nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice nvmlDevice, Profil
 	return __v
 }
 
-// nvmlDeviceWorkloadPowerProfileGetCurrentProfiles function as declared in nvml/nvml.h
-func nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice nvmlDevice, CurrentProfiles *WorkloadPowerProfileCurrentProfiles) Return {
+// cgo_nvmlDeviceWorkloadPowerProfileGetCurrentProfiles function as declared in nvml/nvml.h
+func cgo_nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice nvmlDevice, CurrentProfiles *WorkloadPowerProfileCurrentProfiles) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrentProfiles, _ := (*C.nvmlWorkloadPowerProfileCurrentProfiles_t)(unsafe.Pointer(CurrentProfiles)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(cnvmlDevice, cCurrentProfiles)
@@ -3480,8 +3480,8 @@ func nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice nvmlDevice, Cur
 	return __v
 }
 
-// nvmlDeviceWorkloadPowerProfileSetRequestedProfiles function as declared in nvml/nvml.h
-func nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+// cgo_nvmlDeviceWorkloadPowerProfileSetRequestedProfiles function as declared in nvml/nvml.h
+func cgo_nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cRequestedProfiles, _ := (*C.nvmlWorkloadPowerProfileRequestedProfiles_t)(unsafe.Pointer(RequestedProfiles)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(cnvmlDevice, cRequestedProfiles)
@@ -3489,8 +3489,8 @@ func nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice nvmlDevice, R
 	return __v
 }
 
-// nvmlDeviceWorkloadPowerProfileClearRequestedProfiles function as declared in nvml/nvml.h
-func nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+// cgo_nvmlDeviceWorkloadPowerProfileClearRequestedProfiles function as declared in nvml/nvml.h
+func cgo_nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cRequestedProfiles, _ := (*C.nvmlWorkloadPowerProfileRequestedProfiles_t)(unsafe.Pointer(RequestedProfiles)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(cnvmlDevice, cRequestedProfiles)
@@ -3498,8 +3498,8 @@ func nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice nvmlDevice,
 	return __v
 }
 
-// nvmlDevicePowerSmoothingActivatePresetProfile function as declared in nvml/nvml.h
-func nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+// cgo_nvmlDevicePowerSmoothingActivatePresetProfile function as declared in nvml/nvml.h
+func cgo_nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfile, _ := (*C.nvmlPowerSmoothingProfile_t)(unsafe.Pointer(Profile)), cgoAllocsUnknown
 	__ret := C.nvmlDevicePowerSmoothingActivatePresetProfile(cnvmlDevice, cProfile)
@@ -3507,8 +3507,8 @@ func nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice nvmlDevice, Profil
 	return __v
 }
 
-// nvmlDevicePowerSmoothingUpdatePresetProfileParam function as declared in nvml/nvml.h
-func nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+// cgo_nvmlDevicePowerSmoothingUpdatePresetProfileParam function as declared in nvml/nvml.h
+func cgo_nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfile, _ := (*C.nvmlPowerSmoothingProfile_t)(unsafe.Pointer(Profile)), cgoAllocsUnknown
 	__ret := C.nvmlDevicePowerSmoothingUpdatePresetProfileParam(cnvmlDevice, cProfile)
@@ -3516,8 +3516,8 @@ func nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice nvmlDevice, Pro
 	return __v
 }
 
-// nvmlDevicePowerSmoothingSetState function as declared in nvml/nvml.h
-func nvmlDevicePowerSmoothingSetState(nvmlDevice nvmlDevice, State *PowerSmoothingState) Return {
+// cgo_nvmlDevicePowerSmoothingSetState function as declared in nvml/nvml.h
+func cgo_nvmlDevicePowerSmoothingSetState(nvmlDevice nvmlDevice, State *PowerSmoothingState) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cState, _ := (*C.nvmlPowerSmoothingState_t)(unsafe.Pointer(State)), cgoAllocsUnknown
 	__ret := C.nvmlDevicePowerSmoothingSetState(cnvmlDevice, cState)
@@ -3525,8 +3525,8 @@ func nvmlDevicePowerSmoothingSetState(nvmlDevice nvmlDevice, State *PowerSmoothi
 	return __v
 }
 
-// nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts function as declared in nvml/nvml.h
-func nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice nvmlDevice, ErrorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+// cgo_nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice nvmlDevice, ErrorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cErrorCounts, _ := (*C.nvmlEccSramUniqueUncorrectedErrorCounts_t)(unsafe.Pointer(ErrorCounts)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(cnvmlDevice, cErrorCounts)
@@ -3534,23 +3534,23 @@ func nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice nvmlDevice, Err
 	return __v
 }
 
-// nvmlInit_v1 function as declared in nvml/nvml.h
-func nvmlInit_v1() Return {
+// cgo_nvmlInit_v1 function as declared in nvml/nvml.h
+func cgo_nvmlInit_v1() Return {
 	__ret := C.nvmlInit()
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetCount_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetCount_v1(DeviceCount *uint32) Return {
+// cgo_nvmlDeviceGetCount_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetCount_v1(DeviceCount *uint32) Return {
 	cDeviceCount, _ := (*C.uint)(unsafe.Pointer(DeviceCount)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetCount(cDeviceCount)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlDeviceGetHandleByIndex_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByIndex_v1(Index uint32, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByIndex_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByIndex_v1(Index uint32, nvmlDevice *nvmlDevice) Return {
 	cIndex, _ := (C.uint)(Index), cgoAllocsUnknown
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByIndex(cIndex, cnvmlDevice)
@@ -3558,8 +3558,8 @@ func nvmlDeviceGetHandleByIndex_v1(Index uint32, nvmlDevice *nvmlDevice) Return
 	return __v
 }
 
-// nvmlDeviceGetHandleByPciBusId_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetHandleByPciBusId_v1(PciBusId string, nvmlDevice *nvmlDevice) Return {
+// cgo_nvmlDeviceGetHandleByPciBusId_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetHandleByPciBusId_v1(PciBusId string, nvmlDevice *nvmlDevice) Return {
 	cPciBusId, _ := unpackPCharString(PciBusId)
 	cnvmlDevice, _ := (*C.nvmlDevice_t)(unsafe.Pointer(nvmlDevice)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetHandleByPciBusId(cPciBusId, cnvmlDevice)
@@ -3567,8 +3567,8 @@ func nvmlDeviceGetHandleByPciBusId_v1(PciBusId string, nvmlDevice *nvmlDevice) R
 	return __v
 }
 
-// nvmlDeviceGetPciInfo_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetPciInfo_v1(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+// cgo_nvmlDeviceGetPciInfo_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPciInfo_v1(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPciInfo(cnvmlDevice, cPci)
@@ -3576,8 +3576,8 @@ func nvmlDeviceGetPciInfo_v1(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	return __v
 }
 
-// nvmlDeviceGetPciInfo_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetPciInfo_v2(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+// cgo_nvmlDeviceGetPciInfo_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetPciInfo_v2(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetPciInfo_v2(cnvmlDevice, cPci)
@@ -3585,8 +3585,8 @@ func nvmlDeviceGetPciInfo_v2(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
 	return __v
 }
 
-// nvmlDeviceGetNvLinkRemotePciInfo_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+// cgo_nvmlDeviceGetNvLinkRemotePciInfo_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cLink, _ := (C.uint)(Link), cgoAllocsUnknown
 	cPci, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(Pci)), cgoAllocsUnknown
@@ -3595,8 +3595,8 @@ func nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice nvmlDevice, Link uint32, Pci
 	return __v
 }
 
-// nvmlDeviceGetGridLicensableFeatures_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+// cgo_nvmlDeviceGetGridLicensableFeatures_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPGridLicensableFeatures, _ := (*C.nvmlGridLicensableFeatures_t)(unsafe.Pointer(PGridLicensableFeatures)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGridLicensableFeatures(cnvmlDevice, cPGridLicensableFeatures)
@@ -3604,8 +3604,8 @@ func nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice nvmlDevice, PGridLicensab
 	return __v
 }
 
-// nvmlDeviceGetGridLicensableFeatures_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+// cgo_nvmlDeviceGetGridLicensableFeatures_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPGridLicensableFeatures, _ := (*C.nvmlGridLicensableFeatures_t)(unsafe.Pointer(PGridLicensableFeatures)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGridLicensableFeatures_v2(cnvmlDevice, cPGridLicensableFeatures)
@@ -3613,8 +3613,8 @@ func nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice nvmlDevice, PGridLicensab
 	return __v
 }
 
-// nvmlDeviceGetGridLicensableFeatures_v3 function as declared in nvml/nvml.h
-func nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+// cgo_nvmlDeviceGetGridLicensableFeatures_v3 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cPGridLicensableFeatures, _ := (*C.nvmlGridLicensableFeatures_t)(unsafe.Pointer(PGridLicensableFeatures)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetGridLicensableFeatures_v3(cnvmlDevice, cPGridLicensableFeatures)
@@ -3622,16 +3622,16 @@ func nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice nvmlDevice, PGridLicensab
 	return __v
 }
 
-// nvmlDeviceRemoveGpu_v1 function as declared in nvml/nvml.h
-func nvmlDeviceRemoveGpu_v1(PciInfo *PciInfo) Return {
+// cgo_nvmlDeviceRemoveGpu_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceRemoveGpu_v1(PciInfo *PciInfo) Return {
 	cPciInfo, _ := (*C.nvmlPciInfo_t)(unsafe.Pointer(PciInfo)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceRemoveGpu(cPciInfo)
 	__v := (Return)(__ret)
 	return __v
 }
 
-// nvmlEventSetWait_v1 function as declared in nvml/nvml.h
-func nvmlEventSetWait_v1(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+// cgo_nvmlEventSetWait_v1 function as declared in nvml/nvml.h
+func cgo_nvmlEventSetWait_v1(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
 	cSet, _ := *(*C.nvmlEventSet_t)(unsafe.Pointer(&Set)), cgoAllocsUnknown
 	cData, _ := (*C.nvmlEventData_t)(unsafe.Pointer(Data)), cgoAllocsUnknown
 	cTimeoutms, _ := (C.uint)(Timeoutms), cgoAllocsUnknown
@@ -3640,8 +3640,8 @@ func nvmlEventSetWait_v1(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32
 	return __v
 }
 
-// nvmlDeviceGetAttributes_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetAttributes_v1(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+// cgo_nvmlDeviceGetAttributes_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetAttributes_v1(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cAttributes, _ := (*C.nvmlDeviceAttributes_t)(unsafe.Pointer(Attributes)), cgoAllocsUnknown
 	__ret := C.nvmlDeviceGetAttributes(cnvmlDevice, cAttributes)
@@ -3649,8 +3649,8 @@ func nvmlDeviceGetAttributes_v1(nvmlDevice nvmlDevice, Attributes *DeviceAttribu
 	return __v
 }
 
-// nvmlComputeInstanceGetInfo_v1 function as declared in nvml/nvml.h
-func nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+// cgo_nvmlComputeInstanceGetInfo_v1 function as declared in nvml/nvml.h
+func cgo_nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
 	cnvmlComputeInstance, _ := *(*C.nvmlComputeInstance_t)(unsafe.Pointer(&nvmlComputeInstance)), cgoAllocsUnknown
 	cInfo, _ := (*C.nvmlComputeInstanceInfo_t)(unsafe.Pointer(Info)), cgoAllocsUnknown
 	__ret := C.nvmlComputeInstanceGetInfo(cnvmlComputeInstance, cInfo)
@@ -3658,8 +3658,8 @@ func nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance nvmlComputeInstance, Info
 	return __v
 }
 
-// nvmlDeviceGetComputeRunningProcesses_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+// cgo_nvmlDeviceGetComputeRunningProcesses_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v1_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3668,8 +3668,8 @@ func nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *u
 	return __v
 }
 
-// nvmlDeviceGetComputeRunningProcesses_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+// cgo_nvmlDeviceGetComputeRunningProcesses_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v2_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3678,8 +3678,8 @@ func nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *u
 	return __v
 }
 
-// nvmlDeviceGetGraphicsRunningProcesses_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+// cgo_nvmlDeviceGetGraphicsRunningProcesses_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v1_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3688,8 +3688,8 @@ func nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *
 	return __v
 }
 
-// nvmlDeviceGetGraphicsRunningProcesses_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+// cgo_nvmlDeviceGetGraphicsRunningProcesses_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v2_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3698,8 +3698,8 @@ func nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *
 	return __v
 }
 
-// nvmlDeviceGetMPSComputeRunningProcesses_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+// cgo_nvmlDeviceGetMPSComputeRunningProcesses_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v1_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3708,8 +3708,8 @@ func nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount
 	return __v
 }
 
-// nvmlDeviceGetMPSComputeRunningProcesses_v2 function as declared in nvml/nvml.h
-func nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+// cgo_nvmlDeviceGetMPSComputeRunningProcesses_v2 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cInfoCount, _ := (*C.uint)(unsafe.Pointer(InfoCount)), cgoAllocsUnknown
 	cInfos, _ := (*C.nvmlProcessInfo_v2_t)(unsafe.Pointer(Infos)), cgoAllocsUnknown
@@ -3718,8 +3718,8 @@ func nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount
 	return __v
 }
 
-// nvmlDeviceGetGpuInstancePossiblePlacements_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+// cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cProfileId, _ := (C.uint)(ProfileId), cgoAllocsUnknown
 	cPlacements, _ := (*C.nvmlGpuInstancePlacement_t)(unsafe.Pointer(Placements)), cgoAllocsUnknown
@@ -3729,8 +3729,8 @@ func nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice nvmlDevice, Profil
 	return __v
 }
 
-// nvmlVgpuInstanceGetLicenseInfo_v1 function as declared in nvml/nvml.h
-func nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+// cgo_nvmlVgpuInstanceGetLicenseInfo_v1 function as declared in nvml/nvml.h
+func cgo_nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
 	cnvmlVgpuInstance, _ := (C.nvmlVgpuInstance_t)(nvmlVgpuInstance), cgoAllocsUnknown
 	cLicenseInfo, _ := (*C.nvmlVgpuLicenseInfo_t)(unsafe.Pointer(LicenseInfo)), cgoAllocsUnknown
 	__ret := C.nvmlVgpuInstanceGetLicenseInfo(cnvmlVgpuInstance, cLicenseInfo)
@@ -3738,8 +3738,8 @@ func nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance nvmlVgpuInstance, Licens
 	return __v
 }
 
-// nvmlDeviceGetDriverModel_v1 function as declared in nvml/nvml.h
-func nvmlDeviceGetDriverModel_v1(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+// cgo_nvmlDeviceGetDriverModel_v1 function as declared in nvml/nvml.h
+func cgo_nvmlDeviceGetDriverModel_v1(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
 	cnvmlDevice, _ := *(*C.nvmlDevice_t)(unsafe.Pointer(&nvmlDevice)), cgoAllocsUnknown
 	cCurrent, _ := (*C.nvmlDriverModel_t)(unsafe.Pointer(Current)), cgoAllocsUnknown
 	cPending, _ := (*C.nvmlDriverModel_t)(unsafe.Pointer(Pending)), cgoAllocsUnknown
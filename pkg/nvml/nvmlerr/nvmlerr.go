@@ -0,0 +1,122 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package nvmlerr provides the same API as the nvml package, with methods
+// that return an nvml.Return returning an error instead.
+//
+// A non-success nvml.Return is returned as an *Error that records the NVML
+// function that was called and the device that it was called for. The
+// nvml.Return can be checked using errors.Is:
+//
+//	lib := nvmlerr.New(nvml.New())
+//	...
+//	name, err := device.GetName()
+//	if errors.Is(err, nvml.ERROR_NOT_SUPPORTED) {
+//		...
+//	}
+//
+// The handlers that are returned for versioned structs (e.g. by
+// GetGpuFabricInfoV) are the handlers of the nvml package and are not
+// wrapped. Their V1, V2, V3, and Negotiate methods still return an
+// nvml.Return, which has to be checked against nvml.SUCCESS:
+//
+//	info, ret := device.GetGpuFabricInfoV().Negotiate()
+//	if ret != nvml.SUCCESS {
+//		...
+//	}
+//
+// The interfaces in this package are generated from the nvml package.
+package nvmlerr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Error represents an NVML call that did not succeed.
+type Error struct {
+	// Function is the NVML function that was called (e.g. nvmlDeviceGetName).
+	Function string
+	// Device is the device that the function was called for. This is nil if
+	// the function was not called for a device.
+	Device nvml.Device
+	// Return is the value returned by the NVML function.
+	Return nvml.Return
+
+	uuidOnce sync.Once
+	uuid     string
+}
+
+// Error returns the string representation of the error.
+func (e *Error) Error() string {
+	uuid := e.UUID()
+	if uuid == "" {
+		return fmt.Sprintf("%s: %v", e.Function, e.Return)
+	}
+	return fmt.Sprintf("%s (device %s): %v", e.Function, uuid, e.Return)
+}
+
+// UUID returns the UUID of the device that the function was called for. The
+// UUID is only queried from the device the first time that this is called
+// (e.g. when the error is formatted), so that failed calls do not make an
+// additional NVML call. This is empty if the function was not called for a
+// device or if the UUID could not be queried (e.g. if the GPU is lost).
+func (e *Error) UUID() string {
+	e.uuidOnce.Do(func() {
+		if e.Device == nil {
+			return
+		}
+		if uuid, ret := e.Device.GetUUID(); ret == nvml.SUCCESS {
+			e.uuid = uuid
+		}
+	})
+	return e.uuid
+}
+
+// Unwrap returns the nvml.Return so that errors.Is can be used to check for
+// specific return values.
+func (e *Error) Unwrap() error {
+	return e.Return
+}
+
+// New wraps the specified nvml.Interface.
+func New(l nvml.Interface) Interface {
+	if l == nil {
+		return nil
+	}
+	return &lib{Interface: l}
+}
+
+// NewDevice wraps the specified nvml.Device. This allows devices obtained
+// directly from the nvml package (e.g. from an nvml.EventData) to be used.
+func NewDevice(device nvml.Device) Device {
+	return wrapDevice(device)
+}
+
+// newError returns an error for the specified nvml.Return, or nil if ret is
+// nvml.SUCCESS.
+func newError(function string, device nvml.Device, ret nvml.Return) error {
+	if ret == nvml.SUCCESS {
+		return nil
+	}
+	return &Error{
+		Function: function,
+		Device:   device,
+		Return:   ret,
+	}
+}
@@ -0,0 +1,129 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvmlerr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+)
+
+func TestDeviceErrors(t *testing.T) {
+	var uuidCalls int
+	device := &mock.Device{
+		GetNameFunc: func() (string, nvml.Return) {
+			return "", nvml.ERROR_NOT_SUPPORTED
+		},
+		GetUUIDFunc: func() (string, nvml.Return) {
+			uuidCalls++
+			return "GPU-0", nvml.SUCCESS
+		},
+		GetMinorNumberFunc: func() (int, nvml.Return) {
+			return 1, nvml.SUCCESS
+		},
+	}
+	lib := New(&mock.Interface{
+		DeviceGetHandleByIndexFunc: func(n int) (nvml.Device, nvml.Return) {
+			if n != 0 {
+				return nil, nvml.ERROR_INVALID_ARGUMENT
+			}
+			return device, nvml.SUCCESS
+		},
+		DeviceGetNameFunc: func(d nvml.Device) (string, nvml.Return) {
+			return d.GetName()
+		},
+	})
+
+	_, err := lib.DeviceGetHandleByIndex(1)
+	require.ErrorIs(t, err, nvml.ERROR_INVALID_ARGUMENT)
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, &Error{Function: "nvmlDeviceGetHandleByIndex", Return: nvml.ERROR_INVALID_ARGUMENT}, e)
+
+	d, err := lib.DeviceGetHandleByIndex(0)
+	require.NoError(t, err)
+	require.Same(t, device, d.Unwrap())
+
+	minor, err := d.GetMinorNumber()
+	require.NoError(t, err)
+	require.Equal(t, 1, minor)
+
+	_, err = d.GetName()
+	require.ErrorIs(t, err, nvml.ERROR_NOT_SUPPORTED)
+	require.False(t, errors.Is(err, nvml.ERROR_UNKNOWN))
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, "nvmlDeviceGetName", e.Function)
+	require.Same(t, device, e.Device)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, e.Return)
+
+	// The UUID of the device is only queried once the error is formatted.
+	require.Zero(t, uuidCalls)
+	require.Equal(t, "nvmlDeviceGetName (device GPU-0): ERROR_NOT_SUPPORTED", err.Error())
+	require.Equal(t, "GPU-0", e.UUID())
+	require.Equal(t, 1, uuidCalls)
+
+	_, err = lib.DeviceGetName(d)
+	require.ErrorAs(t, err, &e)
+	require.Same(t, device, e.Device)
+	require.Equal(t, "GPU-0", e.UUID())
+}
+
+func TestLostDeviceError(t *testing.T) {
+	device := &mock.Device{
+		GetTemperatureFunc: func(sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return 0, nvml.ERROR_GPU_IS_LOST
+		},
+		GetUUIDFunc: func() (string, nvml.Return) {
+			return "", nvml.ERROR_GPU_IS_LOST
+		},
+	}
+
+	_, err := NewDevice(device).GetTemperature(nvml.TEMPERATURE_GPU)
+	require.ErrorIs(t, err, nvml.ERROR_GPU_IS_LOST)
+	require.Equal(t, "nvmlDeviceGetTemperature: ERROR_GPU_IS_LOST", err.Error())
+}
+
+func TestHandleSlices(t *testing.T) {
+	devices := []nvml.Device{&mock.Device{}, &mock.Device{}}
+	unit := &mock.Unit{
+		GetDevicesFunc: func() ([]nvml.Device, nvml.Return) {
+			return devices, nvml.SUCCESS
+		},
+	}
+	lib := New(&mock.Interface{
+		UnitGetHandleByIndexFunc: func(n int) (nvml.Unit, nvml.Return) {
+			return unit, nvml.SUCCESS
+		},
+	})
+
+	u, err := lib.UnitGetHandleByIndex(0)
+	require.NoError(t, err)
+
+	wrapped, err := u.GetDevices()
+	require.NoError(t, err)
+	require.Len(t, wrapped, len(devices))
+	for i := range devices {
+		require.Same(t, devices[i], wrapped[i].Unwrap())
+	}
+
+	require.Nil(t, NewDevice(nil))
+	require.Nil(t, New(nil))
+}
@@ -0,0 +1,4576 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvmlerr
+
+import (
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// Interface represents the interface for the nvml.Interface type.
+// Methods that return an nvml.Return return an error instead.
+type Interface interface {
+	ComputeInstanceDestroy(computeInstance ComputeInstance) error
+	ComputeInstanceGetInfo(computeInstance ComputeInstance) (nvml.ComputeInstanceInfo, error)
+	DeviceClearAccountingPids(device Device) error
+	DeviceClearCpuAffinity(device Device) error
+	DeviceClearEccErrorCounts(device Device, counterType nvml.EccCounterType) error
+	DeviceClearFieldValues(device Device, values []nvml.FieldValue) error
+	DeviceCreateGpuInstance(device Device, info *nvml.GpuInstanceProfileInfo) (GpuInstance, error)
+	DeviceCreateGpuInstanceWithPlacement(device Device, info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (GpuInstance, error)
+	DeviceDiscoverGpus() (nvml.PciInfo, error)
+	DeviceFreezeNvLinkUtilizationCounter(device Device, link int, counter int, freeze nvml.EnableState) error
+	DeviceGetAPIRestriction(device Device, apiType nvml.RestrictedAPI) (nvml.EnableState, error)
+	DeviceGetAccountingBufferSize(device Device) (int, error)
+	DeviceGetAccountingMode(device Device) (nvml.EnableState, error)
+	DeviceGetAccountingPids(device Device) ([]int, error)
+	DeviceGetAccountingStats(device Device, pid uint32) (nvml.AccountingStats, error)
+	DeviceGetActiveVgpus(device Device) ([]VgpuInstance, error)
+	DeviceGetAdaptiveClockInfoStatus(device Device) (uint32, error)
+	DeviceGetAddressingMode(device Device) (nvml.DeviceAddressingMode, error)
+	DeviceGetApplicationsClock(device Device, clockType nvml.ClockType) (uint32, error)
+	DeviceGetArchitecture(device Device) (nvml.DeviceArchitecture, error)
+	DeviceGetAttributes(device Device) (nvml.DeviceAttributes, error)
+	DeviceGetAutoBoostedClocksEnabled(device Device) (nvml.EnableState, nvml.EnableState, error)
+	DeviceGetBAR1MemoryInfo(device Device) (nvml.BAR1Memory, error)
+	DeviceGetBoardId(device Device) (uint32, error)
+	DeviceGetBoardPartNumber(device Device) (string, error)
+	DeviceGetBrand(device Device) (nvml.BrandType, error)
+	DeviceGetBridgeChipInfo(device Device) (nvml.BridgeChipHierarchy, error)
+	DeviceGetBusType(device Device) (nvml.BusType, error)
+	DeviceGetC2cModeInfoV(device Device) nvml.C2cModeInfoHandler
+	DeviceGetCapabilities(device Device) (nvml.DeviceCapabilities, error)
+	DeviceGetClkMonStatus(device Device) (nvml.ClkMonStatus, error)
+	DeviceGetClock(device Device, clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error)
+	DeviceGetClockInfo(device Device, clockType nvml.ClockType) (uint32, error)
+	DeviceGetClockOffsets(device Device) (nvml.ClockOffset, error)
+	DeviceGetComputeInstanceId(device Device) (int, error)
+	DeviceGetComputeMode(device Device) (nvml.ComputeMode, error)
+	DeviceGetComputeRunningProcesses(device Device) ([]nvml.ProcessInfo, error)
+	DeviceGetConfComputeGpuAttestationReport(device Device, gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error
+	DeviceGetConfComputeGpuCertificate(device Device) (nvml.ConfComputeGpuCertificate, error)
+	DeviceGetConfComputeMemSizeInfo(device Device) (nvml.ConfComputeMemSizeInfo, error)
+	DeviceGetConfComputeProtectedMemoryUsage(device Device) (nvml.Memory, error)
+	DeviceGetCoolerInfo(device Device) (nvml.CoolerInfo, error)
+	DeviceGetCount() (int, error)
+	DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, error)
+	DeviceGetCpuAffinityWithinScope(device Device, numCPUs int, scope nvml.AffinityScope) ([]uint, error)
+	DeviceGetCreatableVgpus(device Device) ([]VgpuTypeId, error)
+	DeviceGetCudaComputeCapability(device Device) (int, int, error)
+	DeviceGetCurrPcieLinkGeneration(device Device) (int, error)
+	DeviceGetCurrPcieLinkWidth(device Device) (int, error)
+	DeviceGetCurrentClockFreqs(device Device) (nvml.DeviceCurrentClockFreqs, error)
+	DeviceGetCurrentClocksEventReasons(device Device) (uint64, error)
+	DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, error)
+	DeviceGetDecoderUtilization(device Device) (uint32, uint32, error)
+	DeviceGetDefaultApplicationsClock(device Device, clockType nvml.ClockType) (uint32, error)
+	DeviceGetDefaultEccMode(device Device) (nvml.EnableState, error)
+	DeviceGetDetailedEccErrors(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error)
+	DeviceGetDeviceHandleFromMigDeviceHandle(migdevice Device) (Device, error)
+	DeviceGetDisplayActive(device Device) (nvml.EnableState, error)
+	DeviceGetDisplayMode(device Device) (nvml.EnableState, error)
+	DeviceGetDramEncryptionMode(device Device) (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error)
+	DeviceGetDriverModel(device Device) (nvml.DriverModel, nvml.DriverModel, error)
+	DeviceGetDriverModel_v2(device Device) (nvml.DriverModel, nvml.DriverModel, error)
+	DeviceGetDynamicPstatesInfo(device Device) (nvml.GpuDynamicPstatesInfo, error)
+	DeviceGetEccMode(device Device) (nvml.EnableState, nvml.EnableState, error)
+	DeviceGetEncoderCapacity(device Device, encoderQueryType nvml.EncoderType) (int, error)
+	DeviceGetEncoderSessions(device Device) ([]nvml.EncoderSessionInfo, error)
+	DeviceGetEncoderStats(device Device) (int, uint32, uint32, error)
+	DeviceGetEncoderUtilization(device Device) (uint32, uint32, error)
+	DeviceGetEnforcedPowerLimit(device Device) (uint32, error)
+	DeviceGetFBCSessions(device Device) ([]nvml.FBCSessionInfo, error)
+	DeviceGetFBCStats(device Device) (nvml.FBCStats, error)
+	DeviceGetFanControlPolicy_v2(device Device, fan int) (nvml.FanControlPolicy, error)
+	DeviceGetFanSpeed(device Device) (uint32, error)
+	DeviceGetFanSpeedRPM(device Device) (nvml.FanSpeedInfo, error)
+	DeviceGetFanSpeed_v2(device Device, fan int) (uint32, error)
+	DeviceGetFieldValues(device Device, values []nvml.FieldValue) error
+	DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, error)
+	DeviceGetGpcClkVfOffset(device Device) (int, error)
+	DeviceGetGpuFabricInfo(device Device) (nvml.GpuFabricInfo, error)
+	DeviceGetGpuFabricInfoV(device Device) nvml.GpuFabricInfoHandler
+	DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, error)
+	DeviceGetGpuInstanceId(device Device) (int, error)
+	DeviceGetGpuInstancePossiblePlacements(device Device, info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error)
+	DeviceGetGpuInstanceProfileInfo(device Device, profile int) (nvml.GpuInstanceProfileInfo, error)
+	DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) nvml.GpuInstanceProfileInfoByIdHandler
+	DeviceGetGpuInstanceProfileInfoV(device Device, profile int) nvml.GpuInstanceProfileInfoHandler
+	DeviceGetGpuInstanceRemainingCapacity(device Device, info *nvml.GpuInstanceProfileInfo) (int, error)
+	DeviceGetGpuInstances(device Device, info *nvml.GpuInstanceProfileInfo) ([]GpuInstance, error)
+	DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, error)
+	DeviceGetGpuOperationMode(device Device) (nvml.GpuOperationMode, nvml.GpuOperationMode, error)
+	DeviceGetGraphicsRunningProcesses(device Device) ([]nvml.ProcessInfo, error)
+	DeviceGetGridLicensableFeatures(device Device) (nvml.GridLicensableFeatures, error)
+	DeviceGetGspFirmwareMode(device Device) (bool, bool, error)
+	DeviceGetGspFirmwareVersion(device Device) (string, error)
+	DeviceGetHandleByIndex(index int) (Device, error)
+	DeviceGetHandleByPciBusId(pciBusId string) (Device, error)
+	DeviceGetHandleBySerial(serial string) (Device, error)
+	DeviceGetHandleByUUID(uuid string) (Device, error)
+	DeviceGetHandleByUUIDV(uuid *nvml.UUID) (Device, error)
+	DeviceGetHostVgpuMode(device Device) (nvml.HostVgpuMode, error)
+	DeviceGetIndex(device Device) (int, error)
+	DeviceGetInforomConfigurationChecksum(device Device) (uint32, error)
+	DeviceGetInforomImageVersion(device Device) (string, error)
+	DeviceGetInforomVersion(device Device, object nvml.InforomObject) (string, error)
+	DeviceGetIrqNum(device Device) (int, error)
+	DeviceGetJpgUtilization(device Device) (uint32, uint32, error)
+	DeviceGetLastBBXFlushTime(device Device) (uint64, uint, error)
+	DeviceGetMPSComputeRunningProcesses(device Device) ([]nvml.ProcessInfo, error)
+	DeviceGetMarginTemperature(device Device) (nvml.MarginTemperature, error)
+	DeviceGetMaxClockInfo(device Device, clockType nvml.ClockType) (uint32, error)
+	DeviceGetMaxCustomerBoostClock(device Device, clockType nvml.ClockType) (uint32, error)
+	DeviceGetMaxMigDeviceCount(device Device) (int, error)
+	DeviceGetMaxPcieLinkGeneration(device Device) (int, error)
+	DeviceGetMaxPcieLinkWidth(device Device) (int, error)
+	DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, error)
+	DeviceGetMemClkVfOffset(device Device) (int, error)
+	DeviceGetMemoryAffinity(device Device, numNodes int, scope nvml.AffinityScope) ([]uint, error)
+	DeviceGetMemoryBusWidth(device Device) (uint32, error)
+	DeviceGetMemoryErrorCounter(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error)
+	DeviceGetMemoryInfo(device Device) (nvml.Memory, error)
+	DeviceGetMemoryInfo_v2(device Device) (nvml.Memory_v2, error)
+	DeviceGetMigDeviceHandleByIndex(device Device, index int) (Device, error)
+	DeviceGetMigMode(device Device) (int, int, error)
+	DeviceGetMinMaxClockOfPState(device Device, clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error)
+	DeviceGetMinMaxFanSpeed(device Device) (int, int, error)
+	DeviceGetMinorNumber(device Device) (int, error)
+	DeviceGetModuleId(device Device) (int, error)
+	DeviceGetMultiGpuBoard(device Device) (int, error)
+	DeviceGetName(device Device) (string, error)
+	DeviceGetNumFans(device Device) (int, error)
+	DeviceGetNumGpuCores(device Device) (int, error)
+	DeviceGetNumaNodeId(device Device) (int, error)
+	DeviceGetNvLinkCapability(device Device, link int, capability nvml.NvLinkCapability) (uint32, error)
+	DeviceGetNvLinkErrorCounter(device Device, link int, counter nvml.NvLinkErrorCounter) (uint64, error)
+	DeviceGetNvLinkInfo(device Device) nvml.NvLinkInfoHandler
+	DeviceGetNvLinkRemoteDeviceType(device Device, link int) (nvml.IntNvLinkDeviceType, error)
+	DeviceGetNvLinkRemotePciInfo(device Device, link int) (nvml.PciInfo, error)
+	DeviceGetNvLinkState(device Device, link int) (nvml.EnableState, error)
+	DeviceGetNvLinkUtilizationControl(device Device, link int, counter int) (nvml.NvLinkUtilizationControl, error)
+	DeviceGetNvLinkUtilizationCounter(device Device, link int, counter int) (uint64, uint64, error)
+	DeviceGetNvLinkVersion(device Device, link int) (uint32, error)
+	DeviceGetNvlinkBwMode(device Device) (nvml.NvlinkGetBwMode, error)
+	DeviceGetNvlinkSupportedBwModes(device Device) (nvml.NvlinkSupportedBwModes, error)
+	DeviceGetOfaUtilization(device Device) (uint32, uint32, error)
+	DeviceGetP2PStatus(device1 Device, device2 Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error)
+	DeviceGetPciInfo(device Device) (nvml.PciInfo, error)
+	DeviceGetPciInfoExt(device Device) (nvml.PciInfoExt, error)
+	DeviceGetPcieLinkMaxSpeed(device Device) (uint32, error)
+	DeviceGetPcieReplayCounter(device Device) (int, error)
+	DeviceGetPcieSpeed(device Device) (int, error)
+	DeviceGetPcieThroughput(device Device, counter nvml.PcieUtilCounter) (uint32, error)
+	DeviceGetPdi(device Device) (nvml.Pdi, error)
+	DeviceGetPerformanceModes(device Device) (nvml.DevicePerfModes, error)
+	DeviceGetPerformanceState(device Device) (nvml.Pstates, error)
+	DeviceGetPersistenceMode(device Device) (nvml.EnableState, error)
+	DeviceGetPgpuMetadataString(device Device) (string, error)
+	DeviceGetPlatformInfo(device Device) (nvml.PlatformInfo, error)
+	DeviceGetPowerManagementDefaultLimit(device Device) (uint32, error)
+	DeviceGetPowerManagementLimit(device Device) (uint32, error)
+	DeviceGetPowerManagementLimitConstraints(device Device) (uint32, uint32, error)
+	DeviceGetPowerManagementMode(device Device) (nvml.EnableState, error)
+	DeviceGetPowerMizerMode_v1(device Device) (nvml.DevicePowerMizerModes_v1, error)
+	DeviceGetPowerSource(device Device) (nvml.PowerSource, error)
+	DeviceGetPowerState(device Device) (nvml.Pstates, error)
+	DeviceGetPowerUsage(device Device) (uint32, error)
+	DeviceGetProcessUtilization(device Device, lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error)
+	DeviceGetProcessesUtilizationInfo(device Device) (nvml.ProcessesUtilizationInfo, error)
+	DeviceGetRemappedRows(device Device) (int, int, bool, bool, error)
+	DeviceGetRepairStatus(device Device) (nvml.RepairStatus, error)
+	DeviceGetRetiredPages(device Device, cause nvml.PageRetirementCause) ([]uint64, error)
+	DeviceGetRetiredPagesPendingStatus(device Device) (nvml.EnableState, error)
+	DeviceGetRetiredPages_v2(device Device, cause nvml.PageRetirementCause) ([]uint64, []uint64, error)
+	DeviceGetRowRemapperHistogram(device Device) (nvml.RowRemapperHistogramValues, error)
+	DeviceGetRunningProcessDetailList(device Device) (nvml.ProcessDetailList, error)
+	DeviceGetSamples(device Device, samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error)
+	DeviceGetSerial(device Device) (string, error)
+	DeviceGetSramEccErrorStatus(device Device) (nvml.EccSramErrorStatus, error)
+	DeviceGetSramUniqueUncorrectedEccErrorCounts(device Device, errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error
+	DeviceGetSupportedClocksEventReasons(device Device) (uint64, error)
+	DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, error)
+	DeviceGetSupportedEventTypes(device Device) (uint64, error)
+	DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, error)
+	DeviceGetSupportedMemoryClocks(device Device) (int, uint32, error)
+	DeviceGetSupportedPerformanceStates(device Device) ([]nvml.Pstates, error)
+	DeviceGetSupportedVgpus(device Device) ([]VgpuTypeId, error)
+	DeviceGetTargetFanSpeed(device Device, fan int) (int, error)
+	DeviceGetTemperature(device Device, sensorType nvml.TemperatureSensors) (uint32, error)
+	DeviceGetTemperatureThreshold(device Device, thresholdType nvml.TemperatureThresholds) (uint32, error)
+	DeviceGetTemperatureV(device Device) nvml.TemperatureHandler
+	DeviceGetThermalSettings(device Device, sensorIndex uint32) (nvml.GpuThermalSettings, error)
+	DeviceGetTopologyCommonAncestor(device1 Device, device2 Device) (nvml.GpuTopologyLevel, error)
+	DeviceGetTopologyNearestGpus(device Device, level nvml.GpuTopologyLevel) ([]Device, error)
+	DeviceGetTotalEccErrors(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error)
+	DeviceGetTotalEnergyConsumption(device Device) (uint64, error)
+	DeviceGetUUID(device Device) (string, error)
+	DeviceGetUtilizationRates(device Device) (nvml.Utilization, error)
+	DeviceGetVbiosVersion(device Device) (string, error)
+	DeviceGetVgpuCapabilities(device Device, capability nvml.DeviceVgpuCapability) (bool, error)
+	DeviceGetVgpuHeterogeneousMode(device Device) (nvml.VgpuHeterogeneousMode, error)
+	DeviceGetVgpuInstancesUtilizationInfo(device Device) (nvml.VgpuInstancesUtilizationInfo, error)
+	DeviceGetVgpuMetadata(device Device) (nvml.VgpuPgpuMetadata, error)
+	DeviceGetVgpuProcessUtilization(device Device, lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error)
+	DeviceGetVgpuProcessesUtilizationInfo(device Device) (nvml.VgpuProcessesUtilizationInfo, error)
+	DeviceGetVgpuSchedulerCapabilities(device Device) (nvml.VgpuSchedulerCapabilities, error)
+	DeviceGetVgpuSchedulerLog(device Device) (nvml.VgpuSchedulerLog, error)
+	DeviceGetVgpuSchedulerState(device Device) (nvml.VgpuSchedulerGetState, error)
+	DeviceGetVgpuTypeCreatablePlacements(device Device, vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error)
+	DeviceGetVgpuTypeSupportedPlacements(device Device, vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error)
+	DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error)
+	DeviceGetViolationStatus(device Device, perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error)
+	DeviceGetVirtualizationMode(device Device) (nvml.GpuVirtualizationMode, error)
+	DeviceIsMigDeviceHandle(device Device) (bool, error)
+	DeviceModifyDrainState(pciInfo *nvml.PciInfo, newState nvml.EnableState) error
+	DeviceOnSameBoard(device1 Device, device2 Device) (int, error)
+	DevicePowerSmoothingActivatePresetProfile(device Device, profile *nvml.PowerSmoothingProfile) error
+	DevicePowerSmoothingSetState(device Device, state *nvml.PowerSmoothingState) error
+	DevicePowerSmoothingUpdatePresetProfileParam(device Device, profile *nvml.PowerSmoothingProfile) error
+	DeviceQueryDrainState(pciInfo *nvml.PciInfo) (nvml.EnableState, error)
+	DeviceReadWritePRM_v1(device Device, buffer *nvml.PRMTLV_v1) error
+	DeviceRegisterEvents(device Device, eventTypes uint64, set EventSet) error
+	DeviceRemoveGpu(pciInfo *nvml.PciInfo) error
+	DeviceRemoveGpu_v2(pciInfo *nvml.PciInfo, gpuState nvml.DetachGpuState, linkState nvml.PcieLinkState) error
+	DeviceResetApplicationsClocks(device Device) error
+	DeviceResetGpuLockedClocks(device Device) error
+	DeviceResetMemoryLockedClocks(device Device) error
+	DeviceResetNvLinkErrorCounters(device Device, link int) error
+	DeviceResetNvLinkUtilizationCounter(device Device, link int, counter int) error
+	DeviceSetAPIRestriction(device Device, apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error
+	DeviceSetAccountingMode(device Device, mode nvml.EnableState) error
+	DeviceSetApplicationsClocks(device Device, memClockMHz uint32, graphicsClockMHz uint32) error
+	DeviceSetAutoBoostedClocksEnabled(device Device, enabled nvml.EnableState) error
+	DeviceSetClockOffsets(device Device, info nvml.ClockOffset) error
+	DeviceSetComputeMode(device Device, mode nvml.ComputeMode) error
+	DeviceSetConfComputeUnprotectedMemSize(device Device, sizeKiB uint64) error
+	DeviceSetCpuAffinity(device Device) error
+	DeviceSetDefaultAutoBoostedClocksEnabled(device Device, enabled nvml.EnableState, flags uint32) error
+	DeviceSetDefaultFanSpeed_v2(device Device, fan int) error
+	DeviceSetDramEncryptionMode(device Device, dramEncryption *nvml.DramEncryptionInfo) error
+	DeviceSetDriverModel(device Device, driverModel nvml.DriverModel, flags uint32) error
+	DeviceSetEccMode(device Device, ecc nvml.EnableState) error
+	DeviceSetFanControlPolicy(device Device, fan int, policy nvml.FanControlPolicy) error
+	DeviceSetFanSpeed_v2(device Device, fan int, speed int) error
+	DeviceSetGpcClkVfOffset(device Device, offset int) error
+	DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) error
+	DeviceSetGpuOperationMode(device Device, mode nvml.GpuOperationMode) error
+	DeviceSetMemClkVfOffset(device Device, offset int) error
+	DeviceSetMemoryLockedClocks(device Device, minMemClockMHz uint32, maxMemClockMHz uint32) error
+	DeviceSetMigMode(device Device, mode int) (nvml.Return, error)
+	DeviceSetNvLinkDeviceLowPowerThreshold(device Device, info *nvml.NvLinkPowerThres) error
+	DeviceSetNvLinkUtilizationControl(device Device, link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error
+	DeviceSetNvlinkBwMode(device Device, setBwMode *nvml.NvlinkSetBwMode) error
+	DeviceSetPersistenceMode(device Device, mode nvml.EnableState) error
+	DeviceSetPowerManagementLimit(device Device, limit uint32) error
+	DeviceSetPowerManagementLimit_v2(device Device, powerValue *nvml.PowerValue_v2) error
+	DeviceSetPowerMizerMode_v1(device Device, powerMizerMode *nvml.DevicePowerMizerModes_v1) error
+	DeviceSetTemperatureThreshold(device Device, thresholdType nvml.TemperatureThresholds, temp int) error
+	DeviceSetVgpuCapabilities(device Device, capability nvml.DeviceVgpuCapability, state nvml.EnableState) error
+	DeviceSetVgpuHeterogeneousMode(device Device, heterogeneousMode nvml.VgpuHeterogeneousMode) error
+	DeviceSetVgpuSchedulerState(device Device, pSchedulerState *nvml.VgpuSchedulerSetState) error
+	DeviceSetVirtualizationMode(device Device, virtualMode nvml.GpuVirtualizationMode) error
+	DeviceValidateInforom(device Device) error
+	DeviceWorkloadPowerProfileClearRequestedProfiles(device Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error
+	DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (nvml.WorkloadPowerProfileCurrentProfiles, error)
+	DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (nvml.WorkloadPowerProfileProfilesInfo, error)
+	DeviceWorkloadPowerProfileSetRequestedProfiles(device Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error
+	ErrorString(r nvml.Return) string
+	EventSetCreate() (EventSet, error)
+	EventSetFree(set EventSet) error
+	EventSetWait(set EventSet, timeoutms uint32) (nvml.EventData, error)
+	Extensions() nvml.ExtendedInterface
+	GetExcludedDeviceCount() (int, error)
+	GetExcludedDeviceInfoByIndex(index int) (nvml.ExcludedDeviceInfo, error)
+	GetVgpuCompatibility(vgpuMetadata *nvml.VgpuMetadata, pgpuMetadata *nvml.VgpuPgpuMetadata) (nvml.VgpuPgpuCompatibility, error)
+	GetVgpuDriverCapabilities(capability nvml.VgpuDriverCapability) (bool, error)
+	GetVgpuVersion() (nvml.VgpuVersion, nvml.VgpuVersion, error)
+	GpmMetricsGet(metricsGet *nvml.GpmMetricsGetType) error
+	GpmMetricsGetV(metricsGet *nvml.GpmMetricsGetType) nvml.GpmMetricsGetVType
+	GpmMigSampleGet(device Device, gpuInstanceId int, gpmSample GpmSample) error
+	GpmQueryDeviceSupport(device Device) (nvml.GpmSupport, error)
+	GpmQueryDeviceSupportV(device Device) nvml.GpmSupportV
+	GpmQueryIfStreamingEnabled(device Device) (uint32, error)
+	GpmSampleAlloc() (GpmSample, error)
+	GpmSampleFree(gpmSample GpmSample) error
+	GpmSampleGet(device Device, gpmSample GpmSample) error
+	GpmSetStreamingEnabled(device Device, state uint32) error
+	GpuInstanceCreateComputeInstance(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) (ComputeInstance, error)
+	GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (ComputeInstance, error)
+	GpuInstanceDestroy(gpuInstance GpuInstance) error
+	GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (nvml.ActiveVgpuInstanceInfo, error)
+	GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, error)
+	GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error)
+	GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstance, profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error)
+	GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) nvml.ComputeInstanceProfileInfoHandler
+	GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) (int, error)
+	GpuInstanceGetComputeInstances(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]ComputeInstance, error)
+	GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (nvml.VgpuTypeIdInfo, error)
+	GpuInstanceGetInfo(gpuInstance GpuInstance) (nvml.GpuInstanceInfo, error)
+	GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (nvml.VgpuHeterogeneousMode, error)
+	GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (nvml.VgpuSchedulerLogInfo, error)
+	GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (nvml.VgpuSchedulerStateInfo, error)
+	GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInstance) (nvml.VgpuCreatablePlacementInfo, error)
+	GpuInstanceSetVgpuHeterogeneousMode(gpuInstance GpuInstance, heterogeneousMode *nvml.VgpuHeterogeneousMode) error
+	GpuInstanceSetVgpuSchedulerState(gpuInstance GpuInstance, scheduler *nvml.VgpuSchedulerState) error
+	Init() error
+	InitWithFlags(flags uint32) error
+	SetVgpuVersion(vgpuVersion *nvml.VgpuVersion) error
+	Shutdown() error
+	SystemEventSetCreate(request *nvml.SystemEventSetCreateRequest) error
+	SystemEventSetFree(request *nvml.SystemEventSetFreeRequest) error
+	SystemEventSetWait(request *nvml.SystemEventSetWaitRequest) error
+	SystemGetConfComputeCapabilities() (nvml.ConfComputeSystemCaps, error)
+	SystemGetConfComputeGpusReadyState() (uint32, error)
+	SystemGetConfComputeKeyRotationThresholdInfo() (nvml.ConfComputeGetKeyRotationThresholdInfo, error)
+	SystemGetConfComputeSettings() (nvml.SystemConfComputeSettings, error)
+	SystemGetConfComputeState() (nvml.ConfComputeSystemState, error)
+	SystemGetCudaDriverVersion() (int, error)
+	SystemGetCudaDriverVersion_v2() (int, error)
+	SystemGetDriverBranch() (nvml.SystemDriverBranchInfo, error)
+	SystemGetDriverVersion() (string, error)
+	SystemGetHicVersion() ([]nvml.HwbcEntry, error)
+	SystemGetNVMLVersion() (string, error)
+	SystemGetNvlinkBwMode() (uint32, error)
+	SystemGetProcessName(pid int) (string, error)
+	SystemGetTopologyGpuSet(cpuNumber int) ([]Device, error)
+	SystemRegisterEvents(request *nvml.SystemRegisterEventRequest) error
+	SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) error
+	SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo) error
+	SystemSetNvlinkBwMode(nvlinkBwMode uint32) error
+	UnitGetCount() (int, error)
+	UnitGetDevices(unit Unit) ([]Device, error)
+	UnitGetFanSpeedInfo(unit Unit) (nvml.UnitFanSpeeds, error)
+	UnitGetHandleByIndex(index int) (Unit, error)
+	UnitGetLedState(unit Unit) (nvml.LedState, error)
+	UnitGetPsuInfo(unit Unit) (nvml.PSUInfo, error)
+	UnitGetTemperature(unit Unit, ttype int) (uint32, error)
+	UnitGetUnitInfo(unit Unit) (nvml.UnitInfo, error)
+	UnitSetLedState(unit Unit, color nvml.LedColor) error
+	VgpuInstanceClearAccountingPids(vgpuInstance VgpuInstance) error
+	VgpuInstanceGetAccountingMode(vgpuInstance VgpuInstance) (nvml.EnableState, error)
+	VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, error)
+	VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (nvml.AccountingStats, error)
+	VgpuInstanceGetEccMode(vgpuInstance VgpuInstance) (nvml.EnableState, error)
+	VgpuInstanceGetEncoderCapacity(vgpuInstance VgpuInstance) (int, error)
+	VgpuInstanceGetEncoderSessions(vgpuInstance VgpuInstance) (int, nvml.EncoderSessionInfo, error)
+	VgpuInstanceGetEncoderStats(vgpuInstance VgpuInstance) (int, uint32, uint32, error)
+	VgpuInstanceGetFBCSessions(vgpuInstance VgpuInstance) (int, nvml.FBCSessionInfo, error)
+	VgpuInstanceGetFBCStats(vgpuInstance VgpuInstance) (nvml.FBCStats, error)
+	VgpuInstanceGetFbUsage(vgpuInstance VgpuInstance) (uint64, error)
+	VgpuInstanceGetFrameRateLimit(vgpuInstance VgpuInstance) (uint32, error)
+	VgpuInstanceGetGpuInstanceId(vgpuInstance VgpuInstance) (int, error)
+	VgpuInstanceGetGpuPciId(vgpuInstance VgpuInstance) (string, error)
+	VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (nvml.VgpuLicenseInfo, error)
+	VgpuInstanceGetLicenseStatus(vgpuInstance VgpuInstance) (int, error)
+	VgpuInstanceGetMdevUUID(vgpuInstance VgpuInstance) (string, error)
+	VgpuInstanceGetMetadata(vgpuInstance VgpuInstance) (nvml.VgpuMetadata, error)
+	VgpuInstanceGetPlacementId(vgpuInstance VgpuInstance) (nvml.VgpuPlacementId, error)
+	VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (nvml.VgpuRuntimeState, error)
+	VgpuInstanceGetType(vgpuInstance VgpuInstance) (VgpuTypeId, error)
+	VgpuInstanceGetUUID(vgpuInstance VgpuInstance) (string, error)
+	VgpuInstanceGetVmDriverVersion(vgpuInstance VgpuInstance) (string, error)
+	VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, nvml.VgpuVmIdType, error)
+	VgpuInstanceSetEncoderCapacity(vgpuInstance VgpuInstance, encoderCapacity int) error
+	VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (nvml.VgpuTypeBar1Info, error)
+	VgpuTypeGetCapabilities(vgpuTypeId VgpuTypeId, capability nvml.VgpuCapability) (bool, error)
+	VgpuTypeGetClass(vgpuTypeId VgpuTypeId) (string, error)
+	VgpuTypeGetDeviceID(vgpuTypeId VgpuTypeId) (uint64, uint64, error)
+	VgpuTypeGetFbReservation(vgpuTypeId VgpuTypeId) (uint64, error)
+	VgpuTypeGetFrameRateLimit(vgpuTypeId VgpuTypeId) (uint32, error)
+	VgpuTypeGetFramebufferSize(vgpuTypeId VgpuTypeId) (uint64, error)
+	VgpuTypeGetGpuInstanceProfileId(vgpuTypeId VgpuTypeId) (uint32, error)
+	VgpuTypeGetGspHeapSize(vgpuTypeId VgpuTypeId) (uint64, error)
+	VgpuTypeGetLicense(vgpuTypeId VgpuTypeId) (string, error)
+	VgpuTypeGetMaxInstances(device Device, vgpuTypeId VgpuTypeId) (int, error)
+	VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *nvml.VgpuTypeMaxInstance) error
+	VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, error)
+	VgpuTypeGetName(vgpuTypeId VgpuTypeId) (string, error)
+	VgpuTypeGetNumDisplayHeads(vgpuTypeId VgpuTypeId) (int, error)
+	VgpuTypeGetResolution(vgpuTypeId VgpuTypeId, displayIndex int) (uint32, uint32, error)
+}
+
+type lib struct {
+	nvml.Interface
+}
+
+var _ Interface = (*lib)(nil)
+
+func (w *lib) ComputeInstanceDestroy(computeInstance ComputeInstance) error {
+	ret := w.Interface.ComputeInstanceDestroy(unwrapComputeInstance(computeInstance))
+	return newError("nvmlComputeInstanceDestroy", nil, ret)
+}
+
+func (w *lib) ComputeInstanceGetInfo(computeInstance ComputeInstance) (nvml.ComputeInstanceInfo, error) {
+	r0, ret := w.Interface.ComputeInstanceGetInfo(unwrapComputeInstance(computeInstance))
+	return r0, newError("nvmlComputeInstanceGetInfo", nil, ret)
+}
+
+func (w *lib) DeviceClearAccountingPids(device Device) error {
+	ret := w.Interface.DeviceClearAccountingPids(unwrapDevice(device))
+	return newError("nvmlDeviceClearAccountingPids", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceClearCpuAffinity(device Device) error {
+	ret := w.Interface.DeviceClearCpuAffinity(unwrapDevice(device))
+	return newError("nvmlDeviceClearCpuAffinity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceClearEccErrorCounts(device Device, counterType nvml.EccCounterType) error {
+	ret := w.Interface.DeviceClearEccErrorCounts(unwrapDevice(device), counterType)
+	return newError("nvmlDeviceClearEccErrorCounts", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceClearFieldValues(device Device, values []nvml.FieldValue) error {
+	ret := w.Interface.DeviceClearFieldValues(unwrapDevice(device), values)
+	return newError("nvmlDeviceClearFieldValues", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceCreateGpuInstance(device Device, info *nvml.GpuInstanceProfileInfo) (GpuInstance, error) {
+	r0, ret := w.Interface.DeviceCreateGpuInstance(unwrapDevice(device), info)
+	return wrapGpuInstance(r0), newError("nvmlDeviceCreateGpuInstance", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceCreateGpuInstanceWithPlacement(device Device, info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (GpuInstance, error) {
+	r0, ret := w.Interface.DeviceCreateGpuInstanceWithPlacement(unwrapDevice(device), info, placement)
+	return wrapGpuInstance(r0), newError("nvmlDeviceCreateGpuInstanceWithPlacement", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceDiscoverGpus() (nvml.PciInfo, error) {
+	r0, ret := w.Interface.DeviceDiscoverGpus()
+	return r0, newError("nvmlDeviceDiscoverGpus", nil, ret)
+}
+
+func (w *lib) DeviceFreezeNvLinkUtilizationCounter(device Device, link int, counter int, freeze nvml.EnableState) error {
+	ret := w.Interface.DeviceFreezeNvLinkUtilizationCounter(unwrapDevice(device), link, counter, freeze)
+	return newError("nvmlDeviceFreezeNvLinkUtilizationCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAPIRestriction(device Device, apiType nvml.RestrictedAPI) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetAPIRestriction(unwrapDevice(device), apiType)
+	return r0, newError("nvmlDeviceGetAPIRestriction", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAccountingBufferSize(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetAccountingBufferSize(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAccountingBufferSize", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAccountingMode(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetAccountingMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAccountingMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAccountingPids(device Device) ([]int, error) {
+	r0, ret := w.Interface.DeviceGetAccountingPids(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAccountingPids", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAccountingStats(device Device, pid uint32) (nvml.AccountingStats, error) {
+	r0, ret := w.Interface.DeviceGetAccountingStats(unwrapDevice(device), pid)
+	return r0, newError("nvmlDeviceGetAccountingStats", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetActiveVgpus(device Device) ([]VgpuInstance, error) {
+	r0, ret := w.Interface.DeviceGetActiveVgpus(unwrapDevice(device))
+	return wrapVgpuInstances(r0), newError("nvmlDeviceGetActiveVgpus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAdaptiveClockInfoStatus(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetAdaptiveClockInfoStatus(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAdaptiveClockInfoStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAddressingMode(device Device) (nvml.DeviceAddressingMode, error) {
+	r0, ret := w.Interface.DeviceGetAddressingMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAddressingMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetApplicationsClock(device Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetApplicationsClock(unwrapDevice(device), clockType)
+	return r0, newError("nvmlDeviceGetApplicationsClock", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetArchitecture(device Device) (nvml.DeviceArchitecture, error) {
+	r0, ret := w.Interface.DeviceGetArchitecture(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetArchitecture", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAttributes(device Device) (nvml.DeviceAttributes, error) {
+	r0, ret := w.Interface.DeviceGetAttributes(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetAttributes", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetAutoBoostedClocksEnabled(device Device) (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := w.Interface.DeviceGetAutoBoostedClocksEnabled(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetAutoBoostedClocksEnabled", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBAR1MemoryInfo(device Device) (nvml.BAR1Memory, error) {
+	r0, ret := w.Interface.DeviceGetBAR1MemoryInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBAR1MemoryInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBoardId(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetBoardId(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBoardId", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBoardPartNumber(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetBoardPartNumber(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBoardPartNumber", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBrand(device Device) (nvml.BrandType, error) {
+	r0, ret := w.Interface.DeviceGetBrand(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBrand", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBridgeChipInfo(device Device) (nvml.BridgeChipHierarchy, error) {
+	r0, ret := w.Interface.DeviceGetBridgeChipInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBridgeChipInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetBusType(device Device) (nvml.BusType, error) {
+	r0, ret := w.Interface.DeviceGetBusType(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetBusType", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetC2cModeInfoV(device Device) nvml.C2cModeInfoHandler {
+	return w.Interface.DeviceGetC2cModeInfoV(unwrapDevice(device))
+}
+
+func (w *lib) DeviceGetCapabilities(device Device) (nvml.DeviceCapabilities, error) {
+	r0, ret := w.Interface.DeviceGetCapabilities(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCapabilities", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetClkMonStatus(device Device) (nvml.ClkMonStatus, error) {
+	r0, ret := w.Interface.DeviceGetClkMonStatus(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetClkMonStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetClock(device Device, clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetClock(unwrapDevice(device), clockType, clockId)
+	return r0, newError("nvmlDeviceGetClock", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetClockInfo(device Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetClockInfo(unwrapDevice(device), clockType)
+	return r0, newError("nvmlDeviceGetClockInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetClockOffsets(device Device) (nvml.ClockOffset, error) {
+	r0, ret := w.Interface.DeviceGetClockOffsets(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetClockOffsets", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetComputeInstanceId(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetComputeInstanceId(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetComputeInstanceId", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetComputeMode(device Device) (nvml.ComputeMode, error) {
+	r0, ret := w.Interface.DeviceGetComputeMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetComputeMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetComputeRunningProcesses(device Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := w.Interface.DeviceGetComputeRunningProcesses(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetComputeRunningProcesses", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetConfComputeGpuAttestationReport(device Device, gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error {
+	ret := w.Interface.DeviceGetConfComputeGpuAttestationReport(unwrapDevice(device), gpuAtstReport)
+	return newError("nvmlDeviceGetConfComputeGpuAttestationReport", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetConfComputeGpuCertificate(device Device) (nvml.ConfComputeGpuCertificate, error) {
+	r0, ret := w.Interface.DeviceGetConfComputeGpuCertificate(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetConfComputeGpuCertificate", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetConfComputeMemSizeInfo(device Device) (nvml.ConfComputeMemSizeInfo, error) {
+	r0, ret := w.Interface.DeviceGetConfComputeMemSizeInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetConfComputeMemSizeInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetConfComputeProtectedMemoryUsage(device Device) (nvml.Memory, error) {
+	r0, ret := w.Interface.DeviceGetConfComputeProtectedMemoryUsage(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetConfComputeProtectedMemoryUsage", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCoolerInfo(device Device) (nvml.CoolerInfo, error) {
+	r0, ret := w.Interface.DeviceGetCoolerInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCoolerInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCount() (int, error) {
+	r0, ret := w.Interface.DeviceGetCount()
+	return r0, newError("nvmlDeviceGetCount", nil, ret)
+}
+
+func (w *lib) DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, error) {
+	r0, ret := w.Interface.DeviceGetCpuAffinity(unwrapDevice(device), numCPUs)
+	return r0, newError("nvmlDeviceGetCpuAffinity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCpuAffinityWithinScope(device Device, numCPUs int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := w.Interface.DeviceGetCpuAffinityWithinScope(unwrapDevice(device), numCPUs, scope)
+	return r0, newError("nvmlDeviceGetCpuAffinityWithinScope", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCreatableVgpus(device Device) ([]VgpuTypeId, error) {
+	r0, ret := w.Interface.DeviceGetCreatableVgpus(unwrapDevice(device))
+	return wrapVgpuTypeIds(r0), newError("nvmlDeviceGetCreatableVgpus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCudaComputeCapability(device Device) (int, int, error) {
+	r0, r1, ret := w.Interface.DeviceGetCudaComputeCapability(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetCudaComputeCapability", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCurrPcieLinkGeneration(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetCurrPcieLinkGeneration(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCurrPcieLinkGeneration", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCurrPcieLinkWidth(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetCurrPcieLinkWidth(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCurrPcieLinkWidth", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCurrentClockFreqs(device Device) (nvml.DeviceCurrentClockFreqs, error) {
+	r0, ret := w.Interface.DeviceGetCurrentClockFreqs(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCurrentClockFreqs", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCurrentClocksEventReasons(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetCurrentClocksEventReasons(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCurrentClocksEventReasons", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetCurrentClocksThrottleReasons(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetCurrentClocksThrottleReasons", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDecoderUtilization(device Device) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetDecoderUtilization(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetDecoderUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDefaultApplicationsClock(device Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetDefaultApplicationsClock(unwrapDevice(device), clockType)
+	return r0, newError("nvmlDeviceGetDefaultApplicationsClock", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDefaultEccMode(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetDefaultEccMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetDefaultEccMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDetailedEccErrors(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error) {
+	r0, ret := w.Interface.DeviceGetDetailedEccErrors(unwrapDevice(device), errorType, counterType)
+	return r0, newError("nvmlDeviceGetDetailedEccErrors", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDeviceHandleFromMigDeviceHandle(migdevice Device) (Device, error) {
+	r0, ret := w.Interface.DeviceGetDeviceHandleFromMigDeviceHandle(unwrapDevice(migdevice))
+	return wrapDevice(r0), newError("nvmlDeviceGetDeviceHandleFromMigDeviceHandle", unwrapDevice(migdevice), ret)
+}
+
+func (w *lib) DeviceGetDisplayActive(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetDisplayActive(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetDisplayActive", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDisplayMode(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetDisplayMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetDisplayMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDramEncryptionMode(device Device) (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error) {
+	r0, r1, ret := w.Interface.DeviceGetDramEncryptionMode(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetDramEncryptionMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDriverModel(device Device) (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := w.Interface.DeviceGetDriverModel(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetDriverModel", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDriverModel_v2(device Device) (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := w.Interface.DeviceGetDriverModel_v2(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetDriverModel", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetDynamicPstatesInfo(device Device) (nvml.GpuDynamicPstatesInfo, error) {
+	r0, ret := w.Interface.DeviceGetDynamicPstatesInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetDynamicPstatesInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEccMode(device Device) (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := w.Interface.DeviceGetEccMode(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetEccMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEncoderCapacity(device Device, encoderQueryType nvml.EncoderType) (int, error) {
+	r0, ret := w.Interface.DeviceGetEncoderCapacity(unwrapDevice(device), encoderQueryType)
+	return r0, newError("nvmlDeviceGetEncoderCapacity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEncoderSessions(device Device) ([]nvml.EncoderSessionInfo, error) {
+	r0, ret := w.Interface.DeviceGetEncoderSessions(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetEncoderSessions", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEncoderStats(device Device) (int, uint32, uint32, error) {
+	r0, r1, r2, ret := w.Interface.DeviceGetEncoderStats(unwrapDevice(device))
+	return r0, r1, r2, newError("nvmlDeviceGetEncoderStats", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEncoderUtilization(device Device) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetEncoderUtilization(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetEncoderUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetEnforcedPowerLimit(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetEnforcedPowerLimit(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetEnforcedPowerLimit", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFBCSessions(device Device) ([]nvml.FBCSessionInfo, error) {
+	r0, ret := w.Interface.DeviceGetFBCSessions(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetFBCSessions", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFBCStats(device Device) (nvml.FBCStats, error) {
+	r0, ret := w.Interface.DeviceGetFBCStats(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetFBCStats", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFanControlPolicy_v2(device Device, fan int) (nvml.FanControlPolicy, error) {
+	r0, ret := w.Interface.DeviceGetFanControlPolicy_v2(unwrapDevice(device), fan)
+	return r0, newError("nvmlDeviceGetFanControlPolicy", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFanSpeed(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetFanSpeed(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFanSpeedRPM(device Device) (nvml.FanSpeedInfo, error) {
+	r0, ret := w.Interface.DeviceGetFanSpeedRPM(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetFanSpeedRPM", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFanSpeed_v2(device Device, fan int) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetFanSpeed_v2(unwrapDevice(device), fan)
+	return r0, newError("nvmlDeviceGetFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetFieldValues(device Device, values []nvml.FieldValue) error {
+	ret := w.Interface.DeviceGetFieldValues(unwrapDevice(device), values)
+	return newError("nvmlDeviceGetFieldValues", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, error) {
+	r0, r1, ret := w.Interface.DeviceGetGpcClkMinMaxVfOffset(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetGpcClkMinMaxVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpcClkVfOffset(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetGpcClkVfOffset(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGpcClkVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuFabricInfo(device Device) (nvml.GpuFabricInfo, error) {
+	r0, ret := w.Interface.DeviceGetGpuFabricInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGpuFabricInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuFabricInfoV(device Device) nvml.GpuFabricInfoHandler {
+	return w.Interface.DeviceGetGpuFabricInfoV(unwrapDevice(device))
+}
+
+func (w *lib) DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstanceById(unwrapDevice(device), id)
+	return wrapGpuInstance(r0), newError("nvmlDeviceGetGpuInstanceById", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuInstanceId(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstanceId(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGpuInstanceId", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuInstancePossiblePlacements(device Device, info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstancePossiblePlacements(unwrapDevice(device), info)
+	return r0, newError("nvmlDeviceGetGpuInstancePossiblePlacements", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuInstanceProfileInfo(device Device, profile int) (nvml.GpuInstanceProfileInfo, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstanceProfileInfo(unwrapDevice(device), profile)
+	return r0, newError("nvmlDeviceGetGpuInstanceProfileInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) nvml.GpuInstanceProfileInfoByIdHandler {
+	return w.Interface.DeviceGetGpuInstanceProfileInfoByIdV(unwrapDevice(device), profileId)
+}
+
+func (w *lib) DeviceGetGpuInstanceProfileInfoV(device Device, profile int) nvml.GpuInstanceProfileInfoHandler {
+	return w.Interface.DeviceGetGpuInstanceProfileInfoV(unwrapDevice(device), profile)
+}
+
+func (w *lib) DeviceGetGpuInstanceRemainingCapacity(device Device, info *nvml.GpuInstanceProfileInfo) (int, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstanceRemainingCapacity(unwrapDevice(device), info)
+	return r0, newError("nvmlDeviceGetGpuInstanceRemainingCapacity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuInstances(device Device, info *nvml.GpuInstanceProfileInfo) ([]GpuInstance, error) {
+	r0, ret := w.Interface.DeviceGetGpuInstances(unwrapDevice(device), info)
+	return wrapGpuInstances(r0), newError("nvmlDeviceGetGpuInstances", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetGpuMaxPcieLinkGeneration(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGpuMaxPcieLinkGeneration", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGpuOperationMode(device Device) (nvml.GpuOperationMode, nvml.GpuOperationMode, error) {
+	r0, r1, ret := w.Interface.DeviceGetGpuOperationMode(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetGpuOperationMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGraphicsRunningProcesses(device Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := w.Interface.DeviceGetGraphicsRunningProcesses(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGraphicsRunningProcesses", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGridLicensableFeatures(device Device) (nvml.GridLicensableFeatures, error) {
+	r0, ret := w.Interface.DeviceGetGridLicensableFeatures(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGridLicensableFeatures", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGspFirmwareMode(device Device) (bool, bool, error) {
+	r0, r1, ret := w.Interface.DeviceGetGspFirmwareMode(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetGspFirmwareMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetGspFirmwareVersion(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetGspFirmwareVersion(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetGspFirmwareVersion", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetHandleByIndex(index int) (Device, error) {
+	r0, ret := w.Interface.DeviceGetHandleByIndex(index)
+	return wrapDevice(r0), newError("nvmlDeviceGetHandleByIndex", nil, ret)
+}
+
+func (w *lib) DeviceGetHandleByPciBusId(pciBusId string) (Device, error) {
+	r0, ret := w.Interface.DeviceGetHandleByPciBusId(pciBusId)
+	return wrapDevice(r0), newError("nvmlDeviceGetHandleByPciBusId", nil, ret)
+}
+
+func (w *lib) DeviceGetHandleBySerial(serial string) (Device, error) {
+	r0, ret := w.Interface.DeviceGetHandleBySerial(serial)
+	return wrapDevice(r0), newError("nvmlDeviceGetHandleBySerial", nil, ret)
+}
+
+func (w *lib) DeviceGetHandleByUUID(uuid string) (Device, error) {
+	r0, ret := w.Interface.DeviceGetHandleByUUID(uuid)
+	return wrapDevice(r0), newError("nvmlDeviceGetHandleByUUID", nil, ret)
+}
+
+func (w *lib) DeviceGetHandleByUUIDV(uuid *nvml.UUID) (Device, error) {
+	r0, ret := w.Interface.DeviceGetHandleByUUIDV(uuid)
+	return wrapDevice(r0), newError("nvmlDeviceGetHandleByUUIDV", nil, ret)
+}
+
+func (w *lib) DeviceGetHostVgpuMode(device Device) (nvml.HostVgpuMode, error) {
+	r0, ret := w.Interface.DeviceGetHostVgpuMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetHostVgpuMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetIndex(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetIndex(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetIndex", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetInforomConfigurationChecksum(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetInforomConfigurationChecksum(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetInforomConfigurationChecksum", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetInforomImageVersion(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetInforomImageVersion(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetInforomImageVersion", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetInforomVersion(device Device, object nvml.InforomObject) (string, error) {
+	r0, ret := w.Interface.DeviceGetInforomVersion(unwrapDevice(device), object)
+	return r0, newError("nvmlDeviceGetInforomVersion", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetIrqNum(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetIrqNum(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetIrqNum", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetJpgUtilization(device Device) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetJpgUtilization(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetJpgUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetLastBBXFlushTime(device Device) (uint64, uint, error) {
+	r0, r1, ret := w.Interface.DeviceGetLastBBXFlushTime(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetLastBBXFlushTime", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMPSComputeRunningProcesses(device Device) ([]nvml.ProcessInfo, error) {
+	r0, ret := w.Interface.DeviceGetMPSComputeRunningProcesses(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMPSComputeRunningProcesses", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMarginTemperature(device Device) (nvml.MarginTemperature, error) {
+	r0, ret := w.Interface.DeviceGetMarginTemperature(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMarginTemperature", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMaxClockInfo(device Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetMaxClockInfo(unwrapDevice(device), clockType)
+	return r0, newError("nvmlDeviceGetMaxClockInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMaxCustomerBoostClock(device Device, clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetMaxCustomerBoostClock(unwrapDevice(device), clockType)
+	return r0, newError("nvmlDeviceGetMaxCustomerBoostClock", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMaxMigDeviceCount(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMaxMigDeviceCount(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMaxMigDeviceCount", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMaxPcieLinkGeneration(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMaxPcieLinkGeneration(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMaxPcieLinkGeneration", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMaxPcieLinkWidth(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMaxPcieLinkWidth(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMaxPcieLinkWidth", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, error) {
+	r0, r1, ret := w.Interface.DeviceGetMemClkMinMaxVfOffset(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetMemClkMinMaxVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemClkVfOffset(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMemClkVfOffset(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMemClkVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemoryAffinity(device Device, numNodes int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := w.Interface.DeviceGetMemoryAffinity(unwrapDevice(device), numNodes, scope)
+	return r0, newError("nvmlDeviceGetMemoryAffinity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemoryBusWidth(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetMemoryBusWidth(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMemoryBusWidth", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemoryErrorCounter(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetMemoryErrorCounter(unwrapDevice(device), errorType, counterType, locationType)
+	return r0, newError("nvmlDeviceGetMemoryErrorCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemoryInfo(device Device) (nvml.Memory, error) {
+	r0, ret := w.Interface.DeviceGetMemoryInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMemoryInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMemoryInfo_v2(device Device) (nvml.Memory_v2, error) {
+	r0, ret := w.Interface.DeviceGetMemoryInfo_v2(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMemoryInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMigDeviceHandleByIndex(device Device, index int) (Device, error) {
+	r0, ret := w.Interface.DeviceGetMigDeviceHandleByIndex(unwrapDevice(device), index)
+	return wrapDevice(r0), newError("nvmlDeviceGetMigDeviceHandleByIndex", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMigMode(device Device) (int, int, error) {
+	r0, r1, ret := w.Interface.DeviceGetMigMode(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetMigMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMinMaxClockOfPState(device Device, clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetMinMaxClockOfPState(unwrapDevice(device), clockType, pstate)
+	return r0, r1, newError("nvmlDeviceGetMinMaxClockOfPState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMinMaxFanSpeed(device Device) (int, int, error) {
+	r0, r1, ret := w.Interface.DeviceGetMinMaxFanSpeed(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetMinMaxFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMinorNumber(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMinorNumber(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMinorNumber", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetModuleId(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetModuleId(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetModuleId", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetMultiGpuBoard(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetMultiGpuBoard(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetMultiGpuBoard", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetName(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetName(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetName", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNumFans(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetNumFans(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetNumFans", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNumGpuCores(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetNumGpuCores(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetNumGpuCores", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNumaNodeId(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetNumaNodeId(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetNumaNodeId", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkCapability(device Device, link int, capability nvml.NvLinkCapability) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkCapability(unwrapDevice(device), link, capability)
+	return r0, newError("nvmlDeviceGetNvLinkCapability", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkErrorCounter(device Device, link int, counter nvml.NvLinkErrorCounter) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkErrorCounter(unwrapDevice(device), link, counter)
+	return r0, newError("nvmlDeviceGetNvLinkErrorCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkInfo(device Device) nvml.NvLinkInfoHandler {
+	return w.Interface.DeviceGetNvLinkInfo(unwrapDevice(device))
+}
+
+func (w *lib) DeviceGetNvLinkRemoteDeviceType(device Device, link int) (nvml.IntNvLinkDeviceType, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkRemoteDeviceType(unwrapDevice(device), link)
+	return r0, newError("nvmlDeviceGetNvLinkRemoteDeviceType", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkRemotePciInfo(device Device, link int) (nvml.PciInfo, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkRemotePciInfo(unwrapDevice(device), link)
+	return r0, newError("nvmlDeviceGetNvLinkRemotePciInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkState(device Device, link int) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkState(unwrapDevice(device), link)
+	return r0, newError("nvmlDeviceGetNvLinkState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkUtilizationControl(device Device, link int, counter int) (nvml.NvLinkUtilizationControl, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkUtilizationControl(unwrapDevice(device), link, counter)
+	return r0, newError("nvmlDeviceGetNvLinkUtilizationControl", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkUtilizationCounter(device Device, link int, counter int) (uint64, uint64, error) {
+	r0, r1, ret := w.Interface.DeviceGetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+	return r0, r1, newError("nvmlDeviceGetNvLinkUtilizationCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvLinkVersion(device Device, link int) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetNvLinkVersion(unwrapDevice(device), link)
+	return r0, newError("nvmlDeviceGetNvLinkVersion", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvlinkBwMode(device Device) (nvml.NvlinkGetBwMode, error) {
+	r0, ret := w.Interface.DeviceGetNvlinkBwMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetNvlinkBwMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetNvlinkSupportedBwModes(device Device) (nvml.NvlinkSupportedBwModes, error) {
+	r0, ret := w.Interface.DeviceGetNvlinkSupportedBwModes(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetNvlinkSupportedBwModes", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetOfaUtilization(device Device) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetOfaUtilization(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetOfaUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetP2PStatus(device1 Device, device2 Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error) {
+	r0, ret := w.Interface.DeviceGetP2PStatus(unwrapDevice(device1), unwrapDevice(device2), p2pIndex)
+	return r0, newError("nvmlDeviceGetP2PStatus", unwrapDevice(device1), ret)
+}
+
+func (w *lib) DeviceGetPciInfo(device Device) (nvml.PciInfo, error) {
+	r0, ret := w.Interface.DeviceGetPciInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPciInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPciInfoExt(device Device) (nvml.PciInfoExt, error) {
+	r0, ret := w.Interface.DeviceGetPciInfoExt(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPciInfoExt", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPcieLinkMaxSpeed(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetPcieLinkMaxSpeed(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPcieLinkMaxSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPcieReplayCounter(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetPcieReplayCounter(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPcieReplayCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPcieSpeed(device Device) (int, error) {
+	r0, ret := w.Interface.DeviceGetPcieSpeed(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPcieSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPcieThroughput(device Device, counter nvml.PcieUtilCounter) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetPcieThroughput(unwrapDevice(device), counter)
+	return r0, newError("nvmlDeviceGetPcieThroughput", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPdi(device Device) (nvml.Pdi, error) {
+	r0, ret := w.Interface.DeviceGetPdi(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPdi", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPerformanceModes(device Device) (nvml.DevicePerfModes, error) {
+	r0, ret := w.Interface.DeviceGetPerformanceModes(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPerformanceModes", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPerformanceState(device Device) (nvml.Pstates, error) {
+	r0, ret := w.Interface.DeviceGetPerformanceState(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPerformanceState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPersistenceMode(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetPersistenceMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPersistenceMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPgpuMetadataString(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetPgpuMetadataString(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPgpuMetadataString", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPlatformInfo(device Device) (nvml.PlatformInfo, error) {
+	r0, ret := w.Interface.DeviceGetPlatformInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPlatformInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerManagementDefaultLimit(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetPowerManagementDefaultLimit(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerManagementDefaultLimit", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerManagementLimit(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetPowerManagementLimit(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerManagementLimit", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerManagementLimitConstraints(device Device) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetPowerManagementLimitConstraints(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetPowerManagementLimitConstraints", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerManagementMode(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetPowerManagementMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerManagementMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerMizerMode_v1(device Device) (nvml.DevicePowerMizerModes_v1, error) {
+	r0, ret := w.Interface.DeviceGetPowerMizerMode_v1(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerMizerMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerSource(device Device) (nvml.PowerSource, error) {
+	r0, ret := w.Interface.DeviceGetPowerSource(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerSource", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerState(device Device) (nvml.Pstates, error) {
+	r0, ret := w.Interface.DeviceGetPowerState(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetPowerUsage(device Device) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetPowerUsage(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetPowerUsage", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetProcessUtilization(device Device, lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error) {
+	r0, ret := w.Interface.DeviceGetProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, newError("nvmlDeviceGetProcessUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetProcessesUtilizationInfo(device Device) (nvml.ProcessesUtilizationInfo, error) {
+	r0, ret := w.Interface.DeviceGetProcessesUtilizationInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetProcessesUtilizationInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRemappedRows(device Device) (int, int, bool, bool, error) {
+	r0, r1, r2, r3, ret := w.Interface.DeviceGetRemappedRows(unwrapDevice(device))
+	return r0, r1, r2, r3, newError("nvmlDeviceGetRemappedRows", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRepairStatus(device Device) (nvml.RepairStatus, error) {
+	r0, ret := w.Interface.DeviceGetRepairStatus(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetRepairStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRetiredPages(device Device, cause nvml.PageRetirementCause) ([]uint64, error) {
+	r0, ret := w.Interface.DeviceGetRetiredPages(unwrapDevice(device), cause)
+	return r0, newError("nvmlDeviceGetRetiredPages", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRetiredPagesPendingStatus(device Device) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceGetRetiredPagesPendingStatus(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetRetiredPagesPendingStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRetiredPages_v2(device Device, cause nvml.PageRetirementCause) ([]uint64, []uint64, error) {
+	r0, r1, ret := w.Interface.DeviceGetRetiredPages_v2(unwrapDevice(device), cause)
+	return r0, r1, newError("nvmlDeviceGetRetiredPages", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRowRemapperHistogram(device Device) (nvml.RowRemapperHistogramValues, error) {
+	r0, ret := w.Interface.DeviceGetRowRemapperHistogram(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetRowRemapperHistogram", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetRunningProcessDetailList(device Device) (nvml.ProcessDetailList, error) {
+	r0, ret := w.Interface.DeviceGetRunningProcessDetailList(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetRunningProcessDetailList", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSamples(device Device, samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error) {
+	r0, r1, ret := w.Interface.DeviceGetSamples(unwrapDevice(device), samplingType, lastSeenTimestamp)
+	return r0, r1, newError("nvmlDeviceGetSamples", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSerial(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetSerial(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSerial", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSramEccErrorStatus(device Device) (nvml.EccSramErrorStatus, error) {
+	r0, ret := w.Interface.DeviceGetSramEccErrorStatus(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSramEccErrorStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSramUniqueUncorrectedEccErrorCounts(device Device, errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error {
+	ret := w.Interface.DeviceGetSramUniqueUncorrectedEccErrorCounts(unwrapDevice(device), errorCounts)
+	return newError("nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedClocksEventReasons(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetSupportedClocksEventReasons(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSupportedClocksEventReasons", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetSupportedClocksThrottleReasons(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSupportedClocksThrottleReasons", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedEventTypes(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetSupportedEventTypes(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSupportedEventTypes", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetSupportedGraphicsClocks(unwrapDevice(device), memoryClockMHz)
+	return r0, r1, newError("nvmlDeviceGetSupportedGraphicsClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedMemoryClocks(device Device) (int, uint32, error) {
+	r0, r1, ret := w.Interface.DeviceGetSupportedMemoryClocks(unwrapDevice(device))
+	return r0, r1, newError("nvmlDeviceGetSupportedMemoryClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedPerformanceStates(device Device) ([]nvml.Pstates, error) {
+	r0, ret := w.Interface.DeviceGetSupportedPerformanceStates(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetSupportedPerformanceStates", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetSupportedVgpus(device Device) ([]VgpuTypeId, error) {
+	r0, ret := w.Interface.DeviceGetSupportedVgpus(unwrapDevice(device))
+	return wrapVgpuTypeIds(r0), newError("nvmlDeviceGetSupportedVgpus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTargetFanSpeed(device Device, fan int) (int, error) {
+	r0, ret := w.Interface.DeviceGetTargetFanSpeed(unwrapDevice(device), fan)
+	return r0, newError("nvmlDeviceGetTargetFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTemperature(device Device, sensorType nvml.TemperatureSensors) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetTemperature(unwrapDevice(device), sensorType)
+	return r0, newError("nvmlDeviceGetTemperature", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTemperatureThreshold(device Device, thresholdType nvml.TemperatureThresholds) (uint32, error) {
+	r0, ret := w.Interface.DeviceGetTemperatureThreshold(unwrapDevice(device), thresholdType)
+	return r0, newError("nvmlDeviceGetTemperatureThreshold", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTemperatureV(device Device) nvml.TemperatureHandler {
+	return w.Interface.DeviceGetTemperatureV(unwrapDevice(device))
+}
+
+func (w *lib) DeviceGetThermalSettings(device Device, sensorIndex uint32) (nvml.GpuThermalSettings, error) {
+	r0, ret := w.Interface.DeviceGetThermalSettings(unwrapDevice(device), sensorIndex)
+	return r0, newError("nvmlDeviceGetThermalSettings", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTopologyCommonAncestor(device1 Device, device2 Device) (nvml.GpuTopologyLevel, error) {
+	r0, ret := w.Interface.DeviceGetTopologyCommonAncestor(unwrapDevice(device1), unwrapDevice(device2))
+	return r0, newError("nvmlDeviceGetTopologyCommonAncestor", unwrapDevice(device1), ret)
+}
+
+func (w *lib) DeviceGetTopologyNearestGpus(device Device, level nvml.GpuTopologyLevel) ([]Device, error) {
+	r0, ret := w.Interface.DeviceGetTopologyNearestGpus(unwrapDevice(device), level)
+	return wrapDevices(r0), newError("nvmlDeviceGetTopologyNearestGpus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTotalEccErrors(device Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetTotalEccErrors(unwrapDevice(device), errorType, counterType)
+	return r0, newError("nvmlDeviceGetTotalEccErrors", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetTotalEnergyConsumption(device Device) (uint64, error) {
+	r0, ret := w.Interface.DeviceGetTotalEnergyConsumption(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetTotalEnergyConsumption", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetUUID(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetUUID(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetUUID", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetUtilizationRates(device Device) (nvml.Utilization, error) {
+	r0, ret := w.Interface.DeviceGetUtilizationRates(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetUtilizationRates", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVbiosVersion(device Device) (string, error) {
+	r0, ret := w.Interface.DeviceGetVbiosVersion(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVbiosVersion", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuCapabilities(device Device, capability nvml.DeviceVgpuCapability) (bool, error) {
+	r0, ret := w.Interface.DeviceGetVgpuCapabilities(unwrapDevice(device), capability)
+	return r0, newError("nvmlDeviceGetVgpuCapabilities", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuHeterogeneousMode(device Device) (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := w.Interface.DeviceGetVgpuHeterogeneousMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuHeterogeneousMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuInstancesUtilizationInfo(device Device) (nvml.VgpuInstancesUtilizationInfo, error) {
+	r0, ret := w.Interface.DeviceGetVgpuInstancesUtilizationInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuInstancesUtilizationInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuMetadata(device Device) (nvml.VgpuPgpuMetadata, error) {
+	r0, ret := w.Interface.DeviceGetVgpuMetadata(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuMetadata", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuProcessUtilization(device Device, lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error) {
+	r0, ret := w.Interface.DeviceGetVgpuProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, newError("nvmlDeviceGetVgpuProcessUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuProcessesUtilizationInfo(device Device) (nvml.VgpuProcessesUtilizationInfo, error) {
+	r0, ret := w.Interface.DeviceGetVgpuProcessesUtilizationInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuProcessesUtilizationInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuSchedulerCapabilities(device Device) (nvml.VgpuSchedulerCapabilities, error) {
+	r0, ret := w.Interface.DeviceGetVgpuSchedulerCapabilities(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuSchedulerCapabilities", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuSchedulerLog(device Device) (nvml.VgpuSchedulerLog, error) {
+	r0, ret := w.Interface.DeviceGetVgpuSchedulerLog(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuSchedulerLog", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuSchedulerState(device Device) (nvml.VgpuSchedulerGetState, error) {
+	r0, ret := w.Interface.DeviceGetVgpuSchedulerState(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuSchedulerState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuTypeCreatablePlacements(device Device, vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.Interface.DeviceGetVgpuTypeCreatablePlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlDeviceGetVgpuTypeCreatablePlacements", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuTypeSupportedPlacements(device Device, vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.Interface.DeviceGetVgpuTypeSupportedPlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlDeviceGetVgpuTypeSupportedPlacements", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error) {
+	r0, r1, ret := w.Interface.DeviceGetVgpuUtilization(unwrapDevice(device), lastSeenTimestamp)
+	return r0, r1, newError("nvmlDeviceGetVgpuUtilization", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetViolationStatus(device Device, perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error) {
+	r0, ret := w.Interface.DeviceGetViolationStatus(unwrapDevice(device), perfPolicyType)
+	return r0, newError("nvmlDeviceGetViolationStatus", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceGetVirtualizationMode(device Device) (nvml.GpuVirtualizationMode, error) {
+	r0, ret := w.Interface.DeviceGetVirtualizationMode(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVirtualizationMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceIsMigDeviceHandle(device Device) (bool, error) {
+	r0, ret := w.Interface.DeviceIsMigDeviceHandle(unwrapDevice(device))
+	return r0, newError("nvmlDeviceIsMigDeviceHandle", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceModifyDrainState(pciInfo *nvml.PciInfo, newState nvml.EnableState) error {
+	ret := w.Interface.DeviceModifyDrainState(pciInfo, newState)
+	return newError("nvmlDeviceModifyDrainState", nil, ret)
+}
+
+func (w *lib) DeviceOnSameBoard(device1 Device, device2 Device) (int, error) {
+	r0, ret := w.Interface.DeviceOnSameBoard(unwrapDevice(device1), unwrapDevice(device2))
+	return r0, newError("nvmlDeviceOnSameBoard", unwrapDevice(device1), ret)
+}
+
+func (w *lib) DevicePowerSmoothingActivatePresetProfile(device Device, profile *nvml.PowerSmoothingProfile) error {
+	ret := w.Interface.DevicePowerSmoothingActivatePresetProfile(unwrapDevice(device), profile)
+	return newError("nvmlDevicePowerSmoothingActivatePresetProfile", unwrapDevice(device), ret)
+}
+
+func (w *lib) DevicePowerSmoothingSetState(device Device, state *nvml.PowerSmoothingState) error {
+	ret := w.Interface.DevicePowerSmoothingSetState(unwrapDevice(device), state)
+	return newError("nvmlDevicePowerSmoothingSetState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DevicePowerSmoothingUpdatePresetProfileParam(device Device, profile *nvml.PowerSmoothingProfile) error {
+	ret := w.Interface.DevicePowerSmoothingUpdatePresetProfileParam(unwrapDevice(device), profile)
+	return newError("nvmlDevicePowerSmoothingUpdatePresetProfileParam", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceQueryDrainState(pciInfo *nvml.PciInfo) (nvml.EnableState, error) {
+	r0, ret := w.Interface.DeviceQueryDrainState(pciInfo)
+	return r0, newError("nvmlDeviceQueryDrainState", nil, ret)
+}
+
+func (w *lib) DeviceReadWritePRM_v1(device Device, buffer *nvml.PRMTLV_v1) error {
+	ret := w.Interface.DeviceReadWritePRM_v1(unwrapDevice(device), buffer)
+	return newError("nvmlDeviceReadWritePRM", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceRegisterEvents(device Device, eventTypes uint64, set EventSet) error {
+	ret := w.Interface.DeviceRegisterEvents(unwrapDevice(device), eventTypes, unwrapEventSet(set))
+	return newError("nvmlDeviceRegisterEvents", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceRemoveGpu(pciInfo *nvml.PciInfo) error {
+	ret := w.Interface.DeviceRemoveGpu(pciInfo)
+	return newError("nvmlDeviceRemoveGpu", nil, ret)
+}
+
+func (w *lib) DeviceRemoveGpu_v2(pciInfo *nvml.PciInfo, gpuState nvml.DetachGpuState, linkState nvml.PcieLinkState) error {
+	ret := w.Interface.DeviceRemoveGpu_v2(pciInfo, gpuState, linkState)
+	return newError("nvmlDeviceRemoveGpu", nil, ret)
+}
+
+func (w *lib) DeviceResetApplicationsClocks(device Device) error {
+	ret := w.Interface.DeviceResetApplicationsClocks(unwrapDevice(device))
+	return newError("nvmlDeviceResetApplicationsClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceResetGpuLockedClocks(device Device) error {
+	ret := w.Interface.DeviceResetGpuLockedClocks(unwrapDevice(device))
+	return newError("nvmlDeviceResetGpuLockedClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceResetMemoryLockedClocks(device Device) error {
+	ret := w.Interface.DeviceResetMemoryLockedClocks(unwrapDevice(device))
+	return newError("nvmlDeviceResetMemoryLockedClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceResetNvLinkErrorCounters(device Device, link int) error {
+	ret := w.Interface.DeviceResetNvLinkErrorCounters(unwrapDevice(device), link)
+	return newError("nvmlDeviceResetNvLinkErrorCounters", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceResetNvLinkUtilizationCounter(device Device, link int, counter int) error {
+	ret := w.Interface.DeviceResetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+	return newError("nvmlDeviceResetNvLinkUtilizationCounter", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetAPIRestriction(device Device, apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error {
+	ret := w.Interface.DeviceSetAPIRestriction(unwrapDevice(device), apiType, isRestricted)
+	return newError("nvmlDeviceSetAPIRestriction", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetAccountingMode(device Device, mode nvml.EnableState) error {
+	ret := w.Interface.DeviceSetAccountingMode(unwrapDevice(device), mode)
+	return newError("nvmlDeviceSetAccountingMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetApplicationsClocks(device Device, memClockMHz uint32, graphicsClockMHz uint32) error {
+	ret := w.Interface.DeviceSetApplicationsClocks(unwrapDevice(device), memClockMHz, graphicsClockMHz)
+	return newError("nvmlDeviceSetApplicationsClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetAutoBoostedClocksEnabled(device Device, enabled nvml.EnableState) error {
+	ret := w.Interface.DeviceSetAutoBoostedClocksEnabled(unwrapDevice(device), enabled)
+	return newError("nvmlDeviceSetAutoBoostedClocksEnabled", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetClockOffsets(device Device, info nvml.ClockOffset) error {
+	ret := w.Interface.DeviceSetClockOffsets(unwrapDevice(device), info)
+	return newError("nvmlDeviceSetClockOffsets", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetComputeMode(device Device, mode nvml.ComputeMode) error {
+	ret := w.Interface.DeviceSetComputeMode(unwrapDevice(device), mode)
+	return newError("nvmlDeviceSetComputeMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetConfComputeUnprotectedMemSize(device Device, sizeKiB uint64) error {
+	ret := w.Interface.DeviceSetConfComputeUnprotectedMemSize(unwrapDevice(device), sizeKiB)
+	return newError("nvmlDeviceSetConfComputeUnprotectedMemSize", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetCpuAffinity(device Device) error {
+	ret := w.Interface.DeviceSetCpuAffinity(unwrapDevice(device))
+	return newError("nvmlDeviceSetCpuAffinity", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetDefaultAutoBoostedClocksEnabled(device Device, enabled nvml.EnableState, flags uint32) error {
+	ret := w.Interface.DeviceSetDefaultAutoBoostedClocksEnabled(unwrapDevice(device), enabled, flags)
+	return newError("nvmlDeviceSetDefaultAutoBoostedClocksEnabled", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetDefaultFanSpeed_v2(device Device, fan int) error {
+	ret := w.Interface.DeviceSetDefaultFanSpeed_v2(unwrapDevice(device), fan)
+	return newError("nvmlDeviceSetDefaultFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetDramEncryptionMode(device Device, dramEncryption *nvml.DramEncryptionInfo) error {
+	ret := w.Interface.DeviceSetDramEncryptionMode(unwrapDevice(device), dramEncryption)
+	return newError("nvmlDeviceSetDramEncryptionMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetDriverModel(device Device, driverModel nvml.DriverModel, flags uint32) error {
+	ret := w.Interface.DeviceSetDriverModel(unwrapDevice(device), driverModel, flags)
+	return newError("nvmlDeviceSetDriverModel", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetEccMode(device Device, ecc nvml.EnableState) error {
+	ret := w.Interface.DeviceSetEccMode(unwrapDevice(device), ecc)
+	return newError("nvmlDeviceSetEccMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetFanControlPolicy(device Device, fan int, policy nvml.FanControlPolicy) error {
+	ret := w.Interface.DeviceSetFanControlPolicy(unwrapDevice(device), fan, policy)
+	return newError("nvmlDeviceSetFanControlPolicy", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetFanSpeed_v2(device Device, fan int, speed int) error {
+	ret := w.Interface.DeviceSetFanSpeed_v2(unwrapDevice(device), fan, speed)
+	return newError("nvmlDeviceSetFanSpeed", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetGpcClkVfOffset(device Device, offset int) error {
+	ret := w.Interface.DeviceSetGpcClkVfOffset(unwrapDevice(device), offset)
+	return newError("nvmlDeviceSetGpcClkVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) error {
+	ret := w.Interface.DeviceSetGpuLockedClocks(unwrapDevice(device), minGpuClockMHz, maxGpuClockMHz)
+	return newError("nvmlDeviceSetGpuLockedClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetGpuOperationMode(device Device, mode nvml.GpuOperationMode) error {
+	ret := w.Interface.DeviceSetGpuOperationMode(unwrapDevice(device), mode)
+	return newError("nvmlDeviceSetGpuOperationMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetMemClkVfOffset(device Device, offset int) error {
+	ret := w.Interface.DeviceSetMemClkVfOffset(unwrapDevice(device), offset)
+	return newError("nvmlDeviceSetMemClkVfOffset", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetMemoryLockedClocks(device Device, minMemClockMHz uint32, maxMemClockMHz uint32) error {
+	ret := w.Interface.DeviceSetMemoryLockedClocks(unwrapDevice(device), minMemClockMHz, maxMemClockMHz)
+	return newError("nvmlDeviceSetMemoryLockedClocks", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetMigMode(device Device, mode int) (nvml.Return, error) {
+	r0, ret := w.Interface.DeviceSetMigMode(unwrapDevice(device), mode)
+	return r0, newError("nvmlDeviceSetMigMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetNvLinkDeviceLowPowerThreshold(device Device, info *nvml.NvLinkPowerThres) error {
+	ret := w.Interface.DeviceSetNvLinkDeviceLowPowerThreshold(unwrapDevice(device), info)
+	return newError("nvmlDeviceSetNvLinkDeviceLowPowerThreshold", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetNvLinkUtilizationControl(device Device, link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error {
+	ret := w.Interface.DeviceSetNvLinkUtilizationControl(unwrapDevice(device), link, counter, control, reset)
+	return newError("nvmlDeviceSetNvLinkUtilizationControl", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetNvlinkBwMode(device Device, setBwMode *nvml.NvlinkSetBwMode) error {
+	ret := w.Interface.DeviceSetNvlinkBwMode(unwrapDevice(device), setBwMode)
+	return newError("nvmlDeviceSetNvlinkBwMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetPersistenceMode(device Device, mode nvml.EnableState) error {
+	ret := w.Interface.DeviceSetPersistenceMode(unwrapDevice(device), mode)
+	return newError("nvmlDeviceSetPersistenceMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetPowerManagementLimit(device Device, limit uint32) error {
+	ret := w.Interface.DeviceSetPowerManagementLimit(unwrapDevice(device), limit)
+	return newError("nvmlDeviceSetPowerManagementLimit", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetPowerManagementLimit_v2(device Device, powerValue *nvml.PowerValue_v2) error {
+	ret := w.Interface.DeviceSetPowerManagementLimit_v2(unwrapDevice(device), powerValue)
+	return newError("nvmlDeviceSetPowerManagementLimit", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetPowerMizerMode_v1(device Device, powerMizerMode *nvml.DevicePowerMizerModes_v1) error {
+	ret := w.Interface.DeviceSetPowerMizerMode_v1(unwrapDevice(device), powerMizerMode)
+	return newError("nvmlDeviceSetPowerMizerMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetTemperatureThreshold(device Device, thresholdType nvml.TemperatureThresholds, temp int) error {
+	ret := w.Interface.DeviceSetTemperatureThreshold(unwrapDevice(device), thresholdType, temp)
+	return newError("nvmlDeviceSetTemperatureThreshold", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetVgpuCapabilities(device Device, capability nvml.DeviceVgpuCapability, state nvml.EnableState) error {
+	ret := w.Interface.DeviceSetVgpuCapabilities(unwrapDevice(device), capability, state)
+	return newError("nvmlDeviceSetVgpuCapabilities", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetVgpuHeterogeneousMode(device Device, heterogeneousMode nvml.VgpuHeterogeneousMode) error {
+	ret := w.Interface.DeviceSetVgpuHeterogeneousMode(unwrapDevice(device), heterogeneousMode)
+	return newError("nvmlDeviceSetVgpuHeterogeneousMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetVgpuSchedulerState(device Device, pSchedulerState *nvml.VgpuSchedulerSetState) error {
+	ret := w.Interface.DeviceSetVgpuSchedulerState(unwrapDevice(device), pSchedulerState)
+	return newError("nvmlDeviceSetVgpuSchedulerState", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceSetVirtualizationMode(device Device, virtualMode nvml.GpuVirtualizationMode) error {
+	ret := w.Interface.DeviceSetVirtualizationMode(unwrapDevice(device), virtualMode)
+	return newError("nvmlDeviceSetVirtualizationMode", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceValidateInforom(device Device) error {
+	ret := w.Interface.DeviceValidateInforom(unwrapDevice(device))
+	return newError("nvmlDeviceValidateInforom", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceWorkloadPowerProfileClearRequestedProfiles(device Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := w.Interface.DeviceWorkloadPowerProfileClearRequestedProfiles(unwrapDevice(device), requestedProfiles)
+	return newError("nvmlDeviceWorkloadPowerProfileClearRequestedProfiles", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (nvml.WorkloadPowerProfileCurrentProfiles, error) {
+	r0, ret := w.Interface.DeviceWorkloadPowerProfileGetCurrentProfiles(unwrapDevice(device))
+	return r0, newError("nvmlDeviceWorkloadPowerProfileGetCurrentProfiles", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (nvml.WorkloadPowerProfileProfilesInfo, error) {
+	r0, ret := w.Interface.DeviceWorkloadPowerProfileGetProfilesInfo(unwrapDevice(device))
+	return r0, newError("nvmlDeviceWorkloadPowerProfileGetProfilesInfo", unwrapDevice(device), ret)
+}
+
+func (w *lib) DeviceWorkloadPowerProfileSetRequestedProfiles(device Device, requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := w.Interface.DeviceWorkloadPowerProfileSetRequestedProfiles(unwrapDevice(device), requestedProfiles)
+	return newError("nvmlDeviceWorkloadPowerProfileSetRequestedProfiles", unwrapDevice(device), ret)
+}
+
+func (w *lib) ErrorString(r nvml.Return) string {
+	return w.Interface.ErrorString(r)
+}
+
+func (w *lib) EventSetCreate() (EventSet, error) {
+	r0, ret := w.Interface.EventSetCreate()
+	return wrapEventSet(r0), newError("nvmlEventSetCreate", nil, ret)
+}
+
+func (w *lib) EventSetFree(set EventSet) error {
+	ret := w.Interface.EventSetFree(unwrapEventSet(set))
+	return newError("nvmlEventSetFree", nil, ret)
+}
+
+func (w *lib) EventSetWait(set EventSet, timeoutms uint32) (nvml.EventData, error) {
+	r0, ret := w.Interface.EventSetWait(unwrapEventSet(set), timeoutms)
+	return r0, newError("nvmlEventSetWait", nil, ret)
+}
+
+func (w *lib) Extensions() nvml.ExtendedInterface {
+	return w.Interface.Extensions()
+}
+
+func (w *lib) GetExcludedDeviceCount() (int, error) {
+	r0, ret := w.Interface.GetExcludedDeviceCount()
+	return r0, newError("nvmlGetExcludedDeviceCount", nil, ret)
+}
+
+func (w *lib) GetExcludedDeviceInfoByIndex(index int) (nvml.ExcludedDeviceInfo, error) {
+	r0, ret := w.Interface.GetExcludedDeviceInfoByIndex(index)
+	return r0, newError("nvmlGetExcludedDeviceInfoByIndex", nil, ret)
+}
+
+func (w *lib) GetVgpuCompatibility(vgpuMetadata *nvml.VgpuMetadata, pgpuMetadata *nvml.VgpuPgpuMetadata) (nvml.VgpuPgpuCompatibility, error) {
+	r0, ret := w.Interface.GetVgpuCompatibility(vgpuMetadata, pgpuMetadata)
+	return r0, newError("nvmlGetVgpuCompatibility", nil, ret)
+}
+
+func (w *lib) GetVgpuDriverCapabilities(capability nvml.VgpuDriverCapability) (bool, error) {
+	r0, ret := w.Interface.GetVgpuDriverCapabilities(capability)
+	return r0, newError("nvmlGetVgpuDriverCapabilities", nil, ret)
+}
+
+func (w *lib) GetVgpuVersion() (nvml.VgpuVersion, nvml.VgpuVersion, error) {
+	r0, r1, ret := w.Interface.GetVgpuVersion()
+	return r0, r1, newError("nvmlGetVgpuVersion", nil, ret)
+}
+
+func (w *lib) GpmMetricsGet(metricsGet *nvml.GpmMetricsGetType) error {
+	ret := w.Interface.GpmMetricsGet(metricsGet)
+	return newError("nvmlGpmMetricsGet", nil, ret)
+}
+
+func (w *lib) GpmMetricsGetV(metricsGet *nvml.GpmMetricsGetType) nvml.GpmMetricsGetVType {
+	return w.Interface.GpmMetricsGetV(metricsGet)
+}
+
+func (w *lib) GpmMigSampleGet(device Device, gpuInstanceId int, gpmSample GpmSample) error {
+	ret := w.Interface.GpmMigSampleGet(unwrapDevice(device), gpuInstanceId, unwrapGpmSample(gpmSample))
+	return newError("nvmlGpmMigSampleGet", unwrapDevice(device), ret)
+}
+
+func (w *lib) GpmQueryDeviceSupport(device Device) (nvml.GpmSupport, error) {
+	r0, ret := w.Interface.GpmQueryDeviceSupport(unwrapDevice(device))
+	return r0, newError("nvmlGpmQueryDeviceSupport", unwrapDevice(device), ret)
+}
+
+func (w *lib) GpmQueryDeviceSupportV(device Device) nvml.GpmSupportV {
+	return w.Interface.GpmQueryDeviceSupportV(unwrapDevice(device))
+}
+
+func (w *lib) GpmQueryIfStreamingEnabled(device Device) (uint32, error) {
+	r0, ret := w.Interface.GpmQueryIfStreamingEnabled(unwrapDevice(device))
+	return r0, newError("nvmlGpmQueryIfStreamingEnabled", unwrapDevice(device), ret)
+}
+
+func (w *lib) GpmSampleAlloc() (GpmSample, error) {
+	r0, ret := w.Interface.GpmSampleAlloc()
+	return wrapGpmSample(r0), newError("nvmlGpmSampleAlloc", nil, ret)
+}
+
+func (w *lib) GpmSampleFree(gpmSample GpmSample) error {
+	ret := w.Interface.GpmSampleFree(unwrapGpmSample(gpmSample))
+	return newError("nvmlGpmSampleFree", nil, ret)
+}
+
+func (w *lib) GpmSampleGet(device Device, gpmSample GpmSample) error {
+	ret := w.Interface.GpmSampleGet(unwrapDevice(device), unwrapGpmSample(gpmSample))
+	return newError("nvmlGpmSampleGet", unwrapDevice(device), ret)
+}
+
+func (w *lib) GpmSetStreamingEnabled(device Device, state uint32) error {
+	ret := w.Interface.GpmSetStreamingEnabled(unwrapDevice(device), state)
+	return newError("nvmlGpmSetStreamingEnabled", unwrapDevice(device), ret)
+}
+
+func (w *lib) GpuInstanceCreateComputeInstance(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) (ComputeInstance, error) {
+	r0, ret := w.Interface.GpuInstanceCreateComputeInstance(unwrapGpuInstance(gpuInstance), info)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceCreateComputeInstance", nil, ret)
+}
+
+func (w *lib) GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (ComputeInstance, error) {
+	r0, ret := w.Interface.GpuInstanceCreateComputeInstanceWithPlacement(unwrapGpuInstance(gpuInstance), info, placement)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceCreateComputeInstanceWithPlacement", nil, ret)
+}
+
+func (w *lib) GpuInstanceDestroy(gpuInstance GpuInstance) error {
+	ret := w.Interface.GpuInstanceDestroy(unwrapGpuInstance(gpuInstance))
+	return newError("nvmlGpuInstanceDestroy", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (nvml.ActiveVgpuInstanceInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetActiveVgpus(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetActiveVgpus", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, error) {
+	r0, ret := w.Interface.GpuInstanceGetComputeInstanceById(unwrapGpuInstance(gpuInstance), id)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceGetComputeInstanceById", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error) {
+	r0, ret := w.Interface.GpuInstanceGetComputeInstancePossiblePlacements(unwrapGpuInstance(gpuInstance), info)
+	return r0, newError("nvmlGpuInstanceGetComputeInstancePossiblePlacements", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstance, profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetComputeInstanceProfileInfo(unwrapGpuInstance(gpuInstance), profile, engProfile)
+	return r0, newError("nvmlGpuInstanceGetComputeInstanceProfileInfo", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) nvml.ComputeInstanceProfileInfoHandler {
+	return w.Interface.GpuInstanceGetComputeInstanceProfileInfoV(unwrapGpuInstance(gpuInstance), profile, engProfile)
+}
+
+func (w *lib) GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) (int, error) {
+	r0, ret := w.Interface.GpuInstanceGetComputeInstanceRemainingCapacity(unwrapGpuInstance(gpuInstance), info)
+	return r0, newError("nvmlGpuInstanceGetComputeInstanceRemainingCapacity", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetComputeInstances(gpuInstance GpuInstance, info *nvml.ComputeInstanceProfileInfo) ([]ComputeInstance, error) {
+	r0, ret := w.Interface.GpuInstanceGetComputeInstances(unwrapGpuInstance(gpuInstance), info)
+	return wrapComputeInstances(r0), newError("nvmlGpuInstanceGetComputeInstances", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (nvml.VgpuTypeIdInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetCreatableVgpus(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetCreatableVgpus", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetInfo(gpuInstance GpuInstance) (nvml.GpuInstanceInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetInfo(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetInfo", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := w.Interface.GpuInstanceGetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetVgpuHeterogeneousMode", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (nvml.VgpuSchedulerLogInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetVgpuSchedulerLog(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetVgpuSchedulerLog", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (nvml.VgpuSchedulerStateInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetVgpuSchedulerState(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetVgpuSchedulerState", nil, ret)
+}
+
+func (w *lib) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInstance) (nvml.VgpuCreatablePlacementInfo, error) {
+	r0, ret := w.Interface.GpuInstanceGetVgpuTypeCreatablePlacements(unwrapGpuInstance(gpuInstance))
+	return r0, newError("nvmlGpuInstanceGetVgpuTypeCreatablePlacements", nil, ret)
+}
+
+func (w *lib) GpuInstanceSetVgpuHeterogeneousMode(gpuInstance GpuInstance, heterogeneousMode *nvml.VgpuHeterogeneousMode) error {
+	ret := w.Interface.GpuInstanceSetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance), heterogeneousMode)
+	return newError("nvmlGpuInstanceSetVgpuHeterogeneousMode", nil, ret)
+}
+
+func (w *lib) GpuInstanceSetVgpuSchedulerState(gpuInstance GpuInstance, scheduler *nvml.VgpuSchedulerState) error {
+	ret := w.Interface.GpuInstanceSetVgpuSchedulerState(unwrapGpuInstance(gpuInstance), scheduler)
+	return newError("nvmlGpuInstanceSetVgpuSchedulerState", nil, ret)
+}
+
+func (w *lib) Init() error {
+	ret := w.Interface.Init()
+	return newError("nvmlInit", nil, ret)
+}
+
+func (w *lib) InitWithFlags(flags uint32) error {
+	ret := w.Interface.InitWithFlags(flags)
+	return newError("nvmlInitWithFlags", nil, ret)
+}
+
+func (w *lib) SetVgpuVersion(vgpuVersion *nvml.VgpuVersion) error {
+	ret := w.Interface.SetVgpuVersion(vgpuVersion)
+	return newError("nvmlSetVgpuVersion", nil, ret)
+}
+
+func (w *lib) Shutdown() error {
+	ret := w.Interface.Shutdown()
+	return newError("nvmlShutdown", nil, ret)
+}
+
+func (w *lib) SystemEventSetCreate(request *nvml.SystemEventSetCreateRequest) error {
+	ret := w.Interface.SystemEventSetCreate(request)
+	return newError("nvmlSystemEventSetCreate", nil, ret)
+}
+
+func (w *lib) SystemEventSetFree(request *nvml.SystemEventSetFreeRequest) error {
+	ret := w.Interface.SystemEventSetFree(request)
+	return newError("nvmlSystemEventSetFree", nil, ret)
+}
+
+func (w *lib) SystemEventSetWait(request *nvml.SystemEventSetWaitRequest) error {
+	ret := w.Interface.SystemEventSetWait(request)
+	return newError("nvmlSystemEventSetWait", nil, ret)
+}
+
+func (w *lib) SystemGetConfComputeCapabilities() (nvml.ConfComputeSystemCaps, error) {
+	r0, ret := w.Interface.SystemGetConfComputeCapabilities()
+	return r0, newError("nvmlSystemGetConfComputeCapabilities", nil, ret)
+}
+
+func (w *lib) SystemGetConfComputeGpusReadyState() (uint32, error) {
+	r0, ret := w.Interface.SystemGetConfComputeGpusReadyState()
+	return r0, newError("nvmlSystemGetConfComputeGpusReadyState", nil, ret)
+}
+
+func (w *lib) SystemGetConfComputeKeyRotationThresholdInfo() (nvml.ConfComputeGetKeyRotationThresholdInfo, error) {
+	r0, ret := w.Interface.SystemGetConfComputeKeyRotationThresholdInfo()
+	return r0, newError("nvmlSystemGetConfComputeKeyRotationThresholdInfo", nil, ret)
+}
+
+func (w *lib) SystemGetConfComputeSettings() (nvml.SystemConfComputeSettings, error) {
+	r0, ret := w.Interface.SystemGetConfComputeSettings()
+	return r0, newError("nvmlSystemGetConfComputeSettings", nil, ret)
+}
+
+func (w *lib) SystemGetConfComputeState() (nvml.ConfComputeSystemState, error) {
+	r0, ret := w.Interface.SystemGetConfComputeState()
+	return r0, newError("nvmlSystemGetConfComputeState", nil, ret)
+}
+
+func (w *lib) SystemGetCudaDriverVersion() (int, error) {
+	r0, ret := w.Interface.SystemGetCudaDriverVersion()
+	return r0, newError("nvmlSystemGetCudaDriverVersion", nil, ret)
+}
+
+func (w *lib) SystemGetCudaDriverVersion_v2() (int, error) {
+	r0, ret := w.Interface.SystemGetCudaDriverVersion_v2()
+	return r0, newError("nvmlSystemGetCudaDriverVersion", nil, ret)
+}
+
+func (w *lib) SystemGetDriverBranch() (nvml.SystemDriverBranchInfo, error) {
+	r0, ret := w.Interface.SystemGetDriverBranch()
+	return r0, newError("nvmlSystemGetDriverBranch", nil, ret)
+}
+
+func (w *lib) SystemGetDriverVersion() (string, error) {
+	r0, ret := w.Interface.SystemGetDriverVersion()
+	return r0, newError("nvmlSystemGetDriverVersion", nil, ret)
+}
+
+func (w *lib) SystemGetHicVersion() ([]nvml.HwbcEntry, error) {
+	r0, ret := w.Interface.SystemGetHicVersion()
+	return r0, newError("nvmlSystemGetHicVersion", nil, ret)
+}
+
+func (w *lib) SystemGetNVMLVersion() (string, error) {
+	r0, ret := w.Interface.SystemGetNVMLVersion()
+	return r0, newError("nvmlSystemGetNVMLVersion", nil, ret)
+}
+
+func (w *lib) SystemGetNvlinkBwMode() (uint32, error) {
+	r0, ret := w.Interface.SystemGetNvlinkBwMode()
+	return r0, newError("nvmlSystemGetNvlinkBwMode", nil, ret)
+}
+
+func (w *lib) SystemGetProcessName(pid int) (string, error) {
+	r0, ret := w.Interface.SystemGetProcessName(pid)
+	return r0, newError("nvmlSystemGetProcessName", nil, ret)
+}
+
+func (w *lib) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, error) {
+	r0, ret := w.Interface.SystemGetTopologyGpuSet(cpuNumber)
+	return wrapDevices(r0), newError("nvmlSystemGetTopologyGpuSet", nil, ret)
+}
+
+func (w *lib) SystemRegisterEvents(request *nvml.SystemRegisterEventRequest) error {
+	ret := w.Interface.SystemRegisterEvents(request)
+	return newError("nvmlSystemRegisterEvents", nil, ret)
+}
+
+func (w *lib) SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) error {
+	ret := w.Interface.SystemSetConfComputeGpusReadyState(isAcceptingWork)
+	return newError("nvmlSystemSetConfComputeGpusReadyState", nil, ret)
+}
+
+func (w *lib) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo) error {
+	ret := w.Interface.SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo)
+	return newError("nvmlSystemSetConfComputeKeyRotationThresholdInfo", nil, ret)
+}
+
+func (w *lib) SystemSetNvlinkBwMode(nvlinkBwMode uint32) error {
+	ret := w.Interface.SystemSetNvlinkBwMode(nvlinkBwMode)
+	return newError("nvmlSystemSetNvlinkBwMode", nil, ret)
+}
+
+func (w *lib) UnitGetCount() (int, error) {
+	r0, ret := w.Interface.UnitGetCount()
+	return r0, newError("nvmlUnitGetCount", nil, ret)
+}
+
+func (w *lib) UnitGetDevices(unit Unit) ([]Device, error) {
+	r0, ret := w.Interface.UnitGetDevices(unwrapUnit(unit))
+	return wrapDevices(r0), newError("nvmlUnitGetDevices", nil, ret)
+}
+
+func (w *lib) UnitGetFanSpeedInfo(unit Unit) (nvml.UnitFanSpeeds, error) {
+	r0, ret := w.Interface.UnitGetFanSpeedInfo(unwrapUnit(unit))
+	return r0, newError("nvmlUnitGetFanSpeedInfo", nil, ret)
+}
+
+func (w *lib) UnitGetHandleByIndex(index int) (Unit, error) {
+	r0, ret := w.Interface.UnitGetHandleByIndex(index)
+	return wrapUnit(r0), newError("nvmlUnitGetHandleByIndex", nil, ret)
+}
+
+func (w *lib) UnitGetLedState(unit Unit) (nvml.LedState, error) {
+	r0, ret := w.Interface.UnitGetLedState(unwrapUnit(unit))
+	return r0, newError("nvmlUnitGetLedState", nil, ret)
+}
+
+func (w *lib) UnitGetPsuInfo(unit Unit) (nvml.PSUInfo, error) {
+	r0, ret := w.Interface.UnitGetPsuInfo(unwrapUnit(unit))
+	return r0, newError("nvmlUnitGetPsuInfo", nil, ret)
+}
+
+func (w *lib) UnitGetTemperature(unit Unit, ttype int) (uint32, error) {
+	r0, ret := w.Interface.UnitGetTemperature(unwrapUnit(unit), ttype)
+	return r0, newError("nvmlUnitGetTemperature", nil, ret)
+}
+
+func (w *lib) UnitGetUnitInfo(unit Unit) (nvml.UnitInfo, error) {
+	r0, ret := w.Interface.UnitGetUnitInfo(unwrapUnit(unit))
+	return r0, newError("nvmlUnitGetUnitInfo", nil, ret)
+}
+
+func (w *lib) UnitSetLedState(unit Unit, color nvml.LedColor) error {
+	ret := w.Interface.UnitSetLedState(unwrapUnit(unit), color)
+	return newError("nvmlUnitSetLedState", nil, ret)
+}
+
+func (w *lib) VgpuInstanceClearAccountingPids(vgpuInstance VgpuInstance) error {
+	ret := w.Interface.VgpuInstanceClearAccountingPids(unwrapVgpuInstance(vgpuInstance))
+	return newError("nvmlVgpuInstanceClearAccountingPids", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetAccountingMode(vgpuInstance VgpuInstance) (nvml.EnableState, error) {
+	r0, ret := w.Interface.VgpuInstanceGetAccountingMode(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetAccountingMode", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, error) {
+	r0, ret := w.Interface.VgpuInstanceGetAccountingPids(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetAccountingPids", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (nvml.AccountingStats, error) {
+	r0, ret := w.Interface.VgpuInstanceGetAccountingStats(unwrapVgpuInstance(vgpuInstance), pid)
+	return r0, newError("nvmlVgpuInstanceGetAccountingStats", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetEccMode(vgpuInstance VgpuInstance) (nvml.EnableState, error) {
+	r0, ret := w.Interface.VgpuInstanceGetEccMode(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetEccMode", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetEncoderCapacity(vgpuInstance VgpuInstance) (int, error) {
+	r0, ret := w.Interface.VgpuInstanceGetEncoderCapacity(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetEncoderCapacity", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetEncoderSessions(vgpuInstance VgpuInstance) (int, nvml.EncoderSessionInfo, error) {
+	r0, r1, ret := w.Interface.VgpuInstanceGetEncoderSessions(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, newError("nvmlVgpuInstanceGetEncoderSessions", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetEncoderStats(vgpuInstance VgpuInstance) (int, uint32, uint32, error) {
+	r0, r1, r2, ret := w.Interface.VgpuInstanceGetEncoderStats(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, r2, newError("nvmlVgpuInstanceGetEncoderStats", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetFBCSessions(vgpuInstance VgpuInstance) (int, nvml.FBCSessionInfo, error) {
+	r0, r1, ret := w.Interface.VgpuInstanceGetFBCSessions(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, newError("nvmlVgpuInstanceGetFBCSessions", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetFBCStats(vgpuInstance VgpuInstance) (nvml.FBCStats, error) {
+	r0, ret := w.Interface.VgpuInstanceGetFBCStats(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetFBCStats", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetFbUsage(vgpuInstance VgpuInstance) (uint64, error) {
+	r0, ret := w.Interface.VgpuInstanceGetFbUsage(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetFbUsage", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetFrameRateLimit(vgpuInstance VgpuInstance) (uint32, error) {
+	r0, ret := w.Interface.VgpuInstanceGetFrameRateLimit(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetFrameRateLimit", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetGpuInstanceId(vgpuInstance VgpuInstance) (int, error) {
+	r0, ret := w.Interface.VgpuInstanceGetGpuInstanceId(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetGpuInstanceId", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetGpuPciId(vgpuInstance VgpuInstance) (string, error) {
+	r0, ret := w.Interface.VgpuInstanceGetGpuPciId(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetGpuPciId", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (nvml.VgpuLicenseInfo, error) {
+	r0, ret := w.Interface.VgpuInstanceGetLicenseInfo(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetLicenseInfo", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetLicenseStatus(vgpuInstance VgpuInstance) (int, error) {
+	r0, ret := w.Interface.VgpuInstanceGetLicenseStatus(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetLicenseStatus", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetMdevUUID(vgpuInstance VgpuInstance) (string, error) {
+	r0, ret := w.Interface.VgpuInstanceGetMdevUUID(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetMdevUUID", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetMetadata(vgpuInstance VgpuInstance) (nvml.VgpuMetadata, error) {
+	r0, ret := w.Interface.VgpuInstanceGetMetadata(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetMetadata", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetPlacementId(vgpuInstance VgpuInstance) (nvml.VgpuPlacementId, error) {
+	r0, ret := w.Interface.VgpuInstanceGetPlacementId(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetPlacementId", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (nvml.VgpuRuntimeState, error) {
+	r0, ret := w.Interface.VgpuInstanceGetRuntimeStateSize(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetRuntimeStateSize", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetType(vgpuInstance VgpuInstance) (VgpuTypeId, error) {
+	r0, ret := w.Interface.VgpuInstanceGetType(unwrapVgpuInstance(vgpuInstance))
+	return wrapVgpuTypeId(r0), newError("nvmlVgpuInstanceGetType", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetUUID(vgpuInstance VgpuInstance) (string, error) {
+	r0, ret := w.Interface.VgpuInstanceGetUUID(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetUUID", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetVmDriverVersion(vgpuInstance VgpuInstance) (string, error) {
+	r0, ret := w.Interface.VgpuInstanceGetVmDriverVersion(unwrapVgpuInstance(vgpuInstance))
+	return r0, newError("nvmlVgpuInstanceGetVmDriverVersion", nil, ret)
+}
+
+func (w *lib) VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, nvml.VgpuVmIdType, error) {
+	r0, r1, ret := w.Interface.VgpuInstanceGetVmID(unwrapVgpuInstance(vgpuInstance))
+	return r0, r1, newError("nvmlVgpuInstanceGetVmID", nil, ret)
+}
+
+func (w *lib) VgpuInstanceSetEncoderCapacity(vgpuInstance VgpuInstance, encoderCapacity int) error {
+	ret := w.Interface.VgpuInstanceSetEncoderCapacity(unwrapVgpuInstance(vgpuInstance), encoderCapacity)
+	return newError("nvmlVgpuInstanceSetEncoderCapacity", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (nvml.VgpuTypeBar1Info, error) {
+	r0, ret := w.Interface.VgpuTypeGetBAR1Info(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetBAR1Info", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetCapabilities(vgpuTypeId VgpuTypeId, capability nvml.VgpuCapability) (bool, error) {
+	r0, ret := w.Interface.VgpuTypeGetCapabilities(unwrapVgpuTypeId(vgpuTypeId), capability)
+	return r0, newError("nvmlVgpuTypeGetCapabilities", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetClass(vgpuTypeId VgpuTypeId) (string, error) {
+	r0, ret := w.Interface.VgpuTypeGetClass(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetClass", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetDeviceID(vgpuTypeId VgpuTypeId) (uint64, uint64, error) {
+	r0, r1, ret := w.Interface.VgpuTypeGetDeviceID(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, r1, newError("nvmlVgpuTypeGetDeviceID", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetFbReservation(vgpuTypeId VgpuTypeId) (uint64, error) {
+	r0, ret := w.Interface.VgpuTypeGetFbReservation(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetFbReservation", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetFrameRateLimit(vgpuTypeId VgpuTypeId) (uint32, error) {
+	r0, ret := w.Interface.VgpuTypeGetFrameRateLimit(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetFrameRateLimit", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetFramebufferSize(vgpuTypeId VgpuTypeId) (uint64, error) {
+	r0, ret := w.Interface.VgpuTypeGetFramebufferSize(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetFramebufferSize", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetGpuInstanceProfileId(vgpuTypeId VgpuTypeId) (uint32, error) {
+	r0, ret := w.Interface.VgpuTypeGetGpuInstanceProfileId(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetGpuInstanceProfileId", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetGspHeapSize(vgpuTypeId VgpuTypeId) (uint64, error) {
+	r0, ret := w.Interface.VgpuTypeGetGspHeapSize(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetGspHeapSize", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetLicense(vgpuTypeId VgpuTypeId) (string, error) {
+	r0, ret := w.Interface.VgpuTypeGetLicense(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetLicense", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetMaxInstances(device Device, vgpuTypeId VgpuTypeId) (int, error) {
+	r0, ret := w.Interface.VgpuTypeGetMaxInstances(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetMaxInstances", unwrapDevice(device), ret)
+}
+
+func (w *lib) VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *nvml.VgpuTypeMaxInstance) error {
+	ret := w.Interface.VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance)
+	return newError("nvmlVgpuTypeGetMaxInstancesPerGpuInstance", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, error) {
+	r0, ret := w.Interface.VgpuTypeGetMaxInstancesPerVm(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetMaxInstancesPerVm", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetName(vgpuTypeId VgpuTypeId) (string, error) {
+	r0, ret := w.Interface.VgpuTypeGetName(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetName", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetNumDisplayHeads(vgpuTypeId VgpuTypeId) (int, error) {
+	r0, ret := w.Interface.VgpuTypeGetNumDisplayHeads(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetNumDisplayHeads", nil, ret)
+}
+
+func (w *lib) VgpuTypeGetResolution(vgpuTypeId VgpuTypeId, displayIndex int) (uint32, uint32, error) {
+	r0, r1, ret := w.Interface.VgpuTypeGetResolution(unwrapVgpuTypeId(vgpuTypeId), displayIndex)
+	return r0, r1, newError("nvmlVgpuTypeGetResolution", nil, ret)
+}
+
+// Device represents the interface for the nvml.Device type.
+// Methods that return an nvml.Return return an error instead.
+type Device interface {
+	Unwrap() nvml.Device
+	ClearAccountingPids() error
+	ClearCpuAffinity() error
+	ClearEccErrorCounts(counterType nvml.EccCounterType) error
+	ClearFieldValues(values []nvml.FieldValue) error
+	CreateGpuInstance(info *nvml.GpuInstanceProfileInfo) (GpuInstance, error)
+	CreateGpuInstanceWithPlacement(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (GpuInstance, error)
+	FreezeNvLinkUtilizationCounter(link int, counter int, freeze nvml.EnableState) error
+	GetAPIRestriction(apiType nvml.RestrictedAPI) (nvml.EnableState, error)
+	GetAccountingBufferSize() (int, error)
+	GetAccountingMode() (nvml.EnableState, error)
+	GetAccountingPids() ([]int, error)
+	GetAccountingStats(pid uint32) (nvml.AccountingStats, error)
+	GetActiveVgpus() ([]VgpuInstance, error)
+	GetAdaptiveClockInfoStatus() (uint32, error)
+	GetAddressingMode() (nvml.DeviceAddressingMode, error)
+	GetApplicationsClock(clockType nvml.ClockType) (uint32, error)
+	GetArchitecture() (nvml.DeviceArchitecture, error)
+	GetAttributes() (nvml.DeviceAttributes, error)
+	GetAutoBoostedClocksEnabled() (nvml.EnableState, nvml.EnableState, error)
+	GetBAR1MemoryInfo() (nvml.BAR1Memory, error)
+	GetBoardId() (uint32, error)
+	GetBoardPartNumber() (string, error)
+	GetBrand() (nvml.BrandType, error)
+	GetBridgeChipInfo() (nvml.BridgeChipHierarchy, error)
+	GetBusType() (nvml.BusType, error)
+	GetC2cModeInfoV() nvml.C2cModeInfoHandler
+	GetCapabilities() (nvml.DeviceCapabilities, error)
+	GetClkMonStatus() (nvml.ClkMonStatus, error)
+	GetClock(clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error)
+	GetClockInfo(clockType nvml.ClockType) (uint32, error)
+	GetClockOffsets() (nvml.ClockOffset, error)
+	GetComputeInstanceId() (int, error)
+	GetComputeMode() (nvml.ComputeMode, error)
+	GetComputeRunningProcesses() ([]nvml.ProcessInfo, error)
+	GetConfComputeGpuAttestationReport(gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error
+	GetConfComputeGpuCertificate() (nvml.ConfComputeGpuCertificate, error)
+	GetConfComputeMemSizeInfo() (nvml.ConfComputeMemSizeInfo, error)
+	GetConfComputeProtectedMemoryUsage() (nvml.Memory, error)
+	GetCoolerInfo() (nvml.CoolerInfo, error)
+	GetCpuAffinity(numCPUs int) ([]uint, error)
+	GetCpuAffinityWithinScope(numCPUs int, scope nvml.AffinityScope) ([]uint, error)
+	GetCreatableVgpus() ([]VgpuTypeId, error)
+	GetCudaComputeCapability() (int, int, error)
+	GetCurrPcieLinkGeneration() (int, error)
+	GetCurrPcieLinkWidth() (int, error)
+	GetCurrentClockFreqs() (nvml.DeviceCurrentClockFreqs, error)
+	GetCurrentClocksEventReasons() (uint64, error)
+	GetCurrentClocksThrottleReasons() (uint64, error)
+	GetDecoderUtilization() (uint32, uint32, error)
+	GetDefaultApplicationsClock(clockType nvml.ClockType) (uint32, error)
+	GetDefaultEccMode() (nvml.EnableState, error)
+	GetDetailedEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error)
+	GetDeviceHandleFromMigDeviceHandle() (Device, error)
+	GetDisplayActive() (nvml.EnableState, error)
+	GetDisplayMode() (nvml.EnableState, error)
+	GetDramEncryptionMode() (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error)
+	GetDriverModel() (nvml.DriverModel, nvml.DriverModel, error)
+	GetDriverModel_v2() (nvml.DriverModel, nvml.DriverModel, error)
+	GetDynamicPstatesInfo() (nvml.GpuDynamicPstatesInfo, error)
+	GetEccMode() (nvml.EnableState, nvml.EnableState, error)
+	GetEncoderCapacity(encoderQueryType nvml.EncoderType) (int, error)
+	GetEncoderSessions() ([]nvml.EncoderSessionInfo, error)
+	GetEncoderStats() (int, uint32, uint32, error)
+	GetEncoderUtilization() (uint32, uint32, error)
+	GetEnforcedPowerLimit() (uint32, error)
+	GetFBCSessions() ([]nvml.FBCSessionInfo, error)
+	GetFBCStats() (nvml.FBCStats, error)
+	GetFanControlPolicy_v2(fan int) (nvml.FanControlPolicy, error)
+	GetFanSpeed() (uint32, error)
+	GetFanSpeedRPM() (nvml.FanSpeedInfo, error)
+	GetFanSpeed_v2(fan int) (uint32, error)
+	GetFieldValues(values []nvml.FieldValue) error
+	GetGpcClkMinMaxVfOffset() (int, int, error)
+	GetGpcClkVfOffset() (int, error)
+	GetGpuFabricInfo() (nvml.GpuFabricInfo, error)
+	GetGpuFabricInfoV() nvml.GpuFabricInfoHandler
+	GetGpuInstanceById(id int) (GpuInstance, error)
+	GetGpuInstanceId() (int, error)
+	GetGpuInstancePossiblePlacements(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error)
+	GetGpuInstanceProfileInfo(profile int) (nvml.GpuInstanceProfileInfo, error)
+	GetGpuInstanceProfileInfoByIdV(profileId int) nvml.GpuInstanceProfileInfoByIdHandler
+	GetGpuInstanceProfileInfoV(profile int) nvml.GpuInstanceProfileInfoHandler
+	GetGpuInstanceRemainingCapacity(info *nvml.GpuInstanceProfileInfo) (int, error)
+	GetGpuInstances(info *nvml.GpuInstanceProfileInfo) ([]GpuInstance, error)
+	GetGpuMaxPcieLinkGeneration() (int, error)
+	GetGpuOperationMode() (nvml.GpuOperationMode, nvml.GpuOperationMode, error)
+	GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, error)
+	GetGridLicensableFeatures() (nvml.GridLicensableFeatures, error)
+	GetGspFirmwareMode() (bool, bool, error)
+	GetGspFirmwareVersion() (string, error)
+	GetHostVgpuMode() (nvml.HostVgpuMode, error)
+	GetIndex() (int, error)
+	GetInforomConfigurationChecksum() (uint32, error)
+	GetInforomImageVersion() (string, error)
+	GetInforomVersion(object nvml.InforomObject) (string, error)
+	GetIrqNum() (int, error)
+	GetJpgUtilization() (uint32, uint32, error)
+	GetLastBBXFlushTime() (uint64, uint, error)
+	GetMPSComputeRunningProcesses() ([]nvml.ProcessInfo, error)
+	GetMarginTemperature() (nvml.MarginTemperature, error)
+	GetMaxClockInfo(clockType nvml.ClockType) (uint32, error)
+	GetMaxCustomerBoostClock(clockType nvml.ClockType) (uint32, error)
+	GetMaxMigDeviceCount() (int, error)
+	GetMaxPcieLinkGeneration() (int, error)
+	GetMaxPcieLinkWidth() (int, error)
+	GetMemClkMinMaxVfOffset() (int, int, error)
+	GetMemClkVfOffset() (int, error)
+	GetMemoryAffinity(numNodes int, scope nvml.AffinityScope) ([]uint, error)
+	GetMemoryBusWidth() (uint32, error)
+	GetMemoryErrorCounter(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error)
+	GetMemoryInfo() (nvml.Memory, error)
+	GetMemoryInfo_v2() (nvml.Memory_v2, error)
+	GetMigDeviceHandleByIndex(index int) (Device, error)
+	GetMigMode() (int, int, error)
+	GetMinMaxClockOfPState(clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error)
+	GetMinMaxFanSpeed() (int, int, error)
+	GetMinorNumber() (int, error)
+	GetModuleId() (int, error)
+	GetMultiGpuBoard() (int, error)
+	GetName() (string, error)
+	GetNumFans() (int, error)
+	GetNumGpuCores() (int, error)
+	GetNumaNodeId() (int, error)
+	GetNvLinkCapability(link int, capability nvml.NvLinkCapability) (uint32, error)
+	GetNvLinkErrorCounter(link int, counter nvml.NvLinkErrorCounter) (uint64, error)
+	GetNvLinkInfo() nvml.NvLinkInfoHandler
+	GetNvLinkRemoteDeviceType(link int) (nvml.IntNvLinkDeviceType, error)
+	GetNvLinkRemotePciInfo(link int) (nvml.PciInfo, error)
+	GetNvLinkState(link int) (nvml.EnableState, error)
+	GetNvLinkUtilizationControl(link int, counter int) (nvml.NvLinkUtilizationControl, error)
+	GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, error)
+	GetNvLinkVersion(link int) (uint32, error)
+	GetNvlinkBwMode() (nvml.NvlinkGetBwMode, error)
+	GetNvlinkSupportedBwModes() (nvml.NvlinkSupportedBwModes, error)
+	GetOfaUtilization() (uint32, uint32, error)
+	GetP2PStatus(device2 Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error)
+	GetPciInfo() (nvml.PciInfo, error)
+	GetPciInfoExt() (nvml.PciInfoExt, error)
+	GetPcieLinkMaxSpeed() (uint32, error)
+	GetPcieReplayCounter() (int, error)
+	GetPcieSpeed() (int, error)
+	GetPcieThroughput(counter nvml.PcieUtilCounter) (uint32, error)
+	GetPdi() (nvml.Pdi, error)
+	GetPerformanceModes() (nvml.DevicePerfModes, error)
+	GetPerformanceState() (nvml.Pstates, error)
+	GetPersistenceMode() (nvml.EnableState, error)
+	GetPgpuMetadataString() (string, error)
+	GetPlatformInfo() (nvml.PlatformInfo, error)
+	GetPowerManagementDefaultLimit() (uint32, error)
+	GetPowerManagementLimit() (uint32, error)
+	GetPowerManagementLimitConstraints() (uint32, uint32, error)
+	GetPowerManagementMode() (nvml.EnableState, error)
+	GetPowerMizerMode_v1() (nvml.DevicePowerMizerModes_v1, error)
+	GetPowerSource() (nvml.PowerSource, error)
+	GetPowerState() (nvml.Pstates, error)
+	GetPowerUsage() (uint32, error)
+	GetProcessUtilization(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error)
+	GetProcessesUtilizationInfo() (nvml.ProcessesUtilizationInfo, error)
+	GetRemappedRows() (int, int, bool, bool, error)
+	GetRepairStatus() (nvml.RepairStatus, error)
+	GetRetiredPages(cause nvml.PageRetirementCause) ([]uint64, error)
+	GetRetiredPagesPendingStatus() (nvml.EnableState, error)
+	GetRetiredPages_v2(cause nvml.PageRetirementCause) ([]uint64, []uint64, error)
+	GetRowRemapperHistogram() (nvml.RowRemapperHistogramValues, error)
+	GetRunningProcessDetailList() (nvml.ProcessDetailList, error)
+	GetSamples(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error)
+	GetSerial() (string, error)
+	GetSramEccErrorStatus() (nvml.EccSramErrorStatus, error)
+	GetSramUniqueUncorrectedEccErrorCounts(errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error
+	GetSupportedClocksEventReasons() (uint64, error)
+	GetSupportedClocksThrottleReasons() (uint64, error)
+	GetSupportedEventTypes() (uint64, error)
+	GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, error)
+	GetSupportedMemoryClocks() (int, uint32, error)
+	GetSupportedPerformanceStates() ([]nvml.Pstates, error)
+	GetSupportedVgpus() ([]VgpuTypeId, error)
+	GetTargetFanSpeed(fan int) (int, error)
+	GetTemperature(sensorType nvml.TemperatureSensors) (uint32, error)
+	GetTemperatureThreshold(thresholdType nvml.TemperatureThresholds) (uint32, error)
+	GetTemperatureV() nvml.TemperatureHandler
+	GetThermalSettings(sensorIndex uint32) (nvml.GpuThermalSettings, error)
+	GetTopologyCommonAncestor(device2 Device) (nvml.GpuTopologyLevel, error)
+	GetTopologyNearestGpus(level nvml.GpuTopologyLevel) ([]Device, error)
+	GetTotalEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error)
+	GetTotalEnergyConsumption() (uint64, error)
+	GetUUID() (string, error)
+	GetUtilizationRates() (nvml.Utilization, error)
+	GetVbiosVersion() (string, error)
+	GetVgpuCapabilities(capability nvml.DeviceVgpuCapability) (bool, error)
+	GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error)
+	GetVgpuInstancesUtilizationInfo() (nvml.VgpuInstancesUtilizationInfo, error)
+	GetVgpuMetadata() (nvml.VgpuPgpuMetadata, error)
+	GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error)
+	GetVgpuProcessesUtilizationInfo() (nvml.VgpuProcessesUtilizationInfo, error)
+	GetVgpuSchedulerCapabilities() (nvml.VgpuSchedulerCapabilities, error)
+	GetVgpuSchedulerLog() (nvml.VgpuSchedulerLog, error)
+	GetVgpuSchedulerState() (nvml.VgpuSchedulerGetState, error)
+	GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error)
+	GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error)
+	GetVgpuUtilization(lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error)
+	GetViolationStatus(perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error)
+	GetVirtualizationMode() (nvml.GpuVirtualizationMode, error)
+	GpmMigSampleGet(gpuInstanceId int, gpmSample GpmSample) error
+	GpmQueryDeviceSupport() (nvml.GpmSupport, error)
+	GpmQueryDeviceSupportV() nvml.GpmSupportV
+	GpmQueryIfStreamingEnabled() (uint32, error)
+	GpmSampleGet(gpmSample GpmSample) error
+	GpmSetStreamingEnabled(state uint32) error
+	IsMigDeviceHandle() (bool, error)
+	OnSameBoard(device2 Device) (int, error)
+	PowerSmoothingActivatePresetProfile(profile *nvml.PowerSmoothingProfile) error
+	PowerSmoothingSetState(state *nvml.PowerSmoothingState) error
+	PowerSmoothingUpdatePresetProfileParam(profile *nvml.PowerSmoothingProfile) error
+	ReadWritePRM_v1(buffer *nvml.PRMTLV_v1) error
+	RegisterEvents(eventTypes uint64, set EventSet) error
+	ResetApplicationsClocks() error
+	ResetGpuLockedClocks() error
+	ResetMemoryLockedClocks() error
+	ResetNvLinkErrorCounters(link int) error
+	ResetNvLinkUtilizationCounter(link int, counter int) error
+	SetAPIRestriction(apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error
+	SetAccountingMode(mode nvml.EnableState) error
+	SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) error
+	SetAutoBoostedClocksEnabled(enabled nvml.EnableState) error
+	SetClockOffsets(info nvml.ClockOffset) error
+	SetComputeMode(mode nvml.ComputeMode) error
+	SetConfComputeUnprotectedMemSize(sizeKiB uint64) error
+	SetCpuAffinity() error
+	SetDefaultAutoBoostedClocksEnabled(enabled nvml.EnableState, flags uint32) error
+	SetDefaultFanSpeed_v2(fan int) error
+	SetDramEncryptionMode(dramEncryption *nvml.DramEncryptionInfo) error
+	SetDriverModel(driverModel nvml.DriverModel, flags uint32) error
+	SetEccMode(ecc nvml.EnableState) error
+	SetFanControlPolicy(fan int, policy nvml.FanControlPolicy) error
+	SetFanSpeed_v2(fan int, speed int) error
+	SetGpcClkVfOffset(offset int) error
+	SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) error
+	SetGpuOperationMode(mode nvml.GpuOperationMode) error
+	SetMemClkVfOffset(offset int) error
+	SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) error
+	SetMigMode(mode int) (nvml.Return, error)
+	SetNvLinkDeviceLowPowerThreshold(info *nvml.NvLinkPowerThres) error
+	SetNvLinkUtilizationControl(link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error
+	SetNvlinkBwMode(setBwMode *nvml.NvlinkSetBwMode) error
+	SetPersistenceMode(mode nvml.EnableState) error
+	SetPowerManagementLimit(limit uint32) error
+	SetPowerManagementLimit_v2(powerValue *nvml.PowerValue_v2) error
+	SetPowerMizerMode_v1(powerMizerMode *nvml.DevicePowerMizerModes_v1) error
+	SetTemperatureThreshold(thresholdType nvml.TemperatureThresholds, temp int) error
+	SetVgpuCapabilities(capability nvml.DeviceVgpuCapability, state nvml.EnableState) error
+	SetVgpuHeterogeneousMode(heterogeneousMode nvml.VgpuHeterogeneousMode) error
+	SetVgpuSchedulerState(pSchedulerState *nvml.VgpuSchedulerSetState) error
+	SetVirtualizationMode(virtualMode nvml.GpuVirtualizationMode) error
+	ValidateInforom() error
+	VgpuTypeGetMaxInstances(vgpuTypeId VgpuTypeId) (int, error)
+	WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error
+	WorkloadPowerProfileGetCurrentProfiles() (nvml.WorkloadPowerProfileCurrentProfiles, error)
+	WorkloadPowerProfileGetProfilesInfo() (nvml.WorkloadPowerProfileProfilesInfo, error)
+	WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error
+}
+
+type device struct {
+	handle nvml.Device
+}
+
+var _ Device = (*device)(nil)
+
+func (w *device) Unwrap() nvml.Device {
+	return w.handle
+}
+
+func (w *device) ClearAccountingPids() error {
+	ret := w.handle.ClearAccountingPids()
+	return newError("nvmlDeviceClearAccountingPids", w.handle, ret)
+}
+
+func (w *device) ClearCpuAffinity() error {
+	ret := w.handle.ClearCpuAffinity()
+	return newError("nvmlDeviceClearCpuAffinity", w.handle, ret)
+}
+
+func (w *device) ClearEccErrorCounts(counterType nvml.EccCounterType) error {
+	ret := w.handle.ClearEccErrorCounts(counterType)
+	return newError("nvmlDeviceClearEccErrorCounts", w.handle, ret)
+}
+
+func (w *device) ClearFieldValues(values []nvml.FieldValue) error {
+	ret := w.handle.ClearFieldValues(values)
+	return newError("nvmlDeviceClearFieldValues", w.handle, ret)
+}
+
+func (w *device) CreateGpuInstance(info *nvml.GpuInstanceProfileInfo) (GpuInstance, error) {
+	r0, ret := w.handle.CreateGpuInstance(info)
+	return wrapGpuInstance(r0), newError("nvmlDeviceCreateGpuInstance", w.handle, ret)
+}
+
+func (w *device) CreateGpuInstanceWithPlacement(info *nvml.GpuInstanceProfileInfo, placement *nvml.GpuInstancePlacement) (GpuInstance, error) {
+	r0, ret := w.handle.CreateGpuInstanceWithPlacement(info, placement)
+	return wrapGpuInstance(r0), newError("nvmlDeviceCreateGpuInstanceWithPlacement", w.handle, ret)
+}
+
+func (w *device) FreezeNvLinkUtilizationCounter(link int, counter int, freeze nvml.EnableState) error {
+	ret := w.handle.FreezeNvLinkUtilizationCounter(link, counter, freeze)
+	return newError("nvmlDeviceFreezeNvLinkUtilizationCounter", w.handle, ret)
+}
+
+func (w *device) GetAPIRestriction(apiType nvml.RestrictedAPI) (nvml.EnableState, error) {
+	r0, ret := w.handle.GetAPIRestriction(apiType)
+	return r0, newError("nvmlDeviceGetAPIRestriction", w.handle, ret)
+}
+
+func (w *device) GetAccountingBufferSize() (int, error) {
+	r0, ret := w.handle.GetAccountingBufferSize()
+	return r0, newError("nvmlDeviceGetAccountingBufferSize", w.handle, ret)
+}
+
+func (w *device) GetAccountingMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetAccountingMode()
+	return r0, newError("nvmlDeviceGetAccountingMode", w.handle, ret)
+}
+
+func (w *device) GetAccountingPids() ([]int, error) {
+	r0, ret := w.handle.GetAccountingPids()
+	return r0, newError("nvmlDeviceGetAccountingPids", w.handle, ret)
+}
+
+func (w *device) GetAccountingStats(pid uint32) (nvml.AccountingStats, error) {
+	r0, ret := w.handle.GetAccountingStats(pid)
+	return r0, newError("nvmlDeviceGetAccountingStats", w.handle, ret)
+}
+
+func (w *device) GetActiveVgpus() ([]VgpuInstance, error) {
+	r0, ret := w.handle.GetActiveVgpus()
+	return wrapVgpuInstances(r0), newError("nvmlDeviceGetActiveVgpus", w.handle, ret)
+}
+
+func (w *device) GetAdaptiveClockInfoStatus() (uint32, error) {
+	r0, ret := w.handle.GetAdaptiveClockInfoStatus()
+	return r0, newError("nvmlDeviceGetAdaptiveClockInfoStatus", w.handle, ret)
+}
+
+func (w *device) GetAddressingMode() (nvml.DeviceAddressingMode, error) {
+	r0, ret := w.handle.GetAddressingMode()
+	return r0, newError("nvmlDeviceGetAddressingMode", w.handle, ret)
+}
+
+func (w *device) GetApplicationsClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.handle.GetApplicationsClock(clockType)
+	return r0, newError("nvmlDeviceGetApplicationsClock", w.handle, ret)
+}
+
+func (w *device) GetArchitecture() (nvml.DeviceArchitecture, error) {
+	r0, ret := w.handle.GetArchitecture()
+	return r0, newError("nvmlDeviceGetArchitecture", w.handle, ret)
+}
+
+func (w *device) GetAttributes() (nvml.DeviceAttributes, error) {
+	r0, ret := w.handle.GetAttributes()
+	return r0, newError("nvmlDeviceGetAttributes", w.handle, ret)
+}
+
+func (w *device) GetAutoBoostedClocksEnabled() (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := w.handle.GetAutoBoostedClocksEnabled()
+	return r0, r1, newError("nvmlDeviceGetAutoBoostedClocksEnabled", w.handle, ret)
+}
+
+func (w *device) GetBAR1MemoryInfo() (nvml.BAR1Memory, error) {
+	r0, ret := w.handle.GetBAR1MemoryInfo()
+	return r0, newError("nvmlDeviceGetBAR1MemoryInfo", w.handle, ret)
+}
+
+func (w *device) GetBoardId() (uint32, error) {
+	r0, ret := w.handle.GetBoardId()
+	return r0, newError("nvmlDeviceGetBoardId", w.handle, ret)
+}
+
+func (w *device) GetBoardPartNumber() (string, error) {
+	r0, ret := w.handle.GetBoardPartNumber()
+	return r0, newError("nvmlDeviceGetBoardPartNumber", w.handle, ret)
+}
+
+func (w *device) GetBrand() (nvml.BrandType, error) {
+	r0, ret := w.handle.GetBrand()
+	return r0, newError("nvmlDeviceGetBrand", w.handle, ret)
+}
+
+func (w *device) GetBridgeChipInfo() (nvml.BridgeChipHierarchy, error) {
+	r0, ret := w.handle.GetBridgeChipInfo()
+	return r0, newError("nvmlDeviceGetBridgeChipInfo", w.handle, ret)
+}
+
+func (w *device) GetBusType() (nvml.BusType, error) {
+	r0, ret := w.handle.GetBusType()
+	return r0, newError("nvmlDeviceGetBusType", w.handle, ret)
+}
+
+func (w *device) GetC2cModeInfoV() nvml.C2cModeInfoHandler {
+	return w.handle.GetC2cModeInfoV()
+}
+
+func (w *device) GetCapabilities() (nvml.DeviceCapabilities, error) {
+	r0, ret := w.handle.GetCapabilities()
+	return r0, newError("nvmlDeviceGetCapabilities", w.handle, ret)
+}
+
+func (w *device) GetClkMonStatus() (nvml.ClkMonStatus, error) {
+	r0, ret := w.handle.GetClkMonStatus()
+	return r0, newError("nvmlDeviceGetClkMonStatus", w.handle, ret)
+}
+
+func (w *device) GetClock(clockType nvml.ClockType, clockId nvml.ClockId) (uint32, error) {
+	r0, ret := w.handle.GetClock(clockType, clockId)
+	return r0, newError("nvmlDeviceGetClock", w.handle, ret)
+}
+
+func (w *device) GetClockInfo(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.handle.GetClockInfo(clockType)
+	return r0, newError("nvmlDeviceGetClockInfo", w.handle, ret)
+}
+
+func (w *device) GetClockOffsets() (nvml.ClockOffset, error) {
+	r0, ret := w.handle.GetClockOffsets()
+	return r0, newError("nvmlDeviceGetClockOffsets", w.handle, ret)
+}
+
+func (w *device) GetComputeInstanceId() (int, error) {
+	r0, ret := w.handle.GetComputeInstanceId()
+	return r0, newError("nvmlDeviceGetComputeInstanceId", w.handle, ret)
+}
+
+func (w *device) GetComputeMode() (nvml.ComputeMode, error) {
+	r0, ret := w.handle.GetComputeMode()
+	return r0, newError("nvmlDeviceGetComputeMode", w.handle, ret)
+}
+
+func (w *device) GetComputeRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := w.handle.GetComputeRunningProcesses()
+	return r0, newError("nvmlDeviceGetComputeRunningProcesses", w.handle, ret)
+}
+
+func (w *device) GetConfComputeGpuAttestationReport(gpuAtstReport *nvml.ConfComputeGpuAttestationReport) error {
+	ret := w.handle.GetConfComputeGpuAttestationReport(gpuAtstReport)
+	return newError("nvmlDeviceGetConfComputeGpuAttestationReport", w.handle, ret)
+}
+
+func (w *device) GetConfComputeGpuCertificate() (nvml.ConfComputeGpuCertificate, error) {
+	r0, ret := w.handle.GetConfComputeGpuCertificate()
+	return r0, newError("nvmlDeviceGetConfComputeGpuCertificate", w.handle, ret)
+}
+
+func (w *device) GetConfComputeMemSizeInfo() (nvml.ConfComputeMemSizeInfo, error) {
+	r0, ret := w.handle.GetConfComputeMemSizeInfo()
+	return r0, newError("nvmlDeviceGetConfComputeMemSizeInfo", w.handle, ret)
+}
+
+func (w *device) GetConfComputeProtectedMemoryUsage() (nvml.Memory, error) {
+	r0, ret := w.handle.GetConfComputeProtectedMemoryUsage()
+	return r0, newError("nvmlDeviceGetConfComputeProtectedMemoryUsage", w.handle, ret)
+}
+
+func (w *device) GetCoolerInfo() (nvml.CoolerInfo, error) {
+	r0, ret := w.handle.GetCoolerInfo()
+	return r0, newError("nvmlDeviceGetCoolerInfo", w.handle, ret)
+}
+
+func (w *device) GetCpuAffinity(numCPUs int) ([]uint, error) {
+	r0, ret := w.handle.GetCpuAffinity(numCPUs)
+	return r0, newError("nvmlDeviceGetCpuAffinity", w.handle, ret)
+}
+
+func (w *device) GetCpuAffinityWithinScope(numCPUs int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := w.handle.GetCpuAffinityWithinScope(numCPUs, scope)
+	return r0, newError("nvmlDeviceGetCpuAffinityWithinScope", w.handle, ret)
+}
+
+func (w *device) GetCreatableVgpus() ([]VgpuTypeId, error) {
+	r0, ret := w.handle.GetCreatableVgpus()
+	return wrapVgpuTypeIds(r0), newError("nvmlDeviceGetCreatableVgpus", w.handle, ret)
+}
+
+func (w *device) GetCudaComputeCapability() (int, int, error) {
+	r0, r1, ret := w.handle.GetCudaComputeCapability()
+	return r0, r1, newError("nvmlDeviceGetCudaComputeCapability", w.handle, ret)
+}
+
+func (w *device) GetCurrPcieLinkGeneration() (int, error) {
+	r0, ret := w.handle.GetCurrPcieLinkGeneration()
+	return r0, newError("nvmlDeviceGetCurrPcieLinkGeneration", w.handle, ret)
+}
+
+func (w *device) GetCurrPcieLinkWidth() (int, error) {
+	r0, ret := w.handle.GetCurrPcieLinkWidth()
+	return r0, newError("nvmlDeviceGetCurrPcieLinkWidth", w.handle, ret)
+}
+
+func (w *device) GetCurrentClockFreqs() (nvml.DeviceCurrentClockFreqs, error) {
+	r0, ret := w.handle.GetCurrentClockFreqs()
+	return r0, newError("nvmlDeviceGetCurrentClockFreqs", w.handle, ret)
+}
+
+func (w *device) GetCurrentClocksEventReasons() (uint64, error) {
+	r0, ret := w.handle.GetCurrentClocksEventReasons()
+	return r0, newError("nvmlDeviceGetCurrentClocksEventReasons", w.handle, ret)
+}
+
+func (w *device) GetCurrentClocksThrottleReasons() (uint64, error) {
+	r0, ret := w.handle.GetCurrentClocksThrottleReasons()
+	return r0, newError("nvmlDeviceGetCurrentClocksThrottleReasons", w.handle, ret)
+}
+
+func (w *device) GetDecoderUtilization() (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetDecoderUtilization()
+	return r0, r1, newError("nvmlDeviceGetDecoderUtilization", w.handle, ret)
+}
+
+func (w *device) GetDefaultApplicationsClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.handle.GetDefaultApplicationsClock(clockType)
+	return r0, newError("nvmlDeviceGetDefaultApplicationsClock", w.handle, ret)
+}
+
+func (w *device) GetDefaultEccMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetDefaultEccMode()
+	return r0, newError("nvmlDeviceGetDefaultEccMode", w.handle, ret)
+}
+
+func (w *device) GetDetailedEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (nvml.EccErrorCounts, error) {
+	r0, ret := w.handle.GetDetailedEccErrors(errorType, counterType)
+	return r0, newError("nvmlDeviceGetDetailedEccErrors", w.handle, ret)
+}
+
+func (w *device) GetDeviceHandleFromMigDeviceHandle() (Device, error) {
+	r0, ret := w.handle.GetDeviceHandleFromMigDeviceHandle()
+	return wrapDevice(r0), newError("nvmlDeviceGetDeviceHandleFromMigDeviceHandle", w.handle, ret)
+}
+
+func (w *device) GetDisplayActive() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetDisplayActive()
+	return r0, newError("nvmlDeviceGetDisplayActive", w.handle, ret)
+}
+
+func (w *device) GetDisplayMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetDisplayMode()
+	return r0, newError("nvmlDeviceGetDisplayMode", w.handle, ret)
+}
+
+func (w *device) GetDramEncryptionMode() (nvml.DramEncryptionInfo, nvml.DramEncryptionInfo, error) {
+	r0, r1, ret := w.handle.GetDramEncryptionMode()
+	return r0, r1, newError("nvmlDeviceGetDramEncryptionMode", w.handle, ret)
+}
+
+func (w *device) GetDriverModel() (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := w.handle.GetDriverModel()
+	return r0, r1, newError("nvmlDeviceGetDriverModel", w.handle, ret)
+}
+
+func (w *device) GetDriverModel_v2() (nvml.DriverModel, nvml.DriverModel, error) {
+	r0, r1, ret := w.handle.GetDriverModel_v2()
+	return r0, r1, newError("nvmlDeviceGetDriverModel", w.handle, ret)
+}
+
+func (w *device) GetDynamicPstatesInfo() (nvml.GpuDynamicPstatesInfo, error) {
+	r0, ret := w.handle.GetDynamicPstatesInfo()
+	return r0, newError("nvmlDeviceGetDynamicPstatesInfo", w.handle, ret)
+}
+
+func (w *device) GetEccMode() (nvml.EnableState, nvml.EnableState, error) {
+	r0, r1, ret := w.handle.GetEccMode()
+	return r0, r1, newError("nvmlDeviceGetEccMode", w.handle, ret)
+}
+
+func (w *device) GetEncoderCapacity(encoderQueryType nvml.EncoderType) (int, error) {
+	r0, ret := w.handle.GetEncoderCapacity(encoderQueryType)
+	return r0, newError("nvmlDeviceGetEncoderCapacity", w.handle, ret)
+}
+
+func (w *device) GetEncoderSessions() ([]nvml.EncoderSessionInfo, error) {
+	r0, ret := w.handle.GetEncoderSessions()
+	return r0, newError("nvmlDeviceGetEncoderSessions", w.handle, ret)
+}
+
+func (w *device) GetEncoderStats() (int, uint32, uint32, error) {
+	r0, r1, r2, ret := w.handle.GetEncoderStats()
+	return r0, r1, r2, newError("nvmlDeviceGetEncoderStats", w.handle, ret)
+}
+
+func (w *device) GetEncoderUtilization() (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetEncoderUtilization()
+	return r0, r1, newError("nvmlDeviceGetEncoderUtilization", w.handle, ret)
+}
+
+func (w *device) GetEnforcedPowerLimit() (uint32, error) {
+	r0, ret := w.handle.GetEnforcedPowerLimit()
+	return r0, newError("nvmlDeviceGetEnforcedPowerLimit", w.handle, ret)
+}
+
+func (w *device) GetFBCSessions() ([]nvml.FBCSessionInfo, error) {
+	r0, ret := w.handle.GetFBCSessions()
+	return r0, newError("nvmlDeviceGetFBCSessions", w.handle, ret)
+}
+
+func (w *device) GetFBCStats() (nvml.FBCStats, error) {
+	r0, ret := w.handle.GetFBCStats()
+	return r0, newError("nvmlDeviceGetFBCStats", w.handle, ret)
+}
+
+func (w *device) GetFanControlPolicy_v2(fan int) (nvml.FanControlPolicy, error) {
+	r0, ret := w.handle.GetFanControlPolicy_v2(fan)
+	return r0, newError("nvmlDeviceGetFanControlPolicy", w.handle, ret)
+}
+
+func (w *device) GetFanSpeed() (uint32, error) {
+	r0, ret := w.handle.GetFanSpeed()
+	return r0, newError("nvmlDeviceGetFanSpeed", w.handle, ret)
+}
+
+func (w *device) GetFanSpeedRPM() (nvml.FanSpeedInfo, error) {
+	r0, ret := w.handle.GetFanSpeedRPM()
+	return r0, newError("nvmlDeviceGetFanSpeedRPM", w.handle, ret)
+}
+
+func (w *device) GetFanSpeed_v2(fan int) (uint32, error) {
+	r0, ret := w.handle.GetFanSpeed_v2(fan)
+	return r0, newError("nvmlDeviceGetFanSpeed", w.handle, ret)
+}
+
+func (w *device) GetFieldValues(values []nvml.FieldValue) error {
+	ret := w.handle.GetFieldValues(values)
+	return newError("nvmlDeviceGetFieldValues", w.handle, ret)
+}
+
+func (w *device) GetGpcClkMinMaxVfOffset() (int, int, error) {
+	r0, r1, ret := w.handle.GetGpcClkMinMaxVfOffset()
+	return r0, r1, newError("nvmlDeviceGetGpcClkMinMaxVfOffset", w.handle, ret)
+}
+
+func (w *device) GetGpcClkVfOffset() (int, error) {
+	r0, ret := w.handle.GetGpcClkVfOffset()
+	return r0, newError("nvmlDeviceGetGpcClkVfOffset", w.handle, ret)
+}
+
+func (w *device) GetGpuFabricInfo() (nvml.GpuFabricInfo, error) {
+	r0, ret := w.handle.GetGpuFabricInfo()
+	return r0, newError("nvmlDeviceGetGpuFabricInfo", w.handle, ret)
+}
+
+func (w *device) GetGpuFabricInfoV() nvml.GpuFabricInfoHandler {
+	return w.handle.GetGpuFabricInfoV()
+}
+
+func (w *device) GetGpuInstanceById(id int) (GpuInstance, error) {
+	r0, ret := w.handle.GetGpuInstanceById(id)
+	return wrapGpuInstance(r0), newError("nvmlDeviceGetGpuInstanceById", w.handle, ret)
+}
+
+func (w *device) GetGpuInstanceId() (int, error) {
+	r0, ret := w.handle.GetGpuInstanceId()
+	return r0, newError("nvmlDeviceGetGpuInstanceId", w.handle, ret)
+}
+
+func (w *device) GetGpuInstancePossiblePlacements(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstancePlacement, error) {
+	r0, ret := w.handle.GetGpuInstancePossiblePlacements(info)
+	return r0, newError("nvmlDeviceGetGpuInstancePossiblePlacements", w.handle, ret)
+}
+
+func (w *device) GetGpuInstanceProfileInfo(profile int) (nvml.GpuInstanceProfileInfo, error) {
+	r0, ret := w.handle.GetGpuInstanceProfileInfo(profile)
+	return r0, newError("nvmlDeviceGetGpuInstanceProfileInfo", w.handle, ret)
+}
+
+func (w *device) GetGpuInstanceProfileInfoByIdV(profileId int) nvml.GpuInstanceProfileInfoByIdHandler {
+	return w.handle.GetGpuInstanceProfileInfoByIdV(profileId)
+}
+
+func (w *device) GetGpuInstanceProfileInfoV(profile int) nvml.GpuInstanceProfileInfoHandler {
+	return w.handle.GetGpuInstanceProfileInfoV(profile)
+}
+
+func (w *device) GetGpuInstanceRemainingCapacity(info *nvml.GpuInstanceProfileInfo) (int, error) {
+	r0, ret := w.handle.GetGpuInstanceRemainingCapacity(info)
+	return r0, newError("nvmlDeviceGetGpuInstanceRemainingCapacity", w.handle, ret)
+}
+
+func (w *device) GetGpuInstances(info *nvml.GpuInstanceProfileInfo) ([]GpuInstance, error) {
+	r0, ret := w.handle.GetGpuInstances(info)
+	return wrapGpuInstances(r0), newError("nvmlDeviceGetGpuInstances", w.handle, ret)
+}
+
+func (w *device) GetGpuMaxPcieLinkGeneration() (int, error) {
+	r0, ret := w.handle.GetGpuMaxPcieLinkGeneration()
+	return r0, newError("nvmlDeviceGetGpuMaxPcieLinkGeneration", w.handle, ret)
+}
+
+func (w *device) GetGpuOperationMode() (nvml.GpuOperationMode, nvml.GpuOperationMode, error) {
+	r0, r1, ret := w.handle.GetGpuOperationMode()
+	return r0, r1, newError("nvmlDeviceGetGpuOperationMode", w.handle, ret)
+}
+
+func (w *device) GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := w.handle.GetGraphicsRunningProcesses()
+	return r0, newError("nvmlDeviceGetGraphicsRunningProcesses", w.handle, ret)
+}
+
+func (w *device) GetGridLicensableFeatures() (nvml.GridLicensableFeatures, error) {
+	r0, ret := w.handle.GetGridLicensableFeatures()
+	return r0, newError("nvmlDeviceGetGridLicensableFeatures", w.handle, ret)
+}
+
+func (w *device) GetGspFirmwareMode() (bool, bool, error) {
+	r0, r1, ret := w.handle.GetGspFirmwareMode()
+	return r0, r1, newError("nvmlDeviceGetGspFirmwareMode", w.handle, ret)
+}
+
+func (w *device) GetGspFirmwareVersion() (string, error) {
+	r0, ret := w.handle.GetGspFirmwareVersion()
+	return r0, newError("nvmlDeviceGetGspFirmwareVersion", w.handle, ret)
+}
+
+func (w *device) GetHostVgpuMode() (nvml.HostVgpuMode, error) {
+	r0, ret := w.handle.GetHostVgpuMode()
+	return r0, newError("nvmlDeviceGetHostVgpuMode", w.handle, ret)
+}
+
+func (w *device) GetIndex() (int, error) {
+	r0, ret := w.handle.GetIndex()
+	return r0, newError("nvmlDeviceGetIndex", w.handle, ret)
+}
+
+func (w *device) GetInforomConfigurationChecksum() (uint32, error) {
+	r0, ret := w.handle.GetInforomConfigurationChecksum()
+	return r0, newError("nvmlDeviceGetInforomConfigurationChecksum", w.handle, ret)
+}
+
+func (w *device) GetInforomImageVersion() (string, error) {
+	r0, ret := w.handle.GetInforomImageVersion()
+	return r0, newError("nvmlDeviceGetInforomImageVersion", w.handle, ret)
+}
+
+func (w *device) GetInforomVersion(object nvml.InforomObject) (string, error) {
+	r0, ret := w.handle.GetInforomVersion(object)
+	return r0, newError("nvmlDeviceGetInforomVersion", w.handle, ret)
+}
+
+func (w *device) GetIrqNum() (int, error) {
+	r0, ret := w.handle.GetIrqNum()
+	return r0, newError("nvmlDeviceGetIrqNum", w.handle, ret)
+}
+
+func (w *device) GetJpgUtilization() (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetJpgUtilization()
+	return r0, r1, newError("nvmlDeviceGetJpgUtilization", w.handle, ret)
+}
+
+func (w *device) GetLastBBXFlushTime() (uint64, uint, error) {
+	r0, r1, ret := w.handle.GetLastBBXFlushTime()
+	return r0, r1, newError("nvmlDeviceGetLastBBXFlushTime", w.handle, ret)
+}
+
+func (w *device) GetMPSComputeRunningProcesses() ([]nvml.ProcessInfo, error) {
+	r0, ret := w.handle.GetMPSComputeRunningProcesses()
+	return r0, newError("nvmlDeviceGetMPSComputeRunningProcesses", w.handle, ret)
+}
+
+func (w *device) GetMarginTemperature() (nvml.MarginTemperature, error) {
+	r0, ret := w.handle.GetMarginTemperature()
+	return r0, newError("nvmlDeviceGetMarginTemperature", w.handle, ret)
+}
+
+func (w *device) GetMaxClockInfo(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.handle.GetMaxClockInfo(clockType)
+	return r0, newError("nvmlDeviceGetMaxClockInfo", w.handle, ret)
+}
+
+func (w *device) GetMaxCustomerBoostClock(clockType nvml.ClockType) (uint32, error) {
+	r0, ret := w.handle.GetMaxCustomerBoostClock(clockType)
+	return r0, newError("nvmlDeviceGetMaxCustomerBoostClock", w.handle, ret)
+}
+
+func (w *device) GetMaxMigDeviceCount() (int, error) {
+	r0, ret := w.handle.GetMaxMigDeviceCount()
+	return r0, newError("nvmlDeviceGetMaxMigDeviceCount", w.handle, ret)
+}
+
+func (w *device) GetMaxPcieLinkGeneration() (int, error) {
+	r0, ret := w.handle.GetMaxPcieLinkGeneration()
+	return r0, newError("nvmlDeviceGetMaxPcieLinkGeneration", w.handle, ret)
+}
+
+func (w *device) GetMaxPcieLinkWidth() (int, error) {
+	r0, ret := w.handle.GetMaxPcieLinkWidth()
+	return r0, newError("nvmlDeviceGetMaxPcieLinkWidth", w.handle, ret)
+}
+
+func (w *device) GetMemClkMinMaxVfOffset() (int, int, error) {
+	r0, r1, ret := w.handle.GetMemClkMinMaxVfOffset()
+	return r0, r1, newError("nvmlDeviceGetMemClkMinMaxVfOffset", w.handle, ret)
+}
+
+func (w *device) GetMemClkVfOffset() (int, error) {
+	r0, ret := w.handle.GetMemClkVfOffset()
+	return r0, newError("nvmlDeviceGetMemClkVfOffset", w.handle, ret)
+}
+
+func (w *device) GetMemoryAffinity(numNodes int, scope nvml.AffinityScope) ([]uint, error) {
+	r0, ret := w.handle.GetMemoryAffinity(numNodes, scope)
+	return r0, newError("nvmlDeviceGetMemoryAffinity", w.handle, ret)
+}
+
+func (w *device) GetMemoryBusWidth() (uint32, error) {
+	r0, ret := w.handle.GetMemoryBusWidth()
+	return r0, newError("nvmlDeviceGetMemoryBusWidth", w.handle, ret)
+}
+
+func (w *device) GetMemoryErrorCounter(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType, locationType nvml.MemoryLocation) (uint64, error) {
+	r0, ret := w.handle.GetMemoryErrorCounter(errorType, counterType, locationType)
+	return r0, newError("nvmlDeviceGetMemoryErrorCounter", w.handle, ret)
+}
+
+func (w *device) GetMemoryInfo() (nvml.Memory, error) {
+	r0, ret := w.handle.GetMemoryInfo()
+	return r0, newError("nvmlDeviceGetMemoryInfo", w.handle, ret)
+}
+
+func (w *device) GetMemoryInfo_v2() (nvml.Memory_v2, error) {
+	r0, ret := w.handle.GetMemoryInfo_v2()
+	return r0, newError("nvmlDeviceGetMemoryInfo", w.handle, ret)
+}
+
+func (w *device) GetMigDeviceHandleByIndex(index int) (Device, error) {
+	r0, ret := w.handle.GetMigDeviceHandleByIndex(index)
+	return wrapDevice(r0), newError("nvmlDeviceGetMigDeviceHandleByIndex", w.handle, ret)
+}
+
+func (w *device) GetMigMode() (int, int, error) {
+	r0, r1, ret := w.handle.GetMigMode()
+	return r0, r1, newError("nvmlDeviceGetMigMode", w.handle, ret)
+}
+
+func (w *device) GetMinMaxClockOfPState(clockType nvml.ClockType, pstate nvml.Pstates) (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetMinMaxClockOfPState(clockType, pstate)
+	return r0, r1, newError("nvmlDeviceGetMinMaxClockOfPState", w.handle, ret)
+}
+
+func (w *device) GetMinMaxFanSpeed() (int, int, error) {
+	r0, r1, ret := w.handle.GetMinMaxFanSpeed()
+	return r0, r1, newError("nvmlDeviceGetMinMaxFanSpeed", w.handle, ret)
+}
+
+func (w *device) GetMinorNumber() (int, error) {
+	r0, ret := w.handle.GetMinorNumber()
+	return r0, newError("nvmlDeviceGetMinorNumber", w.handle, ret)
+}
+
+func (w *device) GetModuleId() (int, error) {
+	r0, ret := w.handle.GetModuleId()
+	return r0, newError("nvmlDeviceGetModuleId", w.handle, ret)
+}
+
+func (w *device) GetMultiGpuBoard() (int, error) {
+	r0, ret := w.handle.GetMultiGpuBoard()
+	return r0, newError("nvmlDeviceGetMultiGpuBoard", w.handle, ret)
+}
+
+func (w *device) GetName() (string, error) {
+	r0, ret := w.handle.GetName()
+	return r0, newError("nvmlDeviceGetName", w.handle, ret)
+}
+
+func (w *device) GetNumFans() (int, error) {
+	r0, ret := w.handle.GetNumFans()
+	return r0, newError("nvmlDeviceGetNumFans", w.handle, ret)
+}
+
+func (w *device) GetNumGpuCores() (int, error) {
+	r0, ret := w.handle.GetNumGpuCores()
+	return r0, newError("nvmlDeviceGetNumGpuCores", w.handle, ret)
+}
+
+func (w *device) GetNumaNodeId() (int, error) {
+	r0, ret := w.handle.GetNumaNodeId()
+	return r0, newError("nvmlDeviceGetNumaNodeId", w.handle, ret)
+}
+
+func (w *device) GetNvLinkCapability(link int, capability nvml.NvLinkCapability) (uint32, error) {
+	r0, ret := w.handle.GetNvLinkCapability(link, capability)
+	return r0, newError("nvmlDeviceGetNvLinkCapability", w.handle, ret)
+}
+
+func (w *device) GetNvLinkErrorCounter(link int, counter nvml.NvLinkErrorCounter) (uint64, error) {
+	r0, ret := w.handle.GetNvLinkErrorCounter(link, counter)
+	return r0, newError("nvmlDeviceGetNvLinkErrorCounter", w.handle, ret)
+}
+
+func (w *device) GetNvLinkInfo() nvml.NvLinkInfoHandler {
+	return w.handle.GetNvLinkInfo()
+}
+
+func (w *device) GetNvLinkRemoteDeviceType(link int) (nvml.IntNvLinkDeviceType, error) {
+	r0, ret := w.handle.GetNvLinkRemoteDeviceType(link)
+	return r0, newError("nvmlDeviceGetNvLinkRemoteDeviceType", w.handle, ret)
+}
+
+func (w *device) GetNvLinkRemotePciInfo(link int) (nvml.PciInfo, error) {
+	r0, ret := w.handle.GetNvLinkRemotePciInfo(link)
+	return r0, newError("nvmlDeviceGetNvLinkRemotePciInfo", w.handle, ret)
+}
+
+func (w *device) GetNvLinkState(link int) (nvml.EnableState, error) {
+	r0, ret := w.handle.GetNvLinkState(link)
+	return r0, newError("nvmlDeviceGetNvLinkState", w.handle, ret)
+}
+
+func (w *device) GetNvLinkUtilizationControl(link int, counter int) (nvml.NvLinkUtilizationControl, error) {
+	r0, ret := w.handle.GetNvLinkUtilizationControl(link, counter)
+	return r0, newError("nvmlDeviceGetNvLinkUtilizationControl", w.handle, ret)
+}
+
+func (w *device) GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, error) {
+	r0, r1, ret := w.handle.GetNvLinkUtilizationCounter(link, counter)
+	return r0, r1, newError("nvmlDeviceGetNvLinkUtilizationCounter", w.handle, ret)
+}
+
+func (w *device) GetNvLinkVersion(link int) (uint32, error) {
+	r0, ret := w.handle.GetNvLinkVersion(link)
+	return r0, newError("nvmlDeviceGetNvLinkVersion", w.handle, ret)
+}
+
+func (w *device) GetNvlinkBwMode() (nvml.NvlinkGetBwMode, error) {
+	r0, ret := w.handle.GetNvlinkBwMode()
+	return r0, newError("nvmlDeviceGetNvlinkBwMode", w.handle, ret)
+}
+
+func (w *device) GetNvlinkSupportedBwModes() (nvml.NvlinkSupportedBwModes, error) {
+	r0, ret := w.handle.GetNvlinkSupportedBwModes()
+	return r0, newError("nvmlDeviceGetNvlinkSupportedBwModes", w.handle, ret)
+}
+
+func (w *device) GetOfaUtilization() (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetOfaUtilization()
+	return r0, r1, newError("nvmlDeviceGetOfaUtilization", w.handle, ret)
+}
+
+func (w *device) GetP2PStatus(device2 Device, p2pIndex nvml.GpuP2PCapsIndex) (nvml.GpuP2PStatus, error) {
+	r0, ret := w.handle.GetP2PStatus(unwrapDevice(device2), p2pIndex)
+	return r0, newError("nvmlDeviceGetP2PStatus", w.handle, ret)
+}
+
+func (w *device) GetPciInfo() (nvml.PciInfo, error) {
+	r0, ret := w.handle.GetPciInfo()
+	return r0, newError("nvmlDeviceGetPciInfo", w.handle, ret)
+}
+
+func (w *device) GetPciInfoExt() (nvml.PciInfoExt, error) {
+	r0, ret := w.handle.GetPciInfoExt()
+	return r0, newError("nvmlDeviceGetPciInfoExt", w.handle, ret)
+}
+
+func (w *device) GetPcieLinkMaxSpeed() (uint32, error) {
+	r0, ret := w.handle.GetPcieLinkMaxSpeed()
+	return r0, newError("nvmlDeviceGetPcieLinkMaxSpeed", w.handle, ret)
+}
+
+func (w *device) GetPcieReplayCounter() (int, error) {
+	r0, ret := w.handle.GetPcieReplayCounter()
+	return r0, newError("nvmlDeviceGetPcieReplayCounter", w.handle, ret)
+}
+
+func (w *device) GetPcieSpeed() (int, error) {
+	r0, ret := w.handle.GetPcieSpeed()
+	return r0, newError("nvmlDeviceGetPcieSpeed", w.handle, ret)
+}
+
+func (w *device) GetPcieThroughput(counter nvml.PcieUtilCounter) (uint32, error) {
+	r0, ret := w.handle.GetPcieThroughput(counter)
+	return r0, newError("nvmlDeviceGetPcieThroughput", w.handle, ret)
+}
+
+func (w *device) GetPdi() (nvml.Pdi, error) {
+	r0, ret := w.handle.GetPdi()
+	return r0, newError("nvmlDeviceGetPdi", w.handle, ret)
+}
+
+func (w *device) GetPerformanceModes() (nvml.DevicePerfModes, error) {
+	r0, ret := w.handle.GetPerformanceModes()
+	return r0, newError("nvmlDeviceGetPerformanceModes", w.handle, ret)
+}
+
+func (w *device) GetPerformanceState() (nvml.Pstates, error) {
+	r0, ret := w.handle.GetPerformanceState()
+	return r0, newError("nvmlDeviceGetPerformanceState", w.handle, ret)
+}
+
+func (w *device) GetPersistenceMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetPersistenceMode()
+	return r0, newError("nvmlDeviceGetPersistenceMode", w.handle, ret)
+}
+
+func (w *device) GetPgpuMetadataString() (string, error) {
+	r0, ret := w.handle.GetPgpuMetadataString()
+	return r0, newError("nvmlDeviceGetPgpuMetadataString", w.handle, ret)
+}
+
+func (w *device) GetPlatformInfo() (nvml.PlatformInfo, error) {
+	r0, ret := w.handle.GetPlatformInfo()
+	return r0, newError("nvmlDeviceGetPlatformInfo", w.handle, ret)
+}
+
+func (w *device) GetPowerManagementDefaultLimit() (uint32, error) {
+	r0, ret := w.handle.GetPowerManagementDefaultLimit()
+	return r0, newError("nvmlDeviceGetPowerManagementDefaultLimit", w.handle, ret)
+}
+
+func (w *device) GetPowerManagementLimit() (uint32, error) {
+	r0, ret := w.handle.GetPowerManagementLimit()
+	return r0, newError("nvmlDeviceGetPowerManagementLimit", w.handle, ret)
+}
+
+func (w *device) GetPowerManagementLimitConstraints() (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetPowerManagementLimitConstraints()
+	return r0, r1, newError("nvmlDeviceGetPowerManagementLimitConstraints", w.handle, ret)
+}
+
+func (w *device) GetPowerManagementMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetPowerManagementMode()
+	return r0, newError("nvmlDeviceGetPowerManagementMode", w.handle, ret)
+}
+
+func (w *device) GetPowerMizerMode_v1() (nvml.DevicePowerMizerModes_v1, error) {
+	r0, ret := w.handle.GetPowerMizerMode_v1()
+	return r0, newError("nvmlDeviceGetPowerMizerMode", w.handle, ret)
+}
+
+func (w *device) GetPowerSource() (nvml.PowerSource, error) {
+	r0, ret := w.handle.GetPowerSource()
+	return r0, newError("nvmlDeviceGetPowerSource", w.handle, ret)
+}
+
+func (w *device) GetPowerState() (nvml.Pstates, error) {
+	r0, ret := w.handle.GetPowerState()
+	return r0, newError("nvmlDeviceGetPowerState", w.handle, ret)
+}
+
+func (w *device) GetPowerUsage() (uint32, error) {
+	r0, ret := w.handle.GetPowerUsage()
+	return r0, newError("nvmlDeviceGetPowerUsage", w.handle, ret)
+}
+
+func (w *device) GetProcessUtilization(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, error) {
+	r0, ret := w.handle.GetProcessUtilization(lastSeenTimestamp)
+	return r0, newError("nvmlDeviceGetProcessUtilization", w.handle, ret)
+}
+
+func (w *device) GetProcessesUtilizationInfo() (nvml.ProcessesUtilizationInfo, error) {
+	r0, ret := w.handle.GetProcessesUtilizationInfo()
+	return r0, newError("nvmlDeviceGetProcessesUtilizationInfo", w.handle, ret)
+}
+
+func (w *device) GetRemappedRows() (int, int, bool, bool, error) {
+	r0, r1, r2, r3, ret := w.handle.GetRemappedRows()
+	return r0, r1, r2, r3, newError("nvmlDeviceGetRemappedRows", w.handle, ret)
+}
+
+func (w *device) GetRepairStatus() (nvml.RepairStatus, error) {
+	r0, ret := w.handle.GetRepairStatus()
+	return r0, newError("nvmlDeviceGetRepairStatus", w.handle, ret)
+}
+
+func (w *device) GetRetiredPages(cause nvml.PageRetirementCause) ([]uint64, error) {
+	r0, ret := w.handle.GetRetiredPages(cause)
+	return r0, newError("nvmlDeviceGetRetiredPages", w.handle, ret)
+}
+
+func (w *device) GetRetiredPagesPendingStatus() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetRetiredPagesPendingStatus()
+	return r0, newError("nvmlDeviceGetRetiredPagesPendingStatus", w.handle, ret)
+}
+
+func (w *device) GetRetiredPages_v2(cause nvml.PageRetirementCause) ([]uint64, []uint64, error) {
+	r0, r1, ret := w.handle.GetRetiredPages_v2(cause)
+	return r0, r1, newError("nvmlDeviceGetRetiredPages", w.handle, ret)
+}
+
+func (w *device) GetRowRemapperHistogram() (nvml.RowRemapperHistogramValues, error) {
+	r0, ret := w.handle.GetRowRemapperHistogram()
+	return r0, newError("nvmlDeviceGetRowRemapperHistogram", w.handle, ret)
+}
+
+func (w *device) GetRunningProcessDetailList() (nvml.ProcessDetailList, error) {
+	r0, ret := w.handle.GetRunningProcessDetailList()
+	return r0, newError("nvmlDeviceGetRunningProcessDetailList", w.handle, ret)
+}
+
+func (w *device) GetSamples(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, error) {
+	r0, r1, ret := w.handle.GetSamples(samplingType, lastSeenTimestamp)
+	return r0, r1, newError("nvmlDeviceGetSamples", w.handle, ret)
+}
+
+func (w *device) GetSerial() (string, error) {
+	r0, ret := w.handle.GetSerial()
+	return r0, newError("nvmlDeviceGetSerial", w.handle, ret)
+}
+
+func (w *device) GetSramEccErrorStatus() (nvml.EccSramErrorStatus, error) {
+	r0, ret := w.handle.GetSramEccErrorStatus()
+	return r0, newError("nvmlDeviceGetSramEccErrorStatus", w.handle, ret)
+}
+
+func (w *device) GetSramUniqueUncorrectedEccErrorCounts(errorCounts *nvml.EccSramUniqueUncorrectedErrorCounts) error {
+	ret := w.handle.GetSramUniqueUncorrectedEccErrorCounts(errorCounts)
+	return newError("nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts", w.handle, ret)
+}
+
+func (w *device) GetSupportedClocksEventReasons() (uint64, error) {
+	r0, ret := w.handle.GetSupportedClocksEventReasons()
+	return r0, newError("nvmlDeviceGetSupportedClocksEventReasons", w.handle, ret)
+}
+
+func (w *device) GetSupportedClocksThrottleReasons() (uint64, error) {
+	r0, ret := w.handle.GetSupportedClocksThrottleReasons()
+	return r0, newError("nvmlDeviceGetSupportedClocksThrottleReasons", w.handle, ret)
+}
+
+func (w *device) GetSupportedEventTypes() (uint64, error) {
+	r0, ret := w.handle.GetSupportedEventTypes()
+	return r0, newError("nvmlDeviceGetSupportedEventTypes", w.handle, ret)
+}
+
+func (w *device) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, error) {
+	r0, r1, ret := w.handle.GetSupportedGraphicsClocks(memoryClockMHz)
+	return r0, r1, newError("nvmlDeviceGetSupportedGraphicsClocks", w.handle, ret)
+}
+
+func (w *device) GetSupportedMemoryClocks() (int, uint32, error) {
+	r0, r1, ret := w.handle.GetSupportedMemoryClocks()
+	return r0, r1, newError("nvmlDeviceGetSupportedMemoryClocks", w.handle, ret)
+}
+
+func (w *device) GetSupportedPerformanceStates() ([]nvml.Pstates, error) {
+	r0, ret := w.handle.GetSupportedPerformanceStates()
+	return r0, newError("nvmlDeviceGetSupportedPerformanceStates", w.handle, ret)
+}
+
+func (w *device) GetSupportedVgpus() ([]VgpuTypeId, error) {
+	r0, ret := w.handle.GetSupportedVgpus()
+	return wrapVgpuTypeIds(r0), newError("nvmlDeviceGetSupportedVgpus", w.handle, ret)
+}
+
+func (w *device) GetTargetFanSpeed(fan int) (int, error) {
+	r0, ret := w.handle.GetTargetFanSpeed(fan)
+	return r0, newError("nvmlDeviceGetTargetFanSpeed", w.handle, ret)
+}
+
+func (w *device) GetTemperature(sensorType nvml.TemperatureSensors) (uint32, error) {
+	r0, ret := w.handle.GetTemperature(sensorType)
+	return r0, newError("nvmlDeviceGetTemperature", w.handle, ret)
+}
+
+func (w *device) GetTemperatureThreshold(thresholdType nvml.TemperatureThresholds) (uint32, error) {
+	r0, ret := w.handle.GetTemperatureThreshold(thresholdType)
+	return r0, newError("nvmlDeviceGetTemperatureThreshold", w.handle, ret)
+}
+
+func (w *device) GetTemperatureV() nvml.TemperatureHandler {
+	return w.handle.GetTemperatureV()
+}
+
+func (w *device) GetThermalSettings(sensorIndex uint32) (nvml.GpuThermalSettings, error) {
+	r0, ret := w.handle.GetThermalSettings(sensorIndex)
+	return r0, newError("nvmlDeviceGetThermalSettings", w.handle, ret)
+}
+
+func (w *device) GetTopologyCommonAncestor(device2 Device) (nvml.GpuTopologyLevel, error) {
+	r0, ret := w.handle.GetTopologyCommonAncestor(unwrapDevice(device2))
+	return r0, newError("nvmlDeviceGetTopologyCommonAncestor", w.handle, ret)
+}
+
+func (w *device) GetTopologyNearestGpus(level nvml.GpuTopologyLevel) ([]Device, error) {
+	r0, ret := w.handle.GetTopologyNearestGpus(level)
+	return wrapDevices(r0), newError("nvmlDeviceGetTopologyNearestGpus", w.handle, ret)
+}
+
+func (w *device) GetTotalEccErrors(errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, error) {
+	r0, ret := w.handle.GetTotalEccErrors(errorType, counterType)
+	return r0, newError("nvmlDeviceGetTotalEccErrors", w.handle, ret)
+}
+
+func (w *device) GetTotalEnergyConsumption() (uint64, error) {
+	r0, ret := w.handle.GetTotalEnergyConsumption()
+	return r0, newError("nvmlDeviceGetTotalEnergyConsumption", w.handle, ret)
+}
+
+func (w *device) GetUUID() (string, error) {
+	r0, ret := w.handle.GetUUID()
+	return r0, newError("nvmlDeviceGetUUID", w.handle, ret)
+}
+
+func (w *device) GetUtilizationRates() (nvml.Utilization, error) {
+	r0, ret := w.handle.GetUtilizationRates()
+	return r0, newError("nvmlDeviceGetUtilizationRates", w.handle, ret)
+}
+
+func (w *device) GetVbiosVersion() (string, error) {
+	r0, ret := w.handle.GetVbiosVersion()
+	return r0, newError("nvmlDeviceGetVbiosVersion", w.handle, ret)
+}
+
+func (w *device) GetVgpuCapabilities(capability nvml.DeviceVgpuCapability) (bool, error) {
+	r0, ret := w.handle.GetVgpuCapabilities(capability)
+	return r0, newError("nvmlDeviceGetVgpuCapabilities", w.handle, ret)
+}
+
+func (w *device) GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := w.handle.GetVgpuHeterogeneousMode()
+	return r0, newError("nvmlDeviceGetVgpuHeterogeneousMode", w.handle, ret)
+}
+
+func (w *device) GetVgpuInstancesUtilizationInfo() (nvml.VgpuInstancesUtilizationInfo, error) {
+	r0, ret := w.handle.GetVgpuInstancesUtilizationInfo()
+	return r0, newError("nvmlDeviceGetVgpuInstancesUtilizationInfo", w.handle, ret)
+}
+
+func (w *device) GetVgpuMetadata() (nvml.VgpuPgpuMetadata, error) {
+	r0, ret := w.handle.GetVgpuMetadata()
+	return r0, newError("nvmlDeviceGetVgpuMetadata", w.handle, ret)
+}
+
+func (w *device) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]nvml.VgpuProcessUtilizationSample, error) {
+	r0, ret := w.handle.GetVgpuProcessUtilization(lastSeenTimestamp)
+	return r0, newError("nvmlDeviceGetVgpuProcessUtilization", w.handle, ret)
+}
+
+func (w *device) GetVgpuProcessesUtilizationInfo() (nvml.VgpuProcessesUtilizationInfo, error) {
+	r0, ret := w.handle.GetVgpuProcessesUtilizationInfo()
+	return r0, newError("nvmlDeviceGetVgpuProcessesUtilizationInfo", w.handle, ret)
+}
+
+func (w *device) GetVgpuSchedulerCapabilities() (nvml.VgpuSchedulerCapabilities, error) {
+	r0, ret := w.handle.GetVgpuSchedulerCapabilities()
+	return r0, newError("nvmlDeviceGetVgpuSchedulerCapabilities", w.handle, ret)
+}
+
+func (w *device) GetVgpuSchedulerLog() (nvml.VgpuSchedulerLog, error) {
+	r0, ret := w.handle.GetVgpuSchedulerLog()
+	return r0, newError("nvmlDeviceGetVgpuSchedulerLog", w.handle, ret)
+}
+
+func (w *device) GetVgpuSchedulerState() (nvml.VgpuSchedulerGetState, error) {
+	r0, ret := w.handle.GetVgpuSchedulerState()
+	return r0, newError("nvmlDeviceGetVgpuSchedulerState", w.handle, ret)
+}
+
+func (w *device) GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.handle.GetVgpuTypeCreatablePlacements(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlDeviceGetVgpuTypeCreatablePlacements", w.handle, ret)
+}
+
+func (w *device) GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.handle.GetVgpuTypeSupportedPlacements(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlDeviceGetVgpuTypeSupportedPlacements", w.handle, ret)
+}
+
+func (w *device) GetVgpuUtilization(lastSeenTimestamp uint64) (nvml.ValueType, []nvml.VgpuInstanceUtilizationSample, error) {
+	r0, r1, ret := w.handle.GetVgpuUtilization(lastSeenTimestamp)
+	return r0, r1, newError("nvmlDeviceGetVgpuUtilization", w.handle, ret)
+}
+
+func (w *device) GetViolationStatus(perfPolicyType nvml.PerfPolicyType) (nvml.ViolationTime, error) {
+	r0, ret := w.handle.GetViolationStatus(perfPolicyType)
+	return r0, newError("nvmlDeviceGetViolationStatus", w.handle, ret)
+}
+
+func (w *device) GetVirtualizationMode() (nvml.GpuVirtualizationMode, error) {
+	r0, ret := w.handle.GetVirtualizationMode()
+	return r0, newError("nvmlDeviceGetVirtualizationMode", w.handle, ret)
+}
+
+func (w *device) GpmMigSampleGet(gpuInstanceId int, gpmSample GpmSample) error {
+	ret := w.handle.GpmMigSampleGet(gpuInstanceId, unwrapGpmSample(gpmSample))
+	return newError("nvmlGpmMigSampleGet", w.handle, ret)
+}
+
+func (w *device) GpmQueryDeviceSupport() (nvml.GpmSupport, error) {
+	r0, ret := w.handle.GpmQueryDeviceSupport()
+	return r0, newError("nvmlGpmQueryDeviceSupport", w.handle, ret)
+}
+
+func (w *device) GpmQueryDeviceSupportV() nvml.GpmSupportV {
+	return w.handle.GpmQueryDeviceSupportV()
+}
+
+func (w *device) GpmQueryIfStreamingEnabled() (uint32, error) {
+	r0, ret := w.handle.GpmQueryIfStreamingEnabled()
+	return r0, newError("nvmlGpmQueryIfStreamingEnabled", w.handle, ret)
+}
+
+func (w *device) GpmSampleGet(gpmSample GpmSample) error {
+	ret := w.handle.GpmSampleGet(unwrapGpmSample(gpmSample))
+	return newError("nvmlGpmSampleGet", w.handle, ret)
+}
+
+func (w *device) GpmSetStreamingEnabled(state uint32) error {
+	ret := w.handle.GpmSetStreamingEnabled(state)
+	return newError("nvmlGpmSetStreamingEnabled", w.handle, ret)
+}
+
+func (w *device) IsMigDeviceHandle() (bool, error) {
+	r0, ret := w.handle.IsMigDeviceHandle()
+	return r0, newError("nvmlDeviceIsMigDeviceHandle", w.handle, ret)
+}
+
+func (w *device) OnSameBoard(device2 Device) (int, error) {
+	r0, ret := w.handle.OnSameBoard(unwrapDevice(device2))
+	return r0, newError("nvmlDeviceOnSameBoard", w.handle, ret)
+}
+
+func (w *device) PowerSmoothingActivatePresetProfile(profile *nvml.PowerSmoothingProfile) error {
+	ret := w.handle.PowerSmoothingActivatePresetProfile(profile)
+	return newError("nvmlDevicePowerSmoothingActivatePresetProfile", w.handle, ret)
+}
+
+func (w *device) PowerSmoothingSetState(state *nvml.PowerSmoothingState) error {
+	ret := w.handle.PowerSmoothingSetState(state)
+	return newError("nvmlDevicePowerSmoothingSetState", w.handle, ret)
+}
+
+func (w *device) PowerSmoothingUpdatePresetProfileParam(profile *nvml.PowerSmoothingProfile) error {
+	ret := w.handle.PowerSmoothingUpdatePresetProfileParam(profile)
+	return newError("nvmlDevicePowerSmoothingUpdatePresetProfileParam", w.handle, ret)
+}
+
+func (w *device) ReadWritePRM_v1(buffer *nvml.PRMTLV_v1) error {
+	ret := w.handle.ReadWritePRM_v1(buffer)
+	return newError("nvmlDeviceReadWritePRM", w.handle, ret)
+}
+
+func (w *device) RegisterEvents(eventTypes uint64, set EventSet) error {
+	ret := w.handle.RegisterEvents(eventTypes, unwrapEventSet(set))
+	return newError("nvmlDeviceRegisterEvents", w.handle, ret)
+}
+
+func (w *device) ResetApplicationsClocks() error {
+	ret := w.handle.ResetApplicationsClocks()
+	return newError("nvmlDeviceResetApplicationsClocks", w.handle, ret)
+}
+
+func (w *device) ResetGpuLockedClocks() error {
+	ret := w.handle.ResetGpuLockedClocks()
+	return newError("nvmlDeviceResetGpuLockedClocks", w.handle, ret)
+}
+
+func (w *device) ResetMemoryLockedClocks() error {
+	ret := w.handle.ResetMemoryLockedClocks()
+	return newError("nvmlDeviceResetMemoryLockedClocks", w.handle, ret)
+}
+
+func (w *device) ResetNvLinkErrorCounters(link int) error {
+	ret := w.handle.ResetNvLinkErrorCounters(link)
+	return newError("nvmlDeviceResetNvLinkErrorCounters", w.handle, ret)
+}
+
+func (w *device) ResetNvLinkUtilizationCounter(link int, counter int) error {
+	ret := w.handle.ResetNvLinkUtilizationCounter(link, counter)
+	return newError("nvmlDeviceResetNvLinkUtilizationCounter", w.handle, ret)
+}
+
+func (w *device) SetAPIRestriction(apiType nvml.RestrictedAPI, isRestricted nvml.EnableState) error {
+	ret := w.handle.SetAPIRestriction(apiType, isRestricted)
+	return newError("nvmlDeviceSetAPIRestriction", w.handle, ret)
+}
+
+func (w *device) SetAccountingMode(mode nvml.EnableState) error {
+	ret := w.handle.SetAccountingMode(mode)
+	return newError("nvmlDeviceSetAccountingMode", w.handle, ret)
+}
+
+func (w *device) SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) error {
+	ret := w.handle.SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+	return newError("nvmlDeviceSetApplicationsClocks", w.handle, ret)
+}
+
+func (w *device) SetAutoBoostedClocksEnabled(enabled nvml.EnableState) error {
+	ret := w.handle.SetAutoBoostedClocksEnabled(enabled)
+	return newError("nvmlDeviceSetAutoBoostedClocksEnabled", w.handle, ret)
+}
+
+func (w *device) SetClockOffsets(info nvml.ClockOffset) error {
+	ret := w.handle.SetClockOffsets(info)
+	return newError("nvmlDeviceSetClockOffsets", w.handle, ret)
+}
+
+func (w *device) SetComputeMode(mode nvml.ComputeMode) error {
+	ret := w.handle.SetComputeMode(mode)
+	return newError("nvmlDeviceSetComputeMode", w.handle, ret)
+}
+
+func (w *device) SetConfComputeUnprotectedMemSize(sizeKiB uint64) error {
+	ret := w.handle.SetConfComputeUnprotectedMemSize(sizeKiB)
+	return newError("nvmlDeviceSetConfComputeUnprotectedMemSize", w.handle, ret)
+}
+
+func (w *device) SetCpuAffinity() error {
+	ret := w.handle.SetCpuAffinity()
+	return newError("nvmlDeviceSetCpuAffinity", w.handle, ret)
+}
+
+func (w *device) SetDefaultAutoBoostedClocksEnabled(enabled nvml.EnableState, flags uint32) error {
+	ret := w.handle.SetDefaultAutoBoostedClocksEnabled(enabled, flags)
+	return newError("nvmlDeviceSetDefaultAutoBoostedClocksEnabled", w.handle, ret)
+}
+
+func (w *device) SetDefaultFanSpeed_v2(fan int) error {
+	ret := w.handle.SetDefaultFanSpeed_v2(fan)
+	return newError("nvmlDeviceSetDefaultFanSpeed", w.handle, ret)
+}
+
+func (w *device) SetDramEncryptionMode(dramEncryption *nvml.DramEncryptionInfo) error {
+	ret := w.handle.SetDramEncryptionMode(dramEncryption)
+	return newError("nvmlDeviceSetDramEncryptionMode", w.handle, ret)
+}
+
+func (w *device) SetDriverModel(driverModel nvml.DriverModel, flags uint32) error {
+	ret := w.handle.SetDriverModel(driverModel, flags)
+	return newError("nvmlDeviceSetDriverModel", w.handle, ret)
+}
+
+func (w *device) SetEccMode(ecc nvml.EnableState) error {
+	ret := w.handle.SetEccMode(ecc)
+	return newError("nvmlDeviceSetEccMode", w.handle, ret)
+}
+
+func (w *device) SetFanControlPolicy(fan int, policy nvml.FanControlPolicy) error {
+	ret := w.handle.SetFanControlPolicy(fan, policy)
+	return newError("nvmlDeviceSetFanControlPolicy", w.handle, ret)
+}
+
+func (w *device) SetFanSpeed_v2(fan int, speed int) error {
+	ret := w.handle.SetFanSpeed_v2(fan, speed)
+	return newError("nvmlDeviceSetFanSpeed", w.handle, ret)
+}
+
+func (w *device) SetGpcClkVfOffset(offset int) error {
+	ret := w.handle.SetGpcClkVfOffset(offset)
+	return newError("nvmlDeviceSetGpcClkVfOffset", w.handle, ret)
+}
+
+func (w *device) SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) error {
+	ret := w.handle.SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
+	return newError("nvmlDeviceSetGpuLockedClocks", w.handle, ret)
+}
+
+func (w *device) SetGpuOperationMode(mode nvml.GpuOperationMode) error {
+	ret := w.handle.SetGpuOperationMode(mode)
+	return newError("nvmlDeviceSetGpuOperationMode", w.handle, ret)
+}
+
+func (w *device) SetMemClkVfOffset(offset int) error {
+	ret := w.handle.SetMemClkVfOffset(offset)
+	return newError("nvmlDeviceSetMemClkVfOffset", w.handle, ret)
+}
+
+func (w *device) SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) error {
+	ret := w.handle.SetMemoryLockedClocks(minMemClockMHz, maxMemClockMHz)
+	return newError("nvmlDeviceSetMemoryLockedClocks", w.handle, ret)
+}
+
+func (w *device) SetMigMode(mode int) (nvml.Return, error) {
+	r0, ret := w.handle.SetMigMode(mode)
+	return r0, newError("nvmlDeviceSetMigMode", w.handle, ret)
+}
+
+func (w *device) SetNvLinkDeviceLowPowerThreshold(info *nvml.NvLinkPowerThres) error {
+	ret := w.handle.SetNvLinkDeviceLowPowerThreshold(info)
+	return newError("nvmlDeviceSetNvLinkDeviceLowPowerThreshold", w.handle, ret)
+}
+
+func (w *device) SetNvLinkUtilizationControl(link int, counter int, control *nvml.NvLinkUtilizationControl, reset bool) error {
+	ret := w.handle.SetNvLinkUtilizationControl(link, counter, control, reset)
+	return newError("nvmlDeviceSetNvLinkUtilizationControl", w.handle, ret)
+}
+
+func (w *device) SetNvlinkBwMode(setBwMode *nvml.NvlinkSetBwMode) error {
+	ret := w.handle.SetNvlinkBwMode(setBwMode)
+	return newError("nvmlDeviceSetNvlinkBwMode", w.handle, ret)
+}
+
+func (w *device) SetPersistenceMode(mode nvml.EnableState) error {
+	ret := w.handle.SetPersistenceMode(mode)
+	return newError("nvmlDeviceSetPersistenceMode", w.handle, ret)
+}
+
+func (w *device) SetPowerManagementLimit(limit uint32) error {
+	ret := w.handle.SetPowerManagementLimit(limit)
+	return newError("nvmlDeviceSetPowerManagementLimit", w.handle, ret)
+}
+
+func (w *device) SetPowerManagementLimit_v2(powerValue *nvml.PowerValue_v2) error {
+	ret := w.handle.SetPowerManagementLimit_v2(powerValue)
+	return newError("nvmlDeviceSetPowerManagementLimit", w.handle, ret)
+}
+
+func (w *device) SetPowerMizerMode_v1(powerMizerMode *nvml.DevicePowerMizerModes_v1) error {
+	ret := w.handle.SetPowerMizerMode_v1(powerMizerMode)
+	return newError("nvmlDeviceSetPowerMizerMode", w.handle, ret)
+}
+
+func (w *device) SetTemperatureThreshold(thresholdType nvml.TemperatureThresholds, temp int) error {
+	ret := w.handle.SetTemperatureThreshold(thresholdType, temp)
+	return newError("nvmlDeviceSetTemperatureThreshold", w.handle, ret)
+}
+
+func (w *device) SetVgpuCapabilities(capability nvml.DeviceVgpuCapability, state nvml.EnableState) error {
+	ret := w.handle.SetVgpuCapabilities(capability, state)
+	return newError("nvmlDeviceSetVgpuCapabilities", w.handle, ret)
+}
+
+func (w *device) SetVgpuHeterogeneousMode(heterogeneousMode nvml.VgpuHeterogeneousMode) error {
+	ret := w.handle.SetVgpuHeterogeneousMode(heterogeneousMode)
+	return newError("nvmlDeviceSetVgpuHeterogeneousMode", w.handle, ret)
+}
+
+func (w *device) SetVgpuSchedulerState(pSchedulerState *nvml.VgpuSchedulerSetState) error {
+	ret := w.handle.SetVgpuSchedulerState(pSchedulerState)
+	return newError("nvmlDeviceSetVgpuSchedulerState", w.handle, ret)
+}
+
+func (w *device) SetVirtualizationMode(virtualMode nvml.GpuVirtualizationMode) error {
+	ret := w.handle.SetVirtualizationMode(virtualMode)
+	return newError("nvmlDeviceSetVirtualizationMode", w.handle, ret)
+}
+
+func (w *device) ValidateInforom() error {
+	ret := w.handle.ValidateInforom()
+	return newError("nvmlDeviceValidateInforom", w.handle, ret)
+}
+
+func (w *device) VgpuTypeGetMaxInstances(vgpuTypeId VgpuTypeId) (int, error) {
+	r0, ret := w.handle.VgpuTypeGetMaxInstances(unwrapVgpuTypeId(vgpuTypeId))
+	return r0, newError("nvmlVgpuTypeGetMaxInstances", w.handle, ret)
+}
+
+func (w *device) WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := w.handle.WorkloadPowerProfileClearRequestedProfiles(requestedProfiles)
+	return newError("nvmlDeviceWorkloadPowerProfileClearRequestedProfiles", w.handle, ret)
+}
+
+func (w *device) WorkloadPowerProfileGetCurrentProfiles() (nvml.WorkloadPowerProfileCurrentProfiles, error) {
+	r0, ret := w.handle.WorkloadPowerProfileGetCurrentProfiles()
+	return r0, newError("nvmlDeviceWorkloadPowerProfileGetCurrentProfiles", w.handle, ret)
+}
+
+func (w *device) WorkloadPowerProfileGetProfilesInfo() (nvml.WorkloadPowerProfileProfilesInfo, error) {
+	r0, ret := w.handle.WorkloadPowerProfileGetProfilesInfo()
+	return r0, newError("nvmlDeviceWorkloadPowerProfileGetProfilesInfo", w.handle, ret)
+}
+
+func (w *device) WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *nvml.WorkloadPowerProfileRequestedProfiles) error {
+	ret := w.handle.WorkloadPowerProfileSetRequestedProfiles(requestedProfiles)
+	return newError("nvmlDeviceWorkloadPowerProfileSetRequestedProfiles", w.handle, ret)
+}
+
+// GpuInstance represents the interface for the nvml.GpuInstance type.
+// Methods that return an nvml.Return return an error instead.
+type GpuInstance interface {
+	Unwrap() nvml.GpuInstance
+	CreateComputeInstance(info *nvml.ComputeInstanceProfileInfo) (ComputeInstance, error)
+	CreateComputeInstanceWithPlacement(info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (ComputeInstance, error)
+	Destroy() error
+	GetActiveVgpus() (nvml.ActiveVgpuInstanceInfo, error)
+	GetComputeInstanceById(id int) (ComputeInstance, error)
+	GetComputeInstancePossiblePlacements(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error)
+	GetComputeInstanceProfileInfo(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error)
+	GetComputeInstanceProfileInfoV(profile int, engProfile int) nvml.ComputeInstanceProfileInfoHandler
+	GetComputeInstanceRemainingCapacity(info *nvml.ComputeInstanceProfileInfo) (int, error)
+	GetComputeInstances(info *nvml.ComputeInstanceProfileInfo) ([]ComputeInstance, error)
+	GetCreatableVgpus() (nvml.VgpuTypeIdInfo, error)
+	GetInfo() (nvml.GpuInstanceInfo, error)
+	GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error)
+	GetVgpuSchedulerLog() (nvml.VgpuSchedulerLogInfo, error)
+	GetVgpuSchedulerState() (nvml.VgpuSchedulerStateInfo, error)
+	GetVgpuTypeCreatablePlacements() (nvml.VgpuCreatablePlacementInfo, error)
+	SetVgpuHeterogeneousMode(heterogeneousMode *nvml.VgpuHeterogeneousMode) error
+	SetVgpuSchedulerState(scheduler *nvml.VgpuSchedulerState) error
+}
+
+type gpuInstance struct {
+	handle nvml.GpuInstance
+}
+
+var _ GpuInstance = (*gpuInstance)(nil)
+
+func (w *gpuInstance) Unwrap() nvml.GpuInstance {
+	return w.handle
+}
+
+func (w *gpuInstance) CreateComputeInstance(info *nvml.ComputeInstanceProfileInfo) (ComputeInstance, error) {
+	r0, ret := w.handle.CreateComputeInstance(info)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceCreateComputeInstance", nil, ret)
+}
+
+func (w *gpuInstance) CreateComputeInstanceWithPlacement(info *nvml.ComputeInstanceProfileInfo, placement *nvml.ComputeInstancePlacement) (ComputeInstance, error) {
+	r0, ret := w.handle.CreateComputeInstanceWithPlacement(info, placement)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceCreateComputeInstanceWithPlacement", nil, ret)
+}
+
+func (w *gpuInstance) Destroy() error {
+	ret := w.handle.Destroy()
+	return newError("nvmlGpuInstanceDestroy", nil, ret)
+}
+
+func (w *gpuInstance) GetActiveVgpus() (nvml.ActiveVgpuInstanceInfo, error) {
+	r0, ret := w.handle.GetActiveVgpus()
+	return r0, newError("nvmlGpuInstanceGetActiveVgpus", nil, ret)
+}
+
+func (w *gpuInstance) GetComputeInstanceById(id int) (ComputeInstance, error) {
+	r0, ret := w.handle.GetComputeInstanceById(id)
+	return wrapComputeInstance(r0), newError("nvmlGpuInstanceGetComputeInstanceById", nil, ret)
+}
+
+func (w *gpuInstance) GetComputeInstancePossiblePlacements(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstancePlacement, error) {
+	r0, ret := w.handle.GetComputeInstancePossiblePlacements(info)
+	return r0, newError("nvmlGpuInstanceGetComputeInstancePossiblePlacements", nil, ret)
+}
+
+func (w *gpuInstance) GetComputeInstanceProfileInfo(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, error) {
+	r0, ret := w.handle.GetComputeInstanceProfileInfo(profile, engProfile)
+	return r0, newError("nvmlGpuInstanceGetComputeInstanceProfileInfo", nil, ret)
+}
+
+func (w *gpuInstance) GetComputeInstanceProfileInfoV(profile int, engProfile int) nvml.ComputeInstanceProfileInfoHandler {
+	return w.handle.GetComputeInstanceProfileInfoV(profile, engProfile)
+}
+
+func (w *gpuInstance) GetComputeInstanceRemainingCapacity(info *nvml.ComputeInstanceProfileInfo) (int, error) {
+	r0, ret := w.handle.GetComputeInstanceRemainingCapacity(info)
+	return r0, newError("nvmlGpuInstanceGetComputeInstanceRemainingCapacity", nil, ret)
+}
+
+func (w *gpuInstance) GetComputeInstances(info *nvml.ComputeInstanceProfileInfo) ([]ComputeInstance, error) {
+	r0, ret := w.handle.GetComputeInstances(info)
+	return wrapComputeInstances(r0), newError("nvmlGpuInstanceGetComputeInstances", nil, ret)
+}
+
+func (w *gpuInstance) GetCreatableVgpus() (nvml.VgpuTypeIdInfo, error) {
+	r0, ret := w.handle.GetCreatableVgpus()
+	return r0, newError("nvmlGpuInstanceGetCreatableVgpus", nil, ret)
+}
+
+func (w *gpuInstance) GetInfo() (nvml.GpuInstanceInfo, error) {
+	r0, ret := w.handle.GetInfo()
+	return r0, newError("nvmlGpuInstanceGetInfo", nil, ret)
+}
+
+func (w *gpuInstance) GetVgpuHeterogeneousMode() (nvml.VgpuHeterogeneousMode, error) {
+	r0, ret := w.handle.GetVgpuHeterogeneousMode()
+	return r0, newError("nvmlGpuInstanceGetVgpuHeterogeneousMode", nil, ret)
+}
+
+func (w *gpuInstance) GetVgpuSchedulerLog() (nvml.VgpuSchedulerLogInfo, error) {
+	r0, ret := w.handle.GetVgpuSchedulerLog()
+	return r0, newError("nvmlGpuInstanceGetVgpuSchedulerLog", nil, ret)
+}
+
+func (w *gpuInstance) GetVgpuSchedulerState() (nvml.VgpuSchedulerStateInfo, error) {
+	r0, ret := w.handle.GetVgpuSchedulerState()
+	return r0, newError("nvmlGpuInstanceGetVgpuSchedulerState", nil, ret)
+}
+
+func (w *gpuInstance) GetVgpuTypeCreatablePlacements() (nvml.VgpuCreatablePlacementInfo, error) {
+	r0, ret := w.handle.GetVgpuTypeCreatablePlacements()
+	return r0, newError("nvmlGpuInstanceGetVgpuTypeCreatablePlacements", nil, ret)
+}
+
+func (w *gpuInstance) SetVgpuHeterogeneousMode(heterogeneousMode *nvml.VgpuHeterogeneousMode) error {
+	ret := w.handle.SetVgpuHeterogeneousMode(heterogeneousMode)
+	return newError("nvmlGpuInstanceSetVgpuHeterogeneousMode", nil, ret)
+}
+
+func (w *gpuInstance) SetVgpuSchedulerState(scheduler *nvml.VgpuSchedulerState) error {
+	ret := w.handle.SetVgpuSchedulerState(scheduler)
+	return newError("nvmlGpuInstanceSetVgpuSchedulerState", nil, ret)
+}
+
+// ComputeInstance represents the interface for the nvml.ComputeInstance type.
+// Methods that return an nvml.Return return an error instead.
+type ComputeInstance interface {
+	Unwrap() nvml.ComputeInstance
+	Destroy() error
+	GetInfo() (nvml.ComputeInstanceInfo, error)
+}
+
+type computeInstance struct {
+	handle nvml.ComputeInstance
+}
+
+var _ ComputeInstance = (*computeInstance)(nil)
+
+func (w *computeInstance) Unwrap() nvml.ComputeInstance {
+	return w.handle
+}
+
+func (w *computeInstance) Destroy() error {
+	ret := w.handle.Destroy()
+	return newError("nvmlComputeInstanceDestroy", nil, ret)
+}
+
+func (w *computeInstance) GetInfo() (nvml.ComputeInstanceInfo, error) {
+	r0, ret := w.handle.GetInfo()
+	return r0, newError("nvmlComputeInstanceGetInfo", nil, ret)
+}
+
+// EventSet represents the interface for the nvml.EventSet type.
+// Methods that return an nvml.Return return an error instead.
+type EventSet interface {
+	Unwrap() nvml.EventSet
+	Free() error
+	Wait(timeoutms uint32) (nvml.EventData, error)
+}
+
+type eventSet struct {
+	handle nvml.EventSet
+}
+
+var _ EventSet = (*eventSet)(nil)
+
+func (w *eventSet) Unwrap() nvml.EventSet {
+	return w.handle
+}
+
+func (w *eventSet) Free() error {
+	ret := w.handle.Free()
+	return newError("nvmlEventSetFree", nil, ret)
+}
+
+func (w *eventSet) Wait(timeoutms uint32) (nvml.EventData, error) {
+	r0, ret := w.handle.Wait(timeoutms)
+	return r0, newError("nvmlEventSetWait", nil, ret)
+}
+
+// GpmSample represents the interface for the nvml.GpmSample type.
+// Methods that return an nvml.Return return an error instead.
+type GpmSample interface {
+	Unwrap() nvml.GpmSample
+	Free() error
+	Get(device Device) error
+	MigGet(device Device, gpuInstanceId int) error
+}
+
+type gpmSample struct {
+	handle nvml.GpmSample
+}
+
+var _ GpmSample = (*gpmSample)(nil)
+
+func (w *gpmSample) Unwrap() nvml.GpmSample {
+	return w.handle
+}
+
+func (w *gpmSample) Free() error {
+	ret := w.handle.Free()
+	return newError("nvmlGpmSampleFree", nil, ret)
+}
+
+func (w *gpmSample) Get(device Device) error {
+	ret := w.handle.Get(unwrapDevice(device))
+	return newError("nvmlGpmSampleGet", unwrapDevice(device), ret)
+}
+
+func (w *gpmSample) MigGet(device Device, gpuInstanceId int) error {
+	ret := w.handle.MigGet(unwrapDevice(device), gpuInstanceId)
+	return newError("nvmlGpmMigSampleGet", unwrapDevice(device), ret)
+}
+
+// Unit represents the interface for the nvml.Unit type.
+// Methods that return an nvml.Return return an error instead.
+type Unit interface {
+	Unwrap() nvml.Unit
+	GetDevices() ([]Device, error)
+	GetFanSpeedInfo() (nvml.UnitFanSpeeds, error)
+	GetLedState() (nvml.LedState, error)
+	GetPsuInfo() (nvml.PSUInfo, error)
+	GetTemperature(ttype int) (uint32, error)
+	GetUnitInfo() (nvml.UnitInfo, error)
+	SetLedState(color nvml.LedColor) error
+}
+
+type unit struct {
+	handle nvml.Unit
+}
+
+var _ Unit = (*unit)(nil)
+
+func (w *unit) Unwrap() nvml.Unit {
+	return w.handle
+}
+
+func (w *unit) GetDevices() ([]Device, error) {
+	r0, ret := w.handle.GetDevices()
+	return wrapDevices(r0), newError("nvmlUnitGetDevices", nil, ret)
+}
+
+func (w *unit) GetFanSpeedInfo() (nvml.UnitFanSpeeds, error) {
+	r0, ret := w.handle.GetFanSpeedInfo()
+	return r0, newError("nvmlUnitGetFanSpeedInfo", nil, ret)
+}
+
+func (w *unit) GetLedState() (nvml.LedState, error) {
+	r0, ret := w.handle.GetLedState()
+	return r0, newError("nvmlUnitGetLedState", nil, ret)
+}
+
+func (w *unit) GetPsuInfo() (nvml.PSUInfo, error) {
+	r0, ret := w.handle.GetPsuInfo()
+	return r0, newError("nvmlUnitGetPsuInfo", nil, ret)
+}
+
+func (w *unit) GetTemperature(ttype int) (uint32, error) {
+	r0, ret := w.handle.GetTemperature(ttype)
+	return r0, newError("nvmlUnitGetTemperature", nil, ret)
+}
+
+func (w *unit) GetUnitInfo() (nvml.UnitInfo, error) {
+	r0, ret := w.handle.GetUnitInfo()
+	return r0, newError("nvmlUnitGetUnitInfo", nil, ret)
+}
+
+func (w *unit) SetLedState(color nvml.LedColor) error {
+	ret := w.handle.SetLedState(color)
+	return newError("nvmlUnitSetLedState", nil, ret)
+}
+
+// VgpuInstance represents the interface for the nvml.VgpuInstance type.
+// Methods that return an nvml.Return return an error instead.
+type VgpuInstance interface {
+	Unwrap() nvml.VgpuInstance
+	ClearAccountingPids() error
+	GetAccountingMode() (nvml.EnableState, error)
+	GetAccountingPids() ([]int, error)
+	GetAccountingStats(pid int) (nvml.AccountingStats, error)
+	GetEccMode() (nvml.EnableState, error)
+	GetEncoderCapacity() (int, error)
+	GetEncoderSessions() (int, nvml.EncoderSessionInfo, error)
+	GetEncoderStats() (int, uint32, uint32, error)
+	GetFBCSessions() (int, nvml.FBCSessionInfo, error)
+	GetFBCStats() (nvml.FBCStats, error)
+	GetFbUsage() (uint64, error)
+	GetFrameRateLimit() (uint32, error)
+	GetGpuInstanceId() (int, error)
+	GetGpuPciId() (string, error)
+	GetLicenseInfo() (nvml.VgpuLicenseInfo, error)
+	GetLicenseStatus() (int, error)
+	GetMdevUUID() (string, error)
+	GetMetadata() (nvml.VgpuMetadata, error)
+	GetPlacementId() (nvml.VgpuPlacementId, error)
+	GetRuntimeStateSize() (nvml.VgpuRuntimeState, error)
+	GetType() (VgpuTypeId, error)
+	GetUUID() (string, error)
+	GetVmDriverVersion() (string, error)
+	GetVmID() (string, nvml.VgpuVmIdType, error)
+	SetEncoderCapacity(encoderCapacity int) error
+}
+
+type vgpuInstance struct {
+	handle nvml.VgpuInstance
+}
+
+var _ VgpuInstance = (*vgpuInstance)(nil)
+
+func (w *vgpuInstance) Unwrap() nvml.VgpuInstance {
+	return w.handle
+}
+
+func (w *vgpuInstance) ClearAccountingPids() error {
+	ret := w.handle.ClearAccountingPids()
+	return newError("nvmlVgpuInstanceClearAccountingPids", nil, ret)
+}
+
+func (w *vgpuInstance) GetAccountingMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetAccountingMode()
+	return r0, newError("nvmlVgpuInstanceGetAccountingMode", nil, ret)
+}
+
+func (w *vgpuInstance) GetAccountingPids() ([]int, error) {
+	r0, ret := w.handle.GetAccountingPids()
+	return r0, newError("nvmlVgpuInstanceGetAccountingPids", nil, ret)
+}
+
+func (w *vgpuInstance) GetAccountingStats(pid int) (nvml.AccountingStats, error) {
+	r0, ret := w.handle.GetAccountingStats(pid)
+	return r0, newError("nvmlVgpuInstanceGetAccountingStats", nil, ret)
+}
+
+func (w *vgpuInstance) GetEccMode() (nvml.EnableState, error) {
+	r0, ret := w.handle.GetEccMode()
+	return r0, newError("nvmlVgpuInstanceGetEccMode", nil, ret)
+}
+
+func (w *vgpuInstance) GetEncoderCapacity() (int, error) {
+	r0, ret := w.handle.GetEncoderCapacity()
+	return r0, newError("nvmlVgpuInstanceGetEncoderCapacity", nil, ret)
+}
+
+func (w *vgpuInstance) GetEncoderSessions() (int, nvml.EncoderSessionInfo, error) {
+	r0, r1, ret := w.handle.GetEncoderSessions()
+	return r0, r1, newError("nvmlVgpuInstanceGetEncoderSessions", nil, ret)
+}
+
+func (w *vgpuInstance) GetEncoderStats() (int, uint32, uint32, error) {
+	r0, r1, r2, ret := w.handle.GetEncoderStats()
+	return r0, r1, r2, newError("nvmlVgpuInstanceGetEncoderStats", nil, ret)
+}
+
+func (w *vgpuInstance) GetFBCSessions() (int, nvml.FBCSessionInfo, error) {
+	r0, r1, ret := w.handle.GetFBCSessions()
+	return r0, r1, newError("nvmlVgpuInstanceGetFBCSessions", nil, ret)
+}
+
+func (w *vgpuInstance) GetFBCStats() (nvml.FBCStats, error) {
+	r0, ret := w.handle.GetFBCStats()
+	return r0, newError("nvmlVgpuInstanceGetFBCStats", nil, ret)
+}
+
+func (w *vgpuInstance) GetFbUsage() (uint64, error) {
+	r0, ret := w.handle.GetFbUsage()
+	return r0, newError("nvmlVgpuInstanceGetFbUsage", nil, ret)
+}
+
+func (w *vgpuInstance) GetFrameRateLimit() (uint32, error) {
+	r0, ret := w.handle.GetFrameRateLimit()
+	return r0, newError("nvmlVgpuInstanceGetFrameRateLimit", nil, ret)
+}
+
+func (w *vgpuInstance) GetGpuInstanceId() (int, error) {
+	r0, ret := w.handle.GetGpuInstanceId()
+	return r0, newError("nvmlVgpuInstanceGetGpuInstanceId", nil, ret)
+}
+
+func (w *vgpuInstance) GetGpuPciId() (string, error) {
+	r0, ret := w.handle.GetGpuPciId()
+	return r0, newError("nvmlVgpuInstanceGetGpuPciId", nil, ret)
+}
+
+func (w *vgpuInstance) GetLicenseInfo() (nvml.VgpuLicenseInfo, error) {
+	r0, ret := w.handle.GetLicenseInfo()
+	return r0, newError("nvmlVgpuInstanceGetLicenseInfo", nil, ret)
+}
+
+func (w *vgpuInstance) GetLicenseStatus() (int, error) {
+	r0, ret := w.handle.GetLicenseStatus()
+	return r0, newError("nvmlVgpuInstanceGetLicenseStatus", nil, ret)
+}
+
+func (w *vgpuInstance) GetMdevUUID() (string, error) {
+	r0, ret := w.handle.GetMdevUUID()
+	return r0, newError("nvmlVgpuInstanceGetMdevUUID", nil, ret)
+}
+
+func (w *vgpuInstance) GetMetadata() (nvml.VgpuMetadata, error) {
+	r0, ret := w.handle.GetMetadata()
+	return r0, newError("nvmlVgpuInstanceGetMetadata", nil, ret)
+}
+
+func (w *vgpuInstance) GetPlacementId() (nvml.VgpuPlacementId, error) {
+	r0, ret := w.handle.GetPlacementId()
+	return r0, newError("nvmlVgpuInstanceGetPlacementId", nil, ret)
+}
+
+func (w *vgpuInstance) GetRuntimeStateSize() (nvml.VgpuRuntimeState, error) {
+	r0, ret := w.handle.GetRuntimeStateSize()
+	return r0, newError("nvmlVgpuInstanceGetRuntimeStateSize", nil, ret)
+}
+
+func (w *vgpuInstance) GetType() (VgpuTypeId, error) {
+	r0, ret := w.handle.GetType()
+	return wrapVgpuTypeId(r0), newError("nvmlVgpuInstanceGetType", nil, ret)
+}
+
+func (w *vgpuInstance) GetUUID() (string, error) {
+	r0, ret := w.handle.GetUUID()
+	return r0, newError("nvmlVgpuInstanceGetUUID", nil, ret)
+}
+
+func (w *vgpuInstance) GetVmDriverVersion() (string, error) {
+	r0, ret := w.handle.GetVmDriverVersion()
+	return r0, newError("nvmlVgpuInstanceGetVmDriverVersion", nil, ret)
+}
+
+func (w *vgpuInstance) GetVmID() (string, nvml.VgpuVmIdType, error) {
+	r0, r1, ret := w.handle.GetVmID()
+	return r0, r1, newError("nvmlVgpuInstanceGetVmID", nil, ret)
+}
+
+func (w *vgpuInstance) SetEncoderCapacity(encoderCapacity int) error {
+	ret := w.handle.SetEncoderCapacity(encoderCapacity)
+	return newError("nvmlVgpuInstanceSetEncoderCapacity", nil, ret)
+}
+
+// VgpuTypeId represents the interface for the nvml.VgpuTypeId type.
+// Methods that return an nvml.Return return an error instead.
+type VgpuTypeId interface {
+	Unwrap() nvml.VgpuTypeId
+	GetBAR1Info() (nvml.VgpuTypeBar1Info, error)
+	GetCapabilities(capability nvml.VgpuCapability) (bool, error)
+	GetClass() (string, error)
+	GetCreatablePlacements(device Device) (nvml.VgpuPlacementList, error)
+	GetDeviceID() (uint64, uint64, error)
+	GetFbReservation() (uint64, error)
+	GetFrameRateLimit() (uint32, error)
+	GetFramebufferSize() (uint64, error)
+	GetGpuInstanceProfileId() (uint32, error)
+	GetGspHeapSize() (uint64, error)
+	GetLicense() (string, error)
+	GetMaxInstances(device Device) (int, error)
+	GetMaxInstancesPerVm() (int, error)
+	GetName() (string, error)
+	GetNumDisplayHeads() (int, error)
+	GetResolution(displayIndex int) (uint32, uint32, error)
+	GetSupportedPlacements(device Device) (nvml.VgpuPlacementList, error)
+}
+
+type vgpuTypeId struct {
+	handle nvml.VgpuTypeId
+}
+
+var _ VgpuTypeId = (*vgpuTypeId)(nil)
+
+func (w *vgpuTypeId) Unwrap() nvml.VgpuTypeId {
+	return w.handle
+}
+
+func (w *vgpuTypeId) GetBAR1Info() (nvml.VgpuTypeBar1Info, error) {
+	r0, ret := w.handle.GetBAR1Info()
+	return r0, newError("nvmlVgpuTypeGetBAR1Info", nil, ret)
+}
+
+func (w *vgpuTypeId) GetCapabilities(capability nvml.VgpuCapability) (bool, error) {
+	r0, ret := w.handle.GetCapabilities(capability)
+	return r0, newError("nvmlVgpuTypeGetCapabilities", nil, ret)
+}
+
+func (w *vgpuTypeId) GetClass() (string, error) {
+	r0, ret := w.handle.GetClass()
+	return r0, newError("nvmlVgpuTypeGetClass", nil, ret)
+}
+
+func (w *vgpuTypeId) GetCreatablePlacements(device Device) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.handle.GetCreatablePlacements(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuTypeCreatablePlacements", unwrapDevice(device), ret)
+}
+
+func (w *vgpuTypeId) GetDeviceID() (uint64, uint64, error) {
+	r0, r1, ret := w.handle.GetDeviceID()
+	return r0, r1, newError("nvmlVgpuTypeGetDeviceID", nil, ret)
+}
+
+func (w *vgpuTypeId) GetFbReservation() (uint64, error) {
+	r0, ret := w.handle.GetFbReservation()
+	return r0, newError("nvmlVgpuTypeGetFbReservation", nil, ret)
+}
+
+func (w *vgpuTypeId) GetFrameRateLimit() (uint32, error) {
+	r0, ret := w.handle.GetFrameRateLimit()
+	return r0, newError("nvmlVgpuTypeGetFrameRateLimit", nil, ret)
+}
+
+func (w *vgpuTypeId) GetFramebufferSize() (uint64, error) {
+	r0, ret := w.handle.GetFramebufferSize()
+	return r0, newError("nvmlVgpuTypeGetFramebufferSize", nil, ret)
+}
+
+func (w *vgpuTypeId) GetGpuInstanceProfileId() (uint32, error) {
+	r0, ret := w.handle.GetGpuInstanceProfileId()
+	return r0, newError("nvmlVgpuTypeGetGpuInstanceProfileId", nil, ret)
+}
+
+func (w *vgpuTypeId) GetGspHeapSize() (uint64, error) {
+	r0, ret := w.handle.GetGspHeapSize()
+	return r0, newError("nvmlVgpuTypeGetGspHeapSize", nil, ret)
+}
+
+func (w *vgpuTypeId) GetLicense() (string, error) {
+	r0, ret := w.handle.GetLicense()
+	return r0, newError("nvmlVgpuTypeGetLicense", nil, ret)
+}
+
+func (w *vgpuTypeId) GetMaxInstances(device Device) (int, error) {
+	r0, ret := w.handle.GetMaxInstances(unwrapDevice(device))
+	return r0, newError("nvmlVgpuTypeGetMaxInstances", unwrapDevice(device), ret)
+}
+
+func (w *vgpuTypeId) GetMaxInstancesPerVm() (int, error) {
+	r0, ret := w.handle.GetMaxInstancesPerVm()
+	return r0, newError("nvmlVgpuTypeGetMaxInstancesPerVm", nil, ret)
+}
+
+func (w *vgpuTypeId) GetName() (string, error) {
+	r0, ret := w.handle.GetName()
+	return r0, newError("nvmlVgpuTypeGetName", nil, ret)
+}
+
+func (w *vgpuTypeId) GetNumDisplayHeads() (int, error) {
+	r0, ret := w.handle.GetNumDisplayHeads()
+	return r0, newError("nvmlVgpuTypeGetNumDisplayHeads", nil, ret)
+}
+
+func (w *vgpuTypeId) GetResolution(displayIndex int) (uint32, uint32, error) {
+	r0, r1, ret := w.handle.GetResolution(displayIndex)
+	return r0, r1, newError("nvmlVgpuTypeGetResolution", nil, ret)
+}
+
+func (w *vgpuTypeId) GetSupportedPlacements(device Device) (nvml.VgpuPlacementList, error) {
+	r0, ret := w.handle.GetSupportedPlacements(unwrapDevice(device))
+	return r0, newError("nvmlDeviceGetVgpuTypeSupportedPlacements", unwrapDevice(device), ret)
+}
+
+func unwrapComputeInstance(h ComputeInstance) nvml.ComputeInstance {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapDevice(h Device) nvml.Device {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapEventSet(h EventSet) nvml.EventSet {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapGpmSample(h GpmSample) nvml.GpmSample {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapGpuInstance(h GpuInstance) nvml.GpuInstance {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapUnit(h Unit) nvml.Unit {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapVgpuInstance(h VgpuInstance) nvml.VgpuInstance {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func unwrapVgpuTypeId(h VgpuTypeId) nvml.VgpuTypeId {
+	if h == nil {
+		return nil
+	}
+	return h.Unwrap()
+}
+
+func wrapComputeInstance(h nvml.ComputeInstance) ComputeInstance {
+	if h == nil {
+		return nil
+	}
+	return &computeInstance{handle: h}
+}
+
+func wrapComputeInstances(hs []nvml.ComputeInstance) []ComputeInstance {
+	if hs == nil {
+		return nil
+	}
+	out := make([]ComputeInstance, len(hs))
+	for i, h := range hs {
+		out[i] = wrapComputeInstance(h)
+	}
+	return out
+}
+
+func wrapDevice(h nvml.Device) Device {
+	if h == nil {
+		return nil
+	}
+	return &device{handle: h}
+}
+
+func wrapDevices(hs []nvml.Device) []Device {
+	if hs == nil {
+		return nil
+	}
+	out := make([]Device, len(hs))
+	for i, h := range hs {
+		out[i] = wrapDevice(h)
+	}
+	return out
+}
+
+func wrapEventSet(h nvml.EventSet) EventSet {
+	if h == nil {
+		return nil
+	}
+	return &eventSet{handle: h}
+}
+
+func wrapGpmSample(h nvml.GpmSample) GpmSample {
+	if h == nil {
+		return nil
+	}
+	return &gpmSample{handle: h}
+}
+
+func wrapGpuInstance(h nvml.GpuInstance) GpuInstance {
+	if h == nil {
+		return nil
+	}
+	return &gpuInstance{handle: h}
+}
+
+func wrapGpuInstances(hs []nvml.GpuInstance) []GpuInstance {
+	if hs == nil {
+		return nil
+	}
+	out := make([]GpuInstance, len(hs))
+	for i, h := range hs {
+		out[i] = wrapGpuInstance(h)
+	}
+	return out
+}
+
+func wrapUnit(h nvml.Unit) Unit {
+	if h == nil {
+		return nil
+	}
+	return &unit{handle: h}
+}
+
+func wrapVgpuInstance(h nvml.VgpuInstance) VgpuInstance {
+	if h == nil {
+		return nil
+	}
+	return &vgpuInstance{handle: h}
+}
+
+func wrapVgpuInstances(hs []nvml.VgpuInstance) []VgpuInstance {
+	if hs == nil {
+		return nil
+	}
+	out := make([]VgpuInstance, len(hs))
+	for i, h := range hs {
+		out[i] = wrapVgpuInstance(h)
+	}
+	return out
+}
+
+func wrapVgpuTypeId(h nvml.VgpuTypeId) VgpuTypeId {
+	if h == nil {
+		return nil
+	}
+	return &vgpuTypeId{handle: h}
+}
+
+func wrapVgpuTypeIds(hs []nvml.VgpuTypeId) []VgpuTypeId {
+	if hs == nil {
+		return nil
+	}
+	out := make([]VgpuTypeId, len(hs))
+	for i, h := range hs {
+		out[i] = wrapVgpuTypeId(h)
+	}
+	return out
+}
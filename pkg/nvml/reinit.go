@@ -0,0 +1,238 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ReinitEvent describes an automatic reinitialization of the library.
+type ReinitEvent struct {
+	// Trigger is the value returned by the call that caused the library to
+	// be reinitialized.
+	Trigger Return
+	// Return is the result of reinitializing the library.
+	Return Return
+}
+
+// supervisor wraps a library and reinitializes it if a call returns a value
+// indicating that the library must be reinitialized. The methods that call
+// the library are generated in zz_generated.reinit.go.
+type supervisor struct {
+	sync.Mutex
+	lib      *library
+	callback func(ReinitEvent)
+	// inits holds the function used for each call to Init or InitWithFlags
+	// that has not been balanced by a call to Shutdown. These are repeated
+	// when the library is reinitialized.
+	inits []func() Return
+	// generation is incremented each time the library is reinitialized. This
+	// is used to determine whether a supervisedDevice must be resolved again.
+	generation atomic.Uint64
+}
+
+func newSupervisor(l *library, callback func(ReinitEvent)) *supervisor {
+	return &supervisor{
+		lib:      l,
+		callback: callback,
+	}
+}
+
+// nvml.Init()
+func (s *supervisor) Init() Return {
+	return s.init(s.lib.Init)
+}
+
+// nvml.InitWithFlags()
+func (s *supervisor) InitWithFlags(flags uint32) Return {
+	return s.init(func() Return {
+		return s.lib.InitWithFlags(flags)
+	})
+}
+
+func (s *supervisor) init(init func() Return) Return {
+	s.Lock()
+	defer s.Unlock()
+	ret := init()
+	if ret == SUCCESS {
+		s.inits = append(s.inits, init)
+	}
+	return ret
+}
+
+// nvml.Shutdown()
+func (s *supervisor) Shutdown() Return {
+	s.Lock()
+	defer s.Unlock()
+	ret := s.lib.Shutdown()
+	if ret == SUCCESS && len(s.inits) > 0 {
+		s.inits = s.inits[:len(s.inits)-1]
+	}
+	return ret
+}
+
+// retry checks whether a call that returned ret should be retried. If the
+// library must be reinitialized, this is done before the call is retried.
+// A call is only retried once.
+func (s *supervisor) retry(ret Return, generation uint64, attempt int) bool {
+	if attempt > 0 {
+		return false
+	}
+	if ret != ERROR_LIB_RM_VERSION_MISMATCH && ret != ERROR_UNINITIALIZED {
+		return false
+	}
+	return s.reinit(ret, generation) == SUCCESS
+}
+
+// reinit reinitializes the library and calls the callback if this was done.
+func (s *supervisor) reinit(trigger Return, generation uint64) Return {
+	ret, reinitialized := s.reload(trigger, generation)
+	// The callback is called without holding the lock so that it can call
+	// into the library.
+	if reinitialized && s.callback != nil {
+		s.callback(ReinitEvent{Trigger: trigger, Return: ret})
+	}
+	return ret
+}
+
+// reload closes the library and initializes it again using the same calls
+// that were used to initialize it. If the library was already reinitialized
+// since the specified generation, SUCCESS is returned without reinitializing
+// it again. If the library is not initialized, the trigger is returned.
+func (s *supervisor) reload(trigger Return, generation uint64) (Return, bool) {
+	s.Lock()
+	defer s.Unlock()
+	if s.generation.Load() != generation {
+		return SUCCESS, false
+	}
+	if len(s.inits) == 0 {
+		return trigger, false
+	}
+
+	// The library may not be usable, so the underlying library is closed
+	// even if nvmlShutdown fails.
+	for range s.inits {
+		_ = nvmlShutdown()
+		if err := s.lib.close(); err != nil {
+			return ERROR_UNKNOWN, false
+		}
+	}
+
+	// The reinitialized library may be a different driver that supports
+	// different struct versions, so these are negotiated again.
+	s.lib.negotiatedVersions.clear()
+
+	ret := SUCCESS
+	for _, init := range s.inits {
+		if r := init(); r != SUCCESS && ret == SUCCESS {
+			ret = r
+		}
+	}
+	s.generation.Add(1)
+
+	return ret, true
+}
+
+// supervisedDevice is a Device returned by a supervisor. If the library is
+// reinitialized, the underlying device handle is resolved again using the
+// UUID of the device. The methods that call the device are generated in
+// zz_generated.reinit.go.
+type supervisedDevice struct {
+	sync.Mutex
+	s          *supervisor
+	device     Device
+	generation uint64
+	// uuid is the UUID of the device. This is looked up when the device is
+	// first used instead of when it is returned, and again on each use until
+	// the lookup succeeds.
+	uuid string
+}
+
+// handle returns the device handle for the current generation of the
+// library. If the device cannot be resolved, the existing handle is returned.
+func (d *supervisedDevice) handle() Device {
+	d.Lock()
+	defer d.Unlock()
+	if d.uuid == "" {
+		if uuid, ret := d.device.GetUUID(); ret == SUCCESS {
+			d.uuid = uuid
+		}
+	}
+	generation := d.s.generation.Load()
+	if d.generation == generation || d.uuid == "" {
+		return d.device
+	}
+	device, ret := d.s.lib.DeviceGetHandleByUUID(d.uuid)
+	if ret == SUCCESS {
+		d.device = device
+		d.generation = generation
+	}
+	return d.device
+}
+
+// Unwrap returns the device handle for the current generation of the
+// library. This allows a supervisedDevice to be passed to the methods of
+// other handles, such as GpmSample.Get, that take a Device.
+func (d *supervisedDevice) Unwrap() Device {
+	return d.handle()
+}
+
+// wrapDevice wraps a device that was returned by a call made for the
+// specified generation of the library.
+func (s *supervisor) wrapDevice(device Device, ret Return, generation uint64) Device {
+	if ret != SUCCESS || device == nil {
+		return device
+	}
+	if _, ok := device.(*supervisedDevice); ok {
+		return device
+	}
+	return &supervisedDevice{
+		s:          s,
+		device:     device,
+		generation: generation,
+	}
+}
+
+func (s *supervisor) wrapDevices(devices []Device, ret Return, generation uint64) []Device {
+	if ret != SUCCESS {
+		return devices
+	}
+	for i, device := range devices {
+		devices[i] = s.wrapDevice(device, ret, generation)
+	}
+	return devices
+}
+
+// unwrapDevice returns the device handle to pass to the library.
+func (s *supervisor) unwrapDevice(device Device) Device {
+	if d, ok := device.(*supervisedDevice); ok {
+		return d.handle()
+	}
+	return device
+}
+
+func (s *supervisor) unwrapDevices(devices []Device) []Device {
+	if devices == nil {
+		return nil
+	}
+	unwrapped := make([]Device, len(devices))
+	for i, device := range devices {
+		unwrapped[i] = s.unwrapDevice(device)
+	}
+	return unwrapped
+}
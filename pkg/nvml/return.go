@@ -16,6 +16,7 @@ package nvml
 
 import (
 	"fmt"
+	"sort"
 )
 
 // nvml.ErrorString()
@@ -40,64 +41,96 @@ var errorStringFunc = defaultErrorStringFunc
 // This allows the nvml.ErrorString function to be used even if the NVML library
 // is not loaded.
 var defaultErrorStringFunc = func(r Return) string {
-	switch r {
-	case SUCCESS:
-		return "SUCCESS"
-	case ERROR_UNINITIALIZED:
-		return "ERROR_UNINITIALIZED"
-	case ERROR_INVALID_ARGUMENT:
-		return "ERROR_INVALID_ARGUMENT"
-	case ERROR_NOT_SUPPORTED:
-		return "ERROR_NOT_SUPPORTED"
-	case ERROR_NO_PERMISSION:
-		return "ERROR_NO_PERMISSION"
-	case ERROR_ALREADY_INITIALIZED:
-		return "ERROR_ALREADY_INITIALIZED"
-	case ERROR_NOT_FOUND:
-		return "ERROR_NOT_FOUND"
-	case ERROR_INSUFFICIENT_SIZE:
-		return "ERROR_INSUFFICIENT_SIZE"
-	case ERROR_INSUFFICIENT_POWER:
-		return "ERROR_INSUFFICIENT_POWER"
-	case ERROR_DRIVER_NOT_LOADED:
-		return "ERROR_DRIVER_NOT_LOADED"
-	case ERROR_TIMEOUT:
-		return "ERROR_TIMEOUT"
-	case ERROR_IRQ_ISSUE:
-		return "ERROR_IRQ_ISSUE"
-	case ERROR_LIBRARY_NOT_FOUND:
-		return "ERROR_LIBRARY_NOT_FOUND"
-	case ERROR_FUNCTION_NOT_FOUND:
-		return "ERROR_FUNCTION_NOT_FOUND"
-	case ERROR_CORRUPTED_INFOROM:
-		return "ERROR_CORRUPTED_INFOROM"
-	case ERROR_GPU_IS_LOST:
-		return "ERROR_GPU_IS_LOST"
-	case ERROR_RESET_REQUIRED:
-		return "ERROR_RESET_REQUIRED"
-	case ERROR_OPERATING_SYSTEM:
-		return "ERROR_OPERATING_SYSTEM"
-	case ERROR_LIB_RM_VERSION_MISMATCH:
-		return "ERROR_LIB_RM_VERSION_MISMATCH"
-	case ERROR_IN_USE:
-		return "ERROR_IN_USE"
-	case ERROR_MEMORY:
-		return "ERROR_MEMORY"
-	case ERROR_NO_DATA:
-		return "ERROR_NO_DATA"
-	case ERROR_VGPU_ECC_NOT_SUPPORTED:
-		return "ERROR_VGPU_ECC_NOT_SUPPORTED"
-	case ERROR_INSUFFICIENT_RESOURCES:
-		return "ERROR_INSUFFICIENT_RESOURCES"
-	case ERROR_FREQ_NOT_SUPPORTED:
-		return "ERROR_FREQ_NOT_SUPPORTED"
-	case ERROR_ARGUMENT_VERSION_MISMATCH:
-		return "ERROR_ARGUMENT_VERSION_MISMATCH"
-	case ERROR_DEPRECATED:
-		return "ERROR_DEPRECATED"
-	case ERROR_UNKNOWN:
-		return "ERROR_UNKNOWN"
+	if info, ok := returnInfos[r]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("unknown return value: %d", r)
+}
+
+// libraryErrorStringFunc calls nvml.ErrorString. If the library is closed
+// concurrently, the call returns an empty string and defaultErrorStringFunc is
+// used instead.
+var libraryErrorStringFunc = func(r Return) string {
+	if s := nvmlErrorString(r); s != "" {
+		return s
+	}
+	return defaultErrorStringFunc(r)
+}
+
+// returnInfo holds the name and description of a Return as defined in nvml.h
+// and its ReturnClass.
+type returnInfo struct {
+	name        string
+	description string
+	class       ReturnClass
+}
+
+// Description returns the description of a Return from nvml.h. This does not
+// require the NVML library to be loaded.
+func (r Return) Description() string {
+	if info, ok := returnInfos[r]; ok {
+		return info.description
+	}
+	return fmt.Sprintf("unknown return value: %d", r)
+}
+
+// Class returns the ReturnClass of a Return. This does not require the NVML
+// library to be loaded.
+func (r Return) Class() ReturnClass {
+	if info, ok := returnInfos[r]; ok {
+		return info.class
+	}
+	return ReturnClassUnknown
+}
+
+// Returns returns all Return values defined in nvml.h, ordered by value.
+func Returns() []Return {
+	var returns []Return
+	for r := range returnInfos {
+		returns = append(returns, r)
+	}
+	sort.Slice(returns, func(i, j int) bool {
+		return returns[i] < returns[j]
+	})
+	return returns
+}
+
+// ReturnClass indicates how a caller can expect to recover from a Return.
+type ReturnClass int
+
+// The supported ReturnClass values.
+const (
+	// ReturnClassUnknown is used for Return values that are not classified.
+	ReturnClassUnknown ReturnClass = iota
+	// ReturnClassSuccess indicates that the operation was successful.
+	ReturnClassSuccess
+	// ReturnClassTransient indicates that retrying the operation may succeed.
+	ReturnClassTransient
+	// ReturnClassPermanent indicates that retrying the operation will not
+	// succeed without changes to the arguments, the driver, or the system.
+	ReturnClassPermanent
+	// ReturnClassNeedsReset indicates that the GPU must be reset before it can
+	// be used again.
+	ReturnClassNeedsReset
+	// ReturnClassPermission indicates that the caller is not permitted to
+	// perform the operation.
+	ReturnClassPermission
+)
+
+// String returns the string representation of a ReturnClass.
+func (c ReturnClass) String() string {
+	switch c {
+	case ReturnClassSuccess:
+		return "success"
+	case ReturnClassTransient:
+		return "transient"
+	case ReturnClassPermanent:
+		return "permanent"
+	case ReturnClassNeedsReset:
+		return "needs-reset"
+	case ReturnClassPermission:
+		return "permission"
 	default:
-		return fmt.Sprintf("unknown return value: %d", r)
+		return "unknown"
 	}
 }
@@ -0,0 +1,92 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReturnCatalog(t *testing.T) {
+	testCases := []struct {
+		ret                 Return
+		expectedString      string
+		expectedDescription string
+		expectedClass       ReturnClass
+	}{
+		{
+			ret:                 SUCCESS,
+			expectedString:      "SUCCESS",
+			expectedDescription: "The operation was successful",
+			expectedClass:       ReturnClassSuccess,
+		},
+		{
+			ret:                 ERROR_NO_PERMISSION,
+			expectedString:      "ERROR_NO_PERMISSION",
+			expectedDescription: "The current user does not have permission for operation",
+			expectedClass:       ReturnClassPermission,
+		},
+		{
+			ret:                 ERROR_GPU_IS_LOST,
+			expectedString:      "ERROR_GPU_IS_LOST",
+			expectedDescription: "The GPU has fallen off the bus or has otherwise become inaccessible",
+			expectedClass:       ReturnClassNeedsReset,
+		},
+		{
+			ret:                 ERROR_NOT_READY,
+			expectedString:      "ERROR_NOT_READY",
+			expectedDescription: "The system is not ready for the request",
+			expectedClass:       ReturnClassTransient,
+		},
+		{
+			ret:                 ERROR_RESET_TYPE_NOT_SUPPORTED,
+			expectedString:      "ERROR_RESET_TYPE_NOT_SUPPORTED",
+			expectedDescription: "Reset not supported for given device/parameters",
+			expectedClass:       ReturnClassPermanent,
+		},
+		{
+			ret:                 Return(1234),
+			expectedString:      "unknown return value: 1234",
+			expectedDescription: "unknown return value: 1234",
+			expectedClass:       ReturnClassUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expectedString, func(t *testing.T) {
+			require.Equal(t, tc.expectedString, defaultErrorStringFunc(tc.ret))
+			require.Equal(t, tc.expectedDescription, tc.ret.Description())
+			require.Equal(t, tc.expectedClass, tc.ret.Class())
+		})
+	}
+}
+
+func TestReturnsAreClassified(t *testing.T) {
+	returns := Returns()
+	require.Len(t, returns, len(returnInfos))
+	require.Equal(t, SUCCESS, returns[0])
+	require.Equal(t, ERROR_UNKNOWN, returns[len(returns)-1])
+
+	for r, info := range returnInfos {
+		if r == ERROR_UNKNOWN {
+			require.Equal(t, ReturnClassUnknown, info.class)
+			continue
+		}
+		require.NotEqual(t, ReturnClassUnknown, info.class, "%v is not classified", r)
+	}
+}
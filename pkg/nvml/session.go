@@ -0,0 +1,62 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"io"
+	"sync"
+)
+
+// Session represents a successful call to Init on an NVML library. Closing
+// the session calls Shutdown on the library, which ensures that each call to
+// Init is balanced by a call to Shutdown.
+type Session struct {
+	lib       Interface
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ io.Closer = (*Session)(nil)
+
+// NewSession creates an NVML library with the specified options and
+// initializes it. The returned session must be closed once the library is no
+// longer needed. If the library cannot be initialized, the Return value is
+// returned as an error.
+func NewSession(opts ...LibraryOption) (*Session, error) {
+	lib := New(opts...)
+	if ret := lib.Init(); ret != SUCCESS {
+		return nil, ret
+	}
+	return &Session{lib: lib}, nil
+}
+
+// Library returns the initialized library for the session. The library must
+// not be used once the session is closed.
+func (s *Session) Library() Interface {
+	return s.lib
+}
+
+// Close shuts down the library for the session. Only the first call to Close
+// shuts down the library; subsequent calls return the same result.
+func (s *Session) Close() error {
+	s.closeOnce.Do(func() {
+		if ret := s.lib.Shutdown(); ret != SUCCESS {
+			s.closeErr = ret
+		}
+	})
+	return s.closeErr
+}
@@ -0,0 +1,91 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingInterface counts the calls to Init and Shutdown.
+type countingInterface struct {
+	Interface
+	initReturn     Return
+	shutdownReturn Return
+	inits          int
+	shutdowns      int
+}
+
+func (c *countingInterface) Init() Return {
+	c.inits++
+	return c.initReturn
+}
+
+func (c *countingInterface) Shutdown() Return {
+	c.shutdowns++
+	return c.shutdownReturn
+}
+
+func TestSession(t *testing.T) {
+	testCases := []struct {
+		description        string
+		initReturn         Return
+		shutdownReturn     Return
+		expectedInitError  error
+		expectedCloseError error
+	}{
+		{
+			description:    "session is closed",
+			initReturn:     SUCCESS,
+			shutdownReturn: SUCCESS,
+		},
+		{
+			description:       "init error is returned",
+			initReturn:        ERROR_LIBRARY_NOT_FOUND,
+			expectedInitError: ERROR_LIBRARY_NOT_FOUND,
+		},
+		{
+			description:        "shutdown error is returned",
+			initReturn:         SUCCESS,
+			shutdownReturn:     ERROR_UNKNOWN,
+			expectedCloseError: ERROR_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			impl := &countingInterface{
+				initReturn:     tc.initReturn,
+				shutdownReturn: tc.shutdownReturn,
+			}
+
+			session, err := NewSession(WithImplementation(impl))
+			require.Equal(t, tc.expectedInitError, err)
+			require.Equal(t, 1, impl.inits)
+			if err != nil {
+				require.Nil(t, session)
+				return
+			}
+			require.Same(t, impl, session.Library())
+
+			require.Equal(t, tc.expectedCloseError, session.Close())
+			require.Equal(t, tc.expectedCloseError, session.Close())
+			require.Equal(t, 1, impl.shutdowns)
+		})
+	}
+}
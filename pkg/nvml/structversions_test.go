@@ -0,0 +1,100 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestStructVersions checks the generated version constants against the
+// values of the corresponding macros in nvml.h, as computed by the system C
+// compiler. The test is skipped if no C compiler is available.
+func TestStructVersions(t *testing.T) {
+	header := filepath.Join("..", "..", "gen", "nvml", "nvml.h")
+	contents, err := os.ReadFile(header)
+	require.NoError(t, err)
+
+	// Each macro in nvml.h must have a constant.
+	pattern := regexp.MustCompile(`#define\s+(nvml\w+_v\d+)\s+(?:\\\s*)?NVML_STRUCT_VERSION\(`)
+	macros := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(string(contents), -1) {
+		macros[match[1]] = true
+	}
+	generated := make(map[string]bool)
+	for _, v := range structVersions {
+		generated[v.macro] = true
+	}
+	require.NotEmpty(t, macros)
+	require.Equal(t, macros, generated)
+
+	cc := os.Getenv("CC")
+	if cc == "" {
+		cc = "cc"
+	}
+	if _, err := exec.LookPath(cc); err != nil {
+		t.Skipf("This test requires a C compiler: %v", err)
+	}
+
+	var source strings.Builder
+	source.WriteString("#include <stdio.h>\n")
+	source.WriteString("#include \"nvml.h\"\n")
+	source.WriteString("int main(void)\n{\n")
+	for macro := range macros {
+		source.WriteString(fmt.Sprintf("    printf(\"%%s %%u\\n\", %q, %s);\n", macro, macro))
+	}
+	source.WriteString("    return 0;\n}\n")
+
+	dir := t.TempDir()
+	sourcePath := filepath.Join(dir, "versions.c")
+	require.NoError(t, os.WriteFile(sourcePath, []byte(source.String()), 0600))
+	programPath := filepath.Join(dir, "versions")
+	output, err := exec.Command(cc, "-Wall", "-Werror", "-I", filepath.Dir(header), "-o", programPath, sourcePath).CombinedOutput()
+	require.NoError(t, err, "failed to build program:\n%s", output)
+
+	output, err = exec.Command(programPath).Output()
+	require.NoError(t, err)
+	expected := make(map[string]uint32)
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		var macro, value string
+		_, err := fmt.Sscan(line, &macro, &value)
+		require.NoError(t, err)
+		version, err := strconv.ParseUint(value, 10, 32)
+		require.NoError(t, err)
+		expected[macro] = uint32(version)
+	}
+
+	for _, v := range structVersions {
+		require.Equal(t, expected[v.macro], v.version, "%s does not match %s", v.name, v.macro)
+	}
+}
+
+func TestStructConstructors(t *testing.T) {
+	require.Equal(t, ProcessDetailListVersion, NewProcessDetailList().Version)
+	require.Equal(t, STRUCT_VERSION(ProcessDetailList{}, 1), ProcessDetailListVersion)
+	require.Equal(t, GpuFabricInfo_v3Version, NewGpuFabricInfo_v3().Version)
+	require.Equal(t, STRUCT_VERSION(GpuFabricInfo_v3{}, 3), GpuFabricInfo_v3Version)
+	require.Equal(t, NvLinkInfo_v2Version, NvLinkInfoVersion)
+}
@@ -0,0 +1,414 @@
+//go:build linux && cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-nvml/pkg/dl"
+)
+
+// stubFixture defines the values returned by the stub library.
+type stubFixture struct {
+	DriverVersion     string              `json:"driverVersion"`
+	NVMLVersion       string              `json:"nvmlVersion"`
+	CudaDriverVersion int                 `json:"cudaDriverVersion"`
+	OmitSymbols       []string            `json:"omitSymbols"`
+	Devices           []stubFixtureDevice `json:"devices"`
+}
+
+type stubFixtureDevice struct {
+	Name           string `json:"name"`
+	UUID           string `json:"uuid"`
+	MinorNumber    int    `json:"minorNumber"`
+	BusID          string `json:"busId"`
+	PciDeviceID    string `json:"pciDeviceId"`
+	PciSubSystemID string `json:"pciSubSystemId"`
+	Memory         struct {
+		Total    uint64 `json:"total"`
+		Reserved uint64 `json:"reserved"`
+		Free     uint64 `json:"free"`
+		Used     uint64 `json:"used"`
+	} `json:"memory"`
+}
+
+func loadStubFixture(t *testing.T, filename string) *stubFixture {
+	contents, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	var fixture stubFixture
+	require.NoError(t, json.Unmarshal(contents, &fixture))
+	return &fixture
+}
+
+// header generates the fixture.h header that is compiled into the stub.
+func (f *stubFixture) header() (string, error) {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("#define STUB_DRIVER_VERSION %q\n", f.DriverVersion))
+	output.WriteString(fmt.Sprintf("#define STUB_NVML_VERSION %q\n", f.NVMLVersion))
+	output.WriteString(fmt.Sprintf("#define STUB_CUDA_DRIVER_VERSION %d\n", f.CudaDriverVersion))
+	for _, symbol := range f.OmitSymbols {
+		output.WriteString(fmt.Sprintf("#define STUB_OMIT_%s\n", symbol))
+	}
+
+	output.WriteString("\nstatic const struct stubDevice stubDevices[] = {\n")
+	for _, d := range f.Devices {
+		var domain, bus, device, function uint32
+		if _, err := fmt.Sscanf(d.BusID, "%x:%x:%x.%x", &domain, &bus, &device, &function); err != nil {
+			return "", fmt.Errorf("invalid bus ID %q: %w", d.BusID, err)
+		}
+		pciDeviceID, err := strconv.ParseUint(d.PciDeviceID, 0, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid PCI device ID %q: %w", d.PciDeviceID, err)
+		}
+		pciSubSystemID, err := strconv.ParseUint(d.PciSubSystemID, 0, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid PCI subsystem ID %q: %w", d.PciSubSystemID, err)
+		}
+		output.WriteString("    {\n")
+		output.WriteString(fmt.Sprintf("        .name = %q,\n", d.Name))
+		output.WriteString(fmt.Sprintf("        .uuid = %q,\n", d.UUID))
+		output.WriteString(fmt.Sprintf("        .minorNumber = %d,\n", d.MinorNumber))
+		output.WriteString(fmt.Sprintf("        .busId = %q,\n", d.BusID))
+		output.WriteString(fmt.Sprintf("        .domain = %#x,\n", domain))
+		output.WriteString(fmt.Sprintf("        .bus = %#x,\n", bus))
+		output.WriteString(fmt.Sprintf("        .device = %#x,\n", device))
+		output.WriteString(fmt.Sprintf("        .pciDeviceId = %#x,\n", pciDeviceID))
+		output.WriteString(fmt.Sprintf("        .pciSubSystemId = %#x,\n", pciSubSystemID))
+		output.WriteString(fmt.Sprintf("        .memoryTotal = %dULL,\n", d.Memory.Total))
+		output.WriteString(fmt.Sprintf("        .memoryReserved = %dULL,\n", d.Memory.Reserved))
+		output.WriteString(fmt.Sprintf("        .memoryFree = %dULL,\n", d.Memory.Free))
+		output.WriteString(fmt.Sprintf("        .memoryUsed = %dULL,\n", d.Memory.Used))
+		output.WriteString("    },\n")
+	}
+	output.WriteString("};\n")
+
+	return output.String(), nil
+}
+
+// requireStubLibrary builds the stub libnvidia-ml.so for the specified
+// fixture using the system C compiler and returns its path. The stub is built
+// against the unpatched nvml.h in gen/nvml to match the real library. The test
+// is skipped if no C compiler is available.
+//
+// The stub is opened and kept open for the lifetime of the test binary. This
+// ensures that the NVML symbols referenced by the bindings, which are resolved
+// process-wide on first use, remain valid even once the library under test is
+// closed.
+func requireStubLibrary(t *testing.T, fixture *stubFixture) string {
+	cc := os.Getenv("CC")
+	if cc == "" {
+		cc = "cc"
+	}
+	if _, err := exec.LookPath(cc); err != nil {
+		t.Skipf("This test requires a C compiler: %v", err)
+	}
+
+	header, err := fixture.header()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fixture.h"), []byte(header), 0600))
+
+	libraryPath := filepath.Join(dir, "libnvidia-ml.so.1")
+	cmd := exec.Command(cc,
+		"-shared", "-fPIC", "-Wall", "-Werror",
+		"-I", dir,
+		"-I", filepath.Join("..", "..", "gen", "nvml"),
+		"-o", libraryPath,
+		filepath.Join("testdata", "stub", "nvml_stub.c"),
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "failed to build stub library:\n%s", output)
+
+	stub := dl.New(libraryPath, defaultNvmlLibraryLoadFlags)
+	require.NoError(t, stub.Open())
+
+	return libraryPath
+}
+
+func TestStubLibrary(t *testing.T) {
+	fixture := loadStubFixture(t, filepath.Join("testdata", "stub", "fixture.json"))
+	libraryPath := requireStubLibrary(t, fixture)
+
+	defer setLoadedLibrariesForTest()()
+
+	l := New(WithLibraryPath(libraryPath))
+	require.Equal(t, SUCCESS, l.Init())
+
+	t.Run("versioned symbols", func(t *testing.T) {
+		symbols, err := l.Extensions().Symbols()
+		require.NoError(t, err)
+
+		bound := make(map[string]string)
+		for _, s := range symbols {
+			bound[s.Name] = s.Bound
+		}
+		require.Equal(t, "nvmlInit_v2", bound["nvmlInit"])
+		require.Equal(t, "nvmlDeviceGetPciInfo_v3", bound["nvmlDeviceGetPciInfo"])
+		require.Equal(t, "nvmlDeviceGetHandleByIndex_v2", bound["nvmlDeviceGetHandleByIndex"])
+		// The fixture omits nvmlDeviceGetCount_v2
+		require.Equal(t, "nvmlDeviceGetCount", bound["nvmlDeviceGetCount"])
+	})
+
+	t.Run("system strings", func(t *testing.T) {
+		driverVersion, ret := l.SystemGetDriverVersion()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, fixture.DriverVersion, driverVersion)
+
+		nvmlVersion, ret := l.SystemGetNVMLVersion()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, fixture.NVMLVersion, nvmlVersion)
+
+		cudaDriverVersion, ret := l.SystemGetCudaDriverVersion()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, fixture.CudaDriverVersion, cudaDriverVersion)
+	})
+
+	t.Run("devices", func(t *testing.T) {
+		count, ret := l.DeviceGetCount()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, len(fixture.Devices), count)
+
+		for i, expected := range fixture.Devices {
+			device, ret := l.DeviceGetHandleByIndex(i)
+			require.Equal(t, SUCCESS, ret)
+
+			name, ret := device.GetName()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, expected.Name, name)
+
+			uuid, ret := device.GetUUID()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, expected.UUID, uuid)
+
+			minor, ret := device.GetMinorNumber()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, expected.MinorNumber, minor)
+
+			memory, ret := device.GetMemoryInfo()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, Memory{
+				Total: expected.Memory.Total,
+				Free:  expected.Memory.Free,
+				Used:  expected.Memory.Reserved + expected.Memory.Used,
+			}, memory)
+
+			memoryV2, ret := device.GetMemoryInfo_v2()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, Memory_v2{
+				Version:  STRUCT_VERSION(memoryV2, 2),
+				Total:    expected.Memory.Total,
+				Reserved: expected.Memory.Reserved,
+				Free:     expected.Memory.Free,
+				Used:     expected.Memory.Used,
+			}, memoryV2)
+
+			pciInfo, ret := device.GetPciInfo()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, expected.BusID, string(pciInfo.BusId[:clen(pciInfo.BusId[:])]))
+			require.Equal(t, expected.PciDeviceID, fmt.Sprintf("0x%08X", pciInfo.PciDeviceId))
+			require.Equal(t, expected.PciSubSystemID, fmt.Sprintf("0x%08X", pciInfo.PciSubSystemId))
+		}
+	})
+
+	t.Run("field values", func(t *testing.T) {
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+
+		values, ret := QueryFields(device,
+			FieldRequest{FieldId: FI_DEV_ECC_CURRENT},
+			FieldRequest{FieldId: FI_DEV_NVLINK_GET_SPEED, ScopeId: 1},
+			FieldRequest{FieldId: FI_DEV_NVLINK_GET_SPEED, ScopeId: 2},
+			FieldRequest{FieldId: FI_DEV_MEMORY_TEMP},
+		)
+		require.Equal(t, SUCCESS, ret)
+		require.Len(t, values, 4)
+
+		eccCurrent, ret := values[0].Uint64()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, uint64(1), eccCurrent)
+
+		speed, ret := values[1].Float64()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, float64(50000), speed)
+		require.Equal(t, uint32(1), values[1].ScopeId)
+
+		_, ret = values[2].Uint64()
+		require.Equal(t, ERROR_INVALID_ARGUMENT, ret)
+		_, ret = values[3].Int64()
+		require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+	})
+
+	t.Run("negotiated versions", func(t *testing.T) {
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+
+		_, ret = device.GetGpuFabricInfoV().V3()
+		require.Equal(t, ERROR_ARGUMENT_VERSION_MISMATCH, ret)
+
+		info, ret := device.GetGpuFabricInfoV().Negotiate()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, 2, info.Version)
+		require.Equal(t, []string{"HealthSummary"}, info.Unavailable)
+		require.Equal(t, uint32(fixture.Devices[0].MinorNumber), info.Value.CliqueId)
+		require.Equal(t, uint8(GPU_FABRIC_STATE_COMPLETED), info.Value.State)
+	})
+
+	t.Run("gpu instance profile by id", func(t *testing.T) {
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+
+		// The stub defines the profile with ID 0 at index
+		// GPU_INSTANCE_PROFILE_7_SLICE, and the profile with ID 19 at index 0.
+		byIndex, ret := device.GetGpuInstanceProfileInfoV(GPU_INSTANCE_PROFILE_1_SLICE).V3()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, uint32(19), byIndex.Id)
+
+		v1, ret := device.GetGpuInstanceProfileInfoV(GPU_INSTANCE_PROFILE_1_SLICE).V1()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, uint32(19), v1.Id)
+		require.Equal(t, uint32(1), v1.SliceCount)
+
+		byId, ret := device.GetGpuInstanceProfileInfoByIdV(0).V3()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, uint32(0), byId.Id)
+		require.Equal(t, uint32(7), byId.SliceCount)
+
+		for i := 0; i < 2; i++ {
+			info, ret := device.GetGpuInstanceProfileInfoByIdV(19).Negotiate()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, 3, info.Version)
+			require.Equal(t, uint32(19), info.Value.Id)
+			require.Equal(t, uint32(1), info.Value.SliceCount)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, ret := l.DeviceGetHandleByIndex(len(fixture.Devices))
+		require.Equal(t, ERROR_INVALID_ARGUMENT, ret)
+		require.Equal(t, "Invalid Argument", l.ErrorString(ret))
+	})
+
+	t.Run("calls after shutdown", func(t *testing.T) {
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+
+		_, ret = device.GetGpuFabricInfoV().Negotiate()
+		require.Equal(t, SUCCESS, ret)
+		require.NotZero(t, negotiatedVersionCount(l))
+
+		require.Equal(t, SUCCESS, l.Shutdown())
+		require.Equal(t, 0, negotiatedVersionCount(l))
+
+		_, ret = device.GetName()
+		require.Equal(t, ERROR_UNINITIALIZED, ret)
+		_, ret = l.DeviceGetCount()
+		require.Equal(t, ERROR_UNINITIALIZED, ret)
+		require.Equal(t, ERROR_UNINITIALIZED, l.Shutdown())
+	})
+
+	t.Run("reinit on version mismatch", func(t *testing.T) {
+		var events []ReinitEvent
+		l := New(WithLibraryPath(libraryPath), WithReinit(func(e ReinitEvent) {
+			events = append(events, e)
+		}))
+		require.Equal(t, SUCCESS, l.Init())
+
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+
+		_, ret = device.GetGpuFabricInfoV().Negotiate()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, 1, negotiatedVersionCount(l))
+
+		// Simulate a driver upgrade while the library is initialized.
+		t.Setenv("NVML_STUB_DRIVER_GENERATION", "upgraded")
+
+		name, ret := device.GetName()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, fixture.Devices[0].Name, name)
+		require.Equal(t, []ReinitEvent{
+			{Trigger: ERROR_LIB_RM_VERSION_MISMATCH, Return: SUCCESS},
+		}, events)
+
+		// The struct versions are negotiated again for the new driver.
+		require.Equal(t, 0, negotiatedVersionCount(l))
+
+		// Calls made after the reinitialization do not trigger another one.
+		uuid, ret := device.GetUUID()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, fixture.Devices[0].UUID, uuid)
+		require.Len(t, events, 1)
+
+		require.Equal(t, SUCCESS, l.Shutdown())
+		_, ret = device.GetName()
+		require.Equal(t, ERROR_UNINITIALIZED, ret)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("supervised device passed to other handles", func(t *testing.T) {
+		l := New(WithLibraryPath(libraryPath), WithReinit(nil))
+		require.Equal(t, SUCCESS, l.Init())
+		defer l.Shutdown()
+
+		device, ret := l.DeviceGetHandleByIndex(0)
+		require.Equal(t, SUCCESS, ret)
+		require.IsType(t, &supervisedDevice{}, device)
+		// The UUID used to resolve the device again is only looked up once
+		// the device is used.
+		require.Empty(t, device.(*supervisedDevice).uuid)
+
+		sample, ret := l.GpmSampleAlloc()
+		require.Equal(t, SUCCESS, ret)
+		defer sample.Free()
+		require.Equal(t, SUCCESS, sample.Get(device))
+		require.Equal(t, fixture.Devices[0].UUID, device.(*supervisedDevice).uuid)
+
+		count, ret := nvmlVgpuTypeId(1).GetMaxInstances(device)
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, 8, count)
+	})
+
+	t.Run("session with load and init flags", func(t *testing.T) {
+		session, err := NewSession(
+			WithLibraryPath(libraryPath),
+			WithLoadFlags(dl.RTLD_NOW|dl.RTLD_GLOBAL),
+			WithInitFlags(INIT_FLAG_NO_GPUS),
+		)
+		require.NoError(t, err)
+
+		count, ret := session.Library().DeviceGetCount()
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, len(fixture.Devices), count)
+
+		require.NoError(t, session.Close())
+		_, ret = session.Library().DeviceGetCount()
+		require.Equal(t, ERROR_UNINITIALIZED, ret)
+	})
+}
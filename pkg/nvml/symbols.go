@@ -0,0 +1,118 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// SymbolInfo describes the availability of an NVML entry point in the loaded
+// library.
+type SymbolInfo struct {
+	// Name is the unversioned name of the entry point (e.g. nvmlDeviceGetPciInfo).
+	Name string
+	// Variants lists the symbols for the entry point that are referenced by
+	// the bindings, ordered from the oldest to the newest version.
+	Variants []string
+	// Available lists the variants that resolved in the loaded library.
+	Available []string
+	// Bound is the variant that calls to the entry point are bound to. This
+	// is empty if the bound variant did not resolve in the loaded library.
+	Bound string
+}
+
+// IsAvailable returns whether the entry point resolved in the loaded library.
+func (s SymbolInfo) IsAvailable() bool {
+	return s.Bound != ""
+}
+
+var versionedSymbolPattern = regexp.MustCompile(`^(\w+)_v(\d+)$`)
+
+// splitSymbolVersion splits a symbol into its unversioned name and version.
+// Symbols without a version suffix are considered to be the v1 variant.
+func splitSymbolVersion(symbol string) (string, int) {
+	m := versionedSymbolPattern.FindStringSubmatch(symbol)
+	if m == nil {
+		return symbol, 1
+	}
+	version, _ := strconv.Atoi(m[2])
+	return m[1], version
+}
+
+// symbolVariants returns the symbols referenced by the bindings for each
+// unversioned entry point, ordered from the oldest to the newest version.
+func symbolVariants() map[string][]string {
+	variants := make(map[string][]string)
+	for _, symbol := range nvmlSymbols {
+		name, _ := splitSymbolVersion(symbol)
+		variants[name] = append(variants[name], symbol)
+	}
+	for _, symbols := range variants {
+		sort.Slice(symbols, func(i, j int) bool {
+			_, vi := splitSymbolVersion(symbols[i])
+			_, vj := splitSymbolVersion(symbols[j])
+			return vi < vj
+		})
+	}
+	return variants
+}
+
+// Symbols returns the availability of each NVML entry point that is
+// referenced by the bindings, sorted by name. Note that this requires that the
+// library be loaded.
+func (l *library) Symbols() ([]SymbolInfo, error) {
+	if l == nil {
+		return nil, fmt.Errorf("error getting symbols: %w", errLibraryNotLoaded)
+	}
+	l.Lock()
+	defer l.Unlock()
+	if l.refcount == 0 {
+		return nil, fmt.Errorf("error getting symbols: %w", errLibraryNotLoaded)
+	}
+
+	var infos []SymbolInfo
+	for name, symbols := range symbolVariants() {
+		bound := symbols[0]
+		if b, ok := l.symbols.bound[name]; ok {
+			bound = b
+		}
+
+		info := SymbolInfo{
+			Name:     name,
+			Variants: symbols,
+		}
+		for _, symbol := range symbols {
+			if err := l.dl.Lookup(symbol); err != nil {
+				continue
+			}
+			info.Available = append(info.Available, symbol)
+			if symbol == bound {
+				info.Bound = bound
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	return infos, nil
+}
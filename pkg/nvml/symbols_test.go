@@ -0,0 +1,166 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbols(t *testing.T) {
+	available := map[string]bool{
+		"nvmlInit":                                true,
+		"nvmlInit_v2":                             true,
+		"nvmlDeviceGetPciInfo":                    true,
+		"nvmlDeviceGetPciInfo_v2":                 true,
+		"nvmlDeviceGetPciInfo_v3":                 true,
+		"nvmlDeviceGetComputeRunningProcesses_v3": true,
+		"nvmlDeviceGetPowerMizerMode_v1":          true,
+	}
+	l := newTestLibrary(&dynamicLibraryMock{
+		OpenFunc: func() error {
+			return nil
+		},
+		LookupFunc: func(s string) error {
+			if !available[s] {
+				return errors.New("not found")
+			}
+			return nil
+		},
+		CloseFunc: func() error {
+			return nil
+		},
+	})
+	defer setLoadedLibrariesForTest()()
+
+	_, err := l.Symbols()
+	require.ErrorIs(t, err, errLibraryNotLoaded)
+
+	require.Nil(t, l.load())
+	defer l.close()
+
+	infos, err := l.Symbols()
+	require.NoError(t, err)
+
+	byName := make(map[string]SymbolInfo)
+	for i, info := range infos {
+		if i > 0 {
+			require.Less(t, infos[i-1].Name, info.Name)
+		}
+		byName[info.Name] = info
+	}
+
+	testCases := []struct {
+		name     string
+		expected SymbolInfo
+	}{
+		{
+			name: "nvmlInit",
+			expected: SymbolInfo{
+				Name:      "nvmlInit",
+				Variants:  []string{"nvmlInit", "nvmlInit_v2"},
+				Available: []string{"nvmlInit", "nvmlInit_v2"},
+				Bound:     "nvmlInit_v2",
+			},
+		},
+		{
+			name: "nvmlDeviceGetPciInfo",
+			expected: SymbolInfo{
+				Name:      "nvmlDeviceGetPciInfo",
+				Variants:  []string{"nvmlDeviceGetPciInfo", "nvmlDeviceGetPciInfo_v2", "nvmlDeviceGetPciInfo_v3"},
+				Available: []string{"nvmlDeviceGetPciInfo", "nvmlDeviceGetPciInfo_v2", "nvmlDeviceGetPciInfo_v3"},
+				Bound:     "nvmlDeviceGetPciInfo_v3",
+			},
+		},
+		{
+			name: "nvmlDeviceGetComputeRunningProcesses",
+			expected: SymbolInfo{
+				Name: "nvmlDeviceGetComputeRunningProcesses",
+				Variants: []string{
+					"nvmlDeviceGetComputeRunningProcesses",
+					"nvmlDeviceGetComputeRunningProcesses_v2",
+					"nvmlDeviceGetComputeRunningProcesses_v3",
+				},
+				Available: []string{"nvmlDeviceGetComputeRunningProcesses_v3"},
+				Bound:     "nvmlDeviceGetComputeRunningProcesses_v3",
+			},
+		},
+		{
+			name: "nvmlDeviceGetPowerMizerMode",
+			expected: SymbolInfo{
+				Name:      "nvmlDeviceGetPowerMizerMode",
+				Variants:  []string{"nvmlDeviceGetPowerMizerMode_v1"},
+				Available: []string{"nvmlDeviceGetPowerMizerMode_v1"},
+				Bound:     "nvmlDeviceGetPowerMizerMode_v1",
+			},
+		},
+		{
+			name: "nvmlDeviceGetCount",
+			expected: SymbolInfo{
+				Name:     "nvmlDeviceGetCount",
+				Variants: []string{"nvmlDeviceGetCount", "nvmlDeviceGetCount_v2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, ok := byName[tc.name]
+			require.True(t, ok)
+			require.Equal(t, tc.expected, info)
+			require.Equal(t, tc.expected.Bound != "", info.IsAvailable())
+		})
+	}
+}
+
+func TestVersionedSymbolsMatchHeader(t *testing.T) {
+	contents, err := os.ReadFile("nvml.h")
+	require.NoError(t, err)
+
+	// The unversioned names in nvml.h are defined to the latest version of
+	// each versioned function.
+	start := bytes.Index(contents, []byte("#ifndef NVML_NO_UNVERSIONED_FUNC_DEFS"))
+	end := bytes.Index(contents[start:], []byte("#endif"))
+	require.True(t, start >= 0 && end >= 0)
+
+	pattern := regexp.MustCompile(`(?m)^\s*#define\s+(nvml\w+)\s+(nvml\w+_v\d+)\s*$`)
+	expected := make(map[string]string)
+	for _, match := range pattern.FindAllSubmatch(contents[start:start+end], -1) {
+		name, _ := splitSymbolVersion(string(match[2]))
+		if name != string(match[1]) {
+			continue
+		}
+		expected[name] = string(match[2])
+	}
+	// nvmlDeviceRemoveGpu_v2 takes different parameters to the v1 variant
+	// and is not bound.
+	delete(expected, "nvmlDeviceRemoveGpu")
+	require.NotEmpty(t, expected)
+
+	l := newTestLibrary(&dynamicLibraryMock{
+		LookupFunc: func(string) error {
+			return nil
+		},
+	})
+	l.updateVersionedSymbols()
+	require.Equal(t, expected, l.symbols.bound)
+}
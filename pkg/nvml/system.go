@@ -120,16 +120,14 @@ func (l *library) SystemGetNvlinkBwMode() (uint32, Return) {
 
 // nvml.SystemGetConfComputeKeyRotationThresholdInfo()
 func (l *library) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
-	var keyRotationThresholdInfo ConfComputeGetKeyRotationThresholdInfo
-	keyRotationThresholdInfo.Version = STRUCT_VERSION(keyRotationThresholdInfo, 1)
+	keyRotationThresholdInfo := NewConfComputeGetKeyRotationThresholdInfo()
 	ret := nvmlSystemGetConfComputeKeyRotationThresholdInfo(&keyRotationThresholdInfo)
 	return keyRotationThresholdInfo, ret
 }
 
 // nvml.SystemGetConfComputeSettings()
 func (l *library) SystemGetConfComputeSettings() (SystemConfComputeSettings, Return) {
-	var settings SystemConfComputeSettings
-	settings.Version = STRUCT_VERSION(settings, 1)
+	settings := NewSystemConfComputeSettings()
 	ret := nvmlSystemGetConfComputeSettings(&settings)
 	return settings, ret
 }
@@ -141,8 +139,7 @@ func (l *library) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresh
 
 // nvml.SystemGetDriverBranch()
 func (l *library) SystemGetDriverBranch() (SystemDriverBranchInfo, Return) {
-	var branchInfo SystemDriverBranchInfo
-	branchInfo.Version = STRUCT_VERSION(branchInfo, 1)
+	branchInfo := NewSystemDriverBranchInfo()
 	ret := nvmlSystemGetDriverBranch(&branchInfo, SYSTEM_DRIVER_VERSION_BUFFER_SIZE)
 	return branchInfo, ret
 }
@@ -0,0 +1,501 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// This file implements a stub libnvidia-ml.so that is used to test the cgo
+// bindings without a GPU. The values returned by the stub are defined by the
+// fixture.h header, which is generated from a JSON fixture by the tests.
+//
+// Only the NVML functions that are exercised by the tests are implemented.
+// Since the library is loaded with RTLD_LAZY, calling any other function
+// aborts the test binary.
+//
+// Device handles are only valid until the library is shut down. To simulate
+// a driver upgrade, the NVML_STUB_DRIVER_GENERATION environment variable can
+// be changed while the library is initialized. Calls then return
+// NVML_ERROR_LIB_RM_VERSION_MISMATCH until the library is reinitialized.
+
+#define NVML_NO_UNVERSIONED_FUNC_DEFS
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include "nvml.h"
+
+struct stubDevice {
+    const char *name;
+    const char *uuid;
+    unsigned int minorNumber;
+    const char *busId;
+    unsigned int domain;
+    unsigned int bus;
+    unsigned int device;
+    unsigned int pciDeviceId;
+    unsigned int pciSubSystemId;
+    unsigned long long memoryTotal;
+    unsigned long long memoryReserved;
+    unsigned long long memoryFree;
+    unsigned long long memoryUsed;
+};
+
+#include "fixture.h"
+
+#define STUB_DEVICE_COUNT (sizeof(stubDevices) / sizeof(stubDevices[0]))
+#define STUB_NVLINK_COUNT 2
+#define STUB_NVLINK_SPEED 50000ULL
+#define STUB_VGPU_MAX_INSTANCES 8
+
+// The handles for a given initialization of the library. Handles are not
+// freed on shutdown so that stale handles can be detected.
+struct nvmlDevice_st {
+    const struct stubDevice *data;
+    unsigned int epoch;
+};
+
+static unsigned int initCount = 0;
+static unsigned int epoch = 0;
+static struct nvmlDevice_st *handles = NULL;
+static char *driverGeneration = NULL;
+
+static char *getDriverGeneration(void)
+{
+    const char *generation = getenv("NVML_STUB_DRIVER_GENERATION");
+    return strdup(generation == NULL ? "" : generation);
+}
+
+static nvmlReturn_t copyString(const char *value, char *buffer, unsigned int length)
+{
+    if (buffer == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    if (strlen(value) >= length)
+        return NVML_ERROR_INSUFFICIENT_SIZE;
+    strcpy(buffer, value);
+    return NVML_SUCCESS;
+}
+
+static nvmlReturn_t checkInit(void)
+{
+    nvmlReturn_t ret = NVML_SUCCESS;
+    char *generation;
+
+    if (initCount == 0)
+        return NVML_ERROR_UNINITIALIZED;
+    generation = getDriverGeneration();
+    if (strcmp(generation, driverGeneration) != 0)
+        ret = NVML_ERROR_LIB_RM_VERSION_MISMATCH;
+    free(generation);
+    return ret;
+}
+
+static nvmlReturn_t checkDevice(nvmlDevice_t device)
+{
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (device == NULL || device->epoch != epoch)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlInit(void)
+{
+    unsigned int i;
+
+    if (initCount++ > 0)
+        return NVML_SUCCESS;
+
+    epoch++;
+    handles = calloc(STUB_DEVICE_COUNT, sizeof(*handles));
+    if (handles == NULL)
+        return NVML_ERROR_MEMORY;
+    for (i = 0; i < STUB_DEVICE_COUNT; i++) {
+        handles[i].data = &stubDevices[i];
+        handles[i].epoch = epoch;
+    }
+    free(driverGeneration);
+    driverGeneration = getDriverGeneration();
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlInit_v2(void)
+{
+    return nvmlInit();
+}
+
+nvmlReturn_t nvmlInitWithFlags(unsigned int flags)
+{
+    (void)flags;
+    return nvmlInit();
+}
+
+nvmlReturn_t nvmlShutdown(void)
+{
+    if (initCount == 0)
+        return NVML_ERROR_UNINITIALIZED;
+    if (--initCount == 0)
+        epoch++;
+    return NVML_SUCCESS;
+}
+
+const char *nvmlErrorString(nvmlReturn_t result)
+{
+    switch (result) {
+    case NVML_SUCCESS:
+        return "Success";
+    case NVML_ERROR_UNINITIALIZED:
+        return "Uninitialized";
+    case NVML_ERROR_INVALID_ARGUMENT:
+        return "Invalid Argument";
+    case NVML_ERROR_INSUFFICIENT_SIZE:
+        return "Insufficient Size";
+    case NVML_ERROR_NOT_SUPPORTED:
+        return "Not Supported";
+    case NVML_ERROR_LIB_RM_VERSION_MISMATCH:
+        return "RM has detected an NVML/RM version mismatch.";
+    case NVML_ERROR_ARGUMENT_VERSION_MISMATCH:
+        return "Argument version mismatch";
+    default:
+        return "Unknown Error";
+    }
+}
+
+nvmlReturn_t nvmlSystemGetDriverVersion(char *version, unsigned int length)
+{
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    return copyString(STUB_DRIVER_VERSION, version, length);
+}
+
+nvmlReturn_t nvmlSystemGetNVMLVersion(char *version, unsigned int length)
+{
+    return copyString(STUB_NVML_VERSION, version, length);
+}
+
+nvmlReturn_t nvmlSystemGetCudaDriverVersion(int *cudaDriverVersion)
+{
+    if (cudaDriverVersion == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *cudaDriverVersion = STUB_CUDA_DRIVER_VERSION;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlDeviceGetCount(unsigned int *deviceCount)
+{
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (deviceCount == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *deviceCount = STUB_DEVICE_COUNT;
+    return NVML_SUCCESS;
+}
+
+#ifndef STUB_OMIT_nvmlDeviceGetCount_v2
+nvmlReturn_t nvmlDeviceGetCount_v2(unsigned int *deviceCount)
+{
+    return nvmlDeviceGetCount(deviceCount);
+}
+#endif
+
+nvmlReturn_t nvmlDeviceGetHandleByIndex(unsigned int index, nvmlDevice_t *device)
+{
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (index >= STUB_DEVICE_COUNT || device == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *device = &handles[index];
+    return NVML_SUCCESS;
+}
+
+#ifndef STUB_OMIT_nvmlDeviceGetHandleByIndex_v2
+nvmlReturn_t nvmlDeviceGetHandleByIndex_v2(unsigned int index, nvmlDevice_t *device)
+{
+    return nvmlDeviceGetHandleByIndex(index, device);
+}
+#endif
+
+nvmlReturn_t nvmlDeviceGetHandleByUUID(const char *uuid, nvmlDevice_t *device)
+{
+    unsigned int i;
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (uuid == NULL || device == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    for (i = 0; i < STUB_DEVICE_COUNT; i++) {
+        if (strcmp(stubDevices[i].uuid, uuid) == 0) {
+            *device = &handles[i];
+            return NVML_SUCCESS;
+        }
+    }
+    return NVML_ERROR_NOT_FOUND;
+}
+
+nvmlReturn_t nvmlDeviceGetName(nvmlDevice_t device, char *name, unsigned int length)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    return copyString(device->data->name, name, length);
+}
+
+nvmlReturn_t nvmlDeviceGetUUID(nvmlDevice_t device, char *uuid, unsigned int length)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    return copyString(device->data->uuid, uuid, length);
+}
+
+nvmlReturn_t nvmlDeviceGetMinorNumber(nvmlDevice_t device, unsigned int *minorNumber)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (minorNumber == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *minorNumber = device->data->minorNumber;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlDeviceGetMemoryInfo(nvmlDevice_t device, nvmlMemory_t *memory)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (memory == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    memory->total = device->data->memoryTotal;
+    memory->free = device->data->memoryFree;
+    memory->used = device->data->memoryReserved + device->data->memoryUsed;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlDeviceGetMemoryInfo_v2(nvmlDevice_t device, nvmlMemory_v2_t *memory)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (memory == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    if (memory->version != nvmlMemory_v2)
+        return NVML_ERROR_ARGUMENT_VERSION_MISMATCH;
+    memory->total = device->data->memoryTotal;
+    memory->reserved = device->data->memoryReserved;
+    memory->free = device->data->memoryFree;
+    memory->used = device->data->memoryUsed;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlDeviceGetPciInfo(nvmlDevice_t device, nvmlPciInfo_t *pci)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (pci == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    memset(pci, 0, sizeof(*pci));
+    snprintf(pci->busIdLegacy, sizeof(pci->busIdLegacy), "%04x:%02x:%02x.0",
+             device->data->domain, device->data->bus, device->data->device);
+    pci->domain = device->data->domain;
+    pci->bus = device->data->bus;
+    pci->device = device->data->device;
+    pci->pciDeviceId = device->data->pciDeviceId;
+    pci->pciSubSystemId = device->data->pciSubSystemId;
+    return NVML_SUCCESS;
+}
+
+#ifndef STUB_OMIT_nvmlDeviceGetPciInfo_v2
+nvmlReturn_t nvmlDeviceGetPciInfo_v2(nvmlDevice_t device, nvmlPciInfo_t *pci)
+{
+    return nvmlDeviceGetPciInfo(device, pci);
+}
+#endif
+
+#ifndef STUB_OMIT_nvmlDeviceGetPciInfo_v3
+nvmlReturn_t nvmlDeviceGetPciInfo_v3(nvmlDevice_t device, nvmlPciInfo_t *pci)
+{
+    nvmlReturn_t ret = nvmlDeviceGetPciInfo(device, pci);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    return copyString(device->data->busId, pci->busId, sizeof(pci->busId));
+}
+#endif
+
+nvmlReturn_t nvmlDeviceGetFieldValues(nvmlDevice_t device, int valuesCount, nvmlFieldValue_t *values)
+{
+    int i;
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (valuesCount < 0 || values == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    for (i = 0; i < valuesCount; i++) {
+        nvmlFieldValue_t *v = &values[i];
+        v->timestamp = 1;
+        v->latencyUsec = 0;
+        v->nvmlReturn = NVML_SUCCESS;
+        switch (v->fieldId) {
+        case NVML_FI_DEV_ECC_CURRENT:
+            v->valueType = NVML_VALUE_TYPE_UNSIGNED_INT;
+            v->value.uiVal = 1;
+            break;
+        case NVML_FI_DEV_NVLINK_GET_SPEED:
+            if (v->scopeId >= STUB_NVLINK_COUNT) {
+                v->nvmlReturn = NVML_ERROR_INVALID_ARGUMENT;
+                break;
+            }
+            v->valueType = NVML_VALUE_TYPE_UNSIGNED_LONG_LONG;
+            v->value.ullVal = STUB_NVLINK_SPEED;
+            break;
+        default:
+            v->nvmlReturn = NVML_ERROR_NOT_SUPPORTED;
+        }
+    }
+    return NVML_SUCCESS;
+}
+
+// Only version 2 of nvmlGpuFabricInfoV_t is supported so that the bindings
+// have to fall back from the latest version.
+nvmlReturn_t nvmlDeviceGetGpuFabricInfoV(nvmlDevice_t device, nvmlGpuFabricInfoV_t *gpuFabricInfo)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (gpuFabricInfo == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    if (gpuFabricInfo->version != nvmlGpuFabricInfo_v2)
+        return NVML_ERROR_ARGUMENT_VERSION_MISMATCH;
+    gpuFabricInfo->cliqueId = device->data->minorNumber;
+    gpuFabricInfo->state = NVML_GPU_FABRIC_STATE_COMPLETED;
+    gpuFabricInfo->status = NVML_SUCCESS;
+    gpuFabricInfo->healthMask = 0;
+    return NVML_SUCCESS;
+}
+
+// The GPU instance profiles of each device. The profile IDs differ from the
+// profile indices so that lookups by ID and by index can be told apart.
+static const struct {
+    unsigned int index;
+    unsigned int id;
+    unsigned int sliceCount;
+    const char *name;
+} stubGpuInstanceProfiles[] = {
+    { .index = NVML_GPU_INSTANCE_PROFILE_1_SLICE, .id = 19, .sliceCount = 1, .name = "1g.10gb" },
+    { .index = NVML_GPU_INSTANCE_PROFILE_7_SLICE, .id = 0, .sliceCount = 7, .name = "7g.80gb" },
+};
+
+#define STUB_GPU_INSTANCE_PROFILE_COUNT (sizeof(stubGpuInstanceProfiles) / sizeof(stubGpuInstanceProfiles[0]))
+
+// Only version 3 of nvmlGpuInstanceProfileInfo_v3_t is supported.
+static nvmlReturn_t getGpuInstanceProfileInfo(nvmlDevice_t device, int byId, unsigned int value, nvmlGpuInstanceProfileInfo_v2_t *info)
+{
+    unsigned int i;
+    nvmlGpuInstanceProfileInfo_v3_t *v3 = (nvmlGpuInstanceProfileInfo_v3_t *)info;
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (info == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    if (info->version != nvmlGpuInstanceProfileInfo_v3)
+        return NVML_ERROR_ARGUMENT_VERSION_MISMATCH;
+    for (i = 0; i < STUB_GPU_INSTANCE_PROFILE_COUNT; i++) {
+        if ((byId ? stubGpuInstanceProfiles[i].id : stubGpuInstanceProfiles[i].index) != value)
+            continue;
+        v3->id = stubGpuInstanceProfiles[i].id;
+        v3->sliceCount = stubGpuInstanceProfiles[i].sliceCount;
+        return copyString(stubGpuInstanceProfiles[i].name, v3->name, sizeof(v3->name));
+    }
+    return NVML_ERROR_NOT_SUPPORTED;
+}
+
+nvmlReturn_t nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice_t device, unsigned int profile, nvmlGpuInstanceProfileInfo_t *info)
+{
+    unsigned int i;
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (info == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    for (i = 0; i < STUB_GPU_INSTANCE_PROFILE_COUNT; i++) {
+        if (stubGpuInstanceProfiles[i].index != profile)
+            continue;
+        info->id = stubGpuInstanceProfiles[i].id;
+        info->sliceCount = stubGpuInstanceProfiles[i].sliceCount;
+        return NVML_SUCCESS;
+    }
+    return NVML_ERROR_NOT_SUPPORTED;
+}
+
+nvmlReturn_t nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice_t device, unsigned int profile, nvmlGpuInstanceProfileInfo_v2_t *info)
+{
+    return getGpuInstanceProfileInfo(device, 0, profile, info);
+}
+
+nvmlReturn_t nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice_t device, unsigned int profileId, nvmlGpuInstanceProfileInfo_v2_t *info)
+{
+    return getGpuInstanceProfileInfo(device, 1, profileId, info);
+}
+
+// A GPM sample records the device that it was taken on.
+struct nvmlGpmSample_st {
+    nvmlDevice_t device;
+};
+
+nvmlReturn_t nvmlGpmSampleAlloc(nvmlGpmSample_t *gpmSample)
+{
+    nvmlReturn_t ret = checkInit();
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (gpmSample == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *gpmSample = calloc(1, sizeof(struct nvmlGpmSample_st));
+    if (*gpmSample == NULL)
+        return NVML_ERROR_MEMORY;
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlGpmSampleFree(nvmlGpmSample_t gpmSample)
+{
+    if (gpmSample == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    free(gpmSample);
+    return NVML_SUCCESS;
+}
+
+nvmlReturn_t nvmlGpmSampleGet(nvmlDevice_t device, nvmlGpmSample_t gpmSample)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (gpmSample == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    gpmSample->device = device;
+    return NVML_SUCCESS;
+}
+
+// Every vGPU type supports the same number of instances on each device.
+nvmlReturn_t nvmlVgpuTypeGetMaxInstances(nvmlDevice_t device, nvmlVgpuTypeId_t vgpuTypeId, unsigned int *vgpuInstanceCount)
+{
+    nvmlReturn_t ret = checkDevice(device);
+    if (ret != NVML_SUCCESS)
+        return ret;
+    if (vgpuInstanceCount == NULL)
+        return NVML_ERROR_INVALID_ARGUMENT;
+    *vgpuInstanceCount = STUB_VGPU_MAX_INSTANCES;
+    return NVML_SUCCESS;
+}
@@ -209,7 +209,7 @@ func (l *library) VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (VgpuLic
 
 func (vgpuInstance nvmlVgpuInstance) GetLicenseInfo() (VgpuLicenseInfo, Return) {
 	var licenseInfo VgpuLicenseInfo
-	ret := nvmlVgpuInstanceGetLicenseInfo(vgpuInstance, &licenseInfo)
+	ret := boundSymbols().nvmlVgpuInstanceGetLicenseInfo(vgpuInstance, &licenseInfo)
 	return licenseInfo, ret
 }
 
@@ -487,8 +487,7 @@ func (l *library) VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (VgpuTypeBar1Info,
 }
 
 func (vgpuTypeId nvmlVgpuTypeId) GetBAR1Info() (VgpuTypeBar1Info, Return) {
-	var bar1Info VgpuTypeBar1Info
-	bar1Info.Version = STRUCT_VERSION(bar1Info, 1)
+	bar1Info := NewVgpuTypeBar1Info()
 	ret := nvmlVgpuTypeGetBAR1Info(vgpuTypeId, &bar1Info)
 	return bar1Info, ret
 }
@@ -499,8 +498,7 @@ func (l *library) VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (Vg
 }
 
 func (vgpuInstance nvmlVgpuInstance) GetRuntimeStateSize() (VgpuRuntimeState, Return) {
-	var pState VgpuRuntimeState
-	pState.Version = STRUCT_VERSION(pState, 1)
+	pState := NewVgpuRuntimeState()
 	ret := nvmlVgpuInstanceGetRuntimeStateSize(vgpuInstance, &pState)
 	return pState, ret
 }
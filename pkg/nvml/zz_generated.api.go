@@ -274,6 +274,7 @@ var (
 	DeviceSetPersistenceMode                         = libnvml.DeviceSetPersistenceMode
 	DeviceSetPowerManagementLimit                    = libnvml.DeviceSetPowerManagementLimit
 	DeviceSetPowerManagementLimit_v2                 = libnvml.DeviceSetPowerManagementLimit_v2
+	DeviceSetPowerMizerMode_v1                       = libnvml.DeviceSetPowerMizerMode_v1
 	DeviceSetTemperatureThreshold                    = libnvml.DeviceSetTemperatureThreshold
 	DeviceSetVgpuCapabilities                        = libnvml.DeviceSetVgpuCapabilities
 	DeviceSetVgpuHeterogeneousMode                   = libnvml.DeviceSetVgpuHeterogeneousMode
@@ -374,6 +375,7 @@ var (
 	VgpuInstanceGetLicenseStatus                     = libnvml.VgpuInstanceGetLicenseStatus
 	VgpuInstanceGetMdevUUID                          = libnvml.VgpuInstanceGetMdevUUID
 	VgpuInstanceGetMetadata                          = libnvml.VgpuInstanceGetMetadata
+	VgpuInstanceGetPlacementId                       = libnvml.VgpuInstanceGetPlacementId
 	VgpuInstanceGetRuntimeStateSize                  = libnvml.VgpuInstanceGetRuntimeStateSize
 	VgpuInstanceGetType                              = libnvml.VgpuInstanceGetType
 	VgpuInstanceGetUUID                              = libnvml.VgpuInstanceGetUUID
@@ -384,9 +386,11 @@ var (
 	VgpuTypeGetCapabilities                          = libnvml.VgpuTypeGetCapabilities
 	VgpuTypeGetClass                                 = libnvml.VgpuTypeGetClass
 	VgpuTypeGetDeviceID                              = libnvml.VgpuTypeGetDeviceID
+	VgpuTypeGetFbReservation                         = libnvml.VgpuTypeGetFbReservation
 	VgpuTypeGetFrameRateLimit                        = libnvml.VgpuTypeGetFrameRateLimit
 	VgpuTypeGetFramebufferSize                       = libnvml.VgpuTypeGetFramebufferSize
 	VgpuTypeGetGpuInstanceProfileId                  = libnvml.VgpuTypeGetGpuInstanceProfileId
+	VgpuTypeGetGspHeapSize                           = libnvml.VgpuTypeGetGspHeapSize
 	VgpuTypeGetLicense                               = libnvml.VgpuTypeGetLicense
 	VgpuTypeGetMaxInstances                          = libnvml.VgpuTypeGetMaxInstances
 	VgpuTypeGetMaxInstancesPerGpuInstance            = libnvml.VgpuTypeGetMaxInstancesPerGpuInstance
@@ -654,6 +658,7 @@ type Interface interface {
 	DeviceSetPersistenceMode(Device, EnableState) Return
 	DeviceSetPowerManagementLimit(Device, uint32) Return
 	DeviceSetPowerManagementLimit_v2(Device, *PowerValue_v2) Return
+	DeviceSetPowerMizerMode_v1(Device, *DevicePowerMizerModes_v1) Return
 	DeviceSetTemperatureThreshold(Device, TemperatureThresholds, int) Return
 	DeviceSetVgpuCapabilities(Device, DeviceVgpuCapability, EnableState) Return
 	DeviceSetVgpuHeterogeneousMode(Device, VgpuHeterogeneousMode) Return
@@ -754,6 +759,7 @@ type Interface interface {
 	VgpuInstanceGetLicenseStatus(VgpuInstance) (int, Return)
 	VgpuInstanceGetMdevUUID(VgpuInstance) (string, Return)
 	VgpuInstanceGetMetadata(VgpuInstance) (VgpuMetadata, Return)
+	VgpuInstanceGetPlacementId(VgpuInstance) (VgpuPlacementId, Return)
 	VgpuInstanceGetRuntimeStateSize(VgpuInstance) (VgpuRuntimeState, Return)
 	VgpuInstanceGetType(VgpuInstance) (VgpuTypeId, Return)
 	VgpuInstanceGetUUID(VgpuInstance) (string, Return)
@@ -764,9 +770,11 @@ type Interface interface {
 	VgpuTypeGetCapabilities(VgpuTypeId, VgpuCapability) (bool, Return)
 	VgpuTypeGetClass(VgpuTypeId) (string, Return)
 	VgpuTypeGetDeviceID(VgpuTypeId) (uint64, uint64, Return)
+	VgpuTypeGetFbReservation(VgpuTypeId) (uint64, Return)
 	VgpuTypeGetFrameRateLimit(VgpuTypeId) (uint32, Return)
 	VgpuTypeGetFramebufferSize(VgpuTypeId) (uint64, Return)
 	VgpuTypeGetGpuInstanceProfileId(VgpuTypeId) (uint32, Return)
+	VgpuTypeGetGspHeapSize(VgpuTypeId) (uint64, Return)
 	VgpuTypeGetLicense(VgpuTypeId) (string, Return)
 	VgpuTypeGetMaxInstances(Device, VgpuTypeId) (int, Return)
 	VgpuTypeGetMaxInstancesPerGpuInstance(*VgpuTypeMaxInstance) Return
@@ -1027,6 +1035,7 @@ type Device interface {
 	SetPersistenceMode(EnableState) Return
 	SetPowerManagementLimit(uint32) Return
 	SetPowerManagementLimit_v2(*PowerValue_v2) Return
+	SetPowerMizerMode_v1(*DevicePowerMizerModes_v1) Return
 	SetTemperatureThreshold(TemperatureThresholds, int) Return
 	SetVgpuCapabilities(DeviceVgpuCapability, EnableState) Return
 	SetVgpuHeterogeneousMode(VgpuHeterogeneousMode) Return
@@ -1124,6 +1133,7 @@ type VgpuInstance interface {
 	GetLicenseStatus() (int, Return)
 	GetMdevUUID() (string, Return)
 	GetMetadata() (VgpuMetadata, Return)
+	GetPlacementId() (VgpuPlacementId, Return)
 	GetRuntimeStateSize() (VgpuRuntimeState, Return)
 	GetType() (VgpuTypeId, Return)
 	GetUUID() (string, Return)
@@ -1141,9 +1151,11 @@ type VgpuTypeId interface {
 	GetClass() (string, Return)
 	GetCreatablePlacements(Device) (VgpuPlacementList, Return)
 	GetDeviceID() (uint64, uint64, Return)
+	GetFbReservation() (uint64, Return)
 	GetFrameRateLimit() (uint32, Return)
 	GetFramebufferSize() (uint64, Return)
 	GetGpuInstanceProfileId() (uint32, Return)
+	GetGspHeapSize() (uint64, Return)
 	GetLicense() (string, Return)
 	GetMaxInstances(Device) (int, Return)
 	GetMaxInstancesPerVm() (int, Return)
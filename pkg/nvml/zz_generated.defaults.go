@@ -0,0 +1,61 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// nvml.DeviceSetPowerMizerMode_v1()
+func (l *library) DeviceSetPowerMizerMode_v1(device Device, powerMizerMode *DevicePowerMizerModes_v1) Return {
+	return device.SetPowerMizerMode_v1(powerMizerMode)
+}
+
+func (device nvmlDevice) SetPowerMizerMode_v1(powerMizerMode *DevicePowerMizerModes_v1) Return {
+	return nvmlDeviceSetPowerMizerMode_v1(device, powerMizerMode)
+}
+
+// nvml.VgpuInstanceGetPlacementId()
+func (l *library) VgpuInstanceGetPlacementId(vgpuInstance VgpuInstance) (VgpuPlacementId, Return) {
+	return vgpuInstance.GetPlacementId()
+}
+
+func (vgpuInstance nvmlVgpuInstance) GetPlacementId() (VgpuPlacementId, Return) {
+	pPlacement := NewVgpuPlacementId()
+	ret := nvmlVgpuInstanceGetPlacementId(vgpuInstance, &pPlacement)
+	return pPlacement, ret
+}
+
+// nvml.VgpuTypeGetGspHeapSize()
+func (l *library) VgpuTypeGetGspHeapSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	return vgpuTypeId.GetGspHeapSize()
+}
+
+func (vgpuTypeId nvmlVgpuTypeId) GetGspHeapSize() (uint64, Return) {
+	var gspHeapSize uint64
+	ret := nvmlVgpuTypeGetGspHeapSize(vgpuTypeId, &gspHeapSize)
+	return gspHeapSize, ret
+}
+
+// nvml.VgpuTypeGetFbReservation()
+func (l *library) VgpuTypeGetFbReservation(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	return vgpuTypeId.GetFbReservation()
+}
+
+func (vgpuTypeId nvmlVgpuTypeId) GetFbReservation() (uint64, Return) {
+	var fbReservation uint64
+	ret := nvmlVgpuTypeGetFbReservation(vgpuTypeId, &fbReservation)
+	return fbReservation, ret
+}
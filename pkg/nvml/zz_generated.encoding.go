@@ -0,0 +1,4420 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// encodedPciInfoExt_v1 is the JSON and YAML representation of PciInfoExt_v1.
+type encodedPciInfoExt_v1 struct {
+	Version        uint32
+	Domain         uint32
+	Bus            uint32
+	Device         uint32
+	PciDeviceId    uint32
+	PciSubSystemId uint32
+	BaseClass      uint32
+	SubClass       uint32
+	BusId          string
+}
+
+func (s PciInfoExt_v1) encode() encodedPciInfoExt_v1 {
+	return encodedPciInfoExt_v1{
+		Version:        s.Version,
+		Domain:         s.Domain,
+		Bus:            s.Bus,
+		Device:         s.Device,
+		PciDeviceId:    s.PciDeviceId,
+		PciSubSystemId: s.PciSubSystemId,
+		BaseClass:      s.BaseClass,
+		SubClass:       s.SubClass,
+		BusId:          cString(s.BusId[:]),
+	}
+}
+
+func (e encodedPciInfoExt_v1) decode() (PciInfoExt_v1, error) {
+	var s PciInfoExt_v1
+	s.Version = e.Version
+	s.Domain = e.Domain
+	s.Bus = e.Bus
+	s.Device = e.Device
+	s.PciDeviceId = e.PciDeviceId
+	s.PciSubSystemId = e.PciSubSystemId
+	s.BaseClass = e.BaseClass
+	s.SubClass = e.SubClass
+	if err := setCString(s.BusId[:], e.BusId); err != nil {
+		return s, fmt.Errorf("invalid PciInfoExt_v1.BusId: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the PciInfoExt_v1 as described in encodedPciInfoExt_v1.
+func (s PciInfoExt_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PciInfoExt_v1) UnmarshalJSON(data []byte) error {
+	var e encodedPciInfoExt_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PciInfoExt_v1 as described in encodedPciInfoExt_v1.
+func (s PciInfoExt_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PciInfoExt_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPciInfoExt_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPciInfoExt is the JSON and YAML representation of PciInfoExt.
+type encodedPciInfoExt struct {
+	Version        uint32
+	Domain         uint32
+	Bus            uint32
+	Device         uint32
+	PciDeviceId    uint32
+	PciSubSystemId uint32
+	BaseClass      uint32
+	SubClass       uint32
+	BusId          string
+}
+
+func (s PciInfoExt) encode() encodedPciInfoExt {
+	return encodedPciInfoExt{
+		Version:        s.Version,
+		Domain:         s.Domain,
+		Bus:            s.Bus,
+		Device:         s.Device,
+		PciDeviceId:    s.PciDeviceId,
+		PciSubSystemId: s.PciSubSystemId,
+		BaseClass:      s.BaseClass,
+		SubClass:       s.SubClass,
+		BusId:          cString(s.BusId[:]),
+	}
+}
+
+func (e encodedPciInfoExt) decode() (PciInfoExt, error) {
+	var s PciInfoExt
+	s.Version = e.Version
+	s.Domain = e.Domain
+	s.Bus = e.Bus
+	s.Device = e.Device
+	s.PciDeviceId = e.PciDeviceId
+	s.PciSubSystemId = e.PciSubSystemId
+	s.BaseClass = e.BaseClass
+	s.SubClass = e.SubClass
+	if err := setCString(s.BusId[:], e.BusId); err != nil {
+		return s, fmt.Errorf("invalid PciInfoExt.BusId: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the PciInfoExt as described in encodedPciInfoExt.
+func (s PciInfoExt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PciInfoExt) UnmarshalJSON(data []byte) error {
+	var e encodedPciInfoExt
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PciInfoExt as described in encodedPciInfoExt.
+func (s PciInfoExt) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PciInfoExt) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPciInfoExt
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPciInfo is the JSON and YAML representation of PciInfo.
+type encodedPciInfo struct {
+	BusIdLegacy    string
+	Domain         uint32
+	Bus            uint32
+	Device         uint32
+	PciDeviceId    uint32
+	PciSubSystemId uint32
+	BusId          string
+}
+
+func (s PciInfo) encode() encodedPciInfo {
+	return encodedPciInfo{
+		BusIdLegacy:    cString(s.BusIdLegacy[:]),
+		Domain:         s.Domain,
+		Bus:            s.Bus,
+		Device:         s.Device,
+		PciDeviceId:    s.PciDeviceId,
+		PciSubSystemId: s.PciSubSystemId,
+		BusId:          cString(s.BusId[:]),
+	}
+}
+
+func (e encodedPciInfo) decode() (PciInfo, error) {
+	var s PciInfo
+	if err := setCString(s.BusIdLegacy[:], e.BusIdLegacy); err != nil {
+		return s, fmt.Errorf("invalid PciInfo.BusIdLegacy: %w", err)
+	}
+	s.Domain = e.Domain
+	s.Bus = e.Bus
+	s.Device = e.Device
+	s.PciDeviceId = e.PciDeviceId
+	s.PciSubSystemId = e.PciSubSystemId
+	if err := setCString(s.BusId[:], e.BusId); err != nil {
+		return s, fmt.Errorf("invalid PciInfo.BusId: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the PciInfo as described in encodedPciInfo.
+func (s PciInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PciInfo) UnmarshalJSON(data []byte) error {
+	var e encodedPciInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PciInfo as described in encodedPciInfo.
+func (s PciInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PciInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPciInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedSample is the JSON and YAML representation of Sample.
+type encodedSample struct {
+	TimeStamp   uint64
+	SampleValue byteArray
+}
+
+func (s Sample) encode() encodedSample {
+	return encodedSample{
+		TimeStamp:   s.TimeStamp,
+		SampleValue: s.SampleValue[:],
+	}
+}
+
+func (e encodedSample) decode() (Sample, error) {
+	var s Sample
+	s.TimeStamp = e.TimeStamp
+	if err := setBytes(s.SampleValue[:], e.SampleValue); err != nil {
+		return s, fmt.Errorf("invalid Sample.SampleValue: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the Sample as described in encodedSample.
+func (s Sample) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *Sample) UnmarshalJSON(data []byte) error {
+	var e encodedSample
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the Sample as described in encodedSample.
+func (s Sample) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *Sample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedSample
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedUUID_v1 is the JSON and YAML representation of UUID_v1.
+type encodedUUID_v1 struct {
+	Version uint32
+	Type    uint32
+	Value   byteArray
+}
+
+func (s UUID_v1) encode() encodedUUID_v1 {
+	return encodedUUID_v1{
+		Version: s.Version,
+		Type:    s.Type,
+		Value:   s.Value[:],
+	}
+}
+
+func (e encodedUUID_v1) decode() (UUID_v1, error) {
+	var s UUID_v1
+	s.Version = e.Version
+	s.Type = e.Type
+	if err := setBytes(s.Value[:], e.Value); err != nil {
+		return s, fmt.Errorf("invalid UUID_v1.Value: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the UUID_v1 as described in encodedUUID_v1.
+func (s UUID_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *UUID_v1) UnmarshalJSON(data []byte) error {
+	var e encodedUUID_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the UUID_v1 as described in encodedUUID_v1.
+func (s UUID_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *UUID_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedUUID_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedUUID is the JSON and YAML representation of UUID.
+type encodedUUID struct {
+	Version uint32
+	Type    uint32
+	Value   byteArray
+}
+
+func (s UUID) encode() encodedUUID {
+	return encodedUUID{
+		Version: s.Version,
+		Type:    s.Type,
+		Value:   s.Value[:],
+	}
+}
+
+func (e encodedUUID) decode() (UUID, error) {
+	var s UUID
+	s.Version = e.Version
+	s.Type = e.Type
+	if err := setBytes(s.Value[:], e.Value); err != nil {
+		return s, fmt.Errorf("invalid UUID.Value: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the UUID as described in encodedUUID.
+func (s UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *UUID) UnmarshalJSON(data []byte) error {
+	var e encodedUUID
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the UUID as described in encodedUUID.
+func (s UUID) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *UUID) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedUUID
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedDevicePerfModes_v1 is the JSON and YAML representation of DevicePerfModes_v1.
+type encodedDevicePerfModes_v1 struct {
+	Version uint32
+	Str     string
+}
+
+func (s DevicePerfModes_v1) encode() encodedDevicePerfModes_v1 {
+	return encodedDevicePerfModes_v1{
+		Version: s.Version,
+		Str:     cString(s.Str[:]),
+	}
+}
+
+func (e encodedDevicePerfModes_v1) decode() (DevicePerfModes_v1, error) {
+	var s DevicePerfModes_v1
+	s.Version = e.Version
+	if err := setCString(s.Str[:], e.Str); err != nil {
+		return s, fmt.Errorf("invalid DevicePerfModes_v1.Str: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the DevicePerfModes_v1 as described in encodedDevicePerfModes_v1.
+func (s DevicePerfModes_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *DevicePerfModes_v1) UnmarshalJSON(data []byte) error {
+	var e encodedDevicePerfModes_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the DevicePerfModes_v1 as described in encodedDevicePerfModes_v1.
+func (s DevicePerfModes_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *DevicePerfModes_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedDevicePerfModes_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedDevicePerfModes is the JSON and YAML representation of DevicePerfModes.
+type encodedDevicePerfModes struct {
+	Version uint32
+	Str     string
+}
+
+func (s DevicePerfModes) encode() encodedDevicePerfModes {
+	return encodedDevicePerfModes{
+		Version: s.Version,
+		Str:     cString(s.Str[:]),
+	}
+}
+
+func (e encodedDevicePerfModes) decode() (DevicePerfModes, error) {
+	var s DevicePerfModes
+	s.Version = e.Version
+	if err := setCString(s.Str[:], e.Str); err != nil {
+		return s, fmt.Errorf("invalid DevicePerfModes.Str: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the DevicePerfModes as described in encodedDevicePerfModes.
+func (s DevicePerfModes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *DevicePerfModes) UnmarshalJSON(data []byte) error {
+	var e encodedDevicePerfModes
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the DevicePerfModes as described in encodedDevicePerfModes.
+func (s DevicePerfModes) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *DevicePerfModes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedDevicePerfModes
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedDeviceCurrentClockFreqs_v1 is the JSON and YAML representation of DeviceCurrentClockFreqs_v1.
+type encodedDeviceCurrentClockFreqs_v1 struct {
+	Version uint32
+	Str     string
+}
+
+func (s DeviceCurrentClockFreqs_v1) encode() encodedDeviceCurrentClockFreqs_v1 {
+	return encodedDeviceCurrentClockFreqs_v1{
+		Version: s.Version,
+		Str:     cString(s.Str[:]),
+	}
+}
+
+func (e encodedDeviceCurrentClockFreqs_v1) decode() (DeviceCurrentClockFreqs_v1, error) {
+	var s DeviceCurrentClockFreqs_v1
+	s.Version = e.Version
+	if err := setCString(s.Str[:], e.Str); err != nil {
+		return s, fmt.Errorf("invalid DeviceCurrentClockFreqs_v1.Str: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the DeviceCurrentClockFreqs_v1 as described in encodedDeviceCurrentClockFreqs_v1.
+func (s DeviceCurrentClockFreqs_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *DeviceCurrentClockFreqs_v1) UnmarshalJSON(data []byte) error {
+	var e encodedDeviceCurrentClockFreqs_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the DeviceCurrentClockFreqs_v1 as described in encodedDeviceCurrentClockFreqs_v1.
+func (s DeviceCurrentClockFreqs_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *DeviceCurrentClockFreqs_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedDeviceCurrentClockFreqs_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedDeviceCurrentClockFreqs is the JSON and YAML representation of DeviceCurrentClockFreqs.
+type encodedDeviceCurrentClockFreqs struct {
+	Version uint32
+	Str     string
+}
+
+func (s DeviceCurrentClockFreqs) encode() encodedDeviceCurrentClockFreqs {
+	return encodedDeviceCurrentClockFreqs{
+		Version: s.Version,
+		Str:     cString(s.Str[:]),
+	}
+}
+
+func (e encodedDeviceCurrentClockFreqs) decode() (DeviceCurrentClockFreqs, error) {
+	var s DeviceCurrentClockFreqs
+	s.Version = e.Version
+	if err := setCString(s.Str[:], e.Str); err != nil {
+		return s, fmt.Errorf("invalid DeviceCurrentClockFreqs.Str: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the DeviceCurrentClockFreqs as described in encodedDeviceCurrentClockFreqs.
+func (s DeviceCurrentClockFreqs) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *DeviceCurrentClockFreqs) UnmarshalJSON(data []byte) error {
+	var e encodedDeviceCurrentClockFreqs
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the DeviceCurrentClockFreqs as described in encodedDeviceCurrentClockFreqs.
+func (s DeviceCurrentClockFreqs) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *DeviceCurrentClockFreqs) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedDeviceCurrentClockFreqs
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedProcessUtilizationInfo_v1 is the JSON and YAML representation of ProcessUtilizationInfo_v1.
+type encodedProcessUtilizationInfo_v1 struct {
+	TimeStamp uint64
+	Pid       uint32
+	SmUtil    uint32
+	MemUtil   uint32
+	EncUtil   uint32
+	DecUtil   uint32
+	JpgUtil   uint32
+	OfaUtil   uint32
+}
+
+func (s ProcessUtilizationInfo_v1) encode() encodedProcessUtilizationInfo_v1 {
+	return encodedProcessUtilizationInfo_v1{
+		TimeStamp: s.TimeStamp,
+		Pid:       s.Pid,
+		SmUtil:    s.SmUtil,
+		MemUtil:   s.MemUtil,
+		EncUtil:   s.EncUtil,
+		DecUtil:   s.DecUtil,
+		JpgUtil:   s.JpgUtil,
+		OfaUtil:   s.OfaUtil,
+	}
+}
+
+func (e encodedProcessUtilizationInfo_v1) decode() (ProcessUtilizationInfo_v1, error) {
+	var s ProcessUtilizationInfo_v1
+	s.TimeStamp = e.TimeStamp
+	s.Pid = e.Pid
+	s.SmUtil = e.SmUtil
+	s.MemUtil = e.MemUtil
+	s.EncUtil = e.EncUtil
+	s.DecUtil = e.DecUtil
+	s.JpgUtil = e.JpgUtil
+	s.OfaUtil = e.OfaUtil
+	return s, nil
+}
+
+// MarshalJSON encodes the ProcessUtilizationInfo_v1 as described in encodedProcessUtilizationInfo_v1.
+func (s ProcessUtilizationInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ProcessUtilizationInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedProcessUtilizationInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ProcessUtilizationInfo_v1 as described in encodedProcessUtilizationInfo_v1.
+func (s ProcessUtilizationInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ProcessUtilizationInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedProcessUtilizationInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedEccSramErrorStatus_v1 is the JSON and YAML representation of EccSramErrorStatus_v1.
+type encodedEccSramErrorStatus_v1 struct {
+	Version                 uint32
+	AggregateUncParity      uint64
+	AggregateUncSecDed      uint64
+	AggregateCor            uint64
+	VolatileUncParity       uint64
+	VolatileUncSecDed       uint64
+	VolatileCor             uint64
+	AggregateUncBucketL2    uint64
+	AggregateUncBucketSm    uint64
+	AggregateUncBucketPcie  uint64
+	AggregateUncBucketMcu   uint64
+	AggregateUncBucketOther uint64
+	BThresholdExceeded      uint32
+}
+
+func (s EccSramErrorStatus_v1) encode() encodedEccSramErrorStatus_v1 {
+	return encodedEccSramErrorStatus_v1{
+		Version:                 s.Version,
+		AggregateUncParity:      s.AggregateUncParity,
+		AggregateUncSecDed:      s.AggregateUncSecDed,
+		AggregateCor:            s.AggregateCor,
+		VolatileUncParity:       s.VolatileUncParity,
+		VolatileUncSecDed:       s.VolatileUncSecDed,
+		VolatileCor:             s.VolatileCor,
+		AggregateUncBucketL2:    s.AggregateUncBucketL2,
+		AggregateUncBucketSm:    s.AggregateUncBucketSm,
+		AggregateUncBucketPcie:  s.AggregateUncBucketPcie,
+		AggregateUncBucketMcu:   s.AggregateUncBucketMcu,
+		AggregateUncBucketOther: s.AggregateUncBucketOther,
+		BThresholdExceeded:      s.BThresholdExceeded,
+	}
+}
+
+func (e encodedEccSramErrorStatus_v1) decode() (EccSramErrorStatus_v1, error) {
+	var s EccSramErrorStatus_v1
+	s.Version = e.Version
+	s.AggregateUncParity = e.AggregateUncParity
+	s.AggregateUncSecDed = e.AggregateUncSecDed
+	s.AggregateCor = e.AggregateCor
+	s.VolatileUncParity = e.VolatileUncParity
+	s.VolatileUncSecDed = e.VolatileUncSecDed
+	s.VolatileCor = e.VolatileCor
+	s.AggregateUncBucketL2 = e.AggregateUncBucketL2
+	s.AggregateUncBucketSm = e.AggregateUncBucketSm
+	s.AggregateUncBucketPcie = e.AggregateUncBucketPcie
+	s.AggregateUncBucketMcu = e.AggregateUncBucketMcu
+	s.AggregateUncBucketOther = e.AggregateUncBucketOther
+	s.BThresholdExceeded = e.BThresholdExceeded
+	return s, nil
+}
+
+// MarshalJSON encodes the EccSramErrorStatus_v1 as described in encodedEccSramErrorStatus_v1.
+func (s EccSramErrorStatus_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *EccSramErrorStatus_v1) UnmarshalJSON(data []byte) error {
+	var e encodedEccSramErrorStatus_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the EccSramErrorStatus_v1 as described in encodedEccSramErrorStatus_v1.
+func (s EccSramErrorStatus_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *EccSramErrorStatus_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedEccSramErrorStatus_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedEccSramErrorStatus is the JSON and YAML representation of EccSramErrorStatus.
+type encodedEccSramErrorStatus struct {
+	Version                 uint32
+	AggregateUncParity      uint64
+	AggregateUncSecDed      uint64
+	AggregateCor            uint64
+	VolatileUncParity       uint64
+	VolatileUncSecDed       uint64
+	VolatileCor             uint64
+	AggregateUncBucketL2    uint64
+	AggregateUncBucketSm    uint64
+	AggregateUncBucketPcie  uint64
+	AggregateUncBucketMcu   uint64
+	AggregateUncBucketOther uint64
+	BThresholdExceeded      uint32
+}
+
+func (s EccSramErrorStatus) encode() encodedEccSramErrorStatus {
+	return encodedEccSramErrorStatus{
+		Version:                 s.Version,
+		AggregateUncParity:      s.AggregateUncParity,
+		AggregateUncSecDed:      s.AggregateUncSecDed,
+		AggregateCor:            s.AggregateCor,
+		VolatileUncParity:       s.VolatileUncParity,
+		VolatileUncSecDed:       s.VolatileUncSecDed,
+		VolatileCor:             s.VolatileCor,
+		AggregateUncBucketL2:    s.AggregateUncBucketL2,
+		AggregateUncBucketSm:    s.AggregateUncBucketSm,
+		AggregateUncBucketPcie:  s.AggregateUncBucketPcie,
+		AggregateUncBucketMcu:   s.AggregateUncBucketMcu,
+		AggregateUncBucketOther: s.AggregateUncBucketOther,
+		BThresholdExceeded:      s.BThresholdExceeded,
+	}
+}
+
+func (e encodedEccSramErrorStatus) decode() (EccSramErrorStatus, error) {
+	var s EccSramErrorStatus
+	s.Version = e.Version
+	s.AggregateUncParity = e.AggregateUncParity
+	s.AggregateUncSecDed = e.AggregateUncSecDed
+	s.AggregateCor = e.AggregateCor
+	s.VolatileUncParity = e.VolatileUncParity
+	s.VolatileUncSecDed = e.VolatileUncSecDed
+	s.VolatileCor = e.VolatileCor
+	s.AggregateUncBucketL2 = e.AggregateUncBucketL2
+	s.AggregateUncBucketSm = e.AggregateUncBucketSm
+	s.AggregateUncBucketPcie = e.AggregateUncBucketPcie
+	s.AggregateUncBucketMcu = e.AggregateUncBucketMcu
+	s.AggregateUncBucketOther = e.AggregateUncBucketOther
+	s.BThresholdExceeded = e.BThresholdExceeded
+	return s, nil
+}
+
+// MarshalJSON encodes the EccSramErrorStatus as described in encodedEccSramErrorStatus.
+func (s EccSramErrorStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *EccSramErrorStatus) UnmarshalJSON(data []byte) error {
+	var e encodedEccSramErrorStatus
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the EccSramErrorStatus as described in encodedEccSramErrorStatus.
+func (s EccSramErrorStatus) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *EccSramErrorStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedEccSramErrorStatus
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPlatformInfo_v1 is the JSON and YAML representation of PlatformInfo_v1.
+type encodedPlatformInfo_v1 struct {
+	Version                   uint32
+	IbGuid                    byteArray
+	RackGuid                  byteArray
+	ChassisPhysicalSlotNumber uint8
+	ComputeSlotIndex          uint8
+	NodeIndex                 uint8
+	PeerType                  uint8
+	ModuleId                  uint8
+}
+
+func (s PlatformInfo_v1) encode() encodedPlatformInfo_v1 {
+	return encodedPlatformInfo_v1{
+		Version:                   s.Version,
+		IbGuid:                    s.IbGuid[:],
+		RackGuid:                  s.RackGuid[:],
+		ChassisPhysicalSlotNumber: s.ChassisPhysicalSlotNumber,
+		ComputeSlotIndex:          s.ComputeSlotIndex,
+		NodeIndex:                 s.NodeIndex,
+		PeerType:                  s.PeerType,
+		ModuleId:                  s.ModuleId,
+	}
+}
+
+func (e encodedPlatformInfo_v1) decode() (PlatformInfo_v1, error) {
+	var s PlatformInfo_v1
+	s.Version = e.Version
+	if err := setBytes(s.IbGuid[:], e.IbGuid); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo_v1.IbGuid: %w", err)
+	}
+	if err := setBytes(s.RackGuid[:], e.RackGuid); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo_v1.RackGuid: %w", err)
+	}
+	s.ChassisPhysicalSlotNumber = e.ChassisPhysicalSlotNumber
+	s.ComputeSlotIndex = e.ComputeSlotIndex
+	s.NodeIndex = e.NodeIndex
+	s.PeerType = e.PeerType
+	s.ModuleId = e.ModuleId
+	return s, nil
+}
+
+// MarshalJSON encodes the PlatformInfo_v1 as described in encodedPlatformInfo_v1.
+func (s PlatformInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PlatformInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedPlatformInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PlatformInfo_v1 as described in encodedPlatformInfo_v1.
+func (s PlatformInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PlatformInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPlatformInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPlatformInfo_v2 is the JSON and YAML representation of PlatformInfo_v2.
+type encodedPlatformInfo_v2 struct {
+	Version             uint32
+	IbGuid              byteArray
+	ChassisSerialNumber byteArray
+	SlotNumber          uint8
+	TrayIndex           uint8
+	HostId              uint8
+	PeerType            uint8
+	ModuleId            uint8
+}
+
+func (s PlatformInfo_v2) encode() encodedPlatformInfo_v2 {
+	return encodedPlatformInfo_v2{
+		Version:             s.Version,
+		IbGuid:              s.IbGuid[:],
+		ChassisSerialNumber: s.ChassisSerialNumber[:],
+		SlotNumber:          s.SlotNumber,
+		TrayIndex:           s.TrayIndex,
+		HostId:              s.HostId,
+		PeerType:            s.PeerType,
+		ModuleId:            s.ModuleId,
+	}
+}
+
+func (e encodedPlatformInfo_v2) decode() (PlatformInfo_v2, error) {
+	var s PlatformInfo_v2
+	s.Version = e.Version
+	if err := setBytes(s.IbGuid[:], e.IbGuid); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo_v2.IbGuid: %w", err)
+	}
+	if err := setBytes(s.ChassisSerialNumber[:], e.ChassisSerialNumber); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo_v2.ChassisSerialNumber: %w", err)
+	}
+	s.SlotNumber = e.SlotNumber
+	s.TrayIndex = e.TrayIndex
+	s.HostId = e.HostId
+	s.PeerType = e.PeerType
+	s.ModuleId = e.ModuleId
+	return s, nil
+}
+
+// MarshalJSON encodes the PlatformInfo_v2 as described in encodedPlatformInfo_v2.
+func (s PlatformInfo_v2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PlatformInfo_v2) UnmarshalJSON(data []byte) error {
+	var e encodedPlatformInfo_v2
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PlatformInfo_v2 as described in encodedPlatformInfo_v2.
+func (s PlatformInfo_v2) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PlatformInfo_v2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPlatformInfo_v2
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPlatformInfo is the JSON and YAML representation of PlatformInfo.
+type encodedPlatformInfo struct {
+	Version             uint32
+	IbGuid              byteArray
+	ChassisSerialNumber byteArray
+	SlotNumber          uint8
+	TrayIndex           uint8
+	HostId              uint8
+	PeerType            uint8
+	ModuleId            uint8
+}
+
+func (s PlatformInfo) encode() encodedPlatformInfo {
+	return encodedPlatformInfo{
+		Version:             s.Version,
+		IbGuid:              s.IbGuid[:],
+		ChassisSerialNumber: s.ChassisSerialNumber[:],
+		SlotNumber:          s.SlotNumber,
+		TrayIndex:           s.TrayIndex,
+		HostId:              s.HostId,
+		PeerType:            s.PeerType,
+		ModuleId:            s.ModuleId,
+	}
+}
+
+func (e encodedPlatformInfo) decode() (PlatformInfo, error) {
+	var s PlatformInfo
+	s.Version = e.Version
+	if err := setBytes(s.IbGuid[:], e.IbGuid); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo.IbGuid: %w", err)
+	}
+	if err := setBytes(s.ChassisSerialNumber[:], e.ChassisSerialNumber); err != nil {
+		return s, fmt.Errorf("invalid PlatformInfo.ChassisSerialNumber: %w", err)
+	}
+	s.SlotNumber = e.SlotNumber
+	s.TrayIndex = e.TrayIndex
+	s.HostId = e.HostId
+	s.PeerType = e.PeerType
+	s.ModuleId = e.ModuleId
+	return s, nil
+}
+
+// MarshalJSON encodes the PlatformInfo as described in encodedPlatformInfo.
+func (s PlatformInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PlatformInfo) UnmarshalJSON(data []byte) error {
+	var e encodedPlatformInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PlatformInfo as described in encodedPlatformInfo.
+func (s PlatformInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PlatformInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPlatformInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuPlacementList_v2 is the JSON and YAML representation of VgpuPlacementList_v2.
+type encodedVgpuPlacementList_v2 struct {
+	Version       uint32
+	PlacementSize uint32
+	Count         uint32
+	PlacementIds  *uint32
+	Mode          uint32
+}
+
+func (s VgpuPlacementList_v2) encode() encodedVgpuPlacementList_v2 {
+	return encodedVgpuPlacementList_v2{
+		Version:       s.Version,
+		PlacementSize: s.PlacementSize,
+		Count:         s.Count,
+		PlacementIds:  s.PlacementIds,
+		Mode:          s.Mode,
+	}
+}
+
+func (e encodedVgpuPlacementList_v2) decode() (VgpuPlacementList_v2, error) {
+	var s VgpuPlacementList_v2
+	s.Version = e.Version
+	s.PlacementSize = e.PlacementSize
+	s.Count = e.Count
+	s.PlacementIds = e.PlacementIds
+	s.Mode = e.Mode
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuPlacementList_v2 as described in encodedVgpuPlacementList_v2.
+func (s VgpuPlacementList_v2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuPlacementList_v2) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuPlacementList_v2
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuPlacementList_v2 as described in encodedVgpuPlacementList_v2.
+func (s VgpuPlacementList_v2) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuPlacementList_v2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuPlacementList_v2
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuPlacementList is the JSON and YAML representation of VgpuPlacementList.
+type encodedVgpuPlacementList struct {
+	Version       uint32
+	PlacementSize uint32
+	Count         uint32
+	PlacementIds  *uint32
+	Mode          uint32
+}
+
+func (s VgpuPlacementList) encode() encodedVgpuPlacementList {
+	return encodedVgpuPlacementList{
+		Version:       s.Version,
+		PlacementSize: s.PlacementSize,
+		Count:         s.Count,
+		PlacementIds:  s.PlacementIds,
+		Mode:          s.Mode,
+	}
+}
+
+func (e encodedVgpuPlacementList) decode() (VgpuPlacementList, error) {
+	var s VgpuPlacementList
+	s.Version = e.Version
+	s.PlacementSize = e.PlacementSize
+	s.Count = e.Count
+	s.PlacementIds = e.PlacementIds
+	s.Mode = e.Mode
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuPlacementList as described in encodedVgpuPlacementList.
+func (s VgpuPlacementList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuPlacementList) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuPlacementList
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuPlacementList as described in encodedVgpuPlacementList.
+func (s VgpuPlacementList) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuPlacementList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuPlacementList
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuInstanceUtilizationSample is the JSON and YAML representation of VgpuInstanceUtilizationSample.
+type encodedVgpuInstanceUtilizationSample struct {
+	VgpuInstance uint32
+	TimeStamp    uint64
+	SmUtil       byteArray
+	MemUtil      byteArray
+	EncUtil      byteArray
+	DecUtil      byteArray
+}
+
+func (s VgpuInstanceUtilizationSample) encode() encodedVgpuInstanceUtilizationSample {
+	return encodedVgpuInstanceUtilizationSample{
+		VgpuInstance: s.VgpuInstance,
+		TimeStamp:    s.TimeStamp,
+		SmUtil:       s.SmUtil[:],
+		MemUtil:      s.MemUtil[:],
+		EncUtil:      s.EncUtil[:],
+		DecUtil:      s.DecUtil[:],
+	}
+}
+
+func (e encodedVgpuInstanceUtilizationSample) decode() (VgpuInstanceUtilizationSample, error) {
+	var s VgpuInstanceUtilizationSample
+	s.VgpuInstance = e.VgpuInstance
+	s.TimeStamp = e.TimeStamp
+	if err := setBytes(s.SmUtil[:], e.SmUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationSample.SmUtil: %w", err)
+	}
+	if err := setBytes(s.MemUtil[:], e.MemUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationSample.MemUtil: %w", err)
+	}
+	if err := setBytes(s.EncUtil[:], e.EncUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationSample.EncUtil: %w", err)
+	}
+	if err := setBytes(s.DecUtil[:], e.DecUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationSample.DecUtil: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuInstanceUtilizationSample as described in encodedVgpuInstanceUtilizationSample.
+func (s VgpuInstanceUtilizationSample) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuInstanceUtilizationSample) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuInstanceUtilizationSample
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuInstanceUtilizationSample as described in encodedVgpuInstanceUtilizationSample.
+func (s VgpuInstanceUtilizationSample) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuInstanceUtilizationSample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuInstanceUtilizationSample
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuInstanceUtilizationInfo_v1 is the JSON and YAML representation of VgpuInstanceUtilizationInfo_v1.
+type encodedVgpuInstanceUtilizationInfo_v1 struct {
+	TimeStamp    uint64
+	VgpuInstance uint32
+	SmUtil       byteArray
+	MemUtil      byteArray
+	EncUtil      byteArray
+	DecUtil      byteArray
+	JpgUtil      byteArray
+	OfaUtil      byteArray
+}
+
+func (s VgpuInstanceUtilizationInfo_v1) encode() encodedVgpuInstanceUtilizationInfo_v1 {
+	return encodedVgpuInstanceUtilizationInfo_v1{
+		TimeStamp:    s.TimeStamp,
+		VgpuInstance: s.VgpuInstance,
+		SmUtil:       s.SmUtil[:],
+		MemUtil:      s.MemUtil[:],
+		EncUtil:      s.EncUtil[:],
+		DecUtil:      s.DecUtil[:],
+		JpgUtil:      s.JpgUtil[:],
+		OfaUtil:      s.OfaUtil[:],
+	}
+}
+
+func (e encodedVgpuInstanceUtilizationInfo_v1) decode() (VgpuInstanceUtilizationInfo_v1, error) {
+	var s VgpuInstanceUtilizationInfo_v1
+	s.TimeStamp = e.TimeStamp
+	s.VgpuInstance = e.VgpuInstance
+	if err := setBytes(s.SmUtil[:], e.SmUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.SmUtil: %w", err)
+	}
+	if err := setBytes(s.MemUtil[:], e.MemUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.MemUtil: %w", err)
+	}
+	if err := setBytes(s.EncUtil[:], e.EncUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.EncUtil: %w", err)
+	}
+	if err := setBytes(s.DecUtil[:], e.DecUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.DecUtil: %w", err)
+	}
+	if err := setBytes(s.JpgUtil[:], e.JpgUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.JpgUtil: %w", err)
+	}
+	if err := setBytes(s.OfaUtil[:], e.OfaUtil); err != nil {
+		return s, fmt.Errorf("invalid VgpuInstanceUtilizationInfo_v1.OfaUtil: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuInstanceUtilizationInfo_v1 as described in encodedVgpuInstanceUtilizationInfo_v1.
+func (s VgpuInstanceUtilizationInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuInstanceUtilizationInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuInstanceUtilizationInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuInstanceUtilizationInfo_v1 as described in encodedVgpuInstanceUtilizationInfo_v1.
+func (s VgpuInstanceUtilizationInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuInstanceUtilizationInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuInstanceUtilizationInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuProcessUtilizationSample is the JSON and YAML representation of VgpuProcessUtilizationSample.
+type encodedVgpuProcessUtilizationSample struct {
+	VgpuInstance uint32
+	Pid          uint32
+	ProcessName  string
+	TimeStamp    uint64
+	SmUtil       uint32
+	MemUtil      uint32
+	EncUtil      uint32
+	DecUtil      uint32
+}
+
+func (s VgpuProcessUtilizationSample) encode() encodedVgpuProcessUtilizationSample {
+	return encodedVgpuProcessUtilizationSample{
+		VgpuInstance: s.VgpuInstance,
+		Pid:          s.Pid,
+		ProcessName:  cString(s.ProcessName[:]),
+		TimeStamp:    s.TimeStamp,
+		SmUtil:       s.SmUtil,
+		MemUtil:      s.MemUtil,
+		EncUtil:      s.EncUtil,
+		DecUtil:      s.DecUtil,
+	}
+}
+
+func (e encodedVgpuProcessUtilizationSample) decode() (VgpuProcessUtilizationSample, error) {
+	var s VgpuProcessUtilizationSample
+	s.VgpuInstance = e.VgpuInstance
+	s.Pid = e.Pid
+	if err := setCString(s.ProcessName[:], e.ProcessName); err != nil {
+		return s, fmt.Errorf("invalid VgpuProcessUtilizationSample.ProcessName: %w", err)
+	}
+	s.TimeStamp = e.TimeStamp
+	s.SmUtil = e.SmUtil
+	s.MemUtil = e.MemUtil
+	s.EncUtil = e.EncUtil
+	s.DecUtil = e.DecUtil
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuProcessUtilizationSample as described in encodedVgpuProcessUtilizationSample.
+func (s VgpuProcessUtilizationSample) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuProcessUtilizationSample) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuProcessUtilizationSample
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuProcessUtilizationSample as described in encodedVgpuProcessUtilizationSample.
+func (s VgpuProcessUtilizationSample) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuProcessUtilizationSample) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuProcessUtilizationSample
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuProcessUtilizationInfo_v1 is the JSON and YAML representation of VgpuProcessUtilizationInfo_v1.
+type encodedVgpuProcessUtilizationInfo_v1 struct {
+	ProcessName  string
+	TimeStamp    uint64
+	VgpuInstance uint32
+	Pid          uint32
+	SmUtil       uint32
+	MemUtil      uint32
+	EncUtil      uint32
+	DecUtil      uint32
+	JpgUtil      uint32
+	OfaUtil      uint32
+}
+
+func (s VgpuProcessUtilizationInfo_v1) encode() encodedVgpuProcessUtilizationInfo_v1 {
+	return encodedVgpuProcessUtilizationInfo_v1{
+		ProcessName:  cString(s.ProcessName[:]),
+		TimeStamp:    s.TimeStamp,
+		VgpuInstance: s.VgpuInstance,
+		Pid:          s.Pid,
+		SmUtil:       s.SmUtil,
+		MemUtil:      s.MemUtil,
+		EncUtil:      s.EncUtil,
+		DecUtil:      s.DecUtil,
+		JpgUtil:      s.JpgUtil,
+		OfaUtil:      s.OfaUtil,
+	}
+}
+
+func (e encodedVgpuProcessUtilizationInfo_v1) decode() (VgpuProcessUtilizationInfo_v1, error) {
+	var s VgpuProcessUtilizationInfo_v1
+	if err := setCString(s.ProcessName[:], e.ProcessName); err != nil {
+		return s, fmt.Errorf("invalid VgpuProcessUtilizationInfo_v1.ProcessName: %w", err)
+	}
+	s.TimeStamp = e.TimeStamp
+	s.VgpuInstance = e.VgpuInstance
+	s.Pid = e.Pid
+	s.SmUtil = e.SmUtil
+	s.MemUtil = e.MemUtil
+	s.EncUtil = e.EncUtil
+	s.DecUtil = e.DecUtil
+	s.JpgUtil = e.JpgUtil
+	s.OfaUtil = e.OfaUtil
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuProcessUtilizationInfo_v1 as described in encodedVgpuProcessUtilizationInfo_v1.
+func (s VgpuProcessUtilizationInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuProcessUtilizationInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuProcessUtilizationInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuProcessUtilizationInfo_v1 as described in encodedVgpuProcessUtilizationInfo_v1.
+func (s VgpuProcessUtilizationInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuProcessUtilizationInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuProcessUtilizationInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerLog is the JSON and YAML representation of VgpuSchedulerLog.
+type encodedVgpuSchedulerLog struct {
+	EngineId        uint32
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+	EntriesCount    uint32
+	LogEntries      [200]VgpuSchedulerLogEntry
+}
+
+func (s VgpuSchedulerLog) encode() encodedVgpuSchedulerLog {
+	return encodedVgpuSchedulerLog{
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+		EntriesCount:    s.EntriesCount,
+		LogEntries:      s.LogEntries,
+	}
+}
+
+func (e encodedVgpuSchedulerLog) decode() (VgpuSchedulerLog, error) {
+	var s VgpuSchedulerLog
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerLog.SchedulerParams: %w", err)
+	}
+	s.EntriesCount = e.EntriesCount
+	s.LogEntries = e.LogEntries
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerLog as described in encodedVgpuSchedulerLog.
+func (s VgpuSchedulerLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerLog) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerLog
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerLog as described in encodedVgpuSchedulerLog.
+func (s VgpuSchedulerLog) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerLog) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerLog
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerGetState is the JSON and YAML representation of VgpuSchedulerGetState.
+type encodedVgpuSchedulerGetState struct {
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerGetState) encode() encodedVgpuSchedulerGetState {
+	return encodedVgpuSchedulerGetState{
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerGetState) decode() (VgpuSchedulerGetState, error) {
+	var s VgpuSchedulerGetState
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerGetState.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerGetState as described in encodedVgpuSchedulerGetState.
+func (s VgpuSchedulerGetState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerGetState) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerGetState
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerGetState as described in encodedVgpuSchedulerGetState.
+func (s VgpuSchedulerGetState) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerGetState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerGetState
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerSetState is the JSON and YAML representation of VgpuSchedulerSetState.
+type encodedVgpuSchedulerSetState struct {
+	SchedulerPolicy uint32
+	EnableARRMode   uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerSetState) encode() encodedVgpuSchedulerSetState {
+	return encodedVgpuSchedulerSetState{
+		SchedulerPolicy: s.SchedulerPolicy,
+		EnableARRMode:   s.EnableARRMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerSetState) decode() (VgpuSchedulerSetState, error) {
+	var s VgpuSchedulerSetState
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.EnableARRMode = e.EnableARRMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerSetState.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerSetState as described in encodedVgpuSchedulerSetState.
+func (s VgpuSchedulerSetState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerSetState) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerSetState
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerSetState as described in encodedVgpuSchedulerSetState.
+func (s VgpuSchedulerSetState) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerSetState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerSetState
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuLicenseExpiry is the JSON and YAML representation of VgpuLicenseExpiry.
+type encodedVgpuLicenseExpiry struct {
+	Year   uint32
+	Month  uint16
+	Day    uint16
+	Hour   uint16
+	Min    uint16
+	Sec    uint16
+	Status uint8
+}
+
+func (s VgpuLicenseExpiry) encode() encodedVgpuLicenseExpiry {
+	return encodedVgpuLicenseExpiry{
+		Year:   s.Year,
+		Month:  s.Month,
+		Day:    s.Day,
+		Hour:   s.Hour,
+		Min:    s.Min,
+		Sec:    s.Sec,
+		Status: s.Status,
+	}
+}
+
+func (e encodedVgpuLicenseExpiry) decode() (VgpuLicenseExpiry, error) {
+	var s VgpuLicenseExpiry
+	s.Year = e.Year
+	s.Month = e.Month
+	s.Day = e.Day
+	s.Hour = e.Hour
+	s.Min = e.Min
+	s.Sec = e.Sec
+	s.Status = e.Status
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuLicenseExpiry as described in encodedVgpuLicenseExpiry.
+func (s VgpuLicenseExpiry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuLicenseExpiry) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuLicenseExpiry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuLicenseExpiry as described in encodedVgpuLicenseExpiry.
+func (s VgpuLicenseExpiry) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuLicenseExpiry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuLicenseExpiry
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGridLicenseExpiry is the JSON and YAML representation of GridLicenseExpiry.
+type encodedGridLicenseExpiry struct {
+	Year   uint32
+	Month  uint16
+	Day    uint16
+	Hour   uint16
+	Min    uint16
+	Sec    uint16
+	Status uint8
+}
+
+func (s GridLicenseExpiry) encode() encodedGridLicenseExpiry {
+	return encodedGridLicenseExpiry{
+		Year:   s.Year,
+		Month:  s.Month,
+		Day:    s.Day,
+		Hour:   s.Hour,
+		Min:    s.Min,
+		Sec:    s.Sec,
+		Status: s.Status,
+	}
+}
+
+func (e encodedGridLicenseExpiry) decode() (GridLicenseExpiry, error) {
+	var s GridLicenseExpiry
+	s.Year = e.Year
+	s.Month = e.Month
+	s.Day = e.Day
+	s.Hour = e.Hour
+	s.Min = e.Min
+	s.Sec = e.Sec
+	s.Status = e.Status
+	return s, nil
+}
+
+// MarshalJSON encodes the GridLicenseExpiry as described in encodedGridLicenseExpiry.
+func (s GridLicenseExpiry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GridLicenseExpiry) UnmarshalJSON(data []byte) error {
+	var e encodedGridLicenseExpiry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GridLicenseExpiry as described in encodedGridLicenseExpiry.
+func (s GridLicenseExpiry) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GridLicenseExpiry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGridLicenseExpiry
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGridLicensableFeature is the JSON and YAML representation of GridLicensableFeature.
+type encodedGridLicensableFeature struct {
+	FeatureCode    uint32
+	FeatureState   uint32
+	LicenseInfo    string
+	ProductName    string
+	FeatureEnabled uint32
+	LicenseExpiry  GridLicenseExpiry
+}
+
+func (s GridLicensableFeature) encode() encodedGridLicensableFeature {
+	return encodedGridLicensableFeature{
+		FeatureCode:    s.FeatureCode,
+		FeatureState:   s.FeatureState,
+		LicenseInfo:    cString(s.LicenseInfo[:]),
+		ProductName:    cString(s.ProductName[:]),
+		FeatureEnabled: s.FeatureEnabled,
+		LicenseExpiry:  s.LicenseExpiry,
+	}
+}
+
+func (e encodedGridLicensableFeature) decode() (GridLicensableFeature, error) {
+	var s GridLicensableFeature
+	s.FeatureCode = e.FeatureCode
+	s.FeatureState = e.FeatureState
+	if err := setCString(s.LicenseInfo[:], e.LicenseInfo); err != nil {
+		return s, fmt.Errorf("invalid GridLicensableFeature.LicenseInfo: %w", err)
+	}
+	if err := setCString(s.ProductName[:], e.ProductName); err != nil {
+		return s, fmt.Errorf("invalid GridLicensableFeature.ProductName: %w", err)
+	}
+	s.FeatureEnabled = e.FeatureEnabled
+	s.LicenseExpiry = e.LicenseExpiry
+	return s, nil
+}
+
+// MarshalJSON encodes the GridLicensableFeature as described in encodedGridLicensableFeature.
+func (s GridLicensableFeature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GridLicensableFeature) UnmarshalJSON(data []byte) error {
+	var e encodedGridLicensableFeature
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GridLicensableFeature as described in encodedGridLicensableFeature.
+func (s GridLicensableFeature) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GridLicensableFeature) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGridLicensableFeature
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerState_v1 is the JSON and YAML representation of VgpuSchedulerState_v1.
+type encodedVgpuSchedulerState_v1 struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	EnableARRMode   uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerState_v1) encode() encodedVgpuSchedulerState_v1 {
+	return encodedVgpuSchedulerState_v1{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		EnableARRMode:   s.EnableARRMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerState_v1) decode() (VgpuSchedulerState_v1, error) {
+	var s VgpuSchedulerState_v1
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.EnableARRMode = e.EnableARRMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerState_v1.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerState_v1 as described in encodedVgpuSchedulerState_v1.
+func (s VgpuSchedulerState_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerState_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerState_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerState_v1 as described in encodedVgpuSchedulerState_v1.
+func (s VgpuSchedulerState_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerState_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerState_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerState is the JSON and YAML representation of VgpuSchedulerState.
+type encodedVgpuSchedulerState struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	EnableARRMode   uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerState) encode() encodedVgpuSchedulerState {
+	return encodedVgpuSchedulerState{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		EnableARRMode:   s.EnableARRMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerState) decode() (VgpuSchedulerState, error) {
+	var s VgpuSchedulerState
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.EnableARRMode = e.EnableARRMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerState.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerState as described in encodedVgpuSchedulerState.
+func (s VgpuSchedulerState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerState) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerState
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerState as described in encodedVgpuSchedulerState.
+func (s VgpuSchedulerState) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerState
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerStateInfo_v1 is the JSON and YAML representation of VgpuSchedulerStateInfo_v1.
+type encodedVgpuSchedulerStateInfo_v1 struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerStateInfo_v1) encode() encodedVgpuSchedulerStateInfo_v1 {
+	return encodedVgpuSchedulerStateInfo_v1{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerStateInfo_v1) decode() (VgpuSchedulerStateInfo_v1, error) {
+	var s VgpuSchedulerStateInfo_v1
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerStateInfo_v1.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerStateInfo_v1 as described in encodedVgpuSchedulerStateInfo_v1.
+func (s VgpuSchedulerStateInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerStateInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerStateInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerStateInfo_v1 as described in encodedVgpuSchedulerStateInfo_v1.
+func (s VgpuSchedulerStateInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerStateInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerStateInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerStateInfo is the JSON and YAML representation of VgpuSchedulerStateInfo.
+type encodedVgpuSchedulerStateInfo struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+}
+
+func (s VgpuSchedulerStateInfo) encode() encodedVgpuSchedulerStateInfo {
+	return encodedVgpuSchedulerStateInfo{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+	}
+}
+
+func (e encodedVgpuSchedulerStateInfo) decode() (VgpuSchedulerStateInfo, error) {
+	var s VgpuSchedulerStateInfo
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerStateInfo.SchedulerParams: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerStateInfo as described in encodedVgpuSchedulerStateInfo.
+func (s VgpuSchedulerStateInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerStateInfo) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerStateInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerStateInfo as described in encodedVgpuSchedulerStateInfo.
+func (s VgpuSchedulerStateInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerStateInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerStateInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerLogInfo_v1 is the JSON and YAML representation of VgpuSchedulerLogInfo_v1.
+type encodedVgpuSchedulerLogInfo_v1 struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+	EntriesCount    uint32
+	LogEntries      [200]VgpuSchedulerLogEntry
+}
+
+func (s VgpuSchedulerLogInfo_v1) encode() encodedVgpuSchedulerLogInfo_v1 {
+	return encodedVgpuSchedulerLogInfo_v1{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+		EntriesCount:    s.EntriesCount,
+		LogEntries:      s.LogEntries,
+	}
+}
+
+func (e encodedVgpuSchedulerLogInfo_v1) decode() (VgpuSchedulerLogInfo_v1, error) {
+	var s VgpuSchedulerLogInfo_v1
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerLogInfo_v1.SchedulerParams: %w", err)
+	}
+	s.EntriesCount = e.EntriesCount
+	s.LogEntries = e.LogEntries
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerLogInfo_v1 as described in encodedVgpuSchedulerLogInfo_v1.
+func (s VgpuSchedulerLogInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerLogInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerLogInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerLogInfo_v1 as described in encodedVgpuSchedulerLogInfo_v1.
+func (s VgpuSchedulerLogInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerLogInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerLogInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuSchedulerLogInfo is the JSON and YAML representation of VgpuSchedulerLogInfo.
+type encodedVgpuSchedulerLogInfo struct {
+	Version         uint32
+	EngineId        uint32
+	SchedulerPolicy uint32
+	ArrMode         uint32
+	SchedulerParams byteArray
+	EntriesCount    uint32
+	LogEntries      [200]VgpuSchedulerLogEntry
+}
+
+func (s VgpuSchedulerLogInfo) encode() encodedVgpuSchedulerLogInfo {
+	return encodedVgpuSchedulerLogInfo{
+		Version:         s.Version,
+		EngineId:        s.EngineId,
+		SchedulerPolicy: s.SchedulerPolicy,
+		ArrMode:         s.ArrMode,
+		SchedulerParams: s.SchedulerParams[:],
+		EntriesCount:    s.EntriesCount,
+		LogEntries:      s.LogEntries,
+	}
+}
+
+func (e encodedVgpuSchedulerLogInfo) decode() (VgpuSchedulerLogInfo, error) {
+	var s VgpuSchedulerLogInfo
+	s.Version = e.Version
+	s.EngineId = e.EngineId
+	s.SchedulerPolicy = e.SchedulerPolicy
+	s.ArrMode = e.ArrMode
+	if err := setBytes(s.SchedulerParams[:], e.SchedulerParams); err != nil {
+		return s, fmt.Errorf("invalid VgpuSchedulerLogInfo.SchedulerParams: %w", err)
+	}
+	s.EntriesCount = e.EntriesCount
+	s.LogEntries = e.LogEntries
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuSchedulerLogInfo as described in encodedVgpuSchedulerLogInfo.
+func (s VgpuSchedulerLogInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuSchedulerLogInfo) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuSchedulerLogInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuSchedulerLogInfo as described in encodedVgpuSchedulerLogInfo.
+func (s VgpuSchedulerLogInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuSchedulerLogInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuSchedulerLogInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuCreatablePlacementInfo_v1 is the JSON and YAML representation of VgpuCreatablePlacementInfo_v1.
+type encodedVgpuCreatablePlacementInfo_v1 struct {
+	Version       uint32
+	VgpuTypeId    uint32
+	Count         uint32
+	PlacementIds  *uint32
+	PlacementSize uint32
+}
+
+func (s VgpuCreatablePlacementInfo_v1) encode() encodedVgpuCreatablePlacementInfo_v1 {
+	return encodedVgpuCreatablePlacementInfo_v1{
+		Version:       s.Version,
+		VgpuTypeId:    s.VgpuTypeId,
+		Count:         s.Count,
+		PlacementIds:  s.PlacementIds,
+		PlacementSize: s.PlacementSize,
+	}
+}
+
+func (e encodedVgpuCreatablePlacementInfo_v1) decode() (VgpuCreatablePlacementInfo_v1, error) {
+	var s VgpuCreatablePlacementInfo_v1
+	s.Version = e.Version
+	s.VgpuTypeId = e.VgpuTypeId
+	s.Count = e.Count
+	s.PlacementIds = e.PlacementIds
+	s.PlacementSize = e.PlacementSize
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuCreatablePlacementInfo_v1 as described in encodedVgpuCreatablePlacementInfo_v1.
+func (s VgpuCreatablePlacementInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuCreatablePlacementInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuCreatablePlacementInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuCreatablePlacementInfo_v1 as described in encodedVgpuCreatablePlacementInfo_v1.
+func (s VgpuCreatablePlacementInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuCreatablePlacementInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuCreatablePlacementInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedVgpuCreatablePlacementInfo is the JSON and YAML representation of VgpuCreatablePlacementInfo.
+type encodedVgpuCreatablePlacementInfo struct {
+	Version       uint32
+	VgpuTypeId    uint32
+	Count         uint32
+	PlacementIds  *uint32
+	PlacementSize uint32
+}
+
+func (s VgpuCreatablePlacementInfo) encode() encodedVgpuCreatablePlacementInfo {
+	return encodedVgpuCreatablePlacementInfo{
+		Version:       s.Version,
+		VgpuTypeId:    s.VgpuTypeId,
+		Count:         s.Count,
+		PlacementIds:  s.PlacementIds,
+		PlacementSize: s.PlacementSize,
+	}
+}
+
+func (e encodedVgpuCreatablePlacementInfo) decode() (VgpuCreatablePlacementInfo, error) {
+	var s VgpuCreatablePlacementInfo
+	s.Version = e.Version
+	s.VgpuTypeId = e.VgpuTypeId
+	s.Count = e.Count
+	s.PlacementIds = e.PlacementIds
+	s.PlacementSize = e.PlacementSize
+	return s, nil
+}
+
+// MarshalJSON encodes the VgpuCreatablePlacementInfo as described in encodedVgpuCreatablePlacementInfo.
+func (s VgpuCreatablePlacementInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *VgpuCreatablePlacementInfo) UnmarshalJSON(data []byte) error {
+	var e encodedVgpuCreatablePlacementInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the VgpuCreatablePlacementInfo as described in encodedVgpuCreatablePlacementInfo.
+func (s VgpuCreatablePlacementInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *VgpuCreatablePlacementInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedVgpuCreatablePlacementInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedFieldValue is the JSON and YAML representation of FieldValue.
+type encodedFieldValue struct {
+	FieldId     uint32
+	ScopeId     uint32
+	Timestamp   int64
+	LatencyUsec int64
+	ValueType   uint32
+	NvmlReturn  uint32
+	Value       byteArray
+}
+
+func (s FieldValue) encode() encodedFieldValue {
+	return encodedFieldValue{
+		FieldId:     s.FieldId,
+		ScopeId:     s.ScopeId,
+		Timestamp:   s.Timestamp,
+		LatencyUsec: s.LatencyUsec,
+		ValueType:   s.ValueType,
+		NvmlReturn:  s.NvmlReturn,
+		Value:       s.Value[:],
+	}
+}
+
+func (e encodedFieldValue) decode() (FieldValue, error) {
+	var s FieldValue
+	s.FieldId = e.FieldId
+	s.ScopeId = e.ScopeId
+	s.Timestamp = e.Timestamp
+	s.LatencyUsec = e.LatencyUsec
+	s.ValueType = e.ValueType
+	s.NvmlReturn = e.NvmlReturn
+	if err := setBytes(s.Value[:], e.Value); err != nil {
+		return s, fmt.Errorf("invalid FieldValue.Value: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the FieldValue as described in encodedFieldValue.
+func (s FieldValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *FieldValue) UnmarshalJSON(data []byte) error {
+	var e encodedFieldValue
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the FieldValue as described in encodedFieldValue.
+func (s FieldValue) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *FieldValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedFieldValue
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedHwbcEntry is the JSON and YAML representation of HwbcEntry.
+type encodedHwbcEntry struct {
+	HwbcId          uint32
+	FirmwareVersion string
+}
+
+func (s HwbcEntry) encode() encodedHwbcEntry {
+	return encodedHwbcEntry{
+		HwbcId:          s.HwbcId,
+		FirmwareVersion: cString(s.FirmwareVersion[:]),
+	}
+}
+
+func (e encodedHwbcEntry) decode() (HwbcEntry, error) {
+	var s HwbcEntry
+	s.HwbcId = e.HwbcId
+	if err := setCString(s.FirmwareVersion[:], e.FirmwareVersion); err != nil {
+		return s, fmt.Errorf("invalid HwbcEntry.FirmwareVersion: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the HwbcEntry as described in encodedHwbcEntry.
+func (s HwbcEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *HwbcEntry) UnmarshalJSON(data []byte) error {
+	var e encodedHwbcEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the HwbcEntry as described in encodedHwbcEntry.
+func (s HwbcEntry) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *HwbcEntry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedHwbcEntry
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedLedState is the JSON and YAML representation of LedState.
+type encodedLedState struct {
+	Cause string
+	Color uint32
+}
+
+func (s LedState) encode() encodedLedState {
+	return encodedLedState{
+		Cause: cString(s.Cause[:]),
+		Color: s.Color,
+	}
+}
+
+func (e encodedLedState) decode() (LedState, error) {
+	var s LedState
+	if err := setCString(s.Cause[:], e.Cause); err != nil {
+		return s, fmt.Errorf("invalid LedState.Cause: %w", err)
+	}
+	s.Color = e.Color
+	return s, nil
+}
+
+// MarshalJSON encodes the LedState as described in encodedLedState.
+func (s LedState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *LedState) UnmarshalJSON(data []byte) error {
+	var e encodedLedState
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the LedState as described in encodedLedState.
+func (s LedState) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *LedState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedLedState
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedUnitInfo is the JSON and YAML representation of UnitInfo.
+type encodedUnitInfo struct {
+	Name            string
+	Id              string
+	Serial          string
+	FirmwareVersion string
+}
+
+func (s UnitInfo) encode() encodedUnitInfo {
+	return encodedUnitInfo{
+		Name:            cString(s.Name[:]),
+		Id:              cString(s.Id[:]),
+		Serial:          cString(s.Serial[:]),
+		FirmwareVersion: cString(s.FirmwareVersion[:]),
+	}
+}
+
+func (e encodedUnitInfo) decode() (UnitInfo, error) {
+	var s UnitInfo
+	if err := setCString(s.Name[:], e.Name); err != nil {
+		return s, fmt.Errorf("invalid UnitInfo.Name: %w", err)
+	}
+	if err := setCString(s.Id[:], e.Id); err != nil {
+		return s, fmt.Errorf("invalid UnitInfo.Id: %w", err)
+	}
+	if err := setCString(s.Serial[:], e.Serial); err != nil {
+		return s, fmt.Errorf("invalid UnitInfo.Serial: %w", err)
+	}
+	if err := setCString(s.FirmwareVersion[:], e.FirmwareVersion); err != nil {
+		return s, fmt.Errorf("invalid UnitInfo.FirmwareVersion: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the UnitInfo as described in encodedUnitInfo.
+func (s UnitInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *UnitInfo) UnmarshalJSON(data []byte) error {
+	var e encodedUnitInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the UnitInfo as described in encodedUnitInfo.
+func (s UnitInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *UnitInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedUnitInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPSUInfo is the JSON and YAML representation of PSUInfo.
+type encodedPSUInfo struct {
+	State   string
+	Current uint32
+	Voltage uint32
+	Power   uint32
+}
+
+func (s PSUInfo) encode() encodedPSUInfo {
+	return encodedPSUInfo{
+		State:   cString(s.State[:]),
+		Current: s.Current,
+		Voltage: s.Voltage,
+		Power:   s.Power,
+	}
+}
+
+func (e encodedPSUInfo) decode() (PSUInfo, error) {
+	var s PSUInfo
+	if err := setCString(s.State[:], e.State); err != nil {
+		return s, fmt.Errorf("invalid PSUInfo.State: %w", err)
+	}
+	s.Current = e.Current
+	s.Voltage = e.Voltage
+	s.Power = e.Power
+	return s, nil
+}
+
+// MarshalJSON encodes the PSUInfo as described in encodedPSUInfo.
+func (s PSUInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PSUInfo) UnmarshalJSON(data []byte) error {
+	var e encodedPSUInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PSUInfo as described in encodedPSUInfo.
+func (s PSUInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PSUInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPSUInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedSystemEventData_v1 is the JSON and YAML representation of SystemEventData_v1.
+type encodedSystemEventData_v1 struct {
+	EventType uint64
+	GpuId     uint32
+}
+
+func (s SystemEventData_v1) encode() encodedSystemEventData_v1 {
+	return encodedSystemEventData_v1{
+		EventType: s.EventType,
+		GpuId:     s.GpuId,
+	}
+}
+
+func (e encodedSystemEventData_v1) decode() (SystemEventData_v1, error) {
+	var s SystemEventData_v1
+	s.EventType = e.EventType
+	s.GpuId = e.GpuId
+	return s, nil
+}
+
+// MarshalJSON encodes the SystemEventData_v1 as described in encodedSystemEventData_v1.
+func (s SystemEventData_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *SystemEventData_v1) UnmarshalJSON(data []byte) error {
+	var e encodedSystemEventData_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the SystemEventData_v1 as described in encodedSystemEventData_v1.
+func (s SystemEventData_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *SystemEventData_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedSystemEventData_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedConfComputeGpuCertificate is the JSON and YAML representation of ConfComputeGpuCertificate.
+type encodedConfComputeGpuCertificate struct {
+	CertChainSize            uint32
+	AttestationCertChainSize uint32
+	CertChain                byteArray
+	AttestationCertChain     byteArray
+}
+
+func (s ConfComputeGpuCertificate) encode() encodedConfComputeGpuCertificate {
+	return encodedConfComputeGpuCertificate{
+		CertChainSize:            s.CertChainSize,
+		AttestationCertChainSize: s.AttestationCertChainSize,
+		CertChain:                s.CertChain[:],
+		AttestationCertChain:     s.AttestationCertChain[:],
+	}
+}
+
+func (e encodedConfComputeGpuCertificate) decode() (ConfComputeGpuCertificate, error) {
+	var s ConfComputeGpuCertificate
+	s.CertChainSize = e.CertChainSize
+	s.AttestationCertChainSize = e.AttestationCertChainSize
+	if err := setBytes(s.CertChain[:], e.CertChain); err != nil {
+		return s, fmt.Errorf("invalid ConfComputeGpuCertificate.CertChain: %w", err)
+	}
+	if err := setBytes(s.AttestationCertChain[:], e.AttestationCertChain); err != nil {
+		return s, fmt.Errorf("invalid ConfComputeGpuCertificate.AttestationCertChain: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the ConfComputeGpuCertificate as described in encodedConfComputeGpuCertificate.
+func (s ConfComputeGpuCertificate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ConfComputeGpuCertificate) UnmarshalJSON(data []byte) error {
+	var e encodedConfComputeGpuCertificate
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ConfComputeGpuCertificate as described in encodedConfComputeGpuCertificate.
+func (s ConfComputeGpuCertificate) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ConfComputeGpuCertificate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedConfComputeGpuCertificate
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedConfComputeGpuAttestationReport is the JSON and YAML representation of ConfComputeGpuAttestationReport.
+type encodedConfComputeGpuAttestationReport struct {
+	IsCecAttestationReportPresent uint32
+	AttestationReportSize         uint32
+	CecAttestationReportSize      uint32
+	Nonce                         byteArray
+	AttestationReport             byteArray
+	CecAttestationReport          byteArray
+}
+
+func (s ConfComputeGpuAttestationReport) encode() encodedConfComputeGpuAttestationReport {
+	return encodedConfComputeGpuAttestationReport{
+		IsCecAttestationReportPresent: s.IsCecAttestationReportPresent,
+		AttestationReportSize:         s.AttestationReportSize,
+		CecAttestationReportSize:      s.CecAttestationReportSize,
+		Nonce:                         s.Nonce[:],
+		AttestationReport:             s.AttestationReport[:],
+		CecAttestationReport:          s.CecAttestationReport[:],
+	}
+}
+
+func (e encodedConfComputeGpuAttestationReport) decode() (ConfComputeGpuAttestationReport, error) {
+	var s ConfComputeGpuAttestationReport
+	s.IsCecAttestationReportPresent = e.IsCecAttestationReportPresent
+	s.AttestationReportSize = e.AttestationReportSize
+	s.CecAttestationReportSize = e.CecAttestationReportSize
+	if err := setBytes(s.Nonce[:], e.Nonce); err != nil {
+		return s, fmt.Errorf("invalid ConfComputeGpuAttestationReport.Nonce: %w", err)
+	}
+	if err := setBytes(s.AttestationReport[:], e.AttestationReport); err != nil {
+		return s, fmt.Errorf("invalid ConfComputeGpuAttestationReport.AttestationReport: %w", err)
+	}
+	if err := setBytes(s.CecAttestationReport[:], e.CecAttestationReport); err != nil {
+		return s, fmt.Errorf("invalid ConfComputeGpuAttestationReport.CecAttestationReport: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the ConfComputeGpuAttestationReport as described in encodedConfComputeGpuAttestationReport.
+func (s ConfComputeGpuAttestationReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ConfComputeGpuAttestationReport) UnmarshalJSON(data []byte) error {
+	var e encodedConfComputeGpuAttestationReport
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ConfComputeGpuAttestationReport as described in encodedConfComputeGpuAttestationReport.
+func (s ConfComputeGpuAttestationReport) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ConfComputeGpuAttestationReport) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedConfComputeGpuAttestationReport
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuFabricInfo is the JSON and YAML representation of GpuFabricInfo.
+type encodedGpuFabricInfo struct {
+	ClusterUuid uuid.UUID
+	Status      uint32
+	CliqueId    uint32
+	State       uint8
+}
+
+func (s GpuFabricInfo) encode() encodedGpuFabricInfo {
+	return encodedGpuFabricInfo{
+		ClusterUuid: uuid.UUID(s.ClusterUuid),
+		Status:      s.Status,
+		CliqueId:    s.CliqueId,
+		State:       s.State,
+	}
+}
+
+func (e encodedGpuFabricInfo) decode() (GpuFabricInfo, error) {
+	var s GpuFabricInfo
+	s.ClusterUuid = [16]uint8(e.ClusterUuid)
+	s.Status = e.Status
+	s.CliqueId = e.CliqueId
+	s.State = e.State
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuFabricInfo as described in encodedGpuFabricInfo.
+func (s GpuFabricInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuFabricInfo) UnmarshalJSON(data []byte) error {
+	var e encodedGpuFabricInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuFabricInfo as described in encodedGpuFabricInfo.
+func (s GpuFabricInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuFabricInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuFabricInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuFabricInfo_v2 is the JSON and YAML representation of GpuFabricInfo_v2.
+type encodedGpuFabricInfo_v2 struct {
+	Version     uint32
+	ClusterUuid uuid.UUID
+	Status      uint32
+	CliqueId    uint32
+	State       uint8
+	HealthMask  uint32
+}
+
+func (s GpuFabricInfo_v2) encode() encodedGpuFabricInfo_v2 {
+	return encodedGpuFabricInfo_v2{
+		Version:     s.Version,
+		ClusterUuid: uuid.UUID(s.ClusterUuid),
+		Status:      s.Status,
+		CliqueId:    s.CliqueId,
+		State:       s.State,
+		HealthMask:  s.HealthMask,
+	}
+}
+
+func (e encodedGpuFabricInfo_v2) decode() (GpuFabricInfo_v2, error) {
+	var s GpuFabricInfo_v2
+	s.Version = e.Version
+	s.ClusterUuid = [16]uint8(e.ClusterUuid)
+	s.Status = e.Status
+	s.CliqueId = e.CliqueId
+	s.State = e.State
+	s.HealthMask = e.HealthMask
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuFabricInfo_v2 as described in encodedGpuFabricInfo_v2.
+func (s GpuFabricInfo_v2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuFabricInfo_v2) UnmarshalJSON(data []byte) error {
+	var e encodedGpuFabricInfo_v2
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuFabricInfo_v2 as described in encodedGpuFabricInfo_v2.
+func (s GpuFabricInfo_v2) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuFabricInfo_v2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuFabricInfo_v2
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuFabricInfo_v3 is the JSON and YAML representation of GpuFabricInfo_v3.
+type encodedGpuFabricInfo_v3 struct {
+	Version       uint32
+	ClusterUuid   uuid.UUID
+	Status        uint32
+	CliqueId      uint32
+	State         uint8
+	HealthMask    uint32
+	HealthSummary uint8
+}
+
+func (s GpuFabricInfo_v3) encode() encodedGpuFabricInfo_v3 {
+	return encodedGpuFabricInfo_v3{
+		Version:       s.Version,
+		ClusterUuid:   uuid.UUID(s.ClusterUuid),
+		Status:        s.Status,
+		CliqueId:      s.CliqueId,
+		State:         s.State,
+		HealthMask:    s.HealthMask,
+		HealthSummary: s.HealthSummary,
+	}
+}
+
+func (e encodedGpuFabricInfo_v3) decode() (GpuFabricInfo_v3, error) {
+	var s GpuFabricInfo_v3
+	s.Version = e.Version
+	s.ClusterUuid = [16]uint8(e.ClusterUuid)
+	s.Status = e.Status
+	s.CliqueId = e.CliqueId
+	s.State = e.State
+	s.HealthMask = e.HealthMask
+	s.HealthSummary = e.HealthSummary
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuFabricInfo_v3 as described in encodedGpuFabricInfo_v3.
+func (s GpuFabricInfo_v3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuFabricInfo_v3) UnmarshalJSON(data []byte) error {
+	var e encodedGpuFabricInfo_v3
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuFabricInfo_v3 as described in encodedGpuFabricInfo_v3.
+func (s GpuFabricInfo_v3) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuFabricInfo_v3) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuFabricInfo_v3
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuFabricInfoV is the JSON and YAML representation of GpuFabricInfoV.
+type encodedGpuFabricInfoV struct {
+	Version       uint32
+	ClusterUuid   uuid.UUID
+	Status        uint32
+	CliqueId      uint32
+	State         uint8
+	HealthMask    uint32
+	HealthSummary uint8
+}
+
+func (s GpuFabricInfoV) encode() encodedGpuFabricInfoV {
+	return encodedGpuFabricInfoV{
+		Version:       s.Version,
+		ClusterUuid:   uuid.UUID(s.ClusterUuid),
+		Status:        s.Status,
+		CliqueId:      s.CliqueId,
+		State:         s.State,
+		HealthMask:    s.HealthMask,
+		HealthSummary: s.HealthSummary,
+	}
+}
+
+func (e encodedGpuFabricInfoV) decode() (GpuFabricInfoV, error) {
+	var s GpuFabricInfoV
+	s.Version = e.Version
+	s.ClusterUuid = [16]uint8(e.ClusterUuid)
+	s.Status = e.Status
+	s.CliqueId = e.CliqueId
+	s.State = e.State
+	s.HealthMask = e.HealthMask
+	s.HealthSummary = e.HealthSummary
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuFabricInfoV as described in encodedGpuFabricInfoV.
+func (s GpuFabricInfoV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuFabricInfoV) UnmarshalJSON(data []byte) error {
+	var e encodedGpuFabricInfoV
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuFabricInfoV as described in encodedGpuFabricInfoV.
+func (s GpuFabricInfoV) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuFabricInfoV) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuFabricInfoV
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedSystemDriverBranchInfo_v1 is the JSON and YAML representation of SystemDriverBranchInfo_v1.
+type encodedSystemDriverBranchInfo_v1 struct {
+	Version uint32
+	Branch  string
+}
+
+func (s SystemDriverBranchInfo_v1) encode() encodedSystemDriverBranchInfo_v1 {
+	return encodedSystemDriverBranchInfo_v1{
+		Version: s.Version,
+		Branch:  cString(s.Branch[:]),
+	}
+}
+
+func (e encodedSystemDriverBranchInfo_v1) decode() (SystemDriverBranchInfo_v1, error) {
+	var s SystemDriverBranchInfo_v1
+	s.Version = e.Version
+	if err := setCString(s.Branch[:], e.Branch); err != nil {
+		return s, fmt.Errorf("invalid SystemDriverBranchInfo_v1.Branch: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the SystemDriverBranchInfo_v1 as described in encodedSystemDriverBranchInfo_v1.
+func (s SystemDriverBranchInfo_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *SystemDriverBranchInfo_v1) UnmarshalJSON(data []byte) error {
+	var e encodedSystemDriverBranchInfo_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the SystemDriverBranchInfo_v1 as described in encodedSystemDriverBranchInfo_v1.
+func (s SystemDriverBranchInfo_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *SystemDriverBranchInfo_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedSystemDriverBranchInfo_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedSystemDriverBranchInfo is the JSON and YAML representation of SystemDriverBranchInfo.
+type encodedSystemDriverBranchInfo struct {
+	Version uint32
+	Branch  string
+}
+
+func (s SystemDriverBranchInfo) encode() encodedSystemDriverBranchInfo {
+	return encodedSystemDriverBranchInfo{
+		Version: s.Version,
+		Branch:  cString(s.Branch[:]),
+	}
+}
+
+func (e encodedSystemDriverBranchInfo) decode() (SystemDriverBranchInfo, error) {
+	var s SystemDriverBranchInfo
+	s.Version = e.Version
+	if err := setCString(s.Branch[:], e.Branch); err != nil {
+		return s, fmt.Errorf("invalid SystemDriverBranchInfo.Branch: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the SystemDriverBranchInfo as described in encodedSystemDriverBranchInfo.
+func (s SystemDriverBranchInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *SystemDriverBranchInfo) UnmarshalJSON(data []byte) error {
+	var e encodedSystemDriverBranchInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the SystemDriverBranchInfo as described in encodedSystemDriverBranchInfo.
+func (s SystemDriverBranchInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *SystemDriverBranchInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedSystemDriverBranchInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkSupportedBwModes_v1 is the JSON and YAML representation of NvlinkSupportedBwModes_v1.
+type encodedNvlinkSupportedBwModes_v1 struct {
+	Version      uint32
+	BwModes      byteArray
+	TotalBwModes uint8
+}
+
+func (s NvlinkSupportedBwModes_v1) encode() encodedNvlinkSupportedBwModes_v1 {
+	return encodedNvlinkSupportedBwModes_v1{
+		Version:      s.Version,
+		BwModes:      s.BwModes[:],
+		TotalBwModes: s.TotalBwModes,
+	}
+}
+
+func (e encodedNvlinkSupportedBwModes_v1) decode() (NvlinkSupportedBwModes_v1, error) {
+	var s NvlinkSupportedBwModes_v1
+	s.Version = e.Version
+	if err := setBytes(s.BwModes[:], e.BwModes); err != nil {
+		return s, fmt.Errorf("invalid NvlinkSupportedBwModes_v1.BwModes: %w", err)
+	}
+	s.TotalBwModes = e.TotalBwModes
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkSupportedBwModes_v1 as described in encodedNvlinkSupportedBwModes_v1.
+func (s NvlinkSupportedBwModes_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkSupportedBwModes_v1) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkSupportedBwModes_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkSupportedBwModes_v1 as described in encodedNvlinkSupportedBwModes_v1.
+func (s NvlinkSupportedBwModes_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkSupportedBwModes_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkSupportedBwModes_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkSupportedBwModes is the JSON and YAML representation of NvlinkSupportedBwModes.
+type encodedNvlinkSupportedBwModes struct {
+	Version      uint32
+	BwModes      byteArray
+	TotalBwModes uint8
+}
+
+func (s NvlinkSupportedBwModes) encode() encodedNvlinkSupportedBwModes {
+	return encodedNvlinkSupportedBwModes{
+		Version:      s.Version,
+		BwModes:      s.BwModes[:],
+		TotalBwModes: s.TotalBwModes,
+	}
+}
+
+func (e encodedNvlinkSupportedBwModes) decode() (NvlinkSupportedBwModes, error) {
+	var s NvlinkSupportedBwModes
+	s.Version = e.Version
+	if err := setBytes(s.BwModes[:], e.BwModes); err != nil {
+		return s, fmt.Errorf("invalid NvlinkSupportedBwModes.BwModes: %w", err)
+	}
+	s.TotalBwModes = e.TotalBwModes
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkSupportedBwModes as described in encodedNvlinkSupportedBwModes.
+func (s NvlinkSupportedBwModes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkSupportedBwModes) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkSupportedBwModes
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkSupportedBwModes as described in encodedNvlinkSupportedBwModes.
+func (s NvlinkSupportedBwModes) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkSupportedBwModes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkSupportedBwModes
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkGetBwMode_v1 is the JSON and YAML representation of NvlinkGetBwMode_v1.
+type encodedNvlinkGetBwMode_v1 struct {
+	Version uint32
+	BIsBest uint32
+	BwMode  uint8
+}
+
+func (s NvlinkGetBwMode_v1) encode() encodedNvlinkGetBwMode_v1 {
+	return encodedNvlinkGetBwMode_v1{
+		Version: s.Version,
+		BIsBest: s.BIsBest,
+		BwMode:  s.BwMode,
+	}
+}
+
+func (e encodedNvlinkGetBwMode_v1) decode() (NvlinkGetBwMode_v1, error) {
+	var s NvlinkGetBwMode_v1
+	s.Version = e.Version
+	s.BIsBest = e.BIsBest
+	s.BwMode = e.BwMode
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkGetBwMode_v1 as described in encodedNvlinkGetBwMode_v1.
+func (s NvlinkGetBwMode_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkGetBwMode_v1) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkGetBwMode_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkGetBwMode_v1 as described in encodedNvlinkGetBwMode_v1.
+func (s NvlinkGetBwMode_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkGetBwMode_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkGetBwMode_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkGetBwMode is the JSON and YAML representation of NvlinkGetBwMode.
+type encodedNvlinkGetBwMode struct {
+	Version uint32
+	BIsBest uint32
+	BwMode  uint8
+}
+
+func (s NvlinkGetBwMode) encode() encodedNvlinkGetBwMode {
+	return encodedNvlinkGetBwMode{
+		Version: s.Version,
+		BIsBest: s.BIsBest,
+		BwMode:  s.BwMode,
+	}
+}
+
+func (e encodedNvlinkGetBwMode) decode() (NvlinkGetBwMode, error) {
+	var s NvlinkGetBwMode
+	s.Version = e.Version
+	s.BIsBest = e.BIsBest
+	s.BwMode = e.BwMode
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkGetBwMode as described in encodedNvlinkGetBwMode.
+func (s NvlinkGetBwMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkGetBwMode) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkGetBwMode
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkGetBwMode as described in encodedNvlinkGetBwMode.
+func (s NvlinkGetBwMode) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkGetBwMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkGetBwMode
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkSetBwMode_v1 is the JSON and YAML representation of NvlinkSetBwMode_v1.
+type encodedNvlinkSetBwMode_v1 struct {
+	Version  uint32
+	BSetBest uint32
+	BwMode   uint8
+}
+
+func (s NvlinkSetBwMode_v1) encode() encodedNvlinkSetBwMode_v1 {
+	return encodedNvlinkSetBwMode_v1{
+		Version:  s.Version,
+		BSetBest: s.BSetBest,
+		BwMode:   s.BwMode,
+	}
+}
+
+func (e encodedNvlinkSetBwMode_v1) decode() (NvlinkSetBwMode_v1, error) {
+	var s NvlinkSetBwMode_v1
+	s.Version = e.Version
+	s.BSetBest = e.BSetBest
+	s.BwMode = e.BwMode
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkSetBwMode_v1 as described in encodedNvlinkSetBwMode_v1.
+func (s NvlinkSetBwMode_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkSetBwMode_v1) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkSetBwMode_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkSetBwMode_v1 as described in encodedNvlinkSetBwMode_v1.
+func (s NvlinkSetBwMode_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkSetBwMode_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkSetBwMode_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedNvlinkSetBwMode is the JSON and YAML representation of NvlinkSetBwMode.
+type encodedNvlinkSetBwMode struct {
+	Version  uint32
+	BSetBest uint32
+	BwMode   uint8
+}
+
+func (s NvlinkSetBwMode) encode() encodedNvlinkSetBwMode {
+	return encodedNvlinkSetBwMode{
+		Version:  s.Version,
+		BSetBest: s.BSetBest,
+		BwMode:   s.BwMode,
+	}
+}
+
+func (e encodedNvlinkSetBwMode) decode() (NvlinkSetBwMode, error) {
+	var s NvlinkSetBwMode
+	s.Version = e.Version
+	s.BSetBest = e.BSetBest
+	s.BwMode = e.BwMode
+	return s, nil
+}
+
+// MarshalJSON encodes the NvlinkSetBwMode as described in encodedNvlinkSetBwMode.
+func (s NvlinkSetBwMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *NvlinkSetBwMode) UnmarshalJSON(data []byte) error {
+	var e encodedNvlinkSetBwMode
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the NvlinkSetBwMode as described in encodedNvlinkSetBwMode.
+func (s NvlinkSetBwMode) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *NvlinkSetBwMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedNvlinkSetBwMode
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedExcludedDeviceInfo is the JSON and YAML representation of ExcludedDeviceInfo.
+type encodedExcludedDeviceInfo struct {
+	PciInfo PciInfo
+	Uuid    string
+}
+
+func (s ExcludedDeviceInfo) encode() encodedExcludedDeviceInfo {
+	return encodedExcludedDeviceInfo{
+		PciInfo: s.PciInfo,
+		Uuid:    cString(s.Uuid[:]),
+	}
+}
+
+func (e encodedExcludedDeviceInfo) decode() (ExcludedDeviceInfo, error) {
+	var s ExcludedDeviceInfo
+	s.PciInfo = e.PciInfo
+	if err := setCString(s.Uuid[:], e.Uuid); err != nil {
+		return s, fmt.Errorf("invalid ExcludedDeviceInfo.Uuid: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the ExcludedDeviceInfo as described in encodedExcludedDeviceInfo.
+func (s ExcludedDeviceInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ExcludedDeviceInfo) UnmarshalJSON(data []byte) error {
+	var e encodedExcludedDeviceInfo
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ExcludedDeviceInfo as described in encodedExcludedDeviceInfo.
+func (s ExcludedDeviceInfo) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ExcludedDeviceInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedExcludedDeviceInfo
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedPRMTLV_v1 is the JSON and YAML representation of PRMTLV_v1.
+type encodedPRMTLV_v1 struct {
+	DataSize uint32
+	Status   uint32
+	InData   byteArray
+}
+
+func (s PRMTLV_v1) encode() encodedPRMTLV_v1 {
+	return encodedPRMTLV_v1{
+		DataSize: s.DataSize,
+		Status:   s.Status,
+		InData:   s.InData[:],
+	}
+}
+
+func (e encodedPRMTLV_v1) decode() (PRMTLV_v1, error) {
+	var s PRMTLV_v1
+	s.DataSize = e.DataSize
+	s.Status = e.Status
+	if err := setBytes(s.InData[:], e.InData); err != nil {
+		return s, fmt.Errorf("invalid PRMTLV_v1.InData: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the PRMTLV_v1 as described in encodedPRMTLV_v1.
+func (s PRMTLV_v1) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *PRMTLV_v1) UnmarshalJSON(data []byte) error {
+	var e encodedPRMTLV_v1
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the PRMTLV_v1 as described in encodedPRMTLV_v1.
+func (s PRMTLV_v1) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *PRMTLV_v1) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedPRMTLV_v1
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuInstanceProfileInfo_v2 is the JSON and YAML representation of GpuInstanceProfileInfo_v2.
+type encodedGpuInstanceProfileInfo_v2 struct {
+	Version             uint32
+	Id                  uint32
+	IsP2pSupported      uint32
+	SliceCount          uint32
+	InstanceCount       uint32
+	MultiprocessorCount uint32
+	CopyEngineCount     uint32
+	DecoderCount        uint32
+	EncoderCount        uint32
+	JpegCount           uint32
+	OfaCount            uint32
+	MemorySizeMB        uint64
+	Name                string
+}
+
+func (s GpuInstanceProfileInfo_v2) encode() encodedGpuInstanceProfileInfo_v2 {
+	return encodedGpuInstanceProfileInfo_v2{
+		Version:             s.Version,
+		Id:                  s.Id,
+		IsP2pSupported:      s.IsP2pSupported,
+		SliceCount:          s.SliceCount,
+		InstanceCount:       s.InstanceCount,
+		MultiprocessorCount: s.MultiprocessorCount,
+		CopyEngineCount:     s.CopyEngineCount,
+		DecoderCount:        s.DecoderCount,
+		EncoderCount:        s.EncoderCount,
+		JpegCount:           s.JpegCount,
+		OfaCount:            s.OfaCount,
+		MemorySizeMB:        s.MemorySizeMB,
+		Name:                cString(s.Name[:]),
+	}
+}
+
+func (e encodedGpuInstanceProfileInfo_v2) decode() (GpuInstanceProfileInfo_v2, error) {
+	var s GpuInstanceProfileInfo_v2
+	s.Version = e.Version
+	s.Id = e.Id
+	s.IsP2pSupported = e.IsP2pSupported
+	s.SliceCount = e.SliceCount
+	s.InstanceCount = e.InstanceCount
+	s.MultiprocessorCount = e.MultiprocessorCount
+	s.CopyEngineCount = e.CopyEngineCount
+	s.DecoderCount = e.DecoderCount
+	s.EncoderCount = e.EncoderCount
+	s.JpegCount = e.JpegCount
+	s.OfaCount = e.OfaCount
+	s.MemorySizeMB = e.MemorySizeMB
+	if err := setCString(s.Name[:], e.Name); err != nil {
+		return s, fmt.Errorf("invalid GpuInstanceProfileInfo_v2.Name: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuInstanceProfileInfo_v2 as described in encodedGpuInstanceProfileInfo_v2.
+func (s GpuInstanceProfileInfo_v2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuInstanceProfileInfo_v2) UnmarshalJSON(data []byte) error {
+	var e encodedGpuInstanceProfileInfo_v2
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuInstanceProfileInfo_v2 as described in encodedGpuInstanceProfileInfo_v2.
+func (s GpuInstanceProfileInfo_v2) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuInstanceProfileInfo_v2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuInstanceProfileInfo_v2
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedGpuInstanceProfileInfo_v3 is the JSON and YAML representation of GpuInstanceProfileInfo_v3.
+type encodedGpuInstanceProfileInfo_v3 struct {
+	Version             uint32
+	Id                  uint32
+	SliceCount          uint32
+	InstanceCount       uint32
+	MultiprocessorCount uint32
+	CopyEngineCount     uint32
+	DecoderCount        uint32
+	EncoderCount        uint32
+	JpegCount           uint32
+	OfaCount            uint32
+	MemorySizeMB        uint64
+	Name                string
+	Capabilities        uint32
+}
+
+func (s GpuInstanceProfileInfo_v3) encode() encodedGpuInstanceProfileInfo_v3 {
+	return encodedGpuInstanceProfileInfo_v3{
+		Version:             s.Version,
+		Id:                  s.Id,
+		SliceCount:          s.SliceCount,
+		InstanceCount:       s.InstanceCount,
+		MultiprocessorCount: s.MultiprocessorCount,
+		CopyEngineCount:     s.CopyEngineCount,
+		DecoderCount:        s.DecoderCount,
+		EncoderCount:        s.EncoderCount,
+		JpegCount:           s.JpegCount,
+		OfaCount:            s.OfaCount,
+		MemorySizeMB:        s.MemorySizeMB,
+		Name:                cString(s.Name[:]),
+		Capabilities:        s.Capabilities,
+	}
+}
+
+func (e encodedGpuInstanceProfileInfo_v3) decode() (GpuInstanceProfileInfo_v3, error) {
+	var s GpuInstanceProfileInfo_v3
+	s.Version = e.Version
+	s.Id = e.Id
+	s.SliceCount = e.SliceCount
+	s.InstanceCount = e.InstanceCount
+	s.MultiprocessorCount = e.MultiprocessorCount
+	s.CopyEngineCount = e.CopyEngineCount
+	s.DecoderCount = e.DecoderCount
+	s.EncoderCount = e.EncoderCount
+	s.JpegCount = e.JpegCount
+	s.OfaCount = e.OfaCount
+	s.MemorySizeMB = e.MemorySizeMB
+	if err := setCString(s.Name[:], e.Name); err != nil {
+		return s, fmt.Errorf("invalid GpuInstanceProfileInfo_v3.Name: %w", err)
+	}
+	s.Capabilities = e.Capabilities
+	return s, nil
+}
+
+// MarshalJSON encodes the GpuInstanceProfileInfo_v3 as described in encodedGpuInstanceProfileInfo_v3.
+func (s GpuInstanceProfileInfo_v3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *GpuInstanceProfileInfo_v3) UnmarshalJSON(data []byte) error {
+	var e encodedGpuInstanceProfileInfo_v3
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the GpuInstanceProfileInfo_v3 as described in encodedGpuInstanceProfileInfo_v3.
+func (s GpuInstanceProfileInfo_v3) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *GpuInstanceProfileInfo_v3) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedGpuInstanceProfileInfo_v3
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedComputeInstanceProfileInfo_v2 is the JSON and YAML representation of ComputeInstanceProfileInfo_v2.
+type encodedComputeInstanceProfileInfo_v2 struct {
+	Version               uint32
+	Id                    uint32
+	SliceCount            uint32
+	InstanceCount         uint32
+	MultiprocessorCount   uint32
+	SharedCopyEngineCount uint32
+	SharedDecoderCount    uint32
+	SharedEncoderCount    uint32
+	SharedJpegCount       uint32
+	SharedOfaCount        uint32
+	Name                  string
+}
+
+func (s ComputeInstanceProfileInfo_v2) encode() encodedComputeInstanceProfileInfo_v2 {
+	return encodedComputeInstanceProfileInfo_v2{
+		Version:               s.Version,
+		Id:                    s.Id,
+		SliceCount:            s.SliceCount,
+		InstanceCount:         s.InstanceCount,
+		MultiprocessorCount:   s.MultiprocessorCount,
+		SharedCopyEngineCount: s.SharedCopyEngineCount,
+		SharedDecoderCount:    s.SharedDecoderCount,
+		SharedEncoderCount:    s.SharedEncoderCount,
+		SharedJpegCount:       s.SharedJpegCount,
+		SharedOfaCount:        s.SharedOfaCount,
+		Name:                  cString(s.Name[:]),
+	}
+}
+
+func (e encodedComputeInstanceProfileInfo_v2) decode() (ComputeInstanceProfileInfo_v2, error) {
+	var s ComputeInstanceProfileInfo_v2
+	s.Version = e.Version
+	s.Id = e.Id
+	s.SliceCount = e.SliceCount
+	s.InstanceCount = e.InstanceCount
+	s.MultiprocessorCount = e.MultiprocessorCount
+	s.SharedCopyEngineCount = e.SharedCopyEngineCount
+	s.SharedDecoderCount = e.SharedDecoderCount
+	s.SharedEncoderCount = e.SharedEncoderCount
+	s.SharedJpegCount = e.SharedJpegCount
+	s.SharedOfaCount = e.SharedOfaCount
+	if err := setCString(s.Name[:], e.Name); err != nil {
+		return s, fmt.Errorf("invalid ComputeInstanceProfileInfo_v2.Name: %w", err)
+	}
+	return s, nil
+}
+
+// MarshalJSON encodes the ComputeInstanceProfileInfo_v2 as described in encodedComputeInstanceProfileInfo_v2.
+func (s ComputeInstanceProfileInfo_v2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ComputeInstanceProfileInfo_v2) UnmarshalJSON(data []byte) error {
+	var e encodedComputeInstanceProfileInfo_v2
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ComputeInstanceProfileInfo_v2 as described in encodedComputeInstanceProfileInfo_v2.
+func (s ComputeInstanceProfileInfo_v2) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ComputeInstanceProfileInfo_v2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedComputeInstanceProfileInfo_v2
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// encodedComputeInstanceProfileInfo_v3 is the JSON and YAML representation of ComputeInstanceProfileInfo_v3.
+type encodedComputeInstanceProfileInfo_v3 struct {
+	Version               uint32
+	Id                    uint32
+	SliceCount            uint32
+	InstanceCount         uint32
+	MultiprocessorCount   uint32
+	SharedCopyEngineCount uint32
+	SharedDecoderCount    uint32
+	SharedEncoderCount    uint32
+	SharedJpegCount       uint32
+	SharedOfaCount        uint32
+	Name                  string
+	Capabilities          uint32
+}
+
+func (s ComputeInstanceProfileInfo_v3) encode() encodedComputeInstanceProfileInfo_v3 {
+	return encodedComputeInstanceProfileInfo_v3{
+		Version:               s.Version,
+		Id:                    s.Id,
+		SliceCount:            s.SliceCount,
+		InstanceCount:         s.InstanceCount,
+		MultiprocessorCount:   s.MultiprocessorCount,
+		SharedCopyEngineCount: s.SharedCopyEngineCount,
+		SharedDecoderCount:    s.SharedDecoderCount,
+		SharedEncoderCount:    s.SharedEncoderCount,
+		SharedJpegCount:       s.SharedJpegCount,
+		SharedOfaCount:        s.SharedOfaCount,
+		Name:                  cString(s.Name[:]),
+		Capabilities:          s.Capabilities,
+	}
+}
+
+func (e encodedComputeInstanceProfileInfo_v3) decode() (ComputeInstanceProfileInfo_v3, error) {
+	var s ComputeInstanceProfileInfo_v3
+	s.Version = e.Version
+	s.Id = e.Id
+	s.SliceCount = e.SliceCount
+	s.InstanceCount = e.InstanceCount
+	s.MultiprocessorCount = e.MultiprocessorCount
+	s.SharedCopyEngineCount = e.SharedCopyEngineCount
+	s.SharedDecoderCount = e.SharedDecoderCount
+	s.SharedEncoderCount = e.SharedEncoderCount
+	s.SharedJpegCount = e.SharedJpegCount
+	s.SharedOfaCount = e.SharedOfaCount
+	if err := setCString(s.Name[:], e.Name); err != nil {
+		return s, fmt.Errorf("invalid ComputeInstanceProfileInfo_v3.Name: %w", err)
+	}
+	s.Capabilities = e.Capabilities
+	return s, nil
+}
+
+// MarshalJSON encodes the ComputeInstanceProfileInfo_v3 as described in encodedComputeInstanceProfileInfo_v3.
+func (s ComputeInstanceProfileInfo_v3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.encode())
+}
+
+// UnmarshalJSON decodes the representation returned by MarshalJSON.
+func (s *ComputeInstanceProfileInfo_v3) UnmarshalJSON(data []byte) error {
+	var e encodedComputeInstanceProfileInfo_v3
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
+
+// MarshalYAML encodes the ComputeInstanceProfileInfo_v3 as described in encodedComputeInstanceProfileInfo_v3.
+func (s ComputeInstanceProfileInfo_v3) MarshalYAML() (interface{}, error) {
+	return s.encode(), nil
+}
+
+// UnmarshalYAML decodes the representation returned by MarshalYAML.
+func (s *ComputeInstanceProfileInfo_v3) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var e encodedComputeInstanceProfileInfo_v3
+	if err := unmarshal(&e); err != nil {
+		return err
+	}
+	decoded, err := e.decode()
+	if err != nil {
+		return err
+	}
+	*s = decoded
+	return nil
+}
@@ -0,0 +1,2530 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// affinityScopeValues are the named values of AffinityScope.
+var affinityScopeValues = []enumValue[AffinityScope]{
+	{"NODE", "AFFINITY_SCOPE_NODE", AFFINITY_SCOPE_NODE},
+	{"SOCKET", "AFFINITY_SCOPE_SOCKET", AFFINITY_SCOPE_SOCKET},
+}
+
+// String returns the name of the value without the AFFINITY_SCOPE_ prefix.
+func (e AffinityScope) String() string {
+	return formatEnum("AffinityScope", affinityScopeValues, e)
+}
+
+// MarshalText returns the name of the value without the AFFINITY_SCOPE_ prefix,
+// or its number if it has no name.
+func (e AffinityScope) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(affinityScopeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseAffinityScope.
+func (e *AffinityScope) UnmarshalText(text []byte) error {
+	value, err := ParseAffinityScope(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseAffinityScope returns the AffinityScope with the specified name, with or
+// without the AFFINITY_SCOPE_ prefix, or number. Names are not case-sensitive.
+func ParseAffinityScope(s string) (AffinityScope, error) {
+	return parseEnum("AffinityScope", affinityScopeValues, s)
+}
+
+// brandTypeValues are the named values of BrandType.
+var brandTypeValues = []enumValue[BrandType]{
+	{"UNKNOWN", "BRAND_UNKNOWN", BRAND_UNKNOWN},
+	{"QUADRO", "BRAND_QUADRO", BRAND_QUADRO},
+	{"TESLA", "BRAND_TESLA", BRAND_TESLA},
+	{"NVS", "BRAND_NVS", BRAND_NVS},
+	{"GRID", "BRAND_GRID", BRAND_GRID},
+	{"GEFORCE", "BRAND_GEFORCE", BRAND_GEFORCE},
+	{"TITAN", "BRAND_TITAN", BRAND_TITAN},
+	{"NVIDIA_VAPPS", "BRAND_NVIDIA_VAPPS", BRAND_NVIDIA_VAPPS},
+	{"NVIDIA_VPC", "BRAND_NVIDIA_VPC", BRAND_NVIDIA_VPC},
+	{"NVIDIA_VCS", "BRAND_NVIDIA_VCS", BRAND_NVIDIA_VCS},
+	{"NVIDIA_VWS", "BRAND_NVIDIA_VWS", BRAND_NVIDIA_VWS},
+	{"NVIDIA_CLOUD_GAMING", "BRAND_NVIDIA_CLOUD_GAMING", BRAND_NVIDIA_CLOUD_GAMING},
+	{"NVIDIA_VGAMING", "BRAND_NVIDIA_VGAMING", BRAND_NVIDIA_VGAMING},
+	{"QUADRO_RTX", "BRAND_QUADRO_RTX", BRAND_QUADRO_RTX},
+	{"NVIDIA_RTX", "BRAND_NVIDIA_RTX", BRAND_NVIDIA_RTX},
+	{"NVIDIA", "BRAND_NVIDIA", BRAND_NVIDIA},
+	{"GEFORCE_RTX", "BRAND_GEFORCE_RTX", BRAND_GEFORCE_RTX},
+	{"TITAN_RTX", "BRAND_TITAN_RTX", BRAND_TITAN_RTX},
+	{"COUNT", "BRAND_COUNT", BRAND_COUNT},
+}
+
+// String returns the name of the value without the BRAND_ prefix.
+func (e BrandType) String() string {
+	return formatEnum("BrandType", brandTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the BRAND_ prefix,
+// or its number if it has no name.
+func (e BrandType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(brandTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseBrandType.
+func (e *BrandType) UnmarshalText(text []byte) error {
+	value, err := ParseBrandType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseBrandType returns the BrandType with the specified name, with or
+// without the BRAND_ prefix, or number. Names are not case-sensitive.
+func ParseBrandType(s string) (BrandType, error) {
+	return parseEnum("BrandType", brandTypeValues, s)
+}
+
+// bridgeChipTypeValues are the named values of BridgeChipType.
+var bridgeChipTypeValues = []enumValue[BridgeChipType]{
+	{"PLX", "BRIDGE_CHIP_PLX", BRIDGE_CHIP_PLX},
+	{"BRO4", "BRIDGE_CHIP_BRO4", BRIDGE_CHIP_BRO4},
+}
+
+// String returns the name of the value without the BRIDGE_CHIP_ prefix.
+func (e BridgeChipType) String() string {
+	return formatEnum("BridgeChipType", bridgeChipTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the BRIDGE_CHIP_ prefix,
+// or its number if it has no name.
+func (e BridgeChipType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(bridgeChipTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseBridgeChipType.
+func (e *BridgeChipType) UnmarshalText(text []byte) error {
+	value, err := ParseBridgeChipType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseBridgeChipType returns the BridgeChipType with the specified name, with or
+// without the BRIDGE_CHIP_ prefix, or number. Names are not case-sensitive.
+func ParseBridgeChipType(s string) (BridgeChipType, error) {
+	return parseEnum("BridgeChipType", bridgeChipTypeValues, s)
+}
+
+// busTypeValues are the named values of BusType.
+var busTypeValues = []enumValue[BusType]{
+	{"UNKNOWN", "BUS_TYPE_UNKNOWN", BUS_TYPE_UNKNOWN},
+	{"PCI", "BUS_TYPE_PCI", BUS_TYPE_PCI},
+	{"PCIE", "BUS_TYPE_PCIE", BUS_TYPE_PCIE},
+	{"FPCI", "BUS_TYPE_FPCI", BUS_TYPE_FPCI},
+	{"AGP", "BUS_TYPE_AGP", BUS_TYPE_AGP},
+}
+
+// String returns the name of the value without the BUS_TYPE_ prefix.
+func (e BusType) String() string {
+	return formatEnum("BusType", busTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the BUS_TYPE_ prefix,
+// or its number if it has no name.
+func (e BusType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(busTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseBusType.
+func (e *BusType) UnmarshalText(text []byte) error {
+	value, err := ParseBusType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseBusType returns the BusType with the specified name, with or
+// without the BUS_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseBusType(s string) (BusType, error) {
+	return parseEnum("BusType", busTypeValues, s)
+}
+
+// clockIdValues are the named values of ClockId.
+var clockIdValues = []enumValue[ClockId]{
+	{"CURRENT", "CLOCK_ID_CURRENT", CLOCK_ID_CURRENT},
+	{"APP_CLOCK_TARGET", "CLOCK_ID_APP_CLOCK_TARGET", CLOCK_ID_APP_CLOCK_TARGET},
+	{"APP_CLOCK_DEFAULT", "CLOCK_ID_APP_CLOCK_DEFAULT", CLOCK_ID_APP_CLOCK_DEFAULT},
+	{"CUSTOMER_BOOST_MAX", "CLOCK_ID_CUSTOMER_BOOST_MAX", CLOCK_ID_CUSTOMER_BOOST_MAX},
+	{"COUNT", "CLOCK_ID_COUNT", CLOCK_ID_COUNT},
+}
+
+// String returns the name of the value without the CLOCK_ID_ prefix.
+func (e ClockId) String() string {
+	return formatEnum("ClockId", clockIdValues, e)
+}
+
+// MarshalText returns the name of the value without the CLOCK_ID_ prefix,
+// or its number if it has no name.
+func (e ClockId) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(clockIdValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseClockId.
+func (e *ClockId) UnmarshalText(text []byte) error {
+	value, err := ParseClockId(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseClockId returns the ClockId with the specified name, with or
+// without the CLOCK_ID_ prefix, or number. Names are not case-sensitive.
+func ParseClockId(s string) (ClockId, error) {
+	return parseEnum("ClockId", clockIdValues, s)
+}
+
+// clockLimitIdValues are the named values of ClockLimitId.
+var clockLimitIdValues = []enumValue[ClockLimitId]{
+	{"RANGE_START", "CLOCK_LIMIT_ID_RANGE_START", CLOCK_LIMIT_ID_RANGE_START},
+	{"TDP", "CLOCK_LIMIT_ID_TDP", CLOCK_LIMIT_ID_TDP},
+	{"UNLIMITED", "CLOCK_LIMIT_ID_UNLIMITED", CLOCK_LIMIT_ID_UNLIMITED},
+}
+
+// String returns the name of the value without the CLOCK_LIMIT_ID_ prefix.
+func (e ClockLimitId) String() string {
+	return formatEnum("ClockLimitId", clockLimitIdValues, e)
+}
+
+// MarshalText returns the name of the value without the CLOCK_LIMIT_ID_ prefix,
+// or its number if it has no name.
+func (e ClockLimitId) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(clockLimitIdValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseClockLimitId.
+func (e *ClockLimitId) UnmarshalText(text []byte) error {
+	value, err := ParseClockLimitId(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseClockLimitId returns the ClockLimitId with the specified name, with or
+// without the CLOCK_LIMIT_ID_ prefix, or number. Names are not case-sensitive.
+func ParseClockLimitId(s string) (ClockLimitId, error) {
+	return parseEnum("ClockLimitId", clockLimitIdValues, s)
+}
+
+// clockTypeValues are the named values of ClockType.
+var clockTypeValues = []enumValue[ClockType]{
+	{"GRAPHICS", "CLOCK_GRAPHICS", CLOCK_GRAPHICS},
+	{"SM", "CLOCK_SM", CLOCK_SM},
+	{"MEM", "CLOCK_MEM", CLOCK_MEM},
+	{"VIDEO", "CLOCK_VIDEO", CLOCK_VIDEO},
+	{"COUNT", "CLOCK_COUNT", CLOCK_COUNT},
+}
+
+// String returns the name of the value without the CLOCK_ prefix.
+func (e ClockType) String() string {
+	return formatEnum("ClockType", clockTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the CLOCK_ prefix,
+// or its number if it has no name.
+func (e ClockType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(clockTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseClockType.
+func (e *ClockType) UnmarshalText(text []byte) error {
+	value, err := ParseClockType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseClockType returns the ClockType with the specified name, with or
+// without the CLOCK_ prefix, or number. Names are not case-sensitive.
+func ParseClockType(s string) (ClockType, error) {
+	return parseEnum("ClockType", clockTypeValues, s)
+}
+
+// computeModeValues are the named values of ComputeMode.
+var computeModeValues = []enumValue[ComputeMode]{
+	{"DEFAULT", "COMPUTEMODE_DEFAULT", COMPUTEMODE_DEFAULT},
+	{"EXCLUSIVE_THREAD", "COMPUTEMODE_EXCLUSIVE_THREAD", COMPUTEMODE_EXCLUSIVE_THREAD},
+	{"PROHIBITED", "COMPUTEMODE_PROHIBITED", COMPUTEMODE_PROHIBITED},
+	{"EXCLUSIVE_PROCESS", "COMPUTEMODE_EXCLUSIVE_PROCESS", COMPUTEMODE_EXCLUSIVE_PROCESS},
+	{"COUNT", "COMPUTEMODE_COUNT", COMPUTEMODE_COUNT},
+}
+
+// String returns the name of the value without the COMPUTEMODE_ prefix.
+func (e ComputeMode) String() string {
+	return formatEnum("ComputeMode", computeModeValues, e)
+}
+
+// MarshalText returns the name of the value without the COMPUTEMODE_ prefix,
+// or its number if it has no name.
+func (e ComputeMode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(computeModeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseComputeMode.
+func (e *ComputeMode) UnmarshalText(text []byte) error {
+	value, err := ParseComputeMode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseComputeMode returns the ComputeMode with the specified name, with or
+// without the COMPUTEMODE_ prefix, or number. Names are not case-sensitive.
+func ParseComputeMode(s string) (ComputeMode, error) {
+	return parseEnum("ComputeMode", computeModeValues, s)
+}
+
+// coolerControlValues are the named values of CoolerControl.
+var coolerControlValues = []enumValue[CoolerControl]{
+	{"NONE", "THERMAL_COOLER_SIGNAL_NONE", THERMAL_COOLER_SIGNAL_NONE},
+	{"TOGGLE", "THERMAL_COOLER_SIGNAL_TOGGLE", THERMAL_COOLER_SIGNAL_TOGGLE},
+	{"VARIABLE", "THERMAL_COOLER_SIGNAL_VARIABLE", THERMAL_COOLER_SIGNAL_VARIABLE},
+	{"COUNT", "THERMAL_COOLER_SIGNAL_COUNT", THERMAL_COOLER_SIGNAL_COUNT},
+}
+
+// String returns the name of the value without the THERMAL_COOLER_SIGNAL_ prefix.
+func (e CoolerControl) String() string {
+	return formatEnum("CoolerControl", coolerControlValues, e)
+}
+
+// MarshalText returns the name of the value without the THERMAL_COOLER_SIGNAL_ prefix,
+// or its number if it has no name.
+func (e CoolerControl) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(coolerControlValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseCoolerControl.
+func (e *CoolerControl) UnmarshalText(text []byte) error {
+	value, err := ParseCoolerControl(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseCoolerControl returns the CoolerControl with the specified name, with or
+// without the THERMAL_COOLER_SIGNAL_ prefix, or number. Names are not case-sensitive.
+func ParseCoolerControl(s string) (CoolerControl, error) {
+	return parseEnum("CoolerControl", coolerControlValues, s)
+}
+
+// coolerTargetValues are the named values of CoolerTarget.
+var coolerTargetValues = []enumValue[CoolerTarget]{
+	{"NONE", "THERMAL_COOLER_TARGET_NONE", THERMAL_COOLER_TARGET_NONE},
+	{"GPU", "THERMAL_COOLER_TARGET_GPU", THERMAL_COOLER_TARGET_GPU},
+	{"MEMORY", "THERMAL_COOLER_TARGET_MEMORY", THERMAL_COOLER_TARGET_MEMORY},
+	{"POWER_SUPPLY", "THERMAL_COOLER_TARGET_POWER_SUPPLY", THERMAL_COOLER_TARGET_POWER_SUPPLY},
+	{"GPU_RELATED", "THERMAL_COOLER_TARGET_GPU_RELATED", THERMAL_COOLER_TARGET_GPU_RELATED},
+}
+
+// String returns the name of the value without the THERMAL_COOLER_TARGET_ prefix.
+func (e CoolerTarget) String() string {
+	return formatEnum("CoolerTarget", coolerTargetValues, e)
+}
+
+// MarshalText returns the name of the value without the THERMAL_COOLER_TARGET_ prefix,
+// or its number if it has no name.
+func (e CoolerTarget) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(coolerTargetValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseCoolerTarget.
+func (e *CoolerTarget) UnmarshalText(text []byte) error {
+	value, err := ParseCoolerTarget(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseCoolerTarget returns the CoolerTarget with the specified name, with or
+// without the THERMAL_COOLER_TARGET_ prefix, or number. Names are not case-sensitive.
+func ParseCoolerTarget(s string) (CoolerTarget, error) {
+	return parseEnum("CoolerTarget", coolerTargetValues, s)
+}
+
+// detachGpuStateValues are the named values of DetachGpuState.
+var detachGpuStateValues = []enumValue[DetachGpuState]{
+	{"KEEP", "DETACH_GPU_KEEP", DETACH_GPU_KEEP},
+	{"REMOVE", "DETACH_GPU_REMOVE", DETACH_GPU_REMOVE},
+}
+
+// String returns the name of the value without the DETACH_GPU_ prefix.
+func (e DetachGpuState) String() string {
+	return formatEnum("DetachGpuState", detachGpuStateValues, e)
+}
+
+// MarshalText returns the name of the value without the DETACH_GPU_ prefix,
+// or its number if it has no name.
+func (e DetachGpuState) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(detachGpuStateValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDetachGpuState.
+func (e *DetachGpuState) UnmarshalText(text []byte) error {
+	value, err := ParseDetachGpuState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDetachGpuState returns the DetachGpuState with the specified name, with or
+// without the DETACH_GPU_ prefix, or number. Names are not case-sensitive.
+func ParseDetachGpuState(s string) (DetachGpuState, error) {
+	return parseEnum("DetachGpuState", detachGpuStateValues, s)
+}
+
+// deviceAddressingModeTypeValues are the named values of DeviceAddressingModeType.
+var deviceAddressingModeTypeValues = []enumValue[DeviceAddressingModeType]{
+	{"NONE", "DEVICE_ADDRESSING_MODE_NONE", DEVICE_ADDRESSING_MODE_NONE},
+	{"HMM", "DEVICE_ADDRESSING_MODE_HMM", DEVICE_ADDRESSING_MODE_HMM},
+	{"ATS", "DEVICE_ADDRESSING_MODE_ATS", DEVICE_ADDRESSING_MODE_ATS},
+}
+
+// String returns the name of the value without the DEVICE_ADDRESSING_MODE_ prefix.
+func (e DeviceAddressingModeType) String() string {
+	return formatEnum("DeviceAddressingModeType", deviceAddressingModeTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the DEVICE_ADDRESSING_MODE_ prefix,
+// or its number if it has no name.
+func (e DeviceAddressingModeType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(deviceAddressingModeTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDeviceAddressingModeType.
+func (e *DeviceAddressingModeType) UnmarshalText(text []byte) error {
+	value, err := ParseDeviceAddressingModeType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDeviceAddressingModeType returns the DeviceAddressingModeType with the specified name, with or
+// without the DEVICE_ADDRESSING_MODE_ prefix, or number. Names are not case-sensitive.
+func ParseDeviceAddressingModeType(s string) (DeviceAddressingModeType, error) {
+	return parseEnum("DeviceAddressingModeType", deviceAddressingModeTypeValues, s)
+}
+
+// deviceArchitectureValues are the named values of DeviceArchitecture.
+var deviceArchitectureValues = []enumValue[DeviceArchitecture]{
+	{"KEPLER", "DEVICE_ARCH_KEPLER", DEVICE_ARCH_KEPLER},
+	{"MAXWELL", "DEVICE_ARCH_MAXWELL", DEVICE_ARCH_MAXWELL},
+	{"PASCAL", "DEVICE_ARCH_PASCAL", DEVICE_ARCH_PASCAL},
+	{"VOLTA", "DEVICE_ARCH_VOLTA", DEVICE_ARCH_VOLTA},
+	{"TURING", "DEVICE_ARCH_TURING", DEVICE_ARCH_TURING},
+	{"AMPERE", "DEVICE_ARCH_AMPERE", DEVICE_ARCH_AMPERE},
+	{"ADA", "DEVICE_ARCH_ADA", DEVICE_ARCH_ADA},
+	{"HOPPER", "DEVICE_ARCH_HOPPER", DEVICE_ARCH_HOPPER},
+	{"BLACKWELL", "DEVICE_ARCH_BLACKWELL", DEVICE_ARCH_BLACKWELL},
+	{"UNKNOWN", "DEVICE_ARCH_UNKNOWN", DEVICE_ARCH_UNKNOWN},
+}
+
+// String returns the name of the value without the DEVICE_ARCH_ prefix.
+func (e DeviceArchitecture) String() string {
+	return formatEnum("DeviceArchitecture", deviceArchitectureValues, e)
+}
+
+// MarshalText returns the name of the value without the DEVICE_ARCH_ prefix,
+// or its number if it has no name.
+func (e DeviceArchitecture) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(deviceArchitectureValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDeviceArchitecture.
+func (e *DeviceArchitecture) UnmarshalText(text []byte) error {
+	value, err := ParseDeviceArchitecture(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDeviceArchitecture returns the DeviceArchitecture with the specified name, with or
+// without the DEVICE_ARCH_ prefix, or number. Names are not case-sensitive.
+func ParseDeviceArchitecture(s string) (DeviceArchitecture, error) {
+	return parseEnum("DeviceArchitecture", deviceArchitectureValues, s)
+}
+
+// deviceGpuRecoveryActionValues are the named values of DeviceGpuRecoveryAction.
+var deviceGpuRecoveryActionValues = []enumValue[DeviceGpuRecoveryAction]{
+	{"NONE", "GPU_RECOVERY_ACTION_NONE", GPU_RECOVERY_ACTION_NONE},
+	{"GPU_RESET", "GPU_RECOVERY_ACTION_GPU_RESET", GPU_RECOVERY_ACTION_GPU_RESET},
+	{"NODE_REBOOT", "GPU_RECOVERY_ACTION_NODE_REBOOT", GPU_RECOVERY_ACTION_NODE_REBOOT},
+	{"DRAIN_P2P", "GPU_RECOVERY_ACTION_DRAIN_P2P", GPU_RECOVERY_ACTION_DRAIN_P2P},
+	{"DRAIN_AND_RESET", "GPU_RECOVERY_ACTION_DRAIN_AND_RESET", GPU_RECOVERY_ACTION_DRAIN_AND_RESET},
+}
+
+// String returns the name of the value without the GPU_RECOVERY_ACTION_ prefix.
+func (e DeviceGpuRecoveryAction) String() string {
+	return formatEnum("DeviceGpuRecoveryAction", deviceGpuRecoveryActionValues, e)
+}
+
+// MarshalText returns the name of the value without the GPU_RECOVERY_ACTION_ prefix,
+// or its number if it has no name.
+func (e DeviceGpuRecoveryAction) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(deviceGpuRecoveryActionValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDeviceGpuRecoveryAction.
+func (e *DeviceGpuRecoveryAction) UnmarshalText(text []byte) error {
+	value, err := ParseDeviceGpuRecoveryAction(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDeviceGpuRecoveryAction returns the DeviceGpuRecoveryAction with the specified name, with or
+// without the GPU_RECOVERY_ACTION_ prefix, or number. Names are not case-sensitive.
+func ParseDeviceGpuRecoveryAction(s string) (DeviceGpuRecoveryAction, error) {
+	return parseEnum("DeviceGpuRecoveryAction", deviceGpuRecoveryActionValues, s)
+}
+
+// deviceVgpuCapabilityValues are the named values of DeviceVgpuCapability.
+var deviceVgpuCapabilityValues = []enumValue[DeviceVgpuCapability]{
+	{"FRACTIONAL_MULTI_VGPU", "DEVICE_VGPU_CAP_FRACTIONAL_MULTI_VGPU", DEVICE_VGPU_CAP_FRACTIONAL_MULTI_VGPU},
+	{"HETEROGENEOUS_TIMESLICE_PROFILES", "DEVICE_VGPU_CAP_HETEROGENEOUS_TIMESLICE_PROFILES", DEVICE_VGPU_CAP_HETEROGENEOUS_TIMESLICE_PROFILES},
+	{"HETEROGENEOUS_TIMESLICE_SIZES", "DEVICE_VGPU_CAP_HETEROGENEOUS_TIMESLICE_SIZES", DEVICE_VGPU_CAP_HETEROGENEOUS_TIMESLICE_SIZES},
+	{"READ_DEVICE_BUFFER_BW", "DEVICE_VGPU_CAP_READ_DEVICE_BUFFER_BW", DEVICE_VGPU_CAP_READ_DEVICE_BUFFER_BW},
+	{"WRITE_DEVICE_BUFFER_BW", "DEVICE_VGPU_CAP_WRITE_DEVICE_BUFFER_BW", DEVICE_VGPU_CAP_WRITE_DEVICE_BUFFER_BW},
+	{"DEVICE_STREAMING", "DEVICE_VGPU_CAP_DEVICE_STREAMING", DEVICE_VGPU_CAP_DEVICE_STREAMING},
+	{"MINI_QUARTER_GPU", "DEVICE_VGPU_CAP_MINI_QUARTER_GPU", DEVICE_VGPU_CAP_MINI_QUARTER_GPU},
+	{"COMPUTE_MEDIA_ENGINE_GPU", "DEVICE_VGPU_CAP_COMPUTE_MEDIA_ENGINE_GPU", DEVICE_VGPU_CAP_COMPUTE_MEDIA_ENGINE_GPU},
+	{"WARM_UPDATE", "DEVICE_VGPU_CAP_WARM_UPDATE", DEVICE_VGPU_CAP_WARM_UPDATE},
+	{"HOMOGENEOUS_PLACEMENTS", "DEVICE_VGPU_CAP_HOMOGENEOUS_PLACEMENTS", DEVICE_VGPU_CAP_HOMOGENEOUS_PLACEMENTS},
+	{"MIG_TIMESLICING_SUPPORTED", "DEVICE_VGPU_CAP_MIG_TIMESLICING_SUPPORTED", DEVICE_VGPU_CAP_MIG_TIMESLICING_SUPPORTED},
+	{"MIG_TIMESLICING_ENABLED", "DEVICE_VGPU_CAP_MIG_TIMESLICING_ENABLED", DEVICE_VGPU_CAP_MIG_TIMESLICING_ENABLED},
+	{"COUNT", "DEVICE_VGPU_CAP_COUNT", DEVICE_VGPU_CAP_COUNT},
+}
+
+// String returns the name of the value without the DEVICE_VGPU_CAP_ prefix.
+func (e DeviceVgpuCapability) String() string {
+	return formatEnum("DeviceVgpuCapability", deviceVgpuCapabilityValues, e)
+}
+
+// MarshalText returns the name of the value without the DEVICE_VGPU_CAP_ prefix,
+// or its number if it has no name.
+func (e DeviceVgpuCapability) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(deviceVgpuCapabilityValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDeviceVgpuCapability.
+func (e *DeviceVgpuCapability) UnmarshalText(text []byte) error {
+	value, err := ParseDeviceVgpuCapability(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDeviceVgpuCapability returns the DeviceVgpuCapability with the specified name, with or
+// without the DEVICE_VGPU_CAP_ prefix, or number. Names are not case-sensitive.
+func ParseDeviceVgpuCapability(s string) (DeviceVgpuCapability, error) {
+	return parseEnum("DeviceVgpuCapability", deviceVgpuCapabilityValues, s)
+}
+
+// driverModelValues are the named values of DriverModel.
+var driverModelValues = []enumValue[DriverModel]{
+	{"WDDM", "DRIVER_WDDM", DRIVER_WDDM},
+	{"WDM", "DRIVER_WDM", DRIVER_WDM},
+	{"MCDM", "DRIVER_MCDM", DRIVER_MCDM},
+}
+
+// String returns the name of the value without the DRIVER_ prefix.
+func (e DriverModel) String() string {
+	return formatEnum("DriverModel", driverModelValues, e)
+}
+
+// MarshalText returns the name of the value without the DRIVER_ prefix,
+// or its number if it has no name.
+func (e DriverModel) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(driverModelValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseDriverModel.
+func (e *DriverModel) UnmarshalText(text []byte) error {
+	value, err := ParseDriverModel(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseDriverModel returns the DriverModel with the specified name, with or
+// without the DRIVER_ prefix, or number. Names are not case-sensitive.
+func ParseDriverModel(s string) (DriverModel, error) {
+	return parseEnum("DriverModel", driverModelValues, s)
+}
+
+// eccCounterTypeValues are the named values of EccCounterType.
+var eccCounterTypeValues = []enumValue[EccCounterType]{
+	{"VOLATILE_ECC", "VOLATILE_ECC", VOLATILE_ECC},
+	{"AGGREGATE_ECC", "AGGREGATE_ECC", AGGREGATE_ECC},
+	{"ECC_COUNTER_TYPE_COUNT", "ECC_COUNTER_TYPE_COUNT", ECC_COUNTER_TYPE_COUNT},
+}
+
+// String returns the name of the value.
+func (e EccCounterType) String() string {
+	return formatEnum("EccCounterType", eccCounterTypeValues, e)
+}
+
+// MarshalText returns the name of the value,
+// or its number if it has no name.
+func (e EccCounterType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(eccCounterTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseEccCounterType.
+func (e *EccCounterType) UnmarshalText(text []byte) error {
+	value, err := ParseEccCounterType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseEccCounterType returns the EccCounterType with the specified name or number.
+// Names are not case-sensitive.
+func ParseEccCounterType(s string) (EccCounterType, error) {
+	return parseEnum("EccCounterType", eccCounterTypeValues, s)
+}
+
+// enableStateValues are the named values of EnableState.
+var enableStateValues = []enumValue[EnableState]{
+	{"DISABLED", "FEATURE_DISABLED", FEATURE_DISABLED},
+	{"ENABLED", "FEATURE_ENABLED", FEATURE_ENABLED},
+}
+
+// String returns the name of the value without the FEATURE_ prefix.
+func (e EnableState) String() string {
+	return formatEnum("EnableState", enableStateValues, e)
+}
+
+// MarshalText returns the name of the value without the FEATURE_ prefix,
+// or its number if it has no name.
+func (e EnableState) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(enableStateValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseEnableState.
+func (e *EnableState) UnmarshalText(text []byte) error {
+	value, err := ParseEnableState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseEnableState returns the EnableState with the specified name, with or
+// without the FEATURE_ prefix, or number. Names are not case-sensitive.
+func ParseEnableState(s string) (EnableState, error) {
+	return parseEnum("EnableState", enableStateValues, s)
+}
+
+// encoderTypeValues are the named values of EncoderType.
+var encoderTypeValues = []enumValue[EncoderType]{
+	{"H264", "ENCODER_QUERY_H264", ENCODER_QUERY_H264},
+	{"HEVC", "ENCODER_QUERY_HEVC", ENCODER_QUERY_HEVC},
+	{"AV1", "ENCODER_QUERY_AV1", ENCODER_QUERY_AV1},
+	{"UNKNOWN", "ENCODER_QUERY_UNKNOWN", ENCODER_QUERY_UNKNOWN},
+}
+
+// String returns the name of the value without the ENCODER_QUERY_ prefix.
+func (e EncoderType) String() string {
+	return formatEnum("EncoderType", encoderTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the ENCODER_QUERY_ prefix,
+// or its number if it has no name.
+func (e EncoderType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(encoderTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseEncoderType.
+func (e *EncoderType) UnmarshalText(text []byte) error {
+	value, err := ParseEncoderType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseEncoderType returns the EncoderType with the specified name, with or
+// without the ENCODER_QUERY_ prefix, or number. Names are not case-sensitive.
+func ParseEncoderType(s string) (EncoderType, error) {
+	return parseEnum("EncoderType", encoderTypeValues, s)
+}
+
+// fbcSessionTypeValues are the named values of FBCSessionType.
+var fbcSessionTypeValues = []enumValue[FBCSessionType]{
+	{"UNKNOWN", "FBC_SESSION_TYPE_UNKNOWN", FBC_SESSION_TYPE_UNKNOWN},
+	{"TOSYS", "FBC_SESSION_TYPE_TOSYS", FBC_SESSION_TYPE_TOSYS},
+	{"CUDA", "FBC_SESSION_TYPE_CUDA", FBC_SESSION_TYPE_CUDA},
+	{"VID", "FBC_SESSION_TYPE_VID", FBC_SESSION_TYPE_VID},
+	{"HWENC", "FBC_SESSION_TYPE_HWENC", FBC_SESSION_TYPE_HWENC},
+}
+
+// String returns the name of the value without the FBC_SESSION_TYPE_ prefix.
+func (e FBCSessionType) String() string {
+	return formatEnum("FBCSessionType", fbcSessionTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the FBC_SESSION_TYPE_ prefix,
+// or its number if it has no name.
+func (e FBCSessionType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(fbcSessionTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseFBCSessionType.
+func (e *FBCSessionType) UnmarshalText(text []byte) error {
+	value, err := ParseFBCSessionType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseFBCSessionType returns the FBCSessionType with the specified name, with or
+// without the FBC_SESSION_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseFBCSessionType(s string) (FBCSessionType, error) {
+	return parseEnum("FBCSessionType", fbcSessionTypeValues, s)
+}
+
+// fanControlPolicyValues are the named values of FanControlPolicy.
+var fanControlPolicyValues = []enumValue[FanControlPolicy]{
+	{"TEMPERATURE_CONTINOUS_SW", "FAN_POLICY_TEMPERATURE_CONTINOUS_SW", FAN_POLICY_TEMPERATURE_CONTINOUS_SW},
+	{"MANUAL", "FAN_POLICY_MANUAL", FAN_POLICY_MANUAL},
+}
+
+// String returns the name of the value without the FAN_POLICY_ prefix.
+func (e FanControlPolicy) String() string {
+	return formatEnum("FanControlPolicy", fanControlPolicyValues, e)
+}
+
+// MarshalText returns the name of the value without the FAN_POLICY_ prefix,
+// or its number if it has no name.
+func (e FanControlPolicy) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(fanControlPolicyValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseFanControlPolicy.
+func (e *FanControlPolicy) UnmarshalText(text []byte) error {
+	value, err := ParseFanControlPolicy(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseFanControlPolicy returns the FanControlPolicy with the specified name, with or
+// without the FAN_POLICY_ prefix, or number. Names are not case-sensitive.
+func ParseFanControlPolicy(s string) (FanControlPolicy, error) {
+	return parseEnum("FanControlPolicy", fanControlPolicyValues, s)
+}
+
+// fanStateValues are the named values of FanState.
+var fanStateValues = []enumValue[FanState]{
+	{"NORMAL", "FAN_NORMAL", FAN_NORMAL},
+	{"FAILED", "FAN_FAILED", FAN_FAILED},
+}
+
+// String returns the name of the value without the FAN_ prefix.
+func (e FanState) String() string {
+	return formatEnum("FanState", fanStateValues, e)
+}
+
+// MarshalText returns the name of the value without the FAN_ prefix,
+// or its number if it has no name.
+func (e FanState) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(fanStateValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseFanState.
+func (e *FanState) UnmarshalText(text []byte) error {
+	value, err := ParseFanState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseFanState returns the FanState with the specified name, with or
+// without the FAN_ prefix, or number. Names are not case-sensitive.
+func ParseFanState(s string) (FanState, error) {
+	return parseEnum("FanState", fanStateValues, s)
+}
+
+// gpmMetricIdValues are the named values of GpmMetricId.
+var gpmMetricIdValues = []enumValue[GpmMetricId]{
+	{"GRAPHICS_UTIL", "GPM_METRIC_GRAPHICS_UTIL", GPM_METRIC_GRAPHICS_UTIL},
+	{"SM_UTIL", "GPM_METRIC_SM_UTIL", GPM_METRIC_SM_UTIL},
+	{"SM_OCCUPANCY", "GPM_METRIC_SM_OCCUPANCY", GPM_METRIC_SM_OCCUPANCY},
+	{"INTEGER_UTIL", "GPM_METRIC_INTEGER_UTIL", GPM_METRIC_INTEGER_UTIL},
+	{"ANY_TENSOR_UTIL", "GPM_METRIC_ANY_TENSOR_UTIL", GPM_METRIC_ANY_TENSOR_UTIL},
+	{"DFMA_TENSOR_UTIL", "GPM_METRIC_DFMA_TENSOR_UTIL", GPM_METRIC_DFMA_TENSOR_UTIL},
+	{"HMMA_TENSOR_UTIL", "GPM_METRIC_HMMA_TENSOR_UTIL", GPM_METRIC_HMMA_TENSOR_UTIL},
+	{"IMMA_TENSOR_UTIL", "GPM_METRIC_IMMA_TENSOR_UTIL", GPM_METRIC_IMMA_TENSOR_UTIL},
+	{"DRAM_BW_UTIL", "GPM_METRIC_DRAM_BW_UTIL", GPM_METRIC_DRAM_BW_UTIL},
+	{"FP64_UTIL", "GPM_METRIC_FP64_UTIL", GPM_METRIC_FP64_UTIL},
+	{"FP32_UTIL", "GPM_METRIC_FP32_UTIL", GPM_METRIC_FP32_UTIL},
+	{"FP16_UTIL", "GPM_METRIC_FP16_UTIL", GPM_METRIC_FP16_UTIL},
+	{"PCIE_TX_PER_SEC", "GPM_METRIC_PCIE_TX_PER_SEC", GPM_METRIC_PCIE_TX_PER_SEC},
+	{"PCIE_RX_PER_SEC", "GPM_METRIC_PCIE_RX_PER_SEC", GPM_METRIC_PCIE_RX_PER_SEC},
+	{"NVDEC_0_UTIL", "GPM_METRIC_NVDEC_0_UTIL", GPM_METRIC_NVDEC_0_UTIL},
+	{"NVDEC_1_UTIL", "GPM_METRIC_NVDEC_1_UTIL", GPM_METRIC_NVDEC_1_UTIL},
+	{"NVDEC_2_UTIL", "GPM_METRIC_NVDEC_2_UTIL", GPM_METRIC_NVDEC_2_UTIL},
+	{"NVDEC_3_UTIL", "GPM_METRIC_NVDEC_3_UTIL", GPM_METRIC_NVDEC_3_UTIL},
+	{"NVDEC_4_UTIL", "GPM_METRIC_NVDEC_4_UTIL", GPM_METRIC_NVDEC_4_UTIL},
+	{"NVDEC_5_UTIL", "GPM_METRIC_NVDEC_5_UTIL", GPM_METRIC_NVDEC_5_UTIL},
+	{"NVDEC_6_UTIL", "GPM_METRIC_NVDEC_6_UTIL", GPM_METRIC_NVDEC_6_UTIL},
+	{"NVDEC_7_UTIL", "GPM_METRIC_NVDEC_7_UTIL", GPM_METRIC_NVDEC_7_UTIL},
+	{"NVJPG_0_UTIL", "GPM_METRIC_NVJPG_0_UTIL", GPM_METRIC_NVJPG_0_UTIL},
+	{"NVJPG_1_UTIL", "GPM_METRIC_NVJPG_1_UTIL", GPM_METRIC_NVJPG_1_UTIL},
+	{"NVJPG_2_UTIL", "GPM_METRIC_NVJPG_2_UTIL", GPM_METRIC_NVJPG_2_UTIL},
+	{"NVJPG_3_UTIL", "GPM_METRIC_NVJPG_3_UTIL", GPM_METRIC_NVJPG_3_UTIL},
+	{"NVJPG_4_UTIL", "GPM_METRIC_NVJPG_4_UTIL", GPM_METRIC_NVJPG_4_UTIL},
+	{"NVJPG_5_UTIL", "GPM_METRIC_NVJPG_5_UTIL", GPM_METRIC_NVJPG_5_UTIL},
+	{"NVJPG_6_UTIL", "GPM_METRIC_NVJPG_6_UTIL", GPM_METRIC_NVJPG_6_UTIL},
+	{"NVJPG_7_UTIL", "GPM_METRIC_NVJPG_7_UTIL", GPM_METRIC_NVJPG_7_UTIL},
+	{"NVOFA_0_UTIL", "GPM_METRIC_NVOFA_0_UTIL", GPM_METRIC_NVOFA_0_UTIL},
+	{"NVOFA_1_UTIL", "GPM_METRIC_NVOFA_1_UTIL", GPM_METRIC_NVOFA_1_UTIL},
+	{"NVLINK_TOTAL_RX_PER_SEC", "GPM_METRIC_NVLINK_TOTAL_RX_PER_SEC", GPM_METRIC_NVLINK_TOTAL_RX_PER_SEC},
+	{"NVLINK_TOTAL_TX_PER_SEC", "GPM_METRIC_NVLINK_TOTAL_TX_PER_SEC", GPM_METRIC_NVLINK_TOTAL_TX_PER_SEC},
+	{"NVLINK_L0_RX_PER_SEC", "GPM_METRIC_NVLINK_L0_RX_PER_SEC", GPM_METRIC_NVLINK_L0_RX_PER_SEC},
+	{"NVLINK_L0_TX_PER_SEC", "GPM_METRIC_NVLINK_L0_TX_PER_SEC", GPM_METRIC_NVLINK_L0_TX_PER_SEC},
+	{"NVLINK_L1_RX_PER_SEC", "GPM_METRIC_NVLINK_L1_RX_PER_SEC", GPM_METRIC_NVLINK_L1_RX_PER_SEC},
+	{"NVLINK_L1_TX_PER_SEC", "GPM_METRIC_NVLINK_L1_TX_PER_SEC", GPM_METRIC_NVLINK_L1_TX_PER_SEC},
+	{"NVLINK_L2_RX_PER_SEC", "GPM_METRIC_NVLINK_L2_RX_PER_SEC", GPM_METRIC_NVLINK_L2_RX_PER_SEC},
+	{"NVLINK_L2_TX_PER_SEC", "GPM_METRIC_NVLINK_L2_TX_PER_SEC", GPM_METRIC_NVLINK_L2_TX_PER_SEC},
+	{"NVLINK_L3_RX_PER_SEC", "GPM_METRIC_NVLINK_L3_RX_PER_SEC", GPM_METRIC_NVLINK_L3_RX_PER_SEC},
+	{"NVLINK_L3_TX_PER_SEC", "GPM_METRIC_NVLINK_L3_TX_PER_SEC", GPM_METRIC_NVLINK_L3_TX_PER_SEC},
+	{"NVLINK_L4_RX_PER_SEC", "GPM_METRIC_NVLINK_L4_RX_PER_SEC", GPM_METRIC_NVLINK_L4_RX_PER_SEC},
+	{"NVLINK_L4_TX_PER_SEC", "GPM_METRIC_NVLINK_L4_TX_PER_SEC", GPM_METRIC_NVLINK_L4_TX_PER_SEC},
+	{"NVLINK_L5_RX_PER_SEC", "GPM_METRIC_NVLINK_L5_RX_PER_SEC", GPM_METRIC_NVLINK_L5_RX_PER_SEC},
+	{"NVLINK_L5_TX_PER_SEC", "GPM_METRIC_NVLINK_L5_TX_PER_SEC", GPM_METRIC_NVLINK_L5_TX_PER_SEC},
+	{"NVLINK_L6_RX_PER_SEC", "GPM_METRIC_NVLINK_L6_RX_PER_SEC", GPM_METRIC_NVLINK_L6_RX_PER_SEC},
+	{"NVLINK_L6_TX_PER_SEC", "GPM_METRIC_NVLINK_L6_TX_PER_SEC", GPM_METRIC_NVLINK_L6_TX_PER_SEC},
+	{"NVLINK_L7_RX_PER_SEC", "GPM_METRIC_NVLINK_L7_RX_PER_SEC", GPM_METRIC_NVLINK_L7_RX_PER_SEC},
+	{"NVLINK_L7_TX_PER_SEC", "GPM_METRIC_NVLINK_L7_TX_PER_SEC", GPM_METRIC_NVLINK_L7_TX_PER_SEC},
+	{"NVLINK_L8_RX_PER_SEC", "GPM_METRIC_NVLINK_L8_RX_PER_SEC", GPM_METRIC_NVLINK_L8_RX_PER_SEC},
+	{"NVLINK_L8_TX_PER_SEC", "GPM_METRIC_NVLINK_L8_TX_PER_SEC", GPM_METRIC_NVLINK_L8_TX_PER_SEC},
+	{"NVLINK_L9_RX_PER_SEC", "GPM_METRIC_NVLINK_L9_RX_PER_SEC", GPM_METRIC_NVLINK_L9_RX_PER_SEC},
+	{"NVLINK_L9_TX_PER_SEC", "GPM_METRIC_NVLINK_L9_TX_PER_SEC", GPM_METRIC_NVLINK_L9_TX_PER_SEC},
+	{"NVLINK_L10_RX_PER_SEC", "GPM_METRIC_NVLINK_L10_RX_PER_SEC", GPM_METRIC_NVLINK_L10_RX_PER_SEC},
+	{"NVLINK_L10_TX_PER_SEC", "GPM_METRIC_NVLINK_L10_TX_PER_SEC", GPM_METRIC_NVLINK_L10_TX_PER_SEC},
+	{"NVLINK_L11_RX_PER_SEC", "GPM_METRIC_NVLINK_L11_RX_PER_SEC", GPM_METRIC_NVLINK_L11_RX_PER_SEC},
+	{"NVLINK_L11_TX_PER_SEC", "GPM_METRIC_NVLINK_L11_TX_PER_SEC", GPM_METRIC_NVLINK_L11_TX_PER_SEC},
+	{"NVLINK_L12_RX_PER_SEC", "GPM_METRIC_NVLINK_L12_RX_PER_SEC", GPM_METRIC_NVLINK_L12_RX_PER_SEC},
+	{"NVLINK_L12_TX_PER_SEC", "GPM_METRIC_NVLINK_L12_TX_PER_SEC", GPM_METRIC_NVLINK_L12_TX_PER_SEC},
+	{"NVLINK_L13_RX_PER_SEC", "GPM_METRIC_NVLINK_L13_RX_PER_SEC", GPM_METRIC_NVLINK_L13_RX_PER_SEC},
+	{"NVLINK_L13_TX_PER_SEC", "GPM_METRIC_NVLINK_L13_TX_PER_SEC", GPM_METRIC_NVLINK_L13_TX_PER_SEC},
+	{"NVLINK_L14_RX_PER_SEC", "GPM_METRIC_NVLINK_L14_RX_PER_SEC", GPM_METRIC_NVLINK_L14_RX_PER_SEC},
+	{"NVLINK_L14_TX_PER_SEC", "GPM_METRIC_NVLINK_L14_TX_PER_SEC", GPM_METRIC_NVLINK_L14_TX_PER_SEC},
+	{"NVLINK_L15_RX_PER_SEC", "GPM_METRIC_NVLINK_L15_RX_PER_SEC", GPM_METRIC_NVLINK_L15_RX_PER_SEC},
+	{"NVLINK_L15_TX_PER_SEC", "GPM_METRIC_NVLINK_L15_TX_PER_SEC", GPM_METRIC_NVLINK_L15_TX_PER_SEC},
+	{"NVLINK_L16_RX_PER_SEC", "GPM_METRIC_NVLINK_L16_RX_PER_SEC", GPM_METRIC_NVLINK_L16_RX_PER_SEC},
+	{"NVLINK_L16_TX_PER_SEC", "GPM_METRIC_NVLINK_L16_TX_PER_SEC", GPM_METRIC_NVLINK_L16_TX_PER_SEC},
+	{"NVLINK_L17_RX_PER_SEC", "GPM_METRIC_NVLINK_L17_RX_PER_SEC", GPM_METRIC_NVLINK_L17_RX_PER_SEC},
+	{"NVLINK_L17_TX_PER_SEC", "GPM_METRIC_NVLINK_L17_TX_PER_SEC", GPM_METRIC_NVLINK_L17_TX_PER_SEC},
+	{"C2C_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_TOTAL_TX_PER_SEC},
+	{"C2C_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_TOTAL_RX_PER_SEC},
+	{"C2C_DATA_TX_PER_SEC", "GPM_METRIC_C2C_DATA_TX_PER_SEC", GPM_METRIC_C2C_DATA_TX_PER_SEC},
+	{"C2C_DATA_RX_PER_SEC", "GPM_METRIC_C2C_DATA_RX_PER_SEC", GPM_METRIC_C2C_DATA_RX_PER_SEC},
+	{"C2C_LINK0_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK0_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK0_TOTAL_TX_PER_SEC},
+	{"C2C_LINK0_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK0_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK0_TOTAL_RX_PER_SEC},
+	{"C2C_LINK0_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK0_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK0_DATA_TX_PER_SEC},
+	{"C2C_LINK0_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK0_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK0_DATA_RX_PER_SEC},
+	{"C2C_LINK1_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK1_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK1_TOTAL_TX_PER_SEC},
+	{"C2C_LINK1_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK1_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK1_TOTAL_RX_PER_SEC},
+	{"C2C_LINK1_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK1_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK1_DATA_TX_PER_SEC},
+	{"C2C_LINK1_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK1_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK1_DATA_RX_PER_SEC},
+	{"C2C_LINK2_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK2_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK2_TOTAL_TX_PER_SEC},
+	{"C2C_LINK2_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK2_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK2_TOTAL_RX_PER_SEC},
+	{"C2C_LINK2_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK2_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK2_DATA_TX_PER_SEC},
+	{"C2C_LINK2_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK2_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK2_DATA_RX_PER_SEC},
+	{"C2C_LINK3_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK3_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK3_TOTAL_TX_PER_SEC},
+	{"C2C_LINK3_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK3_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK3_TOTAL_RX_PER_SEC},
+	{"C2C_LINK3_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK3_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK3_DATA_TX_PER_SEC},
+	{"C2C_LINK3_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK3_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK3_DATA_RX_PER_SEC},
+	{"C2C_LINK4_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK4_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK4_TOTAL_TX_PER_SEC},
+	{"C2C_LINK4_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK4_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK4_TOTAL_RX_PER_SEC},
+	{"C2C_LINK4_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK4_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK4_DATA_TX_PER_SEC},
+	{"C2C_LINK4_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK4_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK4_DATA_RX_PER_SEC},
+	{"C2C_LINK5_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK5_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK5_TOTAL_TX_PER_SEC},
+	{"C2C_LINK5_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK5_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK5_TOTAL_RX_PER_SEC},
+	{"C2C_LINK5_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK5_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK5_DATA_TX_PER_SEC},
+	{"C2C_LINK5_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK5_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK5_DATA_RX_PER_SEC},
+	{"C2C_LINK6_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK6_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK6_TOTAL_TX_PER_SEC},
+	{"C2C_LINK6_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK6_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK6_TOTAL_RX_PER_SEC},
+	{"C2C_LINK6_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK6_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK6_DATA_TX_PER_SEC},
+	{"C2C_LINK6_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK6_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK6_DATA_RX_PER_SEC},
+	{"C2C_LINK7_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK7_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK7_TOTAL_TX_PER_SEC},
+	{"C2C_LINK7_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK7_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK7_TOTAL_RX_PER_SEC},
+	{"C2C_LINK7_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK7_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK7_DATA_TX_PER_SEC},
+	{"C2C_LINK7_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK7_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK7_DATA_RX_PER_SEC},
+	{"C2C_LINK8_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK8_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK8_TOTAL_TX_PER_SEC},
+	{"C2C_LINK8_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK8_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK8_TOTAL_RX_PER_SEC},
+	{"C2C_LINK8_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK8_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK8_DATA_TX_PER_SEC},
+	{"C2C_LINK8_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK8_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK8_DATA_RX_PER_SEC},
+	{"C2C_LINK9_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK9_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK9_TOTAL_TX_PER_SEC},
+	{"C2C_LINK9_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK9_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK9_TOTAL_RX_PER_SEC},
+	{"C2C_LINK9_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK9_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK9_DATA_TX_PER_SEC},
+	{"C2C_LINK9_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK9_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK9_DATA_RX_PER_SEC},
+	{"C2C_LINK10_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK10_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK10_TOTAL_TX_PER_SEC},
+	{"C2C_LINK10_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK10_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK10_TOTAL_RX_PER_SEC},
+	{"C2C_LINK10_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK10_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK10_DATA_TX_PER_SEC},
+	{"C2C_LINK10_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK10_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK10_DATA_RX_PER_SEC},
+	{"C2C_LINK11_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK11_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK11_TOTAL_TX_PER_SEC},
+	{"C2C_LINK11_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK11_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK11_TOTAL_RX_PER_SEC},
+	{"C2C_LINK11_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK11_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK11_DATA_TX_PER_SEC},
+	{"C2C_LINK11_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK11_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK11_DATA_RX_PER_SEC},
+	{"C2C_LINK12_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK12_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK12_TOTAL_TX_PER_SEC},
+	{"C2C_LINK12_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK12_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK12_TOTAL_RX_PER_SEC},
+	{"C2C_LINK12_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK12_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK12_DATA_TX_PER_SEC},
+	{"C2C_LINK12_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK12_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK12_DATA_RX_PER_SEC},
+	{"C2C_LINK13_TOTAL_TX_PER_SEC", "GPM_METRIC_C2C_LINK13_TOTAL_TX_PER_SEC", GPM_METRIC_C2C_LINK13_TOTAL_TX_PER_SEC},
+	{"C2C_LINK13_TOTAL_RX_PER_SEC", "GPM_METRIC_C2C_LINK13_TOTAL_RX_PER_SEC", GPM_METRIC_C2C_LINK13_TOTAL_RX_PER_SEC},
+	{"C2C_LINK13_DATA_TX_PER_SEC", "GPM_METRIC_C2C_LINK13_DATA_TX_PER_SEC", GPM_METRIC_C2C_LINK13_DATA_TX_PER_SEC},
+	{"C2C_LINK13_DATA_RX_PER_SEC", "GPM_METRIC_C2C_LINK13_DATA_RX_PER_SEC", GPM_METRIC_C2C_LINK13_DATA_RX_PER_SEC},
+	{"HOSTMEM_CACHE_HIT", "GPM_METRIC_HOSTMEM_CACHE_HIT", GPM_METRIC_HOSTMEM_CACHE_HIT},
+	{"HOSTMEM_CACHE_MISS", "GPM_METRIC_HOSTMEM_CACHE_MISS", GPM_METRIC_HOSTMEM_CACHE_MISS},
+	{"PEERMEM_CACHE_HIT", "GPM_METRIC_PEERMEM_CACHE_HIT", GPM_METRIC_PEERMEM_CACHE_HIT},
+	{"PEERMEM_CACHE_MISS", "GPM_METRIC_PEERMEM_CACHE_MISS", GPM_METRIC_PEERMEM_CACHE_MISS},
+	{"DRAM_CACHE_HIT", "GPM_METRIC_DRAM_CACHE_HIT", GPM_METRIC_DRAM_CACHE_HIT},
+	{"DRAM_CACHE_MISS", "GPM_METRIC_DRAM_CACHE_MISS", GPM_METRIC_DRAM_CACHE_MISS},
+	{"NVENC_0_UTIL", "GPM_METRIC_NVENC_0_UTIL", GPM_METRIC_NVENC_0_UTIL},
+	{"NVENC_1_UTIL", "GPM_METRIC_NVENC_1_UTIL", GPM_METRIC_NVENC_1_UTIL},
+	{"NVENC_2_UTIL", "GPM_METRIC_NVENC_2_UTIL", GPM_METRIC_NVENC_2_UTIL},
+	{"NVENC_3_UTIL", "GPM_METRIC_NVENC_3_UTIL", GPM_METRIC_NVENC_3_UTIL},
+	{"GR0_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR0_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR0_CTXSW_CYCLES_ELAPSED},
+	{"GR0_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR0_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR0_CTXSW_CYCLES_ACTIVE},
+	{"GR0_CTXSW_REQUESTS", "GPM_METRIC_GR0_CTXSW_REQUESTS", GPM_METRIC_GR0_CTXSW_REQUESTS},
+	{"GR0_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR0_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR0_CTXSW_CYCLES_PER_REQ},
+	{"GR0_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR0_CTXSW_ACTIVE_PCT", GPM_METRIC_GR0_CTXSW_ACTIVE_PCT},
+	{"GR1_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR1_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR1_CTXSW_CYCLES_ELAPSED},
+	{"GR1_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR1_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR1_CTXSW_CYCLES_ACTIVE},
+	{"GR1_CTXSW_REQUESTS", "GPM_METRIC_GR1_CTXSW_REQUESTS", GPM_METRIC_GR1_CTXSW_REQUESTS},
+	{"GR1_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR1_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR1_CTXSW_CYCLES_PER_REQ},
+	{"GR1_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR1_CTXSW_ACTIVE_PCT", GPM_METRIC_GR1_CTXSW_ACTIVE_PCT},
+	{"GR2_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR2_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR2_CTXSW_CYCLES_ELAPSED},
+	{"GR2_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR2_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR2_CTXSW_CYCLES_ACTIVE},
+	{"GR2_CTXSW_REQUESTS", "GPM_METRIC_GR2_CTXSW_REQUESTS", GPM_METRIC_GR2_CTXSW_REQUESTS},
+	{"GR2_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR2_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR2_CTXSW_CYCLES_PER_REQ},
+	{"GR2_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR2_CTXSW_ACTIVE_PCT", GPM_METRIC_GR2_CTXSW_ACTIVE_PCT},
+	{"GR3_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR3_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR3_CTXSW_CYCLES_ELAPSED},
+	{"GR3_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR3_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR3_CTXSW_CYCLES_ACTIVE},
+	{"GR3_CTXSW_REQUESTS", "GPM_METRIC_GR3_CTXSW_REQUESTS", GPM_METRIC_GR3_CTXSW_REQUESTS},
+	{"GR3_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR3_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR3_CTXSW_CYCLES_PER_REQ},
+	{"GR3_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR3_CTXSW_ACTIVE_PCT", GPM_METRIC_GR3_CTXSW_ACTIVE_PCT},
+	{"GR4_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR4_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR4_CTXSW_CYCLES_ELAPSED},
+	{"GR4_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR4_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR4_CTXSW_CYCLES_ACTIVE},
+	{"GR4_CTXSW_REQUESTS", "GPM_METRIC_GR4_CTXSW_REQUESTS", GPM_METRIC_GR4_CTXSW_REQUESTS},
+	{"GR4_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR4_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR4_CTXSW_CYCLES_PER_REQ},
+	{"GR4_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR4_CTXSW_ACTIVE_PCT", GPM_METRIC_GR4_CTXSW_ACTIVE_PCT},
+	{"GR5_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR5_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR5_CTXSW_CYCLES_ELAPSED},
+	{"GR5_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR5_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR5_CTXSW_CYCLES_ACTIVE},
+	{"GR5_CTXSW_REQUESTS", "GPM_METRIC_GR5_CTXSW_REQUESTS", GPM_METRIC_GR5_CTXSW_REQUESTS},
+	{"GR5_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR5_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR5_CTXSW_CYCLES_PER_REQ},
+	{"GR5_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR5_CTXSW_ACTIVE_PCT", GPM_METRIC_GR5_CTXSW_ACTIVE_PCT},
+	{"GR6_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR6_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR6_CTXSW_CYCLES_ELAPSED},
+	{"GR6_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR6_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR6_CTXSW_CYCLES_ACTIVE},
+	{"GR6_CTXSW_REQUESTS", "GPM_METRIC_GR6_CTXSW_REQUESTS", GPM_METRIC_GR6_CTXSW_REQUESTS},
+	{"GR6_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR6_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR6_CTXSW_CYCLES_PER_REQ},
+	{"GR6_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR6_CTXSW_ACTIVE_PCT", GPM_METRIC_GR6_CTXSW_ACTIVE_PCT},
+	{"GR7_CTXSW_CYCLES_ELAPSED", "GPM_METRIC_GR7_CTXSW_CYCLES_ELAPSED", GPM_METRIC_GR7_CTXSW_CYCLES_ELAPSED},
+	{"GR7_CTXSW_CYCLES_ACTIVE", "GPM_METRIC_GR7_CTXSW_CYCLES_ACTIVE", GPM_METRIC_GR7_CTXSW_CYCLES_ACTIVE},
+	{"GR7_CTXSW_REQUESTS", "GPM_METRIC_GR7_CTXSW_REQUESTS", GPM_METRIC_GR7_CTXSW_REQUESTS},
+	{"GR7_CTXSW_CYCLES_PER_REQ", "GPM_METRIC_GR7_CTXSW_CYCLES_PER_REQ", GPM_METRIC_GR7_CTXSW_CYCLES_PER_REQ},
+	{"GR7_CTXSW_ACTIVE_PCT", "GPM_METRIC_GR7_CTXSW_ACTIVE_PCT", GPM_METRIC_GR7_CTXSW_ACTIVE_PCT},
+	{"MAX", "GPM_METRIC_MAX", GPM_METRIC_MAX},
+}
+
+// String returns the name of the value without the GPM_METRIC_ prefix.
+func (e GpmMetricId) String() string {
+	return formatEnum("GpmMetricId", gpmMetricIdValues, e)
+}
+
+// MarshalText returns the name of the value without the GPM_METRIC_ prefix,
+// or its number if it has no name.
+func (e GpmMetricId) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpmMetricIdValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpmMetricId.
+func (e *GpmMetricId) UnmarshalText(text []byte) error {
+	value, err := ParseGpmMetricId(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpmMetricId returns the GpmMetricId with the specified name, with or
+// without the GPM_METRIC_ prefix, or number. Names are not case-sensitive.
+func ParseGpmMetricId(s string) (GpmMetricId, error) {
+	return parseEnum("GpmMetricId", gpmMetricIdValues, s)
+}
+
+// gpuOperationModeValues are the named values of GpuOperationMode.
+var gpuOperationModeValues = []enumValue[GpuOperationMode]{
+	{"ALL_ON", "GOM_ALL_ON", GOM_ALL_ON},
+	{"COMPUTE", "GOM_COMPUTE", GOM_COMPUTE},
+	{"LOW_DP", "GOM_LOW_DP", GOM_LOW_DP},
+}
+
+// String returns the name of the value without the GOM_ prefix.
+func (e GpuOperationMode) String() string {
+	return formatEnum("GpuOperationMode", gpuOperationModeValues, e)
+}
+
+// MarshalText returns the name of the value without the GOM_ prefix,
+// or its number if it has no name.
+func (e GpuOperationMode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuOperationModeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuOperationMode.
+func (e *GpuOperationMode) UnmarshalText(text []byte) error {
+	value, err := ParseGpuOperationMode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuOperationMode returns the GpuOperationMode with the specified name, with or
+// without the GOM_ prefix, or number. Names are not case-sensitive.
+func ParseGpuOperationMode(s string) (GpuOperationMode, error) {
+	return parseEnum("GpuOperationMode", gpuOperationModeValues, s)
+}
+
+// gpuP2PCapsIndexValues are the named values of GpuP2PCapsIndex.
+var gpuP2PCapsIndexValues = []enumValue[GpuP2PCapsIndex]{
+	{"READ", "P2P_CAPS_INDEX_READ", P2P_CAPS_INDEX_READ},
+	{"WRITE", "P2P_CAPS_INDEX_WRITE", P2P_CAPS_INDEX_WRITE},
+	{"NVLINK", "P2P_CAPS_INDEX_NVLINK", P2P_CAPS_INDEX_NVLINK},
+	{"ATOMICS", "P2P_CAPS_INDEX_ATOMICS", P2P_CAPS_INDEX_ATOMICS},
+	{"PCI", "P2P_CAPS_INDEX_PCI", P2P_CAPS_INDEX_PCI},
+	{"PROP", "P2P_CAPS_INDEX_PROP", P2P_CAPS_INDEX_PROP},
+	{"UNKNOWN", "P2P_CAPS_INDEX_UNKNOWN", P2P_CAPS_INDEX_UNKNOWN},
+}
+
+// String returns the name of the value without the P2P_CAPS_INDEX_ prefix.
+func (e GpuP2PCapsIndex) String() string {
+	return formatEnum("GpuP2PCapsIndex", gpuP2PCapsIndexValues, e)
+}
+
+// MarshalText returns the name of the value without the P2P_CAPS_INDEX_ prefix,
+// or its number if it has no name.
+func (e GpuP2PCapsIndex) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuP2PCapsIndexValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuP2PCapsIndex.
+func (e *GpuP2PCapsIndex) UnmarshalText(text []byte) error {
+	value, err := ParseGpuP2PCapsIndex(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuP2PCapsIndex returns the GpuP2PCapsIndex with the specified name, with or
+// without the P2P_CAPS_INDEX_ prefix, or number. Names are not case-sensitive.
+func ParseGpuP2PCapsIndex(s string) (GpuP2PCapsIndex, error) {
+	return parseEnum("GpuP2PCapsIndex", gpuP2PCapsIndexValues, s)
+}
+
+// gpuP2PStatusValues are the named values of GpuP2PStatus.
+var gpuP2PStatusValues = []enumValue[GpuP2PStatus]{
+	{"OK", "P2P_STATUS_OK", P2P_STATUS_OK},
+	{"CHIPSET_NOT_SUPPORED", "P2P_STATUS_CHIPSET_NOT_SUPPORED", P2P_STATUS_CHIPSET_NOT_SUPPORED},
+	{"CHIPSET_NOT_SUPPORTED", "P2P_STATUS_CHIPSET_NOT_SUPPORTED", P2P_STATUS_CHIPSET_NOT_SUPPORTED},
+	{"GPU_NOT_SUPPORTED", "P2P_STATUS_GPU_NOT_SUPPORTED", P2P_STATUS_GPU_NOT_SUPPORTED},
+	{"IOH_TOPOLOGY_NOT_SUPPORTED", "P2P_STATUS_IOH_TOPOLOGY_NOT_SUPPORTED", P2P_STATUS_IOH_TOPOLOGY_NOT_SUPPORTED},
+	{"DISABLED_BY_REGKEY", "P2P_STATUS_DISABLED_BY_REGKEY", P2P_STATUS_DISABLED_BY_REGKEY},
+	{"NOT_SUPPORTED", "P2P_STATUS_NOT_SUPPORTED", P2P_STATUS_NOT_SUPPORTED},
+	{"UNKNOWN", "P2P_STATUS_UNKNOWN", P2P_STATUS_UNKNOWN},
+}
+
+// String returns the name of the value without the P2P_STATUS_ prefix.
+func (e GpuP2PStatus) String() string {
+	return formatEnum("GpuP2PStatus", gpuP2PStatusValues, e)
+}
+
+// MarshalText returns the name of the value without the P2P_STATUS_ prefix,
+// or its number if it has no name.
+func (e GpuP2PStatus) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuP2PStatusValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuP2PStatus.
+func (e *GpuP2PStatus) UnmarshalText(text []byte) error {
+	value, err := ParseGpuP2PStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuP2PStatus returns the GpuP2PStatus with the specified name, with or
+// without the P2P_STATUS_ prefix, or number. Names are not case-sensitive.
+func ParseGpuP2PStatus(s string) (GpuP2PStatus, error) {
+	return parseEnum("GpuP2PStatus", gpuP2PStatusValues, s)
+}
+
+// gpuTopologyLevelValues are the named values of GpuTopologyLevel.
+var gpuTopologyLevelValues = []enumValue[GpuTopologyLevel]{
+	{"INTERNAL", "TOPOLOGY_INTERNAL", TOPOLOGY_INTERNAL},
+	{"SINGLE", "TOPOLOGY_SINGLE", TOPOLOGY_SINGLE},
+	{"MULTIPLE", "TOPOLOGY_MULTIPLE", TOPOLOGY_MULTIPLE},
+	{"HOSTBRIDGE", "TOPOLOGY_HOSTBRIDGE", TOPOLOGY_HOSTBRIDGE},
+	{"NODE", "TOPOLOGY_NODE", TOPOLOGY_NODE},
+	{"SYSTEM", "TOPOLOGY_SYSTEM", TOPOLOGY_SYSTEM},
+}
+
+// String returns the name of the value without the TOPOLOGY_ prefix.
+func (e GpuTopologyLevel) String() string {
+	return formatEnum("GpuTopologyLevel", gpuTopologyLevelValues, e)
+}
+
+// MarshalText returns the name of the value without the TOPOLOGY_ prefix,
+// or its number if it has no name.
+func (e GpuTopologyLevel) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuTopologyLevelValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuTopologyLevel.
+func (e *GpuTopologyLevel) UnmarshalText(text []byte) error {
+	value, err := ParseGpuTopologyLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuTopologyLevel returns the GpuTopologyLevel with the specified name, with or
+// without the TOPOLOGY_ prefix, or number. Names are not case-sensitive.
+func ParseGpuTopologyLevel(s string) (GpuTopologyLevel, error) {
+	return parseEnum("GpuTopologyLevel", gpuTopologyLevelValues, s)
+}
+
+// gpuUtilizationDomainIdValues are the named values of GpuUtilizationDomainId.
+var gpuUtilizationDomainIdValues = []enumValue[GpuUtilizationDomainId]{
+	{"GPU", "GPU_UTILIZATION_DOMAIN_GPU", GPU_UTILIZATION_DOMAIN_GPU},
+	{"FB", "GPU_UTILIZATION_DOMAIN_FB", GPU_UTILIZATION_DOMAIN_FB},
+	{"VID", "GPU_UTILIZATION_DOMAIN_VID", GPU_UTILIZATION_DOMAIN_VID},
+	{"BUS", "GPU_UTILIZATION_DOMAIN_BUS", GPU_UTILIZATION_DOMAIN_BUS},
+}
+
+// String returns the name of the value without the GPU_UTILIZATION_DOMAIN_ prefix.
+func (e GpuUtilizationDomainId) String() string {
+	return formatEnum("GpuUtilizationDomainId", gpuUtilizationDomainIdValues, e)
+}
+
+// MarshalText returns the name of the value without the GPU_UTILIZATION_DOMAIN_ prefix,
+// or its number if it has no name.
+func (e GpuUtilizationDomainId) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuUtilizationDomainIdValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuUtilizationDomainId.
+func (e *GpuUtilizationDomainId) UnmarshalText(text []byte) error {
+	value, err := ParseGpuUtilizationDomainId(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuUtilizationDomainId returns the GpuUtilizationDomainId with the specified name, with or
+// without the GPU_UTILIZATION_DOMAIN_ prefix, or number. Names are not case-sensitive.
+func ParseGpuUtilizationDomainId(s string) (GpuUtilizationDomainId, error) {
+	return parseEnum("GpuUtilizationDomainId", gpuUtilizationDomainIdValues, s)
+}
+
+// gpuVirtualizationModeValues are the named values of GpuVirtualizationMode.
+var gpuVirtualizationModeValues = []enumValue[GpuVirtualizationMode]{
+	{"NONE", "GPU_VIRTUALIZATION_MODE_NONE", GPU_VIRTUALIZATION_MODE_NONE},
+	{"PASSTHROUGH", "GPU_VIRTUALIZATION_MODE_PASSTHROUGH", GPU_VIRTUALIZATION_MODE_PASSTHROUGH},
+	{"VGPU", "GPU_VIRTUALIZATION_MODE_VGPU", GPU_VIRTUALIZATION_MODE_VGPU},
+	{"HOST_VGPU", "GPU_VIRTUALIZATION_MODE_HOST_VGPU", GPU_VIRTUALIZATION_MODE_HOST_VGPU},
+	{"HOST_VSGA", "GPU_VIRTUALIZATION_MODE_HOST_VSGA", GPU_VIRTUALIZATION_MODE_HOST_VSGA},
+}
+
+// String returns the name of the value without the GPU_VIRTUALIZATION_MODE_ prefix.
+func (e GpuVirtualizationMode) String() string {
+	return formatEnum("GpuVirtualizationMode", gpuVirtualizationModeValues, e)
+}
+
+// MarshalText returns the name of the value without the GPU_VIRTUALIZATION_MODE_ prefix,
+// or its number if it has no name.
+func (e GpuVirtualizationMode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gpuVirtualizationModeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGpuVirtualizationMode.
+func (e *GpuVirtualizationMode) UnmarshalText(text []byte) error {
+	value, err := ParseGpuVirtualizationMode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGpuVirtualizationMode returns the GpuVirtualizationMode with the specified name, with or
+// without the GPU_VIRTUALIZATION_MODE_ prefix, or number. Names are not case-sensitive.
+func ParseGpuVirtualizationMode(s string) (GpuVirtualizationMode, error) {
+	return parseEnum("GpuVirtualizationMode", gpuVirtualizationModeValues, s)
+}
+
+// gridLicenseFeatureCodeValues are the named values of GridLicenseFeatureCode.
+var gridLicenseFeatureCodeValues = []enumValue[GridLicenseFeatureCode]{
+	{"UNKNOWN", "GRID_LICENSE_FEATURE_CODE_UNKNOWN", GRID_LICENSE_FEATURE_CODE_UNKNOWN},
+	{"VGPU", "GRID_LICENSE_FEATURE_CODE_VGPU", GRID_LICENSE_FEATURE_CODE_VGPU},
+	{"NVIDIA_RTX", "GRID_LICENSE_FEATURE_CODE_NVIDIA_RTX", GRID_LICENSE_FEATURE_CODE_NVIDIA_RTX},
+	{"VWORKSTATION", "GRID_LICENSE_FEATURE_CODE_VWORKSTATION", GRID_LICENSE_FEATURE_CODE_VWORKSTATION},
+	{"GAMING", "GRID_LICENSE_FEATURE_CODE_GAMING", GRID_LICENSE_FEATURE_CODE_GAMING},
+	{"COMPUTE", "GRID_LICENSE_FEATURE_CODE_COMPUTE", GRID_LICENSE_FEATURE_CODE_COMPUTE},
+}
+
+// String returns the name of the value without the GRID_LICENSE_FEATURE_CODE_ prefix.
+func (e GridLicenseFeatureCode) String() string {
+	return formatEnum("GridLicenseFeatureCode", gridLicenseFeatureCodeValues, e)
+}
+
+// MarshalText returns the name of the value without the GRID_LICENSE_FEATURE_CODE_ prefix,
+// or its number if it has no name.
+func (e GridLicenseFeatureCode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(gridLicenseFeatureCodeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseGridLicenseFeatureCode.
+func (e *GridLicenseFeatureCode) UnmarshalText(text []byte) error {
+	value, err := ParseGridLicenseFeatureCode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseGridLicenseFeatureCode returns the GridLicenseFeatureCode with the specified name, with or
+// without the GRID_LICENSE_FEATURE_CODE_ prefix, or number. Names are not case-sensitive.
+func ParseGridLicenseFeatureCode(s string) (GridLicenseFeatureCode, error) {
+	return parseEnum("GridLicenseFeatureCode", gridLicenseFeatureCodeValues, s)
+}
+
+// hostVgpuModeValues are the named values of HostVgpuMode.
+var hostVgpuModeValues = []enumValue[HostVgpuMode]{
+	{"NON_SRIOV", "HOST_VGPU_MODE_NON_SRIOV", HOST_VGPU_MODE_NON_SRIOV},
+	{"SRIOV", "HOST_VGPU_MODE_SRIOV", HOST_VGPU_MODE_SRIOV},
+}
+
+// String returns the name of the value without the HOST_VGPU_MODE_ prefix.
+func (e HostVgpuMode) String() string {
+	return formatEnum("HostVgpuMode", hostVgpuModeValues, e)
+}
+
+// MarshalText returns the name of the value without the HOST_VGPU_MODE_ prefix,
+// or its number if it has no name.
+func (e HostVgpuMode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(hostVgpuModeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseHostVgpuMode.
+func (e *HostVgpuMode) UnmarshalText(text []byte) error {
+	value, err := ParseHostVgpuMode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseHostVgpuMode returns the HostVgpuMode with the specified name, with or
+// without the HOST_VGPU_MODE_ prefix, or number. Names are not case-sensitive.
+func ParseHostVgpuMode(s string) (HostVgpuMode, error) {
+	return parseEnum("HostVgpuMode", hostVgpuModeValues, s)
+}
+
+// inforomObjectValues are the named values of InforomObject.
+var inforomObjectValues = []enumValue[InforomObject]{
+	{"OEM", "INFOROM_OEM", INFOROM_OEM},
+	{"ECC", "INFOROM_ECC", INFOROM_ECC},
+	{"POWER", "INFOROM_POWER", INFOROM_POWER},
+	{"DEN", "INFOROM_DEN", INFOROM_DEN},
+	{"COUNT", "INFOROM_COUNT", INFOROM_COUNT},
+}
+
+// String returns the name of the value without the INFOROM_ prefix.
+func (e InforomObject) String() string {
+	return formatEnum("InforomObject", inforomObjectValues, e)
+}
+
+// MarshalText returns the name of the value without the INFOROM_ prefix,
+// or its number if it has no name.
+func (e InforomObject) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(inforomObjectValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseInforomObject.
+func (e *InforomObject) UnmarshalText(text []byte) error {
+	value, err := ParseInforomObject(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseInforomObject returns the InforomObject with the specified name, with or
+// without the INFOROM_ prefix, or number. Names are not case-sensitive.
+func ParseInforomObject(s string) (InforomObject, error) {
+	return parseEnum("InforomObject", inforomObjectValues, s)
+}
+
+// intNvLinkDeviceTypeValues are the named values of IntNvLinkDeviceType.
+var intNvLinkDeviceTypeValues = []enumValue[IntNvLinkDeviceType]{
+	{"GPU", "NVLINK_DEVICE_TYPE_GPU", NVLINK_DEVICE_TYPE_GPU},
+	{"IBMNPU", "NVLINK_DEVICE_TYPE_IBMNPU", NVLINK_DEVICE_TYPE_IBMNPU},
+	{"SWITCH", "NVLINK_DEVICE_TYPE_SWITCH", NVLINK_DEVICE_TYPE_SWITCH},
+	{"UNKNOWN", "NVLINK_DEVICE_TYPE_UNKNOWN", NVLINK_DEVICE_TYPE_UNKNOWN},
+}
+
+// String returns the name of the value without the NVLINK_DEVICE_TYPE_ prefix.
+func (e IntNvLinkDeviceType) String() string {
+	return formatEnum("IntNvLinkDeviceType", intNvLinkDeviceTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the NVLINK_DEVICE_TYPE_ prefix,
+// or its number if it has no name.
+func (e IntNvLinkDeviceType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(intNvLinkDeviceTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseIntNvLinkDeviceType.
+func (e *IntNvLinkDeviceType) UnmarshalText(text []byte) error {
+	value, err := ParseIntNvLinkDeviceType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseIntNvLinkDeviceType returns the IntNvLinkDeviceType with the specified name, with or
+// without the NVLINK_DEVICE_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseIntNvLinkDeviceType(s string) (IntNvLinkDeviceType, error) {
+	return parseEnum("IntNvLinkDeviceType", intNvLinkDeviceTypeValues, s)
+}
+
+// ledColorValues are the named values of LedColor.
+var ledColorValues = []enumValue[LedColor]{
+	{"GREEN", "LED_COLOR_GREEN", LED_COLOR_GREEN},
+	{"AMBER", "LED_COLOR_AMBER", LED_COLOR_AMBER},
+}
+
+// String returns the name of the value without the LED_COLOR_ prefix.
+func (e LedColor) String() string {
+	return formatEnum("LedColor", ledColorValues, e)
+}
+
+// MarshalText returns the name of the value without the LED_COLOR_ prefix,
+// or its number if it has no name.
+func (e LedColor) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(ledColorValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseLedColor.
+func (e *LedColor) UnmarshalText(text []byte) error {
+	value, err := ParseLedColor(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseLedColor returns the LedColor with the specified name, with or
+// without the LED_COLOR_ prefix, or number. Names are not case-sensitive.
+func ParseLedColor(s string) (LedColor, error) {
+	return parseEnum("LedColor", ledColorValues, s)
+}
+
+// memoryErrorTypeValues are the named values of MemoryErrorType.
+var memoryErrorTypeValues = []enumValue[MemoryErrorType]{
+	{"CORRECTED", "MEMORY_ERROR_TYPE_CORRECTED", MEMORY_ERROR_TYPE_CORRECTED},
+	{"UNCORRECTED", "MEMORY_ERROR_TYPE_UNCORRECTED", MEMORY_ERROR_TYPE_UNCORRECTED},
+	{"COUNT", "MEMORY_ERROR_TYPE_COUNT", MEMORY_ERROR_TYPE_COUNT},
+}
+
+// String returns the name of the value without the MEMORY_ERROR_TYPE_ prefix.
+func (e MemoryErrorType) String() string {
+	return formatEnum("MemoryErrorType", memoryErrorTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the MEMORY_ERROR_TYPE_ prefix,
+// or its number if it has no name.
+func (e MemoryErrorType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(memoryErrorTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseMemoryErrorType.
+func (e *MemoryErrorType) UnmarshalText(text []byte) error {
+	value, err := ParseMemoryErrorType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseMemoryErrorType returns the MemoryErrorType with the specified name, with or
+// without the MEMORY_ERROR_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseMemoryErrorType(s string) (MemoryErrorType, error) {
+	return parseEnum("MemoryErrorType", memoryErrorTypeValues, s)
+}
+
+// memoryLocationValues are the named values of MemoryLocation.
+var memoryLocationValues = []enumValue[MemoryLocation]{
+	{"L1_CACHE", "MEMORY_LOCATION_L1_CACHE", MEMORY_LOCATION_L1_CACHE},
+	{"L2_CACHE", "MEMORY_LOCATION_L2_CACHE", MEMORY_LOCATION_L2_CACHE},
+	{"DRAM", "MEMORY_LOCATION_DRAM", MEMORY_LOCATION_DRAM},
+	{"DEVICE_MEMORY", "MEMORY_LOCATION_DEVICE_MEMORY", MEMORY_LOCATION_DEVICE_MEMORY},
+	{"REGISTER_FILE", "MEMORY_LOCATION_REGISTER_FILE", MEMORY_LOCATION_REGISTER_FILE},
+	{"TEXTURE_MEMORY", "MEMORY_LOCATION_TEXTURE_MEMORY", MEMORY_LOCATION_TEXTURE_MEMORY},
+	{"TEXTURE_SHM", "MEMORY_LOCATION_TEXTURE_SHM", MEMORY_LOCATION_TEXTURE_SHM},
+	{"CBU", "MEMORY_LOCATION_CBU", MEMORY_LOCATION_CBU},
+	{"SRAM", "MEMORY_LOCATION_SRAM", MEMORY_LOCATION_SRAM},
+	{"COUNT", "MEMORY_LOCATION_COUNT", MEMORY_LOCATION_COUNT},
+}
+
+// String returns the name of the value without the MEMORY_LOCATION_ prefix.
+func (e MemoryLocation) String() string {
+	return formatEnum("MemoryLocation", memoryLocationValues, e)
+}
+
+// MarshalText returns the name of the value without the MEMORY_LOCATION_ prefix,
+// or its number if it has no name.
+func (e MemoryLocation) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(memoryLocationValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseMemoryLocation.
+func (e *MemoryLocation) UnmarshalText(text []byte) error {
+	value, err := ParseMemoryLocation(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseMemoryLocation returns the MemoryLocation with the specified name, with or
+// without the MEMORY_LOCATION_ prefix, or number. Names are not case-sensitive.
+func ParseMemoryLocation(s string) (MemoryLocation, error) {
+	return parseEnum("MemoryLocation", memoryLocationValues, s)
+}
+
+// nvLinkCapabilityValues are the named values of NvLinkCapability.
+var nvLinkCapabilityValues = []enumValue[NvLinkCapability]{
+	{"P2P_SUPPORTED", "NVLINK_CAP_P2P_SUPPORTED", NVLINK_CAP_P2P_SUPPORTED},
+	{"SYSMEM_ACCESS", "NVLINK_CAP_SYSMEM_ACCESS", NVLINK_CAP_SYSMEM_ACCESS},
+	{"P2P_ATOMICS", "NVLINK_CAP_P2P_ATOMICS", NVLINK_CAP_P2P_ATOMICS},
+	{"SYSMEM_ATOMICS", "NVLINK_CAP_SYSMEM_ATOMICS", NVLINK_CAP_SYSMEM_ATOMICS},
+	{"SLI_BRIDGE", "NVLINK_CAP_SLI_BRIDGE", NVLINK_CAP_SLI_BRIDGE},
+	{"VALID", "NVLINK_CAP_VALID", NVLINK_CAP_VALID},
+	{"COUNT", "NVLINK_CAP_COUNT", NVLINK_CAP_COUNT},
+}
+
+// String returns the name of the value without the NVLINK_CAP_ prefix.
+func (e NvLinkCapability) String() string {
+	return formatEnum("NvLinkCapability", nvLinkCapabilityValues, e)
+}
+
+// MarshalText returns the name of the value without the NVLINK_CAP_ prefix,
+// or its number if it has no name.
+func (e NvLinkCapability) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(nvLinkCapabilityValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseNvLinkCapability.
+func (e *NvLinkCapability) UnmarshalText(text []byte) error {
+	value, err := ParseNvLinkCapability(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseNvLinkCapability returns the NvLinkCapability with the specified name, with or
+// without the NVLINK_CAP_ prefix, or number. Names are not case-sensitive.
+func ParseNvLinkCapability(s string) (NvLinkCapability, error) {
+	return parseEnum("NvLinkCapability", nvLinkCapabilityValues, s)
+}
+
+// nvLinkErrorCounterValues are the named values of NvLinkErrorCounter.
+var nvLinkErrorCounterValues = []enumValue[NvLinkErrorCounter]{
+	{"DL_REPLAY", "NVLINK_ERROR_DL_REPLAY", NVLINK_ERROR_DL_REPLAY},
+	{"DL_RECOVERY", "NVLINK_ERROR_DL_RECOVERY", NVLINK_ERROR_DL_RECOVERY},
+	{"DL_CRC_FLIT", "NVLINK_ERROR_DL_CRC_FLIT", NVLINK_ERROR_DL_CRC_FLIT},
+	{"DL_CRC_DATA", "NVLINK_ERROR_DL_CRC_DATA", NVLINK_ERROR_DL_CRC_DATA},
+	{"DL_ECC_DATA", "NVLINK_ERROR_DL_ECC_DATA", NVLINK_ERROR_DL_ECC_DATA},
+	{"COUNT", "NVLINK_ERROR_COUNT", NVLINK_ERROR_COUNT},
+}
+
+// String returns the name of the value without the NVLINK_ERROR_ prefix.
+func (e NvLinkErrorCounter) String() string {
+	return formatEnum("NvLinkErrorCounter", nvLinkErrorCounterValues, e)
+}
+
+// MarshalText returns the name of the value without the NVLINK_ERROR_ prefix,
+// or its number if it has no name.
+func (e NvLinkErrorCounter) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(nvLinkErrorCounterValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseNvLinkErrorCounter.
+func (e *NvLinkErrorCounter) UnmarshalText(text []byte) error {
+	value, err := ParseNvLinkErrorCounter(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseNvLinkErrorCounter returns the NvLinkErrorCounter with the specified name, with or
+// without the NVLINK_ERROR_ prefix, or number. Names are not case-sensitive.
+func ParseNvLinkErrorCounter(s string) (NvLinkErrorCounter, error) {
+	return parseEnum("NvLinkErrorCounter", nvLinkErrorCounterValues, s)
+}
+
+// nvLinkUtilizationCountPktTypesValues are the named values of NvLinkUtilizationCountPktTypes.
+var nvLinkUtilizationCountPktTypesValues = []enumValue[NvLinkUtilizationCountPktTypes]{
+	{"NOP", "NVLINK_COUNTER_PKTFILTER_NOP", NVLINK_COUNTER_PKTFILTER_NOP},
+	{"READ", "NVLINK_COUNTER_PKTFILTER_READ", NVLINK_COUNTER_PKTFILTER_READ},
+	{"WRITE", "NVLINK_COUNTER_PKTFILTER_WRITE", NVLINK_COUNTER_PKTFILTER_WRITE},
+	{"RATOM", "NVLINK_COUNTER_PKTFILTER_RATOM", NVLINK_COUNTER_PKTFILTER_RATOM},
+	{"NRATOM", "NVLINK_COUNTER_PKTFILTER_NRATOM", NVLINK_COUNTER_PKTFILTER_NRATOM},
+	{"FLUSH", "NVLINK_COUNTER_PKTFILTER_FLUSH", NVLINK_COUNTER_PKTFILTER_FLUSH},
+	{"RESPDATA", "NVLINK_COUNTER_PKTFILTER_RESPDATA", NVLINK_COUNTER_PKTFILTER_RESPDATA},
+	{"RESPNODATA", "NVLINK_COUNTER_PKTFILTER_RESPNODATA", NVLINK_COUNTER_PKTFILTER_RESPNODATA},
+	{"ALL", "NVLINK_COUNTER_PKTFILTER_ALL", NVLINK_COUNTER_PKTFILTER_ALL},
+}
+
+// String returns the name of the value without the NVLINK_COUNTER_PKTFILTER_ prefix.
+func (e NvLinkUtilizationCountPktTypes) String() string {
+	return formatEnum("NvLinkUtilizationCountPktTypes", nvLinkUtilizationCountPktTypesValues, e)
+}
+
+// MarshalText returns the name of the value without the NVLINK_COUNTER_PKTFILTER_ prefix,
+// or its number if it has no name.
+func (e NvLinkUtilizationCountPktTypes) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(nvLinkUtilizationCountPktTypesValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseNvLinkUtilizationCountPktTypes.
+func (e *NvLinkUtilizationCountPktTypes) UnmarshalText(text []byte) error {
+	value, err := ParseNvLinkUtilizationCountPktTypes(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseNvLinkUtilizationCountPktTypes returns the NvLinkUtilizationCountPktTypes with the specified name, with or
+// without the NVLINK_COUNTER_PKTFILTER_ prefix, or number. Names are not case-sensitive.
+func ParseNvLinkUtilizationCountPktTypes(s string) (NvLinkUtilizationCountPktTypes, error) {
+	return parseEnum("NvLinkUtilizationCountPktTypes", nvLinkUtilizationCountPktTypesValues, s)
+}
+
+// nvLinkUtilizationCountUnitsValues are the named values of NvLinkUtilizationCountUnits.
+var nvLinkUtilizationCountUnitsValues = []enumValue[NvLinkUtilizationCountUnits]{
+	{"CYCLES", "NVLINK_COUNTER_UNIT_CYCLES", NVLINK_COUNTER_UNIT_CYCLES},
+	{"PACKETS", "NVLINK_COUNTER_UNIT_PACKETS", NVLINK_COUNTER_UNIT_PACKETS},
+	{"BYTES", "NVLINK_COUNTER_UNIT_BYTES", NVLINK_COUNTER_UNIT_BYTES},
+	{"RESERVED", "NVLINK_COUNTER_UNIT_RESERVED", NVLINK_COUNTER_UNIT_RESERVED},
+	{"COUNT", "NVLINK_COUNTER_UNIT_COUNT", NVLINK_COUNTER_UNIT_COUNT},
+}
+
+// String returns the name of the value without the NVLINK_COUNTER_UNIT_ prefix.
+func (e NvLinkUtilizationCountUnits) String() string {
+	return formatEnum("NvLinkUtilizationCountUnits", nvLinkUtilizationCountUnitsValues, e)
+}
+
+// MarshalText returns the name of the value without the NVLINK_COUNTER_UNIT_ prefix,
+// or its number if it has no name.
+func (e NvLinkUtilizationCountUnits) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(nvLinkUtilizationCountUnitsValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseNvLinkUtilizationCountUnits.
+func (e *NvLinkUtilizationCountUnits) UnmarshalText(text []byte) error {
+	value, err := ParseNvLinkUtilizationCountUnits(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseNvLinkUtilizationCountUnits returns the NvLinkUtilizationCountUnits with the specified name, with or
+// without the NVLINK_COUNTER_UNIT_ prefix, or number. Names are not case-sensitive.
+func ParseNvLinkUtilizationCountUnits(s string) (NvLinkUtilizationCountUnits, error) {
+	return parseEnum("NvLinkUtilizationCountUnits", nvLinkUtilizationCountUnitsValues, s)
+}
+
+// nvlinkVersionValues are the named values of NvlinkVersion.
+var nvlinkVersionValues = []enumValue[NvlinkVersion]{
+	{"NVLINK_VERSION_INVALID", "NVLINK_VERSION_INVALID", NVLINK_VERSION_INVALID},
+	{"NVLINK_VERSION_1_0", "NVLINK_VERSION_1_0", NVLINK_VERSION_1_0},
+	{"NVLINK_VERSION_2_0", "NVLINK_VERSION_2_0", NVLINK_VERSION_2_0},
+	{"NVLINK_VERSION_2_2", "NVLINK_VERSION_2_2", NVLINK_VERSION_2_2},
+	{"NVLINK_VERSION_3_0", "NVLINK_VERSION_3_0", NVLINK_VERSION_3_0},
+	{"NVLINK_VERSION_3_1", "NVLINK_VERSION_3_1", NVLINK_VERSION_3_1},
+	{"NVLINK_VERSION_4_0", "NVLINK_VERSION_4_0", NVLINK_VERSION_4_0},
+	{"NVLINK_VERSION_5_0", "NVLINK_VERSION_5_0", NVLINK_VERSION_5_0},
+}
+
+// String returns the name of the value.
+func (e NvlinkVersion) String() string {
+	return formatEnum("NvlinkVersion", nvlinkVersionValues, e)
+}
+
+// MarshalText returns the name of the value,
+// or its number if it has no name.
+func (e NvlinkVersion) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(nvlinkVersionValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseNvlinkVersion.
+func (e *NvlinkVersion) UnmarshalText(text []byte) error {
+	value, err := ParseNvlinkVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseNvlinkVersion returns the NvlinkVersion with the specified name or number.
+// Names are not case-sensitive.
+func ParseNvlinkVersion(s string) (NvlinkVersion, error) {
+	return parseEnum("NvlinkVersion", nvlinkVersionValues, s)
+}
+
+// pageRetirementCauseValues are the named values of PageRetirementCause.
+var pageRetirementCauseValues = []enumValue[PageRetirementCause]{
+	{"MULTIPLE_SINGLE_BIT_ECC_ERRORS", "PAGE_RETIREMENT_CAUSE_MULTIPLE_SINGLE_BIT_ECC_ERRORS", PAGE_RETIREMENT_CAUSE_MULTIPLE_SINGLE_BIT_ECC_ERRORS},
+	{"DOUBLE_BIT_ECC_ERROR", "PAGE_RETIREMENT_CAUSE_DOUBLE_BIT_ECC_ERROR", PAGE_RETIREMENT_CAUSE_DOUBLE_BIT_ECC_ERROR},
+	{"COUNT", "PAGE_RETIREMENT_CAUSE_COUNT", PAGE_RETIREMENT_CAUSE_COUNT},
+}
+
+// String returns the name of the value without the PAGE_RETIREMENT_CAUSE_ prefix.
+func (e PageRetirementCause) String() string {
+	return formatEnum("PageRetirementCause", pageRetirementCauseValues, e)
+}
+
+// MarshalText returns the name of the value without the PAGE_RETIREMENT_CAUSE_ prefix,
+// or its number if it has no name.
+func (e PageRetirementCause) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(pageRetirementCauseValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePageRetirementCause.
+func (e *PageRetirementCause) UnmarshalText(text []byte) error {
+	value, err := ParsePageRetirementCause(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePageRetirementCause returns the PageRetirementCause with the specified name, with or
+// without the PAGE_RETIREMENT_CAUSE_ prefix, or number. Names are not case-sensitive.
+func ParsePageRetirementCause(s string) (PageRetirementCause, error) {
+	return parseEnum("PageRetirementCause", pageRetirementCauseValues, s)
+}
+
+// pcieLinkStateValues are the named values of PcieLinkState.
+var pcieLinkStateValues = []enumValue[PcieLinkState]{
+	{"KEEP", "PCIE_LINK_KEEP", PCIE_LINK_KEEP},
+	{"SHUT_DOWN", "PCIE_LINK_SHUT_DOWN", PCIE_LINK_SHUT_DOWN},
+}
+
+// String returns the name of the value without the PCIE_LINK_ prefix.
+func (e PcieLinkState) String() string {
+	return formatEnum("PcieLinkState", pcieLinkStateValues, e)
+}
+
+// MarshalText returns the name of the value without the PCIE_LINK_ prefix,
+// or its number if it has no name.
+func (e PcieLinkState) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(pcieLinkStateValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePcieLinkState.
+func (e *PcieLinkState) UnmarshalText(text []byte) error {
+	value, err := ParsePcieLinkState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePcieLinkState returns the PcieLinkState with the specified name, with or
+// without the PCIE_LINK_ prefix, or number. Names are not case-sensitive.
+func ParsePcieLinkState(s string) (PcieLinkState, error) {
+	return parseEnum("PcieLinkState", pcieLinkStateValues, s)
+}
+
+// pcieUtilCounterValues are the named values of PcieUtilCounter.
+var pcieUtilCounterValues = []enumValue[PcieUtilCounter]{
+	{"TX_BYTES", "PCIE_UTIL_TX_BYTES", PCIE_UTIL_TX_BYTES},
+	{"RX_BYTES", "PCIE_UTIL_RX_BYTES", PCIE_UTIL_RX_BYTES},
+	{"COUNT", "PCIE_UTIL_COUNT", PCIE_UTIL_COUNT},
+}
+
+// String returns the name of the value without the PCIE_UTIL_ prefix.
+func (e PcieUtilCounter) String() string {
+	return formatEnum("PcieUtilCounter", pcieUtilCounterValues, e)
+}
+
+// MarshalText returns the name of the value without the PCIE_UTIL_ prefix,
+// or its number if it has no name.
+func (e PcieUtilCounter) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(pcieUtilCounterValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePcieUtilCounter.
+func (e *PcieUtilCounter) UnmarshalText(text []byte) error {
+	value, err := ParsePcieUtilCounter(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePcieUtilCounter returns the PcieUtilCounter with the specified name, with or
+// without the PCIE_UTIL_ prefix, or number. Names are not case-sensitive.
+func ParsePcieUtilCounter(s string) (PcieUtilCounter, error) {
+	return parseEnum("PcieUtilCounter", pcieUtilCounterValues, s)
+}
+
+// perfPolicyTypeValues are the named values of PerfPolicyType.
+var perfPolicyTypeValues = []enumValue[PerfPolicyType]{
+	{"POWER", "PERF_POLICY_POWER", PERF_POLICY_POWER},
+	{"THERMAL", "PERF_POLICY_THERMAL", PERF_POLICY_THERMAL},
+	{"SYNC_BOOST", "PERF_POLICY_SYNC_BOOST", PERF_POLICY_SYNC_BOOST},
+	{"BOARD_LIMIT", "PERF_POLICY_BOARD_LIMIT", PERF_POLICY_BOARD_LIMIT},
+	{"LOW_UTILIZATION", "PERF_POLICY_LOW_UTILIZATION", PERF_POLICY_LOW_UTILIZATION},
+	{"RELIABILITY", "PERF_POLICY_RELIABILITY", PERF_POLICY_RELIABILITY},
+	{"TOTAL_APP_CLOCKS", "PERF_POLICY_TOTAL_APP_CLOCKS", PERF_POLICY_TOTAL_APP_CLOCKS},
+	{"TOTAL_BASE_CLOCKS", "PERF_POLICY_TOTAL_BASE_CLOCKS", PERF_POLICY_TOTAL_BASE_CLOCKS},
+	{"COUNT", "PERF_POLICY_COUNT", PERF_POLICY_COUNT},
+}
+
+// String returns the name of the value without the PERF_POLICY_ prefix.
+func (e PerfPolicyType) String() string {
+	return formatEnum("PerfPolicyType", perfPolicyTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the PERF_POLICY_ prefix,
+// or its number if it has no name.
+func (e PerfPolicyType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(perfPolicyTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePerfPolicyType.
+func (e *PerfPolicyType) UnmarshalText(text []byte) error {
+	value, err := ParsePerfPolicyType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePerfPolicyType returns the PerfPolicyType with the specified name, with or
+// without the PERF_POLICY_ prefix, or number. Names are not case-sensitive.
+func ParsePerfPolicyType(s string) (PerfPolicyType, error) {
+	return parseEnum("PerfPolicyType", perfPolicyTypeValues, s)
+}
+
+// powerProfileTypeValues are the named values of PowerProfileType.
+var powerProfileTypeValues = []enumValue[PowerProfileType]{
+	{"MAX_P", "POWER_PROFILE_MAX_P", POWER_PROFILE_MAX_P},
+	{"MAX_Q", "POWER_PROFILE_MAX_Q", POWER_PROFILE_MAX_Q},
+	{"COMPUTE", "POWER_PROFILE_COMPUTE", POWER_PROFILE_COMPUTE},
+	{"MEMORY_BOUND", "POWER_PROFILE_MEMORY_BOUND", POWER_PROFILE_MEMORY_BOUND},
+	{"NETWORK", "POWER_PROFILE_NETWORK", POWER_PROFILE_NETWORK},
+	{"BALANCED", "POWER_PROFILE_BALANCED", POWER_PROFILE_BALANCED},
+	{"LLM_INFERENCE", "POWER_PROFILE_LLM_INFERENCE", POWER_PROFILE_LLM_INFERENCE},
+	{"LLM_TRAINING", "POWER_PROFILE_LLM_TRAINING", POWER_PROFILE_LLM_TRAINING},
+	{"RBM", "POWER_PROFILE_RBM", POWER_PROFILE_RBM},
+	{"DCPCIE", "POWER_PROFILE_DCPCIE", POWER_PROFILE_DCPCIE},
+	{"HMMA_SPARSE", "POWER_PROFILE_HMMA_SPARSE", POWER_PROFILE_HMMA_SPARSE},
+	{"HMMA_DENSE", "POWER_PROFILE_HMMA_DENSE", POWER_PROFILE_HMMA_DENSE},
+	{"SYNC_BALANCED", "POWER_PROFILE_SYNC_BALANCED", POWER_PROFILE_SYNC_BALANCED},
+	{"HPC", "POWER_PROFILE_HPC", POWER_PROFILE_HPC},
+	{"MIG", "POWER_PROFILE_MIG", POWER_PROFILE_MIG},
+	{"MAX", "POWER_PROFILE_MAX", POWER_PROFILE_MAX},
+}
+
+// String returns the name of the value without the POWER_PROFILE_ prefix.
+func (e PowerProfileType) String() string {
+	return formatEnum("PowerProfileType", powerProfileTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the POWER_PROFILE_ prefix,
+// or its number if it has no name.
+func (e PowerProfileType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(powerProfileTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePowerProfileType.
+func (e *PowerProfileType) UnmarshalText(text []byte) error {
+	value, err := ParsePowerProfileType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePowerProfileType returns the PowerProfileType with the specified name, with or
+// without the POWER_PROFILE_ prefix, or number. Names are not case-sensitive.
+func ParsePowerProfileType(s string) (PowerProfileType, error) {
+	return parseEnum("PowerProfileType", powerProfileTypeValues, s)
+}
+
+// powerSourceValues are the named values of PowerSource.
+var powerSourceValues = []enumValue[PowerSource]{
+	{"AC", "POWER_SOURCE_AC", POWER_SOURCE_AC},
+	{"BATTERY", "POWER_SOURCE_BATTERY", POWER_SOURCE_BATTERY},
+	{"UNDERSIZED", "POWER_SOURCE_UNDERSIZED", POWER_SOURCE_UNDERSIZED},
+}
+
+// String returns the name of the value without the POWER_SOURCE_ prefix.
+func (e PowerSource) String() string {
+	return formatEnum("PowerSource", powerSourceValues, e)
+}
+
+// MarshalText returns the name of the value without the POWER_SOURCE_ prefix,
+// or its number if it has no name.
+func (e PowerSource) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(powerSourceValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePowerSource.
+func (e *PowerSource) UnmarshalText(text []byte) error {
+	value, err := ParsePowerSource(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePowerSource returns the PowerSource with the specified name, with or
+// without the POWER_SOURCE_ prefix, or number. Names are not case-sensitive.
+func ParsePowerSource(s string) (PowerSource, error) {
+	return parseEnum("PowerSource", powerSourceValues, s)
+}
+
+// pstatesValues are the named values of Pstates.
+var pstatesValues = []enumValue[Pstates]{
+	{"PSTATE_0", "PSTATE_0", PSTATE_0},
+	{"PSTATE_1", "PSTATE_1", PSTATE_1},
+	{"PSTATE_2", "PSTATE_2", PSTATE_2},
+	{"PSTATE_3", "PSTATE_3", PSTATE_3},
+	{"PSTATE_4", "PSTATE_4", PSTATE_4},
+	{"PSTATE_5", "PSTATE_5", PSTATE_5},
+	{"PSTATE_6", "PSTATE_6", PSTATE_6},
+	{"PSTATE_7", "PSTATE_7", PSTATE_7},
+	{"PSTATE_8", "PSTATE_8", PSTATE_8},
+	{"PSTATE_9", "PSTATE_9", PSTATE_9},
+	{"PSTATE_10", "PSTATE_10", PSTATE_10},
+	{"PSTATE_11", "PSTATE_11", PSTATE_11},
+	{"PSTATE_12", "PSTATE_12", PSTATE_12},
+	{"PSTATE_13", "PSTATE_13", PSTATE_13},
+	{"PSTATE_14", "PSTATE_14", PSTATE_14},
+	{"PSTATE_15", "PSTATE_15", PSTATE_15},
+	{"PSTATE_UNKNOWN", "PSTATE_UNKNOWN", PSTATE_UNKNOWN},
+}
+
+// String returns the name of the value.
+func (e Pstates) String() string {
+	return formatEnum("Pstates", pstatesValues, e)
+}
+
+// MarshalText returns the name of the value,
+// or its number if it has no name.
+func (e Pstates) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(pstatesValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParsePstates.
+func (e *Pstates) UnmarshalText(text []byte) error {
+	value, err := ParsePstates(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParsePstates returns the Pstates with the specified name or number.
+// Names are not case-sensitive.
+func ParsePstates(s string) (Pstates, error) {
+	return parseEnum("Pstates", pstatesValues, s)
+}
+
+// restrictedAPIValues are the named values of RestrictedAPI.
+var restrictedAPIValues = []enumValue[RestrictedAPI]{
+	{"SET_APPLICATION_CLOCKS", "RESTRICTED_API_SET_APPLICATION_CLOCKS", RESTRICTED_API_SET_APPLICATION_CLOCKS},
+	{"SET_AUTO_BOOSTED_CLOCKS", "RESTRICTED_API_SET_AUTO_BOOSTED_CLOCKS", RESTRICTED_API_SET_AUTO_BOOSTED_CLOCKS},
+	{"COUNT", "RESTRICTED_API_COUNT", RESTRICTED_API_COUNT},
+}
+
+// String returns the name of the value without the RESTRICTED_API_ prefix.
+func (e RestrictedAPI) String() string {
+	return formatEnum("RestrictedAPI", restrictedAPIValues, e)
+}
+
+// MarshalText returns the name of the value without the RESTRICTED_API_ prefix,
+// or its number if it has no name.
+func (e RestrictedAPI) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(restrictedAPIValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseRestrictedAPI.
+func (e *RestrictedAPI) UnmarshalText(text []byte) error {
+	value, err := ParseRestrictedAPI(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseRestrictedAPI returns the RestrictedAPI with the specified name, with or
+// without the RESTRICTED_API_ prefix, or number. Names are not case-sensitive.
+func ParseRestrictedAPI(s string) (RestrictedAPI, error) {
+	return parseEnum("RestrictedAPI", restrictedAPIValues, s)
+}
+
+// returnValues are the named values of Return.
+var returnValues = []enumValue[Return]{
+	{"SUCCESS", "SUCCESS", SUCCESS},
+	{"ERROR_UNINITIALIZED", "ERROR_UNINITIALIZED", ERROR_UNINITIALIZED},
+	{"ERROR_INVALID_ARGUMENT", "ERROR_INVALID_ARGUMENT", ERROR_INVALID_ARGUMENT},
+	{"ERROR_NOT_SUPPORTED", "ERROR_NOT_SUPPORTED", ERROR_NOT_SUPPORTED},
+	{"ERROR_NO_PERMISSION", "ERROR_NO_PERMISSION", ERROR_NO_PERMISSION},
+	{"ERROR_ALREADY_INITIALIZED", "ERROR_ALREADY_INITIALIZED", ERROR_ALREADY_INITIALIZED},
+	{"ERROR_NOT_FOUND", "ERROR_NOT_FOUND", ERROR_NOT_FOUND},
+	{"ERROR_INSUFFICIENT_SIZE", "ERROR_INSUFFICIENT_SIZE", ERROR_INSUFFICIENT_SIZE},
+	{"ERROR_INSUFFICIENT_POWER", "ERROR_INSUFFICIENT_POWER", ERROR_INSUFFICIENT_POWER},
+	{"ERROR_DRIVER_NOT_LOADED", "ERROR_DRIVER_NOT_LOADED", ERROR_DRIVER_NOT_LOADED},
+	{"ERROR_TIMEOUT", "ERROR_TIMEOUT", ERROR_TIMEOUT},
+	{"ERROR_IRQ_ISSUE", "ERROR_IRQ_ISSUE", ERROR_IRQ_ISSUE},
+	{"ERROR_LIBRARY_NOT_FOUND", "ERROR_LIBRARY_NOT_FOUND", ERROR_LIBRARY_NOT_FOUND},
+	{"ERROR_FUNCTION_NOT_FOUND", "ERROR_FUNCTION_NOT_FOUND", ERROR_FUNCTION_NOT_FOUND},
+	{"ERROR_CORRUPTED_INFOROM", "ERROR_CORRUPTED_INFOROM", ERROR_CORRUPTED_INFOROM},
+	{"ERROR_GPU_IS_LOST", "ERROR_GPU_IS_LOST", ERROR_GPU_IS_LOST},
+	{"ERROR_RESET_REQUIRED", "ERROR_RESET_REQUIRED", ERROR_RESET_REQUIRED},
+	{"ERROR_OPERATING_SYSTEM", "ERROR_OPERATING_SYSTEM", ERROR_OPERATING_SYSTEM},
+	{"ERROR_LIB_RM_VERSION_MISMATCH", "ERROR_LIB_RM_VERSION_MISMATCH", ERROR_LIB_RM_VERSION_MISMATCH},
+	{"ERROR_IN_USE", "ERROR_IN_USE", ERROR_IN_USE},
+	{"ERROR_MEMORY", "ERROR_MEMORY", ERROR_MEMORY},
+	{"ERROR_NO_DATA", "ERROR_NO_DATA", ERROR_NO_DATA},
+	{"ERROR_VGPU_ECC_NOT_SUPPORTED", "ERROR_VGPU_ECC_NOT_SUPPORTED", ERROR_VGPU_ECC_NOT_SUPPORTED},
+	{"ERROR_INSUFFICIENT_RESOURCES", "ERROR_INSUFFICIENT_RESOURCES", ERROR_INSUFFICIENT_RESOURCES},
+	{"ERROR_FREQ_NOT_SUPPORTED", "ERROR_FREQ_NOT_SUPPORTED", ERROR_FREQ_NOT_SUPPORTED},
+	{"ERROR_ARGUMENT_VERSION_MISMATCH", "ERROR_ARGUMENT_VERSION_MISMATCH", ERROR_ARGUMENT_VERSION_MISMATCH},
+	{"ERROR_DEPRECATED", "ERROR_DEPRECATED", ERROR_DEPRECATED},
+	{"ERROR_NOT_READY", "ERROR_NOT_READY", ERROR_NOT_READY},
+	{"ERROR_GPU_NOT_FOUND", "ERROR_GPU_NOT_FOUND", ERROR_GPU_NOT_FOUND},
+	{"ERROR_INVALID_STATE", "ERROR_INVALID_STATE", ERROR_INVALID_STATE},
+	{"ERROR_RESET_TYPE_NOT_SUPPORTED", "ERROR_RESET_TYPE_NOT_SUPPORTED", ERROR_RESET_TYPE_NOT_SUPPORTED},
+	{"ERROR_UNKNOWN", "ERROR_UNKNOWN", ERROR_UNKNOWN},
+}
+
+// MarshalText returns the name of the value,
+// or its number if it has no name.
+func (e Return) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(returnValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseReturn.
+func (e *Return) UnmarshalText(text []byte) error {
+	value, err := ParseReturn(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseReturn returns the Return with the specified name or number.
+// Names are not case-sensitive.
+func ParseReturn(s string) (Return, error) {
+	return parseEnum("Return", returnValues, s)
+}
+
+// samplingTypeValues are the named values of SamplingType.
+var samplingTypeValues = []enumValue[SamplingType]{
+	{"TOTAL_POWER_SAMPLES", "TOTAL_POWER_SAMPLES", TOTAL_POWER_SAMPLES},
+	{"GPU_UTILIZATION_SAMPLES", "GPU_UTILIZATION_SAMPLES", GPU_UTILIZATION_SAMPLES},
+	{"MEMORY_UTILIZATION_SAMPLES", "MEMORY_UTILIZATION_SAMPLES", MEMORY_UTILIZATION_SAMPLES},
+	{"ENC_UTILIZATION_SAMPLES", "ENC_UTILIZATION_SAMPLES", ENC_UTILIZATION_SAMPLES},
+	{"DEC_UTILIZATION_SAMPLES", "DEC_UTILIZATION_SAMPLES", DEC_UTILIZATION_SAMPLES},
+	{"PROCESSOR_CLK_SAMPLES", "PROCESSOR_CLK_SAMPLES", PROCESSOR_CLK_SAMPLES},
+	{"MEMORY_CLK_SAMPLES", "MEMORY_CLK_SAMPLES", MEMORY_CLK_SAMPLES},
+	{"MODULE_POWER_SAMPLES", "MODULE_POWER_SAMPLES", MODULE_POWER_SAMPLES},
+	{"JPG_UTILIZATION_SAMPLES", "JPG_UTILIZATION_SAMPLES", JPG_UTILIZATION_SAMPLES},
+	{"OFA_UTILIZATION_SAMPLES", "OFA_UTILIZATION_SAMPLES", OFA_UTILIZATION_SAMPLES},
+	{"SAMPLINGTYPE_COUNT", "SAMPLINGTYPE_COUNT", SAMPLINGTYPE_COUNT},
+}
+
+// String returns the name of the value.
+func (e SamplingType) String() string {
+	return formatEnum("SamplingType", samplingTypeValues, e)
+}
+
+// MarshalText returns the name of the value,
+// or its number if it has no name.
+func (e SamplingType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(samplingTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseSamplingType.
+func (e *SamplingType) UnmarshalText(text []byte) error {
+	value, err := ParseSamplingType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseSamplingType returns the SamplingType with the specified name or number.
+// Names are not case-sensitive.
+func ParseSamplingType(s string) (SamplingType, error) {
+	return parseEnum("SamplingType", samplingTypeValues, s)
+}
+
+// temperatureSensorsValues are the named values of TemperatureSensors.
+var temperatureSensorsValues = []enumValue[TemperatureSensors]{
+	{"GPU", "TEMPERATURE_GPU", TEMPERATURE_GPU},
+	{"COUNT", "TEMPERATURE_COUNT", TEMPERATURE_COUNT},
+}
+
+// String returns the name of the value without the TEMPERATURE_ prefix.
+func (e TemperatureSensors) String() string {
+	return formatEnum("TemperatureSensors", temperatureSensorsValues, e)
+}
+
+// MarshalText returns the name of the value without the TEMPERATURE_ prefix,
+// or its number if it has no name.
+func (e TemperatureSensors) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(temperatureSensorsValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseTemperatureSensors.
+func (e *TemperatureSensors) UnmarshalText(text []byte) error {
+	value, err := ParseTemperatureSensors(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseTemperatureSensors returns the TemperatureSensors with the specified name, with or
+// without the TEMPERATURE_ prefix, or number. Names are not case-sensitive.
+func ParseTemperatureSensors(s string) (TemperatureSensors, error) {
+	return parseEnum("TemperatureSensors", temperatureSensorsValues, s)
+}
+
+// temperatureThresholdsValues are the named values of TemperatureThresholds.
+var temperatureThresholdsValues = []enumValue[TemperatureThresholds]{
+	{"SHUTDOWN", "TEMPERATURE_THRESHOLD_SHUTDOWN", TEMPERATURE_THRESHOLD_SHUTDOWN},
+	{"SLOWDOWN", "TEMPERATURE_THRESHOLD_SLOWDOWN", TEMPERATURE_THRESHOLD_SLOWDOWN},
+	{"MEM_MAX", "TEMPERATURE_THRESHOLD_MEM_MAX", TEMPERATURE_THRESHOLD_MEM_MAX},
+	{"GPU_MAX", "TEMPERATURE_THRESHOLD_GPU_MAX", TEMPERATURE_THRESHOLD_GPU_MAX},
+	{"ACOUSTIC_MIN", "TEMPERATURE_THRESHOLD_ACOUSTIC_MIN", TEMPERATURE_THRESHOLD_ACOUSTIC_MIN},
+	{"ACOUSTIC_CURR", "TEMPERATURE_THRESHOLD_ACOUSTIC_CURR", TEMPERATURE_THRESHOLD_ACOUSTIC_CURR},
+	{"ACOUSTIC_MAX", "TEMPERATURE_THRESHOLD_ACOUSTIC_MAX", TEMPERATURE_THRESHOLD_ACOUSTIC_MAX},
+	{"GPS_CURR", "TEMPERATURE_THRESHOLD_GPS_CURR", TEMPERATURE_THRESHOLD_GPS_CURR},
+	{"COUNT", "TEMPERATURE_THRESHOLD_COUNT", TEMPERATURE_THRESHOLD_COUNT},
+}
+
+// String returns the name of the value without the TEMPERATURE_THRESHOLD_ prefix.
+func (e TemperatureThresholds) String() string {
+	return formatEnum("TemperatureThresholds", temperatureThresholdsValues, e)
+}
+
+// MarshalText returns the name of the value without the TEMPERATURE_THRESHOLD_ prefix,
+// or its number if it has no name.
+func (e TemperatureThresholds) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(temperatureThresholdsValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseTemperatureThresholds.
+func (e *TemperatureThresholds) UnmarshalText(text []byte) error {
+	value, err := ParseTemperatureThresholds(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseTemperatureThresholds returns the TemperatureThresholds with the specified name, with or
+// without the TEMPERATURE_THRESHOLD_ prefix, or number. Names are not case-sensitive.
+func ParseTemperatureThresholds(s string) (TemperatureThresholds, error) {
+	return parseEnum("TemperatureThresholds", temperatureThresholdsValues, s)
+}
+
+// thermalControllerValues are the named values of ThermalController.
+var thermalControllerValues = []enumValue[ThermalController]{
+	{"NONE", "THERMAL_CONTROLLER_NONE", THERMAL_CONTROLLER_NONE},
+	{"GPU_INTERNAL", "THERMAL_CONTROLLER_GPU_INTERNAL", THERMAL_CONTROLLER_GPU_INTERNAL},
+	{"ADM1032", "THERMAL_CONTROLLER_ADM1032", THERMAL_CONTROLLER_ADM1032},
+	{"ADT7461", "THERMAL_CONTROLLER_ADT7461", THERMAL_CONTROLLER_ADT7461},
+	{"MAX6649", "THERMAL_CONTROLLER_MAX6649", THERMAL_CONTROLLER_MAX6649},
+	{"MAX1617", "THERMAL_CONTROLLER_MAX1617", THERMAL_CONTROLLER_MAX1617},
+	{"LM99", "THERMAL_CONTROLLER_LM99", THERMAL_CONTROLLER_LM99},
+	{"LM89", "THERMAL_CONTROLLER_LM89", THERMAL_CONTROLLER_LM89},
+	{"LM64", "THERMAL_CONTROLLER_LM64", THERMAL_CONTROLLER_LM64},
+	{"G781", "THERMAL_CONTROLLER_G781", THERMAL_CONTROLLER_G781},
+	{"ADT7473", "THERMAL_CONTROLLER_ADT7473", THERMAL_CONTROLLER_ADT7473},
+	{"SBMAX6649", "THERMAL_CONTROLLER_SBMAX6649", THERMAL_CONTROLLER_SBMAX6649},
+	{"VBIOSEVT", "THERMAL_CONTROLLER_VBIOSEVT", THERMAL_CONTROLLER_VBIOSEVT},
+	{"OS", "THERMAL_CONTROLLER_OS", THERMAL_CONTROLLER_OS},
+	{"NVSYSCON_CANOAS", "THERMAL_CONTROLLER_NVSYSCON_CANOAS", THERMAL_CONTROLLER_NVSYSCON_CANOAS},
+	{"NVSYSCON_E551", "THERMAL_CONTROLLER_NVSYSCON_E551", THERMAL_CONTROLLER_NVSYSCON_E551},
+	{"MAX6649R", "THERMAL_CONTROLLER_MAX6649R", THERMAL_CONTROLLER_MAX6649R},
+	{"ADT7473S", "THERMAL_CONTROLLER_ADT7473S", THERMAL_CONTROLLER_ADT7473S},
+	{"UNKNOWN", "THERMAL_CONTROLLER_UNKNOWN", THERMAL_CONTROLLER_UNKNOWN},
+}
+
+// String returns the name of the value without the THERMAL_CONTROLLER_ prefix.
+func (e ThermalController) String() string {
+	return formatEnum("ThermalController", thermalControllerValues, e)
+}
+
+// MarshalText returns the name of the value without the THERMAL_CONTROLLER_ prefix,
+// or its number if it has no name.
+func (e ThermalController) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(thermalControllerValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseThermalController.
+func (e *ThermalController) UnmarshalText(text []byte) error {
+	value, err := ParseThermalController(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseThermalController returns the ThermalController with the specified name, with or
+// without the THERMAL_CONTROLLER_ prefix, or number. Names are not case-sensitive.
+func ParseThermalController(s string) (ThermalController, error) {
+	return parseEnum("ThermalController", thermalControllerValues, s)
+}
+
+// thermalTargetValues are the named values of ThermalTarget.
+var thermalTargetValues = []enumValue[ThermalTarget]{
+	{"NONE", "THERMAL_TARGET_NONE", THERMAL_TARGET_NONE},
+	{"GPU", "THERMAL_TARGET_GPU", THERMAL_TARGET_GPU},
+	{"MEMORY", "THERMAL_TARGET_MEMORY", THERMAL_TARGET_MEMORY},
+	{"POWER_SUPPLY", "THERMAL_TARGET_POWER_SUPPLY", THERMAL_TARGET_POWER_SUPPLY},
+	{"BOARD", "THERMAL_TARGET_BOARD", THERMAL_TARGET_BOARD},
+	{"VCD_BOARD", "THERMAL_TARGET_VCD_BOARD", THERMAL_TARGET_VCD_BOARD},
+	{"VCD_INLET", "THERMAL_TARGET_VCD_INLET", THERMAL_TARGET_VCD_INLET},
+	{"VCD_OUTLET", "THERMAL_TARGET_VCD_OUTLET", THERMAL_TARGET_VCD_OUTLET},
+	{"ALL", "THERMAL_TARGET_ALL", THERMAL_TARGET_ALL},
+	{"UNKNOWN", "THERMAL_TARGET_UNKNOWN", THERMAL_TARGET_UNKNOWN},
+}
+
+// String returns the name of the value without the THERMAL_TARGET_ prefix.
+func (e ThermalTarget) String() string {
+	return formatEnum("ThermalTarget", thermalTargetValues, e)
+}
+
+// MarshalText returns the name of the value without the THERMAL_TARGET_ prefix,
+// or its number if it has no name.
+func (e ThermalTarget) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(thermalTargetValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseThermalTarget.
+func (e *ThermalTarget) UnmarshalText(text []byte) error {
+	value, err := ParseThermalTarget(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseThermalTarget returns the ThermalTarget with the specified name, with or
+// without the THERMAL_TARGET_ prefix, or number. Names are not case-sensitive.
+func ParseThermalTarget(s string) (ThermalTarget, error) {
+	return parseEnum("ThermalTarget", thermalTargetValues, s)
+}
+
+// uuidTypeValues are the named values of UUIDType.
+var uuidTypeValues = []enumValue[UUIDType]{
+	{"NONE", "UUID_TYPE_NONE", UUID_TYPE_NONE},
+	{"ASCII", "UUID_TYPE_ASCII", UUID_TYPE_ASCII},
+	{"BINARY", "UUID_TYPE_BINARY", UUID_TYPE_BINARY},
+}
+
+// String returns the name of the value without the UUID_TYPE_ prefix.
+func (e UUIDType) String() string {
+	return formatEnum("UUIDType", uuidTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the UUID_TYPE_ prefix,
+// or its number if it has no name.
+func (e UUIDType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(uuidTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseUUIDType.
+func (e *UUIDType) UnmarshalText(text []byte) error {
+	value, err := ParseUUIDType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseUUIDType returns the UUIDType with the specified name, with or
+// without the UUID_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseUUIDType(s string) (UUIDType, error) {
+	return parseEnum("UUIDType", uuidTypeValues, s)
+}
+
+// valueTypeValues are the named values of ValueType.
+var valueTypeValues = []enumValue[ValueType]{
+	{"DOUBLE", "VALUE_TYPE_DOUBLE", VALUE_TYPE_DOUBLE},
+	{"UNSIGNED_INT", "VALUE_TYPE_UNSIGNED_INT", VALUE_TYPE_UNSIGNED_INT},
+	{"UNSIGNED_LONG", "VALUE_TYPE_UNSIGNED_LONG", VALUE_TYPE_UNSIGNED_LONG},
+	{"UNSIGNED_LONG_LONG", "VALUE_TYPE_UNSIGNED_LONG_LONG", VALUE_TYPE_UNSIGNED_LONG_LONG},
+	{"SIGNED_LONG_LONG", "VALUE_TYPE_SIGNED_LONG_LONG", VALUE_TYPE_SIGNED_LONG_LONG},
+	{"SIGNED_INT", "VALUE_TYPE_SIGNED_INT", VALUE_TYPE_SIGNED_INT},
+	{"UNSIGNED_SHORT", "VALUE_TYPE_UNSIGNED_SHORT", VALUE_TYPE_UNSIGNED_SHORT},
+	{"COUNT", "VALUE_TYPE_COUNT", VALUE_TYPE_COUNT},
+}
+
+// String returns the name of the value without the VALUE_TYPE_ prefix.
+func (e ValueType) String() string {
+	return formatEnum("ValueType", valueTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the VALUE_TYPE_ prefix,
+// or its number if it has no name.
+func (e ValueType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(valueTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseValueType.
+func (e *ValueType) UnmarshalText(text []byte) error {
+	value, err := ParseValueType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseValueType returns the ValueType with the specified name, with or
+// without the VALUE_TYPE_ prefix, or number. Names are not case-sensitive.
+func ParseValueType(s string) (ValueType, error) {
+	return parseEnum("ValueType", valueTypeValues, s)
+}
+
+// vgpuCapabilityValues are the named values of VgpuCapability.
+var vgpuCapabilityValues = []enumValue[VgpuCapability]{
+	{"NVLINK_P2P", "VGPU_CAP_NVLINK_P2P", VGPU_CAP_NVLINK_P2P},
+	{"GPUDIRECT", "VGPU_CAP_GPUDIRECT", VGPU_CAP_GPUDIRECT},
+	{"MULTI_VGPU_EXCLUSIVE", "VGPU_CAP_MULTI_VGPU_EXCLUSIVE", VGPU_CAP_MULTI_VGPU_EXCLUSIVE},
+	{"EXCLUSIVE_TYPE", "VGPU_CAP_EXCLUSIVE_TYPE", VGPU_CAP_EXCLUSIVE_TYPE},
+	{"EXCLUSIVE_SIZE", "VGPU_CAP_EXCLUSIVE_SIZE", VGPU_CAP_EXCLUSIVE_SIZE},
+	{"COUNT", "VGPU_CAP_COUNT", VGPU_CAP_COUNT},
+}
+
+// String returns the name of the value without the VGPU_CAP_ prefix.
+func (e VgpuCapability) String() string {
+	return formatEnum("VgpuCapability", vgpuCapabilityValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_CAP_ prefix,
+// or its number if it has no name.
+func (e VgpuCapability) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuCapabilityValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuCapability.
+func (e *VgpuCapability) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuCapability(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuCapability returns the VgpuCapability with the specified name, with or
+// without the VGPU_CAP_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuCapability(s string) (VgpuCapability, error) {
+	return parseEnum("VgpuCapability", vgpuCapabilityValues, s)
+}
+
+// vgpuDriverCapabilityValues are the named values of VgpuDriverCapability.
+var vgpuDriverCapabilityValues = []enumValue[VgpuDriverCapability]{
+	{"HETEROGENEOUS_MULTI_VGPU", "VGPU_DRIVER_CAP_HETEROGENEOUS_MULTI_VGPU", VGPU_DRIVER_CAP_HETEROGENEOUS_MULTI_VGPU},
+	{"WARM_UPDATE", "VGPU_DRIVER_CAP_WARM_UPDATE", VGPU_DRIVER_CAP_WARM_UPDATE},
+	{"COUNT", "VGPU_DRIVER_CAP_COUNT", VGPU_DRIVER_CAP_COUNT},
+}
+
+// String returns the name of the value without the VGPU_DRIVER_CAP_ prefix.
+func (e VgpuDriverCapability) String() string {
+	return formatEnum("VgpuDriverCapability", vgpuDriverCapabilityValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_DRIVER_CAP_ prefix,
+// or its number if it has no name.
+func (e VgpuDriverCapability) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuDriverCapabilityValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuDriverCapability.
+func (e *VgpuDriverCapability) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuDriverCapability(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuDriverCapability returns the VgpuDriverCapability with the specified name, with or
+// without the VGPU_DRIVER_CAP_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuDriverCapability(s string) (VgpuDriverCapability, error) {
+	return parseEnum("VgpuDriverCapability", vgpuDriverCapabilityValues, s)
+}
+
+// vgpuGuestInfoStateValues are the named values of VgpuGuestInfoState.
+var vgpuGuestInfoStateValues = []enumValue[VgpuGuestInfoState]{
+	{"UNINITIALIZED", "VGPU_INSTANCE_GUEST_INFO_STATE_UNINITIALIZED", VGPU_INSTANCE_GUEST_INFO_STATE_UNINITIALIZED},
+	{"INITIALIZED", "VGPU_INSTANCE_GUEST_INFO_STATE_INITIALIZED", VGPU_INSTANCE_GUEST_INFO_STATE_INITIALIZED},
+}
+
+// String returns the name of the value without the VGPU_INSTANCE_GUEST_INFO_STATE_ prefix.
+func (e VgpuGuestInfoState) String() string {
+	return formatEnum("VgpuGuestInfoState", vgpuGuestInfoStateValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_INSTANCE_GUEST_INFO_STATE_ prefix,
+// or its number if it has no name.
+func (e VgpuGuestInfoState) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuGuestInfoStateValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuGuestInfoState.
+func (e *VgpuGuestInfoState) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuGuestInfoState(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuGuestInfoState returns the VgpuGuestInfoState with the specified name, with or
+// without the VGPU_INSTANCE_GUEST_INFO_STATE_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuGuestInfoState(s string) (VgpuGuestInfoState, error) {
+	return parseEnum("VgpuGuestInfoState", vgpuGuestInfoStateValues, s)
+}
+
+// vgpuPgpuCompatibilityLimitCodeValues are the named values of VgpuPgpuCompatibilityLimitCode.
+var vgpuPgpuCompatibilityLimitCodeValues = []enumValue[VgpuPgpuCompatibilityLimitCode]{
+	{"NONE", "VGPU_COMPATIBILITY_LIMIT_NONE", VGPU_COMPATIBILITY_LIMIT_NONE},
+	{"HOST_DRIVER", "VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER", VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER},
+	{"GUEST_DRIVER", "VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER", VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER},
+	{"GPU", "VGPU_COMPATIBILITY_LIMIT_GPU", VGPU_COMPATIBILITY_LIMIT_GPU},
+	{"OTHER", "VGPU_COMPATIBILITY_LIMIT_OTHER", VGPU_COMPATIBILITY_LIMIT_OTHER},
+}
+
+// String returns the name of the value without the VGPU_COMPATIBILITY_LIMIT_ prefix.
+func (e VgpuPgpuCompatibilityLimitCode) String() string {
+	return formatEnum("VgpuPgpuCompatibilityLimitCode", vgpuPgpuCompatibilityLimitCodeValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_COMPATIBILITY_LIMIT_ prefix,
+// or its number if it has no name.
+func (e VgpuPgpuCompatibilityLimitCode) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuPgpuCompatibilityLimitCodeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuPgpuCompatibilityLimitCode.
+func (e *VgpuPgpuCompatibilityLimitCode) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuPgpuCompatibilityLimitCode(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuPgpuCompatibilityLimitCode returns the VgpuPgpuCompatibilityLimitCode with the specified name, with or
+// without the VGPU_COMPATIBILITY_LIMIT_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuPgpuCompatibilityLimitCode(s string) (VgpuPgpuCompatibilityLimitCode, error) {
+	return parseEnum("VgpuPgpuCompatibilityLimitCode", vgpuPgpuCompatibilityLimitCodeValues, s)
+}
+
+// vgpuVmCompatibilityValues are the named values of VgpuVmCompatibility.
+var vgpuVmCompatibilityValues = []enumValue[VgpuVmCompatibility]{
+	{"NONE", "VGPU_VM_COMPATIBILITY_NONE", VGPU_VM_COMPATIBILITY_NONE},
+	{"COLD", "VGPU_VM_COMPATIBILITY_COLD", VGPU_VM_COMPATIBILITY_COLD},
+	{"HIBERNATE", "VGPU_VM_COMPATIBILITY_HIBERNATE", VGPU_VM_COMPATIBILITY_HIBERNATE},
+	{"SLEEP", "VGPU_VM_COMPATIBILITY_SLEEP", VGPU_VM_COMPATIBILITY_SLEEP},
+	{"LIVE", "VGPU_VM_COMPATIBILITY_LIVE", VGPU_VM_COMPATIBILITY_LIVE},
+}
+
+// String returns the name of the value without the VGPU_VM_COMPATIBILITY_ prefix.
+func (e VgpuVmCompatibility) String() string {
+	return formatEnum("VgpuVmCompatibility", vgpuVmCompatibilityValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_VM_COMPATIBILITY_ prefix,
+// or its number if it has no name.
+func (e VgpuVmCompatibility) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuVmCompatibilityValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuVmCompatibility.
+func (e *VgpuVmCompatibility) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuVmCompatibility(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuVmCompatibility returns the VgpuVmCompatibility with the specified name, with or
+// without the VGPU_VM_COMPATIBILITY_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuVmCompatibility(s string) (VgpuVmCompatibility, error) {
+	return parseEnum("VgpuVmCompatibility", vgpuVmCompatibilityValues, s)
+}
+
+// vgpuVmIdTypeValues are the named values of VgpuVmIdType.
+var vgpuVmIdTypeValues = []enumValue[VgpuVmIdType]{
+	{"DOMAIN_ID", "VGPU_VM_ID_DOMAIN_ID", VGPU_VM_ID_DOMAIN_ID},
+	{"UUID", "VGPU_VM_ID_UUID", VGPU_VM_ID_UUID},
+}
+
+// String returns the name of the value without the VGPU_VM_ID_ prefix.
+func (e VgpuVmIdType) String() string {
+	return formatEnum("VgpuVmIdType", vgpuVmIdTypeValues, e)
+}
+
+// MarshalText returns the name of the value without the VGPU_VM_ID_ prefix,
+// or its number if it has no name.
+func (e VgpuVmIdType) MarshalText() ([]byte, error) {
+	return []byte(formatEnumText(vgpuVmIdTypeValues, e)), nil
+}
+
+// UnmarshalText parses the value as described for ParseVgpuVmIdType.
+func (e *VgpuVmIdType) UnmarshalText(text []byte) error {
+	value, err := ParseVgpuVmIdType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// ParseVgpuVmIdType returns the VgpuVmIdType with the specified name, with or
+// without the VGPU_VM_ID_ prefix, or number. Names are not case-sensitive.
+func ParseVgpuVmIdType(s string) (VgpuVmIdType, error) {
+	return parseEnum("VgpuVmIdType", vgpuVmIdTypeValues, s)
+}
@@ -0,0 +1,2207 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// fieldInfos describes the field identifiers defined in nvml.h.
+var fieldInfos = map[uint32]FieldInfo{
+	FI_DEV_ECC_CURRENT: {
+		Id:          FI_DEV_ECC_CURRENT,
+		Name:        "FI_DEV_ECC_CURRENT",
+		Description: "Current ECC mode. 1=Active. 0=Inactive",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_ECC_PENDING: {
+		Id:          FI_DEV_ECC_PENDING,
+		Name:        "FI_DEV_ECC_PENDING",
+		Description: "Pending ECC mode. 1=Active. 0=Inactive",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_ECC_SBE_VOL_TOTAL: {
+		Id:          FI_DEV_ECC_SBE_VOL_TOTAL,
+		Name:        "FI_DEV_ECC_SBE_VOL_TOTAL",
+		Description: "Total single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_TOTAL: {
+		Id:          FI_DEV_ECC_DBE_VOL_TOTAL,
+		Name:        "FI_DEV_ECC_DBE_VOL_TOTAL",
+		Description: "Total double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_TOTAL: {
+		Id:          FI_DEV_ECC_SBE_AGG_TOTAL,
+		Name:        "FI_DEV_ECC_SBE_AGG_TOTAL",
+		Description: "Total single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_TOTAL: {
+		Id:          FI_DEV_ECC_DBE_AGG_TOTAL,
+		Name:        "FI_DEV_ECC_DBE_AGG_TOTAL",
+		Description: "Total double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_VOL_L1: {
+		Id:          FI_DEV_ECC_SBE_VOL_L1,
+		Name:        "FI_DEV_ECC_SBE_VOL_L1",
+		Description: "L1 cache single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_L1: {
+		Id:          FI_DEV_ECC_DBE_VOL_L1,
+		Name:        "FI_DEV_ECC_DBE_VOL_L1",
+		Description: "L1 cache double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_VOL_L2: {
+		Id:          FI_DEV_ECC_SBE_VOL_L2,
+		Name:        "FI_DEV_ECC_SBE_VOL_L2",
+		Description: "L2 cache single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_L2: {
+		Id:          FI_DEV_ECC_DBE_VOL_L2,
+		Name:        "FI_DEV_ECC_DBE_VOL_L2",
+		Description: "L2 cache double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_VOL_DEV: {
+		Id:          FI_DEV_ECC_SBE_VOL_DEV,
+		Name:        "FI_DEV_ECC_SBE_VOL_DEV",
+		Description: "Device memory single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_DEV: {
+		Id:          FI_DEV_ECC_DBE_VOL_DEV,
+		Name:        "FI_DEV_ECC_DBE_VOL_DEV",
+		Description: "Device memory double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_VOL_REG: {
+		Id:          FI_DEV_ECC_SBE_VOL_REG,
+		Name:        "FI_DEV_ECC_SBE_VOL_REG",
+		Description: "Register file single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_REG: {
+		Id:          FI_DEV_ECC_DBE_VOL_REG,
+		Name:        "FI_DEV_ECC_DBE_VOL_REG",
+		Description: "Register file double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_VOL_TEX: {
+		Id:          FI_DEV_ECC_SBE_VOL_TEX,
+		Name:        "FI_DEV_ECC_SBE_VOL_TEX",
+		Description: "Texture memory single bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_TEX: {
+		Id:          FI_DEV_ECC_DBE_VOL_TEX,
+		Name:        "FI_DEV_ECC_DBE_VOL_TEX",
+		Description: "Texture memory double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_VOL_CBU: {
+		Id:          FI_DEV_ECC_DBE_VOL_CBU,
+		Name:        "FI_DEV_ECC_DBE_VOL_CBU",
+		Description: "CBU double bit volatile ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_L1: {
+		Id:          FI_DEV_ECC_SBE_AGG_L1,
+		Name:        "FI_DEV_ECC_SBE_AGG_L1",
+		Description: "L1 cache single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_L1: {
+		Id:          FI_DEV_ECC_DBE_AGG_L1,
+		Name:        "FI_DEV_ECC_DBE_AGG_L1",
+		Description: "L1 cache double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_L2: {
+		Id:          FI_DEV_ECC_SBE_AGG_L2,
+		Name:        "FI_DEV_ECC_SBE_AGG_L2",
+		Description: "L2 cache single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_L2: {
+		Id:          FI_DEV_ECC_DBE_AGG_L2,
+		Name:        "FI_DEV_ECC_DBE_AGG_L2",
+		Description: "L2 cache double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_DEV: {
+		Id:          FI_DEV_ECC_SBE_AGG_DEV,
+		Name:        "FI_DEV_ECC_SBE_AGG_DEV",
+		Description: "Device memory single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_DEV: {
+		Id:          FI_DEV_ECC_DBE_AGG_DEV,
+		Name:        "FI_DEV_ECC_DBE_AGG_DEV",
+		Description: "Device memory double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_REG: {
+		Id:          FI_DEV_ECC_SBE_AGG_REG,
+		Name:        "FI_DEV_ECC_SBE_AGG_REG",
+		Description: "Register File single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_REG: {
+		Id:          FI_DEV_ECC_DBE_AGG_REG,
+		Name:        "FI_DEV_ECC_DBE_AGG_REG",
+		Description: "Register File double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_SBE_AGG_TEX: {
+		Id:          FI_DEV_ECC_SBE_AGG_TEX,
+		Name:        "FI_DEV_ECC_SBE_AGG_TEX",
+		Description: "Texture memory single bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_TEX: {
+		Id:          FI_DEV_ECC_DBE_AGG_TEX,
+		Name:        "FI_DEV_ECC_DBE_AGG_TEX",
+		Description: "Texture memory double bit aggregate (persistent) ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_ECC_DBE_AGG_CBU: {
+		Id:          FI_DEV_ECC_DBE_AGG_CBU,
+		Name:        "FI_DEV_ECC_DBE_AGG_CBU",
+		Description: "CBU double bit aggregate ECC errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_RETIRED_SBE: {
+		Id:          FI_DEV_RETIRED_SBE,
+		Name:        "FI_DEV_RETIRED_SBE",
+		Description: "Number of retired pages because of single bit errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_RETIRED_DBE: {
+		Id:          FI_DEV_RETIRED_DBE,
+		Name:        "FI_DEV_RETIRED_DBE",
+		Description: "Number of retired pages because of double bit errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_RETIRED_PENDING: {
+		Id:          FI_DEV_RETIRED_PENDING,
+		Name:        "FI_DEV_RETIRED_PENDING",
+		Description: "If any pages are pending retirement. 1=yes. 0=no.",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L0: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L0,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L0",
+		Description: "NVLink flow control CRC  Error Counter for Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L1: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L1,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L1",
+		Description: "NVLink flow control CRC  Error Counter for Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L2: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L2,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L2",
+		Description: "NVLink flow control CRC  Error Counter for Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L3: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L3,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L3",
+		Description: "NVLink flow control CRC  Error Counter for Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L4: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L4,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L4",
+		Description: "NVLink flow control CRC  Error Counter for Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L5: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L5,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L5",
+		Description: "NVLink flow control CRC  Error Counter for Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL",
+		Description: "NVLink flow control CRC  Error Counter total for all Lanes",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L0: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L0,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L0",
+		Description: "NVLink data CRC Error Counter for Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L1: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L1,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L1",
+		Description: "NVLink data CRC Error Counter for Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L2: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L2,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L2",
+		Description: "NVLink data CRC Error Counter for Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L3: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L3,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L3",
+		Description: "NVLink data CRC Error Counter for Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L4: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L4,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L4",
+		Description: "NVLink data CRC Error Counter for Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L5: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L5,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L5",
+		Description: "NVLink data CRC Error Counter for Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_TOTAL: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_TOTAL,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_TOTAL",
+		Description: "NvLink data CRC Error Counter total for all Lanes",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L0: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L0,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L0",
+		Description: "NVLink Replay Error Counter for Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L1: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L1,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L1",
+		Description: "NVLink Replay Error Counter for Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L2: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L2,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L2",
+		Description: "NVLink Replay Error Counter for Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L3: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L3,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L3",
+		Description: "NVLink Replay Error Counter for Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L4: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L4,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L4",
+		Description: "NVLink Replay Error Counter for Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L5: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L5,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L5",
+		Description: "NVLink Replay Error Counter for Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_TOTAL: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_TOTAL,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_TOTAL",
+		Description: "NVLink Replay Error Counter total for all Lanes",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L0: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L0,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L0",
+		Description: "NVLink Recovery Error Counter for Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L1: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L1,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L1",
+		Description: "NVLink Recovery Error Counter for Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L2: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L2,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L2",
+		Description: "NVLink Recovery Error Counter for Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L3: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L3,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L3",
+		Description: "NVLink Recovery Error Counter for Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L4: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L4,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L4",
+		Description: "NVLink Recovery Error Counter for Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L5: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L5,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L5",
+		Description: "NVLink Recovery Error Counter for Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_TOTAL: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_TOTAL,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_TOTAL",
+		Description: "NVLink Recovery Error Counter total for all Lanes",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L0: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L0,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L0",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L1: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L1,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L1",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L2: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L2,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L2",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L3: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L3,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L3",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L4: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L4,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L4",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L5: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L5,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L5",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_TOTAL: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_TOTAL,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_TOTAL",
+		Description: "NVLink Bandwidth Counter Total for Counter Set 0, All Lanes",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L0: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L0,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L0",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 0",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L1: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L1,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L1",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 1",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L2: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L2,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L2",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 2",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L3: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L3,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L3",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 3",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L4: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L4,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L4",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 4",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L5: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L5,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L5",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 5",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_TOTAL: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_TOTAL,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_TOTAL",
+		Description: "NVLink Bandwidth Counter Total for Counter Set 1, All Lanes",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_POWER: {
+		Id:          FI_DEV_PERF_POLICY_POWER,
+		Name:        "FI_DEV_PERF_POLICY_POWER",
+		Description: "Perf Policy Counter for Power Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_THERMAL: {
+		Id:          FI_DEV_PERF_POLICY_THERMAL,
+		Name:        "FI_DEV_PERF_POLICY_THERMAL",
+		Description: "Perf Policy Counter for Thermal Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_SYNC_BOOST: {
+		Id:          FI_DEV_PERF_POLICY_SYNC_BOOST,
+		Name:        "FI_DEV_PERF_POLICY_SYNC_BOOST",
+		Description: "Perf Policy Counter for Sync boost Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_BOARD_LIMIT: {
+		Id:          FI_DEV_PERF_POLICY_BOARD_LIMIT,
+		Name:        "FI_DEV_PERF_POLICY_BOARD_LIMIT",
+		Description: "Perf Policy Counter for Board Limit",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_LOW_UTILIZATION: {
+		Id:          FI_DEV_PERF_POLICY_LOW_UTILIZATION,
+		Name:        "FI_DEV_PERF_POLICY_LOW_UTILIZATION",
+		Description: "Perf Policy Counter for Low GPU Utilization Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_RELIABILITY: {
+		Id:          FI_DEV_PERF_POLICY_RELIABILITY,
+		Name:        "FI_DEV_PERF_POLICY_RELIABILITY",
+		Description: "Perf Policy Counter for Reliability Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_TOTAL_APP_CLOCKS: {
+		Id:          FI_DEV_PERF_POLICY_TOTAL_APP_CLOCKS,
+		Name:        "FI_DEV_PERF_POLICY_TOTAL_APP_CLOCKS",
+		Description: "Perf Policy Counter for Total App Clock Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PERF_POLICY_TOTAL_BASE_CLOCKS: {
+		Id:          FI_DEV_PERF_POLICY_TOTAL_BASE_CLOCKS,
+		Name:        "FI_DEV_PERF_POLICY_TOTAL_BASE_CLOCKS",
+		Description: "Perf Policy Counter for Total Base Clocks Policy",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_MEMORY_TEMP: {
+		Id:          FI_DEV_MEMORY_TEMP,
+		Name:        "FI_DEV_MEMORY_TEMP",
+		Description: "Memory temperature for the device",
+		Unit:        "C",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_TOTAL_ENERGY_CONSUMPTION: {
+		Id:          FI_DEV_TOTAL_ENERGY_CONSUMPTION,
+		Name:        "FI_DEV_TOTAL_ENERGY_CONSUMPTION",
+		Description: "Total energy consumption for the GPU in mJ since the driver was last reloaded",
+		Unit:        "mJ",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L0: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L0,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L0",
+		Description: "NVLink Speed in MBps for Link 0",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L1: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L1,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L1",
+		Description: "NVLink Speed in MBps for Link 1",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L2: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L2,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L2",
+		Description: "NVLink Speed in MBps for Link 2",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L3: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L3,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L3",
+		Description: "NVLink Speed in MBps for Link 3",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L4: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L4,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L4",
+		Description: "NVLink Speed in MBps for Link 4",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L5: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L5,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L5",
+		Description: "NVLink Speed in MBps for Link 5",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_COMMON: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_COMMON,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_COMMON",
+		Description: "Common NVLink Speed in MBps for active links",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_LINK_COUNT: {
+		Id:          FI_DEV_NVLINK_LINK_COUNT,
+		Name:        "FI_DEV_NVLINK_LINK_COUNT",
+		Description: "Number of NVLinks present on the device",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_RETIRED_PENDING_SBE: {
+		Id:          FI_DEV_RETIRED_PENDING_SBE,
+		Name:        "FI_DEV_RETIRED_PENDING_SBE",
+		Description: "If any pages are pending retirement due to SBE. 1=yes. 0=no.",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_RETIRED_PENDING_DBE: {
+		Id:          FI_DEV_RETIRED_PENDING_DBE,
+		Name:        "FI_DEV_RETIRED_PENDING_DBE",
+		Description: "If any pages are pending retirement due to DBE. 1=yes. 0=no.",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_REPLAY_COUNTER: {
+		Id:          FI_DEV_PCIE_REPLAY_COUNTER,
+		Name:        "FI_DEV_PCIE_REPLAY_COUNTER",
+		Description: "PCIe replay counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_REPLAY_ROLLOVER_COUNTER: {
+		Id:          FI_DEV_PCIE_REPLAY_ROLLOVER_COUNTER,
+		Name:        "FI_DEV_PCIE_REPLAY_ROLLOVER_COUNTER",
+		Description: "PCIe replay rollover counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L6: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L6,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L6",
+		Description: "NVLink flow control CRC  Error Counter for Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L7: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L7,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L7",
+		Description: "NVLink flow control CRC  Error Counter for Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L8: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L8,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L8",
+		Description: "NVLink flow control CRC  Error Counter for Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L9: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L9,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L9",
+		Description: "NVLink flow control CRC  Error Counter for Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L10: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L10,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L10",
+		Description: "NVLink flow control CRC  Error Counter for Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L11: {
+		Id:          FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L11,
+		Name:        "FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_L11",
+		Description: "NVLink flow control CRC  Error Counter for Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L6: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L6,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L6",
+		Description: "NVLink data CRC Error Counter for Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L7: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L7,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L7",
+		Description: "NVLink data CRC Error Counter for Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L8: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L8,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L8",
+		Description: "NVLink data CRC Error Counter for Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L9: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L9,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L9",
+		Description: "NVLink data CRC Error Counter for Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L10: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L10,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L10",
+		Description: "NVLink data CRC Error Counter for Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L11: {
+		Id:          FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L11,
+		Name:        "FI_DEV_NVLINK_CRC_DATA_ERROR_COUNT_L11",
+		Description: "NVLink data CRC Error Counter for Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L6: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L6,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L6",
+		Description: "NVLink Replay Error Counter for Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L7: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L7,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L7",
+		Description: "NVLink Replay Error Counter for Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L8: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L8,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L8",
+		Description: "NVLink Replay Error Counter for Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L9: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L9,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L9",
+		Description: "NVLink Replay Error Counter for Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L10: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L10,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L10",
+		Description: "NVLink Replay Error Counter for Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L11: {
+		Id:          FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L11,
+		Name:        "FI_DEV_NVLINK_REPLAY_ERROR_COUNT_L11",
+		Description: "NVLink Replay Error Counter for Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L6: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L6,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L6",
+		Description: "NVLink Recovery Error Counter for Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L7: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L7,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L7",
+		Description: "NVLink Recovery Error Counter for Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L8: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L8,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L8",
+		Description: "NVLink Recovery Error Counter for Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L9: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L9,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L9",
+		Description: "NVLink Recovery Error Counter for Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L10: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L10,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L10",
+		Description: "NVLink Recovery Error Counter for Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L11: {
+		Id:          FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L11,
+		Name:        "FI_DEV_NVLINK_RECOVERY_ERROR_COUNT_L11",
+		Description: "NVLink Recovery Error Counter for Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L6: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L6,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L6",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L7: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L7,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L7",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L8: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L8,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L8",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L9: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L9,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L9",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L10: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L10,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L10",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C0_L11: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C0_L11,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C0_L11",
+		Description: "NVLink Bandwidth Counter for Counter Set 0, Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L6: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L6,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L6",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 6",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L7: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L7,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L7",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 7",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L8: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L8,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L8",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 8",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L9: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L9,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L9",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 9",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L10: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L10,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L10",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 10",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_BANDWIDTH_C1_L11: {
+		Id:          FI_DEV_NVLINK_BANDWIDTH_C1_L11,
+		Name:        "FI_DEV_NVLINK_BANDWIDTH_C1_L11",
+		Description: "NVLink Bandwidth Counter for Counter Set 1, Lane 11",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L6: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L6,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L6",
+		Description: "NVLink Speed in MBps for Link 6",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L7: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L7,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L7",
+		Description: "NVLink Speed in MBps for Link 7",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L8: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L8,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L8",
+		Description: "NVLink Speed in MBps for Link 8",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L9: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L9,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L9",
+		Description: "NVLink Speed in MBps for Link 9",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L10: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L10,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L10",
+		Description: "NVLink Speed in MBps for Link 10",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_SPEED_MBPS_L11: {
+		Id:          FI_DEV_NVLINK_SPEED_MBPS_L11,
+		Name:        "FI_DEV_NVLINK_SPEED_MBPS_L11",
+		Description: "NVLink Speed in MBps for Link 11",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_THROUGHPUT_DATA_TX: {
+		Id:          FI_DEV_NVLINK_THROUGHPUT_DATA_TX,
+		Name:        "FI_DEV_NVLINK_THROUGHPUT_DATA_TX",
+		Description: "NVLink TX Data throughput in KiB",
+		Unit:        "KiB",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_THROUGHPUT_DATA_RX: {
+		Id:          FI_DEV_NVLINK_THROUGHPUT_DATA_RX,
+		Name:        "FI_DEV_NVLINK_THROUGHPUT_DATA_RX",
+		Description: "NVLink RX Data throughput in KiB",
+		Unit:        "KiB",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_THROUGHPUT_RAW_TX: {
+		Id:          FI_DEV_NVLINK_THROUGHPUT_RAW_TX,
+		Name:        "FI_DEV_NVLINK_THROUGHPUT_RAW_TX",
+		Description: "NVLink TX Data + protocol overhead in KiB",
+		Unit:        "KiB",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_THROUGHPUT_RAW_RX: {
+		Id:          FI_DEV_NVLINK_THROUGHPUT_RAW_RX,
+		Name:        "FI_DEV_NVLINK_THROUGHPUT_RAW_RX",
+		Description: "NVLink RX Data + protocol overhead in KiB",
+		Unit:        "KiB",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_REMAPPED_COR: {
+		Id:          FI_DEV_REMAPPED_COR,
+		Name:        "FI_DEV_REMAPPED_COR",
+		Description: "Number of remapped rows due to correctable errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_REMAPPED_UNC: {
+		Id:          FI_DEV_REMAPPED_UNC,
+		Name:        "FI_DEV_REMAPPED_UNC",
+		Description: "Number of remapped rows due to uncorrectable errors",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_REMAPPED_PENDING: {
+		Id:          FI_DEV_REMAPPED_PENDING,
+		Name:        "FI_DEV_REMAPPED_PENDING",
+		Description: "If any rows are pending remapping. 1=yes 0=no",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_REMAPPED_FAILURE: {
+		Id:          FI_DEV_REMAPPED_FAILURE,
+		Name:        "FI_DEV_REMAPPED_FAILURE",
+		Description: "If any rows failed to be remapped 1=yes 0=no",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_REMOTE_NVLINK_ID: {
+		Id:          FI_DEV_NVLINK_REMOTE_NVLINK_ID,
+		Name:        "FI_DEV_NVLINK_REMOTE_NVLINK_ID",
+		Description: "Remote device NVLink ID",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVSWITCH_CONNECTED_LINK_COUNT: {
+		Id:          FI_DEV_NVSWITCH_CONNECTED_LINK_COUNT,
+		Name:        "FI_DEV_NVSWITCH_CONNECTED_LINK_COUNT",
+		Description: "Number of NVLinks connected to NVSwitch",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L0: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L0,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L0",
+		Description: "NVLink data ECC Error Counter for Link 0",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L1: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L1,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L1",
+		Description: "NVLink data ECC Error Counter for Link 1",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L2: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L2,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L2",
+		Description: "NVLink data ECC Error Counter for Link 2",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L3: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L3,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L3",
+		Description: "NVLink data ECC Error Counter for Link 3",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L4: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L4,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L4",
+		Description: "NVLink data ECC Error Counter for Link 4",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L5: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L5,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L5",
+		Description: "NVLink data ECC Error Counter for Link 5",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L6: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L6,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L6",
+		Description: "NVLink data ECC Error Counter for Link 6",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L7: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L7,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L7",
+		Description: "NVLink data ECC Error Counter for Link 7",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L8: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L8,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L8",
+		Description: "NVLink data ECC Error Counter for Link 8",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L9: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L9,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L9",
+		Description: "NVLink data ECC Error Counter for Link 9",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L10: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L10,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L10",
+		Description: "NVLink data ECC Error Counter for Link 10",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L11: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L11,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_L11",
+		Description: "NVLink data ECC Error Counter for Link 11",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL: {
+		Id:          FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL,
+		Name:        "FI_DEV_NVLINK_ECC_DATA_ERROR_COUNT_TOTAL",
+		Description: "NVLink data ECC Error Counter total for all Links",
+		Unit:        "",
+		Scope:       FieldScopeLane,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ERROR_DL_REPLAY: {
+		Id:          FI_DEV_NVLINK_ERROR_DL_REPLAY,
+		Name:        "FI_DEV_NVLINK_ERROR_DL_REPLAY",
+		Description: "NVLink Replay Error Counter This is unsupported for Blackwell+. Please use NVML_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_*",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ERROR_DL_RECOVERY: {
+		Id:          FI_DEV_NVLINK_ERROR_DL_RECOVERY,
+		Name:        "FI_DEV_NVLINK_ERROR_DL_RECOVERY",
+		Description: "NVLink Recovery Error Counter This is unsupported for Blackwell+ Please use NVML_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_*",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_ERROR_DL_CRC: {
+		Id:          FI_DEV_NVLINK_ERROR_DL_CRC,
+		Name:        "FI_DEV_NVLINK_ERROR_DL_CRC",
+		Description: "NVLink CRC Error Counter This is unsupported for Blackwell+ Please use NVML_FI_DEV_NVLINK_COUNT_LINK_RECOVERY_*",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_GET_SPEED: {
+		Id:          FI_DEV_NVLINK_GET_SPEED,
+		Name:        "FI_DEV_NVLINK_GET_SPEED",
+		Description: "NVLink Speed in MBps",
+		Unit:        "MBps",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_STATE: {
+		Id:          FI_DEV_NVLINK_GET_STATE,
+		Name:        "FI_DEV_NVLINK_GET_STATE",
+		Description: "NVLink State - Active,Inactive",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_VERSION: {
+		Id:          FI_DEV_NVLINK_GET_VERSION,
+		Name:        "FI_DEV_NVLINK_GET_VERSION",
+		Description: "NVLink Version",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_POWER_STATE: {
+		Id:          FI_DEV_NVLINK_GET_POWER_STATE,
+		Name:        "FI_DEV_NVLINK_GET_POWER_STATE",
+		Description: "NVLink Power state. 0=HIGH_SPEED 1=LOW_SPEED",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_POWER_THRESHOLD: {
+		Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD,
+		Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD",
+		Description: "NVLink length of idle period (units can be found from NVML_FI_DEV_NVLINK_GET_POWER_THRESHOLD_UNITS) before transitioning links to sleep state",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_L0_TO_RECOVERY_COUNTER: {
+		Id:          FI_DEV_PCIE_L0_TO_RECOVERY_COUNTER,
+		Name:        "FI_DEV_PCIE_L0_TO_RECOVERY_COUNTER",
+		Description: "Device PEX error recovery counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_C2C_LINK_COUNT: {
+		Id:          FI_DEV_C2C_LINK_COUNT,
+		Name:        "FI_DEV_C2C_LINK_COUNT",
+		Description: "Number of C2C Links present on the device",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_C2C_LINK_GET_STATUS: {
+		Id:          FI_DEV_C2C_LINK_GET_STATUS,
+		Name:        "FI_DEV_C2C_LINK_GET_STATUS",
+		Description: "C2C Link Status 0=INACTIVE 1=ACTIVE",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_C2C_LINK_GET_MAX_BW: {
+		Id:          FI_DEV_C2C_LINK_GET_MAX_BW,
+		Name:        "FI_DEV_C2C_LINK_GET_MAX_BW",
+		Description: "C2C Link Speed in MBps for active links",
+		Unit:        "MBps",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_COUNT_CORRECTABLE_ERRORS: {
+		Id:          FI_DEV_PCIE_COUNT_CORRECTABLE_ERRORS,
+		Name:        "FI_DEV_PCIE_COUNT_CORRECTABLE_ERRORS",
+		Description: "PCIe Correctable Errors Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_NAKS_RECEIVED: {
+		Id:          FI_DEV_PCIE_COUNT_NAKS_RECEIVED,
+		Name:        "FI_DEV_PCIE_COUNT_NAKS_RECEIVED",
+		Description: "PCIe NAK Receive Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_RECEIVER_ERROR: {
+		Id:          FI_DEV_PCIE_COUNT_RECEIVER_ERROR,
+		Name:        "FI_DEV_PCIE_COUNT_RECEIVER_ERROR",
+		Description: "PCIe Receiver Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_BAD_TLP: {
+		Id:          FI_DEV_PCIE_COUNT_BAD_TLP,
+		Name:        "FI_DEV_PCIE_COUNT_BAD_TLP",
+		Description: "PCIe Bad TLP Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_NAKS_SENT: {
+		Id:          FI_DEV_PCIE_COUNT_NAKS_SENT,
+		Name:        "FI_DEV_PCIE_COUNT_NAKS_SENT",
+		Description: "PCIe NAK Send Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_BAD_DLLP: {
+		Id:          FI_DEV_PCIE_COUNT_BAD_DLLP,
+		Name:        "FI_DEV_PCIE_COUNT_BAD_DLLP",
+		Description: "PCIe Bad DLLP Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_NON_FATAL_ERROR: {
+		Id:          FI_DEV_PCIE_COUNT_NON_FATAL_ERROR,
+		Name:        "FI_DEV_PCIE_COUNT_NON_FATAL_ERROR",
+		Description: "PCIe Non Fatal Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_FATAL_ERROR: {
+		Id:          FI_DEV_PCIE_COUNT_FATAL_ERROR,
+		Name:        "FI_DEV_PCIE_COUNT_FATAL_ERROR",
+		Description: "PCIe Fatal Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_UNSUPPORTED_REQ: {
+		Id:          FI_DEV_PCIE_COUNT_UNSUPPORTED_REQ,
+		Name:        "FI_DEV_PCIE_COUNT_UNSUPPORTED_REQ",
+		Description: "PCIe Unsupported Request Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_LCRC_ERROR: {
+		Id:          FI_DEV_PCIE_COUNT_LCRC_ERROR,
+		Name:        "FI_DEV_PCIE_COUNT_LCRC_ERROR",
+		Description: "PCIe LCRC Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_LANE_ERROR: {
+		Id:          FI_DEV_PCIE_COUNT_LANE_ERROR,
+		Name:        "FI_DEV_PCIE_COUNT_LANE_ERROR",
+		Description: "PCIe Per Lane Error Counter.",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_IS_RESETLESS_MIG_SUPPORTED: {
+		Id:          FI_DEV_IS_RESETLESS_MIG_SUPPORTED,
+		Name:        "FI_DEV_IS_RESETLESS_MIG_SUPPORTED",
+		Description: "Device's Restless MIG Capability",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_AVERAGE: {
+		Id:          FI_DEV_POWER_AVERAGE,
+		Name:        "FI_DEV_POWER_AVERAGE",
+		Description: "GPU power averaged over 1 sec interval, supported on Ampere (except GA100) or newer architectures.",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_INSTANT: {
+		Id:          FI_DEV_POWER_INSTANT,
+		Name:        "FI_DEV_POWER_INSTANT",
+		Description: "Current GPU power, supported on all architectures.",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_MIN_LIMIT: {
+		Id:          FI_DEV_POWER_MIN_LIMIT,
+		Name:        "FI_DEV_POWER_MIN_LIMIT",
+		Description: "Minimum power limit in milliwatts.",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_MAX_LIMIT: {
+		Id:          FI_DEV_POWER_MAX_LIMIT,
+		Name:        "FI_DEV_POWER_MAX_LIMIT",
+		Description: "Maximum power limit in milliwatts.",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_DEFAULT_LIMIT: {
+		Id:          FI_DEV_POWER_DEFAULT_LIMIT,
+		Name:        "FI_DEV_POWER_DEFAULT_LIMIT",
+		Description: "Default power limit in milliwatts (limit which device boots with).",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_POWER_CURRENT_LIMIT: {
+		Id:          FI_DEV_POWER_CURRENT_LIMIT,
+		Name:        "FI_DEV_POWER_CURRENT_LIMIT",
+		Description: "Limit currently enforced in milliwatts (This includes other limits set elsewhere. E.g. Out-of-band).",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_ENERGY: {
+		Id:          FI_DEV_ENERGY,
+		Name:        "FI_DEV_ENERGY",
+		Description: "Total energy consumption (in mJ) since the driver was last reloaded. Same as \\ref NVML_FI_DEV_TOTAL_ENERGY_CONSUMPTION for the GPU.",
+		Unit:        "mJ",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_POWER_REQUESTED_LIMIT: {
+		Id:          FI_DEV_POWER_REQUESTED_LIMIT,
+		Name:        "FI_DEV_POWER_REQUESTED_LIMIT",
+		Description: "Power limit requested by NVML or any other userspace client.",
+		Unit:        "mW",
+		Scope:       FieldScopePower,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_TEMPERATURE_SHUTDOWN_TLIMIT: {
+		Id:          FI_DEV_TEMPERATURE_SHUTDOWN_TLIMIT,
+		Name:        "FI_DEV_TEMPERATURE_SHUTDOWN_TLIMIT",
+		Description: "T.Limit temperature after which GPU may shut down for HW protection",
+		Unit:        "C",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_TEMPERATURE_SLOWDOWN_TLIMIT: {
+		Id:          FI_DEV_TEMPERATURE_SLOWDOWN_TLIMIT,
+		Name:        "FI_DEV_TEMPERATURE_SLOWDOWN_TLIMIT",
+		Description: "T.Limit temperature after which GPU may begin HW slowdown",
+		Unit:        "C",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_TEMPERATURE_MEM_MAX_TLIMIT: {
+		Id:          FI_DEV_TEMPERATURE_MEM_MAX_TLIMIT,
+		Name:        "FI_DEV_TEMPERATURE_MEM_MAX_TLIMIT",
+		Description: "T.Limit temperature after which GPU may begin SW slowdown due to memory temperature",
+		Unit:        "C",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_TEMPERATURE_GPU_MAX_TLIMIT: {
+		Id:          FI_DEV_TEMPERATURE_GPU_MAX_TLIMIT,
+		Name:        "FI_DEV_TEMPERATURE_GPU_MAX_TLIMIT",
+		Description: "T.Limit temperature after which GPU may be throttled below base clock",
+		Unit:        "C",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_COUNT_TX_BYTES: {
+		Id:          FI_DEV_PCIE_COUNT_TX_BYTES,
+		Name:        "FI_DEV_PCIE_COUNT_TX_BYTES",
+		Description: "PCIe transmit bytes. Value can be wrapped.",
+		Unit:        "B",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_PCIE_COUNT_RX_BYTES: {
+		Id:          FI_DEV_PCIE_COUNT_RX_BYTES,
+		Name:        "FI_DEV_PCIE_COUNT_RX_BYTES",
+		Description: "PCIe receive bytes. Value can be wrapped.",
+		Unit:        "B",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_IS_MIG_MODE_INDEPENDENT_MIG_QUERY_CAPABLE: {
+		Id:          FI_DEV_IS_MIG_MODE_INDEPENDENT_MIG_QUERY_CAPABLE,
+		Name:        "FI_DEV_IS_MIG_MODE_INDEPENDENT_MIG_QUERY_CAPABLE",
+		Description: "MIG mode independent, MIG query capable device. 1=yes. 0=no.",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_POWER_THRESHOLD_MAX: {
+		Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD_MAX,
+		Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD_MAX",
+		Description: "Max Nvlink Power Threshold. See NVML_FI_DEV_NVLINK_GET_POWER_THRESHOLD",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_COUNT_XMIT_PACKETS: {
+		Id:          FI_DEV_NVLINK_COUNT_XMIT_PACKETS,
+		Name:        "FI_DEV_NVLINK_COUNT_XMIT_PACKETS",
+		Description: "Total Tx packets on the link in NVLink5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_XMIT_BYTES: {
+		Id:          FI_DEV_NVLINK_COUNT_XMIT_BYTES,
+		Name:        "FI_DEV_NVLINK_COUNT_XMIT_BYTES",
+		Description: "Total Tx bytes on the link in NVLink5",
+		Unit:        "B",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RCV_PACKETS: {
+		Id:          FI_DEV_NVLINK_COUNT_RCV_PACKETS,
+		Name:        "FI_DEV_NVLINK_COUNT_RCV_PACKETS",
+		Description: "Total Rx packets on the link in NVLink5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RCV_BYTES: {
+		Id:          FI_DEV_NVLINK_COUNT_RCV_BYTES,
+		Name:        "FI_DEV_NVLINK_COUNT_RCV_BYTES",
+		Description: "Total Rx bytes on the link in NVLink5",
+		Unit:        "B",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_VL15_DROPPED: {
+		Id:          FI_DEV_NVLINK_COUNT_VL15_DROPPED,
+		Name:        "FI_DEV_NVLINK_COUNT_VL15_DROPPED",
+		Description: "Deprecated, do not use",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_MALFORMED_PACKET_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_MALFORMED_PACKET_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_MALFORMED_PACKET_ERRORS",
+		Description: "Number of packets Rx on a link where packets are malformed",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_BUFFER_OVERRUN_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_BUFFER_OVERRUN_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_BUFFER_OVERRUN_ERRORS",
+		Description: "Number of packets that were discarded on Rx due to buffer overrun",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RCV_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_RCV_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_RCV_ERRORS",
+		Description: "Total number of packets with errors Rx on a link",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RCV_REMOTE_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_RCV_REMOTE_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_RCV_REMOTE_ERRORS",
+		Description: "Total number of packets Rx - stomp/EBP marker",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RCV_GENERAL_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_RCV_GENERAL_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_RCV_GENERAL_ERRORS",
+		Description: "Total number of packets Rx with header mismatch",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_LOCAL_LINK_INTEGRITY_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_LOCAL_LINK_INTEGRITY_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_LOCAL_LINK_INTEGRITY_ERRORS",
+		Description: "Total number of times that the count of local errors exceeded a threshold",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_XMIT_DISCARDS: {
+		Id:          FI_DEV_NVLINK_COUNT_XMIT_DISCARDS,
+		Name:        "FI_DEV_NVLINK_COUNT_XMIT_DISCARDS",
+		Description: "Total number of tx error packets that were discarded",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_LINK_RECOVERY_SUCCESSFUL_EVENTS: {
+		Id:          FI_DEV_NVLINK_COUNT_LINK_RECOVERY_SUCCESSFUL_EVENTS,
+		Name:        "FI_DEV_NVLINK_COUNT_LINK_RECOVERY_SUCCESSFUL_EVENTS",
+		Description: "Number of times link went from Up to recovery, succeeded and link came back up",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_LINK_RECOVERY_FAILED_EVENTS: {
+		Id:          FI_DEV_NVLINK_COUNT_LINK_RECOVERY_FAILED_EVENTS,
+		Name:        "FI_DEV_NVLINK_COUNT_LINK_RECOVERY_FAILED_EVENTS",
+		Description: "Number of times link went from Up to recovery, failed and link was declared down",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS: {
+		Id:          FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS,
+		Name:        "FI_DEV_NVLINK_COUNT_LINK_RECOVERY_EVENTS",
+		Description: "Number of times link went from Up to recovery, irrespective of the result",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RAW_BER_LANE0: {
+		Id:          FI_DEV_NVLINK_COUNT_RAW_BER_LANE0,
+		Name:        "FI_DEV_NVLINK_COUNT_RAW_BER_LANE0",
+		Description: "Deprecated, do not use",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RAW_BER_LANE1: {
+		Id:          FI_DEV_NVLINK_COUNT_RAW_BER_LANE1,
+		Name:        "FI_DEV_NVLINK_COUNT_RAW_BER_LANE1",
+		Description: "Deprecated, do not use",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_RAW_BER: {
+		Id:          FI_DEV_NVLINK_COUNT_RAW_BER,
+		Name:        "FI_DEV_NVLINK_COUNT_RAW_BER",
+		Description: "Deprecated, do not use",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_EFFECTIVE_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_EFFECTIVE_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_EFFECTIVE_ERRORS",
+		Description: "Sum of the number of errors in each Nvlink packet",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_EFFECTIVE_BER: {
+		Id:          FI_DEV_NVLINK_COUNT_EFFECTIVE_BER,
+		Name:        "FI_DEV_NVLINK_COUNT_EFFECTIVE_BER",
+		Description: "Effective BER for effective errors",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_SYMBOL_ERRORS: {
+		Id:          FI_DEV_NVLINK_COUNT_SYMBOL_ERRORS,
+		Name:        "FI_DEV_NVLINK_COUNT_SYMBOL_ERRORS",
+		Description: "Number of errors in rx symbols",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_SYMBOL_BER: {
+		Id:          FI_DEV_NVLINK_COUNT_SYMBOL_BER,
+		Name:        "FI_DEV_NVLINK_COUNT_SYMBOL_BER",
+		Description: "BER for symbol errors",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_GET_POWER_THRESHOLD_MIN: {
+		Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD_MIN,
+		Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD_MIN",
+		Description: "Min Nvlink Power Threshold. See NVML_FI_DEV_NVLINK_GET_POWER_THRESHOLD",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_POWER_THRESHOLD_UNITS: {
+		Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD_UNITS,
+		Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD_UNITS",
+		Description: "Values are in the form NVML_NVLINK_LOW_POWER_THRESHOLD_UNIT_*",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_GET_POWER_THRESHOLD_SUPPORTED: {
+		Id:          FI_DEV_NVLINK_GET_POWER_THRESHOLD_SUPPORTED,
+		Name:        "FI_DEV_NVLINK_GET_POWER_THRESHOLD_SUPPORTED",
+		Description: "Determine if Nvlink Power Threshold feature is supported",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_RESET_STATUS: {
+		Id:          FI_DEV_RESET_STATUS,
+		Name:        "FI_DEV_RESET_STATUS",
+		Description: "Depracated, do not use (use NVML_FI_DEV_GET_GPU_RECOVERY_ACTION instead)",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_DRAIN_AND_RESET_STATUS: {
+		Id:          FI_DEV_DRAIN_AND_RESET_STATUS,
+		Name:        "FI_DEV_DRAIN_AND_RESET_STATUS",
+		Description: "Deprecated, do not use (use NVML_FI_DEV_GET_GPU_RECOVERY_ACTION instead)",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_OUTBOUND_ATOMICS_MASK: {
+		Id:          FI_DEV_PCIE_OUTBOUND_ATOMICS_MASK,
+		Name:        "FI_DEV_PCIE_OUTBOUND_ATOMICS_MASK",
+		Description: "",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_PCIE_INBOUND_ATOMICS_MASK: {
+		Id:          FI_DEV_PCIE_INBOUND_ATOMICS_MASK,
+		Name:        "FI_DEV_PCIE_INBOUND_ATOMICS_MASK",
+		Description: "",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_GET_GPU_RECOVERY_ACTION: {
+		Id:          FI_DEV_GET_GPU_RECOVERY_ACTION,
+		Name:        "FI_DEV_GET_GPU_RECOVERY_ACTION",
+		Description: "GPU Recovery action - None/Reset/Reboot/Drain P2P/Drain and Reset",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_C2C_LINK_ERROR_INTR: {
+		Id:          FI_DEV_C2C_LINK_ERROR_INTR,
+		Name:        "FI_DEV_C2C_LINK_ERROR_INTR",
+		Description: "C2C Link CRC Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_C2C_LINK_ERROR_REPLAY: {
+		Id:          FI_DEV_C2C_LINK_ERROR_REPLAY,
+		Name:        "FI_DEV_C2C_LINK_ERROR_REPLAY",
+		Description: "C2C Link Replay Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_C2C_LINK_ERROR_REPLAY_B2B: {
+		Id:          FI_DEV_C2C_LINK_ERROR_REPLAY_B2B,
+		Name:        "FI_DEV_C2C_LINK_ERROR_REPLAY_B2B",
+		Description: "C2C Link Back to Back Replay Error Counter",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_C2C_LINK_POWER_STATE: {
+		Id:          FI_DEV_C2C_LINK_POWER_STATE,
+		Name:        "FI_DEV_C2C_LINK_POWER_STATE",
+		Description: "C2C Link Power state. See NVML_C2C_POWER_STATE_*",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_0: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_0,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_0",
+		Description: "Count of symbol errors that are corrected - bin 0",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_1: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_1,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_1",
+		Description: "Count of symbol errors that are corrected - bin 1",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_2: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_2,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_2",
+		Description: "Count of symbol errors that are corrected - bin 2",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_3: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_3,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_3",
+		Description: "Count of symbol errors that are corrected - bin 3",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_4: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_4,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_4",
+		Description: "Count of symbol errors that are corrected - bin 4",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_5: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_5,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_5",
+		Description: "Count of symbol errors that are corrected - bin 5",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_6: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_6,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_6",
+		Description: "Count of symbol errors that are corrected - bin 6",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_7: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_7,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_7",
+		Description: "Count of symbol errors that are corrected - bin 7",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_8: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_8,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_8",
+		Description: "Count of symbol errors that are corrected - bin 8",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_9: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_9,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_9",
+		Description: "Count of symbol errors that are corrected - bin 9",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_10: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_10,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_10",
+		Description: "Count of symbol errors that are corrected - bin 10",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_11: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_11,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_11",
+		Description: "Count of symbol errors that are corrected - bin 11",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_12: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_12,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_12",
+		Description: "Count of symbol errors that are corrected - bin 12",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_13: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_13,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_13",
+		Description: "Count of symbol errors that are corrected - bin 13",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_14: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_14,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_14",
+		Description: "Count of symbol errors that are corrected - bin 14",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_NVLINK_COUNT_FEC_HISTORY_15: {
+		Id:          FI_DEV_NVLINK_COUNT_FEC_HISTORY_15,
+		Name:        "FI_DEV_NVLINK_COUNT_FEC_HISTORY_15",
+		Description: "Count of symbol errors that are corrected - bin 15",
+		Unit:        "",
+		Scope:       FieldScopeLink,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_CLOCKS_EVENT_REASON_SW_THERM_SLOWDOWN: {
+		Id:          FI_DEV_CLOCKS_EVENT_REASON_SW_THERM_SLOWDOWN,
+		Name:        "FI_DEV_CLOCKS_EVENT_REASON_SW_THERM_SLOWDOWN",
+		Description: "Throttling to ensure ((GPU temp < GPU Max Operating Temp) && (Memory Temp < Memory Max Operating Temp)) in ns",
+		Unit:        "ns",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN: {
+		Id:          FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN,
+		Name:        "FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN",
+		Description: "Throttling due to temperature being too high (reducing core clocks by a factor of 2 or more) in ns",
+		Unit:        "ns",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_CLOCKS_EVENT_REASON_HW_POWER_BRAKE_SLOWDOWN: {
+		Id:          FI_DEV_CLOCKS_EVENT_REASON_HW_POWER_BRAKE_SLOWDOWN,
+		Name:        "FI_DEV_CLOCKS_EVENT_REASON_HW_POWER_BRAKE_SLOWDOWN",
+		Description: "Throttling due to external power brake assertion trigger (reducing core clocks by a factor of 2 or more) in ns",
+		Unit:        "ns",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_POWER_SYNC_BALANCING_FREQ: {
+		Id:          FI_DEV_POWER_SYNC_BALANCING_FREQ,
+		Name:        "FI_DEV_POWER_SYNC_BALANCING_FREQ",
+		Description: "Accumulated frequency of the GPU to be used for averaging",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_DEV_POWER_SYNC_BALANCING_AF: {
+		Id:          FI_DEV_POWER_SYNC_BALANCING_AF,
+		Name:        "FI_DEV_POWER_SYNC_BALANCING_AF",
+		Description: "Accumulated activity factor of the GPU to be used for averaging",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_LONG_LONG,
+	},
+	FI_PWR_SMOOTHING_ENABLED: {
+		Id:          FI_PWR_SMOOTHING_ENABLED,
+		Name:        "FI_PWR_SMOOTHING_ENABLED",
+		Description: "Enablement (0/DISABLED or 1/ENABLED)",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_PRIV_LVL: {
+		Id:          FI_PWR_SMOOTHING_PRIV_LVL,
+		Name:        "FI_PWR_SMOOTHING_PRIV_LVL",
+		Description: "Current privilege level",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_IMM_RAMP_DOWN_ENABLED: {
+		Id:          FI_PWR_SMOOTHING_IMM_RAMP_DOWN_ENABLED,
+		Name:        "FI_PWR_SMOOTHING_IMM_RAMP_DOWN_ENABLED",
+		Description: "Immediate ramp down enablement (0/DISABLED or 1/ENABLED)",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_APPLIED_TMP_CEIL: {
+		Id:          FI_PWR_SMOOTHING_APPLIED_TMP_CEIL,
+		Name:        "FI_PWR_SMOOTHING_APPLIED_TMP_CEIL",
+		Description: "Applied TMP ceiling value in Watts",
+		Unit:        "W",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_APPLIED_TMP_FLOOR: {
+		Id:          FI_PWR_SMOOTHING_APPLIED_TMP_FLOOR,
+		Name:        "FI_PWR_SMOOTHING_APPLIED_TMP_FLOOR",
+		Description: "Applied TMP floor value in Watts",
+		Unit:        "W",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_MAX_PERCENT_TMP_FLOOR_SETTING: {
+		Id:          FI_PWR_SMOOTHING_MAX_PERCENT_TMP_FLOOR_SETTING,
+		Name:        "FI_PWR_SMOOTHING_MAX_PERCENT_TMP_FLOOR_SETTING",
+		Description: "Max % TMP Floor value",
+		Unit:        "%",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_MIN_PERCENT_TMP_FLOOR_SETTING: {
+		Id:          FI_PWR_SMOOTHING_MIN_PERCENT_TMP_FLOOR_SETTING,
+		Name:        "FI_PWR_SMOOTHING_MIN_PERCENT_TMP_FLOOR_SETTING",
+		Description: "Min % TMP Floor value",
+		Unit:        "%",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_HW_CIRCUITRY_PERCENT_LIFETIME_REMAINING: {
+		Id:          FI_PWR_SMOOTHING_HW_CIRCUITRY_PERCENT_LIFETIME_REMAINING,
+		Name:        "FI_PWR_SMOOTHING_HW_CIRCUITRY_PERCENT_LIFETIME_REMAINING",
+		Description: "HW Circuitry % lifetime remaining",
+		Unit:        "%",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_MAX_NUM_PRESET_PROFILES: {
+		Id:          FI_PWR_SMOOTHING_MAX_NUM_PRESET_PROFILES,
+		Name:        "FI_PWR_SMOOTHING_MAX_NUM_PRESET_PROFILES",
+		Description: "Max number of preset profiles",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_PROFILE_PERCENT_TMP_FLOOR: {
+		Id:          FI_PWR_SMOOTHING_PROFILE_PERCENT_TMP_FLOOR,
+		Name:        "FI_PWR_SMOOTHING_PROFILE_PERCENT_TMP_FLOOR",
+		Description: "% TMP floor for a given profile",
+		Unit:        "%",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_PROFILE_RAMP_UP_RATE: {
+		Id:          FI_PWR_SMOOTHING_PROFILE_RAMP_UP_RATE,
+		Name:        "FI_PWR_SMOOTHING_PROFILE_RAMP_UP_RATE",
+		Description: "Ramp up rate in mW/s for a given profile",
+		Unit:        "mW/s",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_RATE: {
+		Id:          FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_RATE,
+		Name:        "FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_RATE",
+		Description: "Ramp down rate in mW/s for a given profile",
+		Unit:        "mW/s",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_HYST_VAL: {
+		Id:          FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_HYST_VAL,
+		Name:        "FI_PWR_SMOOTHING_PROFILE_RAMP_DOWN_HYST_VAL",
+		Description: "Ramp down hysteresis value in ms for a given profile",
+		Unit:        "ms",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_ACTIVE_PRESET_PROFILE: {
+		Id:          FI_PWR_SMOOTHING_ACTIVE_PRESET_PROFILE,
+		Name:        "FI_PWR_SMOOTHING_ACTIVE_PRESET_PROFILE",
+		Description: "Active preset profile number",
+		Unit:        "",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_ADMIN_OVERRIDE_PERCENT_TMP_FLOOR: {
+		Id:          FI_PWR_SMOOTHING_ADMIN_OVERRIDE_PERCENT_TMP_FLOOR,
+		Name:        "FI_PWR_SMOOTHING_ADMIN_OVERRIDE_PERCENT_TMP_FLOOR",
+		Description: "% TMP floor for a given profile",
+		Unit:        "%",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_UP_RATE: {
+		Id:          FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_UP_RATE,
+		Name:        "FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_UP_RATE",
+		Description: "Ramp up rate in mW/s for a given profile",
+		Unit:        "mW/s",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_RATE: {
+		Id:          FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_RATE,
+		Name:        "FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_RATE",
+		Description: "Ramp down rate in mW/s for a given profile",
+		Unit:        "mW/s",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+	FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_HYST_VAL: {
+		Id:          FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_HYST_VAL,
+		Name:        "FI_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_HYST_VAL",
+		Description: "Ramp down hysteresis value in ms for a given profile",
+		Unit:        "ms",
+		Scope:       FieldScopeDevice,
+		ValueType:   VALUE_TYPE_UNSIGNED_INT,
+	},
+}
@@ -0,0 +1,3982 @@
+//go:build cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// nvmlInit_v2 calls cgo_nvmlInit_v2 through the call gate.
+func nvmlInit_v2() Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlInit_v2()
+}
+
+// nvmlInitWithFlags calls cgo_nvmlInitWithFlags through the call gate.
+func nvmlInitWithFlags(Flags uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlInitWithFlags(Flags)
+}
+
+// nvmlShutdown calls cgo_nvmlShutdown through the call gate.
+func nvmlShutdown() Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlShutdown()
+}
+
+// nvmlErrorString calls cgo_nvmlErrorString through the call gate.
+func nvmlErrorString(Result Return) string {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		var __v0 string
+		return __v0
+	}
+	defer gate.exit()
+	return cgo_nvmlErrorString(Result)
+}
+
+// nvmlSystemGetDriverVersion calls cgo_nvmlSystemGetDriverVersion through the call gate.
+func nvmlSystemGetDriverVersion(Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetDriverVersion(Version, Length)
+}
+
+// nvmlSystemGetNVMLVersion calls cgo_nvmlSystemGetNVMLVersion through the call gate.
+func nvmlSystemGetNVMLVersion(Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetNVMLVersion(Version, Length)
+}
+
+// nvmlSystemGetCudaDriverVersion calls cgo_nvmlSystemGetCudaDriverVersion through the call gate.
+func nvmlSystemGetCudaDriverVersion(CudaDriverVersion *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetCudaDriverVersion(CudaDriverVersion)
+}
+
+// nvmlSystemGetCudaDriverVersion_v2 calls cgo_nvmlSystemGetCudaDriverVersion_v2 through the call gate.
+func nvmlSystemGetCudaDriverVersion_v2(CudaDriverVersion *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetCudaDriverVersion_v2(CudaDriverVersion)
+}
+
+// nvmlSystemGetProcessName calls cgo_nvmlSystemGetProcessName through the call gate.
+func nvmlSystemGetProcessName(Pid uint32, Name *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetProcessName(Pid, Name, Length)
+}
+
+// nvmlSystemGetHicVersion calls cgo_nvmlSystemGetHicVersion through the call gate.
+func nvmlSystemGetHicVersion(HwbcCount *uint32, HwbcEntries *HwbcEntry) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetHicVersion(HwbcCount, HwbcEntries)
+}
+
+// nvmlSystemGetTopologyGpuSet calls cgo_nvmlSystemGetTopologyGpuSet through the call gate.
+func nvmlSystemGetTopologyGpuSet(CpuNumber uint32, Count *uint32, DeviceArray *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetTopologyGpuSet(CpuNumber, Count, DeviceArray)
+}
+
+// nvmlSystemGetDriverBranch calls cgo_nvmlSystemGetDriverBranch through the call gate.
+func nvmlSystemGetDriverBranch(BranchInfo *SystemDriverBranchInfo, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetDriverBranch(BranchInfo, Length)
+}
+
+// nvmlUnitGetCount calls cgo_nvmlUnitGetCount through the call gate.
+func nvmlUnitGetCount(UnitCount *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetCount(UnitCount)
+}
+
+// nvmlUnitGetHandleByIndex calls cgo_nvmlUnitGetHandleByIndex through the call gate.
+func nvmlUnitGetHandleByIndex(Index uint32, nvmlUnit *nvmlUnit) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetHandleByIndex(Index, nvmlUnit)
+}
+
+// nvmlUnitGetUnitInfo calls cgo_nvmlUnitGetUnitInfo through the call gate.
+func nvmlUnitGetUnitInfo(nvmlUnit nvmlUnit, Info *UnitInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetUnitInfo(nvmlUnit, Info)
+}
+
+// nvmlUnitGetLedState calls cgo_nvmlUnitGetLedState through the call gate.
+func nvmlUnitGetLedState(nvmlUnit nvmlUnit, State *LedState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetLedState(nvmlUnit, State)
+}
+
+// nvmlUnitGetPsuInfo calls cgo_nvmlUnitGetPsuInfo through the call gate.
+func nvmlUnitGetPsuInfo(nvmlUnit nvmlUnit, Psu *PSUInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetPsuInfo(nvmlUnit, Psu)
+}
+
+// nvmlUnitGetTemperature calls cgo_nvmlUnitGetTemperature through the call gate.
+func nvmlUnitGetTemperature(nvmlUnit nvmlUnit, _type uint32, Temp *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetTemperature(nvmlUnit, _type, Temp)
+}
+
+// nvmlUnitGetFanSpeedInfo calls cgo_nvmlUnitGetFanSpeedInfo through the call gate.
+func nvmlUnitGetFanSpeedInfo(nvmlUnit nvmlUnit, FanSpeeds *UnitFanSpeeds) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetFanSpeedInfo(nvmlUnit, FanSpeeds)
+}
+
+// nvmlUnitGetDevices calls cgo_nvmlUnitGetDevices through the call gate.
+func nvmlUnitGetDevices(nvmlUnit nvmlUnit, DeviceCount *uint32, Devices *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitGetDevices(nvmlUnit, DeviceCount, Devices)
+}
+
+// nvmlDeviceGetCount_v2 calls cgo_nvmlDeviceGetCount_v2 through the call gate.
+func nvmlDeviceGetCount_v2(DeviceCount *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCount_v2(DeviceCount)
+}
+
+// nvmlDeviceGetAttributes_v2 calls cgo_nvmlDeviceGetAttributes_v2 through the call gate.
+func nvmlDeviceGetAttributes_v2(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAttributes_v2(nvmlDevice, Attributes)
+}
+
+// nvmlDeviceGetHandleByIndex_v2 calls cgo_nvmlDeviceGetHandleByIndex_v2 through the call gate.
+func nvmlDeviceGetHandleByIndex_v2(Index uint32, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByIndex_v2(Index, nvmlDevice)
+}
+
+// nvmlDeviceGetHandleBySerial calls cgo_nvmlDeviceGetHandleBySerial through the call gate.
+func nvmlDeviceGetHandleBySerial(Serial string, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleBySerial(Serial, nvmlDevice)
+}
+
+// nvmlDeviceGetHandleByUUID calls cgo_nvmlDeviceGetHandleByUUID through the call gate.
+func nvmlDeviceGetHandleByUUID(Uuid string, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByUUID(Uuid, nvmlDevice)
+}
+
+// nvmlDeviceGetHandleByUUIDV calls cgo_nvmlDeviceGetHandleByUUIDV through the call gate.
+func nvmlDeviceGetHandleByUUIDV(Uuid *UUID, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByUUIDV(Uuid, nvmlDevice)
+}
+
+// nvmlDeviceGetHandleByPciBusId_v2 calls cgo_nvmlDeviceGetHandleByPciBusId_v2 through the call gate.
+func nvmlDeviceGetHandleByPciBusId_v2(PciBusId string, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByPciBusId_v2(PciBusId, nvmlDevice)
+}
+
+// nvmlDeviceGetName calls cgo_nvmlDeviceGetName through the call gate.
+func nvmlDeviceGetName(nvmlDevice nvmlDevice, Name *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetName(nvmlDevice, Name, Length)
+}
+
+// nvmlDeviceGetBrand calls cgo_nvmlDeviceGetBrand through the call gate.
+func nvmlDeviceGetBrand(nvmlDevice nvmlDevice, _type *BrandType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBrand(nvmlDevice, _type)
+}
+
+// nvmlDeviceGetIndex calls cgo_nvmlDeviceGetIndex through the call gate.
+func nvmlDeviceGetIndex(nvmlDevice nvmlDevice, Index *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetIndex(nvmlDevice, Index)
+}
+
+// nvmlDeviceGetSerial calls cgo_nvmlDeviceGetSerial through the call gate.
+func nvmlDeviceGetSerial(nvmlDevice nvmlDevice, Serial *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSerial(nvmlDevice, Serial, Length)
+}
+
+// nvmlDeviceGetModuleId calls cgo_nvmlDeviceGetModuleId through the call gate.
+func nvmlDeviceGetModuleId(nvmlDevice nvmlDevice, ModuleId *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetModuleId(nvmlDevice, ModuleId)
+}
+
+// nvmlDeviceGetC2cModeInfoV calls cgo_nvmlDeviceGetC2cModeInfoV through the call gate.
+func nvmlDeviceGetC2cModeInfoV(nvmlDevice nvmlDevice, C2cModeInfo *C2cModeInfo_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetC2cModeInfoV(nvmlDevice, C2cModeInfo)
+}
+
+// nvmlDeviceGetMemoryAffinity calls cgo_nvmlDeviceGetMemoryAffinity through the call gate.
+func nvmlDeviceGetMemoryAffinity(nvmlDevice nvmlDevice, NodeSetSize uint32, NodeSet *uint, Scope AffinityScope) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemoryAffinity(nvmlDevice, NodeSetSize, NodeSet, Scope)
+}
+
+// nvmlDeviceGetCpuAffinityWithinScope calls cgo_nvmlDeviceGetCpuAffinityWithinScope through the call gate.
+func nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint, Scope AffinityScope) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice, CpuSetSize, CpuSet, Scope)
+}
+
+// nvmlDeviceGetCpuAffinity calls cgo_nvmlDeviceGetCpuAffinity through the call gate.
+func nvmlDeviceGetCpuAffinity(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCpuAffinity(nvmlDevice, CpuSetSize, CpuSet)
+}
+
+// nvmlDeviceSetCpuAffinity calls cgo_nvmlDeviceSetCpuAffinity through the call gate.
+func nvmlDeviceSetCpuAffinity(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetCpuAffinity(nvmlDevice)
+}
+
+// nvmlDeviceClearCpuAffinity calls cgo_nvmlDeviceClearCpuAffinity through the call gate.
+func nvmlDeviceClearCpuAffinity(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceClearCpuAffinity(nvmlDevice)
+}
+
+// nvmlDeviceGetNumaNodeId calls cgo_nvmlDeviceGetNumaNodeId through the call gate.
+func nvmlDeviceGetNumaNodeId(nvmlDevice nvmlDevice, Node *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNumaNodeId(nvmlDevice, Node)
+}
+
+// nvmlDeviceGetAddressingMode calls cgo_nvmlDeviceGetAddressingMode through the call gate.
+func nvmlDeviceGetAddressingMode(nvmlDevice nvmlDevice, Mode *DeviceAddressingMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAddressingMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceGetRepairStatus calls cgo_nvmlDeviceGetRepairStatus through the call gate.
+func nvmlDeviceGetRepairStatus(nvmlDevice nvmlDevice, RepairStatus *RepairStatus) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRepairStatus(nvmlDevice, RepairStatus)
+}
+
+// nvmlDeviceGetTopologyCommonAncestor calls cgo_nvmlDeviceGetTopologyCommonAncestor through the call gate.
+func nvmlDeviceGetTopologyCommonAncestor(Device1 nvmlDevice, Device2 nvmlDevice, PathInfo *GpuTopologyLevel) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTopologyCommonAncestor(Device1, Device2, PathInfo)
+}
+
+// nvmlDeviceGetTopologyNearestGpus calls cgo_nvmlDeviceGetTopologyNearestGpus through the call gate.
+func nvmlDeviceGetTopologyNearestGpus(nvmlDevice nvmlDevice, Level GpuTopologyLevel, Count *uint32, DeviceArray *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTopologyNearestGpus(nvmlDevice, Level, Count, DeviceArray)
+}
+
+// nvmlDeviceGetP2PStatus calls cgo_nvmlDeviceGetP2PStatus through the call gate.
+func nvmlDeviceGetP2PStatus(Device1 nvmlDevice, Device2 nvmlDevice, P2pIndex GpuP2PCapsIndex, P2pStatus *GpuP2PStatus) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetP2PStatus(Device1, Device2, P2pIndex, P2pStatus)
+}
+
+// nvmlDeviceGetUUID calls cgo_nvmlDeviceGetUUID through the call gate.
+func nvmlDeviceGetUUID(nvmlDevice nvmlDevice, Uuid *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetUUID(nvmlDevice, Uuid, Length)
+}
+
+// nvmlDeviceGetMinorNumber calls cgo_nvmlDeviceGetMinorNumber through the call gate.
+func nvmlDeviceGetMinorNumber(nvmlDevice nvmlDevice, MinorNumber *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMinorNumber(nvmlDevice, MinorNumber)
+}
+
+// nvmlDeviceGetBoardPartNumber calls cgo_nvmlDeviceGetBoardPartNumber through the call gate.
+func nvmlDeviceGetBoardPartNumber(nvmlDevice nvmlDevice, PartNumber *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBoardPartNumber(nvmlDevice, PartNumber, Length)
+}
+
+// nvmlDeviceGetInforomVersion calls cgo_nvmlDeviceGetInforomVersion through the call gate.
+func nvmlDeviceGetInforomVersion(nvmlDevice nvmlDevice, Object InforomObject, Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetInforomVersion(nvmlDevice, Object, Version, Length)
+}
+
+// nvmlDeviceGetInforomImageVersion calls cgo_nvmlDeviceGetInforomImageVersion through the call gate.
+func nvmlDeviceGetInforomImageVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetInforomImageVersion(nvmlDevice, Version, Length)
+}
+
+// nvmlDeviceGetInforomConfigurationChecksum calls cgo_nvmlDeviceGetInforomConfigurationChecksum through the call gate.
+func nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice nvmlDevice, Checksum *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice, Checksum)
+}
+
+// nvmlDeviceValidateInforom calls cgo_nvmlDeviceValidateInforom through the call gate.
+func nvmlDeviceValidateInforom(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceValidateInforom(nvmlDevice)
+}
+
+// nvmlDeviceGetLastBBXFlushTime calls cgo_nvmlDeviceGetLastBBXFlushTime through the call gate.
+func nvmlDeviceGetLastBBXFlushTime(nvmlDevice nvmlDevice, Timestamp *uint64, DurationUs *uint) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetLastBBXFlushTime(nvmlDevice, Timestamp, DurationUs)
+}
+
+// nvmlDeviceGetDisplayMode calls cgo_nvmlDeviceGetDisplayMode through the call gate.
+func nvmlDeviceGetDisplayMode(nvmlDevice nvmlDevice, Display *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDisplayMode(nvmlDevice, Display)
+}
+
+// nvmlDeviceGetDisplayActive calls cgo_nvmlDeviceGetDisplayActive through the call gate.
+func nvmlDeviceGetDisplayActive(nvmlDevice nvmlDevice, IsActive *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDisplayActive(nvmlDevice, IsActive)
+}
+
+// nvmlDeviceGetPersistenceMode calls cgo_nvmlDeviceGetPersistenceMode through the call gate.
+func nvmlDeviceGetPersistenceMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPersistenceMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceGetPciInfoExt calls cgo_nvmlDeviceGetPciInfoExt through the call gate.
+func nvmlDeviceGetPciInfoExt(nvmlDevice nvmlDevice, Pci *PciInfoExt) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPciInfoExt(nvmlDevice, Pci)
+}
+
+// nvmlDeviceGetPciInfo_v3 calls cgo_nvmlDeviceGetPciInfo_v3 through the call gate.
+func nvmlDeviceGetPciInfo_v3(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPciInfo_v3(nvmlDevice, Pci)
+}
+
+// nvmlDeviceGetMaxPcieLinkGeneration calls cgo_nvmlDeviceGetMaxPcieLinkGeneration through the call gate.
+func nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGen *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice, MaxLinkGen)
+}
+
+// nvmlDeviceGetGpuMaxPcieLinkGeneration calls cgo_nvmlDeviceGetGpuMaxPcieLinkGeneration through the call gate.
+func nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGenDevice *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice, MaxLinkGenDevice)
+}
+
+// nvmlDeviceGetMaxPcieLinkWidth calls cgo_nvmlDeviceGetMaxPcieLinkWidth through the call gate.
+func nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice nvmlDevice, MaxLinkWidth *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice, MaxLinkWidth)
+}
+
+// nvmlDeviceGetCurrPcieLinkGeneration calls cgo_nvmlDeviceGetCurrPcieLinkGeneration through the call gate.
+func nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice nvmlDevice, CurrLinkGen *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice, CurrLinkGen)
+}
+
+// nvmlDeviceGetCurrPcieLinkWidth calls cgo_nvmlDeviceGetCurrPcieLinkWidth through the call gate.
+func nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice nvmlDevice, CurrLinkWidth *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice, CurrLinkWidth)
+}
+
+// nvmlDeviceGetPcieThroughput calls cgo_nvmlDeviceGetPcieThroughput through the call gate.
+func nvmlDeviceGetPcieThroughput(nvmlDevice nvmlDevice, Counter PcieUtilCounter, Value *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPcieThroughput(nvmlDevice, Counter, Value)
+}
+
+// nvmlDeviceGetPcieReplayCounter calls cgo_nvmlDeviceGetPcieReplayCounter through the call gate.
+func nvmlDeviceGetPcieReplayCounter(nvmlDevice nvmlDevice, Value *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPcieReplayCounter(nvmlDevice, Value)
+}
+
+// nvmlDeviceGetClockInfo calls cgo_nvmlDeviceGetClockInfo through the call gate.
+func nvmlDeviceGetClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetClockInfo(nvmlDevice, _type, Clock)
+}
+
+// nvmlDeviceGetMaxClockInfo calls cgo_nvmlDeviceGetMaxClockInfo through the call gate.
+func nvmlDeviceGetMaxClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMaxClockInfo(nvmlDevice, _type, Clock)
+}
+
+// nvmlDeviceGetGpcClkVfOffset calls cgo_nvmlDeviceGetGpcClkVfOffset through the call gate.
+func nvmlDeviceGetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpcClkVfOffset(nvmlDevice, Offset)
+}
+
+// nvmlDeviceGetApplicationsClock calls cgo_nvmlDeviceGetApplicationsClock through the call gate.
+func nvmlDeviceGetApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetApplicationsClock(nvmlDevice, ClockType, ClockMHz)
+}
+
+// nvmlDeviceGetDefaultApplicationsClock calls cgo_nvmlDeviceGetDefaultApplicationsClock through the call gate.
+func nvmlDeviceGetDefaultApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDefaultApplicationsClock(nvmlDevice, ClockType, ClockMHz)
+}
+
+// nvmlDeviceGetClock calls cgo_nvmlDeviceGetClock through the call gate.
+func nvmlDeviceGetClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockId ClockId, ClockMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetClock(nvmlDevice, ClockType, ClockId, ClockMHz)
+}
+
+// nvmlDeviceGetMaxCustomerBoostClock calls cgo_nvmlDeviceGetMaxCustomerBoostClock through the call gate.
+func nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice, ClockType, ClockMHz)
+}
+
+// nvmlDeviceGetSupportedMemoryClocks calls cgo_nvmlDeviceGetSupportedMemoryClocks through the call gate.
+func nvmlDeviceGetSupportedMemoryClocks(nvmlDevice nvmlDevice, Count *uint32, ClocksMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedMemoryClocks(nvmlDevice, Count, ClocksMHz)
+}
+
+// nvmlDeviceGetSupportedGraphicsClocks calls cgo_nvmlDeviceGetSupportedGraphicsClocks through the call gate.
+func nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice nvmlDevice, MemoryClockMHz uint32, Count *uint32, ClocksMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice, MemoryClockMHz, Count, ClocksMHz)
+}
+
+// nvmlDeviceGetAutoBoostedClocksEnabled calls cgo_nvmlDeviceGetAutoBoostedClocksEnabled through the call gate.
+func nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, IsEnabled *EnableState, DefaultIsEnabled *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice, IsEnabled, DefaultIsEnabled)
+}
+
+// nvmlDeviceGetFanSpeed calls cgo_nvmlDeviceGetFanSpeed through the call gate.
+func nvmlDeviceGetFanSpeed(nvmlDevice nvmlDevice, Speed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFanSpeed(nvmlDevice, Speed)
+}
+
+// nvmlDeviceGetFanSpeed_v2 calls cgo_nvmlDeviceGetFanSpeed_v2 through the call gate.
+func nvmlDeviceGetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFanSpeed_v2(nvmlDevice, Fan, Speed)
+}
+
+// nvmlDeviceGetFanSpeedRPM calls cgo_nvmlDeviceGetFanSpeedRPM through the call gate.
+func nvmlDeviceGetFanSpeedRPM(nvmlDevice nvmlDevice, FanSpeed *FanSpeedInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFanSpeedRPM(nvmlDevice, FanSpeed)
+}
+
+// nvmlDeviceGetTargetFanSpeed calls cgo_nvmlDeviceGetTargetFanSpeed through the call gate.
+func nvmlDeviceGetTargetFanSpeed(nvmlDevice nvmlDevice, Fan uint32, TargetSpeed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTargetFanSpeed(nvmlDevice, Fan, TargetSpeed)
+}
+
+// nvmlDeviceGetMinMaxFanSpeed calls cgo_nvmlDeviceGetMinMaxFanSpeed through the call gate.
+func nvmlDeviceGetMinMaxFanSpeed(nvmlDevice nvmlDevice, MinSpeed *uint32, MaxSpeed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMinMaxFanSpeed(nvmlDevice, MinSpeed, MaxSpeed)
+}
+
+// nvmlDeviceGetFanControlPolicy_v2 calls cgo_nvmlDeviceGetFanControlPolicy_v2 through the call gate.
+func nvmlDeviceGetFanControlPolicy_v2(nvmlDevice nvmlDevice, Fan uint32, Policy *FanControlPolicy) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFanControlPolicy_v2(nvmlDevice, Fan, Policy)
+}
+
+// nvmlDeviceGetNumFans calls cgo_nvmlDeviceGetNumFans through the call gate.
+func nvmlDeviceGetNumFans(nvmlDevice nvmlDevice, NumFans *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNumFans(nvmlDevice, NumFans)
+}
+
+// nvmlDeviceGetTemperature calls cgo_nvmlDeviceGetTemperature through the call gate.
+func nvmlDeviceGetTemperature(nvmlDevice nvmlDevice, SensorType TemperatureSensors, Temp *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTemperature(nvmlDevice, SensorType, Temp)
+}
+
+// nvmlDeviceGetCoolerInfo calls cgo_nvmlDeviceGetCoolerInfo through the call gate.
+func nvmlDeviceGetCoolerInfo(nvmlDevice nvmlDevice, CoolerInfo *CoolerInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCoolerInfo(nvmlDevice, CoolerInfo)
+}
+
+// nvmlDeviceGetTemperatureV calls cgo_nvmlDeviceGetTemperatureV through the call gate.
+func nvmlDeviceGetTemperatureV(nvmlDevice nvmlDevice, Temperature *Temperature) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTemperatureV(nvmlDevice, Temperature)
+}
+
+// nvmlDeviceGetTemperatureThreshold calls cgo_nvmlDeviceGetTemperatureThreshold through the call gate.
+func nvmlDeviceGetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTemperatureThreshold(nvmlDevice, ThresholdType, Temp)
+}
+
+// nvmlDeviceGetMarginTemperature calls cgo_nvmlDeviceGetMarginTemperature through the call gate.
+func nvmlDeviceGetMarginTemperature(nvmlDevice nvmlDevice, MarginTempInfo *MarginTemperature) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMarginTemperature(nvmlDevice, MarginTempInfo)
+}
+
+// nvmlDeviceGetThermalSettings calls cgo_nvmlDeviceGetThermalSettings through the call gate.
+func nvmlDeviceGetThermalSettings(nvmlDevice nvmlDevice, SensorIndex uint32, PThermalSettings *GpuThermalSettings) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetThermalSettings(nvmlDevice, SensorIndex, PThermalSettings)
+}
+
+// nvmlDeviceGetPerformanceState calls cgo_nvmlDeviceGetPerformanceState through the call gate.
+func nvmlDeviceGetPerformanceState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPerformanceState(nvmlDevice, PState)
+}
+
+// nvmlDeviceGetCurrentClocksEventReasons calls cgo_nvmlDeviceGetCurrentClocksEventReasons through the call gate.
+func nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice nvmlDevice, ClocksEventReasons *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice, ClocksEventReasons)
+}
+
+// nvmlDeviceGetCurrentClocksThrottleReasons calls cgo_nvmlDeviceGetCurrentClocksThrottleReasons through the call gate.
+func nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice nvmlDevice, ClocksThrottleReasons *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice, ClocksThrottleReasons)
+}
+
+// nvmlDeviceGetSupportedClocksEventReasons calls cgo_nvmlDeviceGetSupportedClocksEventReasons through the call gate.
+func nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice nvmlDevice, SupportedClocksEventReasons *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice, SupportedClocksEventReasons)
+}
+
+// nvmlDeviceGetSupportedClocksThrottleReasons calls cgo_nvmlDeviceGetSupportedClocksThrottleReasons through the call gate.
+func nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice nvmlDevice, SupportedClocksThrottleReasons *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice, SupportedClocksThrottleReasons)
+}
+
+// nvmlDeviceGetPowerState calls cgo_nvmlDeviceGetPowerState through the call gate.
+func nvmlDeviceGetPowerState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerState(nvmlDevice, PState)
+}
+
+// nvmlDeviceGetDynamicPstatesInfo calls cgo_nvmlDeviceGetDynamicPstatesInfo through the call gate.
+func nvmlDeviceGetDynamicPstatesInfo(nvmlDevice nvmlDevice, PDynamicPstatesInfo *GpuDynamicPstatesInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDynamicPstatesInfo(nvmlDevice, PDynamicPstatesInfo)
+}
+
+// nvmlDeviceGetMemClkVfOffset calls cgo_nvmlDeviceGetMemClkVfOffset through the call gate.
+func nvmlDeviceGetMemClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemClkVfOffset(nvmlDevice, Offset)
+}
+
+// nvmlDeviceGetMinMaxClockOfPState calls cgo_nvmlDeviceGetMinMaxClockOfPState through the call gate.
+func nvmlDeviceGetMinMaxClockOfPState(nvmlDevice nvmlDevice, _type ClockType, Pstate Pstates, MinClockMHz *uint32, MaxClockMHz *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMinMaxClockOfPState(nvmlDevice, _type, Pstate, MinClockMHz, MaxClockMHz)
+}
+
+// nvmlDeviceGetSupportedPerformanceStates calls cgo_nvmlDeviceGetSupportedPerformanceStates through the call gate.
+func nvmlDeviceGetSupportedPerformanceStates(nvmlDevice nvmlDevice, Pstates *Pstates, Size uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedPerformanceStates(nvmlDevice, Pstates, Size)
+}
+
+// nvmlDeviceGetGpcClkMinMaxVfOffset calls cgo_nvmlDeviceGetGpcClkMinMaxVfOffset through the call gate.
+func nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice, MinOffset, MaxOffset)
+}
+
+// nvmlDeviceGetMemClkMinMaxVfOffset calls cgo_nvmlDeviceGetMemClkMinMaxVfOffset through the call gate.
+func nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice, MinOffset, MaxOffset)
+}
+
+// nvmlDeviceGetClockOffsets calls cgo_nvmlDeviceGetClockOffsets through the call gate.
+func nvmlDeviceGetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetClockOffsets(nvmlDevice, Info)
+}
+
+// nvmlDeviceSetClockOffsets calls cgo_nvmlDeviceSetClockOffsets through the call gate.
+func nvmlDeviceSetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetClockOffsets(nvmlDevice, Info)
+}
+
+// nvmlDeviceGetPerformanceModes calls cgo_nvmlDeviceGetPerformanceModes through the call gate.
+func nvmlDeviceGetPerformanceModes(nvmlDevice nvmlDevice, PerfModes *DevicePerfModes) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPerformanceModes(nvmlDevice, PerfModes)
+}
+
+// nvmlDeviceGetCurrentClockFreqs calls cgo_nvmlDeviceGetCurrentClockFreqs through the call gate.
+func nvmlDeviceGetCurrentClockFreqs(nvmlDevice nvmlDevice, CurrentClockFreqs *DeviceCurrentClockFreqs) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCurrentClockFreqs(nvmlDevice, CurrentClockFreqs)
+}
+
+// nvmlDeviceGetPowerManagementMode calls cgo_nvmlDeviceGetPowerManagementMode through the call gate.
+func nvmlDeviceGetPowerManagementMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerManagementMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceGetPowerManagementLimit calls cgo_nvmlDeviceGetPowerManagementLimit through the call gate.
+func nvmlDeviceGetPowerManagementLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerManagementLimit(nvmlDevice, Limit)
+}
+
+// nvmlDeviceGetPowerManagementLimitConstraints calls cgo_nvmlDeviceGetPowerManagementLimitConstraints through the call gate.
+func nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice nvmlDevice, MinLimit *uint32, MaxLimit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice, MinLimit, MaxLimit)
+}
+
+// nvmlDeviceGetPowerManagementDefaultLimit calls cgo_nvmlDeviceGetPowerManagementDefaultLimit through the call gate.
+func nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice nvmlDevice, DefaultLimit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice, DefaultLimit)
+}
+
+// nvmlDeviceGetPowerUsage calls cgo_nvmlDeviceGetPowerUsage through the call gate.
+func nvmlDeviceGetPowerUsage(nvmlDevice nvmlDevice, Power *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerUsage(nvmlDevice, Power)
+}
+
+// nvmlDeviceGetPowerMizerMode_v1 calls cgo_nvmlDeviceGetPowerMizerMode_v1 through the call gate.
+func nvmlDeviceGetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerMizerMode_v1(nvmlDevice, PowerMizerMode)
+}
+
+// nvmlDeviceSetPowerMizerMode_v1 calls cgo_nvmlDeviceSetPowerMizerMode_v1 through the call gate.
+func nvmlDeviceSetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetPowerMizerMode_v1(nvmlDevice, PowerMizerMode)
+}
+
+// nvmlDeviceGetTotalEnergyConsumption calls cgo_nvmlDeviceGetTotalEnergyConsumption through the call gate.
+func nvmlDeviceGetTotalEnergyConsumption(nvmlDevice nvmlDevice, Energy *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTotalEnergyConsumption(nvmlDevice, Energy)
+}
+
+// nvmlDeviceGetEnforcedPowerLimit calls cgo_nvmlDeviceGetEnforcedPowerLimit through the call gate.
+func nvmlDeviceGetEnforcedPowerLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEnforcedPowerLimit(nvmlDevice, Limit)
+}
+
+// nvmlDeviceGetGpuOperationMode calls cgo_nvmlDeviceGetGpuOperationMode through the call gate.
+func nvmlDeviceGetGpuOperationMode(nvmlDevice nvmlDevice, Current *GpuOperationMode, Pending *GpuOperationMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuOperationMode(nvmlDevice, Current, Pending)
+}
+
+// nvmlDeviceGetMemoryInfo calls cgo_nvmlDeviceGetMemoryInfo through the call gate.
+func nvmlDeviceGetMemoryInfo(nvmlDevice nvmlDevice, Memory *Memory) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemoryInfo(nvmlDevice, Memory)
+}
+
+// nvmlDeviceGetMemoryInfo_v2 calls cgo_nvmlDeviceGetMemoryInfo_v2 through the call gate.
+func nvmlDeviceGetMemoryInfo_v2(nvmlDevice nvmlDevice, Memory *Memory_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemoryInfo_v2(nvmlDevice, Memory)
+}
+
+// nvmlDeviceGetComputeMode calls cgo_nvmlDeviceGetComputeMode through the call gate.
+func nvmlDeviceGetComputeMode(nvmlDevice nvmlDevice, Mode *ComputeMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetComputeMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceGetCudaComputeCapability calls cgo_nvmlDeviceGetCudaComputeCapability through the call gate.
+func nvmlDeviceGetCudaComputeCapability(nvmlDevice nvmlDevice, Major *int32, Minor *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCudaComputeCapability(nvmlDevice, Major, Minor)
+}
+
+// nvmlDeviceGetDramEncryptionMode calls cgo_nvmlDeviceGetDramEncryptionMode through the call gate.
+func nvmlDeviceGetDramEncryptionMode(nvmlDevice nvmlDevice, Current *DramEncryptionInfo, Pending *DramEncryptionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDramEncryptionMode(nvmlDevice, Current, Pending)
+}
+
+// nvmlDeviceSetDramEncryptionMode calls cgo_nvmlDeviceSetDramEncryptionMode through the call gate.
+func nvmlDeviceSetDramEncryptionMode(nvmlDevice nvmlDevice, DramEncryption *DramEncryptionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetDramEncryptionMode(nvmlDevice, DramEncryption)
+}
+
+// nvmlDeviceGetEccMode calls cgo_nvmlDeviceGetEccMode through the call gate.
+func nvmlDeviceGetEccMode(nvmlDevice nvmlDevice, Current *EnableState, Pending *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEccMode(nvmlDevice, Current, Pending)
+}
+
+// nvmlDeviceGetDefaultEccMode calls cgo_nvmlDeviceGetDefaultEccMode through the call gate.
+func nvmlDeviceGetDefaultEccMode(nvmlDevice nvmlDevice, DefaultMode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDefaultEccMode(nvmlDevice, DefaultMode)
+}
+
+// nvmlDeviceGetBoardId calls cgo_nvmlDeviceGetBoardId through the call gate.
+func nvmlDeviceGetBoardId(nvmlDevice nvmlDevice, BoardId *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBoardId(nvmlDevice, BoardId)
+}
+
+// nvmlDeviceGetMultiGpuBoard calls cgo_nvmlDeviceGetMultiGpuBoard through the call gate.
+func nvmlDeviceGetMultiGpuBoard(nvmlDevice nvmlDevice, MultiGpuBool *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMultiGpuBoard(nvmlDevice, MultiGpuBool)
+}
+
+// nvmlDeviceGetTotalEccErrors calls cgo_nvmlDeviceGetTotalEccErrors through the call gate.
+func nvmlDeviceGetTotalEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetTotalEccErrors(nvmlDevice, ErrorType, CounterType, EccCounts)
+}
+
+// nvmlDeviceGetDetailedEccErrors calls cgo_nvmlDeviceGetDetailedEccErrors through the call gate.
+func nvmlDeviceGetDetailedEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *EccErrorCounts) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDetailedEccErrors(nvmlDevice, ErrorType, CounterType, EccCounts)
+}
+
+// nvmlDeviceGetMemoryErrorCounter calls cgo_nvmlDeviceGetMemoryErrorCounter through the call gate.
+func nvmlDeviceGetMemoryErrorCounter(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, LocationType MemoryLocation, Count *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemoryErrorCounter(nvmlDevice, ErrorType, CounterType, LocationType, Count)
+}
+
+// nvmlDeviceGetUtilizationRates calls cgo_nvmlDeviceGetUtilizationRates through the call gate.
+func nvmlDeviceGetUtilizationRates(nvmlDevice nvmlDevice, Utilization *Utilization) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetUtilizationRates(nvmlDevice, Utilization)
+}
+
+// nvmlDeviceGetEncoderUtilization calls cgo_nvmlDeviceGetEncoderUtilization through the call gate.
+func nvmlDeviceGetEncoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEncoderUtilization(nvmlDevice, Utilization, SamplingPeriodUs)
+}
+
+// nvmlDeviceGetEncoderCapacity calls cgo_nvmlDeviceGetEncoderCapacity through the call gate.
+func nvmlDeviceGetEncoderCapacity(nvmlDevice nvmlDevice, EncoderQueryType EncoderType, EncoderCapacity *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEncoderCapacity(nvmlDevice, EncoderQueryType, EncoderCapacity)
+}
+
+// nvmlDeviceGetEncoderStats calls cgo_nvmlDeviceGetEncoderStats through the call gate.
+func nvmlDeviceGetEncoderStats(nvmlDevice nvmlDevice, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEncoderStats(nvmlDevice, SessionCount, AverageFps, AverageLatency)
+}
+
+// nvmlDeviceGetEncoderSessions calls cgo_nvmlDeviceGetEncoderSessions through the call gate.
+func nvmlDeviceGetEncoderSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfos *EncoderSessionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetEncoderSessions(nvmlDevice, SessionCount, SessionInfos)
+}
+
+// nvmlDeviceGetDecoderUtilization calls cgo_nvmlDeviceGetDecoderUtilization through the call gate.
+func nvmlDeviceGetDecoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDecoderUtilization(nvmlDevice, Utilization, SamplingPeriodUs)
+}
+
+// nvmlDeviceGetJpgUtilization calls cgo_nvmlDeviceGetJpgUtilization through the call gate.
+func nvmlDeviceGetJpgUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetJpgUtilization(nvmlDevice, Utilization, SamplingPeriodUs)
+}
+
+// nvmlDeviceGetOfaUtilization calls cgo_nvmlDeviceGetOfaUtilization through the call gate.
+func nvmlDeviceGetOfaUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetOfaUtilization(nvmlDevice, Utilization, SamplingPeriodUs)
+}
+
+// nvmlDeviceGetFBCStats calls cgo_nvmlDeviceGetFBCStats through the call gate.
+func nvmlDeviceGetFBCStats(nvmlDevice nvmlDevice, FbcStats *FBCStats) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFBCStats(nvmlDevice, FbcStats)
+}
+
+// nvmlDeviceGetFBCSessions calls cgo_nvmlDeviceGetFBCSessions through the call gate.
+func nvmlDeviceGetFBCSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFBCSessions(nvmlDevice, SessionCount, SessionInfo)
+}
+
+// nvmlDeviceGetDriverModel_v2 calls cgo_nvmlDeviceGetDriverModel_v2 through the call gate.
+func nvmlDeviceGetDriverModel_v2(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDriverModel_v2(nvmlDevice, Current, Pending)
+}
+
+// nvmlDeviceGetVbiosVersion calls cgo_nvmlDeviceGetVbiosVersion through the call gate.
+func nvmlDeviceGetVbiosVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVbiosVersion(nvmlDevice, Version, Length)
+}
+
+// nvmlDeviceGetBridgeChipInfo calls cgo_nvmlDeviceGetBridgeChipInfo through the call gate.
+func nvmlDeviceGetBridgeChipInfo(nvmlDevice nvmlDevice, BridgeHierarchy *BridgeChipHierarchy) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBridgeChipInfo(nvmlDevice, BridgeHierarchy)
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v3 calls cgo_nvmlDeviceGetComputeRunningProcesses_v3 through the call gate.
+func nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v3 calls cgo_nvmlDeviceGetGraphicsRunningProcesses_v3 through the call gate.
+func nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v3 calls cgo_nvmlDeviceGetMPSComputeRunningProcesses_v3 through the call gate.
+func nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetRunningProcessDetailList calls cgo_nvmlDeviceGetRunningProcessDetailList through the call gate.
+func nvmlDeviceGetRunningProcessDetailList(nvmlDevice nvmlDevice, Plist *ProcessDetailList) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRunningProcessDetailList(nvmlDevice, Plist)
+}
+
+// nvmlDeviceOnSameBoard calls cgo_nvmlDeviceOnSameBoard through the call gate.
+func nvmlDeviceOnSameBoard(Device1 nvmlDevice, Device2 nvmlDevice, OnSameBoard *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceOnSameBoard(Device1, Device2, OnSameBoard)
+}
+
+// nvmlDeviceGetAPIRestriction calls cgo_nvmlDeviceGetAPIRestriction through the call gate.
+func nvmlDeviceGetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAPIRestriction(nvmlDevice, ApiType, IsRestricted)
+}
+
+// nvmlDeviceGetSamples calls cgo_nvmlDeviceGetSamples through the call gate.
+func nvmlDeviceGetSamples(nvmlDevice nvmlDevice, _type SamplingType, LastSeenTimeStamp uint64, SampleValType *ValueType, SampleCount *uint32, Samples *Sample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSamples(nvmlDevice, _type, LastSeenTimeStamp, SampleValType, SampleCount, Samples)
+}
+
+// nvmlDeviceGetBAR1MemoryInfo calls cgo_nvmlDeviceGetBAR1MemoryInfo through the call gate.
+func nvmlDeviceGetBAR1MemoryInfo(nvmlDevice nvmlDevice, Bar1Memory *BAR1Memory) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBAR1MemoryInfo(nvmlDevice, Bar1Memory)
+}
+
+// nvmlDeviceGetViolationStatus calls cgo_nvmlDeviceGetViolationStatus through the call gate.
+func nvmlDeviceGetViolationStatus(nvmlDevice nvmlDevice, PerfPolicyType PerfPolicyType, ViolTime *ViolationTime) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetViolationStatus(nvmlDevice, PerfPolicyType, ViolTime)
+}
+
+// nvmlDeviceGetIrqNum calls cgo_nvmlDeviceGetIrqNum through the call gate.
+func nvmlDeviceGetIrqNum(nvmlDevice nvmlDevice, IrqNum *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetIrqNum(nvmlDevice, IrqNum)
+}
+
+// nvmlDeviceGetNumGpuCores calls cgo_nvmlDeviceGetNumGpuCores through the call gate.
+func nvmlDeviceGetNumGpuCores(nvmlDevice nvmlDevice, NumCores *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNumGpuCores(nvmlDevice, NumCores)
+}
+
+// nvmlDeviceGetPowerSource calls cgo_nvmlDeviceGetPowerSource through the call gate.
+func nvmlDeviceGetPowerSource(nvmlDevice nvmlDevice, PowerSource *PowerSource) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPowerSource(nvmlDevice, PowerSource)
+}
+
+// nvmlDeviceGetMemoryBusWidth calls cgo_nvmlDeviceGetMemoryBusWidth through the call gate.
+func nvmlDeviceGetMemoryBusWidth(nvmlDevice nvmlDevice, BusWidth *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMemoryBusWidth(nvmlDevice, BusWidth)
+}
+
+// nvmlDeviceGetPcieLinkMaxSpeed calls cgo_nvmlDeviceGetPcieLinkMaxSpeed through the call gate.
+func nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice nvmlDevice, MaxSpeed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice, MaxSpeed)
+}
+
+// nvmlDeviceGetPcieSpeed calls cgo_nvmlDeviceGetPcieSpeed through the call gate.
+func nvmlDeviceGetPcieSpeed(nvmlDevice nvmlDevice, PcieSpeed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPcieSpeed(nvmlDevice, PcieSpeed)
+}
+
+// nvmlDeviceGetAdaptiveClockInfoStatus calls cgo_nvmlDeviceGetAdaptiveClockInfoStatus through the call gate.
+func nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice nvmlDevice, AdaptiveClockStatus *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice, AdaptiveClockStatus)
+}
+
+// nvmlDeviceGetBusType calls cgo_nvmlDeviceGetBusType through the call gate.
+func nvmlDeviceGetBusType(nvmlDevice nvmlDevice, _type *BusType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetBusType(nvmlDevice, _type)
+}
+
+// nvmlDeviceGetGpuFabricInfo calls cgo_nvmlDeviceGetGpuFabricInfo through the call gate.
+func nvmlDeviceGetGpuFabricInfo(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuFabricInfo(nvmlDevice, GpuFabricInfo)
+}
+
+// nvmlDeviceGetGpuFabricInfoV calls cgo_nvmlDeviceGetGpuFabricInfoV through the call gate.
+func nvmlDeviceGetGpuFabricInfoV(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfoV) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuFabricInfoV(nvmlDevice, GpuFabricInfo)
+}
+
+// nvmlSystemGetConfComputeCapabilities calls cgo_nvmlSystemGetConfComputeCapabilities through the call gate.
+func nvmlSystemGetConfComputeCapabilities(Capabilities *ConfComputeSystemCaps) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetConfComputeCapabilities(Capabilities)
+}
+
+// nvmlSystemGetConfComputeState calls cgo_nvmlSystemGetConfComputeState through the call gate.
+func nvmlSystemGetConfComputeState(State *ConfComputeSystemState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetConfComputeState(State)
+}
+
+// nvmlDeviceGetConfComputeMemSizeInfo calls cgo_nvmlDeviceGetConfComputeMemSizeInfo through the call gate.
+func nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice nvmlDevice, MemInfo *ConfComputeMemSizeInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice, MemInfo)
+}
+
+// nvmlSystemGetConfComputeGpusReadyState calls cgo_nvmlSystemGetConfComputeGpusReadyState through the call gate.
+func nvmlSystemGetConfComputeGpusReadyState(IsAcceptingWork *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetConfComputeGpusReadyState(IsAcceptingWork)
+}
+
+// nvmlDeviceGetConfComputeProtectedMemoryUsage calls cgo_nvmlDeviceGetConfComputeProtectedMemoryUsage through the call gate.
+func nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice nvmlDevice, Memory *Memory) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice, Memory)
+}
+
+// nvmlDeviceGetConfComputeGpuCertificate calls cgo_nvmlDeviceGetConfComputeGpuCertificate through the call gate.
+func nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice nvmlDevice, GpuCert *ConfComputeGpuCertificate) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice, GpuCert)
+}
+
+// nvmlDeviceGetConfComputeGpuAttestationReport calls cgo_nvmlDeviceGetConfComputeGpuAttestationReport through the call gate.
+func nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice nvmlDevice, GpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice, GpuAtstReport)
+}
+
+// nvmlSystemGetConfComputeKeyRotationThresholdInfo calls cgo_nvmlSystemGetConfComputeKeyRotationThresholdInfo through the call gate.
+func nvmlSystemGetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeGetKeyRotationThresholdInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo)
+}
+
+// nvmlDeviceSetConfComputeUnprotectedMemSize calls cgo_nvmlDeviceSetConfComputeUnprotectedMemSize through the call gate.
+func nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice nvmlDevice, SizeKiB uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice, SizeKiB)
+}
+
+// nvmlSystemSetConfComputeGpusReadyState calls cgo_nvmlSystemSetConfComputeGpusReadyState through the call gate.
+func nvmlSystemSetConfComputeGpusReadyState(IsAcceptingWork uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemSetConfComputeGpusReadyState(IsAcceptingWork)
+}
+
+// nvmlSystemSetConfComputeKeyRotationThresholdInfo calls cgo_nvmlSystemSetConfComputeKeyRotationThresholdInfo through the call gate.
+func nvmlSystemSetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeSetKeyRotationThresholdInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemSetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo)
+}
+
+// nvmlSystemGetConfComputeSettings calls cgo_nvmlSystemGetConfComputeSettings through the call gate.
+func nvmlSystemGetConfComputeSettings(Settings *SystemConfComputeSettings) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetConfComputeSettings(Settings)
+}
+
+// nvmlDeviceGetGspFirmwareVersion calls cgo_nvmlDeviceGetGspFirmwareVersion through the call gate.
+func nvmlDeviceGetGspFirmwareVersion(nvmlDevice nvmlDevice, Version *byte) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGspFirmwareVersion(nvmlDevice, Version)
+}
+
+// nvmlDeviceGetGspFirmwareMode calls cgo_nvmlDeviceGetGspFirmwareMode through the call gate.
+func nvmlDeviceGetGspFirmwareMode(nvmlDevice nvmlDevice, IsEnabled *uint32, DefaultMode *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGspFirmwareMode(nvmlDevice, IsEnabled, DefaultMode)
+}
+
+// nvmlDeviceGetSramEccErrorStatus calls cgo_nvmlDeviceGetSramEccErrorStatus through the call gate.
+func nvmlDeviceGetSramEccErrorStatus(nvmlDevice nvmlDevice, Status *EccSramErrorStatus) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSramEccErrorStatus(nvmlDevice, Status)
+}
+
+// nvmlDeviceSetPowerManagementLimit_v2 calls cgo_nvmlDeviceSetPowerManagementLimit_v2 through the call gate.
+func nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice nvmlDevice, PowerValue *PowerValue_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice, PowerValue)
+}
+
+// nvmlDeviceGetAccountingMode calls cgo_nvmlDeviceGetAccountingMode through the call gate.
+func nvmlDeviceGetAccountingMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAccountingMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceGetAccountingStats calls cgo_nvmlDeviceGetAccountingStats through the call gate.
+func nvmlDeviceGetAccountingStats(nvmlDevice nvmlDevice, Pid uint32, Stats *AccountingStats) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAccountingStats(nvmlDevice, Pid, Stats)
+}
+
+// nvmlDeviceGetAccountingPids calls cgo_nvmlDeviceGetAccountingPids through the call gate.
+func nvmlDeviceGetAccountingPids(nvmlDevice nvmlDevice, Count *uint32, Pids *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAccountingPids(nvmlDevice, Count, Pids)
+}
+
+// nvmlDeviceGetAccountingBufferSize calls cgo_nvmlDeviceGetAccountingBufferSize through the call gate.
+func nvmlDeviceGetAccountingBufferSize(nvmlDevice nvmlDevice, BufferSize *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAccountingBufferSize(nvmlDevice, BufferSize)
+}
+
+// nvmlDeviceGetRetiredPages calls cgo_nvmlDeviceGetRetiredPages through the call gate.
+func nvmlDeviceGetRetiredPages(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRetiredPages(nvmlDevice, Cause, PageCount, Addresses)
+}
+
+// nvmlDeviceGetRetiredPages_v2 calls cgo_nvmlDeviceGetRetiredPages_v2 through the call gate.
+func nvmlDeviceGetRetiredPages_v2(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64, Timestamps *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRetiredPages_v2(nvmlDevice, Cause, PageCount, Addresses, Timestamps)
+}
+
+// nvmlDeviceGetRetiredPagesPendingStatus calls cgo_nvmlDeviceGetRetiredPagesPendingStatus through the call gate.
+func nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice nvmlDevice, IsPending *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice, IsPending)
+}
+
+// nvmlDeviceGetRemappedRows calls cgo_nvmlDeviceGetRemappedRows through the call gate.
+func nvmlDeviceGetRemappedRows(nvmlDevice nvmlDevice, CorrRows *uint32, UncRows *uint32, IsPending *uint32, FailureOccurred *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRemappedRows(nvmlDevice, CorrRows, UncRows, IsPending, FailureOccurred)
+}
+
+// nvmlDeviceGetRowRemapperHistogram calls cgo_nvmlDeviceGetRowRemapperHistogram through the call gate.
+func nvmlDeviceGetRowRemapperHistogram(nvmlDevice nvmlDevice, Values *RowRemapperHistogramValues) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetRowRemapperHistogram(nvmlDevice, Values)
+}
+
+// nvmlDeviceGetArchitecture calls cgo_nvmlDeviceGetArchitecture through the call gate.
+func nvmlDeviceGetArchitecture(nvmlDevice nvmlDevice, Arch *DeviceArchitecture) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetArchitecture(nvmlDevice, Arch)
+}
+
+// nvmlDeviceGetClkMonStatus calls cgo_nvmlDeviceGetClkMonStatus through the call gate.
+func nvmlDeviceGetClkMonStatus(nvmlDevice nvmlDevice, Status *ClkMonStatus) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetClkMonStatus(nvmlDevice, Status)
+}
+
+// nvmlDeviceGetProcessUtilization calls cgo_nvmlDeviceGetProcessUtilization through the call gate.
+func nvmlDeviceGetProcessUtilization(nvmlDevice nvmlDevice, Utilization *ProcessUtilizationSample, ProcessSamplesCount *uint32, LastSeenTimeStamp uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetProcessUtilization(nvmlDevice, Utilization, ProcessSamplesCount, LastSeenTimeStamp)
+}
+
+// nvmlDeviceGetProcessesUtilizationInfo calls cgo_nvmlDeviceGetProcessesUtilizationInfo through the call gate.
+func nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice nvmlDevice, ProcesesUtilInfo *ProcessesUtilizationInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice, ProcesesUtilInfo)
+}
+
+// nvmlDeviceGetPlatformInfo calls cgo_nvmlDeviceGetPlatformInfo through the call gate.
+func nvmlDeviceGetPlatformInfo(nvmlDevice nvmlDevice, PlatformInfo *PlatformInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPlatformInfo(nvmlDevice, PlatformInfo)
+}
+
+// nvmlDeviceGetPdi calls cgo_nvmlDeviceGetPdi through the call gate.
+func nvmlDeviceGetPdi(nvmlDevice nvmlDevice, Pdi *Pdi) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPdi(nvmlDevice, Pdi)
+}
+
+// nvmlUnitSetLedState calls cgo_nvmlUnitSetLedState through the call gate.
+func nvmlUnitSetLedState(nvmlUnit nvmlUnit, Color LedColor) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlUnitSetLedState(nvmlUnit, Color)
+}
+
+// nvmlDeviceSetPersistenceMode calls cgo_nvmlDeviceSetPersistenceMode through the call gate.
+func nvmlDeviceSetPersistenceMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetPersistenceMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceSetComputeMode calls cgo_nvmlDeviceSetComputeMode through the call gate.
+func nvmlDeviceSetComputeMode(nvmlDevice nvmlDevice, Mode ComputeMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetComputeMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceSetEccMode calls cgo_nvmlDeviceSetEccMode through the call gate.
+func nvmlDeviceSetEccMode(nvmlDevice nvmlDevice, Ecc EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetEccMode(nvmlDevice, Ecc)
+}
+
+// nvmlDeviceClearEccErrorCounts calls cgo_nvmlDeviceClearEccErrorCounts through the call gate.
+func nvmlDeviceClearEccErrorCounts(nvmlDevice nvmlDevice, CounterType EccCounterType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceClearEccErrorCounts(nvmlDevice, CounterType)
+}
+
+// nvmlDeviceSetDriverModel calls cgo_nvmlDeviceSetDriverModel through the call gate.
+func nvmlDeviceSetDriverModel(nvmlDevice nvmlDevice, DriverModel DriverModel, Flags uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetDriverModel(nvmlDevice, DriverModel, Flags)
+}
+
+// nvmlDeviceSetGpuLockedClocks calls cgo_nvmlDeviceSetGpuLockedClocks through the call gate.
+func nvmlDeviceSetGpuLockedClocks(nvmlDevice nvmlDevice, MinGpuClockMHz uint32, MaxGpuClockMHz uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetGpuLockedClocks(nvmlDevice, MinGpuClockMHz, MaxGpuClockMHz)
+}
+
+// nvmlDeviceResetGpuLockedClocks calls cgo_nvmlDeviceResetGpuLockedClocks through the call gate.
+func nvmlDeviceResetGpuLockedClocks(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceResetGpuLockedClocks(nvmlDevice)
+}
+
+// nvmlDeviceSetMemoryLockedClocks calls cgo_nvmlDeviceSetMemoryLockedClocks through the call gate.
+func nvmlDeviceSetMemoryLockedClocks(nvmlDevice nvmlDevice, MinMemClockMHz uint32, MaxMemClockMHz uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetMemoryLockedClocks(nvmlDevice, MinMemClockMHz, MaxMemClockMHz)
+}
+
+// nvmlDeviceResetMemoryLockedClocks calls cgo_nvmlDeviceResetMemoryLockedClocks through the call gate.
+func nvmlDeviceResetMemoryLockedClocks(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceResetMemoryLockedClocks(nvmlDevice)
+}
+
+// nvmlDeviceSetApplicationsClocks calls cgo_nvmlDeviceSetApplicationsClocks through the call gate.
+func nvmlDeviceSetApplicationsClocks(nvmlDevice nvmlDevice, MemClockMHz uint32, GraphicsClockMHz uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetApplicationsClocks(nvmlDevice, MemClockMHz, GraphicsClockMHz)
+}
+
+// nvmlDeviceResetApplicationsClocks calls cgo_nvmlDeviceResetApplicationsClocks through the call gate.
+func nvmlDeviceResetApplicationsClocks(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceResetApplicationsClocks(nvmlDevice)
+}
+
+// nvmlDeviceSetAutoBoostedClocksEnabled calls cgo_nvmlDeviceSetAutoBoostedClocksEnabled through the call gate.
+func nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice, Enabled)
+}
+
+// nvmlDeviceSetDefaultAutoBoostedClocksEnabled calls cgo_nvmlDeviceSetDefaultAutoBoostedClocksEnabled through the call gate.
+func nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState, Flags uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice, Enabled, Flags)
+}
+
+// nvmlDeviceSetDefaultFanSpeed_v2 calls cgo_nvmlDeviceSetDefaultFanSpeed_v2 through the call gate.
+func nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice, Fan)
+}
+
+// nvmlDeviceSetFanControlPolicy calls cgo_nvmlDeviceSetFanControlPolicy through the call gate.
+func nvmlDeviceSetFanControlPolicy(nvmlDevice nvmlDevice, Fan uint32, Policy FanControlPolicy) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetFanControlPolicy(nvmlDevice, Fan, Policy)
+}
+
+// nvmlDeviceSetTemperatureThreshold calls cgo_nvmlDeviceSetTemperatureThreshold through the call gate.
+func nvmlDeviceSetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetTemperatureThreshold(nvmlDevice, ThresholdType, Temp)
+}
+
+// nvmlDeviceSetPowerManagementLimit calls cgo_nvmlDeviceSetPowerManagementLimit through the call gate.
+func nvmlDeviceSetPowerManagementLimit(nvmlDevice nvmlDevice, Limit uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetPowerManagementLimit(nvmlDevice, Limit)
+}
+
+// nvmlDeviceSetGpuOperationMode calls cgo_nvmlDeviceSetGpuOperationMode through the call gate.
+func nvmlDeviceSetGpuOperationMode(nvmlDevice nvmlDevice, Mode GpuOperationMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetGpuOperationMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceSetAPIRestriction calls cgo_nvmlDeviceSetAPIRestriction through the call gate.
+func nvmlDeviceSetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetAPIRestriction(nvmlDevice, ApiType, IsRestricted)
+}
+
+// nvmlDeviceSetFanSpeed_v2 calls cgo_nvmlDeviceSetFanSpeed_v2 through the call gate.
+func nvmlDeviceSetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetFanSpeed_v2(nvmlDevice, Fan, Speed)
+}
+
+// nvmlDeviceSetGpcClkVfOffset calls cgo_nvmlDeviceSetGpcClkVfOffset through the call gate.
+func nvmlDeviceSetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetGpcClkVfOffset(nvmlDevice, Offset)
+}
+
+// nvmlDeviceSetMemClkVfOffset calls cgo_nvmlDeviceSetMemClkVfOffset through the call gate.
+func nvmlDeviceSetMemClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetMemClkVfOffset(nvmlDevice, Offset)
+}
+
+// nvmlDeviceSetAccountingMode calls cgo_nvmlDeviceSetAccountingMode through the call gate.
+func nvmlDeviceSetAccountingMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetAccountingMode(nvmlDevice, Mode)
+}
+
+// nvmlDeviceClearAccountingPids calls cgo_nvmlDeviceClearAccountingPids through the call gate.
+func nvmlDeviceClearAccountingPids(nvmlDevice nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceClearAccountingPids(nvmlDevice)
+}
+
+// nvmlDeviceGetNvLinkState calls cgo_nvmlDeviceGetNvLinkState through the call gate.
+func nvmlDeviceGetNvLinkState(nvmlDevice nvmlDevice, Link uint32, IsActive *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkState(nvmlDevice, Link, IsActive)
+}
+
+// nvmlDeviceGetNvLinkVersion calls cgo_nvmlDeviceGetNvLinkVersion through the call gate.
+func nvmlDeviceGetNvLinkVersion(nvmlDevice nvmlDevice, Link uint32, Version *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkVersion(nvmlDevice, Link, Version)
+}
+
+// nvmlDeviceGetNvLinkCapability calls cgo_nvmlDeviceGetNvLinkCapability through the call gate.
+func nvmlDeviceGetNvLinkCapability(nvmlDevice nvmlDevice, Link uint32, Capability NvLinkCapability, CapResult *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkCapability(nvmlDevice, Link, Capability, CapResult)
+}
+
+// nvmlDeviceGetNvLinkRemotePciInfo_v2 calls cgo_nvmlDeviceGetNvLinkRemotePciInfo_v2 through the call gate.
+func nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice, Link, Pci)
+}
+
+// nvmlDeviceGetNvLinkErrorCounter calls cgo_nvmlDeviceGetNvLinkErrorCounter through the call gate.
+func nvmlDeviceGetNvLinkErrorCounter(nvmlDevice nvmlDevice, Link uint32, Counter NvLinkErrorCounter, CounterValue *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkErrorCounter(nvmlDevice, Link, Counter, CounterValue)
+}
+
+// nvmlDeviceResetNvLinkErrorCounters calls cgo_nvmlDeviceResetNvLinkErrorCounters through the call gate.
+func nvmlDeviceResetNvLinkErrorCounters(nvmlDevice nvmlDevice, Link uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceResetNvLinkErrorCounters(nvmlDevice, Link)
+}
+
+// nvmlDeviceSetNvLinkUtilizationControl calls cgo_nvmlDeviceSetNvLinkUtilizationControl through the call gate.
+func nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl, Reset uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice, Link, Counter, Control, Reset)
+}
+
+// nvmlDeviceGetNvLinkUtilizationControl calls cgo_nvmlDeviceGetNvLinkUtilizationControl through the call gate.
+func nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice, Link, Counter, Control)
+}
+
+// nvmlDeviceGetNvLinkUtilizationCounter calls cgo_nvmlDeviceGetNvLinkUtilizationCounter through the call gate.
+func nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Rxcounter *uint64, Txcounter *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice, Link, Counter, Rxcounter, Txcounter)
+}
+
+// nvmlDeviceFreezeNvLinkUtilizationCounter calls cgo_nvmlDeviceFreezeNvLinkUtilizationCounter through the call gate.
+func nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Freeze EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice, Link, Counter, Freeze)
+}
+
+// nvmlDeviceResetNvLinkUtilizationCounter calls cgo_nvmlDeviceResetNvLinkUtilizationCounter through the call gate.
+func nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice, Link, Counter)
+}
+
+// nvmlDeviceGetNvLinkRemoteDeviceType calls cgo_nvmlDeviceGetNvLinkRemoteDeviceType through the call gate.
+func nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice nvmlDevice, Link uint32, PNvLinkDeviceType *IntNvLinkDeviceType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice, Link, PNvLinkDeviceType)
+}
+
+// nvmlDeviceSetNvLinkDeviceLowPowerThreshold calls cgo_nvmlDeviceSetNvLinkDeviceLowPowerThreshold through the call gate.
+func nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice nvmlDevice, Info *NvLinkPowerThres) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice, Info)
+}
+
+// nvmlSystemSetNvlinkBwMode calls cgo_nvmlSystemSetNvlinkBwMode through the call gate.
+func nvmlSystemSetNvlinkBwMode(NvlinkBwMode uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemSetNvlinkBwMode(NvlinkBwMode)
+}
+
+// nvmlSystemGetNvlinkBwMode calls cgo_nvmlSystemGetNvlinkBwMode through the call gate.
+func nvmlSystemGetNvlinkBwMode(NvlinkBwMode *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemGetNvlinkBwMode(NvlinkBwMode)
+}
+
+// nvmlDeviceGetNvlinkSupportedBwModes calls cgo_nvmlDeviceGetNvlinkSupportedBwModes through the call gate.
+func nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice nvmlDevice, SupportedBwMode *NvlinkSupportedBwModes) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice, SupportedBwMode)
+}
+
+// nvmlDeviceGetNvlinkBwMode calls cgo_nvmlDeviceGetNvlinkBwMode through the call gate.
+func nvmlDeviceGetNvlinkBwMode(nvmlDevice nvmlDevice, GetBwMode *NvlinkGetBwMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvlinkBwMode(nvmlDevice, GetBwMode)
+}
+
+// nvmlDeviceSetNvlinkBwMode calls cgo_nvmlDeviceSetNvlinkBwMode through the call gate.
+func nvmlDeviceSetNvlinkBwMode(nvmlDevice nvmlDevice, SetBwMode *NvlinkSetBwMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetNvlinkBwMode(nvmlDevice, SetBwMode)
+}
+
+// nvmlDeviceGetNvLinkInfo calls cgo_nvmlDeviceGetNvLinkInfo through the call gate.
+func nvmlDeviceGetNvLinkInfo(nvmlDevice nvmlDevice, Info *NvLinkInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkInfo(nvmlDevice, Info)
+}
+
+// nvmlEventSetCreate calls cgo_nvmlEventSetCreate through the call gate.
+func nvmlEventSetCreate(Set *nvmlEventSet) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlEventSetCreate(Set)
+}
+
+// nvmlDeviceRegisterEvents calls cgo_nvmlDeviceRegisterEvents through the call gate.
+func nvmlDeviceRegisterEvents(nvmlDevice nvmlDevice, EventTypes uint64, Set nvmlEventSet) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceRegisterEvents(nvmlDevice, EventTypes, Set)
+}
+
+// nvmlDeviceGetSupportedEventTypes calls cgo_nvmlDeviceGetSupportedEventTypes through the call gate.
+func nvmlDeviceGetSupportedEventTypes(nvmlDevice nvmlDevice, EventTypes *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedEventTypes(nvmlDevice, EventTypes)
+}
+
+// nvmlEventSetWait_v2 calls cgo_nvmlEventSetWait_v2 through the call gate.
+func nvmlEventSetWait_v2(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlEventSetWait_v2(Set, Data, Timeoutms)
+}
+
+// nvmlEventSetFree calls cgo_nvmlEventSetFree through the call gate.
+func nvmlEventSetFree(Set nvmlEventSet) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlEventSetFree(Set)
+}
+
+// nvmlSystemEventSetCreate calls cgo_nvmlSystemEventSetCreate through the call gate.
+func nvmlSystemEventSetCreate(Request *SystemEventSetCreateRequest) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemEventSetCreate(Request)
+}
+
+// nvmlSystemEventSetFree calls cgo_nvmlSystemEventSetFree through the call gate.
+func nvmlSystemEventSetFree(Request *SystemEventSetFreeRequest) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemEventSetFree(Request)
+}
+
+// nvmlSystemRegisterEvents calls cgo_nvmlSystemRegisterEvents through the call gate.
+func nvmlSystemRegisterEvents(Request *SystemRegisterEventRequest) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemRegisterEvents(Request)
+}
+
+// nvmlSystemEventSetWait calls cgo_nvmlSystemEventSetWait through the call gate.
+func nvmlSystemEventSetWait(Request *SystemEventSetWaitRequest) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSystemEventSetWait(Request)
+}
+
+// nvmlDeviceModifyDrainState calls cgo_nvmlDeviceModifyDrainState through the call gate.
+func nvmlDeviceModifyDrainState(PciInfo *PciInfo, NewState EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceModifyDrainState(PciInfo, NewState)
+}
+
+// nvmlDeviceQueryDrainState calls cgo_nvmlDeviceQueryDrainState through the call gate.
+func nvmlDeviceQueryDrainState(PciInfo *PciInfo, CurrentState *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceQueryDrainState(PciInfo, CurrentState)
+}
+
+// nvmlDeviceRemoveGpu_v2 calls cgo_nvmlDeviceRemoveGpu_v2 through the call gate.
+func nvmlDeviceRemoveGpu_v2(PciInfo *PciInfo, GpuState DetachGpuState, LinkState PcieLinkState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceRemoveGpu_v2(PciInfo, GpuState, LinkState)
+}
+
+// nvmlDeviceDiscoverGpus calls cgo_nvmlDeviceDiscoverGpus through the call gate.
+func nvmlDeviceDiscoverGpus(PciInfo *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceDiscoverGpus(PciInfo)
+}
+
+// nvmlDeviceGetFieldValues calls cgo_nvmlDeviceGetFieldValues through the call gate.
+func nvmlDeviceGetFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetFieldValues(nvmlDevice, ValuesCount, Values)
+}
+
+// nvmlDeviceClearFieldValues calls cgo_nvmlDeviceClearFieldValues through the call gate.
+func nvmlDeviceClearFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceClearFieldValues(nvmlDevice, ValuesCount, Values)
+}
+
+// nvmlDeviceGetVirtualizationMode calls cgo_nvmlDeviceGetVirtualizationMode through the call gate.
+func nvmlDeviceGetVirtualizationMode(nvmlDevice nvmlDevice, PVirtualMode *GpuVirtualizationMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVirtualizationMode(nvmlDevice, PVirtualMode)
+}
+
+// nvmlDeviceGetHostVgpuMode calls cgo_nvmlDeviceGetHostVgpuMode through the call gate.
+func nvmlDeviceGetHostVgpuMode(nvmlDevice nvmlDevice, PHostVgpuMode *HostVgpuMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHostVgpuMode(nvmlDevice, PHostVgpuMode)
+}
+
+// nvmlDeviceSetVirtualizationMode calls cgo_nvmlDeviceSetVirtualizationMode through the call gate.
+func nvmlDeviceSetVirtualizationMode(nvmlDevice nvmlDevice, VirtualMode GpuVirtualizationMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetVirtualizationMode(nvmlDevice, VirtualMode)
+}
+
+// nvmlDeviceGetVgpuHeterogeneousMode calls cgo_nvmlDeviceGetVgpuHeterogeneousMode through the call gate.
+func nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice, PHeterogeneousMode)
+}
+
+// nvmlDeviceSetVgpuHeterogeneousMode calls cgo_nvmlDeviceSetVgpuHeterogeneousMode through the call gate.
+func nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice, PHeterogeneousMode)
+}
+
+// nvmlVgpuInstanceGetPlacementId calls cgo_nvmlVgpuInstanceGetPlacementId through the call gate.
+func nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance nvmlVgpuInstance, PPlacement *VgpuPlacementId) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance, PPlacement)
+}
+
+// nvmlDeviceGetVgpuTypeSupportedPlacements calls cgo_nvmlDeviceGetVgpuTypeSupportedPlacements through the call gate.
+func nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice, nvmlVgpuTypeId, PPlacementList)
+}
+
+// nvmlDeviceGetVgpuTypeCreatablePlacements calls cgo_nvmlDeviceGetVgpuTypeCreatablePlacements through the call gate.
+func nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice, nvmlVgpuTypeId, PPlacementList)
+}
+
+// nvmlVgpuTypeGetGspHeapSize calls cgo_nvmlVgpuTypeGetGspHeapSize through the call gate.
+func nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId nvmlVgpuTypeId, GspHeapSize *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId, GspHeapSize)
+}
+
+// nvmlVgpuTypeGetFbReservation calls cgo_nvmlVgpuTypeGetFbReservation through the call gate.
+func nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId nvmlVgpuTypeId, FbReservation *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId, FbReservation)
+}
+
+// nvmlVgpuInstanceGetRuntimeStateSize calls cgo_nvmlVgpuInstanceGetRuntimeStateSize through the call gate.
+func nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance nvmlVgpuInstance, PState *VgpuRuntimeState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance, PState)
+}
+
+// nvmlDeviceSetVgpuCapabilities calls cgo_nvmlDeviceSetVgpuCapabilities through the call gate.
+func nvmlDeviceSetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, State EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetVgpuCapabilities(nvmlDevice, Capability, State)
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v4 calls cgo_nvmlDeviceGetGridLicensableFeatures_v4 through the call gate.
+func nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice, PGridLicensableFeatures)
+}
+
+// nvmlGetVgpuDriverCapabilities calls cgo_nvmlGetVgpuDriverCapabilities through the call gate.
+func nvmlGetVgpuDriverCapabilities(Capability VgpuDriverCapability, CapResult *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGetVgpuDriverCapabilities(Capability, CapResult)
+}
+
+// nvmlDeviceGetVgpuCapabilities calls cgo_nvmlDeviceGetVgpuCapabilities through the call gate.
+func nvmlDeviceGetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, CapResult *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuCapabilities(nvmlDevice, Capability, CapResult)
+}
+
+// nvmlDeviceGetSupportedVgpus calls cgo_nvmlDeviceGetSupportedVgpus through the call gate.
+func nvmlDeviceGetSupportedVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSupportedVgpus(nvmlDevice, VgpuCount, VgpuTypeIds)
+}
+
+// nvmlDeviceGetCreatableVgpus calls cgo_nvmlDeviceGetCreatableVgpus through the call gate.
+func nvmlDeviceGetCreatableVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCreatableVgpus(nvmlDevice, VgpuCount, VgpuTypeIds)
+}
+
+// nvmlVgpuTypeGetClass calls cgo_nvmlVgpuTypeGetClass through the call gate.
+func nvmlVgpuTypeGetClass(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeClass *byte, Size *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetClass(nvmlVgpuTypeId, VgpuTypeClass, Size)
+}
+
+// nvmlVgpuTypeGetName calls cgo_nvmlVgpuTypeGetName through the call gate.
+func nvmlVgpuTypeGetName(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeName *byte, Size *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetName(nvmlVgpuTypeId, VgpuTypeName, Size)
+}
+
+// nvmlVgpuTypeGetGpuInstanceProfileId calls cgo_nvmlVgpuTypeGetGpuInstanceProfileId through the call gate.
+func nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId nvmlVgpuTypeId, GpuInstanceProfileId *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId, GpuInstanceProfileId)
+}
+
+// nvmlVgpuTypeGetDeviceID calls cgo_nvmlVgpuTypeGetDeviceID through the call gate.
+func nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId nvmlVgpuTypeId, DeviceID *uint64, SubsystemID *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId, DeviceID, SubsystemID)
+}
+
+// nvmlVgpuTypeGetFramebufferSize calls cgo_nvmlVgpuTypeGetFramebufferSize through the call gate.
+func nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId nvmlVgpuTypeId, FbSize *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId, FbSize)
+}
+
+// nvmlVgpuTypeGetNumDisplayHeads calls cgo_nvmlVgpuTypeGetNumDisplayHeads through the call gate.
+func nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId nvmlVgpuTypeId, NumDisplayHeads *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId, NumDisplayHeads)
+}
+
+// nvmlVgpuTypeGetResolution calls cgo_nvmlVgpuTypeGetResolution through the call gate.
+func nvmlVgpuTypeGetResolution(nvmlVgpuTypeId nvmlVgpuTypeId, DisplayIndex uint32, Xdim *uint32, Ydim *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetResolution(nvmlVgpuTypeId, DisplayIndex, Xdim, Ydim)
+}
+
+// nvmlVgpuTypeGetLicense calls cgo_nvmlVgpuTypeGetLicense through the call gate.
+func nvmlVgpuTypeGetLicense(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeLicenseString *byte, Size uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetLicense(nvmlVgpuTypeId, VgpuTypeLicenseString, Size)
+}
+
+// nvmlVgpuTypeGetFrameRateLimit calls cgo_nvmlVgpuTypeGetFrameRateLimit through the call gate.
+func nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId nvmlVgpuTypeId, FrameRateLimit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId, FrameRateLimit)
+}
+
+// nvmlVgpuTypeGetMaxInstances calls cgo_nvmlVgpuTypeGetMaxInstances through the call gate.
+func nvmlVgpuTypeGetMaxInstances(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCount *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetMaxInstances(nvmlDevice, nvmlVgpuTypeId, VgpuInstanceCount)
+}
+
+// nvmlVgpuTypeGetMaxInstancesPerVm calls cgo_nvmlVgpuTypeGetMaxInstancesPerVm through the call gate.
+func nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCountPerVm *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId, VgpuInstanceCountPerVm)
+}
+
+// nvmlVgpuTypeGetBAR1Info calls cgo_nvmlVgpuTypeGetBAR1Info through the call gate.
+func nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId nvmlVgpuTypeId, Bar1Info *VgpuTypeBar1Info) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId, Bar1Info)
+}
+
+// nvmlDeviceGetActiveVgpus calls cgo_nvmlDeviceGetActiveVgpus through the call gate.
+func nvmlDeviceGetActiveVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuInstances *nvmlVgpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetActiveVgpus(nvmlDevice, VgpuCount, VgpuInstances)
+}
+
+// nvmlVgpuInstanceGetVmID calls cgo_nvmlVgpuInstanceGetVmID through the call gate.
+func nvmlVgpuInstanceGetVmID(nvmlVgpuInstance nvmlVgpuInstance, VmId *byte, Size uint32, VmIdType *VgpuVmIdType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetVmID(nvmlVgpuInstance, VmId, Size, VmIdType)
+}
+
+// nvmlVgpuInstanceGetUUID calls cgo_nvmlVgpuInstanceGetUUID through the call gate.
+func nvmlVgpuInstanceGetUUID(nvmlVgpuInstance nvmlVgpuInstance, Uuid *byte, Size uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetUUID(nvmlVgpuInstance, Uuid, Size)
+}
+
+// nvmlVgpuInstanceGetVmDriverVersion calls cgo_nvmlVgpuInstanceGetVmDriverVersion through the call gate.
+func nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance nvmlVgpuInstance, Version *byte, Length uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance, Version, Length)
+}
+
+// nvmlVgpuInstanceGetFbUsage calls cgo_nvmlVgpuInstanceGetFbUsage through the call gate.
+func nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance nvmlVgpuInstance, FbUsage *uint64) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance, FbUsage)
+}
+
+// nvmlVgpuInstanceGetLicenseStatus calls cgo_nvmlVgpuInstanceGetLicenseStatus through the call gate.
+func nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance nvmlVgpuInstance, Licensed *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance, Licensed)
+}
+
+// nvmlVgpuInstanceGetType calls cgo_nvmlVgpuInstanceGetType through the call gate.
+func nvmlVgpuInstanceGetType(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuTypeId *nvmlVgpuTypeId) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetType(nvmlVgpuInstance, nvmlVgpuTypeId)
+}
+
+// nvmlVgpuInstanceGetFrameRateLimit calls cgo_nvmlVgpuInstanceGetFrameRateLimit through the call gate.
+func nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance nvmlVgpuInstance, FrameRateLimit *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance, FrameRateLimit)
+}
+
+// nvmlVgpuInstanceGetEccMode calls cgo_nvmlVgpuInstanceGetEccMode through the call gate.
+func nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance nvmlVgpuInstance, EccMode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance, EccMode)
+}
+
+// nvmlVgpuInstanceGetEncoderCapacity calls cgo_nvmlVgpuInstanceGetEncoderCapacity through the call gate.
+func nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance, EncoderCapacity)
+}
+
+// nvmlVgpuInstanceSetEncoderCapacity calls cgo_nvmlVgpuInstanceSetEncoderCapacity through the call gate.
+func nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance, EncoderCapacity)
+}
+
+// nvmlVgpuInstanceGetEncoderStats calls cgo_nvmlVgpuInstanceGetEncoderStats through the call gate.
+func nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance, SessionCount, AverageFps, AverageLatency)
+}
+
+// nvmlVgpuInstanceGetEncoderSessions calls cgo_nvmlVgpuInstanceGetEncoderSessions through the call gate.
+func nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *EncoderSessionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance, SessionCount, SessionInfo)
+}
+
+// nvmlVgpuInstanceGetFBCStats calls cgo_nvmlVgpuInstanceGetFBCStats through the call gate.
+func nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance nvmlVgpuInstance, FbcStats *FBCStats) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance, FbcStats)
+}
+
+// nvmlVgpuInstanceGetFBCSessions calls cgo_nvmlVgpuInstanceGetFBCSessions through the call gate.
+func nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance, SessionCount, SessionInfo)
+}
+
+// nvmlVgpuInstanceGetGpuInstanceId calls cgo_nvmlVgpuInstanceGetGpuInstanceId through the call gate.
+func nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance nvmlVgpuInstance, GpuInstanceId *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance, GpuInstanceId)
+}
+
+// nvmlVgpuInstanceGetGpuPciId calls cgo_nvmlVgpuInstanceGetGpuPciId through the call gate.
+func nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance nvmlVgpuInstance, VgpuPciId *byte, Length *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance, VgpuPciId, Length)
+}
+
+// nvmlVgpuTypeGetCapabilities calls cgo_nvmlVgpuTypeGetCapabilities through the call gate.
+func nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId nvmlVgpuTypeId, Capability VgpuCapability, CapResult *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId, Capability, CapResult)
+}
+
+// nvmlVgpuInstanceGetMdevUUID calls cgo_nvmlVgpuInstanceGetMdevUUID through the call gate.
+func nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance nvmlVgpuInstance, MdevUuid *byte, Size uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance, MdevUuid, Size)
+}
+
+// nvmlGpuInstanceGetCreatableVgpus calls cgo_nvmlGpuInstanceGetCreatableVgpus through the call gate.
+func nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpus *VgpuTypeIdInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance, PVgpus)
+}
+
+// nvmlVgpuTypeGetMaxInstancesPerGpuInstance calls cgo_nvmlVgpuTypeGetMaxInstancesPerGpuInstance through the call gate.
+func nvmlVgpuTypeGetMaxInstancesPerGpuInstance(PMaxInstance *VgpuTypeMaxInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuTypeGetMaxInstancesPerGpuInstance(PMaxInstance)
+}
+
+// nvmlGpuInstanceGetActiveVgpus calls cgo_nvmlGpuInstanceGetActiveVgpus through the call gate.
+func nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpuInstanceInfo *ActiveVgpuInstanceInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance, PVgpuInstanceInfo)
+}
+
+// nvmlGpuInstanceSetVgpuSchedulerState calls cgo_nvmlGpuInstanceSetVgpuSchedulerState through the call gate.
+func nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PScheduler *VgpuSchedulerState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance, PScheduler)
+}
+
+// nvmlGpuInstanceGetVgpuSchedulerState calls cgo_nvmlGpuInstanceGetVgpuSchedulerState through the call gate.
+func nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSchedulerStateInfo *VgpuSchedulerStateInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance, PSchedulerStateInfo)
+}
+
+// nvmlGpuInstanceGetVgpuSchedulerLog calls cgo_nvmlGpuInstanceGetVgpuSchedulerLog through the call gate.
+func nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance nvmlGpuInstance, PSchedulerLogInfo *VgpuSchedulerLogInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance, PSchedulerLogInfo)
+}
+
+// nvmlGpuInstanceGetVgpuTypeCreatablePlacements calls cgo_nvmlGpuInstanceGetVgpuTypeCreatablePlacements through the call gate.
+func nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance nvmlGpuInstance, PCreatablePlacementInfo *VgpuCreatablePlacementInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance, PCreatablePlacementInfo)
+}
+
+// nvmlGpuInstanceGetVgpuHeterogeneousMode calls cgo_nvmlGpuInstanceGetVgpuHeterogeneousMode through the call gate.
+func nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance, PHeterogeneousMode)
+}
+
+// nvmlGpuInstanceSetVgpuHeterogeneousMode calls cgo_nvmlGpuInstanceSetVgpuHeterogeneousMode through the call gate.
+func nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance, PHeterogeneousMode)
+}
+
+// nvmlVgpuInstanceGetMetadata calls cgo_nvmlVgpuInstanceGetMetadata through the call gate.
+func nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuMetadata *nvmlVgpuMetadata, BufferSize *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance, nvmlVgpuMetadata, BufferSize)
+}
+
+// nvmlDeviceGetVgpuMetadata calls cgo_nvmlDeviceGetVgpuMetadata through the call gate.
+func nvmlDeviceGetVgpuMetadata(nvmlDevice nvmlDevice, PgpuMetadata *nvmlVgpuPgpuMetadata, BufferSize *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuMetadata(nvmlDevice, PgpuMetadata, BufferSize)
+}
+
+// nvmlGetVgpuCompatibility calls cgo_nvmlGetVgpuCompatibility through the call gate.
+func nvmlGetVgpuCompatibility(nvmlVgpuMetadata *nvmlVgpuMetadata, PgpuMetadata *nvmlVgpuPgpuMetadata, CompatibilityInfo *VgpuPgpuCompatibility) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGetVgpuCompatibility(nvmlVgpuMetadata, PgpuMetadata, CompatibilityInfo)
+}
+
+// nvmlDeviceGetPgpuMetadataString calls cgo_nvmlDeviceGetPgpuMetadataString through the call gate.
+func nvmlDeviceGetPgpuMetadataString(nvmlDevice nvmlDevice, PgpuMetadata *byte, BufferSize *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPgpuMetadataString(nvmlDevice, PgpuMetadata, BufferSize)
+}
+
+// nvmlDeviceGetVgpuSchedulerLog calls cgo_nvmlDeviceGetVgpuSchedulerLog through the call gate.
+func nvmlDeviceGetVgpuSchedulerLog(nvmlDevice nvmlDevice, PSchedulerLog *VgpuSchedulerLog) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuSchedulerLog(nvmlDevice, PSchedulerLog)
+}
+
+// nvmlDeviceGetVgpuSchedulerState calls cgo_nvmlDeviceGetVgpuSchedulerState through the call gate.
+func nvmlDeviceGetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerGetState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuSchedulerState(nvmlDevice, PSchedulerState)
+}
+
+// nvmlDeviceGetVgpuSchedulerCapabilities calls cgo_nvmlDeviceGetVgpuSchedulerCapabilities through the call gate.
+func nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice nvmlDevice, PCapabilities *VgpuSchedulerCapabilities) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice, PCapabilities)
+}
+
+// nvmlDeviceSetVgpuSchedulerState calls cgo_nvmlDeviceSetVgpuSchedulerState through the call gate.
+func nvmlDeviceSetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerSetState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetVgpuSchedulerState(nvmlDevice, PSchedulerState)
+}
+
+// nvmlGetVgpuVersion calls cgo_nvmlGetVgpuVersion through the call gate.
+func nvmlGetVgpuVersion(Supported *VgpuVersion, Current *VgpuVersion) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGetVgpuVersion(Supported, Current)
+}
+
+// nvmlSetVgpuVersion calls cgo_nvmlSetVgpuVersion through the call gate.
+func nvmlSetVgpuVersion(VgpuVersion *VgpuVersion) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlSetVgpuVersion(VgpuVersion)
+}
+
+// nvmlDeviceGetVgpuUtilization calls cgo_nvmlDeviceGetVgpuUtilization through the call gate.
+func nvmlDeviceGetVgpuUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, SampleValType *ValueType, VgpuInstanceSamplesCount *uint32, UtilizationSamples *VgpuInstanceUtilizationSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuUtilization(nvmlDevice, LastSeenTimeStamp, SampleValType, VgpuInstanceSamplesCount, UtilizationSamples)
+}
+
+// nvmlDeviceGetVgpuInstancesUtilizationInfo calls cgo_nvmlDeviceGetVgpuInstancesUtilizationInfo through the call gate.
+func nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice nvmlDevice, VgpuUtilInfo *VgpuInstancesUtilizationInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice, VgpuUtilInfo)
+}
+
+// nvmlDeviceGetVgpuProcessUtilization calls cgo_nvmlDeviceGetVgpuProcessUtilization through the call gate.
+func nvmlDeviceGetVgpuProcessUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, VgpuProcessSamplesCount *uint32, UtilizationSamples *VgpuProcessUtilizationSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuProcessUtilization(nvmlDevice, LastSeenTimeStamp, VgpuProcessSamplesCount, UtilizationSamples)
+}
+
+// nvmlDeviceGetVgpuProcessesUtilizationInfo calls cgo_nvmlDeviceGetVgpuProcessesUtilizationInfo through the call gate.
+func nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice nvmlDevice, VgpuProcUtilInfo *VgpuProcessesUtilizationInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice, VgpuProcUtilInfo)
+}
+
+// nvmlVgpuInstanceGetAccountingMode calls cgo_nvmlVgpuInstanceGetAccountingMode through the call gate.
+func nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance nvmlVgpuInstance, Mode *EnableState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance, Mode)
+}
+
+// nvmlVgpuInstanceGetAccountingPids calls cgo_nvmlVgpuInstanceGetAccountingPids through the call gate.
+func nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance nvmlVgpuInstance, Count *uint32, Pids *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance, Count, Pids)
+}
+
+// nvmlVgpuInstanceGetAccountingStats calls cgo_nvmlVgpuInstanceGetAccountingStats through the call gate.
+func nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance nvmlVgpuInstance, Pid uint32, Stats *AccountingStats) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance, Pid, Stats)
+}
+
+// nvmlVgpuInstanceClearAccountingPids calls cgo_nvmlVgpuInstanceClearAccountingPids through the call gate.
+func nvmlVgpuInstanceClearAccountingPids(nvmlVgpuInstance nvmlVgpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceClearAccountingPids(nvmlVgpuInstance)
+}
+
+// nvmlVgpuInstanceGetLicenseInfo_v2 calls cgo_nvmlVgpuInstanceGetLicenseInfo_v2 through the call gate.
+func nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance, LicenseInfo)
+}
+
+// nvmlGetExcludedDeviceCount calls cgo_nvmlGetExcludedDeviceCount through the call gate.
+func nvmlGetExcludedDeviceCount(DeviceCount *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGetExcludedDeviceCount(DeviceCount)
+}
+
+// nvmlGetExcludedDeviceInfoByIndex calls cgo_nvmlGetExcludedDeviceInfoByIndex through the call gate.
+func nvmlGetExcludedDeviceInfoByIndex(Index uint32, Info *ExcludedDeviceInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGetExcludedDeviceInfoByIndex(Index, Info)
+}
+
+// nvmlDeviceReadWritePRM_v1 calls cgo_nvmlDeviceReadWritePRM_v1 through the call gate.
+func nvmlDeviceReadWritePRM_v1(nvmlDevice nvmlDevice, Buffer *PRMTLV_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceReadWritePRM_v1(nvmlDevice, Buffer)
+}
+
+// nvmlDeviceSetMigMode calls cgo_nvmlDeviceSetMigMode through the call gate.
+func nvmlDeviceSetMigMode(nvmlDevice nvmlDevice, Mode uint32, ActivationStatus *Return) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceSetMigMode(nvmlDevice, Mode, ActivationStatus)
+}
+
+// nvmlDeviceGetMigMode calls cgo_nvmlDeviceGetMigMode through the call gate.
+func nvmlDeviceGetMigMode(nvmlDevice nvmlDevice, CurrentMode *uint32, PendingMode *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMigMode(nvmlDevice, CurrentMode, PendingMode)
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfo calls cgo_nvmlDeviceGetGpuInstanceProfileInfo through the call gate.
+func nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice, Profile, Info)
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfoV calls cgo_nvmlDeviceGetGpuInstanceProfileInfoV through the call gate.
+func nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice, Profile, Info)
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfoByIdV calls cgo_nvmlDeviceGetGpuInstanceProfileInfoByIdV through the call gate.
+func nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice nvmlDevice, ProfileId uint32, Info *GpuInstanceProfileInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice, ProfileId, Info)
+}
+
+// nvmlDeviceGetGpuInstancePossiblePlacements_v2 calls cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v2 through the call gate.
+func nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice, ProfileId, Placements, Count)
+}
+
+// nvmlDeviceGetGpuInstanceRemainingCapacity calls cgo_nvmlDeviceGetGpuInstanceRemainingCapacity through the call gate.
+func nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice nvmlDevice, ProfileId uint32, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice, ProfileId, Count)
+}
+
+// nvmlDeviceCreateGpuInstance calls cgo_nvmlDeviceCreateGpuInstance through the call gate.
+func nvmlDeviceCreateGpuInstance(nvmlDevice nvmlDevice, ProfileId uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceCreateGpuInstance(nvmlDevice, ProfileId, nvmlGpuInstance)
+}
+
+// nvmlDeviceCreateGpuInstanceWithPlacement calls cgo_nvmlDeviceCreateGpuInstanceWithPlacement through the call gate.
+func nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice nvmlDevice, ProfileId uint32, Placement *GpuInstancePlacement, nvmlGpuInstance *nvmlGpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice, ProfileId, Placement, nvmlGpuInstance)
+}
+
+// nvmlGpuInstanceDestroy calls cgo_nvmlGpuInstanceDestroy through the call gate.
+func nvmlGpuInstanceDestroy(nvmlGpuInstance nvmlGpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceDestroy(nvmlGpuInstance)
+}
+
+// nvmlDeviceGetGpuInstances calls cgo_nvmlDeviceGetGpuInstances through the call gate.
+func nvmlDeviceGetGpuInstances(nvmlDevice nvmlDevice, ProfileId uint32, GpuInstances *nvmlGpuInstance, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstances(nvmlDevice, ProfileId, GpuInstances, Count)
+}
+
+// nvmlDeviceGetGpuInstanceById calls cgo_nvmlDeviceGetGpuInstanceById through the call gate.
+func nvmlDeviceGetGpuInstanceById(nvmlDevice nvmlDevice, Id uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceById(nvmlDevice, Id, nvmlGpuInstance)
+}
+
+// nvmlGpuInstanceGetInfo calls cgo_nvmlGpuInstanceGetInfo through the call gate.
+func nvmlGpuInstanceGetInfo(nvmlGpuInstance nvmlGpuInstance, Info *nvmlGpuInstanceInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetInfo(nvmlGpuInstance, Info)
+}
+
+// nvmlGpuInstanceGetComputeInstanceProfileInfo calls cgo_nvmlGpuInstanceGetComputeInstanceProfileInfo through the call gate.
+func nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance, Profile, EngProfile, Info)
+}
+
+// nvmlGpuInstanceGetComputeInstanceProfileInfoV calls cgo_nvmlGpuInstanceGetComputeInstanceProfileInfoV through the call gate.
+func nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance, Profile, EngProfile, Info)
+}
+
+// nvmlGpuInstanceGetComputeInstanceRemainingCapacity calls cgo_nvmlGpuInstanceGetComputeInstanceRemainingCapacity through the call gate.
+func nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance, ProfileId, Count)
+}
+
+// nvmlGpuInstanceGetComputeInstancePossiblePlacements calls cgo_nvmlGpuInstanceGetComputeInstancePossiblePlacements through the call gate.
+func nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placements *ComputeInstancePlacement, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance, ProfileId, Placements, Count)
+}
+
+// nvmlGpuInstanceCreateComputeInstance calls cgo_nvmlGpuInstanceCreateComputeInstance through the call gate.
+func nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance, ProfileId, nvmlComputeInstance)
+}
+
+// nvmlGpuInstanceCreateComputeInstanceWithPlacement calls cgo_nvmlGpuInstanceCreateComputeInstanceWithPlacement through the call gate.
+func nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placement *ComputeInstancePlacement, nvmlComputeInstance *nvmlComputeInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance, ProfileId, Placement, nvmlComputeInstance)
+}
+
+// nvmlComputeInstanceDestroy calls cgo_nvmlComputeInstanceDestroy through the call gate.
+func nvmlComputeInstanceDestroy(nvmlComputeInstance nvmlComputeInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlComputeInstanceDestroy(nvmlComputeInstance)
+}
+
+// nvmlGpuInstanceGetComputeInstances calls cgo_nvmlGpuInstanceGetComputeInstances through the call gate.
+func nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, ComputeInstances *nvmlComputeInstance, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance, ProfileId, ComputeInstances, Count)
+}
+
+// nvmlGpuInstanceGetComputeInstanceById calls cgo_nvmlGpuInstanceGetComputeInstanceById through the call gate.
+func nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance nvmlGpuInstance, Id uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance, Id, nvmlComputeInstance)
+}
+
+// nvmlComputeInstanceGetInfo_v2 calls cgo_nvmlComputeInstanceGetInfo_v2 through the call gate.
+func nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance, Info)
+}
+
+// nvmlDeviceIsMigDeviceHandle calls cgo_nvmlDeviceIsMigDeviceHandle through the call gate.
+func nvmlDeviceIsMigDeviceHandle(nvmlDevice nvmlDevice, IsMigDevice *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceIsMigDeviceHandle(nvmlDevice, IsMigDevice)
+}
+
+// nvmlDeviceGetGpuInstanceId calls cgo_nvmlDeviceGetGpuInstanceId through the call gate.
+func nvmlDeviceGetGpuInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstanceId(nvmlDevice, Id)
+}
+
+// nvmlDeviceGetComputeInstanceId calls cgo_nvmlDeviceGetComputeInstanceId through the call gate.
+func nvmlDeviceGetComputeInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetComputeInstanceId(nvmlDevice, Id)
+}
+
+// nvmlDeviceGetMaxMigDeviceCount calls cgo_nvmlDeviceGetMaxMigDeviceCount through the call gate.
+func nvmlDeviceGetMaxMigDeviceCount(nvmlDevice nvmlDevice, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMaxMigDeviceCount(nvmlDevice, Count)
+}
+
+// nvmlDeviceGetMigDeviceHandleByIndex calls cgo_nvmlDeviceGetMigDeviceHandleByIndex through the call gate.
+func nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice nvmlDevice, Index uint32, MigDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice, Index, MigDevice)
+}
+
+// nvmlDeviceGetDeviceHandleFromMigDeviceHandle calls cgo_nvmlDeviceGetDeviceHandleFromMigDeviceHandle through the call gate.
+func nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice nvmlDevice, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice, nvmlDevice)
+}
+
+// nvmlGpmMetricsGet calls cgo_nvmlGpmMetricsGet through the call gate.
+func nvmlGpmMetricsGet(MetricsGet *nvmlGpmMetricsGetType) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmMetricsGet(MetricsGet)
+}
+
+// nvmlGpmSampleFree calls cgo_nvmlGpmSampleFree through the call gate.
+func nvmlGpmSampleFree(nvmlGpmSample nvmlGpmSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmSampleFree(nvmlGpmSample)
+}
+
+// nvmlGpmSampleAlloc calls cgo_nvmlGpmSampleAlloc through the call gate.
+func nvmlGpmSampleAlloc(nvmlGpmSample *nvmlGpmSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmSampleAlloc(nvmlGpmSample)
+}
+
+// nvmlGpmSampleGet calls cgo_nvmlGpmSampleGet through the call gate.
+func nvmlGpmSampleGet(nvmlDevice nvmlDevice, nvmlGpmSample nvmlGpmSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmSampleGet(nvmlDevice, nvmlGpmSample)
+}
+
+// nvmlGpmMigSampleGet calls cgo_nvmlGpmMigSampleGet through the call gate.
+func nvmlGpmMigSampleGet(nvmlDevice nvmlDevice, GpuInstanceId uint32, nvmlGpmSample nvmlGpmSample) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmMigSampleGet(nvmlDevice, GpuInstanceId, nvmlGpmSample)
+}
+
+// nvmlGpmQueryDeviceSupport calls cgo_nvmlGpmQueryDeviceSupport through the call gate.
+func nvmlGpmQueryDeviceSupport(nvmlDevice nvmlDevice, GpmSupport *GpmSupport) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmQueryDeviceSupport(nvmlDevice, GpmSupport)
+}
+
+// nvmlGpmQueryIfStreamingEnabled calls cgo_nvmlGpmQueryIfStreamingEnabled through the call gate.
+func nvmlGpmQueryIfStreamingEnabled(nvmlDevice nvmlDevice, State *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmQueryIfStreamingEnabled(nvmlDevice, State)
+}
+
+// nvmlGpmSetStreamingEnabled calls cgo_nvmlGpmSetStreamingEnabled through the call gate.
+func nvmlGpmSetStreamingEnabled(nvmlDevice nvmlDevice, State uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlGpmSetStreamingEnabled(nvmlDevice, State)
+}
+
+// nvmlDeviceGetCapabilities calls cgo_nvmlDeviceGetCapabilities through the call gate.
+func nvmlDeviceGetCapabilities(nvmlDevice nvmlDevice, Caps *DeviceCapabilities) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCapabilities(nvmlDevice, Caps)
+}
+
+// nvmlDeviceWorkloadPowerProfileGetProfilesInfo calls cgo_nvmlDeviceWorkloadPowerProfileGetProfilesInfo through the call gate.
+func nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice nvmlDevice, ProfilesInfo *WorkloadPowerProfileProfilesInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice, ProfilesInfo)
+}
+
+// nvmlDeviceWorkloadPowerProfileGetCurrentProfiles calls cgo_nvmlDeviceWorkloadPowerProfileGetCurrentProfiles through the call gate.
+func nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice nvmlDevice, CurrentProfiles *WorkloadPowerProfileCurrentProfiles) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice, CurrentProfiles)
+}
+
+// nvmlDeviceWorkloadPowerProfileSetRequestedProfiles calls cgo_nvmlDeviceWorkloadPowerProfileSetRequestedProfiles through the call gate.
+func nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice, RequestedProfiles)
+}
+
+// nvmlDeviceWorkloadPowerProfileClearRequestedProfiles calls cgo_nvmlDeviceWorkloadPowerProfileClearRequestedProfiles through the call gate.
+func nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice, RequestedProfiles)
+}
+
+// nvmlDevicePowerSmoothingActivatePresetProfile calls cgo_nvmlDevicePowerSmoothingActivatePresetProfile through the call gate.
+func nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice, Profile)
+}
+
+// nvmlDevicePowerSmoothingUpdatePresetProfileParam calls cgo_nvmlDevicePowerSmoothingUpdatePresetProfileParam through the call gate.
+func nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice, Profile)
+}
+
+// nvmlDevicePowerSmoothingSetState calls cgo_nvmlDevicePowerSmoothingSetState through the call gate.
+func nvmlDevicePowerSmoothingSetState(nvmlDevice nvmlDevice, State *PowerSmoothingState) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDevicePowerSmoothingSetState(nvmlDevice, State)
+}
+
+// nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts calls cgo_nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts through the call gate.
+func nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice nvmlDevice, ErrorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice, ErrorCounts)
+}
+
+// nvmlInit_v1 calls cgo_nvmlInit_v1 through the call gate.
+func nvmlInit_v1() Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlInit_v1()
+}
+
+// nvmlDeviceGetCount_v1 calls cgo_nvmlDeviceGetCount_v1 through the call gate.
+func nvmlDeviceGetCount_v1(DeviceCount *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetCount_v1(DeviceCount)
+}
+
+// nvmlDeviceGetHandleByIndex_v1 calls cgo_nvmlDeviceGetHandleByIndex_v1 through the call gate.
+func nvmlDeviceGetHandleByIndex_v1(Index uint32, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByIndex_v1(Index, nvmlDevice)
+}
+
+// nvmlDeviceGetHandleByPciBusId_v1 calls cgo_nvmlDeviceGetHandleByPciBusId_v1 through the call gate.
+func nvmlDeviceGetHandleByPciBusId_v1(PciBusId string, nvmlDevice *nvmlDevice) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetHandleByPciBusId_v1(PciBusId, nvmlDevice)
+}
+
+// nvmlDeviceGetPciInfo_v1 calls cgo_nvmlDeviceGetPciInfo_v1 through the call gate.
+func nvmlDeviceGetPciInfo_v1(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPciInfo_v1(nvmlDevice, Pci)
+}
+
+// nvmlDeviceGetPciInfo_v2 calls cgo_nvmlDeviceGetPciInfo_v2 through the call gate.
+func nvmlDeviceGetPciInfo_v2(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetPciInfo_v2(nvmlDevice, Pci)
+}
+
+// nvmlDeviceGetNvLinkRemotePciInfo_v1 calls cgo_nvmlDeviceGetNvLinkRemotePciInfo_v1 through the call gate.
+func nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice, Link, Pci)
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v1 calls cgo_nvmlDeviceGetGridLicensableFeatures_v1 through the call gate.
+func nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice, PGridLicensableFeatures)
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v2 calls cgo_nvmlDeviceGetGridLicensableFeatures_v2 through the call gate.
+func nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice, PGridLicensableFeatures)
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v3 calls cgo_nvmlDeviceGetGridLicensableFeatures_v3 through the call gate.
+func nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice, PGridLicensableFeatures)
+}
+
+// nvmlDeviceRemoveGpu_v1 calls cgo_nvmlDeviceRemoveGpu_v1 through the call gate.
+func nvmlDeviceRemoveGpu_v1(PciInfo *PciInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceRemoveGpu_v1(PciInfo)
+}
+
+// nvmlEventSetWait_v1 calls cgo_nvmlEventSetWait_v1 through the call gate.
+func nvmlEventSetWait_v1(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlEventSetWait_v1(Set, Data, Timeoutms)
+}
+
+// nvmlDeviceGetAttributes_v1 calls cgo_nvmlDeviceGetAttributes_v1 through the call gate.
+func nvmlDeviceGetAttributes_v1(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetAttributes_v1(nvmlDevice, Attributes)
+}
+
+// nvmlComputeInstanceGetInfo_v1 calls cgo_nvmlComputeInstanceGetInfo_v1 through the call gate.
+func nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance, Info)
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v1 calls cgo_nvmlDeviceGetComputeRunningProcesses_v1 through the call gate.
+func nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v2 calls cgo_nvmlDeviceGetComputeRunningProcesses_v2 through the call gate.
+func nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v1 calls cgo_nvmlDeviceGetGraphicsRunningProcesses_v1 through the call gate.
+func nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v2 calls cgo_nvmlDeviceGetGraphicsRunningProcesses_v2 through the call gate.
+func nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v1 calls cgo_nvmlDeviceGetMPSComputeRunningProcesses_v1 through the call gate.
+func nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v2 calls cgo_nvmlDeviceGetMPSComputeRunningProcesses_v2 through the call gate.
+func nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice, InfoCount, Infos)
+}
+
+// nvmlDeviceGetGpuInstancePossiblePlacements_v1 calls cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v1 through the call gate.
+func nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice, ProfileId, Placements, Count)
+}
+
+// nvmlVgpuInstanceGetLicenseInfo_v1 calls cgo_nvmlVgpuInstanceGetLicenseInfo_v1 through the call gate.
+func nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance, LicenseInfo)
+}
+
+// nvmlDeviceGetDriverModel_v1 calls cgo_nvmlDeviceGetDriverModel_v1 through the call gate.
+func nvmlDeviceGetDriverModel_v1(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+	gate := loadedLibraries.enter()
+	if gate == nil {
+		return ERROR_UNINITIALIZED
+	}
+	defer gate.exit()
+	return cgo_nvmlDeviceGetDriverModel_v1(nvmlDevice, Current, Pending)
+}
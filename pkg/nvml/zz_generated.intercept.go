@@ -0,0 +1,7141 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// interceptedDevice is a Device returned by an interceptor.
+type interceptedDevice struct {
+	i      *interceptor
+	handle Device
+}
+
+func (i *interceptor) wrapDevice(device Device) Device {
+	if device == nil {
+		return nil
+	}
+	return &interceptedDevice{i: i, handle: device}
+}
+
+// unwrapDevice returns the handle to pass to the wrapped Interface.
+func unwrapDevice(device Device) Device {
+	if h, ok := device.(*interceptedDevice); ok {
+		return h.handle
+	}
+	return device
+}
+
+// interceptedGpuInstance is a GpuInstance returned by an interceptor.
+type interceptedGpuInstance struct {
+	i      *interceptor
+	handle GpuInstance
+}
+
+func (i *interceptor) wrapGpuInstance(gpuInstance GpuInstance) GpuInstance {
+	if gpuInstance == nil {
+		return nil
+	}
+	return &interceptedGpuInstance{i: i, handle: gpuInstance}
+}
+
+// unwrapGpuInstance returns the handle to pass to the wrapped Interface.
+func unwrapGpuInstance(gpuInstance GpuInstance) GpuInstance {
+	if h, ok := gpuInstance.(*interceptedGpuInstance); ok {
+		return h.handle
+	}
+	return gpuInstance
+}
+
+// interceptedComputeInstance is a ComputeInstance returned by an interceptor.
+type interceptedComputeInstance struct {
+	i      *interceptor
+	handle ComputeInstance
+}
+
+func (i *interceptor) wrapComputeInstance(computeInstance ComputeInstance) ComputeInstance {
+	if computeInstance == nil {
+		return nil
+	}
+	return &interceptedComputeInstance{i: i, handle: computeInstance}
+}
+
+// unwrapComputeInstance returns the handle to pass to the wrapped Interface.
+func unwrapComputeInstance(computeInstance ComputeInstance) ComputeInstance {
+	if h, ok := computeInstance.(*interceptedComputeInstance); ok {
+		return h.handle
+	}
+	return computeInstance
+}
+
+// interceptedEventSet is a EventSet returned by an interceptor.
+type interceptedEventSet struct {
+	i      *interceptor
+	handle EventSet
+}
+
+func (i *interceptor) wrapEventSet(eventSet EventSet) EventSet {
+	if eventSet == nil {
+		return nil
+	}
+	return &interceptedEventSet{i: i, handle: eventSet}
+}
+
+// unwrapEventSet returns the handle to pass to the wrapped Interface.
+func unwrapEventSet(eventSet EventSet) EventSet {
+	if h, ok := eventSet.(*interceptedEventSet); ok {
+		return h.handle
+	}
+	return eventSet
+}
+
+// interceptedGpmSample is a GpmSample returned by an interceptor.
+type interceptedGpmSample struct {
+	i      *interceptor
+	handle GpmSample
+}
+
+func (i *interceptor) wrapGpmSample(gpmSample GpmSample) GpmSample {
+	if gpmSample == nil {
+		return nil
+	}
+	return &interceptedGpmSample{i: i, handle: gpmSample}
+}
+
+// unwrapGpmSample returns the handle to pass to the wrapped Interface.
+func unwrapGpmSample(gpmSample GpmSample) GpmSample {
+	if h, ok := gpmSample.(*interceptedGpmSample); ok {
+		return h.handle
+	}
+	return gpmSample
+}
+
+// interceptedUnit is a Unit returned by an interceptor.
+type interceptedUnit struct {
+	i      *interceptor
+	handle Unit
+}
+
+func (i *interceptor) wrapUnit(unit Unit) Unit {
+	if unit == nil {
+		return nil
+	}
+	return &interceptedUnit{i: i, handle: unit}
+}
+
+// unwrapUnit returns the handle to pass to the wrapped Interface.
+func unwrapUnit(unit Unit) Unit {
+	if h, ok := unit.(*interceptedUnit); ok {
+		return h.handle
+	}
+	return unit
+}
+
+// interceptedVgpuInstance is a VgpuInstance returned by an interceptor.
+type interceptedVgpuInstance struct {
+	i      *interceptor
+	handle VgpuInstance
+}
+
+func (i *interceptor) wrapVgpuInstance(vgpuInstance VgpuInstance) VgpuInstance {
+	if vgpuInstance == nil {
+		return nil
+	}
+	return &interceptedVgpuInstance{i: i, handle: vgpuInstance}
+}
+
+// unwrapVgpuInstance returns the handle to pass to the wrapped Interface.
+func unwrapVgpuInstance(vgpuInstance VgpuInstance) VgpuInstance {
+	if h, ok := vgpuInstance.(*interceptedVgpuInstance); ok {
+		return h.handle
+	}
+	return vgpuInstance
+}
+
+// interceptedVgpuTypeId is a VgpuTypeId returned by an interceptor.
+type interceptedVgpuTypeId struct {
+	i      *interceptor
+	handle VgpuTypeId
+}
+
+func (i *interceptor) wrapVgpuTypeId(vgpuTypeId VgpuTypeId) VgpuTypeId {
+	if vgpuTypeId == nil {
+		return nil
+	}
+	return &interceptedVgpuTypeId{i: i, handle: vgpuTypeId}
+}
+
+// unwrapVgpuTypeId returns the handle to pass to the wrapped Interface.
+func unwrapVgpuTypeId(vgpuTypeId VgpuTypeId) VgpuTypeId {
+	if h, ok := vgpuTypeId.(*interceptedVgpuTypeId); ok {
+		return h.handle
+	}
+	return vgpuTypeId
+}
+
+// Unwrap returns the handle wrapped by a handle (e.g. a Device) that was
+// returned by an Interface created by Intercept. Calls to the methods of the
+// returned handle are not intercepted by that Interface. Any other value is
+// returned as is.
+func Unwrap(handle interface{}) interface{} {
+	switch h := handle.(type) {
+	case *interceptedDevice:
+		return h.handle
+	case *interceptedGpuInstance:
+		return h.handle
+	case *interceptedComputeInstance:
+		return h.handle
+	case *interceptedEventSet:
+		return h.handle
+	case *interceptedGpmSample:
+		return h.handle
+	case *interceptedUnit:
+		return h.handle
+	case *interceptedVgpuInstance:
+		return h.handle
+	case *interceptedVgpuTypeId:
+		return h.handle
+	}
+	return handle
+}
+
+var _ Interface = (*interceptor)(nil)
+
+func (i *interceptor) ComputeInstanceDestroy(computeInstance ComputeInstance) Return {
+	call := Call{Method: "ComputeInstanceDestroy", Args: []interface{}{computeInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.ComputeInstanceDestroy(unwrapComputeInstance(computeInstance))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) ComputeInstanceGetInfo(computeInstance ComputeInstance) (ComputeInstanceInfo, Return) {
+	var r0 ComputeInstanceInfo
+	call := Call{Method: "ComputeInstanceGetInfo", Args: []interface{}{computeInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.ComputeInstanceGetInfo(unwrapComputeInstance(computeInstance))
+		return ret
+	})
+	return i.wrapComputeInstanceInfo(r0), ret
+}
+
+func (i *interceptor) DeviceClearAccountingPids(device Device) Return {
+	call := Call{Method: "DeviceClearAccountingPids", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceClearAccountingPids(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceClearCpuAffinity(device Device) Return {
+	call := Call{Method: "DeviceClearCpuAffinity", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceClearCpuAffinity(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceClearEccErrorCounts(device Device, counterType EccCounterType) Return {
+	call := Call{Method: "DeviceClearEccErrorCounts", Args: []interface{}{device, counterType}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceClearEccErrorCounts(unwrapDevice(device), counterType)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceClearFieldValues(device Device, values []FieldValue) Return {
+	call := Call{Method: "DeviceClearFieldValues", Args: []interface{}{device, values}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceClearFieldValues(unwrapDevice(device), values)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceCreateGpuInstance(device Device, info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "DeviceCreateGpuInstance", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceCreateGpuInstance(unwrapDevice(device), info)
+		return ret
+	})
+	return i.wrapGpuInstance(r0), ret
+}
+
+func (i *interceptor) DeviceCreateGpuInstanceWithPlacement(device Device, info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "DeviceCreateGpuInstanceWithPlacement", Args: []interface{}{device, info, placement}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceCreateGpuInstanceWithPlacement(unwrapDevice(device), info, placement)
+		return ret
+	})
+	return i.wrapGpuInstance(r0), ret
+}
+
+func (i *interceptor) DeviceDiscoverGpus() (PciInfo, Return) {
+	var r0 PciInfo
+	call := Call{Method: "DeviceDiscoverGpus"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceDiscoverGpus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceFreezeNvLinkUtilizationCounter(device Device, link int, counter int, freeze EnableState) Return {
+	call := Call{Method: "DeviceFreezeNvLinkUtilizationCounter", Args: []interface{}{device, link, counter, freeze}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceFreezeNvLinkUtilizationCounter(unwrapDevice(device), link, counter, freeze)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceGetAPIRestriction(device Device, apiType RestrictedAPI) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetAPIRestriction", Args: []interface{}{device, apiType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAPIRestriction(unwrapDevice(device), apiType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAccountingBufferSize(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetAccountingBufferSize", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAccountingBufferSize(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAccountingMode(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetAccountingMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAccountingMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAccountingPids(device Device) ([]int, Return) {
+	var r0 []int
+	call := Call{Method: "DeviceGetAccountingPids", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAccountingPids(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAccountingStats(device Device, pid uint32) (AccountingStats, Return) {
+	var r0 AccountingStats
+	call := Call{Method: "DeviceGetAccountingStats", Args: []interface{}{device, pid}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAccountingStats(unwrapDevice(device), pid)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetActiveVgpus(device Device) ([]VgpuInstance, Return) {
+	var r0 []VgpuInstance
+	call := Call{Method: "DeviceGetActiveVgpus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetActiveVgpus(unwrapDevice(device))
+		return ret
+	})
+	return wrapHandles(r0, i.wrapVgpuInstance), ret
+}
+
+func (i *interceptor) DeviceGetAdaptiveClockInfoStatus(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetAdaptiveClockInfoStatus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAdaptiveClockInfoStatus(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAddressingMode(device Device) (DeviceAddressingMode, Return) {
+	var r0 DeviceAddressingMode
+	call := Call{Method: "DeviceGetAddressingMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAddressingMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetApplicationsClock", Args: []interface{}{device, clockType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetApplicationsClock(unwrapDevice(device), clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetArchitecture(device Device) (DeviceArchitecture, Return) {
+	var r0 DeviceArchitecture
+	call := Call{Method: "DeviceGetArchitecture", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetArchitecture(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAttributes(device Device) (DeviceAttributes, Return) {
+	var r0 DeviceAttributes
+	call := Call{Method: "DeviceGetAttributes", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetAttributes(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetAutoBoostedClocksEnabled(device Device) (EnableState, EnableState, Return) {
+	var r0 EnableState
+	var r1 EnableState
+	call := Call{Method: "DeviceGetAutoBoostedClocksEnabled", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetAutoBoostedClocksEnabled(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetBAR1MemoryInfo(device Device) (BAR1Memory, Return) {
+	var r0 BAR1Memory
+	call := Call{Method: "DeviceGetBAR1MemoryInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBAR1MemoryInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetBoardId(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetBoardId", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBoardId(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetBoardPartNumber(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetBoardPartNumber", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBoardPartNumber(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetBrand(device Device) (BrandType, Return) {
+	var r0 BrandType
+	call := Call{Method: "DeviceGetBrand", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBrand(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetBridgeChipInfo(device Device) (BridgeChipHierarchy, Return) {
+	var r0 BridgeChipHierarchy
+	call := Call{Method: "DeviceGetBridgeChipInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBridgeChipInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetBusType(device Device) (BusType, Return) {
+	var r0 BusType
+	call := Call{Method: "DeviceGetBusType", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetBusType(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetC2cModeInfoV(device Device) C2cModeInfoHandler {
+	return i.lib.DeviceGetC2cModeInfoV(unwrapDevice(device))
+}
+
+func (i *interceptor) DeviceGetCapabilities(device Device) (DeviceCapabilities, Return) {
+	var r0 DeviceCapabilities
+	call := Call{Method: "DeviceGetCapabilities", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCapabilities(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetClkMonStatus(device Device) (ClkMonStatus, Return) {
+	var r0 ClkMonStatus
+	call := Call{Method: "DeviceGetClkMonStatus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetClkMonStatus(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetClock(device Device, clockType ClockType, clockId ClockId) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetClock", Args: []interface{}{device, clockType, clockId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetClock(unwrapDevice(device), clockType, clockId)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetClockInfo", Args: []interface{}{device, clockType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetClockInfo(unwrapDevice(device), clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetClockOffsets(device Device) (ClockOffset, Return) {
+	var r0 ClockOffset
+	call := Call{Method: "DeviceGetClockOffsets", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetClockOffsets(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetComputeInstanceId(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetComputeInstanceId", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetComputeInstanceId(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetComputeMode(device Device) (ComputeMode, Return) {
+	var r0 ComputeMode
+	call := Call{Method: "DeviceGetComputeMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetComputeMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "DeviceGetComputeRunningProcesses", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetComputeRunningProcesses(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetConfComputeGpuAttestationReport(device Device, gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	call := Call{Method: "DeviceGetConfComputeGpuAttestationReport", Args: []interface{}{device, gpuAtstReport}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceGetConfComputeGpuAttestationReport(unwrapDevice(device), gpuAtstReport)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceGetConfComputeGpuCertificate(device Device) (ConfComputeGpuCertificate, Return) {
+	var r0 ConfComputeGpuCertificate
+	call := Call{Method: "DeviceGetConfComputeGpuCertificate", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetConfComputeGpuCertificate(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetConfComputeMemSizeInfo(device Device) (ConfComputeMemSizeInfo, Return) {
+	var r0 ConfComputeMemSizeInfo
+	call := Call{Method: "DeviceGetConfComputeMemSizeInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetConfComputeMemSizeInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetConfComputeProtectedMemoryUsage(device Device) (Memory, Return) {
+	var r0 Memory
+	call := Call{Method: "DeviceGetConfComputeProtectedMemoryUsage", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetConfComputeProtectedMemoryUsage(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCoolerInfo(device Device) (CoolerInfo, Return) {
+	var r0 CoolerInfo
+	call := Call{Method: "DeviceGetCoolerInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCoolerInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCount() (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetCount"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCount()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "DeviceGetCpuAffinity", Args: []interface{}{device, numCPUs}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCpuAffinity(unwrapDevice(device), numCPUs)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCpuAffinityWithinScope(device Device, numCPUs int, scope AffinityScope) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "DeviceGetCpuAffinityWithinScope", Args: []interface{}{device, numCPUs, scope}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCpuAffinityWithinScope(unwrapDevice(device), numCPUs, scope)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCreatableVgpus(device Device) ([]VgpuTypeId, Return) {
+	var r0 []VgpuTypeId
+	call := Call{Method: "DeviceGetCreatableVgpus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCreatableVgpus(unwrapDevice(device))
+		return ret
+	})
+	return wrapHandles(r0, i.wrapVgpuTypeId), ret
+}
+
+func (i *interceptor) DeviceGetCudaComputeCapability(device Device) (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "DeviceGetCudaComputeCapability", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetCudaComputeCapability(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetCurrPcieLinkGeneration(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetCurrPcieLinkGeneration", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCurrPcieLinkGeneration(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCurrPcieLinkWidth(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetCurrPcieLinkWidth", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCurrPcieLinkWidth(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCurrentClockFreqs(device Device) (DeviceCurrentClockFreqs, Return) {
+	var r0 DeviceCurrentClockFreqs
+	call := Call{Method: "DeviceGetCurrentClockFreqs", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCurrentClockFreqs(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCurrentClocksEventReasons(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetCurrentClocksEventReasons", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCurrentClocksEventReasons(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetCurrentClocksThrottleReasons", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetCurrentClocksThrottleReasons(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDecoderUtilization(device Device) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetDecoderUtilization", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetDecoderUtilization(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetDefaultApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetDefaultApplicationsClock", Args: []interface{}{device, clockType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDefaultApplicationsClock(unwrapDevice(device), clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDefaultEccMode(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetDefaultEccMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDefaultEccMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDetailedEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	var r0 EccErrorCounts
+	call := Call{Method: "DeviceGetDetailedEccErrors", Args: []interface{}{device, errorType, counterType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDetailedEccErrors(unwrapDevice(device), errorType, counterType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDeviceHandleFromMigDeviceHandle(migdevice Device) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetDeviceHandleFromMigDeviceHandle", Args: []interface{}{migdevice}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDeviceHandleFromMigDeviceHandle(unwrapDevice(migdevice))
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetDisplayActive(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetDisplayActive", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDisplayActive(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDisplayMode(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetDisplayMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDisplayMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetDramEncryptionMode(device Device) (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	var r0 DramEncryptionInfo
+	var r1 DramEncryptionInfo
+	call := Call{Method: "DeviceGetDramEncryptionMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetDramEncryptionMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetDriverModel(device Device) (DriverModel, DriverModel, Return) {
+	var r0 DriverModel
+	var r1 DriverModel
+	call := Call{Method: "DeviceGetDriverModel", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetDriverModel(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetDriverModel_v2(device Device) (DriverModel, DriverModel, Return) {
+	var r0 DriverModel
+	var r1 DriverModel
+	call := Call{Method: "DeviceGetDriverModel_v2", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetDriverModel_v2(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetDynamicPstatesInfo(device Device) (GpuDynamicPstatesInfo, Return) {
+	var r0 GpuDynamicPstatesInfo
+	call := Call{Method: "DeviceGetDynamicPstatesInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetDynamicPstatesInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetEccMode(device Device) (EnableState, EnableState, Return) {
+	var r0 EnableState
+	var r1 EnableState
+	call := Call{Method: "DeviceGetEccMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetEccMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetEncoderCapacity(device Device, encoderQueryType EncoderType) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetEncoderCapacity", Args: []interface{}{device, encoderQueryType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetEncoderCapacity(unwrapDevice(device), encoderQueryType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetEncoderSessions(device Device) ([]EncoderSessionInfo, Return) {
+	var r0 []EncoderSessionInfo
+	call := Call{Method: "DeviceGetEncoderSessions", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetEncoderSessions(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetEncoderStats(device Device) (int, uint32, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	var r2 uint32
+	call := Call{Method: "DeviceGetEncoderStats", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, r2, ret = i.lib.DeviceGetEncoderStats(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, r2, ret
+}
+
+func (i *interceptor) DeviceGetEncoderUtilization(device Device) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetEncoderUtilization", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetEncoderUtilization(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetEnforcedPowerLimit(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetEnforcedPowerLimit", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetEnforcedPowerLimit(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFBCSessions(device Device) ([]FBCSessionInfo, Return) {
+	var r0 []FBCSessionInfo
+	call := Call{Method: "DeviceGetFBCSessions", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFBCSessions(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFBCStats(device Device) (FBCStats, Return) {
+	var r0 FBCStats
+	call := Call{Method: "DeviceGetFBCStats", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFBCStats(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFanControlPolicy_v2(device Device, fan int) (FanControlPolicy, Return) {
+	var r0 FanControlPolicy
+	call := Call{Method: "DeviceGetFanControlPolicy_v2", Args: []interface{}{device, fan}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFanControlPolicy_v2(unwrapDevice(device), fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFanSpeed(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetFanSpeed", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFanSpeed(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFanSpeedRPM(device Device) (FanSpeedInfo, Return) {
+	var r0 FanSpeedInfo
+	call := Call{Method: "DeviceGetFanSpeedRPM", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFanSpeedRPM(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFanSpeed_v2(device Device, fan int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetFanSpeed_v2", Args: []interface{}{device, fan}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetFanSpeed_v2(unwrapDevice(device), fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetFieldValues(device Device, values []FieldValue) Return {
+	call := Call{Method: "DeviceGetFieldValues", Args: []interface{}{device, values}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceGetFieldValues(unwrapDevice(device), values)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "DeviceGetGpcClkMinMaxVfOffset", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetGpcClkMinMaxVfOffset(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetGpcClkVfOffset(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetGpcClkVfOffset", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpcClkVfOffset(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuFabricInfo(device Device) (GpuFabricInfo, Return) {
+	var r0 GpuFabricInfo
+	call := Call{Method: "DeviceGetGpuFabricInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuFabricInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuFabricInfoV(device Device) GpuFabricInfoHandler {
+	return i.lib.DeviceGetGpuFabricInfoV(unwrapDevice(device))
+}
+
+func (i *interceptor) DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "DeviceGetGpuInstanceById", Args: []interface{}{device, id}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstanceById(unwrapDevice(device), id)
+		return ret
+	})
+	return i.wrapGpuInstance(r0), ret
+}
+
+func (i *interceptor) DeviceGetGpuInstanceId(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetGpuInstanceId", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstanceId(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuInstancePossiblePlacements(device Device, info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	var r0 []GpuInstancePlacement
+	call := Call{Method: "DeviceGetGpuInstancePossiblePlacements", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstancePossiblePlacements(unwrapDevice(device), info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuInstanceProfileInfo(device Device, profile int) (GpuInstanceProfileInfo, Return) {
+	var r0 GpuInstanceProfileInfo
+	call := Call{Method: "DeviceGetGpuInstanceProfileInfo", Args: []interface{}{device, profile}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstanceProfileInfo(unwrapDevice(device), profile)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) GpuInstanceProfileInfoByIdHandler {
+	return i.lib.DeviceGetGpuInstanceProfileInfoByIdV(unwrapDevice(device), profileId)
+}
+
+func (i *interceptor) DeviceGetGpuInstanceProfileInfoV(device Device, profile int) GpuInstanceProfileInfoHandler {
+	return i.lib.DeviceGetGpuInstanceProfileInfoV(unwrapDevice(device), profile)
+}
+
+func (i *interceptor) DeviceGetGpuInstanceRemainingCapacity(device Device, info *GpuInstanceProfileInfo) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetGpuInstanceRemainingCapacity", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstanceRemainingCapacity(unwrapDevice(device), info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuInstances(device Device, info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	var r0 []GpuInstance
+	call := Call{Method: "DeviceGetGpuInstances", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuInstances(unwrapDevice(device), info)
+		return ret
+	})
+	return wrapHandles(r0, i.wrapGpuInstance), ret
+}
+
+func (i *interceptor) DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetGpuMaxPcieLinkGeneration", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGpuMaxPcieLinkGeneration(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGpuOperationMode(device Device) (GpuOperationMode, GpuOperationMode, Return) {
+	var r0 GpuOperationMode
+	var r1 GpuOperationMode
+	call := Call{Method: "DeviceGetGpuOperationMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetGpuOperationMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetGraphicsRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "DeviceGetGraphicsRunningProcesses", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGraphicsRunningProcesses(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGridLicensableFeatures(device Device) (GridLicensableFeatures, Return) {
+	var r0 GridLicensableFeatures
+	call := Call{Method: "DeviceGetGridLicensableFeatures", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGridLicensableFeatures(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetGspFirmwareMode(device Device) (bool, bool, Return) {
+	var r0 bool
+	var r1 bool
+	call := Call{Method: "DeviceGetGspFirmwareMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetGspFirmwareMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetGspFirmwareVersion(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetGspFirmwareVersion", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetGspFirmwareVersion(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetHandleByIndex(index int) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetHandleByIndex", Args: []interface{}{index}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHandleByIndex(index)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetHandleByPciBusId(pciBusId string) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetHandleByPciBusId", Args: []interface{}{pciBusId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHandleByPciBusId(pciBusId)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetHandleBySerial(serial string) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetHandleBySerial", Args: []interface{}{serial}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHandleBySerial(serial)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetHandleByUUID(uuid string) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetHandleByUUID", Args: []interface{}{uuid}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHandleByUUID(uuid)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetHandleByUUIDV(uuid *UUID) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetHandleByUUIDV", Args: []interface{}{uuid}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHandleByUUIDV(uuid)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetHostVgpuMode(device Device) (HostVgpuMode, Return) {
+	var r0 HostVgpuMode
+	call := Call{Method: "DeviceGetHostVgpuMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetHostVgpuMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetIndex(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetIndex", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetIndex(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetInforomConfigurationChecksum(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetInforomConfigurationChecksum", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetInforomConfigurationChecksum(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetInforomImageVersion(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetInforomImageVersion", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetInforomImageVersion(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetInforomVersion(device Device, object InforomObject) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetInforomVersion", Args: []interface{}{device, object}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetInforomVersion(unwrapDevice(device), object)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetIrqNum(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetIrqNum", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetIrqNum(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetJpgUtilization(device Device) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetJpgUtilization", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetJpgUtilization(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetLastBBXFlushTime(device Device) (uint64, uint, Return) {
+	var r0 uint64
+	var r1 uint
+	call := Call{Method: "DeviceGetLastBBXFlushTime", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetLastBBXFlushTime(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetMPSComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "DeviceGetMPSComputeRunningProcesses", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMPSComputeRunningProcesses(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMarginTemperature(device Device) (MarginTemperature, Return) {
+	var r0 MarginTemperature
+	call := Call{Method: "DeviceGetMarginTemperature", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMarginTemperature(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMaxClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetMaxClockInfo", Args: []interface{}{device, clockType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMaxClockInfo(unwrapDevice(device), clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMaxCustomerBoostClock(device Device, clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetMaxCustomerBoostClock", Args: []interface{}{device, clockType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMaxCustomerBoostClock(unwrapDevice(device), clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMaxMigDeviceCount(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMaxMigDeviceCount", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMaxMigDeviceCount(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMaxPcieLinkGeneration(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMaxPcieLinkGeneration", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMaxPcieLinkGeneration(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMaxPcieLinkWidth(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMaxPcieLinkWidth", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMaxPcieLinkWidth(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "DeviceGetMemClkMinMaxVfOffset", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetMemClkMinMaxVfOffset(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetMemClkVfOffset(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMemClkVfOffset", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemClkVfOffset(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemoryAffinity(device Device, numNodes int, scope AffinityScope) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "DeviceGetMemoryAffinity", Args: []interface{}{device, numNodes, scope}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemoryAffinity(unwrapDevice(device), numNodes, scope)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemoryBusWidth(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetMemoryBusWidth", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemoryBusWidth(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemoryErrorCounter(device Device, errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetMemoryErrorCounter", Args: []interface{}{device, errorType, counterType, locationType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemoryErrorCounter(unwrapDevice(device), errorType, counterType, locationType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemoryInfo(device Device) (Memory, Return) {
+	var r0 Memory
+	call := Call{Method: "DeviceGetMemoryInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemoryInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMemoryInfo_v2(device Device) (Memory_v2, Return) {
+	var r0 Memory_v2
+	call := Call{Method: "DeviceGetMemoryInfo_v2", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMemoryInfo_v2(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMigDeviceHandleByIndex(device Device, index int) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "DeviceGetMigDeviceHandleByIndex", Args: []interface{}{device, index}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMigDeviceHandleByIndex(unwrapDevice(device), index)
+		return ret
+	})
+	return i.wrapDevice(r0), ret
+}
+
+func (i *interceptor) DeviceGetMigMode(device Device) (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "DeviceGetMigMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetMigMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetMinMaxClockOfPState(device Device, clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetMinMaxClockOfPState", Args: []interface{}{device, clockType, pstate}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetMinMaxClockOfPState(unwrapDevice(device), clockType, pstate)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetMinMaxFanSpeed(device Device) (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "DeviceGetMinMaxFanSpeed", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetMinMaxFanSpeed(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetMinorNumber(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMinorNumber", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMinorNumber(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetModuleId(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetModuleId", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetModuleId(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetMultiGpuBoard(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetMultiGpuBoard", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetMultiGpuBoard(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetName(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetName", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetName(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNumFans(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetNumFans", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNumFans(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNumGpuCores(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetNumGpuCores", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNumGpuCores(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNumaNodeId(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetNumaNodeId", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNumaNodeId(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkCapability(device Device, link int, capability NvLinkCapability) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetNvLinkCapability", Args: []interface{}{device, link, capability}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkCapability(unwrapDevice(device), link, capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkErrorCounter(device Device, link int, counter NvLinkErrorCounter) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetNvLinkErrorCounter", Args: []interface{}{device, link, counter}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkErrorCounter(unwrapDevice(device), link, counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkInfo(device Device) NvLinkInfoHandler {
+	return i.lib.DeviceGetNvLinkInfo(unwrapDevice(device))
+}
+
+func (i *interceptor) DeviceGetNvLinkRemoteDeviceType(device Device, link int) (IntNvLinkDeviceType, Return) {
+	var r0 IntNvLinkDeviceType
+	call := Call{Method: "DeviceGetNvLinkRemoteDeviceType", Args: []interface{}{device, link}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkRemoteDeviceType(unwrapDevice(device), link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkRemotePciInfo(device Device, link int) (PciInfo, Return) {
+	var r0 PciInfo
+	call := Call{Method: "DeviceGetNvLinkRemotePciInfo", Args: []interface{}{device, link}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkRemotePciInfo(unwrapDevice(device), link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkState(device Device, link int) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetNvLinkState", Args: []interface{}{device, link}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkState(unwrapDevice(device), link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkUtilizationControl(device Device, link int, counter int) (NvLinkUtilizationControl, Return) {
+	var r0 NvLinkUtilizationControl
+	call := Call{Method: "DeviceGetNvLinkUtilizationControl", Args: []interface{}{device, link, counter}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkUtilizationControl(unwrapDevice(device), link, counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkUtilizationCounter(device Device, link int, counter int) (uint64, uint64, Return) {
+	var r0 uint64
+	var r1 uint64
+	call := Call{Method: "DeviceGetNvLinkUtilizationCounter", Args: []interface{}{device, link, counter}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetNvLinkVersion(device Device, link int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetNvLinkVersion", Args: []interface{}{device, link}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvLinkVersion(unwrapDevice(device), link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvlinkBwMode(device Device) (NvlinkGetBwMode, Return) {
+	var r0 NvlinkGetBwMode
+	call := Call{Method: "DeviceGetNvlinkBwMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvlinkBwMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetNvlinkSupportedBwModes(device Device) (NvlinkSupportedBwModes, Return) {
+	var r0 NvlinkSupportedBwModes
+	call := Call{Method: "DeviceGetNvlinkSupportedBwModes", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetNvlinkSupportedBwModes(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetOfaUtilization(device Device) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetOfaUtilization", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetOfaUtilization(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetP2PStatus(device1 Device, device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	var r0 GpuP2PStatus
+	call := Call{Method: "DeviceGetP2PStatus", Args: []interface{}{device1, device2, p2pIndex}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetP2PStatus(unwrapDevice(device1), unwrapDevice(device2), p2pIndex)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPciInfo(device Device) (PciInfo, Return) {
+	var r0 PciInfo
+	call := Call{Method: "DeviceGetPciInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPciInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPciInfoExt(device Device) (PciInfoExt, Return) {
+	var r0 PciInfoExt
+	call := Call{Method: "DeviceGetPciInfoExt", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPciInfoExt(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPcieLinkMaxSpeed(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetPcieLinkMaxSpeed", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPcieLinkMaxSpeed(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPcieReplayCounter(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetPcieReplayCounter", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPcieReplayCounter(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPcieSpeed(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetPcieSpeed", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPcieSpeed(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPcieThroughput(device Device, counter PcieUtilCounter) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetPcieThroughput", Args: []interface{}{device, counter}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPcieThroughput(unwrapDevice(device), counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPdi(device Device) (Pdi, Return) {
+	var r0 Pdi
+	call := Call{Method: "DeviceGetPdi", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPdi(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPerformanceModes(device Device) (DevicePerfModes, Return) {
+	var r0 DevicePerfModes
+	call := Call{Method: "DeviceGetPerformanceModes", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPerformanceModes(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPerformanceState(device Device) (Pstates, Return) {
+	var r0 Pstates
+	call := Call{Method: "DeviceGetPerformanceState", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPerformanceState(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPersistenceMode(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetPersistenceMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPersistenceMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPgpuMetadataString(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetPgpuMetadataString", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPgpuMetadataString(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPlatformInfo(device Device) (PlatformInfo, Return) {
+	var r0 PlatformInfo
+	call := Call{Method: "DeviceGetPlatformInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPlatformInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerManagementDefaultLimit(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetPowerManagementDefaultLimit", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerManagementDefaultLimit(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerManagementLimit(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetPowerManagementLimit", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerManagementLimit(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerManagementLimitConstraints(device Device) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "DeviceGetPowerManagementLimitConstraints", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetPowerManagementLimitConstraints(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetPowerManagementMode(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetPowerManagementMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerManagementMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerMizerMode_v1(device Device) (DevicePowerMizerModes_v1, Return) {
+	var r0 DevicePowerMizerModes_v1
+	call := Call{Method: "DeviceGetPowerMizerMode_v1", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerMizerMode_v1(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerSource(device Device) (PowerSource, Return) {
+	var r0 PowerSource
+	call := Call{Method: "DeviceGetPowerSource", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerSource(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerState(device Device) (Pstates, Return) {
+	var r0 Pstates
+	call := Call{Method: "DeviceGetPowerState", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerState(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetPowerUsage(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetPowerUsage", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetPowerUsage(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetProcessUtilization(device Device, lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	var r0 []ProcessUtilizationSample
+	call := Call{Method: "DeviceGetProcessUtilization", Args: []interface{}{device, lastSeenTimestamp}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetProcessesUtilizationInfo(device Device) (ProcessesUtilizationInfo, Return) {
+	var r0 ProcessesUtilizationInfo
+	call := Call{Method: "DeviceGetProcessesUtilizationInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetProcessesUtilizationInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetRemappedRows(device Device) (int, int, bool, bool, Return) {
+	var r0 int
+	var r1 int
+	var r2 bool
+	var r3 bool
+	call := Call{Method: "DeviceGetRemappedRows", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, r2, r3, ret = i.lib.DeviceGetRemappedRows(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, r2, r3, ret
+}
+
+func (i *interceptor) DeviceGetRepairStatus(device Device) (RepairStatus, Return) {
+	var r0 RepairStatus
+	call := Call{Method: "DeviceGetRepairStatus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetRepairStatus(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetRetiredPages(device Device, cause PageRetirementCause) ([]uint64, Return) {
+	var r0 []uint64
+	call := Call{Method: "DeviceGetRetiredPages", Args: []interface{}{device, cause}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetRetiredPages(unwrapDevice(device), cause)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetRetiredPagesPendingStatus(device Device) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceGetRetiredPagesPendingStatus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetRetiredPagesPendingStatus(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetRetiredPages_v2(device Device, cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	var r0 []uint64
+	var r1 []uint64
+	call := Call{Method: "DeviceGetRetiredPages_v2", Args: []interface{}{device, cause}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetRetiredPages_v2(unwrapDevice(device), cause)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetRowRemapperHistogram(device Device) (RowRemapperHistogramValues, Return) {
+	var r0 RowRemapperHistogramValues
+	call := Call{Method: "DeviceGetRowRemapperHistogram", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetRowRemapperHistogram(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetRunningProcessDetailList(device Device) (ProcessDetailList, Return) {
+	var r0 ProcessDetailList
+	call := Call{Method: "DeviceGetRunningProcessDetailList", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetRunningProcessDetailList(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSamples(device Device, samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	var r0 ValueType
+	var r1 []Sample
+	call := Call{Method: "DeviceGetSamples", Args: []interface{}{device, samplingType, lastSeenTimestamp}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetSamples(unwrapDevice(device), samplingType, lastSeenTimestamp)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetSerial(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetSerial", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSerial(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSramEccErrorStatus(device Device) (EccSramErrorStatus, Return) {
+	var r0 EccSramErrorStatus
+	call := Call{Method: "DeviceGetSramEccErrorStatus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSramEccErrorStatus(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSramUniqueUncorrectedEccErrorCounts(device Device, errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	call := Call{Method: "DeviceGetSramUniqueUncorrectedEccErrorCounts", Args: []interface{}{device, errorCounts}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceGetSramUniqueUncorrectedEccErrorCounts(unwrapDevice(device), errorCounts)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceGetSupportedClocksEventReasons(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetSupportedClocksEventReasons", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSupportedClocksEventReasons(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetSupportedClocksThrottleReasons", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSupportedClocksThrottleReasons(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSupportedEventTypes(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetSupportedEventTypes", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSupportedEventTypes(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	call := Call{Method: "DeviceGetSupportedGraphicsClocks", Args: []interface{}{device, memoryClockMHz}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetSupportedGraphicsClocks(unwrapDevice(device), memoryClockMHz)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetSupportedMemoryClocks(device Device) (int, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	call := Call{Method: "DeviceGetSupportedMemoryClocks", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetSupportedMemoryClocks(unwrapDevice(device))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetSupportedPerformanceStates(device Device) ([]Pstates, Return) {
+	var r0 []Pstates
+	call := Call{Method: "DeviceGetSupportedPerformanceStates", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSupportedPerformanceStates(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetSupportedVgpus(device Device) ([]VgpuTypeId, Return) {
+	var r0 []VgpuTypeId
+	call := Call{Method: "DeviceGetSupportedVgpus", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetSupportedVgpus(unwrapDevice(device))
+		return ret
+	})
+	return wrapHandles(r0, i.wrapVgpuTypeId), ret
+}
+
+func (i *interceptor) DeviceGetTargetFanSpeed(device Device, fan int) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceGetTargetFanSpeed", Args: []interface{}{device, fan}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTargetFanSpeed(unwrapDevice(device), fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTemperature(device Device, sensorType TemperatureSensors) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetTemperature", Args: []interface{}{device, sensorType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTemperature(unwrapDevice(device), sensorType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTemperatureThreshold(device Device, thresholdType TemperatureThresholds) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "DeviceGetTemperatureThreshold", Args: []interface{}{device, thresholdType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTemperatureThreshold(unwrapDevice(device), thresholdType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTemperatureV(device Device) TemperatureHandler {
+	return i.lib.DeviceGetTemperatureV(unwrapDevice(device))
+}
+
+func (i *interceptor) DeviceGetThermalSettings(device Device, sensorIndex uint32) (GpuThermalSettings, Return) {
+	var r0 GpuThermalSettings
+	call := Call{Method: "DeviceGetThermalSettings", Args: []interface{}{device, sensorIndex}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetThermalSettings(unwrapDevice(device), sensorIndex)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTopologyCommonAncestor(device1 Device, device2 Device) (GpuTopologyLevel, Return) {
+	var r0 GpuTopologyLevel
+	call := Call{Method: "DeviceGetTopologyCommonAncestor", Args: []interface{}{device1, device2}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTopologyCommonAncestor(unwrapDevice(device1), unwrapDevice(device2))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTopologyNearestGpus(device Device, level GpuTopologyLevel) ([]Device, Return) {
+	var r0 []Device
+	call := Call{Method: "DeviceGetTopologyNearestGpus", Args: []interface{}{device, level}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTopologyNearestGpus(unwrapDevice(device), level)
+		return ret
+	})
+	return wrapHandles(r0, i.wrapDevice), ret
+}
+
+func (i *interceptor) DeviceGetTotalEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetTotalEccErrors", Args: []interface{}{device, errorType, counterType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTotalEccErrors(unwrapDevice(device), errorType, counterType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetTotalEnergyConsumption(device Device) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "DeviceGetTotalEnergyConsumption", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetTotalEnergyConsumption(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetUUID(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetUUID", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetUUID(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetUtilizationRates(device Device) (Utilization, Return) {
+	var r0 Utilization
+	call := Call{Method: "DeviceGetUtilizationRates", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetUtilizationRates(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVbiosVersion(device Device) (string, Return) {
+	var r0 string
+	call := Call{Method: "DeviceGetVbiosVersion", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVbiosVersion(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuCapabilities(device Device, capability DeviceVgpuCapability) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "DeviceGetVgpuCapabilities", Args: []interface{}{device, capability}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuCapabilities(unwrapDevice(device), capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuHeterogeneousMode(device Device) (VgpuHeterogeneousMode, Return) {
+	var r0 VgpuHeterogeneousMode
+	call := Call{Method: "DeviceGetVgpuHeterogeneousMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuHeterogeneousMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuInstancesUtilizationInfo(device Device) (VgpuInstancesUtilizationInfo, Return) {
+	var r0 VgpuInstancesUtilizationInfo
+	call := Call{Method: "DeviceGetVgpuInstancesUtilizationInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuInstancesUtilizationInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuMetadata(device Device) (VgpuPgpuMetadata, Return) {
+	var r0 VgpuPgpuMetadata
+	call := Call{Method: "DeviceGetVgpuMetadata", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuMetadata(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuProcessUtilization(device Device, lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	var r0 []VgpuProcessUtilizationSample
+	call := Call{Method: "DeviceGetVgpuProcessUtilization", Args: []interface{}{device, lastSeenTimestamp}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuProcessUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuProcessesUtilizationInfo(device Device) (VgpuProcessesUtilizationInfo, Return) {
+	var r0 VgpuProcessesUtilizationInfo
+	call := Call{Method: "DeviceGetVgpuProcessesUtilizationInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuProcessesUtilizationInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuSchedulerCapabilities(device Device) (VgpuSchedulerCapabilities, Return) {
+	var r0 VgpuSchedulerCapabilities
+	call := Call{Method: "DeviceGetVgpuSchedulerCapabilities", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuSchedulerCapabilities(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuSchedulerLog(device Device) (VgpuSchedulerLog, Return) {
+	var r0 VgpuSchedulerLog
+	call := Call{Method: "DeviceGetVgpuSchedulerLog", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuSchedulerLog(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuSchedulerState(device Device) (VgpuSchedulerGetState, Return) {
+	var r0 VgpuSchedulerGetState
+	call := Call{Method: "DeviceGetVgpuSchedulerState", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuSchedulerState(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuTypeCreatablePlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "DeviceGetVgpuTypeCreatablePlacements", Args: []interface{}{device, vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuTypeCreatablePlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuTypeSupportedPlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "DeviceGetVgpuTypeSupportedPlacements", Args: []interface{}{device, vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVgpuTypeSupportedPlacements(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	var r0 ValueType
+	var r1 []VgpuInstanceUtilizationSample
+	call := Call{Method: "DeviceGetVgpuUtilization", Args: []interface{}{device, lastSeenTimestamp}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.DeviceGetVgpuUtilization(unwrapDevice(device), lastSeenTimestamp)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) DeviceGetViolationStatus(device Device, perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	var r0 ViolationTime
+	call := Call{Method: "DeviceGetViolationStatus", Args: []interface{}{device, perfPolicyType}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetViolationStatus(unwrapDevice(device), perfPolicyType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceGetVirtualizationMode(device Device) (GpuVirtualizationMode, Return) {
+	var r0 GpuVirtualizationMode
+	call := Call{Method: "DeviceGetVirtualizationMode", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceGetVirtualizationMode(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceIsMigDeviceHandle(device Device) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "DeviceIsMigDeviceHandle", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceIsMigDeviceHandle(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceModifyDrainState(pciInfo *PciInfo, newState EnableState) Return {
+	call := Call{Method: "DeviceModifyDrainState", Args: []interface{}{pciInfo, newState}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceModifyDrainState(pciInfo, newState)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceOnSameBoard(device1 Device, device2 Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "DeviceOnSameBoard", Args: []interface{}{device1, device2}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceOnSameBoard(unwrapDevice(device1), unwrapDevice(device2))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DevicePowerSmoothingActivatePresetProfile(device Device, profile *PowerSmoothingProfile) Return {
+	call := Call{Method: "DevicePowerSmoothingActivatePresetProfile", Args: []interface{}{device, profile}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DevicePowerSmoothingActivatePresetProfile(unwrapDevice(device), profile)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DevicePowerSmoothingSetState(device Device, state *PowerSmoothingState) Return {
+	call := Call{Method: "DevicePowerSmoothingSetState", Args: []interface{}{device, state}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DevicePowerSmoothingSetState(unwrapDevice(device), state)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DevicePowerSmoothingUpdatePresetProfileParam(device Device, profile *PowerSmoothingProfile) Return {
+	call := Call{Method: "DevicePowerSmoothingUpdatePresetProfileParam", Args: []interface{}{device, profile}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DevicePowerSmoothingUpdatePresetProfileParam(unwrapDevice(device), profile)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceQueryDrainState(pciInfo *PciInfo) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "DeviceQueryDrainState", Args: []interface{}{pciInfo}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceQueryDrainState(pciInfo)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceReadWritePRM_v1(device Device, buffer *PRMTLV_v1) Return {
+	call := Call{Method: "DeviceReadWritePRM_v1", Args: []interface{}{device, buffer}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceReadWritePRM_v1(unwrapDevice(device), buffer)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceRegisterEvents(device Device, eventTypes uint64, set EventSet) Return {
+	call := Call{Method: "DeviceRegisterEvents", Args: []interface{}{device, eventTypes, set}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceRegisterEvents(unwrapDevice(device), eventTypes, unwrapEventSet(set))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceRemoveGpu(pciInfo *PciInfo) Return {
+	call := Call{Method: "DeviceRemoveGpu", Args: []interface{}{pciInfo}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceRemoveGpu(pciInfo)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceRemoveGpu_v2(pciInfo *PciInfo, gpuState DetachGpuState, linkState PcieLinkState) Return {
+	call := Call{Method: "DeviceRemoveGpu_v2", Args: []interface{}{pciInfo, gpuState, linkState}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceRemoveGpu_v2(pciInfo, gpuState, linkState)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceResetApplicationsClocks(device Device) Return {
+	call := Call{Method: "DeviceResetApplicationsClocks", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceResetApplicationsClocks(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceResetGpuLockedClocks(device Device) Return {
+	call := Call{Method: "DeviceResetGpuLockedClocks", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceResetGpuLockedClocks(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceResetMemoryLockedClocks(device Device) Return {
+	call := Call{Method: "DeviceResetMemoryLockedClocks", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceResetMemoryLockedClocks(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceResetNvLinkErrorCounters(device Device, link int) Return {
+	call := Call{Method: "DeviceResetNvLinkErrorCounters", Args: []interface{}{device, link}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceResetNvLinkErrorCounters(unwrapDevice(device), link)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceResetNvLinkUtilizationCounter(device Device, link int, counter int) Return {
+	call := Call{Method: "DeviceResetNvLinkUtilizationCounter", Args: []interface{}{device, link, counter}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceResetNvLinkUtilizationCounter(unwrapDevice(device), link, counter)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetAPIRestriction(device Device, apiType RestrictedAPI, isRestricted EnableState) Return {
+	call := Call{Method: "DeviceSetAPIRestriction", Args: []interface{}{device, apiType, isRestricted}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetAPIRestriction(unwrapDevice(device), apiType, isRestricted)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetAccountingMode(device Device, mode EnableState) Return {
+	call := Call{Method: "DeviceSetAccountingMode", Args: []interface{}{device, mode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetAccountingMode(unwrapDevice(device), mode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetApplicationsClocks(device Device, memClockMHz uint32, graphicsClockMHz uint32) Return {
+	call := Call{Method: "DeviceSetApplicationsClocks", Args: []interface{}{device, memClockMHz, graphicsClockMHz}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetApplicationsClocks(unwrapDevice(device), memClockMHz, graphicsClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetAutoBoostedClocksEnabled(device Device, enabled EnableState) Return {
+	call := Call{Method: "DeviceSetAutoBoostedClocksEnabled", Args: []interface{}{device, enabled}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetAutoBoostedClocksEnabled(unwrapDevice(device), enabled)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetClockOffsets(device Device, info ClockOffset) Return {
+	call := Call{Method: "DeviceSetClockOffsets", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetClockOffsets(unwrapDevice(device), info)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetComputeMode(device Device, mode ComputeMode) Return {
+	call := Call{Method: "DeviceSetComputeMode", Args: []interface{}{device, mode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetComputeMode(unwrapDevice(device), mode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetConfComputeUnprotectedMemSize(device Device, sizeKiB uint64) Return {
+	call := Call{Method: "DeviceSetConfComputeUnprotectedMemSize", Args: []interface{}{device, sizeKiB}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetConfComputeUnprotectedMemSize(unwrapDevice(device), sizeKiB)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetCpuAffinity(device Device) Return {
+	call := Call{Method: "DeviceSetCpuAffinity", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetCpuAffinity(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetDefaultAutoBoostedClocksEnabled(device Device, enabled EnableState, flags uint32) Return {
+	call := Call{Method: "DeviceSetDefaultAutoBoostedClocksEnabled", Args: []interface{}{device, enabled, flags}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetDefaultAutoBoostedClocksEnabled(unwrapDevice(device), enabled, flags)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetDefaultFanSpeed_v2(device Device, fan int) Return {
+	call := Call{Method: "DeviceSetDefaultFanSpeed_v2", Args: []interface{}{device, fan}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetDefaultFanSpeed_v2(unwrapDevice(device), fan)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetDramEncryptionMode(device Device, dramEncryption *DramEncryptionInfo) Return {
+	call := Call{Method: "DeviceSetDramEncryptionMode", Args: []interface{}{device, dramEncryption}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetDramEncryptionMode(unwrapDevice(device), dramEncryption)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetDriverModel(device Device, driverModel DriverModel, flags uint32) Return {
+	call := Call{Method: "DeviceSetDriverModel", Args: []interface{}{device, driverModel, flags}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetDriverModel(unwrapDevice(device), driverModel, flags)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetEccMode(device Device, ecc EnableState) Return {
+	call := Call{Method: "DeviceSetEccMode", Args: []interface{}{device, ecc}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetEccMode(unwrapDevice(device), ecc)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetFanControlPolicy(device Device, fan int, policy FanControlPolicy) Return {
+	call := Call{Method: "DeviceSetFanControlPolicy", Args: []interface{}{device, fan, policy}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetFanControlPolicy(unwrapDevice(device), fan, policy)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetFanSpeed_v2(device Device, fan int, speed int) Return {
+	call := Call{Method: "DeviceSetFanSpeed_v2", Args: []interface{}{device, fan, speed}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetFanSpeed_v2(unwrapDevice(device), fan, speed)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetGpcClkVfOffset(device Device, offset int) Return {
+	call := Call{Method: "DeviceSetGpcClkVfOffset", Args: []interface{}{device, offset}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetGpcClkVfOffset(unwrapDevice(device), offset)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	call := Call{Method: "DeviceSetGpuLockedClocks", Args: []interface{}{device, minGpuClockMHz, maxGpuClockMHz}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetGpuLockedClocks(unwrapDevice(device), minGpuClockMHz, maxGpuClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetGpuOperationMode(device Device, mode GpuOperationMode) Return {
+	call := Call{Method: "DeviceSetGpuOperationMode", Args: []interface{}{device, mode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetGpuOperationMode(unwrapDevice(device), mode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetMemClkVfOffset(device Device, offset int) Return {
+	call := Call{Method: "DeviceSetMemClkVfOffset", Args: []interface{}{device, offset}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetMemClkVfOffset(unwrapDevice(device), offset)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetMemoryLockedClocks(device Device, minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	call := Call{Method: "DeviceSetMemoryLockedClocks", Args: []interface{}{device, minMemClockMHz, maxMemClockMHz}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetMemoryLockedClocks(unwrapDevice(device), minMemClockMHz, maxMemClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetMigMode(device Device, mode int) (Return, Return) {
+	var r0 Return
+	call := Call{Method: "DeviceSetMigMode", Args: []interface{}{device, mode}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceSetMigMode(unwrapDevice(device), mode)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceSetNvLinkDeviceLowPowerThreshold(device Device, info *NvLinkPowerThres) Return {
+	call := Call{Method: "DeviceSetNvLinkDeviceLowPowerThreshold", Args: []interface{}{device, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetNvLinkDeviceLowPowerThreshold(unwrapDevice(device), info)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetNvLinkUtilizationControl(device Device, link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	call := Call{Method: "DeviceSetNvLinkUtilizationControl", Args: []interface{}{device, link, counter, control, reset}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetNvLinkUtilizationControl(unwrapDevice(device), link, counter, control, reset)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetNvlinkBwMode(device Device, setBwMode *NvlinkSetBwMode) Return {
+	call := Call{Method: "DeviceSetNvlinkBwMode", Args: []interface{}{device, setBwMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetNvlinkBwMode(unwrapDevice(device), setBwMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetPersistenceMode(device Device, mode EnableState) Return {
+	call := Call{Method: "DeviceSetPersistenceMode", Args: []interface{}{device, mode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetPersistenceMode(unwrapDevice(device), mode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetPowerManagementLimit(device Device, limit uint32) Return {
+	call := Call{Method: "DeviceSetPowerManagementLimit", Args: []interface{}{device, limit}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetPowerManagementLimit(unwrapDevice(device), limit)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetPowerManagementLimit_v2(device Device, powerValue *PowerValue_v2) Return {
+	call := Call{Method: "DeviceSetPowerManagementLimit_v2", Args: []interface{}{device, powerValue}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetPowerManagementLimit_v2(unwrapDevice(device), powerValue)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetPowerMizerMode_v1(device Device, powerMizerMode *DevicePowerMizerModes_v1) Return {
+	call := Call{Method: "DeviceSetPowerMizerMode_v1", Args: []interface{}{device, powerMizerMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetPowerMizerMode_v1(unwrapDevice(device), powerMizerMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetTemperatureThreshold(device Device, thresholdType TemperatureThresholds, temp int) Return {
+	call := Call{Method: "DeviceSetTemperatureThreshold", Args: []interface{}{device, thresholdType, temp}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetTemperatureThreshold(unwrapDevice(device), thresholdType, temp)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetVgpuCapabilities(device Device, capability DeviceVgpuCapability, state EnableState) Return {
+	call := Call{Method: "DeviceSetVgpuCapabilities", Args: []interface{}{device, capability, state}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetVgpuCapabilities(unwrapDevice(device), capability, state)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetVgpuHeterogeneousMode(device Device, heterogeneousMode VgpuHeterogeneousMode) Return {
+	call := Call{Method: "DeviceSetVgpuHeterogeneousMode", Args: []interface{}{device, heterogeneousMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetVgpuHeterogeneousMode(unwrapDevice(device), heterogeneousMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetVgpuSchedulerState(device Device, pSchedulerState *VgpuSchedulerSetState) Return {
+	call := Call{Method: "DeviceSetVgpuSchedulerState", Args: []interface{}{device, pSchedulerState}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetVgpuSchedulerState(unwrapDevice(device), pSchedulerState)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceSetVirtualizationMode(device Device, virtualMode GpuVirtualizationMode) Return {
+	call := Call{Method: "DeviceSetVirtualizationMode", Args: []interface{}{device, virtualMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceSetVirtualizationMode(unwrapDevice(device), virtualMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceValidateInforom(device Device) Return {
+	call := Call{Method: "DeviceValidateInforom", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceValidateInforom(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceWorkloadPowerProfileClearRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	call := Call{Method: "DeviceWorkloadPowerProfileClearRequestedProfiles", Args: []interface{}{device, requestedProfiles}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceWorkloadPowerProfileClearRequestedProfiles(unwrapDevice(device), requestedProfiles)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (WorkloadPowerProfileCurrentProfiles, Return) {
+	var r0 WorkloadPowerProfileCurrentProfiles
+	call := Call{Method: "DeviceWorkloadPowerProfileGetCurrentProfiles", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceWorkloadPowerProfileGetCurrentProfiles(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (WorkloadPowerProfileProfilesInfo, Return) {
+	var r0 WorkloadPowerProfileProfilesInfo
+	call := Call{Method: "DeviceWorkloadPowerProfileGetProfilesInfo", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.DeviceWorkloadPowerProfileGetProfilesInfo(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) DeviceWorkloadPowerProfileSetRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	call := Call{Method: "DeviceWorkloadPowerProfileSetRequestedProfiles", Args: []interface{}{device, requestedProfiles}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.DeviceWorkloadPowerProfileSetRequestedProfiles(unwrapDevice(device), requestedProfiles)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) ErrorString(r Return) string {
+	return i.lib.ErrorString(r)
+}
+
+func (i *interceptor) EventSetCreate() (EventSet, Return) {
+	var r0 EventSet
+	call := Call{Method: "EventSetCreate"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.EventSetCreate()
+		return ret
+	})
+	return i.wrapEventSet(r0), ret
+}
+
+func (i *interceptor) EventSetFree(set EventSet) Return {
+	call := Call{Method: "EventSetFree", Args: []interface{}{set}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.EventSetFree(unwrapEventSet(set))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) EventSetWait(set EventSet, timeoutms uint32) (EventData, Return) {
+	var r0 EventData
+	call := Call{Method: "EventSetWait", Args: []interface{}{set, timeoutms}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.EventSetWait(unwrapEventSet(set), timeoutms)
+		return ret
+	})
+	return i.wrapEventData(r0), ret
+}
+
+func (i *interceptor) Extensions() ExtendedInterface {
+	return i.lib.Extensions()
+}
+
+func (i *interceptor) GetExcludedDeviceCount() (int, Return) {
+	var r0 int
+	call := Call{Method: "GetExcludedDeviceCount"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GetExcludedDeviceCount()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GetExcludedDeviceInfoByIndex(index int) (ExcludedDeviceInfo, Return) {
+	var r0 ExcludedDeviceInfo
+	call := Call{Method: "GetExcludedDeviceInfoByIndex", Args: []interface{}{index}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GetExcludedDeviceInfoByIndex(index)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GetVgpuCompatibility(vgpuMetadata *VgpuMetadata, pgpuMetadata *VgpuPgpuMetadata) (VgpuPgpuCompatibility, Return) {
+	var r0 VgpuPgpuCompatibility
+	call := Call{Method: "GetVgpuCompatibility", Args: []interface{}{vgpuMetadata, pgpuMetadata}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GetVgpuCompatibility(vgpuMetadata, pgpuMetadata)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GetVgpuDriverCapabilities(capability VgpuDriverCapability) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "GetVgpuDriverCapabilities", Args: []interface{}{capability}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GetVgpuDriverCapabilities(capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GetVgpuVersion() (VgpuVersion, VgpuVersion, Return) {
+	var r0 VgpuVersion
+	var r1 VgpuVersion
+	call := Call{Method: "GetVgpuVersion"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.GetVgpuVersion()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) GpmMigSampleGet(device Device, gpuInstanceId int, gpmSample GpmSample) Return {
+	call := Call{Method: "GpmMigSampleGet", Args: []interface{}{device, gpuInstanceId, gpmSample}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpmMigSampleGet(unwrapDevice(device), gpuInstanceId, unwrapGpmSample(gpmSample))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpmQueryDeviceSupport(device Device) (GpmSupport, Return) {
+	var r0 GpmSupport
+	call := Call{Method: "GpmQueryDeviceSupport", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpmQueryDeviceSupport(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpmQueryDeviceSupportV(device Device) GpmSupportV {
+	return i.lib.GpmQueryDeviceSupportV(unwrapDevice(device))
+}
+
+func (i *interceptor) GpmQueryIfStreamingEnabled(device Device) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "GpmQueryIfStreamingEnabled", Args: []interface{}{device}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpmQueryIfStreamingEnabled(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpmSampleAlloc() (GpmSample, Return) {
+	var r0 GpmSample
+	call := Call{Method: "GpmSampleAlloc"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpmSampleAlloc()
+		return ret
+	})
+	return i.wrapGpmSample(r0), ret
+}
+
+func (i *interceptor) GpmSampleFree(gpmSample GpmSample) Return {
+	call := Call{Method: "GpmSampleFree", Args: []interface{}{gpmSample}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpmSampleFree(unwrapGpmSample(gpmSample))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpmSampleGet(device Device, gpmSample GpmSample) Return {
+	call := Call{Method: "GpmSampleGet", Args: []interface{}{device, gpmSample}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpmSampleGet(unwrapDevice(device), unwrapGpmSample(gpmSample))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpmSetStreamingEnabled(device Device, state uint32) Return {
+	call := Call{Method: "GpmSetStreamingEnabled", Args: []interface{}{device, state}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpmSetStreamingEnabled(unwrapDevice(device), state)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpuInstanceCreateComputeInstance(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstanceCreateComputeInstance", Args: []interface{}{gpuInstance, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceCreateComputeInstance(unwrapGpuInstance(gpuInstance), info)
+		return ret
+	})
+	return i.wrapComputeInstance(r0), ret
+}
+
+func (i *interceptor) GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo, placement *ComputeInstancePlacement) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstanceCreateComputeInstanceWithPlacement", Args: []interface{}{gpuInstance, info, placement}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceCreateComputeInstanceWithPlacement(unwrapGpuInstance(gpuInstance), info, placement)
+		return ret
+	})
+	return i.wrapComputeInstance(r0), ret
+}
+
+func (i *interceptor) GpuInstanceDestroy(gpuInstance GpuInstance) Return {
+	call := Call{Method: "GpuInstanceDestroy", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpuInstanceDestroy(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (ActiveVgpuInstanceInfo, Return) {
+	var r0 ActiveVgpuInstanceInfo
+	call := Call{Method: "GpuInstanceGetActiveVgpus", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetActiveVgpus(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstanceGetComputeInstanceById", Args: []interface{}{gpuInstance, id}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetComputeInstanceById(unwrapGpuInstance(gpuInstance), id)
+		return ret
+	})
+	return i.wrapComputeInstance(r0), ret
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	var r0 []ComputeInstancePlacement
+	call := Call{Method: "GpuInstanceGetComputeInstancePossiblePlacements", Args: []interface{}{gpuInstance, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetComputeInstancePossiblePlacements(unwrapGpuInstance(gpuInstance), info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstance, profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
+	var r0 ComputeInstanceProfileInfo
+	call := Call{Method: "GpuInstanceGetComputeInstanceProfileInfo", Args: []interface{}{gpuInstance, profile, engProfile}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetComputeInstanceProfileInfo(unwrapGpuInstance(gpuInstance), profile, engProfile)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) ComputeInstanceProfileInfoHandler {
+	return i.lib.GpuInstanceGetComputeInstanceProfileInfoV(unwrapGpuInstance(gpuInstance), profile, engProfile)
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (int, Return) {
+	var r0 int
+	call := Call{Method: "GpuInstanceGetComputeInstanceRemainingCapacity", Args: []interface{}{gpuInstance, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetComputeInstanceRemainingCapacity(unwrapGpuInstance(gpuInstance), info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetComputeInstances(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	var r0 []ComputeInstance
+	call := Call{Method: "GpuInstanceGetComputeInstances", Args: []interface{}{gpuInstance, info}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetComputeInstances(unwrapGpuInstance(gpuInstance), info)
+		return ret
+	})
+	return wrapHandles(r0, i.wrapComputeInstance), ret
+}
+
+func (i *interceptor) GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (VgpuTypeIdInfo, Return) {
+	var r0 VgpuTypeIdInfo
+	call := Call{Method: "GpuInstanceGetCreatableVgpus", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetCreatableVgpus(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetInfo(gpuInstance GpuInstance) (GpuInstanceInfo, Return) {
+	var r0 GpuInstanceInfo
+	call := Call{Method: "GpuInstanceGetInfo", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetInfo(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return i.wrapGpuInstanceInfo(r0), ret
+}
+
+func (i *interceptor) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (VgpuHeterogeneousMode, Return) {
+	var r0 VgpuHeterogeneousMode
+	call := Call{Method: "GpuInstanceGetVgpuHeterogeneousMode", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (VgpuSchedulerLogInfo, Return) {
+	var r0 VgpuSchedulerLogInfo
+	call := Call{Method: "GpuInstanceGetVgpuSchedulerLog", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetVgpuSchedulerLog(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (VgpuSchedulerStateInfo, Return) {
+	var r0 VgpuSchedulerStateInfo
+	call := Call{Method: "GpuInstanceGetVgpuSchedulerState", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetVgpuSchedulerState(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInstance) (VgpuCreatablePlacementInfo, Return) {
+	var r0 VgpuCreatablePlacementInfo
+	call := Call{Method: "GpuInstanceGetVgpuTypeCreatablePlacements", Args: []interface{}{gpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.GpuInstanceGetVgpuTypeCreatablePlacements(unwrapGpuInstance(gpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) GpuInstanceSetVgpuHeterogeneousMode(gpuInstance GpuInstance, heterogeneousMode *VgpuHeterogeneousMode) Return {
+	call := Call{Method: "GpuInstanceSetVgpuHeterogeneousMode", Args: []interface{}{gpuInstance, heterogeneousMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpuInstanceSetVgpuHeterogeneousMode(unwrapGpuInstance(gpuInstance), heterogeneousMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) GpuInstanceSetVgpuSchedulerState(gpuInstance GpuInstance, scheduler *VgpuSchedulerState) Return {
+	call := Call{Method: "GpuInstanceSetVgpuSchedulerState", Args: []interface{}{gpuInstance, scheduler}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.GpuInstanceSetVgpuSchedulerState(unwrapGpuInstance(gpuInstance), scheduler)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) Init() Return {
+	call := Call{Method: "Init"}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.Init()
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) InitWithFlags(flags uint32) Return {
+	call := Call{Method: "InitWithFlags", Args: []interface{}{flags}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.InitWithFlags(flags)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SetVgpuVersion(vgpuVersion *VgpuVersion) Return {
+	call := Call{Method: "SetVgpuVersion", Args: []interface{}{vgpuVersion}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SetVgpuVersion(vgpuVersion)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) Shutdown() Return {
+	call := Call{Method: "Shutdown"}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.Shutdown()
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemEventSetCreate(request *SystemEventSetCreateRequest) Return {
+	call := Call{Method: "SystemEventSetCreate", Args: []interface{}{request}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemEventSetCreate(request)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemEventSetFree(request *SystemEventSetFreeRequest) Return {
+	call := Call{Method: "SystemEventSetFree", Args: []interface{}{request}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemEventSetFree(request)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemEventSetWait(request *SystemEventSetWaitRequest) Return {
+	call := Call{Method: "SystemEventSetWait", Args: []interface{}{request}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemEventSetWait(request)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemGetConfComputeCapabilities() (ConfComputeSystemCaps, Return) {
+	var r0 ConfComputeSystemCaps
+	call := Call{Method: "SystemGetConfComputeCapabilities"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetConfComputeCapabilities()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetConfComputeGpusReadyState() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "SystemGetConfComputeGpusReadyState"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetConfComputeGpusReadyState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
+	var r0 ConfComputeGetKeyRotationThresholdInfo
+	call := Call{Method: "SystemGetConfComputeKeyRotationThresholdInfo"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetConfComputeKeyRotationThresholdInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetConfComputeSettings() (SystemConfComputeSettings, Return) {
+	var r0 SystemConfComputeSettings
+	call := Call{Method: "SystemGetConfComputeSettings"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetConfComputeSettings()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetConfComputeState() (ConfComputeSystemState, Return) {
+	var r0 ConfComputeSystemState
+	call := Call{Method: "SystemGetConfComputeState"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetConfComputeState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetCudaDriverVersion() (int, Return) {
+	var r0 int
+	call := Call{Method: "SystemGetCudaDriverVersion"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetCudaDriverVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetCudaDriverVersion_v2() (int, Return) {
+	var r0 int
+	call := Call{Method: "SystemGetCudaDriverVersion_v2"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetCudaDriverVersion_v2()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetDriverBranch() (SystemDriverBranchInfo, Return) {
+	var r0 SystemDriverBranchInfo
+	call := Call{Method: "SystemGetDriverBranch"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetDriverBranch()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetDriverVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "SystemGetDriverVersion"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetDriverVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetHicVersion() ([]HwbcEntry, Return) {
+	var r0 []HwbcEntry
+	call := Call{Method: "SystemGetHicVersion"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetHicVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetNVMLVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "SystemGetNVMLVersion"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetNVMLVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetNvlinkBwMode() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "SystemGetNvlinkBwMode"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetNvlinkBwMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetProcessName(pid int) (string, Return) {
+	var r0 string
+	call := Call{Method: "SystemGetProcessName", Args: []interface{}{pid}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetProcessName(pid)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, Return) {
+	var r0 []Device
+	call := Call{Method: "SystemGetTopologyGpuSet", Args: []interface{}{cpuNumber}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.SystemGetTopologyGpuSet(cpuNumber)
+		return ret
+	})
+	return wrapHandles(r0, i.wrapDevice), ret
+}
+
+func (i *interceptor) SystemRegisterEvents(request *SystemRegisterEventRequest) Return {
+	call := Call{Method: "SystemRegisterEvents", Args: []interface{}{request}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemRegisterEvents(request)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) Return {
+	call := Call{Method: "SystemSetConfComputeGpusReadyState", Args: []interface{}{isAcceptingWork}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemSetConfComputeGpusReadyState(isAcceptingWork)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo ConfComputeSetKeyRotationThresholdInfo) Return {
+	call := Call{Method: "SystemSetConfComputeKeyRotationThresholdInfo", Args: []interface{}{keyRotationThresholdInfo}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) SystemSetNvlinkBwMode(nvlinkBwMode uint32) Return {
+	call := Call{Method: "SystemSetNvlinkBwMode", Args: []interface{}{nvlinkBwMode}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.SystemSetNvlinkBwMode(nvlinkBwMode)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) UnitGetCount() (int, Return) {
+	var r0 int
+	call := Call{Method: "UnitGetCount"}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetCount()
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitGetDevices(unit Unit) ([]Device, Return) {
+	var r0 []Device
+	call := Call{Method: "UnitGetDevices", Args: []interface{}{unit}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetDevices(unwrapUnit(unit))
+		return ret
+	})
+	return wrapHandles(r0, i.wrapDevice), ret
+}
+
+func (i *interceptor) UnitGetFanSpeedInfo(unit Unit) (UnitFanSpeeds, Return) {
+	var r0 UnitFanSpeeds
+	call := Call{Method: "UnitGetFanSpeedInfo", Args: []interface{}{unit}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetFanSpeedInfo(unwrapUnit(unit))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitGetHandleByIndex(index int) (Unit, Return) {
+	var r0 Unit
+	call := Call{Method: "UnitGetHandleByIndex", Args: []interface{}{index}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetHandleByIndex(index)
+		return ret
+	})
+	return i.wrapUnit(r0), ret
+}
+
+func (i *interceptor) UnitGetLedState(unit Unit) (LedState, Return) {
+	var r0 LedState
+	call := Call{Method: "UnitGetLedState", Args: []interface{}{unit}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetLedState(unwrapUnit(unit))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitGetPsuInfo(unit Unit) (PSUInfo, Return) {
+	var r0 PSUInfo
+	call := Call{Method: "UnitGetPsuInfo", Args: []interface{}{unit}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetPsuInfo(unwrapUnit(unit))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitGetTemperature(unit Unit, ttype int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "UnitGetTemperature", Args: []interface{}{unit, ttype}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetTemperature(unwrapUnit(unit), ttype)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitGetUnitInfo(unit Unit) (UnitInfo, Return) {
+	var r0 UnitInfo
+	call := Call{Method: "UnitGetUnitInfo", Args: []interface{}{unit}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.UnitGetUnitInfo(unwrapUnit(unit))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) UnitSetLedState(unit Unit, color LedColor) Return {
+	call := Call{Method: "UnitSetLedState", Args: []interface{}{unit, color}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.UnitSetLedState(unwrapUnit(unit), color)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) VgpuInstanceClearAccountingPids(vgpuInstance VgpuInstance) Return {
+	call := Call{Method: "VgpuInstanceClearAccountingPids", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.VgpuInstanceClearAccountingPids(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) VgpuInstanceGetAccountingMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "VgpuInstanceGetAccountingMode", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetAccountingMode(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, Return) {
+	var r0 []int
+	call := Call{Method: "VgpuInstanceGetAccountingPids", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetAccountingPids(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (AccountingStats, Return) {
+	var r0 AccountingStats
+	call := Call{Method: "VgpuInstanceGetAccountingStats", Args: []interface{}{vgpuInstance, pid}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetAccountingStats(unwrapVgpuInstance(vgpuInstance), pid)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetEccMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "VgpuInstanceGetEccMode", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetEccMode(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetEncoderCapacity(vgpuInstance VgpuInstance) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstanceGetEncoderCapacity", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetEncoderCapacity(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetEncoderSessions(vgpuInstance VgpuInstance) (int, EncoderSessionInfo, Return) {
+	var r0 int
+	var r1 EncoderSessionInfo
+	call := Call{Method: "VgpuInstanceGetEncoderSessions", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.VgpuInstanceGetEncoderSessions(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) VgpuInstanceGetEncoderStats(vgpuInstance VgpuInstance) (int, uint32, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	var r2 uint32
+	call := Call{Method: "VgpuInstanceGetEncoderStats", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, r2, ret = i.lib.VgpuInstanceGetEncoderStats(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, r1, r2, ret
+}
+
+func (i *interceptor) VgpuInstanceGetFBCSessions(vgpuInstance VgpuInstance) (int, FBCSessionInfo, Return) {
+	var r0 int
+	var r1 FBCSessionInfo
+	call := Call{Method: "VgpuInstanceGetFBCSessions", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.VgpuInstanceGetFBCSessions(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) VgpuInstanceGetFBCStats(vgpuInstance VgpuInstance) (FBCStats, Return) {
+	var r0 FBCStats
+	call := Call{Method: "VgpuInstanceGetFBCStats", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetFBCStats(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetFbUsage(vgpuInstance VgpuInstance) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuInstanceGetFbUsage", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetFbUsage(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetFrameRateLimit(vgpuInstance VgpuInstance) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuInstanceGetFrameRateLimit", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetFrameRateLimit(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetGpuInstanceId(vgpuInstance VgpuInstance) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstanceGetGpuInstanceId", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetGpuInstanceId(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetGpuPciId(vgpuInstance VgpuInstance) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstanceGetGpuPciId", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetGpuPciId(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (VgpuLicenseInfo, Return) {
+	var r0 VgpuLicenseInfo
+	call := Call{Method: "VgpuInstanceGetLicenseInfo", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetLicenseInfo(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetLicenseStatus(vgpuInstance VgpuInstance) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstanceGetLicenseStatus", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetLicenseStatus(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetMdevUUID(vgpuInstance VgpuInstance) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstanceGetMdevUUID", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetMdevUUID(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetMetadata(vgpuInstance VgpuInstance) (VgpuMetadata, Return) {
+	var r0 VgpuMetadata
+	call := Call{Method: "VgpuInstanceGetMetadata", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetMetadata(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetPlacementId(vgpuInstance VgpuInstance) (VgpuPlacementId, Return) {
+	var r0 VgpuPlacementId
+	call := Call{Method: "VgpuInstanceGetPlacementId", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetPlacementId(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (VgpuRuntimeState, Return) {
+	var r0 VgpuRuntimeState
+	call := Call{Method: "VgpuInstanceGetRuntimeStateSize", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetRuntimeStateSize(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetType(vgpuInstance VgpuInstance) (VgpuTypeId, Return) {
+	var r0 VgpuTypeId
+	call := Call{Method: "VgpuInstanceGetType", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetType(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return i.wrapVgpuTypeId(r0), ret
+}
+
+func (i *interceptor) VgpuInstanceGetUUID(vgpuInstance VgpuInstance) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstanceGetUUID", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetUUID(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetVmDriverVersion(vgpuInstance VgpuInstance) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstanceGetVmDriverVersion", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuInstanceGetVmDriverVersion(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, VgpuVmIdType, Return) {
+	var r0 string
+	var r1 VgpuVmIdType
+	call := Call{Method: "VgpuInstanceGetVmID", Args: []interface{}{vgpuInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.VgpuInstanceGetVmID(unwrapVgpuInstance(vgpuInstance))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) VgpuInstanceSetEncoderCapacity(vgpuInstance VgpuInstance, encoderCapacity int) Return {
+	call := Call{Method: "VgpuInstanceSetEncoderCapacity", Args: []interface{}{vgpuInstance, encoderCapacity}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.VgpuInstanceSetEncoderCapacity(unwrapVgpuInstance(vgpuInstance), encoderCapacity)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (VgpuTypeBar1Info, Return) {
+	var r0 VgpuTypeBar1Info
+	call := Call{Method: "VgpuTypeGetBAR1Info", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetBAR1Info(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetCapabilities(vgpuTypeId VgpuTypeId, capability VgpuCapability) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "VgpuTypeGetCapabilities", Args: []interface{}{vgpuTypeId, capability}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetCapabilities(unwrapVgpuTypeId(vgpuTypeId), capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetClass(vgpuTypeId VgpuTypeId) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeGetClass", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetClass(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetDeviceID(vgpuTypeId VgpuTypeId) (uint64, uint64, Return) {
+	var r0 uint64
+	var r1 uint64
+	call := Call{Method: "VgpuTypeGetDeviceID", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.VgpuTypeGetDeviceID(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (i *interceptor) VgpuTypeGetFbReservation(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeGetFbReservation", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetFbReservation(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetFrameRateLimit(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuTypeGetFrameRateLimit", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetFrameRateLimit(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetFramebufferSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeGetFramebufferSize", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetFramebufferSize(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetGpuInstanceProfileId(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuTypeGetGpuInstanceProfileId", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetGpuInstanceProfileId(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetGspHeapSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeGetGspHeapSize", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetGspHeapSize(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetLicense(vgpuTypeId VgpuTypeId) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeGetLicense", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetLicense(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetMaxInstances(device Device, vgpuTypeId VgpuTypeId) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeGetMaxInstances", Args: []interface{}{device, vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetMaxInstances(unwrapDevice(device), unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *VgpuTypeMaxInstance) Return {
+	call := Call{Method: "VgpuTypeGetMaxInstancesPerGpuInstance", Args: []interface{}{maxInstance}}
+	ret := i.intercept(call, func() (ret Return) {
+		ret = i.lib.VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance)
+		return ret
+	})
+	return ret
+}
+
+func (i *interceptor) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeGetMaxInstancesPerVm", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetMaxInstancesPerVm(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetName(vgpuTypeId VgpuTypeId) (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeGetName", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetName(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetNumDisplayHeads(vgpuTypeId VgpuTypeId) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeGetNumDisplayHeads", Args: []interface{}{vgpuTypeId}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, ret = i.lib.VgpuTypeGetNumDisplayHeads(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (i *interceptor) VgpuTypeGetResolution(vgpuTypeId VgpuTypeId, displayIndex int) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "VgpuTypeGetResolution", Args: []interface{}{vgpuTypeId, displayIndex}}
+	ret := i.intercept(call, func() (ret Return) {
+		r0, r1, ret = i.lib.VgpuTypeGetResolution(unwrapVgpuTypeId(vgpuTypeId), displayIndex)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+var _ Device = (*interceptedDevice)(nil)
+
+func (h *interceptedDevice) ClearAccountingPids() Return {
+	call := Call{Method: "Device.ClearAccountingPids", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ClearAccountingPids()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ClearCpuAffinity() Return {
+	call := Call{Method: "Device.ClearCpuAffinity", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ClearCpuAffinity()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ClearEccErrorCounts(counterType EccCounterType) Return {
+	call := Call{Method: "Device.ClearEccErrorCounts", Receiver: h, Args: []interface{}{counterType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ClearEccErrorCounts(counterType)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ClearFieldValues(values []FieldValue) Return {
+	call := Call{Method: "Device.ClearFieldValues", Receiver: h, Args: []interface{}{values}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ClearFieldValues(values)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) CreateGpuInstance(info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "Device.CreateGpuInstance", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.CreateGpuInstance(info)
+		return ret
+	})
+	return h.i.wrapGpuInstance(r0), ret
+}
+
+func (h *interceptedDevice) CreateGpuInstanceWithPlacement(info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "Device.CreateGpuInstanceWithPlacement", Receiver: h, Args: []interface{}{info, placement}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.CreateGpuInstanceWithPlacement(info, placement)
+		return ret
+	})
+	return h.i.wrapGpuInstance(r0), ret
+}
+
+func (h *interceptedDevice) FreezeNvLinkUtilizationCounter(link int, counter int, freeze EnableState) Return {
+	call := Call{Method: "Device.FreezeNvLinkUtilizationCounter", Receiver: h, Args: []interface{}{link, counter, freeze}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.FreezeNvLinkUtilizationCounter(link, counter, freeze)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GetAPIRestriction(apiType RestrictedAPI) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetAPIRestriction", Receiver: h, Args: []interface{}{apiType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAPIRestriction(apiType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAccountingBufferSize() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetAccountingBufferSize", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingBufferSize()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAccountingMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetAccountingMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAccountingPids() ([]int, Return) {
+	var r0 []int
+	call := Call{Method: "Device.GetAccountingPids", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingPids()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAccountingStats(pid uint32) (AccountingStats, Return) {
+	var r0 AccountingStats
+	call := Call{Method: "Device.GetAccountingStats", Receiver: h, Args: []interface{}{pid}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingStats(pid)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
+	var r0 []VgpuInstance
+	call := Call{Method: "Device.GetActiveVgpus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetActiveVgpus()
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapVgpuInstance), ret
+}
+
+func (h *interceptedDevice) GetAdaptiveClockInfoStatus() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetAdaptiveClockInfoStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAdaptiveClockInfoStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAddressingMode() (DeviceAddressingMode, Return) {
+	var r0 DeviceAddressingMode
+	call := Call{Method: "Device.GetAddressingMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAddressingMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetApplicationsClock(clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetApplicationsClock", Receiver: h, Args: []interface{}{clockType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetApplicationsClock(clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetArchitecture() (DeviceArchitecture, Return) {
+	var r0 DeviceArchitecture
+	call := Call{Method: "Device.GetArchitecture", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetArchitecture()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAttributes() (DeviceAttributes, Return) {
+	var r0 DeviceAttributes
+	call := Call{Method: "Device.GetAttributes", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAttributes()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetAutoBoostedClocksEnabled() (EnableState, EnableState, Return) {
+	var r0 EnableState
+	var r1 EnableState
+	call := Call{Method: "Device.GetAutoBoostedClocksEnabled", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetAutoBoostedClocksEnabled()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetBAR1MemoryInfo() (BAR1Memory, Return) {
+	var r0 BAR1Memory
+	call := Call{Method: "Device.GetBAR1MemoryInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBAR1MemoryInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetBoardId() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetBoardId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBoardId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetBoardPartNumber() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetBoardPartNumber", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBoardPartNumber()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetBrand() (BrandType, Return) {
+	var r0 BrandType
+	call := Call{Method: "Device.GetBrand", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBrand()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetBridgeChipInfo() (BridgeChipHierarchy, Return) {
+	var r0 BridgeChipHierarchy
+	call := Call{Method: "Device.GetBridgeChipInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBridgeChipInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetBusType() (BusType, Return) {
+	var r0 BusType
+	call := Call{Method: "Device.GetBusType", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBusType()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetC2cModeInfoV() C2cModeInfoHandler {
+	return h.handle.GetC2cModeInfoV()
+}
+
+func (h *interceptedDevice) GetCapabilities() (DeviceCapabilities, Return) {
+	var r0 DeviceCapabilities
+	call := Call{Method: "Device.GetCapabilities", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCapabilities()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetClkMonStatus() (ClkMonStatus, Return) {
+	var r0 ClkMonStatus
+	call := Call{Method: "Device.GetClkMonStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetClkMonStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetClock(clockType ClockType, clockId ClockId) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetClock", Receiver: h, Args: []interface{}{clockType, clockId}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetClock(clockType, clockId)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetClockInfo(clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetClockInfo", Receiver: h, Args: []interface{}{clockType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetClockInfo(clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetClockOffsets() (ClockOffset, Return) {
+	var r0 ClockOffset
+	call := Call{Method: "Device.GetClockOffsets", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetClockOffsets()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetComputeInstanceId() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetComputeInstanceId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstanceId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetComputeMode() (ComputeMode, Return) {
+	var r0 ComputeMode
+	call := Call{Method: "Device.GetComputeMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetComputeRunningProcesses() ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "Device.GetComputeRunningProcesses", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeRunningProcesses()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetConfComputeGpuAttestationReport(gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	call := Call{Method: "Device.GetConfComputeGpuAttestationReport", Receiver: h, Args: []interface{}{gpuAtstReport}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GetConfComputeGpuAttestationReport(gpuAtstReport)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GetConfComputeGpuCertificate() (ConfComputeGpuCertificate, Return) {
+	var r0 ConfComputeGpuCertificate
+	call := Call{Method: "Device.GetConfComputeGpuCertificate", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetConfComputeGpuCertificate()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetConfComputeMemSizeInfo() (ConfComputeMemSizeInfo, Return) {
+	var r0 ConfComputeMemSizeInfo
+	call := Call{Method: "Device.GetConfComputeMemSizeInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetConfComputeMemSizeInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetConfComputeProtectedMemoryUsage() (Memory, Return) {
+	var r0 Memory
+	call := Call{Method: "Device.GetConfComputeProtectedMemoryUsage", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetConfComputeProtectedMemoryUsage()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCoolerInfo() (CoolerInfo, Return) {
+	var r0 CoolerInfo
+	call := Call{Method: "Device.GetCoolerInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCoolerInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCpuAffinity(numCPUs int) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "Device.GetCpuAffinity", Receiver: h, Args: []interface{}{numCPUs}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCpuAffinity(numCPUs)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCpuAffinityWithinScope(numCPUs int, scope AffinityScope) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "Device.GetCpuAffinityWithinScope", Receiver: h, Args: []interface{}{numCPUs, scope}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCpuAffinityWithinScope(numCPUs, scope)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCreatableVgpus() ([]VgpuTypeId, Return) {
+	var r0 []VgpuTypeId
+	call := Call{Method: "Device.GetCreatableVgpus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCreatableVgpus()
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapVgpuTypeId), ret
+}
+
+func (h *interceptedDevice) GetCudaComputeCapability() (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "Device.GetCudaComputeCapability", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetCudaComputeCapability()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetCurrPcieLinkGeneration() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetCurrPcieLinkGeneration", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCurrPcieLinkGeneration()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCurrPcieLinkWidth() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetCurrPcieLinkWidth", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCurrPcieLinkWidth()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCurrentClockFreqs() (DeviceCurrentClockFreqs, Return) {
+	var r0 DeviceCurrentClockFreqs
+	call := Call{Method: "Device.GetCurrentClockFreqs", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCurrentClockFreqs()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCurrentClocksEventReasons() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetCurrentClocksEventReasons", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCurrentClocksEventReasons()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetCurrentClocksThrottleReasons() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetCurrentClocksThrottleReasons", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCurrentClocksThrottleReasons()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDecoderUtilization() (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetDecoderUtilization", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetDecoderUtilization()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetDefaultApplicationsClock(clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetDefaultApplicationsClock", Receiver: h, Args: []interface{}{clockType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDefaultApplicationsClock(clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDefaultEccMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetDefaultEccMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDefaultEccMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDetailedEccErrors(errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	var r0 EccErrorCounts
+	call := Call{Method: "Device.GetDetailedEccErrors", Receiver: h, Args: []interface{}{errorType, counterType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDetailedEccErrors(errorType, counterType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDeviceHandleFromMigDeviceHandle() (Device, Return) {
+	var r0 Device
+	call := Call{Method: "Device.GetDeviceHandleFromMigDeviceHandle", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDeviceHandleFromMigDeviceHandle()
+		return ret
+	})
+	return h.i.wrapDevice(r0), ret
+}
+
+func (h *interceptedDevice) GetDisplayActive() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetDisplayActive", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDisplayActive()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDisplayMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetDisplayMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDisplayMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetDramEncryptionMode() (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	var r0 DramEncryptionInfo
+	var r1 DramEncryptionInfo
+	call := Call{Method: "Device.GetDramEncryptionMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetDramEncryptionMode()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetDriverModel() (DriverModel, DriverModel, Return) {
+	var r0 DriverModel
+	var r1 DriverModel
+	call := Call{Method: "Device.GetDriverModel", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetDriverModel()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetDriverModel_v2() (DriverModel, DriverModel, Return) {
+	var r0 DriverModel
+	var r1 DriverModel
+	call := Call{Method: "Device.GetDriverModel_v2", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetDriverModel_v2()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetDynamicPstatesInfo() (GpuDynamicPstatesInfo, Return) {
+	var r0 GpuDynamicPstatesInfo
+	call := Call{Method: "Device.GetDynamicPstatesInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDynamicPstatesInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetEccMode() (EnableState, EnableState, Return) {
+	var r0 EnableState
+	var r1 EnableState
+	call := Call{Method: "Device.GetEccMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetEccMode()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetEncoderCapacity(encoderQueryType EncoderType) (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetEncoderCapacity", Receiver: h, Args: []interface{}{encoderQueryType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetEncoderCapacity(encoderQueryType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetEncoderSessions() ([]EncoderSessionInfo, Return) {
+	var r0 []EncoderSessionInfo
+	call := Call{Method: "Device.GetEncoderSessions", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetEncoderSessions()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetEncoderStats() (int, uint32, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	var r2 uint32
+	call := Call{Method: "Device.GetEncoderStats", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, r2, ret = h.handle.GetEncoderStats()
+		return ret
+	})
+	return r0, r1, r2, ret
+}
+
+func (h *interceptedDevice) GetEncoderUtilization() (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetEncoderUtilization", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetEncoderUtilization()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetEnforcedPowerLimit() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetEnforcedPowerLimit", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetEnforcedPowerLimit()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFBCSessions() ([]FBCSessionInfo, Return) {
+	var r0 []FBCSessionInfo
+	call := Call{Method: "Device.GetFBCSessions", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFBCSessions()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFBCStats() (FBCStats, Return) {
+	var r0 FBCStats
+	call := Call{Method: "Device.GetFBCStats", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFBCStats()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFanControlPolicy_v2(fan int) (FanControlPolicy, Return) {
+	var r0 FanControlPolicy
+	call := Call{Method: "Device.GetFanControlPolicy_v2", Receiver: h, Args: []interface{}{fan}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFanControlPolicy_v2(fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFanSpeed() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetFanSpeed", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFanSpeed()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFanSpeedRPM() (FanSpeedInfo, Return) {
+	var r0 FanSpeedInfo
+	call := Call{Method: "Device.GetFanSpeedRPM", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFanSpeedRPM()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFanSpeed_v2(fan int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetFanSpeed_v2", Receiver: h, Args: []interface{}{fan}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFanSpeed_v2(fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetFieldValues(values []FieldValue) Return {
+	call := Call{Method: "Device.GetFieldValues", Receiver: h, Args: []interface{}{values}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GetFieldValues(values)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GetGpcClkMinMaxVfOffset() (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "Device.GetGpcClkMinMaxVfOffset", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetGpcClkMinMaxVfOffset()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetGpcClkVfOffset() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetGpcClkVfOffset", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpcClkVfOffset()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuFabricInfo() (GpuFabricInfo, Return) {
+	var r0 GpuFabricInfo
+	call := Call{Method: "Device.GetGpuFabricInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuFabricInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuFabricInfoV() GpuFabricInfoHandler {
+	return h.handle.GetGpuFabricInfoV()
+}
+
+func (h *interceptedDevice) GetGpuInstanceById(id int) (GpuInstance, Return) {
+	var r0 GpuInstance
+	call := Call{Method: "Device.GetGpuInstanceById", Receiver: h, Args: []interface{}{id}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceById(id)
+		return ret
+	})
+	return h.i.wrapGpuInstance(r0), ret
+}
+
+func (h *interceptedDevice) GetGpuInstanceId() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetGpuInstanceId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuInstancePossiblePlacements(info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	var r0 []GpuInstancePlacement
+	call := Call{Method: "Device.GetGpuInstancePossiblePlacements", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstancePossiblePlacements(info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuInstanceProfileInfo(profile int) (GpuInstanceProfileInfo, Return) {
+	var r0 GpuInstanceProfileInfo
+	call := Call{Method: "Device.GetGpuInstanceProfileInfo", Receiver: h, Args: []interface{}{profile}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceProfileInfo(profile)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuInstanceProfileInfoByIdV(profileId int) GpuInstanceProfileInfoByIdHandler {
+	return h.handle.GetGpuInstanceProfileInfoByIdV(profileId)
+}
+
+func (h *interceptedDevice) GetGpuInstanceProfileInfoV(profile int) GpuInstanceProfileInfoHandler {
+	return h.handle.GetGpuInstanceProfileInfoV(profile)
+}
+
+func (h *interceptedDevice) GetGpuInstanceRemainingCapacity(info *GpuInstanceProfileInfo) (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetGpuInstanceRemainingCapacity", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceRemainingCapacity(info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuInstances(info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	var r0 []GpuInstance
+	call := Call{Method: "Device.GetGpuInstances", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstances(info)
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapGpuInstance), ret
+}
+
+func (h *interceptedDevice) GetGpuMaxPcieLinkGeneration() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetGpuMaxPcieLinkGeneration", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuMaxPcieLinkGeneration()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGpuOperationMode() (GpuOperationMode, GpuOperationMode, Return) {
+	var r0 GpuOperationMode
+	var r1 GpuOperationMode
+	call := Call{Method: "Device.GetGpuOperationMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetGpuOperationMode()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetGraphicsRunningProcesses() ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "Device.GetGraphicsRunningProcesses", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGraphicsRunningProcesses()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGridLicensableFeatures() (GridLicensableFeatures, Return) {
+	var r0 GridLicensableFeatures
+	call := Call{Method: "Device.GetGridLicensableFeatures", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGridLicensableFeatures()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetGspFirmwareMode() (bool, bool, Return) {
+	var r0 bool
+	var r1 bool
+	call := Call{Method: "Device.GetGspFirmwareMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetGspFirmwareMode()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetGspFirmwareVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetGspFirmwareVersion", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGspFirmwareVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetHostVgpuMode() (HostVgpuMode, Return) {
+	var r0 HostVgpuMode
+	call := Call{Method: "Device.GetHostVgpuMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetHostVgpuMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetIndex() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetIndex", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetIndex()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetInforomConfigurationChecksum() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetInforomConfigurationChecksum", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetInforomConfigurationChecksum()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetInforomImageVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetInforomImageVersion", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetInforomImageVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetInforomVersion(object InforomObject) (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetInforomVersion", Receiver: h, Args: []interface{}{object}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetInforomVersion(object)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetIrqNum() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetIrqNum", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetIrqNum()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetJpgUtilization() (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetJpgUtilization", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetJpgUtilization()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetLastBBXFlushTime() (uint64, uint, Return) {
+	var r0 uint64
+	var r1 uint
+	call := Call{Method: "Device.GetLastBBXFlushTime", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetLastBBXFlushTime()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetMPSComputeRunningProcesses() ([]ProcessInfo, Return) {
+	var r0 []ProcessInfo
+	call := Call{Method: "Device.GetMPSComputeRunningProcesses", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMPSComputeRunningProcesses()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMarginTemperature() (MarginTemperature, Return) {
+	var r0 MarginTemperature
+	call := Call{Method: "Device.GetMarginTemperature", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMarginTemperature()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMaxClockInfo(clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetMaxClockInfo", Receiver: h, Args: []interface{}{clockType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxClockInfo(clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMaxCustomerBoostClock(clockType ClockType) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetMaxCustomerBoostClock", Receiver: h, Args: []interface{}{clockType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxCustomerBoostClock(clockType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMaxMigDeviceCount() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMaxMigDeviceCount", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxMigDeviceCount()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMaxPcieLinkGeneration() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMaxPcieLinkGeneration", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxPcieLinkGeneration()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMaxPcieLinkWidth() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMaxPcieLinkWidth", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxPcieLinkWidth()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemClkMinMaxVfOffset() (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "Device.GetMemClkMinMaxVfOffset", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetMemClkMinMaxVfOffset()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetMemClkVfOffset() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMemClkVfOffset", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemClkVfOffset()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemoryAffinity(numNodes int, scope AffinityScope) ([]uint, Return) {
+	var r0 []uint
+	call := Call{Method: "Device.GetMemoryAffinity", Receiver: h, Args: []interface{}{numNodes, scope}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemoryAffinity(numNodes, scope)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemoryBusWidth() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetMemoryBusWidth", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemoryBusWidth()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemoryErrorCounter(errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetMemoryErrorCounter", Receiver: h, Args: []interface{}{errorType, counterType, locationType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemoryErrorCounter(errorType, counterType, locationType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemoryInfo() (Memory, Return) {
+	var r0 Memory
+	call := Call{Method: "Device.GetMemoryInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemoryInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMemoryInfo_v2() (Memory_v2, Return) {
+	var r0 Memory_v2
+	call := Call{Method: "Device.GetMemoryInfo_v2", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMemoryInfo_v2()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMigDeviceHandleByIndex(index int) (Device, Return) {
+	var r0 Device
+	call := Call{Method: "Device.GetMigDeviceHandleByIndex", Receiver: h, Args: []interface{}{index}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMigDeviceHandleByIndex(index)
+		return ret
+	})
+	return h.i.wrapDevice(r0), ret
+}
+
+func (h *interceptedDevice) GetMigMode() (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "Device.GetMigMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetMigMode()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetMinMaxClockOfPState(clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetMinMaxClockOfPState", Receiver: h, Args: []interface{}{clockType, pstate}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetMinMaxClockOfPState(clockType, pstate)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetMinMaxFanSpeed() (int, int, Return) {
+	var r0 int
+	var r1 int
+	call := Call{Method: "Device.GetMinMaxFanSpeed", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetMinMaxFanSpeed()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetMinorNumber() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMinorNumber", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMinorNumber()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetModuleId() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetModuleId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetModuleId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetMultiGpuBoard() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetMultiGpuBoard", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMultiGpuBoard()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetName() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetName", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetName()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNumFans() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetNumFans", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNumFans()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNumGpuCores() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetNumGpuCores", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNumGpuCores()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNumaNodeId() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetNumaNodeId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNumaNodeId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkCapability(link int, capability NvLinkCapability) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetNvLinkCapability", Receiver: h, Args: []interface{}{link, capability}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkCapability(link, capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkErrorCounter(link int, counter NvLinkErrorCounter) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetNvLinkErrorCounter", Receiver: h, Args: []interface{}{link, counter}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkErrorCounter(link, counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkInfo() NvLinkInfoHandler {
+	return h.handle.GetNvLinkInfo()
+}
+
+func (h *interceptedDevice) GetNvLinkRemoteDeviceType(link int) (IntNvLinkDeviceType, Return) {
+	var r0 IntNvLinkDeviceType
+	call := Call{Method: "Device.GetNvLinkRemoteDeviceType", Receiver: h, Args: []interface{}{link}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkRemoteDeviceType(link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkRemotePciInfo(link int) (PciInfo, Return) {
+	var r0 PciInfo
+	call := Call{Method: "Device.GetNvLinkRemotePciInfo", Receiver: h, Args: []interface{}{link}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkRemotePciInfo(link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkState(link int) (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetNvLinkState", Receiver: h, Args: []interface{}{link}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkState(link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkUtilizationControl(link int, counter int) (NvLinkUtilizationControl, Return) {
+	var r0 NvLinkUtilizationControl
+	call := Call{Method: "Device.GetNvLinkUtilizationControl", Receiver: h, Args: []interface{}{link, counter}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkUtilizationControl(link, counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, Return) {
+	var r0 uint64
+	var r1 uint64
+	call := Call{Method: "Device.GetNvLinkUtilizationCounter", Receiver: h, Args: []interface{}{link, counter}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetNvLinkUtilizationCounter(link, counter)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetNvLinkVersion(link int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetNvLinkVersion", Receiver: h, Args: []interface{}{link}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvLinkVersion(link)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvlinkBwMode() (NvlinkGetBwMode, Return) {
+	var r0 NvlinkGetBwMode
+	call := Call{Method: "Device.GetNvlinkBwMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvlinkBwMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetNvlinkSupportedBwModes() (NvlinkSupportedBwModes, Return) {
+	var r0 NvlinkSupportedBwModes
+	call := Call{Method: "Device.GetNvlinkSupportedBwModes", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNvlinkSupportedBwModes()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetOfaUtilization() (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetOfaUtilization", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetOfaUtilization()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetP2PStatus(device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	var r0 GpuP2PStatus
+	call := Call{Method: "Device.GetP2PStatus", Receiver: h, Args: []interface{}{device2, p2pIndex}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetP2PStatus(unwrapDevice(device2), p2pIndex)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPciInfo() (PciInfo, Return) {
+	var r0 PciInfo
+	call := Call{Method: "Device.GetPciInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPciInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPciInfoExt() (PciInfoExt, Return) {
+	var r0 PciInfoExt
+	call := Call{Method: "Device.GetPciInfoExt", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPciInfoExt()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPcieLinkMaxSpeed() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetPcieLinkMaxSpeed", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPcieLinkMaxSpeed()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPcieReplayCounter() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetPcieReplayCounter", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPcieReplayCounter()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPcieSpeed() (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetPcieSpeed", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPcieSpeed()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPcieThroughput(counter PcieUtilCounter) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetPcieThroughput", Receiver: h, Args: []interface{}{counter}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPcieThroughput(counter)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPdi() (Pdi, Return) {
+	var r0 Pdi
+	call := Call{Method: "Device.GetPdi", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPdi()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPerformanceModes() (DevicePerfModes, Return) {
+	var r0 DevicePerfModes
+	call := Call{Method: "Device.GetPerformanceModes", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPerformanceModes()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPerformanceState() (Pstates, Return) {
+	var r0 Pstates
+	call := Call{Method: "Device.GetPerformanceState", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPerformanceState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPersistenceMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetPersistenceMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPersistenceMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPgpuMetadataString() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetPgpuMetadataString", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPgpuMetadataString()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPlatformInfo() (PlatformInfo, Return) {
+	var r0 PlatformInfo
+	call := Call{Method: "Device.GetPlatformInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPlatformInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerManagementDefaultLimit() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetPowerManagementDefaultLimit", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerManagementDefaultLimit()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerManagementLimit() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetPowerManagementLimit", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerManagementLimit()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerManagementLimitConstraints() (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "Device.GetPowerManagementLimitConstraints", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetPowerManagementLimitConstraints()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetPowerManagementMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetPowerManagementMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerManagementMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerMizerMode_v1() (DevicePowerMizerModes_v1, Return) {
+	var r0 DevicePowerMizerModes_v1
+	call := Call{Method: "Device.GetPowerMizerMode_v1", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerMizerMode_v1()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerSource() (PowerSource, Return) {
+	var r0 PowerSource
+	call := Call{Method: "Device.GetPowerSource", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerSource()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerState() (Pstates, Return) {
+	var r0 Pstates
+	call := Call{Method: "Device.GetPowerState", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetPowerUsage() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetPowerUsage", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPowerUsage()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetProcessUtilization(lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	var r0 []ProcessUtilizationSample
+	call := Call{Method: "Device.GetProcessUtilization", Receiver: h, Args: []interface{}{lastSeenTimestamp}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetProcessUtilization(lastSeenTimestamp)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetProcessesUtilizationInfo() (ProcessesUtilizationInfo, Return) {
+	var r0 ProcessesUtilizationInfo
+	call := Call{Method: "Device.GetProcessesUtilizationInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetProcessesUtilizationInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetRemappedRows() (int, int, bool, bool, Return) {
+	var r0 int
+	var r1 int
+	var r2 bool
+	var r3 bool
+	call := Call{Method: "Device.GetRemappedRows", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, r2, r3, ret = h.handle.GetRemappedRows()
+		return ret
+	})
+	return r0, r1, r2, r3, ret
+}
+
+func (h *interceptedDevice) GetRepairStatus() (RepairStatus, Return) {
+	var r0 RepairStatus
+	call := Call{Method: "Device.GetRepairStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRepairStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetRetiredPages(cause PageRetirementCause) ([]uint64, Return) {
+	var r0 []uint64
+	call := Call{Method: "Device.GetRetiredPages", Receiver: h, Args: []interface{}{cause}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRetiredPages(cause)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetRetiredPagesPendingStatus() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "Device.GetRetiredPagesPendingStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRetiredPagesPendingStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetRetiredPages_v2(cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	var r0 []uint64
+	var r1 []uint64
+	call := Call{Method: "Device.GetRetiredPages_v2", Receiver: h, Args: []interface{}{cause}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetRetiredPages_v2(cause)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetRowRemapperHistogram() (RowRemapperHistogramValues, Return) {
+	var r0 RowRemapperHistogramValues
+	call := Call{Method: "Device.GetRowRemapperHistogram", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRowRemapperHistogram()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetRunningProcessDetailList() (ProcessDetailList, Return) {
+	var r0 ProcessDetailList
+	call := Call{Method: "Device.GetRunningProcessDetailList", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRunningProcessDetailList()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSamples(samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	var r0 ValueType
+	var r1 []Sample
+	call := Call{Method: "Device.GetSamples", Receiver: h, Args: []interface{}{samplingType, lastSeenTimestamp}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetSamples(samplingType, lastSeenTimestamp)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetSerial() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetSerial", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSerial()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSramEccErrorStatus() (EccSramErrorStatus, Return) {
+	var r0 EccSramErrorStatus
+	call := Call{Method: "Device.GetSramEccErrorStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSramEccErrorStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSramUniqueUncorrectedEccErrorCounts(errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	call := Call{Method: "Device.GetSramUniqueUncorrectedEccErrorCounts", Receiver: h, Args: []interface{}{errorCounts}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GetSramUniqueUncorrectedEccErrorCounts(errorCounts)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GetSupportedClocksEventReasons() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetSupportedClocksEventReasons", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedClocksEventReasons()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSupportedClocksThrottleReasons() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetSupportedClocksThrottleReasons", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedClocksThrottleReasons()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSupportedEventTypes() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetSupportedEventTypes", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedEventTypes()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	call := Call{Method: "Device.GetSupportedGraphicsClocks", Receiver: h, Args: []interface{}{memoryClockMHz}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetSupportedGraphicsClocks(memoryClockMHz)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetSupportedMemoryClocks() (int, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	call := Call{Method: "Device.GetSupportedMemoryClocks", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetSupportedMemoryClocks()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetSupportedPerformanceStates() ([]Pstates, Return) {
+	var r0 []Pstates
+	call := Call{Method: "Device.GetSupportedPerformanceStates", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedPerformanceStates()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetSupportedVgpus() ([]VgpuTypeId, Return) {
+	var r0 []VgpuTypeId
+	call := Call{Method: "Device.GetSupportedVgpus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedVgpus()
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapVgpuTypeId), ret
+}
+
+func (h *interceptedDevice) GetTargetFanSpeed(fan int) (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.GetTargetFanSpeed", Receiver: h, Args: []interface{}{fan}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTargetFanSpeed(fan)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTemperature(sensorType TemperatureSensors) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetTemperature", Receiver: h, Args: []interface{}{sensorType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTemperature(sensorType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTemperatureThreshold(thresholdType TemperatureThresholds) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GetTemperatureThreshold", Receiver: h, Args: []interface{}{thresholdType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTemperatureThreshold(thresholdType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTemperatureV() TemperatureHandler {
+	return h.handle.GetTemperatureV()
+}
+
+func (h *interceptedDevice) GetThermalSettings(sensorIndex uint32) (GpuThermalSettings, Return) {
+	var r0 GpuThermalSettings
+	call := Call{Method: "Device.GetThermalSettings", Receiver: h, Args: []interface{}{sensorIndex}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetThermalSettings(sensorIndex)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTopologyCommonAncestor(device2 Device) (GpuTopologyLevel, Return) {
+	var r0 GpuTopologyLevel
+	call := Call{Method: "Device.GetTopologyCommonAncestor", Receiver: h, Args: []interface{}{device2}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTopologyCommonAncestor(unwrapDevice(device2))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTopologyNearestGpus(level GpuTopologyLevel) ([]Device, Return) {
+	var r0 []Device
+	call := Call{Method: "Device.GetTopologyNearestGpus", Receiver: h, Args: []interface{}{level}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTopologyNearestGpus(level)
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapDevice), ret
+}
+
+func (h *interceptedDevice) GetTotalEccErrors(errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetTotalEccErrors", Receiver: h, Args: []interface{}{errorType, counterType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTotalEccErrors(errorType, counterType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetTotalEnergyConsumption() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "Device.GetTotalEnergyConsumption", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTotalEnergyConsumption()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetUUID() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetUUID", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetUUID()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetUtilizationRates() (Utilization, Return) {
+	var r0 Utilization
+	call := Call{Method: "Device.GetUtilizationRates", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetUtilizationRates()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVbiosVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "Device.GetVbiosVersion", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVbiosVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuCapabilities(capability DeviceVgpuCapability) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "Device.GetVgpuCapabilities", Receiver: h, Args: []interface{}{capability}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuCapabilities(capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	var r0 VgpuHeterogeneousMode
+	call := Call{Method: "Device.GetVgpuHeterogeneousMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuHeterogeneousMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuInstancesUtilizationInfo() (VgpuInstancesUtilizationInfo, Return) {
+	var r0 VgpuInstancesUtilizationInfo
+	call := Call{Method: "Device.GetVgpuInstancesUtilizationInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuInstancesUtilizationInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuMetadata() (VgpuPgpuMetadata, Return) {
+	var r0 VgpuPgpuMetadata
+	call := Call{Method: "Device.GetVgpuMetadata", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuMetadata()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	var r0 []VgpuProcessUtilizationSample
+	call := Call{Method: "Device.GetVgpuProcessUtilization", Receiver: h, Args: []interface{}{lastSeenTimestamp}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuProcessUtilization(lastSeenTimestamp)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuProcessesUtilizationInfo() (VgpuProcessesUtilizationInfo, Return) {
+	var r0 VgpuProcessesUtilizationInfo
+	call := Call{Method: "Device.GetVgpuProcessesUtilizationInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuProcessesUtilizationInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuSchedulerCapabilities() (VgpuSchedulerCapabilities, Return) {
+	var r0 VgpuSchedulerCapabilities
+	call := Call{Method: "Device.GetVgpuSchedulerCapabilities", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuSchedulerCapabilities()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuSchedulerLog() (VgpuSchedulerLog, Return) {
+	var r0 VgpuSchedulerLog
+	call := Call{Method: "Device.GetVgpuSchedulerLog", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuSchedulerLog()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuSchedulerState() (VgpuSchedulerGetState, Return) {
+	var r0 VgpuSchedulerGetState
+	call := Call{Method: "Device.GetVgpuSchedulerState", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuSchedulerState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "Device.GetVgpuTypeCreatablePlacements", Receiver: h, Args: []interface{}{vgpuTypeId}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuTypeCreatablePlacements(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "Device.GetVgpuTypeSupportedPlacements", Receiver: h, Args: []interface{}{vgpuTypeId}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuTypeSupportedPlacements(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVgpuUtilization(lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	var r0 ValueType
+	var r1 []VgpuInstanceUtilizationSample
+	call := Call{Method: "Device.GetVgpuUtilization", Receiver: h, Args: []interface{}{lastSeenTimestamp}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetVgpuUtilization(lastSeenTimestamp)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedDevice) GetViolationStatus(perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	var r0 ViolationTime
+	call := Call{Method: "Device.GetViolationStatus", Receiver: h, Args: []interface{}{perfPolicyType}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetViolationStatus(perfPolicyType)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GetVirtualizationMode() (GpuVirtualizationMode, Return) {
+	var r0 GpuVirtualizationMode
+	call := Call{Method: "Device.GetVirtualizationMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVirtualizationMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GpmMigSampleGet(gpuInstanceId int, gpmSample GpmSample) Return {
+	call := Call{Method: "Device.GpmMigSampleGet", Receiver: h, Args: []interface{}{gpuInstanceId, gpmSample}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GpmMigSampleGet(gpuInstanceId, unwrapGpmSample(gpmSample))
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GpmQueryDeviceSupport() (GpmSupport, Return) {
+	var r0 GpmSupport
+	call := Call{Method: "Device.GpmQueryDeviceSupport", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GpmQueryDeviceSupport()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GpmQueryDeviceSupportV() GpmSupportV {
+	return h.handle.GpmQueryDeviceSupportV()
+}
+
+func (h *interceptedDevice) GpmQueryIfStreamingEnabled() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Device.GpmQueryIfStreamingEnabled", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GpmQueryIfStreamingEnabled()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) GpmSampleGet(gpmSample GpmSample) Return {
+	call := Call{Method: "Device.GpmSampleGet", Receiver: h, Args: []interface{}{gpmSample}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GpmSampleGet(unwrapGpmSample(gpmSample))
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) GpmSetStreamingEnabled(state uint32) Return {
+	call := Call{Method: "Device.GpmSetStreamingEnabled", Receiver: h, Args: []interface{}{state}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.GpmSetStreamingEnabled(state)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) IsMigDeviceHandle() (bool, Return) {
+	var r0 bool
+	call := Call{Method: "Device.IsMigDeviceHandle", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.IsMigDeviceHandle()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) OnSameBoard(device2 Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.OnSameBoard", Receiver: h, Args: []interface{}{device2}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.OnSameBoard(unwrapDevice(device2))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) PowerSmoothingActivatePresetProfile(profile *PowerSmoothingProfile) Return {
+	call := Call{Method: "Device.PowerSmoothingActivatePresetProfile", Receiver: h, Args: []interface{}{profile}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.PowerSmoothingActivatePresetProfile(profile)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) PowerSmoothingSetState(state *PowerSmoothingState) Return {
+	call := Call{Method: "Device.PowerSmoothingSetState", Receiver: h, Args: []interface{}{state}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.PowerSmoothingSetState(state)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) PowerSmoothingUpdatePresetProfileParam(profile *PowerSmoothingProfile) Return {
+	call := Call{Method: "Device.PowerSmoothingUpdatePresetProfileParam", Receiver: h, Args: []interface{}{profile}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.PowerSmoothingUpdatePresetProfileParam(profile)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ReadWritePRM_v1(buffer *PRMTLV_v1) Return {
+	call := Call{Method: "Device.ReadWritePRM_v1", Receiver: h, Args: []interface{}{buffer}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ReadWritePRM_v1(buffer)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) RegisterEvents(eventTypes uint64, set EventSet) Return {
+	call := Call{Method: "Device.RegisterEvents", Receiver: h, Args: []interface{}{eventTypes, set}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.RegisterEvents(eventTypes, unwrapEventSet(set))
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ResetApplicationsClocks() Return {
+	call := Call{Method: "Device.ResetApplicationsClocks", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ResetApplicationsClocks()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ResetGpuLockedClocks() Return {
+	call := Call{Method: "Device.ResetGpuLockedClocks", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ResetGpuLockedClocks()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ResetMemoryLockedClocks() Return {
+	call := Call{Method: "Device.ResetMemoryLockedClocks", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ResetMemoryLockedClocks()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ResetNvLinkErrorCounters(link int) Return {
+	call := Call{Method: "Device.ResetNvLinkErrorCounters", Receiver: h, Args: []interface{}{link}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ResetNvLinkErrorCounters(link)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ResetNvLinkUtilizationCounter(link int, counter int) Return {
+	call := Call{Method: "Device.ResetNvLinkUtilizationCounter", Receiver: h, Args: []interface{}{link, counter}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ResetNvLinkUtilizationCounter(link, counter)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetAPIRestriction(apiType RestrictedAPI, isRestricted EnableState) Return {
+	call := Call{Method: "Device.SetAPIRestriction", Receiver: h, Args: []interface{}{apiType, isRestricted}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetAPIRestriction(apiType, isRestricted)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetAccountingMode(mode EnableState) Return {
+	call := Call{Method: "Device.SetAccountingMode", Receiver: h, Args: []interface{}{mode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetAccountingMode(mode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) Return {
+	call := Call{Method: "Device.SetApplicationsClocks", Receiver: h, Args: []interface{}{memClockMHz, graphicsClockMHz}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetAutoBoostedClocksEnabled(enabled EnableState) Return {
+	call := Call{Method: "Device.SetAutoBoostedClocksEnabled", Receiver: h, Args: []interface{}{enabled}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetAutoBoostedClocksEnabled(enabled)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetClockOffsets(info ClockOffset) Return {
+	call := Call{Method: "Device.SetClockOffsets", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetClockOffsets(info)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetComputeMode(mode ComputeMode) Return {
+	call := Call{Method: "Device.SetComputeMode", Receiver: h, Args: []interface{}{mode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetComputeMode(mode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetConfComputeUnprotectedMemSize(sizeKiB uint64) Return {
+	call := Call{Method: "Device.SetConfComputeUnprotectedMemSize", Receiver: h, Args: []interface{}{sizeKiB}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetConfComputeUnprotectedMemSize(sizeKiB)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetCpuAffinity() Return {
+	call := Call{Method: "Device.SetCpuAffinity", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetCpuAffinity()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetDefaultAutoBoostedClocksEnabled(enabled EnableState, flags uint32) Return {
+	call := Call{Method: "Device.SetDefaultAutoBoostedClocksEnabled", Receiver: h, Args: []interface{}{enabled, flags}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetDefaultAutoBoostedClocksEnabled(enabled, flags)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetDefaultFanSpeed_v2(fan int) Return {
+	call := Call{Method: "Device.SetDefaultFanSpeed_v2", Receiver: h, Args: []interface{}{fan}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetDefaultFanSpeed_v2(fan)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetDramEncryptionMode(dramEncryption *DramEncryptionInfo) Return {
+	call := Call{Method: "Device.SetDramEncryptionMode", Receiver: h, Args: []interface{}{dramEncryption}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetDramEncryptionMode(dramEncryption)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetDriverModel(driverModel DriverModel, flags uint32) Return {
+	call := Call{Method: "Device.SetDriverModel", Receiver: h, Args: []interface{}{driverModel, flags}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetDriverModel(driverModel, flags)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetEccMode(ecc EnableState) Return {
+	call := Call{Method: "Device.SetEccMode", Receiver: h, Args: []interface{}{ecc}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetEccMode(ecc)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetFanControlPolicy(fan int, policy FanControlPolicy) Return {
+	call := Call{Method: "Device.SetFanControlPolicy", Receiver: h, Args: []interface{}{fan, policy}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetFanControlPolicy(fan, policy)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetFanSpeed_v2(fan int, speed int) Return {
+	call := Call{Method: "Device.SetFanSpeed_v2", Receiver: h, Args: []interface{}{fan, speed}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetFanSpeed_v2(fan, speed)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetGpcClkVfOffset(offset int) Return {
+	call := Call{Method: "Device.SetGpcClkVfOffset", Receiver: h, Args: []interface{}{offset}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetGpcClkVfOffset(offset)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	call := Call{Method: "Device.SetGpuLockedClocks", Receiver: h, Args: []interface{}{minGpuClockMHz, maxGpuClockMHz}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetGpuOperationMode(mode GpuOperationMode) Return {
+	call := Call{Method: "Device.SetGpuOperationMode", Receiver: h, Args: []interface{}{mode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetGpuOperationMode(mode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetMemClkVfOffset(offset int) Return {
+	call := Call{Method: "Device.SetMemClkVfOffset", Receiver: h, Args: []interface{}{offset}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetMemClkVfOffset(offset)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	call := Call{Method: "Device.SetMemoryLockedClocks", Receiver: h, Args: []interface{}{minMemClockMHz, maxMemClockMHz}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetMemoryLockedClocks(minMemClockMHz, maxMemClockMHz)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetMigMode(mode int) (Return, Return) {
+	var r0 Return
+	call := Call{Method: "Device.SetMigMode", Receiver: h, Args: []interface{}{mode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.SetMigMode(mode)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) SetNvLinkDeviceLowPowerThreshold(info *NvLinkPowerThres) Return {
+	call := Call{Method: "Device.SetNvLinkDeviceLowPowerThreshold", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetNvLinkDeviceLowPowerThreshold(info)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetNvLinkUtilizationControl(link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	call := Call{Method: "Device.SetNvLinkUtilizationControl", Receiver: h, Args: []interface{}{link, counter, control, reset}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetNvLinkUtilizationControl(link, counter, control, reset)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetNvlinkBwMode(setBwMode *NvlinkSetBwMode) Return {
+	call := Call{Method: "Device.SetNvlinkBwMode", Receiver: h, Args: []interface{}{setBwMode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetNvlinkBwMode(setBwMode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetPersistenceMode(mode EnableState) Return {
+	call := Call{Method: "Device.SetPersistenceMode", Receiver: h, Args: []interface{}{mode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetPersistenceMode(mode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetPowerManagementLimit(limit uint32) Return {
+	call := Call{Method: "Device.SetPowerManagementLimit", Receiver: h, Args: []interface{}{limit}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetPowerManagementLimit(limit)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetPowerManagementLimit_v2(powerValue *PowerValue_v2) Return {
+	call := Call{Method: "Device.SetPowerManagementLimit_v2", Receiver: h, Args: []interface{}{powerValue}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetPowerManagementLimit_v2(powerValue)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetPowerMizerMode_v1(powerMizerMode *DevicePowerMizerModes_v1) Return {
+	call := Call{Method: "Device.SetPowerMizerMode_v1", Receiver: h, Args: []interface{}{powerMizerMode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetPowerMizerMode_v1(powerMizerMode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetTemperatureThreshold(thresholdType TemperatureThresholds, temp int) Return {
+	call := Call{Method: "Device.SetTemperatureThreshold", Receiver: h, Args: []interface{}{thresholdType, temp}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetTemperatureThreshold(thresholdType, temp)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetVgpuCapabilities(capability DeviceVgpuCapability, state EnableState) Return {
+	call := Call{Method: "Device.SetVgpuCapabilities", Receiver: h, Args: []interface{}{capability, state}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVgpuCapabilities(capability, state)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetVgpuHeterogeneousMode(heterogeneousMode VgpuHeterogeneousMode) Return {
+	call := Call{Method: "Device.SetVgpuHeterogeneousMode", Receiver: h, Args: []interface{}{heterogeneousMode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVgpuHeterogeneousMode(heterogeneousMode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetVgpuSchedulerState(pSchedulerState *VgpuSchedulerSetState) Return {
+	call := Call{Method: "Device.SetVgpuSchedulerState", Receiver: h, Args: []interface{}{pSchedulerState}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVgpuSchedulerState(pSchedulerState)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) SetVirtualizationMode(virtualMode GpuVirtualizationMode) Return {
+	call := Call{Method: "Device.SetVirtualizationMode", Receiver: h, Args: []interface{}{virtualMode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVirtualizationMode(virtualMode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) ValidateInforom() Return {
+	call := Call{Method: "Device.ValidateInforom", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ValidateInforom()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) VgpuTypeGetMaxInstances(vgpuTypeId VgpuTypeId) (int, Return) {
+	var r0 int
+	call := Call{Method: "Device.VgpuTypeGetMaxInstances", Receiver: h, Args: []interface{}{vgpuTypeId}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.VgpuTypeGetMaxInstances(unwrapVgpuTypeId(vgpuTypeId))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	call := Call{Method: "Device.WorkloadPowerProfileClearRequestedProfiles", Receiver: h, Args: []interface{}{requestedProfiles}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.WorkloadPowerProfileClearRequestedProfiles(requestedProfiles)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedDevice) WorkloadPowerProfileGetCurrentProfiles() (WorkloadPowerProfileCurrentProfiles, Return) {
+	var r0 WorkloadPowerProfileCurrentProfiles
+	call := Call{Method: "Device.WorkloadPowerProfileGetCurrentProfiles", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.WorkloadPowerProfileGetCurrentProfiles()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) WorkloadPowerProfileGetProfilesInfo() (WorkloadPowerProfileProfilesInfo, Return) {
+	var r0 WorkloadPowerProfileProfilesInfo
+	call := Call{Method: "Device.WorkloadPowerProfileGetProfilesInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.WorkloadPowerProfileGetProfilesInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedDevice) WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	call := Call{Method: "Device.WorkloadPowerProfileSetRequestedProfiles", Receiver: h, Args: []interface{}{requestedProfiles}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.WorkloadPowerProfileSetRequestedProfiles(requestedProfiles)
+		return ret
+	})
+	return ret
+}
+
+var _ GpuInstance = (*interceptedGpuInstance)(nil)
+
+func (h *interceptedGpuInstance) CreateComputeInstance(info *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstance.CreateComputeInstance", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.CreateComputeInstance(info)
+		return ret
+	})
+	return h.i.wrapComputeInstance(r0), ret
+}
+
+func (h *interceptedGpuInstance) CreateComputeInstanceWithPlacement(info *ComputeInstanceProfileInfo, placement *ComputeInstancePlacement) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstance.CreateComputeInstanceWithPlacement", Receiver: h, Args: []interface{}{info, placement}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.CreateComputeInstanceWithPlacement(info, placement)
+		return ret
+	})
+	return h.i.wrapComputeInstance(r0), ret
+}
+
+func (h *interceptedGpuInstance) Destroy() Return {
+	call := Call{Method: "GpuInstance.Destroy", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.Destroy()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedGpuInstance) GetActiveVgpus() (ActiveVgpuInstanceInfo, Return) {
+	var r0 ActiveVgpuInstanceInfo
+	call := Call{Method: "GpuInstance.GetActiveVgpus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetActiveVgpus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetComputeInstanceById(id int) (ComputeInstance, Return) {
+	var r0 ComputeInstance
+	call := Call{Method: "GpuInstance.GetComputeInstanceById", Receiver: h, Args: []interface{}{id}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstanceById(id)
+		return ret
+	})
+	return h.i.wrapComputeInstance(r0), ret
+}
+
+func (h *interceptedGpuInstance) GetComputeInstancePossiblePlacements(info *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	var r0 []ComputeInstancePlacement
+	call := Call{Method: "GpuInstance.GetComputeInstancePossiblePlacements", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstancePossiblePlacements(info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetComputeInstanceProfileInfo(profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
+	var r0 ComputeInstanceProfileInfo
+	call := Call{Method: "GpuInstance.GetComputeInstanceProfileInfo", Receiver: h, Args: []interface{}{profile, engProfile}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstanceProfileInfo(profile, engProfile)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetComputeInstanceProfileInfoV(profile int, engProfile int) ComputeInstanceProfileInfoHandler {
+	return h.handle.GetComputeInstanceProfileInfoV(profile, engProfile)
+}
+
+func (h *interceptedGpuInstance) GetComputeInstanceRemainingCapacity(info *ComputeInstanceProfileInfo) (int, Return) {
+	var r0 int
+	call := Call{Method: "GpuInstance.GetComputeInstanceRemainingCapacity", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstanceRemainingCapacity(info)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetComputeInstances(info *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	var r0 []ComputeInstance
+	call := Call{Method: "GpuInstance.GetComputeInstances", Receiver: h, Args: []interface{}{info}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetComputeInstances(info)
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapComputeInstance), ret
+}
+
+func (h *interceptedGpuInstance) GetCreatableVgpus() (VgpuTypeIdInfo, Return) {
+	var r0 VgpuTypeIdInfo
+	call := Call{Method: "GpuInstance.GetCreatableVgpus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCreatableVgpus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetInfo() (GpuInstanceInfo, Return) {
+	var r0 GpuInstanceInfo
+	call := Call{Method: "GpuInstance.GetInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetInfo()
+		return ret
+	})
+	return h.i.wrapGpuInstanceInfo(r0), ret
+}
+
+func (h *interceptedGpuInstance) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	var r0 VgpuHeterogeneousMode
+	call := Call{Method: "GpuInstance.GetVgpuHeterogeneousMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuHeterogeneousMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetVgpuSchedulerLog() (VgpuSchedulerLogInfo, Return) {
+	var r0 VgpuSchedulerLogInfo
+	call := Call{Method: "GpuInstance.GetVgpuSchedulerLog", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuSchedulerLog()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetVgpuSchedulerState() (VgpuSchedulerStateInfo, Return) {
+	var r0 VgpuSchedulerStateInfo
+	call := Call{Method: "GpuInstance.GetVgpuSchedulerState", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuSchedulerState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) GetVgpuTypeCreatablePlacements() (VgpuCreatablePlacementInfo, Return) {
+	var r0 VgpuCreatablePlacementInfo
+	call := Call{Method: "GpuInstance.GetVgpuTypeCreatablePlacements", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVgpuTypeCreatablePlacements()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedGpuInstance) SetVgpuHeterogeneousMode(heterogeneousMode *VgpuHeterogeneousMode) Return {
+	call := Call{Method: "GpuInstance.SetVgpuHeterogeneousMode", Receiver: h, Args: []interface{}{heterogeneousMode}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVgpuHeterogeneousMode(heterogeneousMode)
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedGpuInstance) SetVgpuSchedulerState(scheduler *VgpuSchedulerState) Return {
+	call := Call{Method: "GpuInstance.SetVgpuSchedulerState", Receiver: h, Args: []interface{}{scheduler}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetVgpuSchedulerState(scheduler)
+		return ret
+	})
+	return ret
+}
+
+var _ ComputeInstance = (*interceptedComputeInstance)(nil)
+
+func (h *interceptedComputeInstance) Destroy() Return {
+	call := Call{Method: "ComputeInstance.Destroy", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.Destroy()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedComputeInstance) GetInfo() (ComputeInstanceInfo, Return) {
+	var r0 ComputeInstanceInfo
+	call := Call{Method: "ComputeInstance.GetInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetInfo()
+		return ret
+	})
+	return h.i.wrapComputeInstanceInfo(r0), ret
+}
+
+var _ EventSet = (*interceptedEventSet)(nil)
+
+func (h *interceptedEventSet) Free() Return {
+	call := Call{Method: "EventSet.Free", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.Free()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedEventSet) Wait(timeoutms uint32) (EventData, Return) {
+	var r0 EventData
+	call := Call{Method: "EventSet.Wait", Receiver: h, Args: []interface{}{timeoutms}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.Wait(timeoutms)
+		return ret
+	})
+	return h.i.wrapEventData(r0), ret
+}
+
+var _ GpmSample = (*interceptedGpmSample)(nil)
+
+func (h *interceptedGpmSample) Free() Return {
+	call := Call{Method: "GpmSample.Free", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.Free()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedGpmSample) Get(device Device) Return {
+	call := Call{Method: "GpmSample.Get", Receiver: h, Args: []interface{}{device}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.Get(unwrapDevice(device))
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedGpmSample) MigGet(device Device, gpuInstanceId int) Return {
+	call := Call{Method: "GpmSample.MigGet", Receiver: h, Args: []interface{}{device, gpuInstanceId}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.MigGet(unwrapDevice(device), gpuInstanceId)
+		return ret
+	})
+	return ret
+}
+
+var _ Unit = (*interceptedUnit)(nil)
+
+func (h *interceptedUnit) GetDevices() ([]Device, Return) {
+	var r0 []Device
+	call := Call{Method: "Unit.GetDevices", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetDevices()
+		return ret
+	})
+	return wrapHandles(r0, h.i.wrapDevice), ret
+}
+
+func (h *interceptedUnit) GetFanSpeedInfo() (UnitFanSpeeds, Return) {
+	var r0 UnitFanSpeeds
+	call := Call{Method: "Unit.GetFanSpeedInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFanSpeedInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedUnit) GetLedState() (LedState, Return) {
+	var r0 LedState
+	call := Call{Method: "Unit.GetLedState", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetLedState()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedUnit) GetPsuInfo() (PSUInfo, Return) {
+	var r0 PSUInfo
+	call := Call{Method: "Unit.GetPsuInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPsuInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedUnit) GetTemperature(ttype int) (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "Unit.GetTemperature", Receiver: h, Args: []interface{}{ttype}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetTemperature(ttype)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedUnit) GetUnitInfo() (UnitInfo, Return) {
+	var r0 UnitInfo
+	call := Call{Method: "Unit.GetUnitInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetUnitInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedUnit) SetLedState(color LedColor) Return {
+	call := Call{Method: "Unit.SetLedState", Receiver: h, Args: []interface{}{color}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetLedState(color)
+		return ret
+	})
+	return ret
+}
+
+var _ VgpuInstance = (*interceptedVgpuInstance)(nil)
+
+func (h *interceptedVgpuInstance) ClearAccountingPids() Return {
+	call := Call{Method: "VgpuInstance.ClearAccountingPids", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.ClearAccountingPids()
+		return ret
+	})
+	return ret
+}
+
+func (h *interceptedVgpuInstance) GetAccountingMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "VgpuInstance.GetAccountingMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetAccountingPids() ([]int, Return) {
+	var r0 []int
+	call := Call{Method: "VgpuInstance.GetAccountingPids", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingPids()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetAccountingStats(pid int) (AccountingStats, Return) {
+	var r0 AccountingStats
+	call := Call{Method: "VgpuInstance.GetAccountingStats", Receiver: h, Args: []interface{}{pid}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetAccountingStats(pid)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetEccMode() (EnableState, Return) {
+	var r0 EnableState
+	call := Call{Method: "VgpuInstance.GetEccMode", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetEccMode()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetEncoderCapacity() (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstance.GetEncoderCapacity", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetEncoderCapacity()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetEncoderSessions() (int, EncoderSessionInfo, Return) {
+	var r0 int
+	var r1 EncoderSessionInfo
+	call := Call{Method: "VgpuInstance.GetEncoderSessions", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetEncoderSessions()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedVgpuInstance) GetEncoderStats() (int, uint32, uint32, Return) {
+	var r0 int
+	var r1 uint32
+	var r2 uint32
+	call := Call{Method: "VgpuInstance.GetEncoderStats", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, r2, ret = h.handle.GetEncoderStats()
+		return ret
+	})
+	return r0, r1, r2, ret
+}
+
+func (h *interceptedVgpuInstance) GetFBCSessions() (int, FBCSessionInfo, Return) {
+	var r0 int
+	var r1 FBCSessionInfo
+	call := Call{Method: "VgpuInstance.GetFBCSessions", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetFBCSessions()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedVgpuInstance) GetFBCStats() (FBCStats, Return) {
+	var r0 FBCStats
+	call := Call{Method: "VgpuInstance.GetFBCStats", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFBCStats()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetFbUsage() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuInstance.GetFbUsage", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFbUsage()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetFrameRateLimit() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuInstance.GetFrameRateLimit", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFrameRateLimit()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetGpuInstanceId() (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstance.GetGpuInstanceId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetGpuPciId() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstance.GetGpuPciId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuPciId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetLicenseInfo() (VgpuLicenseInfo, Return) {
+	var r0 VgpuLicenseInfo
+	call := Call{Method: "VgpuInstance.GetLicenseInfo", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetLicenseInfo()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetLicenseStatus() (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuInstance.GetLicenseStatus", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetLicenseStatus()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetMdevUUID() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstance.GetMdevUUID", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMdevUUID()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetMetadata() (VgpuMetadata, Return) {
+	var r0 VgpuMetadata
+	call := Call{Method: "VgpuInstance.GetMetadata", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMetadata()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetPlacementId() (VgpuPlacementId, Return) {
+	var r0 VgpuPlacementId
+	call := Call{Method: "VgpuInstance.GetPlacementId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetPlacementId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetRuntimeStateSize() (VgpuRuntimeState, Return) {
+	var r0 VgpuRuntimeState
+	call := Call{Method: "VgpuInstance.GetRuntimeStateSize", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetRuntimeStateSize()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetType() (VgpuTypeId, Return) {
+	var r0 VgpuTypeId
+	call := Call{Method: "VgpuInstance.GetType", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetType()
+		return ret
+	})
+	return h.i.wrapVgpuTypeId(r0), ret
+}
+
+func (h *interceptedVgpuInstance) GetUUID() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstance.GetUUID", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetUUID()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetVmDriverVersion() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuInstance.GetVmDriverVersion", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetVmDriverVersion()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuInstance) GetVmID() (string, VgpuVmIdType, Return) {
+	var r0 string
+	var r1 VgpuVmIdType
+	call := Call{Method: "VgpuInstance.GetVmID", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetVmID()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedVgpuInstance) SetEncoderCapacity(encoderCapacity int) Return {
+	call := Call{Method: "VgpuInstance.SetEncoderCapacity", Receiver: h, Args: []interface{}{encoderCapacity}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		ret = h.handle.SetEncoderCapacity(encoderCapacity)
+		return ret
+	})
+	return ret
+}
+
+var _ VgpuTypeId = (*interceptedVgpuTypeId)(nil)
+
+func (h *interceptedVgpuTypeId) GetBAR1Info() (VgpuTypeBar1Info, Return) {
+	var r0 VgpuTypeBar1Info
+	call := Call{Method: "VgpuTypeId.GetBAR1Info", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetBAR1Info()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetCapabilities(capability VgpuCapability) (bool, Return) {
+	var r0 bool
+	call := Call{Method: "VgpuTypeId.GetCapabilities", Receiver: h, Args: []interface{}{capability}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCapabilities(capability)
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetClass() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeId.GetClass", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetClass()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetCreatablePlacements(device Device) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "VgpuTypeId.GetCreatablePlacements", Receiver: h, Args: []interface{}{device}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetCreatablePlacements(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetDeviceID() (uint64, uint64, Return) {
+	var r0 uint64
+	var r1 uint64
+	call := Call{Method: "VgpuTypeId.GetDeviceID", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetDeviceID()
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedVgpuTypeId) GetFbReservation() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeId.GetFbReservation", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFbReservation()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetFrameRateLimit() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuTypeId.GetFrameRateLimit", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFrameRateLimit()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetFramebufferSize() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeId.GetFramebufferSize", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetFramebufferSize()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetGpuInstanceProfileId() (uint32, Return) {
+	var r0 uint32
+	call := Call{Method: "VgpuTypeId.GetGpuInstanceProfileId", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGpuInstanceProfileId()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetGspHeapSize() (uint64, Return) {
+	var r0 uint64
+	call := Call{Method: "VgpuTypeId.GetGspHeapSize", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetGspHeapSize()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetLicense() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeId.GetLicense", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetLicense()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetMaxInstances(device Device) (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeId.GetMaxInstances", Receiver: h, Args: []interface{}{device}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxInstances(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetMaxInstancesPerVm() (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeId.GetMaxInstancesPerVm", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetMaxInstancesPerVm()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetName() (string, Return) {
+	var r0 string
+	call := Call{Method: "VgpuTypeId.GetName", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetName()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetNumDisplayHeads() (int, Return) {
+	var r0 int
+	call := Call{Method: "VgpuTypeId.GetNumDisplayHeads", Receiver: h}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetNumDisplayHeads()
+		return ret
+	})
+	return r0, ret
+}
+
+func (h *interceptedVgpuTypeId) GetResolution(displayIndex int) (uint32, uint32, Return) {
+	var r0 uint32
+	var r1 uint32
+	call := Call{Method: "VgpuTypeId.GetResolution", Receiver: h, Args: []interface{}{displayIndex}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, r1, ret = h.handle.GetResolution(displayIndex)
+		return ret
+	})
+	return r0, r1, ret
+}
+
+func (h *interceptedVgpuTypeId) GetSupportedPlacements(device Device) (VgpuPlacementList, Return) {
+	var r0 VgpuPlacementList
+	call := Call{Method: "VgpuTypeId.GetSupportedPlacements", Receiver: h, Args: []interface{}{device}}
+	ret := h.i.intercept(call, func() (ret Return) {
+		r0, ret = h.handle.GetSupportedPlacements(unwrapDevice(device))
+		return ret
+	})
+	return r0, ret
+}
@@ -0,0 +1,735 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// methodInfos describes the methods of Interface and of the handle
+// interfaces, ordered by interface and method name.
+var methodInfos = []MethodInfo{
+	{Interface: "Interface", Name: "ComputeInstanceDestroy", Symbol: "nvmlComputeInstanceDestroy", Mutates: true},
+	{Interface: "Interface", Name: "ComputeInstanceGetInfo", Symbol: "nvmlComputeInstanceGetInfo"},
+	{Interface: "Interface", Name: "DeviceClearAccountingPids", Symbol: "nvmlDeviceClearAccountingPids", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceClearCpuAffinity", Symbol: "nvmlDeviceClearCpuAffinity", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceClearEccErrorCounts", Symbol: "nvmlDeviceClearEccErrorCounts", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceClearFieldValues", Symbol: "nvmlDeviceClearFieldValues", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceCreateGpuInstance", Symbol: "nvmlDeviceCreateGpuInstance", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceCreateGpuInstanceWithPlacement", Symbol: "nvmlDeviceCreateGpuInstanceWithPlacement", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceDiscoverGpus", Symbol: "nvmlDeviceDiscoverGpus", Mutates: true},
+	{Interface: "Interface", Name: "DeviceFreezeNvLinkUtilizationCounter", Symbol: "nvmlDeviceFreezeNvLinkUtilizationCounter", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAPIRestriction", Symbol: "nvmlDeviceGetAPIRestriction", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAccountingBufferSize", Symbol: "nvmlDeviceGetAccountingBufferSize", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAccountingMode", Symbol: "nvmlDeviceGetAccountingMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAccountingPids", Symbol: "nvmlDeviceGetAccountingPids", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAccountingStats", Symbol: "nvmlDeviceGetAccountingStats", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetActiveVgpus", Symbol: "nvmlDeviceGetActiveVgpus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAdaptiveClockInfoStatus", Symbol: "nvmlDeviceGetAdaptiveClockInfoStatus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAddressingMode", Symbol: "nvmlDeviceGetAddressingMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetApplicationsClock", Symbol: "nvmlDeviceGetApplicationsClock", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetArchitecture", Symbol: "nvmlDeviceGetArchitecture", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetAttributes", Symbol: "nvmlDeviceGetAttributes", Handles: MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetAutoBoostedClocksEnabled", Symbol: "nvmlDeviceGetAutoBoostedClocksEnabled", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetBAR1MemoryInfo", Symbol: "nvmlDeviceGetBAR1MemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetBoardId", Symbol: "nvmlDeviceGetBoardId", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetBoardPartNumber", Symbol: "nvmlDeviceGetBoardPartNumber", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetBrand", Symbol: "nvmlDeviceGetBrand", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetBridgeChipInfo", Symbol: "nvmlDeviceGetBridgeChipInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetBusType", Symbol: "nvmlDeviceGetBusType", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetC2cModeInfoV", Symbol: "nvmlDeviceGetC2cModeInfoV", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCapabilities", Symbol: "nvmlDeviceGetCapabilities", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetClkMonStatus", Symbol: "nvmlDeviceGetClkMonStatus", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetClock", Symbol: "nvmlDeviceGetClock", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetClockInfo", Symbol: "nvmlDeviceGetClockInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetClockOffsets", Symbol: "nvmlDeviceGetClockOffsets", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetComputeInstanceId", Symbol: "nvmlDeviceGetComputeInstanceId", Handles: MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetComputeMode", Symbol: "nvmlDeviceGetComputeMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetComputeRunningProcesses", Symbol: "nvmlDeviceGetComputeRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetConfComputeGpuAttestationReport", Symbol: "nvmlDeviceGetConfComputeGpuAttestationReport", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetConfComputeGpuCertificate", Symbol: "nvmlDeviceGetConfComputeGpuCertificate", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetConfComputeMemSizeInfo", Symbol: "nvmlDeviceGetConfComputeMemSizeInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetConfComputeProtectedMemoryUsage", Symbol: "nvmlDeviceGetConfComputeProtectedMemoryUsage", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCoolerInfo", Symbol: "nvmlDeviceGetCoolerInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCount", Symbol: "nvmlDeviceGetCount"},
+	{Interface: "Interface", Name: "DeviceGetCpuAffinity", Symbol: "nvmlDeviceGetCpuAffinity", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCpuAffinityWithinScope", Symbol: "nvmlDeviceGetCpuAffinityWithinScope", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCreatableVgpus", Symbol: "nvmlDeviceGetCreatableVgpus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCudaComputeCapability", Symbol: "nvmlDeviceGetCudaComputeCapability", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCurrPcieLinkGeneration", Symbol: "nvmlDeviceGetCurrPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCurrPcieLinkWidth", Symbol: "nvmlDeviceGetCurrPcieLinkWidth", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCurrentClockFreqs", Symbol: "nvmlDeviceGetCurrentClockFreqs", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCurrentClocksEventReasons", Symbol: "nvmlDeviceGetCurrentClocksEventReasons", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetCurrentClocksThrottleReasons", Symbol: "nvmlDeviceGetCurrentClocksThrottleReasons", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDecoderUtilization", Symbol: "nvmlDeviceGetDecoderUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDefaultApplicationsClock", Symbol: "nvmlDeviceGetDefaultApplicationsClock", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDefaultEccMode", Symbol: "nvmlDeviceGetDefaultEccMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDetailedEccErrors", Symbol: "nvmlDeviceGetDetailedEccErrors", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDeviceHandleFromMigDeviceHandle", Symbol: "nvmlDeviceGetDeviceHandleFromMigDeviceHandle", Handles: MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetDisplayActive", Symbol: "nvmlDeviceGetDisplayActive", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDisplayMode", Symbol: "nvmlDeviceGetDisplayMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDramEncryptionMode", Symbol: "nvmlDeviceGetDramEncryptionMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDriverModel", Symbol: "nvmlDeviceGetDriverModel", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDriverModel_v2", Symbol: "nvmlDeviceGetDriverModel", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetDynamicPstatesInfo", Symbol: "nvmlDeviceGetDynamicPstatesInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEccMode", Symbol: "nvmlDeviceGetEccMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEncoderCapacity", Symbol: "nvmlDeviceGetEncoderCapacity", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEncoderSessions", Symbol: "nvmlDeviceGetEncoderSessions", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEncoderStats", Symbol: "nvmlDeviceGetEncoderStats", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEncoderUtilization", Symbol: "nvmlDeviceGetEncoderUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetEnforcedPowerLimit", Symbol: "nvmlDeviceGetEnforcedPowerLimit", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFBCSessions", Symbol: "nvmlDeviceGetFBCSessions", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFBCStats", Symbol: "nvmlDeviceGetFBCStats", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFanControlPolicy_v2", Symbol: "nvmlDeviceGetFanControlPolicy", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFanSpeed", Symbol: "nvmlDeviceGetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFanSpeedRPM", Symbol: "nvmlDeviceGetFanSpeedRPM", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFanSpeed_v2", Symbol: "nvmlDeviceGetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetFieldValues", Symbol: "nvmlDeviceGetFieldValues", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpcClkMinMaxVfOffset", Symbol: "nvmlDeviceGetGpcClkMinMaxVfOffset", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpcClkVfOffset", Symbol: "nvmlDeviceGetGpcClkVfOffset", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuFabricInfo", Symbol: "nvmlDeviceGetGpuFabricInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuFabricInfoV", Symbol: "nvmlDeviceGetGpuFabricInfoV", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceById", Symbol: "nvmlDeviceGetGpuInstanceById", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceId", Symbol: "nvmlDeviceGetGpuInstanceId", Handles: MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstancePossiblePlacements", Symbol: "nvmlDeviceGetGpuInstancePossiblePlacements", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceProfileInfo", Symbol: "nvmlDeviceGetGpuInstanceProfileInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceProfileInfoByIdV", Symbol: "nvmlDeviceGetGpuInstanceProfileInfoByIdV", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceProfileInfoV", Symbol: "nvmlDeviceGetGpuInstanceProfileInfoV", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstanceRemainingCapacity", Symbol: "nvmlDeviceGetGpuInstanceRemainingCapacity", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuInstances", Symbol: "nvmlDeviceGetGpuInstances", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuMaxPcieLinkGeneration", Symbol: "nvmlDeviceGetGpuMaxPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGpuOperationMode", Symbol: "nvmlDeviceGetGpuOperationMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGraphicsRunningProcesses", Symbol: "nvmlDeviceGetGraphicsRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetGridLicensableFeatures", Symbol: "nvmlDeviceGetGridLicensableFeatures", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGspFirmwareMode", Symbol: "nvmlDeviceGetGspFirmwareMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetGspFirmwareVersion", Symbol: "nvmlDeviceGetGspFirmwareVersion", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetHandleByIndex", Symbol: "nvmlDeviceGetHandleByIndex"},
+	{Interface: "Interface", Name: "DeviceGetHandleByPciBusId", Symbol: "nvmlDeviceGetHandleByPciBusId"},
+	{Interface: "Interface", Name: "DeviceGetHandleBySerial", Symbol: "nvmlDeviceGetHandleBySerial"},
+	{Interface: "Interface", Name: "DeviceGetHandleByUUID", Symbol: "nvmlDeviceGetHandleByUUID"},
+	{Interface: "Interface", Name: "DeviceGetHandleByUUIDV", Symbol: "nvmlDeviceGetHandleByUUIDV"},
+	{Interface: "Interface", Name: "DeviceGetHostVgpuMode", Symbol: "nvmlDeviceGetHostVgpuMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetIndex", Symbol: "nvmlDeviceGetIndex", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetInforomConfigurationChecksum", Symbol: "nvmlDeviceGetInforomConfigurationChecksum", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetInforomImageVersion", Symbol: "nvmlDeviceGetInforomImageVersion", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetInforomVersion", Symbol: "nvmlDeviceGetInforomVersion", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetIrqNum", Symbol: "nvmlDeviceGetIrqNum", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetJpgUtilization", Symbol: "nvmlDeviceGetJpgUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetLastBBXFlushTime", Symbol: "nvmlDeviceGetLastBBXFlushTime", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMPSComputeRunningProcesses", Symbol: "nvmlDeviceGetMPSComputeRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetMarginTemperature", Symbol: "nvmlDeviceGetMarginTemperature", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMaxClockInfo", Symbol: "nvmlDeviceGetMaxClockInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMaxCustomerBoostClock", Symbol: "nvmlDeviceGetMaxCustomerBoostClock", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMaxMigDeviceCount", Symbol: "nvmlDeviceGetMaxMigDeviceCount", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMaxPcieLinkGeneration", Symbol: "nvmlDeviceGetMaxPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMaxPcieLinkWidth", Symbol: "nvmlDeviceGetMaxPcieLinkWidth", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMemClkMinMaxVfOffset", Symbol: "nvmlDeviceGetMemClkMinMaxVfOffset", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMemClkVfOffset", Symbol: "nvmlDeviceGetMemClkVfOffset", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMemoryAffinity", Symbol: "nvmlDeviceGetMemoryAffinity", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMemoryBusWidth", Symbol: "nvmlDeviceGetMemoryBusWidth", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMemoryErrorCounter", Symbol: "nvmlDeviceGetMemoryErrorCounter", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetMemoryInfo", Symbol: "nvmlDeviceGetMemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetMemoryInfo_v2", Symbol: "nvmlDeviceGetMemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetMigDeviceHandleByIndex", Symbol: "nvmlDeviceGetMigDeviceHandleByIndex", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMigMode", Symbol: "nvmlDeviceGetMigMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMinMaxClockOfPState", Symbol: "nvmlDeviceGetMinMaxClockOfPState", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMinMaxFanSpeed", Symbol: "nvmlDeviceGetMinMaxFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMinorNumber", Symbol: "nvmlDeviceGetMinorNumber", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetModuleId", Symbol: "nvmlDeviceGetModuleId", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetMultiGpuBoard", Symbol: "nvmlDeviceGetMultiGpuBoard", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetName", Symbol: "nvmlDeviceGetName", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetNumFans", Symbol: "nvmlDeviceGetNumFans", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNumGpuCores", Symbol: "nvmlDeviceGetNumGpuCores", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNumaNodeId", Symbol: "nvmlDeviceGetNumaNodeId", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkCapability", Symbol: "nvmlDeviceGetNvLinkCapability", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkErrorCounter", Symbol: "nvmlDeviceGetNvLinkErrorCounter", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkInfo", Symbol: "nvmlDeviceGetNvLinkInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkRemoteDeviceType", Symbol: "nvmlDeviceGetNvLinkRemoteDeviceType", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkRemotePciInfo", Symbol: "nvmlDeviceGetNvLinkRemotePciInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkState", Symbol: "nvmlDeviceGetNvLinkState", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkUtilizationControl", Symbol: "nvmlDeviceGetNvLinkUtilizationControl", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkUtilizationCounter", Symbol: "nvmlDeviceGetNvLinkUtilizationCounter", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvLinkVersion", Symbol: "nvmlDeviceGetNvLinkVersion", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvlinkBwMode", Symbol: "nvmlDeviceGetNvlinkBwMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetNvlinkSupportedBwModes", Symbol: "nvmlDeviceGetNvlinkSupportedBwModes", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetOfaUtilization", Symbol: "nvmlDeviceGetOfaUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetP2PStatus", Symbol: "nvmlDeviceGetP2PStatus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPciInfo", Symbol: "nvmlDeviceGetPciInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPciInfoExt", Symbol: "nvmlDeviceGetPciInfoExt", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPcieLinkMaxSpeed", Symbol: "nvmlDeviceGetPcieLinkMaxSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPcieReplayCounter", Symbol: "nvmlDeviceGetPcieReplayCounter", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPcieSpeed", Symbol: "nvmlDeviceGetPcieSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPcieThroughput", Symbol: "nvmlDeviceGetPcieThroughput", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPdi", Symbol: "nvmlDeviceGetPdi", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPerformanceModes", Symbol: "nvmlDeviceGetPerformanceModes", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPerformanceState", Symbol: "nvmlDeviceGetPerformanceState", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPersistenceMode", Symbol: "nvmlDeviceGetPersistenceMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPgpuMetadataString", Symbol: "nvmlDeviceGetPgpuMetadataString", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPlatformInfo", Symbol: "nvmlDeviceGetPlatformInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerManagementDefaultLimit", Symbol: "nvmlDeviceGetPowerManagementDefaultLimit", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerManagementLimit", Symbol: "nvmlDeviceGetPowerManagementLimit", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerManagementLimitConstraints", Symbol: "nvmlDeviceGetPowerManagementLimitConstraints", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerManagementMode", Symbol: "nvmlDeviceGetPowerManagementMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerMizerMode_v1", Symbol: "nvmlDeviceGetPowerMizerMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerSource", Symbol: "nvmlDeviceGetPowerSource", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerState", Symbol: "nvmlDeviceGetPowerState", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetPowerUsage", Symbol: "nvmlDeviceGetPowerUsage", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetProcessUtilization", Symbol: "nvmlDeviceGetProcessUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetProcessesUtilizationInfo", Symbol: "nvmlDeviceGetProcessesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRemappedRows", Symbol: "nvmlDeviceGetRemappedRows", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRepairStatus", Symbol: "nvmlDeviceGetRepairStatus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRetiredPages", Symbol: "nvmlDeviceGetRetiredPages", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRetiredPagesPendingStatus", Symbol: "nvmlDeviceGetRetiredPagesPendingStatus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRetiredPages_v2", Symbol: "nvmlDeviceGetRetiredPages", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRowRemapperHistogram", Symbol: "nvmlDeviceGetRowRemapperHistogram", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetRunningProcessDetailList", Symbol: "nvmlDeviceGetRunningProcessDetailList", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetSamples", Symbol: "nvmlDeviceGetSamples", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSerial", Symbol: "nvmlDeviceGetSerial", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSramEccErrorStatus", Symbol: "nvmlDeviceGetSramEccErrorStatus", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSramUniqueUncorrectedEccErrorCounts", Symbol: "nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedClocksEventReasons", Symbol: "nvmlDeviceGetSupportedClocksEventReasons", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedClocksThrottleReasons", Symbol: "nvmlDeviceGetSupportedClocksThrottleReasons", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedEventTypes", Symbol: "nvmlDeviceGetSupportedEventTypes", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedGraphicsClocks", Symbol: "nvmlDeviceGetSupportedGraphicsClocks", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedMemoryClocks", Symbol: "nvmlDeviceGetSupportedMemoryClocks", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedPerformanceStates", Symbol: "nvmlDeviceGetSupportedPerformanceStates", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetSupportedVgpus", Symbol: "nvmlDeviceGetSupportedVgpus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTargetFanSpeed", Symbol: "nvmlDeviceGetTargetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTemperature", Symbol: "nvmlDeviceGetTemperature", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTemperatureThreshold", Symbol: "nvmlDeviceGetTemperatureThreshold", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTemperatureV", Symbol: "nvmlDeviceGetTemperatureV", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetThermalSettings", Symbol: "nvmlDeviceGetThermalSettings", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTopologyCommonAncestor", Symbol: "nvmlDeviceGetTopologyCommonAncestor", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTopologyNearestGpus", Symbol: "nvmlDeviceGetTopologyNearestGpus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTotalEccErrors", Symbol: "nvmlDeviceGetTotalEccErrors", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetTotalEnergyConsumption", Symbol: "nvmlDeviceGetTotalEnergyConsumption", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetUUID", Symbol: "nvmlDeviceGetUUID", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceGetUtilizationRates", Symbol: "nvmlDeviceGetUtilizationRates", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVbiosVersion", Symbol: "nvmlDeviceGetVbiosVersion", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuCapabilities", Symbol: "nvmlDeviceGetVgpuCapabilities", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuHeterogeneousMode", Symbol: "nvmlDeviceGetVgpuHeterogeneousMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuInstancesUtilizationInfo", Symbol: "nvmlDeviceGetVgpuInstancesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuMetadata", Symbol: "nvmlDeviceGetVgpuMetadata", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuProcessUtilization", Symbol: "nvmlDeviceGetVgpuProcessUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuProcessesUtilizationInfo", Symbol: "nvmlDeviceGetVgpuProcessesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuSchedulerCapabilities", Symbol: "nvmlDeviceGetVgpuSchedulerCapabilities", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuSchedulerLog", Symbol: "nvmlDeviceGetVgpuSchedulerLog", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuSchedulerState", Symbol: "nvmlDeviceGetVgpuSchedulerState", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuTypeCreatablePlacements", Symbol: "nvmlDeviceGetVgpuTypeCreatablePlacements", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuTypeSupportedPlacements", Symbol: "nvmlDeviceGetVgpuTypeSupportedPlacements", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVgpuUtilization", Symbol: "nvmlDeviceGetVgpuUtilization", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetViolationStatus", Symbol: "nvmlDeviceGetViolationStatus", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceGetVirtualizationMode", Symbol: "nvmlDeviceGetVirtualizationMode", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceIsMigDeviceHandle", Symbol: "nvmlDeviceIsMigDeviceHandle", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Interface", Name: "DeviceModifyDrainState", Symbol: "nvmlDeviceModifyDrainState", Mutates: true},
+	{Interface: "Interface", Name: "DeviceOnSameBoard", Symbol: "nvmlDeviceOnSameBoard", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DevicePowerSmoothingActivatePresetProfile", Symbol: "nvmlDevicePowerSmoothingActivatePresetProfile", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DevicePowerSmoothingSetState", Symbol: "nvmlDevicePowerSmoothingSetState", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DevicePowerSmoothingUpdatePresetProfileParam", Symbol: "nvmlDevicePowerSmoothingUpdatePresetProfileParam", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceQueryDrainState", Symbol: "nvmlDeviceQueryDrainState"},
+	{Interface: "Interface", Name: "DeviceReadWritePRM_v1", Symbol: "nvmlDeviceReadWritePRM", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceRegisterEvents", Symbol: "nvmlDeviceRegisterEvents", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceRemoveGpu", Symbol: "nvmlDeviceRemoveGpu", Mutates: true},
+	{Interface: "Interface", Name: "DeviceRemoveGpu_v2", Symbol: "nvmlDeviceRemoveGpu", Mutates: true},
+	{Interface: "Interface", Name: "DeviceResetApplicationsClocks", Symbol: "nvmlDeviceResetApplicationsClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceResetGpuLockedClocks", Symbol: "nvmlDeviceResetGpuLockedClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceResetMemoryLockedClocks", Symbol: "nvmlDeviceResetMemoryLockedClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceResetNvLinkErrorCounters", Symbol: "nvmlDeviceResetNvLinkErrorCounters", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceResetNvLinkUtilizationCounter", Symbol: "nvmlDeviceResetNvLinkUtilizationCounter", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetAPIRestriction", Symbol: "nvmlDeviceSetAPIRestriction", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetAccountingMode", Symbol: "nvmlDeviceSetAccountingMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetApplicationsClocks", Symbol: "nvmlDeviceSetApplicationsClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetAutoBoostedClocksEnabled", Symbol: "nvmlDeviceSetAutoBoostedClocksEnabled", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetClockOffsets", Symbol: "nvmlDeviceSetClockOffsets", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetComputeMode", Symbol: "nvmlDeviceSetComputeMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetConfComputeUnprotectedMemSize", Symbol: "nvmlDeviceSetConfComputeUnprotectedMemSize", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetCpuAffinity", Symbol: "nvmlDeviceSetCpuAffinity", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetDefaultAutoBoostedClocksEnabled", Symbol: "nvmlDeviceSetDefaultAutoBoostedClocksEnabled", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetDefaultFanSpeed_v2", Symbol: "nvmlDeviceSetDefaultFanSpeed", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetDramEncryptionMode", Symbol: "nvmlDeviceSetDramEncryptionMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetDriverModel", Symbol: "nvmlDeviceSetDriverModel", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetEccMode", Symbol: "nvmlDeviceSetEccMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetFanControlPolicy", Symbol: "nvmlDeviceSetFanControlPolicy", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetFanSpeed_v2", Symbol: "nvmlDeviceSetFanSpeed", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetGpcClkVfOffset", Symbol: "nvmlDeviceSetGpcClkVfOffset", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetGpuLockedClocks", Symbol: "nvmlDeviceSetGpuLockedClocks", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetGpuOperationMode", Symbol: "nvmlDeviceSetGpuOperationMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetMemClkVfOffset", Symbol: "nvmlDeviceSetMemClkVfOffset", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetMemoryLockedClocks", Symbol: "nvmlDeviceSetMemoryLockedClocks", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetMigMode", Symbol: "nvmlDeviceSetMigMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetNvLinkDeviceLowPowerThreshold", Symbol: "nvmlDeviceSetNvLinkDeviceLowPowerThreshold", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetNvLinkUtilizationControl", Symbol: "nvmlDeviceSetNvLinkUtilizationControl", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetNvlinkBwMode", Symbol: "nvmlDeviceSetNvlinkBwMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetPersistenceMode", Symbol: "nvmlDeviceSetPersistenceMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetPowerManagementLimit", Symbol: "nvmlDeviceSetPowerManagementLimit", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetPowerManagementLimit_v2", Symbol: "nvmlDeviceSetPowerManagementLimit", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetPowerMizerMode_v1", Symbol: "nvmlDeviceSetPowerMizerMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetTemperatureThreshold", Symbol: "nvmlDeviceSetTemperatureThreshold", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetVgpuCapabilities", Symbol: "nvmlDeviceSetVgpuCapabilities", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetVgpuHeterogeneousMode", Symbol: "nvmlDeviceSetVgpuHeterogeneousMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetVgpuSchedulerState", Symbol: "nvmlDeviceSetVgpuSchedulerState", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceSetVirtualizationMode", Symbol: "nvmlDeviceSetVirtualizationMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceValidateInforom", Symbol: "nvmlDeviceValidateInforom", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceWorkloadPowerProfileClearRequestedProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileClearRequestedProfiles", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceWorkloadPowerProfileGetCurrentProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileGetCurrentProfiles", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceWorkloadPowerProfileGetProfilesInfo", Symbol: "nvmlDeviceWorkloadPowerProfileGetProfilesInfo", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "DeviceWorkloadPowerProfileSetRequestedProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileSetRequestedProfiles", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "ErrorString", Symbol: "nvmlErrorString"},
+	{Interface: "Interface", Name: "EventSetCreate", Symbol: "nvmlEventSetCreate"},
+	{Interface: "Interface", Name: "EventSetFree", Symbol: "nvmlEventSetFree"},
+	{Interface: "Interface", Name: "EventSetWait", Symbol: "nvmlEventSetWait"},
+	{Interface: "Interface", Name: "Extensions"},
+	{Interface: "Interface", Name: "GetExcludedDeviceCount", Symbol: "nvmlGetExcludedDeviceCount"},
+	{Interface: "Interface", Name: "GetExcludedDeviceInfoByIndex", Symbol: "nvmlGetExcludedDeviceInfoByIndex"},
+	{Interface: "Interface", Name: "GetVgpuCompatibility", Symbol: "nvmlGetVgpuCompatibility"},
+	{Interface: "Interface", Name: "GetVgpuDriverCapabilities", Symbol: "nvmlGetVgpuDriverCapabilities"},
+	{Interface: "Interface", Name: "GetVgpuVersion", Symbol: "nvmlGetVgpuVersion"},
+	{Interface: "Interface", Name: "GpmMetricsGet", Symbol: "nvmlGpmMetricsGet"},
+	{Interface: "Interface", Name: "GpmMetricsGetV", Symbol: "nvmlGpmMetricsGet"},
+	{Interface: "Interface", Name: "GpmMigSampleGet", Symbol: "nvmlGpmMigSampleGet", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpmQueryDeviceSupport", Symbol: "nvmlGpmQueryDeviceSupport", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpmQueryDeviceSupportV", Symbol: "nvmlGpmQueryDeviceSupport", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpmQueryIfStreamingEnabled", Symbol: "nvmlGpmQueryIfStreamingEnabled", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpmSampleAlloc", Symbol: "nvmlGpmSampleAlloc"},
+	{Interface: "Interface", Name: "GpmSampleFree", Symbol: "nvmlGpmSampleFree"},
+	{Interface: "Interface", Name: "GpmSampleGet", Symbol: "nvmlGpmSampleGet", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpmSetStreamingEnabled", Symbol: "nvmlGpmSetStreamingEnabled", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "GpuInstanceCreateComputeInstance", Symbol: "nvmlGpuInstanceCreateComputeInstance", Mutates: true},
+	{Interface: "Interface", Name: "GpuInstanceCreateComputeInstanceWithPlacement", Symbol: "nvmlGpuInstanceCreateComputeInstanceWithPlacement", Mutates: true},
+	{Interface: "Interface", Name: "GpuInstanceDestroy", Symbol: "nvmlGpuInstanceDestroy", Mutates: true},
+	{Interface: "Interface", Name: "GpuInstanceGetActiveVgpus", Symbol: "nvmlGpuInstanceGetActiveVgpus"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstanceById", Symbol: "nvmlGpuInstanceGetComputeInstanceById"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstancePossiblePlacements", Symbol: "nvmlGpuInstanceGetComputeInstancePossiblePlacements"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstanceProfileInfo", Symbol: "nvmlGpuInstanceGetComputeInstanceProfileInfo"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstanceProfileInfoV", Symbol: "nvmlGpuInstanceGetComputeInstanceProfileInfoV"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstanceRemainingCapacity", Symbol: "nvmlGpuInstanceGetComputeInstanceRemainingCapacity"},
+	{Interface: "Interface", Name: "GpuInstanceGetComputeInstances", Symbol: "nvmlGpuInstanceGetComputeInstances"},
+	{Interface: "Interface", Name: "GpuInstanceGetCreatableVgpus", Symbol: "nvmlGpuInstanceGetCreatableVgpus"},
+	{Interface: "Interface", Name: "GpuInstanceGetInfo", Symbol: "nvmlGpuInstanceGetInfo"},
+	{Interface: "Interface", Name: "GpuInstanceGetVgpuHeterogeneousMode", Symbol: "nvmlGpuInstanceGetVgpuHeterogeneousMode"},
+	{Interface: "Interface", Name: "GpuInstanceGetVgpuSchedulerLog", Symbol: "nvmlGpuInstanceGetVgpuSchedulerLog"},
+	{Interface: "Interface", Name: "GpuInstanceGetVgpuSchedulerState", Symbol: "nvmlGpuInstanceGetVgpuSchedulerState"},
+	{Interface: "Interface", Name: "GpuInstanceGetVgpuTypeCreatablePlacements", Symbol: "nvmlGpuInstanceGetVgpuTypeCreatablePlacements"},
+	{Interface: "Interface", Name: "GpuInstanceSetVgpuHeterogeneousMode", Symbol: "nvmlGpuInstanceSetVgpuHeterogeneousMode", Mutates: true},
+	{Interface: "Interface", Name: "GpuInstanceSetVgpuSchedulerState", Symbol: "nvmlGpuInstanceSetVgpuSchedulerState", Mutates: true},
+	{Interface: "Interface", Name: "Init", Symbol: "nvmlInit"},
+	{Interface: "Interface", Name: "InitWithFlags", Symbol: "nvmlInitWithFlags"},
+	{Interface: "Interface", Name: "SetVgpuVersion", Symbol: "nvmlSetVgpuVersion", Mutates: true},
+	{Interface: "Interface", Name: "Shutdown", Symbol: "nvmlShutdown"},
+	{Interface: "Interface", Name: "SystemEventSetCreate", Symbol: "nvmlSystemEventSetCreate"},
+	{Interface: "Interface", Name: "SystemEventSetFree", Symbol: "nvmlSystemEventSetFree"},
+	{Interface: "Interface", Name: "SystemEventSetWait", Symbol: "nvmlSystemEventSetWait"},
+	{Interface: "Interface", Name: "SystemGetConfComputeCapabilities", Symbol: "nvmlSystemGetConfComputeCapabilities"},
+	{Interface: "Interface", Name: "SystemGetConfComputeGpusReadyState", Symbol: "nvmlSystemGetConfComputeGpusReadyState"},
+	{Interface: "Interface", Name: "SystemGetConfComputeKeyRotationThresholdInfo", Symbol: "nvmlSystemGetConfComputeKeyRotationThresholdInfo"},
+	{Interface: "Interface", Name: "SystemGetConfComputeSettings", Symbol: "nvmlSystemGetConfComputeSettings"},
+	{Interface: "Interface", Name: "SystemGetConfComputeState", Symbol: "nvmlSystemGetConfComputeState"},
+	{Interface: "Interface", Name: "SystemGetCudaDriverVersion", Symbol: "nvmlSystemGetCudaDriverVersion"},
+	{Interface: "Interface", Name: "SystemGetCudaDriverVersion_v2", Symbol: "nvmlSystemGetCudaDriverVersion"},
+	{Interface: "Interface", Name: "SystemGetDriverBranch", Symbol: "nvmlSystemGetDriverBranch"},
+	{Interface: "Interface", Name: "SystemGetDriverVersion", Symbol: "nvmlSystemGetDriverVersion"},
+	{Interface: "Interface", Name: "SystemGetHicVersion", Symbol: "nvmlSystemGetHicVersion"},
+	{Interface: "Interface", Name: "SystemGetNVMLVersion", Symbol: "nvmlSystemGetNVMLVersion"},
+	{Interface: "Interface", Name: "SystemGetNvlinkBwMode", Symbol: "nvmlSystemGetNvlinkBwMode"},
+	{Interface: "Interface", Name: "SystemGetProcessName", Symbol: "nvmlSystemGetProcessName"},
+	{Interface: "Interface", Name: "SystemGetTopologyGpuSet", Symbol: "nvmlSystemGetTopologyGpuSet"},
+	{Interface: "Interface", Name: "SystemRegisterEvents", Symbol: "nvmlSystemRegisterEvents"},
+	{Interface: "Interface", Name: "SystemSetConfComputeGpusReadyState", Symbol: "nvmlSystemSetConfComputeGpusReadyState", Mutates: true},
+	{Interface: "Interface", Name: "SystemSetConfComputeKeyRotationThresholdInfo", Symbol: "nvmlSystemSetConfComputeKeyRotationThresholdInfo", Mutates: true},
+	{Interface: "Interface", Name: "SystemSetNvlinkBwMode", Symbol: "nvmlSystemSetNvlinkBwMode", Mutates: true},
+	{Interface: "Interface", Name: "UnitGetCount", Symbol: "nvmlUnitGetCount"},
+	{Interface: "Interface", Name: "UnitGetDevices", Symbol: "nvmlUnitGetDevices"},
+	{Interface: "Interface", Name: "UnitGetFanSpeedInfo", Symbol: "nvmlUnitGetFanSpeedInfo"},
+	{Interface: "Interface", Name: "UnitGetHandleByIndex", Symbol: "nvmlUnitGetHandleByIndex"},
+	{Interface: "Interface", Name: "UnitGetLedState", Symbol: "nvmlUnitGetLedState"},
+	{Interface: "Interface", Name: "UnitGetPsuInfo", Symbol: "nvmlUnitGetPsuInfo"},
+	{Interface: "Interface", Name: "UnitGetTemperature", Symbol: "nvmlUnitGetTemperature"},
+	{Interface: "Interface", Name: "UnitGetUnitInfo", Symbol: "nvmlUnitGetUnitInfo"},
+	{Interface: "Interface", Name: "UnitSetLedState", Symbol: "nvmlUnitSetLedState", Mutates: true, RequiresRoot: true},
+	{Interface: "Interface", Name: "VgpuInstanceClearAccountingPids", Symbol: "nvmlVgpuInstanceClearAccountingPids", Mutates: true, RequiresRoot: true},
+	{Interface: "Interface", Name: "VgpuInstanceGetAccountingMode", Symbol: "nvmlVgpuInstanceGetAccountingMode"},
+	{Interface: "Interface", Name: "VgpuInstanceGetAccountingPids", Symbol: "nvmlVgpuInstanceGetAccountingPids"},
+	{Interface: "Interface", Name: "VgpuInstanceGetAccountingStats", Symbol: "nvmlVgpuInstanceGetAccountingStats"},
+	{Interface: "Interface", Name: "VgpuInstanceGetEccMode", Symbol: "nvmlVgpuInstanceGetEccMode"},
+	{Interface: "Interface", Name: "VgpuInstanceGetEncoderCapacity", Symbol: "nvmlVgpuInstanceGetEncoderCapacity"},
+	{Interface: "Interface", Name: "VgpuInstanceGetEncoderSessions", Symbol: "nvmlVgpuInstanceGetEncoderSessions"},
+	{Interface: "Interface", Name: "VgpuInstanceGetEncoderStats", Symbol: "nvmlVgpuInstanceGetEncoderStats"},
+	{Interface: "Interface", Name: "VgpuInstanceGetFBCSessions", Symbol: "nvmlVgpuInstanceGetFBCSessions"},
+	{Interface: "Interface", Name: "VgpuInstanceGetFBCStats", Symbol: "nvmlVgpuInstanceGetFBCStats"},
+	{Interface: "Interface", Name: "VgpuInstanceGetFbUsage", Symbol: "nvmlVgpuInstanceGetFbUsage"},
+	{Interface: "Interface", Name: "VgpuInstanceGetFrameRateLimit", Symbol: "nvmlVgpuInstanceGetFrameRateLimit"},
+	{Interface: "Interface", Name: "VgpuInstanceGetGpuInstanceId", Symbol: "nvmlVgpuInstanceGetGpuInstanceId"},
+	{Interface: "Interface", Name: "VgpuInstanceGetGpuPciId", Symbol: "nvmlVgpuInstanceGetGpuPciId"},
+	{Interface: "Interface", Name: "VgpuInstanceGetLicenseInfo", Symbol: "nvmlVgpuInstanceGetLicenseInfo"},
+	{Interface: "Interface", Name: "VgpuInstanceGetLicenseStatus", Symbol: "nvmlVgpuInstanceGetLicenseStatus"},
+	{Interface: "Interface", Name: "VgpuInstanceGetMdevUUID", Symbol: "nvmlVgpuInstanceGetMdevUUID"},
+	{Interface: "Interface", Name: "VgpuInstanceGetMetadata", Symbol: "nvmlVgpuInstanceGetMetadata"},
+	{Interface: "Interface", Name: "VgpuInstanceGetPlacementId", Symbol: "nvmlVgpuInstanceGetPlacementId"},
+	{Interface: "Interface", Name: "VgpuInstanceGetRuntimeStateSize", Symbol: "nvmlVgpuInstanceGetRuntimeStateSize"},
+	{Interface: "Interface", Name: "VgpuInstanceGetType", Symbol: "nvmlVgpuInstanceGetType"},
+	{Interface: "Interface", Name: "VgpuInstanceGetUUID", Symbol: "nvmlVgpuInstanceGetUUID"},
+	{Interface: "Interface", Name: "VgpuInstanceGetVmDriverVersion", Symbol: "nvmlVgpuInstanceGetVmDriverVersion"},
+	{Interface: "Interface", Name: "VgpuInstanceGetVmID", Symbol: "nvmlVgpuInstanceGetVmID"},
+	{Interface: "Interface", Name: "VgpuInstanceSetEncoderCapacity", Symbol: "nvmlVgpuInstanceSetEncoderCapacity", Mutates: true},
+	{Interface: "Interface", Name: "VgpuTypeGetBAR1Info", Symbol: "nvmlVgpuTypeGetBAR1Info"},
+	{Interface: "Interface", Name: "VgpuTypeGetCapabilities", Symbol: "nvmlVgpuTypeGetCapabilities"},
+	{Interface: "Interface", Name: "VgpuTypeGetClass", Symbol: "nvmlVgpuTypeGetClass"},
+	{Interface: "Interface", Name: "VgpuTypeGetDeviceID", Symbol: "nvmlVgpuTypeGetDeviceID"},
+	{Interface: "Interface", Name: "VgpuTypeGetFbReservation", Symbol: "nvmlVgpuTypeGetFbReservation"},
+	{Interface: "Interface", Name: "VgpuTypeGetFrameRateLimit", Symbol: "nvmlVgpuTypeGetFrameRateLimit"},
+	{Interface: "Interface", Name: "VgpuTypeGetFramebufferSize", Symbol: "nvmlVgpuTypeGetFramebufferSize"},
+	{Interface: "Interface", Name: "VgpuTypeGetGpuInstanceProfileId", Symbol: "nvmlVgpuTypeGetGpuInstanceProfileId"},
+	{Interface: "Interface", Name: "VgpuTypeGetGspHeapSize", Symbol: "nvmlVgpuTypeGetGspHeapSize"},
+	{Interface: "Interface", Name: "VgpuTypeGetLicense", Symbol: "nvmlVgpuTypeGetLicense"},
+	{Interface: "Interface", Name: "VgpuTypeGetMaxInstances", Symbol: "nvmlVgpuTypeGetMaxInstances", Handles: FullGpuHandle},
+	{Interface: "Interface", Name: "VgpuTypeGetMaxInstancesPerGpuInstance", Symbol: "nvmlVgpuTypeGetMaxInstancesPerGpuInstance"},
+	{Interface: "Interface", Name: "VgpuTypeGetMaxInstancesPerVm", Symbol: "nvmlVgpuTypeGetMaxInstancesPerVm"},
+	{Interface: "Interface", Name: "VgpuTypeGetName", Symbol: "nvmlVgpuTypeGetName"},
+	{Interface: "Interface", Name: "VgpuTypeGetNumDisplayHeads", Symbol: "nvmlVgpuTypeGetNumDisplayHeads"},
+	{Interface: "Interface", Name: "VgpuTypeGetResolution", Symbol: "nvmlVgpuTypeGetResolution"},
+	{Interface: "Device", Name: "ClearAccountingPids", Symbol: "nvmlDeviceClearAccountingPids", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ClearCpuAffinity", Symbol: "nvmlDeviceClearCpuAffinity", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ClearEccErrorCounts", Symbol: "nvmlDeviceClearEccErrorCounts", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ClearFieldValues", Symbol: "nvmlDeviceClearFieldValues", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "CreateGpuInstance", Symbol: "nvmlDeviceCreateGpuInstance", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "CreateGpuInstanceWithPlacement", Symbol: "nvmlDeviceCreateGpuInstanceWithPlacement", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "FreezeNvLinkUtilizationCounter", Symbol: "nvmlDeviceFreezeNvLinkUtilizationCounter", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAPIRestriction", Symbol: "nvmlDeviceGetAPIRestriction", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAccountingBufferSize", Symbol: "nvmlDeviceGetAccountingBufferSize", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAccountingMode", Symbol: "nvmlDeviceGetAccountingMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAccountingPids", Symbol: "nvmlDeviceGetAccountingPids", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAccountingStats", Symbol: "nvmlDeviceGetAccountingStats", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetActiveVgpus", Symbol: "nvmlDeviceGetActiveVgpus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAdaptiveClockInfoStatus", Symbol: "nvmlDeviceGetAdaptiveClockInfoStatus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAddressingMode", Symbol: "nvmlDeviceGetAddressingMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetApplicationsClock", Symbol: "nvmlDeviceGetApplicationsClock", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetArchitecture", Symbol: "nvmlDeviceGetArchitecture", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetAttributes", Symbol: "nvmlDeviceGetAttributes", Handles: MigDeviceHandle},
+	{Interface: "Device", Name: "GetAutoBoostedClocksEnabled", Symbol: "nvmlDeviceGetAutoBoostedClocksEnabled", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetBAR1MemoryInfo", Symbol: "nvmlDeviceGetBAR1MemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetBoardId", Symbol: "nvmlDeviceGetBoardId", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetBoardPartNumber", Symbol: "nvmlDeviceGetBoardPartNumber", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetBrand", Symbol: "nvmlDeviceGetBrand", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetBridgeChipInfo", Symbol: "nvmlDeviceGetBridgeChipInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetBusType", Symbol: "nvmlDeviceGetBusType", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetC2cModeInfoV", Symbol: "nvmlDeviceGetC2cModeInfoV", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCapabilities", Symbol: "nvmlDeviceGetCapabilities", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetClkMonStatus", Symbol: "nvmlDeviceGetClkMonStatus", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetClock", Symbol: "nvmlDeviceGetClock", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetClockInfo", Symbol: "nvmlDeviceGetClockInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetClockOffsets", Symbol: "nvmlDeviceGetClockOffsets", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetComputeInstanceId", Symbol: "nvmlDeviceGetComputeInstanceId", Handles: MigDeviceHandle},
+	{Interface: "Device", Name: "GetComputeMode", Symbol: "nvmlDeviceGetComputeMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetComputeRunningProcesses", Symbol: "nvmlDeviceGetComputeRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetConfComputeGpuAttestationReport", Symbol: "nvmlDeviceGetConfComputeGpuAttestationReport", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetConfComputeGpuCertificate", Symbol: "nvmlDeviceGetConfComputeGpuCertificate", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetConfComputeMemSizeInfo", Symbol: "nvmlDeviceGetConfComputeMemSizeInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetConfComputeProtectedMemoryUsage", Symbol: "nvmlDeviceGetConfComputeProtectedMemoryUsage", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCoolerInfo", Symbol: "nvmlDeviceGetCoolerInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCpuAffinity", Symbol: "nvmlDeviceGetCpuAffinity", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCpuAffinityWithinScope", Symbol: "nvmlDeviceGetCpuAffinityWithinScope", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCreatableVgpus", Symbol: "nvmlDeviceGetCreatableVgpus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCudaComputeCapability", Symbol: "nvmlDeviceGetCudaComputeCapability", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCurrPcieLinkGeneration", Symbol: "nvmlDeviceGetCurrPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCurrPcieLinkWidth", Symbol: "nvmlDeviceGetCurrPcieLinkWidth", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCurrentClockFreqs", Symbol: "nvmlDeviceGetCurrentClockFreqs", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCurrentClocksEventReasons", Symbol: "nvmlDeviceGetCurrentClocksEventReasons", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetCurrentClocksThrottleReasons", Symbol: "nvmlDeviceGetCurrentClocksThrottleReasons", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDecoderUtilization", Symbol: "nvmlDeviceGetDecoderUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDefaultApplicationsClock", Symbol: "nvmlDeviceGetDefaultApplicationsClock", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDefaultEccMode", Symbol: "nvmlDeviceGetDefaultEccMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDetailedEccErrors", Symbol: "nvmlDeviceGetDetailedEccErrors", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDeviceHandleFromMigDeviceHandle", Symbol: "nvmlDeviceGetDeviceHandleFromMigDeviceHandle", Handles: MigDeviceHandle},
+	{Interface: "Device", Name: "GetDisplayActive", Symbol: "nvmlDeviceGetDisplayActive", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDisplayMode", Symbol: "nvmlDeviceGetDisplayMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDramEncryptionMode", Symbol: "nvmlDeviceGetDramEncryptionMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDriverModel", Symbol: "nvmlDeviceGetDriverModel", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDriverModel_v2", Symbol: "nvmlDeviceGetDriverModel", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetDynamicPstatesInfo", Symbol: "nvmlDeviceGetDynamicPstatesInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEccMode", Symbol: "nvmlDeviceGetEccMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEncoderCapacity", Symbol: "nvmlDeviceGetEncoderCapacity", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEncoderSessions", Symbol: "nvmlDeviceGetEncoderSessions", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEncoderStats", Symbol: "nvmlDeviceGetEncoderStats", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEncoderUtilization", Symbol: "nvmlDeviceGetEncoderUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetEnforcedPowerLimit", Symbol: "nvmlDeviceGetEnforcedPowerLimit", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFBCSessions", Symbol: "nvmlDeviceGetFBCSessions", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFBCStats", Symbol: "nvmlDeviceGetFBCStats", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFanControlPolicy_v2", Symbol: "nvmlDeviceGetFanControlPolicy", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFanSpeed", Symbol: "nvmlDeviceGetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFanSpeedRPM", Symbol: "nvmlDeviceGetFanSpeedRPM", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFanSpeed_v2", Symbol: "nvmlDeviceGetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetFieldValues", Symbol: "nvmlDeviceGetFieldValues", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpcClkMinMaxVfOffset", Symbol: "nvmlDeviceGetGpcClkMinMaxVfOffset", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpcClkVfOffset", Symbol: "nvmlDeviceGetGpcClkVfOffset", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuFabricInfo", Symbol: "nvmlDeviceGetGpuFabricInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuFabricInfoV", Symbol: "nvmlDeviceGetGpuFabricInfoV", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceById", Symbol: "nvmlDeviceGetGpuInstanceById", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceId", Symbol: "nvmlDeviceGetGpuInstanceId", Handles: MigDeviceHandle},
+	{Interface: "Device", Name: "GetGpuInstancePossiblePlacements", Symbol: "nvmlDeviceGetGpuInstancePossiblePlacements", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceProfileInfo", Symbol: "nvmlDeviceGetGpuInstanceProfileInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceProfileInfoByIdV", Symbol: "nvmlDeviceGetGpuInstanceProfileInfoByIdV", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceProfileInfoV", Symbol: "nvmlDeviceGetGpuInstanceProfileInfoV", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstanceRemainingCapacity", Symbol: "nvmlDeviceGetGpuInstanceRemainingCapacity", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuInstances", Symbol: "nvmlDeviceGetGpuInstances", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuMaxPcieLinkGeneration", Symbol: "nvmlDeviceGetGpuMaxPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGpuOperationMode", Symbol: "nvmlDeviceGetGpuOperationMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGraphicsRunningProcesses", Symbol: "nvmlDeviceGetGraphicsRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetGridLicensableFeatures", Symbol: "nvmlDeviceGetGridLicensableFeatures", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGspFirmwareMode", Symbol: "nvmlDeviceGetGspFirmwareMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetGspFirmwareVersion", Symbol: "nvmlDeviceGetGspFirmwareVersion", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetHostVgpuMode", Symbol: "nvmlDeviceGetHostVgpuMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetIndex", Symbol: "nvmlDeviceGetIndex", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetInforomConfigurationChecksum", Symbol: "nvmlDeviceGetInforomConfigurationChecksum", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetInforomImageVersion", Symbol: "nvmlDeviceGetInforomImageVersion", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetInforomVersion", Symbol: "nvmlDeviceGetInforomVersion", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetIrqNum", Symbol: "nvmlDeviceGetIrqNum", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetJpgUtilization", Symbol: "nvmlDeviceGetJpgUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetLastBBXFlushTime", Symbol: "nvmlDeviceGetLastBBXFlushTime", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMPSComputeRunningProcesses", Symbol: "nvmlDeviceGetMPSComputeRunningProcesses", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetMarginTemperature", Symbol: "nvmlDeviceGetMarginTemperature", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMaxClockInfo", Symbol: "nvmlDeviceGetMaxClockInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMaxCustomerBoostClock", Symbol: "nvmlDeviceGetMaxCustomerBoostClock", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMaxMigDeviceCount", Symbol: "nvmlDeviceGetMaxMigDeviceCount", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMaxPcieLinkGeneration", Symbol: "nvmlDeviceGetMaxPcieLinkGeneration", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMaxPcieLinkWidth", Symbol: "nvmlDeviceGetMaxPcieLinkWidth", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMemClkMinMaxVfOffset", Symbol: "nvmlDeviceGetMemClkMinMaxVfOffset", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMemClkVfOffset", Symbol: "nvmlDeviceGetMemClkVfOffset", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMemoryAffinity", Symbol: "nvmlDeviceGetMemoryAffinity", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMemoryBusWidth", Symbol: "nvmlDeviceGetMemoryBusWidth", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMemoryErrorCounter", Symbol: "nvmlDeviceGetMemoryErrorCounter", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetMemoryInfo", Symbol: "nvmlDeviceGetMemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetMemoryInfo_v2", Symbol: "nvmlDeviceGetMemoryInfo", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetMigDeviceHandleByIndex", Symbol: "nvmlDeviceGetMigDeviceHandleByIndex", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMigMode", Symbol: "nvmlDeviceGetMigMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMinMaxClockOfPState", Symbol: "nvmlDeviceGetMinMaxClockOfPState", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMinMaxFanSpeed", Symbol: "nvmlDeviceGetMinMaxFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMinorNumber", Symbol: "nvmlDeviceGetMinorNumber", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetModuleId", Symbol: "nvmlDeviceGetModuleId", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetMultiGpuBoard", Symbol: "nvmlDeviceGetMultiGpuBoard", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetName", Symbol: "nvmlDeviceGetName", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetNumFans", Symbol: "nvmlDeviceGetNumFans", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNumGpuCores", Symbol: "nvmlDeviceGetNumGpuCores", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNumaNodeId", Symbol: "nvmlDeviceGetNumaNodeId", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkCapability", Symbol: "nvmlDeviceGetNvLinkCapability", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkErrorCounter", Symbol: "nvmlDeviceGetNvLinkErrorCounter", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkInfo", Symbol: "nvmlDeviceGetNvLinkInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkRemoteDeviceType", Symbol: "nvmlDeviceGetNvLinkRemoteDeviceType", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkRemotePciInfo", Symbol: "nvmlDeviceGetNvLinkRemotePciInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkState", Symbol: "nvmlDeviceGetNvLinkState", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkUtilizationControl", Symbol: "nvmlDeviceGetNvLinkUtilizationControl", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkUtilizationCounter", Symbol: "nvmlDeviceGetNvLinkUtilizationCounter", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvLinkVersion", Symbol: "nvmlDeviceGetNvLinkVersion", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvlinkBwMode", Symbol: "nvmlDeviceGetNvlinkBwMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetNvlinkSupportedBwModes", Symbol: "nvmlDeviceGetNvlinkSupportedBwModes", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetOfaUtilization", Symbol: "nvmlDeviceGetOfaUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetP2PStatus", Symbol: "nvmlDeviceGetP2PStatus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPciInfo", Symbol: "nvmlDeviceGetPciInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPciInfoExt", Symbol: "nvmlDeviceGetPciInfoExt", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPcieLinkMaxSpeed", Symbol: "nvmlDeviceGetPcieLinkMaxSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPcieReplayCounter", Symbol: "nvmlDeviceGetPcieReplayCounter", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPcieSpeed", Symbol: "nvmlDeviceGetPcieSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPcieThroughput", Symbol: "nvmlDeviceGetPcieThroughput", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPdi", Symbol: "nvmlDeviceGetPdi", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPerformanceModes", Symbol: "nvmlDeviceGetPerformanceModes", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPerformanceState", Symbol: "nvmlDeviceGetPerformanceState", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPersistenceMode", Symbol: "nvmlDeviceGetPersistenceMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPgpuMetadataString", Symbol: "nvmlDeviceGetPgpuMetadataString", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPlatformInfo", Symbol: "nvmlDeviceGetPlatformInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerManagementDefaultLimit", Symbol: "nvmlDeviceGetPowerManagementDefaultLimit", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerManagementLimit", Symbol: "nvmlDeviceGetPowerManagementLimit", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerManagementLimitConstraints", Symbol: "nvmlDeviceGetPowerManagementLimitConstraints", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerManagementMode", Symbol: "nvmlDeviceGetPowerManagementMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerMizerMode_v1", Symbol: "nvmlDeviceGetPowerMizerMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerSource", Symbol: "nvmlDeviceGetPowerSource", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerState", Symbol: "nvmlDeviceGetPowerState", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetPowerUsage", Symbol: "nvmlDeviceGetPowerUsage", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetProcessUtilization", Symbol: "nvmlDeviceGetProcessUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetProcessesUtilizationInfo", Symbol: "nvmlDeviceGetProcessesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRemappedRows", Symbol: "nvmlDeviceGetRemappedRows", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRepairStatus", Symbol: "nvmlDeviceGetRepairStatus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRetiredPages", Symbol: "nvmlDeviceGetRetiredPages", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRetiredPagesPendingStatus", Symbol: "nvmlDeviceGetRetiredPagesPendingStatus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRetiredPages_v2", Symbol: "nvmlDeviceGetRetiredPages", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRowRemapperHistogram", Symbol: "nvmlDeviceGetRowRemapperHistogram", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetRunningProcessDetailList", Symbol: "nvmlDeviceGetRunningProcessDetailList", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetSamples", Symbol: "nvmlDeviceGetSamples", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSerial", Symbol: "nvmlDeviceGetSerial", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSramEccErrorStatus", Symbol: "nvmlDeviceGetSramEccErrorStatus", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSramUniqueUncorrectedEccErrorCounts", Symbol: "nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedClocksEventReasons", Symbol: "nvmlDeviceGetSupportedClocksEventReasons", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedClocksThrottleReasons", Symbol: "nvmlDeviceGetSupportedClocksThrottleReasons", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedEventTypes", Symbol: "nvmlDeviceGetSupportedEventTypes", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedGraphicsClocks", Symbol: "nvmlDeviceGetSupportedGraphicsClocks", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedMemoryClocks", Symbol: "nvmlDeviceGetSupportedMemoryClocks", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedPerformanceStates", Symbol: "nvmlDeviceGetSupportedPerformanceStates", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetSupportedVgpus", Symbol: "nvmlDeviceGetSupportedVgpus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTargetFanSpeed", Symbol: "nvmlDeviceGetTargetFanSpeed", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTemperature", Symbol: "nvmlDeviceGetTemperature", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTemperatureThreshold", Symbol: "nvmlDeviceGetTemperatureThreshold", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTemperatureV", Symbol: "nvmlDeviceGetTemperatureV", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetThermalSettings", Symbol: "nvmlDeviceGetThermalSettings", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTopologyCommonAncestor", Symbol: "nvmlDeviceGetTopologyCommonAncestor", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTopologyNearestGpus", Symbol: "nvmlDeviceGetTopologyNearestGpus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTotalEccErrors", Symbol: "nvmlDeviceGetTotalEccErrors", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetTotalEnergyConsumption", Symbol: "nvmlDeviceGetTotalEnergyConsumption", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetUUID", Symbol: "nvmlDeviceGetUUID", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "GetUtilizationRates", Symbol: "nvmlDeviceGetUtilizationRates", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVbiosVersion", Symbol: "nvmlDeviceGetVbiosVersion", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuCapabilities", Symbol: "nvmlDeviceGetVgpuCapabilities", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuHeterogeneousMode", Symbol: "nvmlDeviceGetVgpuHeterogeneousMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuInstancesUtilizationInfo", Symbol: "nvmlDeviceGetVgpuInstancesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuMetadata", Symbol: "nvmlDeviceGetVgpuMetadata", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuProcessUtilization", Symbol: "nvmlDeviceGetVgpuProcessUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuProcessesUtilizationInfo", Symbol: "nvmlDeviceGetVgpuProcessesUtilizationInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuSchedulerCapabilities", Symbol: "nvmlDeviceGetVgpuSchedulerCapabilities", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuSchedulerLog", Symbol: "nvmlDeviceGetVgpuSchedulerLog", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuSchedulerState", Symbol: "nvmlDeviceGetVgpuSchedulerState", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuTypeCreatablePlacements", Symbol: "nvmlDeviceGetVgpuTypeCreatablePlacements", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuTypeSupportedPlacements", Symbol: "nvmlDeviceGetVgpuTypeSupportedPlacements", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVgpuUtilization", Symbol: "nvmlDeviceGetVgpuUtilization", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetViolationStatus", Symbol: "nvmlDeviceGetViolationStatus", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GetVirtualizationMode", Symbol: "nvmlDeviceGetVirtualizationMode", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmMigSampleGet", Symbol: "nvmlGpmMigSampleGet", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmQueryDeviceSupport", Symbol: "nvmlGpmQueryDeviceSupport", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmQueryDeviceSupportV", Symbol: "nvmlGpmQueryDeviceSupport", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmQueryIfStreamingEnabled", Symbol: "nvmlGpmQueryIfStreamingEnabled", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmSampleGet", Symbol: "nvmlGpmSampleGet", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "GpmSetStreamingEnabled", Symbol: "nvmlGpmSetStreamingEnabled", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "IsMigDeviceHandle", Symbol: "nvmlDeviceIsMigDeviceHandle", Handles: FullGpuHandle | MigDeviceHandle},
+	{Interface: "Device", Name: "OnSameBoard", Symbol: "nvmlDeviceOnSameBoard", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "PowerSmoothingActivatePresetProfile", Symbol: "nvmlDevicePowerSmoothingActivatePresetProfile", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "PowerSmoothingSetState", Symbol: "nvmlDevicePowerSmoothingSetState", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "PowerSmoothingUpdatePresetProfileParam", Symbol: "nvmlDevicePowerSmoothingUpdatePresetProfileParam", RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ReadWritePRM_v1", Symbol: "nvmlDeviceReadWritePRM", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "RegisterEvents", Symbol: "nvmlDeviceRegisterEvents", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ResetApplicationsClocks", Symbol: "nvmlDeviceResetApplicationsClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ResetGpuLockedClocks", Symbol: "nvmlDeviceResetGpuLockedClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ResetMemoryLockedClocks", Symbol: "nvmlDeviceResetMemoryLockedClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ResetNvLinkErrorCounters", Symbol: "nvmlDeviceResetNvLinkErrorCounters", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ResetNvLinkUtilizationCounter", Symbol: "nvmlDeviceResetNvLinkUtilizationCounter", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetAPIRestriction", Symbol: "nvmlDeviceSetAPIRestriction", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetAccountingMode", Symbol: "nvmlDeviceSetAccountingMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetApplicationsClocks", Symbol: "nvmlDeviceSetApplicationsClocks", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetAutoBoostedClocksEnabled", Symbol: "nvmlDeviceSetAutoBoostedClocksEnabled", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetClockOffsets", Symbol: "nvmlDeviceSetClockOffsets", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetComputeMode", Symbol: "nvmlDeviceSetComputeMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetConfComputeUnprotectedMemSize", Symbol: "nvmlDeviceSetConfComputeUnprotectedMemSize", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetCpuAffinity", Symbol: "nvmlDeviceSetCpuAffinity", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetDefaultAutoBoostedClocksEnabled", Symbol: "nvmlDeviceSetDefaultAutoBoostedClocksEnabled", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetDefaultFanSpeed_v2", Symbol: "nvmlDeviceSetDefaultFanSpeed", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetDramEncryptionMode", Symbol: "nvmlDeviceSetDramEncryptionMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetDriverModel", Symbol: "nvmlDeviceSetDriverModel", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetEccMode", Symbol: "nvmlDeviceSetEccMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetFanControlPolicy", Symbol: "nvmlDeviceSetFanControlPolicy", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetFanSpeed_v2", Symbol: "nvmlDeviceSetFanSpeed", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetGpcClkVfOffset", Symbol: "nvmlDeviceSetGpcClkVfOffset", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetGpuLockedClocks", Symbol: "nvmlDeviceSetGpuLockedClocks", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetGpuOperationMode", Symbol: "nvmlDeviceSetGpuOperationMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetMemClkVfOffset", Symbol: "nvmlDeviceSetMemClkVfOffset", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetMemoryLockedClocks", Symbol: "nvmlDeviceSetMemoryLockedClocks", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetMigMode", Symbol: "nvmlDeviceSetMigMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetNvLinkDeviceLowPowerThreshold", Symbol: "nvmlDeviceSetNvLinkDeviceLowPowerThreshold", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetNvLinkUtilizationControl", Symbol: "nvmlDeviceSetNvLinkUtilizationControl", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetNvlinkBwMode", Symbol: "nvmlDeviceSetNvlinkBwMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetPersistenceMode", Symbol: "nvmlDeviceSetPersistenceMode", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetPowerManagementLimit", Symbol: "nvmlDeviceSetPowerManagementLimit", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetPowerManagementLimit_v2", Symbol: "nvmlDeviceSetPowerManagementLimit", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetPowerMizerMode_v1", Symbol: "nvmlDeviceSetPowerMizerMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetTemperatureThreshold", Symbol: "nvmlDeviceSetTemperatureThreshold", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetVgpuCapabilities", Symbol: "nvmlDeviceSetVgpuCapabilities", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetVgpuHeterogeneousMode", Symbol: "nvmlDeviceSetVgpuHeterogeneousMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetVgpuSchedulerState", Symbol: "nvmlDeviceSetVgpuSchedulerState", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "SetVirtualizationMode", Symbol: "nvmlDeviceSetVirtualizationMode", Mutates: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "ValidateInforom", Symbol: "nvmlDeviceValidateInforom", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "VgpuTypeGetMaxInstances", Symbol: "nvmlVgpuTypeGetMaxInstances", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "WorkloadPowerProfileClearRequestedProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileClearRequestedProfiles", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "Device", Name: "WorkloadPowerProfileGetCurrentProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileGetCurrentProfiles", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "WorkloadPowerProfileGetProfilesInfo", Symbol: "nvmlDeviceWorkloadPowerProfileGetProfilesInfo", Handles: FullGpuHandle},
+	{Interface: "Device", Name: "WorkloadPowerProfileSetRequestedProfiles", Symbol: "nvmlDeviceWorkloadPowerProfileSetRequestedProfiles", Mutates: true, RequiresRoot: true, Handles: FullGpuHandle},
+	{Interface: "GpuInstance", Name: "CreateComputeInstance", Symbol: "nvmlGpuInstanceCreateComputeInstance", Mutates: true},
+	{Interface: "GpuInstance", Name: "CreateComputeInstanceWithPlacement", Symbol: "nvmlGpuInstanceCreateComputeInstanceWithPlacement", Mutates: true},
+	{Interface: "GpuInstance", Name: "Destroy", Symbol: "nvmlGpuInstanceDestroy", Mutates: true},
+	{Interface: "GpuInstance", Name: "GetActiveVgpus", Symbol: "nvmlGpuInstanceGetActiveVgpus"},
+	{Interface: "GpuInstance", Name: "GetComputeInstanceById", Symbol: "nvmlGpuInstanceGetComputeInstanceById"},
+	{Interface: "GpuInstance", Name: "GetComputeInstancePossiblePlacements", Symbol: "nvmlGpuInstanceGetComputeInstancePossiblePlacements"},
+	{Interface: "GpuInstance", Name: "GetComputeInstanceProfileInfo", Symbol: "nvmlGpuInstanceGetComputeInstanceProfileInfo"},
+	{Interface: "GpuInstance", Name: "GetComputeInstanceProfileInfoV", Symbol: "nvmlGpuInstanceGetComputeInstanceProfileInfoV"},
+	{Interface: "GpuInstance", Name: "GetComputeInstanceRemainingCapacity", Symbol: "nvmlGpuInstanceGetComputeInstanceRemainingCapacity"},
+	{Interface: "GpuInstance", Name: "GetComputeInstances", Symbol: "nvmlGpuInstanceGetComputeInstances"},
+	{Interface: "GpuInstance", Name: "GetCreatableVgpus", Symbol: "nvmlGpuInstanceGetCreatableVgpus"},
+	{Interface: "GpuInstance", Name: "GetInfo", Symbol: "nvmlGpuInstanceGetInfo"},
+	{Interface: "GpuInstance", Name: "GetVgpuHeterogeneousMode", Symbol: "nvmlGpuInstanceGetVgpuHeterogeneousMode"},
+	{Interface: "GpuInstance", Name: "GetVgpuSchedulerLog", Symbol: "nvmlGpuInstanceGetVgpuSchedulerLog"},
+	{Interface: "GpuInstance", Name: "GetVgpuSchedulerState", Symbol: "nvmlGpuInstanceGetVgpuSchedulerState"},
+	{Interface: "GpuInstance", Name: "GetVgpuTypeCreatablePlacements", Symbol: "nvmlGpuInstanceGetVgpuTypeCreatablePlacements"},
+	{Interface: "GpuInstance", Name: "SetVgpuHeterogeneousMode", Symbol: "nvmlGpuInstanceSetVgpuHeterogeneousMode", Mutates: true},
+	{Interface: "GpuInstance", Name: "SetVgpuSchedulerState", Symbol: "nvmlGpuInstanceSetVgpuSchedulerState", Mutates: true},
+	{Interface: "ComputeInstance", Name: "Destroy", Symbol: "nvmlComputeInstanceDestroy", Mutates: true},
+	{Interface: "ComputeInstance", Name: "GetInfo", Symbol: "nvmlComputeInstanceGetInfo"},
+	{Interface: "EventSet", Name: "Free", Symbol: "nvmlEventSetFree"},
+	{Interface: "EventSet", Name: "Wait", Symbol: "nvmlEventSetWait"},
+	{Interface: "GpmSample", Name: "Free", Symbol: "nvmlGpmSampleFree"},
+	{Interface: "GpmSample", Name: "Get", Symbol: "nvmlGpmSampleGet", Handles: FullGpuHandle},
+	{Interface: "GpmSample", Name: "MigGet", Symbol: "nvmlGpmMigSampleGet", Handles: FullGpuHandle},
+	{Interface: "Unit", Name: "GetDevices", Symbol: "nvmlUnitGetDevices"},
+	{Interface: "Unit", Name: "GetFanSpeedInfo", Symbol: "nvmlUnitGetFanSpeedInfo"},
+	{Interface: "Unit", Name: "GetLedState", Symbol: "nvmlUnitGetLedState"},
+	{Interface: "Unit", Name: "GetPsuInfo", Symbol: "nvmlUnitGetPsuInfo"},
+	{Interface: "Unit", Name: "GetTemperature", Symbol: "nvmlUnitGetTemperature"},
+	{Interface: "Unit", Name: "GetUnitInfo", Symbol: "nvmlUnitGetUnitInfo"},
+	{Interface: "Unit", Name: "SetLedState", Symbol: "nvmlUnitSetLedState", Mutates: true, RequiresRoot: true},
+	{Interface: "VgpuInstance", Name: "ClearAccountingPids", Symbol: "nvmlVgpuInstanceClearAccountingPids", Mutates: true, RequiresRoot: true},
+	{Interface: "VgpuInstance", Name: "GetAccountingMode", Symbol: "nvmlVgpuInstanceGetAccountingMode"},
+	{Interface: "VgpuInstance", Name: "GetAccountingPids", Symbol: "nvmlVgpuInstanceGetAccountingPids"},
+	{Interface: "VgpuInstance", Name: "GetAccountingStats", Symbol: "nvmlVgpuInstanceGetAccountingStats"},
+	{Interface: "VgpuInstance", Name: "GetEccMode", Symbol: "nvmlVgpuInstanceGetEccMode"},
+	{Interface: "VgpuInstance", Name: "GetEncoderCapacity", Symbol: "nvmlVgpuInstanceGetEncoderCapacity"},
+	{Interface: "VgpuInstance", Name: "GetEncoderSessions", Symbol: "nvmlVgpuInstanceGetEncoderSessions"},
+	{Interface: "VgpuInstance", Name: "GetEncoderStats", Symbol: "nvmlVgpuInstanceGetEncoderStats"},
+	{Interface: "VgpuInstance", Name: "GetFBCSessions", Symbol: "nvmlVgpuInstanceGetFBCSessions"},
+	{Interface: "VgpuInstance", Name: "GetFBCStats", Symbol: "nvmlVgpuInstanceGetFBCStats"},
+	{Interface: "VgpuInstance", Name: "GetFbUsage", Symbol: "nvmlVgpuInstanceGetFbUsage"},
+	{Interface: "VgpuInstance", Name: "GetFrameRateLimit", Symbol: "nvmlVgpuInstanceGetFrameRateLimit"},
+	{Interface: "VgpuInstance", Name: "GetGpuInstanceId", Symbol: "nvmlVgpuInstanceGetGpuInstanceId"},
+	{Interface: "VgpuInstance", Name: "GetGpuPciId", Symbol: "nvmlVgpuInstanceGetGpuPciId"},
+	{Interface: "VgpuInstance", Name: "GetLicenseInfo", Symbol: "nvmlVgpuInstanceGetLicenseInfo"},
+	{Interface: "VgpuInstance", Name: "GetLicenseStatus", Symbol: "nvmlVgpuInstanceGetLicenseStatus"},
+	{Interface: "VgpuInstance", Name: "GetMdevUUID", Symbol: "nvmlVgpuInstanceGetMdevUUID"},
+	{Interface: "VgpuInstance", Name: "GetMetadata", Symbol: "nvmlVgpuInstanceGetMetadata"},
+	{Interface: "VgpuInstance", Name: "GetPlacementId", Symbol: "nvmlVgpuInstanceGetPlacementId"},
+	{Interface: "VgpuInstance", Name: "GetRuntimeStateSize", Symbol: "nvmlVgpuInstanceGetRuntimeStateSize"},
+	{Interface: "VgpuInstance", Name: "GetType", Symbol: "nvmlVgpuInstanceGetType"},
+	{Interface: "VgpuInstance", Name: "GetUUID", Symbol: "nvmlVgpuInstanceGetUUID"},
+	{Interface: "VgpuInstance", Name: "GetVmDriverVersion", Symbol: "nvmlVgpuInstanceGetVmDriverVersion"},
+	{Interface: "VgpuInstance", Name: "GetVmID", Symbol: "nvmlVgpuInstanceGetVmID"},
+	{Interface: "VgpuInstance", Name: "SetEncoderCapacity", Symbol: "nvmlVgpuInstanceSetEncoderCapacity", Mutates: true},
+	{Interface: "VgpuTypeId", Name: "GetBAR1Info", Symbol: "nvmlVgpuTypeGetBAR1Info"},
+	{Interface: "VgpuTypeId", Name: "GetCapabilities", Symbol: "nvmlVgpuTypeGetCapabilities"},
+	{Interface: "VgpuTypeId", Name: "GetClass", Symbol: "nvmlVgpuTypeGetClass"},
+	{Interface: "VgpuTypeId", Name: "GetCreatablePlacements", Symbol: "nvmlDeviceGetVgpuTypeCreatablePlacements", Handles: FullGpuHandle},
+	{Interface: "VgpuTypeId", Name: "GetDeviceID", Symbol: "nvmlVgpuTypeGetDeviceID"},
+	{Interface: "VgpuTypeId", Name: "GetFbReservation", Symbol: "nvmlVgpuTypeGetFbReservation"},
+	{Interface: "VgpuTypeId", Name: "GetFrameRateLimit", Symbol: "nvmlVgpuTypeGetFrameRateLimit"},
+	{Interface: "VgpuTypeId", Name: "GetFramebufferSize", Symbol: "nvmlVgpuTypeGetFramebufferSize"},
+	{Interface: "VgpuTypeId", Name: "GetGpuInstanceProfileId", Symbol: "nvmlVgpuTypeGetGpuInstanceProfileId"},
+	{Interface: "VgpuTypeId", Name: "GetGspHeapSize", Symbol: "nvmlVgpuTypeGetGspHeapSize"},
+	{Interface: "VgpuTypeId", Name: "GetLicense", Symbol: "nvmlVgpuTypeGetLicense"},
+	{Interface: "VgpuTypeId", Name: "GetMaxInstances", Symbol: "nvmlVgpuTypeGetMaxInstances", Handles: FullGpuHandle},
+	{Interface: "VgpuTypeId", Name: "GetMaxInstancesPerVm", Symbol: "nvmlVgpuTypeGetMaxInstancesPerVm"},
+	{Interface: "VgpuTypeId", Name: "GetName", Symbol: "nvmlVgpuTypeGetName"},
+	{Interface: "VgpuTypeId", Name: "GetNumDisplayHeads", Symbol: "nvmlVgpuTypeGetNumDisplayHeads"},
+	{Interface: "VgpuTypeId", Name: "GetResolution", Symbol: "nvmlVgpuTypeGetResolution"},
+	{Interface: "VgpuTypeId", Name: "GetSupportedPlacements", Symbol: "nvmlDeviceGetVgpuTypeSupportedPlacements", Handles: FullGpuHandle},
+}
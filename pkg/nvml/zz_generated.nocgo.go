@@ -0,0 +1,2016 @@
+//go:build !cgo
+
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// The types below are placeholders for the C types defined by cgo.
+type (
+	_Ctype_struct___19                   struct{}
+	_Ctype_struct___23                   struct{}
+	_Ctype_struct___28                   struct{}
+	_Ctype_struct_nvmlComputeInstance_st struct{}
+	_Ctype_struct_nvmlDevice_st          struct{}
+	_Ctype_struct_nvmlEventSet_st        struct{}
+	_Ctype_struct_nvmlGpmSample_st       struct{}
+	_Ctype_struct_nvmlGpuInstance_st     struct{}
+	_Ctype_struct_nvmlSystemEventSet_st  struct{}
+	_Ctype_struct_nvmlUnit_st            struct{}
+)
+
+// nvmlInit_v2 is not available without cgo.
+func nvmlInit_v2() Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlInitWithFlags is not available without cgo.
+func nvmlInitWithFlags(Flags uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlShutdown is not available without cgo.
+func nvmlShutdown() Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlErrorString is not available without cgo.
+func nvmlErrorString(Result Return) string {
+	var __v0 string
+	return __v0
+}
+
+// nvmlSystemGetDriverVersion is not available without cgo.
+func nvmlSystemGetDriverVersion(Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetNVMLVersion is not available without cgo.
+func nvmlSystemGetNVMLVersion(Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetCudaDriverVersion is not available without cgo.
+func nvmlSystemGetCudaDriverVersion(CudaDriverVersion *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetCudaDriverVersion_v2 is not available without cgo.
+func nvmlSystemGetCudaDriverVersion_v2(CudaDriverVersion *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetProcessName is not available without cgo.
+func nvmlSystemGetProcessName(Pid uint32, Name *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetHicVersion is not available without cgo.
+func nvmlSystemGetHicVersion(HwbcCount *uint32, HwbcEntries *HwbcEntry) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetTopologyGpuSet is not available without cgo.
+func nvmlSystemGetTopologyGpuSet(CpuNumber uint32, Count *uint32, DeviceArray *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetDriverBranch is not available without cgo.
+func nvmlSystemGetDriverBranch(BranchInfo *SystemDriverBranchInfo, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetCount is not available without cgo.
+func nvmlUnitGetCount(UnitCount *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetHandleByIndex is not available without cgo.
+func nvmlUnitGetHandleByIndex(Index uint32, nvmlUnit *nvmlUnit) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetUnitInfo is not available without cgo.
+func nvmlUnitGetUnitInfo(nvmlUnit nvmlUnit, Info *UnitInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetLedState is not available without cgo.
+func nvmlUnitGetLedState(nvmlUnit nvmlUnit, State *LedState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetPsuInfo is not available without cgo.
+func nvmlUnitGetPsuInfo(nvmlUnit nvmlUnit, Psu *PSUInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetTemperature is not available without cgo.
+func nvmlUnitGetTemperature(nvmlUnit nvmlUnit, _type uint32, Temp *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetFanSpeedInfo is not available without cgo.
+func nvmlUnitGetFanSpeedInfo(nvmlUnit nvmlUnit, FanSpeeds *UnitFanSpeeds) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitGetDevices is not available without cgo.
+func nvmlUnitGetDevices(nvmlUnit nvmlUnit, DeviceCount *uint32, Devices *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCount_v2 is not available without cgo.
+func nvmlDeviceGetCount_v2(DeviceCount *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAttributes_v2 is not available without cgo.
+func nvmlDeviceGetAttributes_v2(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByIndex_v2 is not available without cgo.
+func nvmlDeviceGetHandleByIndex_v2(Index uint32, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleBySerial is not available without cgo.
+func nvmlDeviceGetHandleBySerial(Serial string, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByUUID is not available without cgo.
+func nvmlDeviceGetHandleByUUID(Uuid string, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByUUIDV is not available without cgo.
+func nvmlDeviceGetHandleByUUIDV(Uuid *UUID, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByPciBusId_v2 is not available without cgo.
+func nvmlDeviceGetHandleByPciBusId_v2(PciBusId string, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetName is not available without cgo.
+func nvmlDeviceGetName(nvmlDevice nvmlDevice, Name *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBrand is not available without cgo.
+func nvmlDeviceGetBrand(nvmlDevice nvmlDevice, _type *BrandType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetIndex is not available without cgo.
+func nvmlDeviceGetIndex(nvmlDevice nvmlDevice, Index *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSerial is not available without cgo.
+func nvmlDeviceGetSerial(nvmlDevice nvmlDevice, Serial *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetModuleId is not available without cgo.
+func nvmlDeviceGetModuleId(nvmlDevice nvmlDevice, ModuleId *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetC2cModeInfoV is not available without cgo.
+func nvmlDeviceGetC2cModeInfoV(nvmlDevice nvmlDevice, C2cModeInfo *C2cModeInfo_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemoryAffinity is not available without cgo.
+func nvmlDeviceGetMemoryAffinity(nvmlDevice nvmlDevice, NodeSetSize uint32, NodeSet *uint, Scope AffinityScope) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCpuAffinityWithinScope is not available without cgo.
+func nvmlDeviceGetCpuAffinityWithinScope(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint, Scope AffinityScope) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCpuAffinity is not available without cgo.
+func nvmlDeviceGetCpuAffinity(nvmlDevice nvmlDevice, CpuSetSize uint32, CpuSet *uint) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetCpuAffinity is not available without cgo.
+func nvmlDeviceSetCpuAffinity(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceClearCpuAffinity is not available without cgo.
+func nvmlDeviceClearCpuAffinity(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNumaNodeId is not available without cgo.
+func nvmlDeviceGetNumaNodeId(nvmlDevice nvmlDevice, Node *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAddressingMode is not available without cgo.
+func nvmlDeviceGetAddressingMode(nvmlDevice nvmlDevice, Mode *DeviceAddressingMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRepairStatus is not available without cgo.
+func nvmlDeviceGetRepairStatus(nvmlDevice nvmlDevice, RepairStatus *RepairStatus) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTopologyCommonAncestor is not available without cgo.
+func nvmlDeviceGetTopologyCommonAncestor(Device1 nvmlDevice, Device2 nvmlDevice, PathInfo *GpuTopologyLevel) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTopologyNearestGpus is not available without cgo.
+func nvmlDeviceGetTopologyNearestGpus(nvmlDevice nvmlDevice, Level GpuTopologyLevel, Count *uint32, DeviceArray *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetP2PStatus is not available without cgo.
+func nvmlDeviceGetP2PStatus(Device1 nvmlDevice, Device2 nvmlDevice, P2pIndex GpuP2PCapsIndex, P2pStatus *GpuP2PStatus) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetUUID is not available without cgo.
+func nvmlDeviceGetUUID(nvmlDevice nvmlDevice, Uuid *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMinorNumber is not available without cgo.
+func nvmlDeviceGetMinorNumber(nvmlDevice nvmlDevice, MinorNumber *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBoardPartNumber is not available without cgo.
+func nvmlDeviceGetBoardPartNumber(nvmlDevice nvmlDevice, PartNumber *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetInforomVersion is not available without cgo.
+func nvmlDeviceGetInforomVersion(nvmlDevice nvmlDevice, Object InforomObject, Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetInforomImageVersion is not available without cgo.
+func nvmlDeviceGetInforomImageVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetInforomConfigurationChecksum is not available without cgo.
+func nvmlDeviceGetInforomConfigurationChecksum(nvmlDevice nvmlDevice, Checksum *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceValidateInforom is not available without cgo.
+func nvmlDeviceValidateInforom(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetLastBBXFlushTime is not available without cgo.
+func nvmlDeviceGetLastBBXFlushTime(nvmlDevice nvmlDevice, Timestamp *uint64, DurationUs *uint) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDisplayMode is not available without cgo.
+func nvmlDeviceGetDisplayMode(nvmlDevice nvmlDevice, Display *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDisplayActive is not available without cgo.
+func nvmlDeviceGetDisplayActive(nvmlDevice nvmlDevice, IsActive *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPersistenceMode is not available without cgo.
+func nvmlDeviceGetPersistenceMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPciInfoExt is not available without cgo.
+func nvmlDeviceGetPciInfoExt(nvmlDevice nvmlDevice, Pci *PciInfoExt) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPciInfo_v3 is not available without cgo.
+func nvmlDeviceGetPciInfo_v3(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMaxPcieLinkGeneration is not available without cgo.
+func nvmlDeviceGetMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGen *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuMaxPcieLinkGeneration is not available without cgo.
+func nvmlDeviceGetGpuMaxPcieLinkGeneration(nvmlDevice nvmlDevice, MaxLinkGenDevice *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMaxPcieLinkWidth is not available without cgo.
+func nvmlDeviceGetMaxPcieLinkWidth(nvmlDevice nvmlDevice, MaxLinkWidth *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCurrPcieLinkGeneration is not available without cgo.
+func nvmlDeviceGetCurrPcieLinkGeneration(nvmlDevice nvmlDevice, CurrLinkGen *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCurrPcieLinkWidth is not available without cgo.
+func nvmlDeviceGetCurrPcieLinkWidth(nvmlDevice nvmlDevice, CurrLinkWidth *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPcieThroughput is not available without cgo.
+func nvmlDeviceGetPcieThroughput(nvmlDevice nvmlDevice, Counter PcieUtilCounter, Value *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPcieReplayCounter is not available without cgo.
+func nvmlDeviceGetPcieReplayCounter(nvmlDevice nvmlDevice, Value *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetClockInfo is not available without cgo.
+func nvmlDeviceGetClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMaxClockInfo is not available without cgo.
+func nvmlDeviceGetMaxClockInfo(nvmlDevice nvmlDevice, _type ClockType, Clock *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpcClkVfOffset is not available without cgo.
+func nvmlDeviceGetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetApplicationsClock is not available without cgo.
+func nvmlDeviceGetApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDefaultApplicationsClock is not available without cgo.
+func nvmlDeviceGetDefaultApplicationsClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetClock is not available without cgo.
+func nvmlDeviceGetClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockId ClockId, ClockMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMaxCustomerBoostClock is not available without cgo.
+func nvmlDeviceGetMaxCustomerBoostClock(nvmlDevice nvmlDevice, ClockType ClockType, ClockMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedMemoryClocks is not available without cgo.
+func nvmlDeviceGetSupportedMemoryClocks(nvmlDevice nvmlDevice, Count *uint32, ClocksMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedGraphicsClocks is not available without cgo.
+func nvmlDeviceGetSupportedGraphicsClocks(nvmlDevice nvmlDevice, MemoryClockMHz uint32, Count *uint32, ClocksMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAutoBoostedClocksEnabled is not available without cgo.
+func nvmlDeviceGetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, IsEnabled *EnableState, DefaultIsEnabled *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFanSpeed is not available without cgo.
+func nvmlDeviceGetFanSpeed(nvmlDevice nvmlDevice, Speed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFanSpeed_v2 is not available without cgo.
+func nvmlDeviceGetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFanSpeedRPM is not available without cgo.
+func nvmlDeviceGetFanSpeedRPM(nvmlDevice nvmlDevice, FanSpeed *FanSpeedInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTargetFanSpeed is not available without cgo.
+func nvmlDeviceGetTargetFanSpeed(nvmlDevice nvmlDevice, Fan uint32, TargetSpeed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMinMaxFanSpeed is not available without cgo.
+func nvmlDeviceGetMinMaxFanSpeed(nvmlDevice nvmlDevice, MinSpeed *uint32, MaxSpeed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFanControlPolicy_v2 is not available without cgo.
+func nvmlDeviceGetFanControlPolicy_v2(nvmlDevice nvmlDevice, Fan uint32, Policy *FanControlPolicy) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNumFans is not available without cgo.
+func nvmlDeviceGetNumFans(nvmlDevice nvmlDevice, NumFans *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTemperature is not available without cgo.
+func nvmlDeviceGetTemperature(nvmlDevice nvmlDevice, SensorType TemperatureSensors, Temp *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCoolerInfo is not available without cgo.
+func nvmlDeviceGetCoolerInfo(nvmlDevice nvmlDevice, CoolerInfo *CoolerInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTemperatureV is not available without cgo.
+func nvmlDeviceGetTemperatureV(nvmlDevice nvmlDevice, Temperature *Temperature) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTemperatureThreshold is not available without cgo.
+func nvmlDeviceGetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMarginTemperature is not available without cgo.
+func nvmlDeviceGetMarginTemperature(nvmlDevice nvmlDevice, MarginTempInfo *MarginTemperature) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetThermalSettings is not available without cgo.
+func nvmlDeviceGetThermalSettings(nvmlDevice nvmlDevice, SensorIndex uint32, PThermalSettings *GpuThermalSettings) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPerformanceState is not available without cgo.
+func nvmlDeviceGetPerformanceState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCurrentClocksEventReasons is not available without cgo.
+func nvmlDeviceGetCurrentClocksEventReasons(nvmlDevice nvmlDevice, ClocksEventReasons *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCurrentClocksThrottleReasons is not available without cgo.
+func nvmlDeviceGetCurrentClocksThrottleReasons(nvmlDevice nvmlDevice, ClocksThrottleReasons *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedClocksEventReasons is not available without cgo.
+func nvmlDeviceGetSupportedClocksEventReasons(nvmlDevice nvmlDevice, SupportedClocksEventReasons *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedClocksThrottleReasons is not available without cgo.
+func nvmlDeviceGetSupportedClocksThrottleReasons(nvmlDevice nvmlDevice, SupportedClocksThrottleReasons *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerState is not available without cgo.
+func nvmlDeviceGetPowerState(nvmlDevice nvmlDevice, PState *Pstates) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDynamicPstatesInfo is not available without cgo.
+func nvmlDeviceGetDynamicPstatesInfo(nvmlDevice nvmlDevice, PDynamicPstatesInfo *GpuDynamicPstatesInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemClkVfOffset is not available without cgo.
+func nvmlDeviceGetMemClkVfOffset(nvmlDevice nvmlDevice, Offset *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMinMaxClockOfPState is not available without cgo.
+func nvmlDeviceGetMinMaxClockOfPState(nvmlDevice nvmlDevice, _type ClockType, Pstate Pstates, MinClockMHz *uint32, MaxClockMHz *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedPerformanceStates is not available without cgo.
+func nvmlDeviceGetSupportedPerformanceStates(nvmlDevice nvmlDevice, Pstates *Pstates, Size uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpcClkMinMaxVfOffset is not available without cgo.
+func nvmlDeviceGetGpcClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemClkMinMaxVfOffset is not available without cgo.
+func nvmlDeviceGetMemClkMinMaxVfOffset(nvmlDevice nvmlDevice, MinOffset *int32, MaxOffset *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetClockOffsets is not available without cgo.
+func nvmlDeviceGetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetClockOffsets is not available without cgo.
+func nvmlDeviceSetClockOffsets(nvmlDevice nvmlDevice, Info *ClockOffset) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPerformanceModes is not available without cgo.
+func nvmlDeviceGetPerformanceModes(nvmlDevice nvmlDevice, PerfModes *DevicePerfModes) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCurrentClockFreqs is not available without cgo.
+func nvmlDeviceGetCurrentClockFreqs(nvmlDevice nvmlDevice, CurrentClockFreqs *DeviceCurrentClockFreqs) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerManagementMode is not available without cgo.
+func nvmlDeviceGetPowerManagementMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerManagementLimit is not available without cgo.
+func nvmlDeviceGetPowerManagementLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerManagementLimitConstraints is not available without cgo.
+func nvmlDeviceGetPowerManagementLimitConstraints(nvmlDevice nvmlDevice, MinLimit *uint32, MaxLimit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerManagementDefaultLimit is not available without cgo.
+func nvmlDeviceGetPowerManagementDefaultLimit(nvmlDevice nvmlDevice, DefaultLimit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerUsage is not available without cgo.
+func nvmlDeviceGetPowerUsage(nvmlDevice nvmlDevice, Power *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerMizerMode_v1 is not available without cgo.
+func nvmlDeviceGetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetPowerMizerMode_v1 is not available without cgo.
+func nvmlDeviceSetPowerMizerMode_v1(nvmlDevice nvmlDevice, PowerMizerMode *DevicePowerMizerModes_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTotalEnergyConsumption is not available without cgo.
+func nvmlDeviceGetTotalEnergyConsumption(nvmlDevice nvmlDevice, Energy *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEnforcedPowerLimit is not available without cgo.
+func nvmlDeviceGetEnforcedPowerLimit(nvmlDevice nvmlDevice, Limit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuOperationMode is not available without cgo.
+func nvmlDeviceGetGpuOperationMode(nvmlDevice nvmlDevice, Current *GpuOperationMode, Pending *GpuOperationMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemoryInfo is not available without cgo.
+func nvmlDeviceGetMemoryInfo(nvmlDevice nvmlDevice, Memory *Memory) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemoryInfo_v2 is not available without cgo.
+func nvmlDeviceGetMemoryInfo_v2(nvmlDevice nvmlDevice, Memory *Memory_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetComputeMode is not available without cgo.
+func nvmlDeviceGetComputeMode(nvmlDevice nvmlDevice, Mode *ComputeMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCudaComputeCapability is not available without cgo.
+func nvmlDeviceGetCudaComputeCapability(nvmlDevice nvmlDevice, Major *int32, Minor *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDramEncryptionMode is not available without cgo.
+func nvmlDeviceGetDramEncryptionMode(nvmlDevice nvmlDevice, Current *DramEncryptionInfo, Pending *DramEncryptionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetDramEncryptionMode is not available without cgo.
+func nvmlDeviceSetDramEncryptionMode(nvmlDevice nvmlDevice, DramEncryption *DramEncryptionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEccMode is not available without cgo.
+func nvmlDeviceGetEccMode(nvmlDevice nvmlDevice, Current *EnableState, Pending *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDefaultEccMode is not available without cgo.
+func nvmlDeviceGetDefaultEccMode(nvmlDevice nvmlDevice, DefaultMode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBoardId is not available without cgo.
+func nvmlDeviceGetBoardId(nvmlDevice nvmlDevice, BoardId *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMultiGpuBoard is not available without cgo.
+func nvmlDeviceGetMultiGpuBoard(nvmlDevice nvmlDevice, MultiGpuBool *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetTotalEccErrors is not available without cgo.
+func nvmlDeviceGetTotalEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDetailedEccErrors is not available without cgo.
+func nvmlDeviceGetDetailedEccErrors(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, EccCounts *EccErrorCounts) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemoryErrorCounter is not available without cgo.
+func nvmlDeviceGetMemoryErrorCounter(nvmlDevice nvmlDevice, ErrorType MemoryErrorType, CounterType EccCounterType, LocationType MemoryLocation, Count *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetUtilizationRates is not available without cgo.
+func nvmlDeviceGetUtilizationRates(nvmlDevice nvmlDevice, Utilization *Utilization) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEncoderUtilization is not available without cgo.
+func nvmlDeviceGetEncoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEncoderCapacity is not available without cgo.
+func nvmlDeviceGetEncoderCapacity(nvmlDevice nvmlDevice, EncoderQueryType EncoderType, EncoderCapacity *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEncoderStats is not available without cgo.
+func nvmlDeviceGetEncoderStats(nvmlDevice nvmlDevice, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetEncoderSessions is not available without cgo.
+func nvmlDeviceGetEncoderSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfos *EncoderSessionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDecoderUtilization is not available without cgo.
+func nvmlDeviceGetDecoderUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetJpgUtilization is not available without cgo.
+func nvmlDeviceGetJpgUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetOfaUtilization is not available without cgo.
+func nvmlDeviceGetOfaUtilization(nvmlDevice nvmlDevice, Utilization *uint32, SamplingPeriodUs *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFBCStats is not available without cgo.
+func nvmlDeviceGetFBCStats(nvmlDevice nvmlDevice, FbcStats *FBCStats) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFBCSessions is not available without cgo.
+func nvmlDeviceGetFBCSessions(nvmlDevice nvmlDevice, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDriverModel_v2 is not available without cgo.
+func nvmlDeviceGetDriverModel_v2(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVbiosVersion is not available without cgo.
+func nvmlDeviceGetVbiosVersion(nvmlDevice nvmlDevice, Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBridgeChipInfo is not available without cgo.
+func nvmlDeviceGetBridgeChipInfo(nvmlDevice nvmlDevice, BridgeHierarchy *BridgeChipHierarchy) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v3 is not available without cgo.
+func nvmlDeviceGetComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v3 is not available without cgo.
+func nvmlDeviceGetGraphicsRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v3 is not available without cgo.
+func nvmlDeviceGetMPSComputeRunningProcesses_v3(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRunningProcessDetailList is not available without cgo.
+func nvmlDeviceGetRunningProcessDetailList(nvmlDevice nvmlDevice, Plist *ProcessDetailList) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceOnSameBoard is not available without cgo.
+func nvmlDeviceOnSameBoard(Device1 nvmlDevice, Device2 nvmlDevice, OnSameBoard *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAPIRestriction is not available without cgo.
+func nvmlDeviceGetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSamples is not available without cgo.
+func nvmlDeviceGetSamples(nvmlDevice nvmlDevice, _type SamplingType, LastSeenTimeStamp uint64, SampleValType *ValueType, SampleCount *uint32, Samples *Sample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBAR1MemoryInfo is not available without cgo.
+func nvmlDeviceGetBAR1MemoryInfo(nvmlDevice nvmlDevice, Bar1Memory *BAR1Memory) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetViolationStatus is not available without cgo.
+func nvmlDeviceGetViolationStatus(nvmlDevice nvmlDevice, PerfPolicyType PerfPolicyType, ViolTime *ViolationTime) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetIrqNum is not available without cgo.
+func nvmlDeviceGetIrqNum(nvmlDevice nvmlDevice, IrqNum *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNumGpuCores is not available without cgo.
+func nvmlDeviceGetNumGpuCores(nvmlDevice nvmlDevice, NumCores *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPowerSource is not available without cgo.
+func nvmlDeviceGetPowerSource(nvmlDevice nvmlDevice, PowerSource *PowerSource) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMemoryBusWidth is not available without cgo.
+func nvmlDeviceGetMemoryBusWidth(nvmlDevice nvmlDevice, BusWidth *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPcieLinkMaxSpeed is not available without cgo.
+func nvmlDeviceGetPcieLinkMaxSpeed(nvmlDevice nvmlDevice, MaxSpeed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPcieSpeed is not available without cgo.
+func nvmlDeviceGetPcieSpeed(nvmlDevice nvmlDevice, PcieSpeed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAdaptiveClockInfoStatus is not available without cgo.
+func nvmlDeviceGetAdaptiveClockInfoStatus(nvmlDevice nvmlDevice, AdaptiveClockStatus *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetBusType is not available without cgo.
+func nvmlDeviceGetBusType(nvmlDevice nvmlDevice, _type *BusType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuFabricInfo is not available without cgo.
+func nvmlDeviceGetGpuFabricInfo(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuFabricInfoV is not available without cgo.
+func nvmlDeviceGetGpuFabricInfoV(nvmlDevice nvmlDevice, GpuFabricInfo *GpuFabricInfoV) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetConfComputeCapabilities is not available without cgo.
+func nvmlSystemGetConfComputeCapabilities(Capabilities *ConfComputeSystemCaps) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetConfComputeState is not available without cgo.
+func nvmlSystemGetConfComputeState(State *ConfComputeSystemState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetConfComputeMemSizeInfo is not available without cgo.
+func nvmlDeviceGetConfComputeMemSizeInfo(nvmlDevice nvmlDevice, MemInfo *ConfComputeMemSizeInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetConfComputeGpusReadyState is not available without cgo.
+func nvmlSystemGetConfComputeGpusReadyState(IsAcceptingWork *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetConfComputeProtectedMemoryUsage is not available without cgo.
+func nvmlDeviceGetConfComputeProtectedMemoryUsage(nvmlDevice nvmlDevice, Memory *Memory) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetConfComputeGpuCertificate is not available without cgo.
+func nvmlDeviceGetConfComputeGpuCertificate(nvmlDevice nvmlDevice, GpuCert *ConfComputeGpuCertificate) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetConfComputeGpuAttestationReport is not available without cgo.
+func nvmlDeviceGetConfComputeGpuAttestationReport(nvmlDevice nvmlDevice, GpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetConfComputeKeyRotationThresholdInfo is not available without cgo.
+func nvmlSystemGetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeGetKeyRotationThresholdInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetConfComputeUnprotectedMemSize is not available without cgo.
+func nvmlDeviceSetConfComputeUnprotectedMemSize(nvmlDevice nvmlDevice, SizeKiB uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemSetConfComputeGpusReadyState is not available without cgo.
+func nvmlSystemSetConfComputeGpusReadyState(IsAcceptingWork uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemSetConfComputeKeyRotationThresholdInfo is not available without cgo.
+func nvmlSystemSetConfComputeKeyRotationThresholdInfo(PKeyRotationThrInfo *ConfComputeSetKeyRotationThresholdInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetConfComputeSettings is not available without cgo.
+func nvmlSystemGetConfComputeSettings(Settings *SystemConfComputeSettings) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGspFirmwareVersion is not available without cgo.
+func nvmlDeviceGetGspFirmwareVersion(nvmlDevice nvmlDevice, Version *byte) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGspFirmwareMode is not available without cgo.
+func nvmlDeviceGetGspFirmwareMode(nvmlDevice nvmlDevice, IsEnabled *uint32, DefaultMode *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSramEccErrorStatus is not available without cgo.
+func nvmlDeviceGetSramEccErrorStatus(nvmlDevice nvmlDevice, Status *EccSramErrorStatus) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetPowerManagementLimit_v2 is not available without cgo.
+func nvmlDeviceSetPowerManagementLimit_v2(nvmlDevice nvmlDevice, PowerValue *PowerValue_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAccountingMode is not available without cgo.
+func nvmlDeviceGetAccountingMode(nvmlDevice nvmlDevice, Mode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAccountingStats is not available without cgo.
+func nvmlDeviceGetAccountingStats(nvmlDevice nvmlDevice, Pid uint32, Stats *AccountingStats) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAccountingPids is not available without cgo.
+func nvmlDeviceGetAccountingPids(nvmlDevice nvmlDevice, Count *uint32, Pids *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAccountingBufferSize is not available without cgo.
+func nvmlDeviceGetAccountingBufferSize(nvmlDevice nvmlDevice, BufferSize *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRetiredPages is not available without cgo.
+func nvmlDeviceGetRetiredPages(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRetiredPages_v2 is not available without cgo.
+func nvmlDeviceGetRetiredPages_v2(nvmlDevice nvmlDevice, Cause PageRetirementCause, PageCount *uint32, Addresses *uint64, Timestamps *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRetiredPagesPendingStatus is not available without cgo.
+func nvmlDeviceGetRetiredPagesPendingStatus(nvmlDevice nvmlDevice, IsPending *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRemappedRows is not available without cgo.
+func nvmlDeviceGetRemappedRows(nvmlDevice nvmlDevice, CorrRows *uint32, UncRows *uint32, IsPending *uint32, FailureOccurred *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetRowRemapperHistogram is not available without cgo.
+func nvmlDeviceGetRowRemapperHistogram(nvmlDevice nvmlDevice, Values *RowRemapperHistogramValues) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetArchitecture is not available without cgo.
+func nvmlDeviceGetArchitecture(nvmlDevice nvmlDevice, Arch *DeviceArchitecture) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetClkMonStatus is not available without cgo.
+func nvmlDeviceGetClkMonStatus(nvmlDevice nvmlDevice, Status *ClkMonStatus) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetProcessUtilization is not available without cgo.
+func nvmlDeviceGetProcessUtilization(nvmlDevice nvmlDevice, Utilization *ProcessUtilizationSample, ProcessSamplesCount *uint32, LastSeenTimeStamp uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetProcessesUtilizationInfo is not available without cgo.
+func nvmlDeviceGetProcessesUtilizationInfo(nvmlDevice nvmlDevice, ProcesesUtilInfo *ProcessesUtilizationInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPlatformInfo is not available without cgo.
+func nvmlDeviceGetPlatformInfo(nvmlDevice nvmlDevice, PlatformInfo *PlatformInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPdi is not available without cgo.
+func nvmlDeviceGetPdi(nvmlDevice nvmlDevice, Pdi *Pdi) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlUnitSetLedState is not available without cgo.
+func nvmlUnitSetLedState(nvmlUnit nvmlUnit, Color LedColor) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetPersistenceMode is not available without cgo.
+func nvmlDeviceSetPersistenceMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetComputeMode is not available without cgo.
+func nvmlDeviceSetComputeMode(nvmlDevice nvmlDevice, Mode ComputeMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetEccMode is not available without cgo.
+func nvmlDeviceSetEccMode(nvmlDevice nvmlDevice, Ecc EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceClearEccErrorCounts is not available without cgo.
+func nvmlDeviceClearEccErrorCounts(nvmlDevice nvmlDevice, CounterType EccCounterType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetDriverModel is not available without cgo.
+func nvmlDeviceSetDriverModel(nvmlDevice nvmlDevice, DriverModel DriverModel, Flags uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetGpuLockedClocks is not available without cgo.
+func nvmlDeviceSetGpuLockedClocks(nvmlDevice nvmlDevice, MinGpuClockMHz uint32, MaxGpuClockMHz uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceResetGpuLockedClocks is not available without cgo.
+func nvmlDeviceResetGpuLockedClocks(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetMemoryLockedClocks is not available without cgo.
+func nvmlDeviceSetMemoryLockedClocks(nvmlDevice nvmlDevice, MinMemClockMHz uint32, MaxMemClockMHz uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceResetMemoryLockedClocks is not available without cgo.
+func nvmlDeviceResetMemoryLockedClocks(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetApplicationsClocks is not available without cgo.
+func nvmlDeviceSetApplicationsClocks(nvmlDevice nvmlDevice, MemClockMHz uint32, GraphicsClockMHz uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceResetApplicationsClocks is not available without cgo.
+func nvmlDeviceResetApplicationsClocks(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetAutoBoostedClocksEnabled is not available without cgo.
+func nvmlDeviceSetAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetDefaultAutoBoostedClocksEnabled is not available without cgo.
+func nvmlDeviceSetDefaultAutoBoostedClocksEnabled(nvmlDevice nvmlDevice, Enabled EnableState, Flags uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetDefaultFanSpeed_v2 is not available without cgo.
+func nvmlDeviceSetDefaultFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetFanControlPolicy is not available without cgo.
+func nvmlDeviceSetFanControlPolicy(nvmlDevice nvmlDevice, Fan uint32, Policy FanControlPolicy) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetTemperatureThreshold is not available without cgo.
+func nvmlDeviceSetTemperatureThreshold(nvmlDevice nvmlDevice, ThresholdType TemperatureThresholds, Temp *int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetPowerManagementLimit is not available without cgo.
+func nvmlDeviceSetPowerManagementLimit(nvmlDevice nvmlDevice, Limit uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetGpuOperationMode is not available without cgo.
+func nvmlDeviceSetGpuOperationMode(nvmlDevice nvmlDevice, Mode GpuOperationMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetAPIRestriction is not available without cgo.
+func nvmlDeviceSetAPIRestriction(nvmlDevice nvmlDevice, ApiType RestrictedAPI, IsRestricted EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetFanSpeed_v2 is not available without cgo.
+func nvmlDeviceSetFanSpeed_v2(nvmlDevice nvmlDevice, Fan uint32, Speed uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetGpcClkVfOffset is not available without cgo.
+func nvmlDeviceSetGpcClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetMemClkVfOffset is not available without cgo.
+func nvmlDeviceSetMemClkVfOffset(nvmlDevice nvmlDevice, Offset int32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetAccountingMode is not available without cgo.
+func nvmlDeviceSetAccountingMode(nvmlDevice nvmlDevice, Mode EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceClearAccountingPids is not available without cgo.
+func nvmlDeviceClearAccountingPids(nvmlDevice nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkState is not available without cgo.
+func nvmlDeviceGetNvLinkState(nvmlDevice nvmlDevice, Link uint32, IsActive *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkVersion is not available without cgo.
+func nvmlDeviceGetNvLinkVersion(nvmlDevice nvmlDevice, Link uint32, Version *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkCapability is not available without cgo.
+func nvmlDeviceGetNvLinkCapability(nvmlDevice nvmlDevice, Link uint32, Capability NvLinkCapability, CapResult *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkRemotePciInfo_v2 is not available without cgo.
+func nvmlDeviceGetNvLinkRemotePciInfo_v2(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkErrorCounter is not available without cgo.
+func nvmlDeviceGetNvLinkErrorCounter(nvmlDevice nvmlDevice, Link uint32, Counter NvLinkErrorCounter, CounterValue *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceResetNvLinkErrorCounters is not available without cgo.
+func nvmlDeviceResetNvLinkErrorCounters(nvmlDevice nvmlDevice, Link uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetNvLinkUtilizationControl is not available without cgo.
+func nvmlDeviceSetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl, Reset uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkUtilizationControl is not available without cgo.
+func nvmlDeviceGetNvLinkUtilizationControl(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Control *NvLinkUtilizationControl) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkUtilizationCounter is not available without cgo.
+func nvmlDeviceGetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Rxcounter *uint64, Txcounter *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceFreezeNvLinkUtilizationCounter is not available without cgo.
+func nvmlDeviceFreezeNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32, Freeze EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceResetNvLinkUtilizationCounter is not available without cgo.
+func nvmlDeviceResetNvLinkUtilizationCounter(nvmlDevice nvmlDevice, Link uint32, Counter uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkRemoteDeviceType is not available without cgo.
+func nvmlDeviceGetNvLinkRemoteDeviceType(nvmlDevice nvmlDevice, Link uint32, PNvLinkDeviceType *IntNvLinkDeviceType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetNvLinkDeviceLowPowerThreshold is not available without cgo.
+func nvmlDeviceSetNvLinkDeviceLowPowerThreshold(nvmlDevice nvmlDevice, Info *NvLinkPowerThres) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemSetNvlinkBwMode is not available without cgo.
+func nvmlSystemSetNvlinkBwMode(NvlinkBwMode uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemGetNvlinkBwMode is not available without cgo.
+func nvmlSystemGetNvlinkBwMode(NvlinkBwMode *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvlinkSupportedBwModes is not available without cgo.
+func nvmlDeviceGetNvlinkSupportedBwModes(nvmlDevice nvmlDevice, SupportedBwMode *NvlinkSupportedBwModes) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvlinkBwMode is not available without cgo.
+func nvmlDeviceGetNvlinkBwMode(nvmlDevice nvmlDevice, GetBwMode *NvlinkGetBwMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetNvlinkBwMode is not available without cgo.
+func nvmlDeviceSetNvlinkBwMode(nvmlDevice nvmlDevice, SetBwMode *NvlinkSetBwMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkInfo is not available without cgo.
+func nvmlDeviceGetNvLinkInfo(nvmlDevice nvmlDevice, Info *NvLinkInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlEventSetCreate is not available without cgo.
+func nvmlEventSetCreate(Set *nvmlEventSet) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceRegisterEvents is not available without cgo.
+func nvmlDeviceRegisterEvents(nvmlDevice nvmlDevice, EventTypes uint64, Set nvmlEventSet) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedEventTypes is not available without cgo.
+func nvmlDeviceGetSupportedEventTypes(nvmlDevice nvmlDevice, EventTypes *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlEventSetWait_v2 is not available without cgo.
+func nvmlEventSetWait_v2(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlEventSetFree is not available without cgo.
+func nvmlEventSetFree(Set nvmlEventSet) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemEventSetCreate is not available without cgo.
+func nvmlSystemEventSetCreate(Request *SystemEventSetCreateRequest) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemEventSetFree is not available without cgo.
+func nvmlSystemEventSetFree(Request *SystemEventSetFreeRequest) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemRegisterEvents is not available without cgo.
+func nvmlSystemRegisterEvents(Request *SystemRegisterEventRequest) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSystemEventSetWait is not available without cgo.
+func nvmlSystemEventSetWait(Request *SystemEventSetWaitRequest) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceModifyDrainState is not available without cgo.
+func nvmlDeviceModifyDrainState(PciInfo *PciInfo, NewState EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceQueryDrainState is not available without cgo.
+func nvmlDeviceQueryDrainState(PciInfo *PciInfo, CurrentState *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceRemoveGpu_v2 is not available without cgo.
+func nvmlDeviceRemoveGpu_v2(PciInfo *PciInfo, GpuState DetachGpuState, LinkState PcieLinkState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceDiscoverGpus is not available without cgo.
+func nvmlDeviceDiscoverGpus(PciInfo *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetFieldValues is not available without cgo.
+func nvmlDeviceGetFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceClearFieldValues is not available without cgo.
+func nvmlDeviceClearFieldValues(nvmlDevice nvmlDevice, ValuesCount int32, Values *FieldValue) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVirtualizationMode is not available without cgo.
+func nvmlDeviceGetVirtualizationMode(nvmlDevice nvmlDevice, PVirtualMode *GpuVirtualizationMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHostVgpuMode is not available without cgo.
+func nvmlDeviceGetHostVgpuMode(nvmlDevice nvmlDevice, PHostVgpuMode *HostVgpuMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetVirtualizationMode is not available without cgo.
+func nvmlDeviceSetVirtualizationMode(nvmlDevice nvmlDevice, VirtualMode GpuVirtualizationMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuHeterogeneousMode is not available without cgo.
+func nvmlDeviceGetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetVgpuHeterogeneousMode is not available without cgo.
+func nvmlDeviceSetVgpuHeterogeneousMode(nvmlDevice nvmlDevice, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetPlacementId is not available without cgo.
+func nvmlVgpuInstanceGetPlacementId(nvmlVgpuInstance nvmlVgpuInstance, PPlacement *VgpuPlacementId) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuTypeSupportedPlacements is not available without cgo.
+func nvmlDeviceGetVgpuTypeSupportedPlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuTypeCreatablePlacements is not available without cgo.
+func nvmlDeviceGetVgpuTypeCreatablePlacements(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, PPlacementList *VgpuPlacementList) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetGspHeapSize is not available without cgo.
+func nvmlVgpuTypeGetGspHeapSize(nvmlVgpuTypeId nvmlVgpuTypeId, GspHeapSize *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetFbReservation is not available without cgo.
+func nvmlVgpuTypeGetFbReservation(nvmlVgpuTypeId nvmlVgpuTypeId, FbReservation *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetRuntimeStateSize is not available without cgo.
+func nvmlVgpuInstanceGetRuntimeStateSize(nvmlVgpuInstance nvmlVgpuInstance, PState *VgpuRuntimeState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetVgpuCapabilities is not available without cgo.
+func nvmlDeviceSetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, State EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v4 is not available without cgo.
+func nvmlDeviceGetGridLicensableFeatures_v4(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGetVgpuDriverCapabilities is not available without cgo.
+func nvmlGetVgpuDriverCapabilities(Capability VgpuDriverCapability, CapResult *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuCapabilities is not available without cgo.
+func nvmlDeviceGetVgpuCapabilities(nvmlDevice nvmlDevice, Capability DeviceVgpuCapability, CapResult *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSupportedVgpus is not available without cgo.
+func nvmlDeviceGetSupportedVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCreatableVgpus is not available without cgo.
+func nvmlDeviceGetCreatableVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuTypeIds *nvmlVgpuTypeId) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetClass is not available without cgo.
+func nvmlVgpuTypeGetClass(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeClass *byte, Size *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetName is not available without cgo.
+func nvmlVgpuTypeGetName(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeName *byte, Size *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetGpuInstanceProfileId is not available without cgo.
+func nvmlVgpuTypeGetGpuInstanceProfileId(nvmlVgpuTypeId nvmlVgpuTypeId, GpuInstanceProfileId *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetDeviceID is not available without cgo.
+func nvmlVgpuTypeGetDeviceID(nvmlVgpuTypeId nvmlVgpuTypeId, DeviceID *uint64, SubsystemID *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetFramebufferSize is not available without cgo.
+func nvmlVgpuTypeGetFramebufferSize(nvmlVgpuTypeId nvmlVgpuTypeId, FbSize *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetNumDisplayHeads is not available without cgo.
+func nvmlVgpuTypeGetNumDisplayHeads(nvmlVgpuTypeId nvmlVgpuTypeId, NumDisplayHeads *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetResolution is not available without cgo.
+func nvmlVgpuTypeGetResolution(nvmlVgpuTypeId nvmlVgpuTypeId, DisplayIndex uint32, Xdim *uint32, Ydim *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetLicense is not available without cgo.
+func nvmlVgpuTypeGetLicense(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuTypeLicenseString *byte, Size uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetFrameRateLimit is not available without cgo.
+func nvmlVgpuTypeGetFrameRateLimit(nvmlVgpuTypeId nvmlVgpuTypeId, FrameRateLimit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetMaxInstances is not available without cgo.
+func nvmlVgpuTypeGetMaxInstances(nvmlDevice nvmlDevice, nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCount *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetMaxInstancesPerVm is not available without cgo.
+func nvmlVgpuTypeGetMaxInstancesPerVm(nvmlVgpuTypeId nvmlVgpuTypeId, VgpuInstanceCountPerVm *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetBAR1Info is not available without cgo.
+func nvmlVgpuTypeGetBAR1Info(nvmlVgpuTypeId nvmlVgpuTypeId, Bar1Info *VgpuTypeBar1Info) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetActiveVgpus is not available without cgo.
+func nvmlDeviceGetActiveVgpus(nvmlDevice nvmlDevice, VgpuCount *uint32, VgpuInstances *nvmlVgpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetVmID is not available without cgo.
+func nvmlVgpuInstanceGetVmID(nvmlVgpuInstance nvmlVgpuInstance, VmId *byte, Size uint32, VmIdType *VgpuVmIdType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetUUID is not available without cgo.
+func nvmlVgpuInstanceGetUUID(nvmlVgpuInstance nvmlVgpuInstance, Uuid *byte, Size uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetVmDriverVersion is not available without cgo.
+func nvmlVgpuInstanceGetVmDriverVersion(nvmlVgpuInstance nvmlVgpuInstance, Version *byte, Length uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetFbUsage is not available without cgo.
+func nvmlVgpuInstanceGetFbUsage(nvmlVgpuInstance nvmlVgpuInstance, FbUsage *uint64) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetLicenseStatus is not available without cgo.
+func nvmlVgpuInstanceGetLicenseStatus(nvmlVgpuInstance nvmlVgpuInstance, Licensed *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetType is not available without cgo.
+func nvmlVgpuInstanceGetType(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuTypeId *nvmlVgpuTypeId) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetFrameRateLimit is not available without cgo.
+func nvmlVgpuInstanceGetFrameRateLimit(nvmlVgpuInstance nvmlVgpuInstance, FrameRateLimit *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetEccMode is not available without cgo.
+func nvmlVgpuInstanceGetEccMode(nvmlVgpuInstance nvmlVgpuInstance, EccMode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetEncoderCapacity is not available without cgo.
+func nvmlVgpuInstanceGetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceSetEncoderCapacity is not available without cgo.
+func nvmlVgpuInstanceSetEncoderCapacity(nvmlVgpuInstance nvmlVgpuInstance, EncoderCapacity uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetEncoderStats is not available without cgo.
+func nvmlVgpuInstanceGetEncoderStats(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, AverageFps *uint32, AverageLatency *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetEncoderSessions is not available without cgo.
+func nvmlVgpuInstanceGetEncoderSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *EncoderSessionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetFBCStats is not available without cgo.
+func nvmlVgpuInstanceGetFBCStats(nvmlVgpuInstance nvmlVgpuInstance, FbcStats *FBCStats) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetFBCSessions is not available without cgo.
+func nvmlVgpuInstanceGetFBCSessions(nvmlVgpuInstance nvmlVgpuInstance, SessionCount *uint32, SessionInfo *FBCSessionInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetGpuInstanceId is not available without cgo.
+func nvmlVgpuInstanceGetGpuInstanceId(nvmlVgpuInstance nvmlVgpuInstance, GpuInstanceId *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetGpuPciId is not available without cgo.
+func nvmlVgpuInstanceGetGpuPciId(nvmlVgpuInstance nvmlVgpuInstance, VgpuPciId *byte, Length *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetCapabilities is not available without cgo.
+func nvmlVgpuTypeGetCapabilities(nvmlVgpuTypeId nvmlVgpuTypeId, Capability VgpuCapability, CapResult *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetMdevUUID is not available without cgo.
+func nvmlVgpuInstanceGetMdevUUID(nvmlVgpuInstance nvmlVgpuInstance, MdevUuid *byte, Size uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetCreatableVgpus is not available without cgo.
+func nvmlGpuInstanceGetCreatableVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpus *VgpuTypeIdInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuTypeGetMaxInstancesPerGpuInstance is not available without cgo.
+func nvmlVgpuTypeGetMaxInstancesPerGpuInstance(PMaxInstance *VgpuTypeMaxInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetActiveVgpus is not available without cgo.
+func nvmlGpuInstanceGetActiveVgpus(nvmlGpuInstance nvmlGpuInstance, PVgpuInstanceInfo *ActiveVgpuInstanceInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceSetVgpuSchedulerState is not available without cgo.
+func nvmlGpuInstanceSetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PScheduler *VgpuSchedulerState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetVgpuSchedulerState is not available without cgo.
+func nvmlGpuInstanceGetVgpuSchedulerState(nvmlGpuInstance nvmlGpuInstance, PSchedulerStateInfo *VgpuSchedulerStateInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetVgpuSchedulerLog is not available without cgo.
+func nvmlGpuInstanceGetVgpuSchedulerLog(nvmlGpuInstance nvmlGpuInstance, PSchedulerLogInfo *VgpuSchedulerLogInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetVgpuTypeCreatablePlacements is not available without cgo.
+func nvmlGpuInstanceGetVgpuTypeCreatablePlacements(nvmlGpuInstance nvmlGpuInstance, PCreatablePlacementInfo *VgpuCreatablePlacementInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetVgpuHeterogeneousMode is not available without cgo.
+func nvmlGpuInstanceGetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceSetVgpuHeterogeneousMode is not available without cgo.
+func nvmlGpuInstanceSetVgpuHeterogeneousMode(nvmlGpuInstance nvmlGpuInstance, PHeterogeneousMode *VgpuHeterogeneousMode) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetMetadata is not available without cgo.
+func nvmlVgpuInstanceGetMetadata(nvmlVgpuInstance nvmlVgpuInstance, nvmlVgpuMetadata *nvmlVgpuMetadata, BufferSize *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuMetadata is not available without cgo.
+func nvmlDeviceGetVgpuMetadata(nvmlDevice nvmlDevice, PgpuMetadata *nvmlVgpuPgpuMetadata, BufferSize *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGetVgpuCompatibility is not available without cgo.
+func nvmlGetVgpuCompatibility(nvmlVgpuMetadata *nvmlVgpuMetadata, PgpuMetadata *nvmlVgpuPgpuMetadata, CompatibilityInfo *VgpuPgpuCompatibility) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPgpuMetadataString is not available without cgo.
+func nvmlDeviceGetPgpuMetadataString(nvmlDevice nvmlDevice, PgpuMetadata *byte, BufferSize *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuSchedulerLog is not available without cgo.
+func nvmlDeviceGetVgpuSchedulerLog(nvmlDevice nvmlDevice, PSchedulerLog *VgpuSchedulerLog) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuSchedulerState is not available without cgo.
+func nvmlDeviceGetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerGetState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuSchedulerCapabilities is not available without cgo.
+func nvmlDeviceGetVgpuSchedulerCapabilities(nvmlDevice nvmlDevice, PCapabilities *VgpuSchedulerCapabilities) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetVgpuSchedulerState is not available without cgo.
+func nvmlDeviceSetVgpuSchedulerState(nvmlDevice nvmlDevice, PSchedulerState *VgpuSchedulerSetState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGetVgpuVersion is not available without cgo.
+func nvmlGetVgpuVersion(Supported *VgpuVersion, Current *VgpuVersion) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlSetVgpuVersion is not available without cgo.
+func nvmlSetVgpuVersion(VgpuVersion *VgpuVersion) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuUtilization is not available without cgo.
+func nvmlDeviceGetVgpuUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, SampleValType *ValueType, VgpuInstanceSamplesCount *uint32, UtilizationSamples *VgpuInstanceUtilizationSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuInstancesUtilizationInfo is not available without cgo.
+func nvmlDeviceGetVgpuInstancesUtilizationInfo(nvmlDevice nvmlDevice, VgpuUtilInfo *VgpuInstancesUtilizationInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuProcessUtilization is not available without cgo.
+func nvmlDeviceGetVgpuProcessUtilization(nvmlDevice nvmlDevice, LastSeenTimeStamp uint64, VgpuProcessSamplesCount *uint32, UtilizationSamples *VgpuProcessUtilizationSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetVgpuProcessesUtilizationInfo is not available without cgo.
+func nvmlDeviceGetVgpuProcessesUtilizationInfo(nvmlDevice nvmlDevice, VgpuProcUtilInfo *VgpuProcessesUtilizationInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetAccountingMode is not available without cgo.
+func nvmlVgpuInstanceGetAccountingMode(nvmlVgpuInstance nvmlVgpuInstance, Mode *EnableState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetAccountingPids is not available without cgo.
+func nvmlVgpuInstanceGetAccountingPids(nvmlVgpuInstance nvmlVgpuInstance, Count *uint32, Pids *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetAccountingStats is not available without cgo.
+func nvmlVgpuInstanceGetAccountingStats(nvmlVgpuInstance nvmlVgpuInstance, Pid uint32, Stats *AccountingStats) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceClearAccountingPids is not available without cgo.
+func nvmlVgpuInstanceClearAccountingPids(nvmlVgpuInstance nvmlVgpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetLicenseInfo_v2 is not available without cgo.
+func nvmlVgpuInstanceGetLicenseInfo_v2(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGetExcludedDeviceCount is not available without cgo.
+func nvmlGetExcludedDeviceCount(DeviceCount *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGetExcludedDeviceInfoByIndex is not available without cgo.
+func nvmlGetExcludedDeviceInfoByIndex(Index uint32, Info *ExcludedDeviceInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceReadWritePRM_v1 is not available without cgo.
+func nvmlDeviceReadWritePRM_v1(nvmlDevice nvmlDevice, Buffer *PRMTLV_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceSetMigMode is not available without cgo.
+func nvmlDeviceSetMigMode(nvmlDevice nvmlDevice, Mode uint32, ActivationStatus *Return) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMigMode is not available without cgo.
+func nvmlDeviceGetMigMode(nvmlDevice nvmlDevice, CurrentMode *uint32, PendingMode *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfo is not available without cgo.
+func nvmlDeviceGetGpuInstanceProfileInfo(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfoV is not available without cgo.
+func nvmlDeviceGetGpuInstanceProfileInfoV(nvmlDevice nvmlDevice, Profile uint32, Info *GpuInstanceProfileInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceProfileInfoByIdV is not available without cgo.
+func nvmlDeviceGetGpuInstanceProfileInfoByIdV(nvmlDevice nvmlDevice, ProfileId uint32, Info *GpuInstanceProfileInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstancePossiblePlacements_v2 is not available without cgo.
+func nvmlDeviceGetGpuInstancePossiblePlacements_v2(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceRemainingCapacity is not available without cgo.
+func nvmlDeviceGetGpuInstanceRemainingCapacity(nvmlDevice nvmlDevice, ProfileId uint32, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceCreateGpuInstance is not available without cgo.
+func nvmlDeviceCreateGpuInstance(nvmlDevice nvmlDevice, ProfileId uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceCreateGpuInstanceWithPlacement is not available without cgo.
+func nvmlDeviceCreateGpuInstanceWithPlacement(nvmlDevice nvmlDevice, ProfileId uint32, Placement *GpuInstancePlacement, nvmlGpuInstance *nvmlGpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceDestroy is not available without cgo.
+func nvmlGpuInstanceDestroy(nvmlGpuInstance nvmlGpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstances is not available without cgo.
+func nvmlDeviceGetGpuInstances(nvmlDevice nvmlDevice, ProfileId uint32, GpuInstances *nvmlGpuInstance, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceById is not available without cgo.
+func nvmlDeviceGetGpuInstanceById(nvmlDevice nvmlDevice, Id uint32, nvmlGpuInstance *nvmlGpuInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetInfo is not available without cgo.
+func nvmlGpuInstanceGetInfo(nvmlGpuInstance nvmlGpuInstance, Info *nvmlGpuInstanceInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstanceProfileInfo is not available without cgo.
+func nvmlGpuInstanceGetComputeInstanceProfileInfo(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstanceProfileInfoV is not available without cgo.
+func nvmlGpuInstanceGetComputeInstanceProfileInfoV(nvmlGpuInstance nvmlGpuInstance, Profile uint32, EngProfile uint32, Info *ComputeInstanceProfileInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstanceRemainingCapacity is not available without cgo.
+func nvmlGpuInstanceGetComputeInstanceRemainingCapacity(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstancePossiblePlacements is not available without cgo.
+func nvmlGpuInstanceGetComputeInstancePossiblePlacements(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placements *ComputeInstancePlacement, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceCreateComputeInstance is not available without cgo.
+func nvmlGpuInstanceCreateComputeInstance(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceCreateComputeInstanceWithPlacement is not available without cgo.
+func nvmlGpuInstanceCreateComputeInstanceWithPlacement(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, Placement *ComputeInstancePlacement, nvmlComputeInstance *nvmlComputeInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlComputeInstanceDestroy is not available without cgo.
+func nvmlComputeInstanceDestroy(nvmlComputeInstance nvmlComputeInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstances is not available without cgo.
+func nvmlGpuInstanceGetComputeInstances(nvmlGpuInstance nvmlGpuInstance, ProfileId uint32, ComputeInstances *nvmlComputeInstance, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpuInstanceGetComputeInstanceById is not available without cgo.
+func nvmlGpuInstanceGetComputeInstanceById(nvmlGpuInstance nvmlGpuInstance, Id uint32, nvmlComputeInstance *nvmlComputeInstance) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlComputeInstanceGetInfo_v2 is not available without cgo.
+func nvmlComputeInstanceGetInfo_v2(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceIsMigDeviceHandle is not available without cgo.
+func nvmlDeviceIsMigDeviceHandle(nvmlDevice nvmlDevice, IsMigDevice *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstanceId is not available without cgo.
+func nvmlDeviceGetGpuInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetComputeInstanceId is not available without cgo.
+func nvmlDeviceGetComputeInstanceId(nvmlDevice nvmlDevice, Id *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMaxMigDeviceCount is not available without cgo.
+func nvmlDeviceGetMaxMigDeviceCount(nvmlDevice nvmlDevice, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMigDeviceHandleByIndex is not available without cgo.
+func nvmlDeviceGetMigDeviceHandleByIndex(nvmlDevice nvmlDevice, Index uint32, MigDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDeviceHandleFromMigDeviceHandle is not available without cgo.
+func nvmlDeviceGetDeviceHandleFromMigDeviceHandle(MigDevice nvmlDevice, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmMetricsGet is not available without cgo.
+func nvmlGpmMetricsGet(MetricsGet *nvmlGpmMetricsGetType) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmSampleFree is not available without cgo.
+func nvmlGpmSampleFree(nvmlGpmSample nvmlGpmSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmSampleAlloc is not available without cgo.
+func nvmlGpmSampleAlloc(nvmlGpmSample *nvmlGpmSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmSampleGet is not available without cgo.
+func nvmlGpmSampleGet(nvmlDevice nvmlDevice, nvmlGpmSample nvmlGpmSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmMigSampleGet is not available without cgo.
+func nvmlGpmMigSampleGet(nvmlDevice nvmlDevice, GpuInstanceId uint32, nvmlGpmSample nvmlGpmSample) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmQueryDeviceSupport is not available without cgo.
+func nvmlGpmQueryDeviceSupport(nvmlDevice nvmlDevice, GpmSupport *GpmSupport) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmQueryIfStreamingEnabled is not available without cgo.
+func nvmlGpmQueryIfStreamingEnabled(nvmlDevice nvmlDevice, State *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlGpmSetStreamingEnabled is not available without cgo.
+func nvmlGpmSetStreamingEnabled(nvmlDevice nvmlDevice, State uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCapabilities is not available without cgo.
+func nvmlDeviceGetCapabilities(nvmlDevice nvmlDevice, Caps *DeviceCapabilities) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceWorkloadPowerProfileGetProfilesInfo is not available without cgo.
+func nvmlDeviceWorkloadPowerProfileGetProfilesInfo(nvmlDevice nvmlDevice, ProfilesInfo *WorkloadPowerProfileProfilesInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceWorkloadPowerProfileGetCurrentProfiles is not available without cgo.
+func nvmlDeviceWorkloadPowerProfileGetCurrentProfiles(nvmlDevice nvmlDevice, CurrentProfiles *WorkloadPowerProfileCurrentProfiles) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceWorkloadPowerProfileSetRequestedProfiles is not available without cgo.
+func nvmlDeviceWorkloadPowerProfileSetRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceWorkloadPowerProfileClearRequestedProfiles is not available without cgo.
+func nvmlDeviceWorkloadPowerProfileClearRequestedProfiles(nvmlDevice nvmlDevice, RequestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDevicePowerSmoothingActivatePresetProfile is not available without cgo.
+func nvmlDevicePowerSmoothingActivatePresetProfile(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDevicePowerSmoothingUpdatePresetProfileParam is not available without cgo.
+func nvmlDevicePowerSmoothingUpdatePresetProfileParam(nvmlDevice nvmlDevice, Profile *PowerSmoothingProfile) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDevicePowerSmoothingSetState is not available without cgo.
+func nvmlDevicePowerSmoothingSetState(nvmlDevice nvmlDevice, State *PowerSmoothingState) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts is not available without cgo.
+func nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts(nvmlDevice nvmlDevice, ErrorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlInit_v1 is not available without cgo.
+func nvmlInit_v1() Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetCount_v1 is not available without cgo.
+func nvmlDeviceGetCount_v1(DeviceCount *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByIndex_v1 is not available without cgo.
+func nvmlDeviceGetHandleByIndex_v1(Index uint32, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetHandleByPciBusId_v1 is not available without cgo.
+func nvmlDeviceGetHandleByPciBusId_v1(PciBusId string, nvmlDevice *nvmlDevice) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPciInfo_v1 is not available without cgo.
+func nvmlDeviceGetPciInfo_v1(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetPciInfo_v2 is not available without cgo.
+func nvmlDeviceGetPciInfo_v2(nvmlDevice nvmlDevice, Pci *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetNvLinkRemotePciInfo_v1 is not available without cgo.
+func nvmlDeviceGetNvLinkRemotePciInfo_v1(nvmlDevice nvmlDevice, Link uint32, Pci *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v1 is not available without cgo.
+func nvmlDeviceGetGridLicensableFeatures_v1(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v2 is not available without cgo.
+func nvmlDeviceGetGridLicensableFeatures_v2(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGridLicensableFeatures_v3 is not available without cgo.
+func nvmlDeviceGetGridLicensableFeatures_v3(nvmlDevice nvmlDevice, PGridLicensableFeatures *GridLicensableFeatures) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceRemoveGpu_v1 is not available without cgo.
+func nvmlDeviceRemoveGpu_v1(PciInfo *PciInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlEventSetWait_v1 is not available without cgo.
+func nvmlEventSetWait_v1(Set nvmlEventSet, Data *nvmlEventData, Timeoutms uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetAttributes_v1 is not available without cgo.
+func nvmlDeviceGetAttributes_v1(nvmlDevice nvmlDevice, Attributes *DeviceAttributes) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlComputeInstanceGetInfo_v1 is not available without cgo.
+func nvmlComputeInstanceGetInfo_v1(nvmlComputeInstance nvmlComputeInstance, Info *nvmlComputeInstanceInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v1 is not available without cgo.
+func nvmlDeviceGetComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetComputeRunningProcesses_v2 is not available without cgo.
+func nvmlDeviceGetComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v1 is not available without cgo.
+func nvmlDeviceGetGraphicsRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGraphicsRunningProcesses_v2 is not available without cgo.
+func nvmlDeviceGetGraphicsRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v1 is not available without cgo.
+func nvmlDeviceGetMPSComputeRunningProcesses_v1(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v1) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetMPSComputeRunningProcesses_v2 is not available without cgo.
+func nvmlDeviceGetMPSComputeRunningProcesses_v2(nvmlDevice nvmlDevice, InfoCount *uint32, Infos *ProcessInfo_v2) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetGpuInstancePossiblePlacements_v1 is not available without cgo.
+func nvmlDeviceGetGpuInstancePossiblePlacements_v1(nvmlDevice nvmlDevice, ProfileId uint32, Placements *GpuInstancePlacement, Count *uint32) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlVgpuInstanceGetLicenseInfo_v1 is not available without cgo.
+func nvmlVgpuInstanceGetLicenseInfo_v1(nvmlVgpuInstance nvmlVgpuInstance, LicenseInfo *VgpuLicenseInfo) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
+
+// nvmlDeviceGetDriverModel_v1 is not available without cgo.
+func nvmlDeviceGetDriverModel_v1(nvmlDevice nvmlDevice, Current *DriverModel, Pending *DriverModel) Return {
+	return ERROR_LIBRARY_NOT_FOUND
+}
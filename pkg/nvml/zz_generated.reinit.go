@@ -0,0 +1,6255 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+var _ Interface = (*supervisor)(nil)
+
+func (s *supervisor) ComputeInstanceDestroy(computeInstance ComputeInstance) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.ComputeInstanceDestroy(computeInstance)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) ComputeInstanceGetInfo(computeInstance ComputeInstance) (ComputeInstanceInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.ComputeInstanceGetInfo(computeInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceClearAccountingPids(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceClearAccountingPids(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceClearCpuAffinity(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceClearCpuAffinity(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceClearEccErrorCounts(device Device, counterType EccCounterType) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceClearEccErrorCounts(s.unwrapDevice(device), counterType)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceClearFieldValues(device Device, values []FieldValue) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceClearFieldValues(s.unwrapDevice(device), values)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceCreateGpuInstance(device Device, info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceCreateGpuInstance(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceCreateGpuInstanceWithPlacement(device Device, info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceCreateGpuInstanceWithPlacement(s.unwrapDevice(device), info, placement)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceDiscoverGpus() (PciInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceDiscoverGpus()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceFreezeNvLinkUtilizationCounter(device Device, link int, counter int, freeze EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceFreezeNvLinkUtilizationCounter(s.unwrapDevice(device), link, counter, freeze)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAPIRestriction(device Device, apiType RestrictedAPI) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAPIRestriction(s.unwrapDevice(device), apiType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAccountingBufferSize(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAccountingBufferSize(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAccountingMode(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAccountingMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAccountingPids(device Device) ([]int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAccountingPids(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAccountingStats(device Device, pid uint32) (AccountingStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAccountingStats(s.unwrapDevice(device), pid)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetActiveVgpus(device Device) ([]VgpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetActiveVgpus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAdaptiveClockInfoStatus(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAdaptiveClockInfoStatus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAddressingMode(device Device) (DeviceAddressingMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAddressingMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetApplicationsClock(s.unwrapDevice(device), clockType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetArchitecture(device Device) (DeviceArchitecture, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetArchitecture(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAttributes(device Device) (DeviceAttributes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetAttributes(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetAutoBoostedClocksEnabled(device Device) (EnableState, EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetAutoBoostedClocksEnabled(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBAR1MemoryInfo(device Device) (BAR1Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBAR1MemoryInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBoardId(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBoardId(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBoardPartNumber(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBoardPartNumber(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBrand(device Device) (BrandType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBrand(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBridgeChipInfo(device Device) (BridgeChipHierarchy, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBridgeChipInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetBusType(device Device) (BusType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetBusType(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetC2cModeInfoV(device Device) C2cModeInfoHandler {
+	return s.lib.DeviceGetC2cModeInfoV(s.unwrapDevice(device))
+}
+
+func (s *supervisor) DeviceGetCapabilities(device Device) (DeviceCapabilities, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCapabilities(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetClkMonStatus(device Device) (ClkMonStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetClkMonStatus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetClock(device Device, clockType ClockType, clockId ClockId) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetClock(s.unwrapDevice(device), clockType, clockId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetClockInfo(s.unwrapDevice(device), clockType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetClockOffsets(device Device) (ClockOffset, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetClockOffsets(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetComputeInstanceId(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetComputeInstanceId(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetComputeMode(device Device) (ComputeMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetComputeMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetComputeRunningProcesses(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetConfComputeGpuAttestationReport(device Device, gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceGetConfComputeGpuAttestationReport(s.unwrapDevice(device), gpuAtstReport)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetConfComputeGpuCertificate(device Device) (ConfComputeGpuCertificate, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetConfComputeGpuCertificate(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetConfComputeMemSizeInfo(device Device) (ConfComputeMemSizeInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetConfComputeMemSizeInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetConfComputeProtectedMemoryUsage(device Device) (Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetConfComputeProtectedMemoryUsage(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCoolerInfo(device Device) (CoolerInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCoolerInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCount() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCount()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCpuAffinity(s.unwrapDevice(device), numCPUs)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCpuAffinityWithinScope(device Device, numCPUs int, scope AffinityScope) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCpuAffinityWithinScope(s.unwrapDevice(device), numCPUs, scope)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCreatableVgpus(device Device) ([]VgpuTypeId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCreatableVgpus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCudaComputeCapability(device Device) (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetCudaComputeCapability(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCurrPcieLinkGeneration(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCurrPcieLinkGeneration(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCurrPcieLinkWidth(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCurrPcieLinkWidth(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCurrentClockFreqs(device Device) (DeviceCurrentClockFreqs, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCurrentClockFreqs(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCurrentClocksEventReasons(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCurrentClocksEventReasons(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetCurrentClocksThrottleReasons(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDecoderUtilization(device Device) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetDecoderUtilization(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDefaultApplicationsClock(device Device, clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDefaultApplicationsClock(s.unwrapDevice(device), clockType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDefaultEccMode(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDefaultEccMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDetailedEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDetailedEccErrors(s.unwrapDevice(device), errorType, counterType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDeviceHandleFromMigDeviceHandle(migdevice Device) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDeviceHandleFromMigDeviceHandle(s.unwrapDevice(migdevice))
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDisplayActive(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDisplayActive(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDisplayMode(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDisplayMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDramEncryptionMode(device Device) (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetDramEncryptionMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDriverModel(device Device) (DriverModel, DriverModel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetDriverModel(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDriverModel_v2(device Device) (DriverModel, DriverModel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetDriverModel_v2(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetDynamicPstatesInfo(device Device) (GpuDynamicPstatesInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetDynamicPstatesInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEccMode(device Device) (EnableState, EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetEccMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEncoderCapacity(device Device, encoderQueryType EncoderType) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetEncoderCapacity(s.unwrapDevice(device), encoderQueryType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEncoderSessions(device Device) ([]EncoderSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetEncoderSessions(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEncoderStats(device Device) (int, uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, r2, ret := s.lib.DeviceGetEncoderStats(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, r2, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEncoderUtilization(device Device) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetEncoderUtilization(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetEnforcedPowerLimit(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetEnforcedPowerLimit(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFBCSessions(device Device) ([]FBCSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFBCSessions(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFBCStats(device Device) (FBCStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFBCStats(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFanControlPolicy_v2(device Device, fan int) (FanControlPolicy, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFanControlPolicy_v2(s.unwrapDevice(device), fan)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFanSpeed(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFanSpeed(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFanSpeedRPM(device Device) (FanSpeedInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFanSpeedRPM(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFanSpeed_v2(device Device, fan int) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetFanSpeed_v2(s.unwrapDevice(device), fan)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetFieldValues(device Device, values []FieldValue) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceGetFieldValues(s.unwrapDevice(device), values)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetGpcClkMinMaxVfOffset(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpcClkVfOffset(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpcClkVfOffset(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuFabricInfo(device Device) (GpuFabricInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuFabricInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuFabricInfoV(device Device) GpuFabricInfoHandler {
+	return s.lib.DeviceGetGpuFabricInfoV(s.unwrapDevice(device))
+}
+
+func (s *supervisor) DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstanceById(s.unwrapDevice(device), id)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuInstanceId(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstanceId(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuInstancePossiblePlacements(device Device, info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstancePossiblePlacements(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuInstanceProfileInfo(device Device, profile int) (GpuInstanceProfileInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstanceProfileInfo(s.unwrapDevice(device), profile)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuInstanceProfileInfoByIdV(device Device, profileId int) GpuInstanceProfileInfoByIdHandler {
+	return s.lib.DeviceGetGpuInstanceProfileInfoByIdV(s.unwrapDevice(device), profileId)
+}
+
+func (s *supervisor) DeviceGetGpuInstanceProfileInfoV(device Device, profile int) GpuInstanceProfileInfoHandler {
+	return s.lib.DeviceGetGpuInstanceProfileInfoV(s.unwrapDevice(device), profile)
+}
+
+func (s *supervisor) DeviceGetGpuInstanceRemainingCapacity(device Device, info *GpuInstanceProfileInfo) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstanceRemainingCapacity(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuInstances(device Device, info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuInstances(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGpuMaxPcieLinkGeneration(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGpuOperationMode(device Device) (GpuOperationMode, GpuOperationMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetGpuOperationMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGraphicsRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGraphicsRunningProcesses(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGridLicensableFeatures(device Device) (GridLicensableFeatures, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGridLicensableFeatures(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGspFirmwareMode(device Device) (bool, bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetGspFirmwareMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetGspFirmwareVersion(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetGspFirmwareVersion(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHandleByIndex(index int) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHandleByIndex(index)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHandleByPciBusId(pciBusId string) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHandleByPciBusId(pciBusId)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHandleBySerial(serial string) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHandleBySerial(serial)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHandleByUUID(uuid string) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHandleByUUID(uuid)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHandleByUUIDV(uuid *UUID) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHandleByUUIDV(uuid)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetHostVgpuMode(device Device) (HostVgpuMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetHostVgpuMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetIndex(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetIndex(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetInforomConfigurationChecksum(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetInforomConfigurationChecksum(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetInforomImageVersion(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetInforomImageVersion(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetInforomVersion(device Device, object InforomObject) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetInforomVersion(s.unwrapDevice(device), object)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetIrqNum(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetIrqNum(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetJpgUtilization(device Device) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetJpgUtilization(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetLastBBXFlushTime(device Device) (uint64, uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetLastBBXFlushTime(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMPSComputeRunningProcesses(device Device) ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMPSComputeRunningProcesses(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMarginTemperature(device Device) (MarginTemperature, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMarginTemperature(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMaxClockInfo(device Device, clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMaxClockInfo(s.unwrapDevice(device), clockType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMaxCustomerBoostClock(device Device, clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMaxCustomerBoostClock(s.unwrapDevice(device), clockType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMaxMigDeviceCount(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMaxMigDeviceCount(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMaxPcieLinkGeneration(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMaxPcieLinkGeneration(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMaxPcieLinkWidth(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMaxPcieLinkWidth(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetMemClkMinMaxVfOffset(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemClkVfOffset(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemClkVfOffset(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemoryAffinity(device Device, numNodes int, scope AffinityScope) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemoryAffinity(s.unwrapDevice(device), numNodes, scope)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemoryBusWidth(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemoryBusWidth(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemoryErrorCounter(device Device, errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemoryErrorCounter(s.unwrapDevice(device), errorType, counterType, locationType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemoryInfo(device Device) (Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemoryInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMemoryInfo_v2(device Device) (Memory_v2, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMemoryInfo_v2(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMigDeviceHandleByIndex(device Device, index int) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMigDeviceHandleByIndex(s.unwrapDevice(device), index)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMigMode(device Device) (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetMigMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMinMaxClockOfPState(device Device, clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetMinMaxClockOfPState(s.unwrapDevice(device), clockType, pstate)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMinMaxFanSpeed(device Device) (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetMinMaxFanSpeed(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMinorNumber(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMinorNumber(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetModuleId(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetModuleId(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetMultiGpuBoard(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetMultiGpuBoard(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetName(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetName(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNumFans(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNumFans(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNumGpuCores(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNumGpuCores(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNumaNodeId(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNumaNodeId(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkCapability(device Device, link int, capability NvLinkCapability) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkCapability(s.unwrapDevice(device), link, capability)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkErrorCounter(device Device, link int, counter NvLinkErrorCounter) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkErrorCounter(s.unwrapDevice(device), link, counter)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkInfo(device Device) NvLinkInfoHandler {
+	return s.lib.DeviceGetNvLinkInfo(s.unwrapDevice(device))
+}
+
+func (s *supervisor) DeviceGetNvLinkRemoteDeviceType(device Device, link int) (IntNvLinkDeviceType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkRemoteDeviceType(s.unwrapDevice(device), link)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkRemotePciInfo(device Device, link int) (PciInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkRemotePciInfo(s.unwrapDevice(device), link)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkState(device Device, link int) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkState(s.unwrapDevice(device), link)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkUtilizationControl(device Device, link int, counter int) (NvLinkUtilizationControl, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkUtilizationControl(s.unwrapDevice(device), link, counter)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkUtilizationCounter(device Device, link int, counter int) (uint64, uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetNvLinkUtilizationCounter(s.unwrapDevice(device), link, counter)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvLinkVersion(device Device, link int) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvLinkVersion(s.unwrapDevice(device), link)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvlinkBwMode(device Device) (NvlinkGetBwMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvlinkBwMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetNvlinkSupportedBwModes(device Device) (NvlinkSupportedBwModes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetNvlinkSupportedBwModes(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetOfaUtilization(device Device) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetOfaUtilization(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetP2PStatus(device1 Device, device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetP2PStatus(s.unwrapDevice(device1), s.unwrapDevice(device2), p2pIndex)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPciInfo(device Device) (PciInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPciInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPciInfoExt(device Device) (PciInfoExt, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPciInfoExt(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPcieLinkMaxSpeed(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPcieLinkMaxSpeed(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPcieReplayCounter(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPcieReplayCounter(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPcieSpeed(device Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPcieSpeed(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPcieThroughput(device Device, counter PcieUtilCounter) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPcieThroughput(s.unwrapDevice(device), counter)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPdi(device Device) (Pdi, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPdi(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPerformanceModes(device Device) (DevicePerfModes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPerformanceModes(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPerformanceState(device Device) (Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPerformanceState(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPersistenceMode(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPersistenceMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPgpuMetadataString(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPgpuMetadataString(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPlatformInfo(device Device) (PlatformInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPlatformInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerManagementDefaultLimit(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerManagementDefaultLimit(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerManagementLimit(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerManagementLimit(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerManagementLimitConstraints(device Device) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetPowerManagementLimitConstraints(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerManagementMode(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerManagementMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerMizerMode_v1(device Device) (DevicePowerMizerModes_v1, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerMizerMode_v1(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerSource(device Device) (PowerSource, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerSource(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerState(device Device) (Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerState(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetPowerUsage(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetPowerUsage(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetProcessUtilization(device Device, lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetProcessUtilization(s.unwrapDevice(device), lastSeenTimestamp)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetProcessesUtilizationInfo(device Device) (ProcessesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetProcessesUtilizationInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRemappedRows(device Device) (int, int, bool, bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, r2, r3, ret := s.lib.DeviceGetRemappedRows(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, r2, r3, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRepairStatus(device Device) (RepairStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetRepairStatus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRetiredPages(device Device, cause PageRetirementCause) ([]uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetRetiredPages(s.unwrapDevice(device), cause)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRetiredPagesPendingStatus(device Device) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetRetiredPagesPendingStatus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRetiredPages_v2(device Device, cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetRetiredPages_v2(s.unwrapDevice(device), cause)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRowRemapperHistogram(device Device) (RowRemapperHistogramValues, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetRowRemapperHistogram(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetRunningProcessDetailList(device Device) (ProcessDetailList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetRunningProcessDetailList(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSamples(device Device, samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetSamples(s.unwrapDevice(device), samplingType, lastSeenTimestamp)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSerial(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSerial(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSramEccErrorStatus(device Device) (EccSramErrorStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSramEccErrorStatus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSramUniqueUncorrectedEccErrorCounts(device Device, errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceGetSramUniqueUncorrectedEccErrorCounts(s.unwrapDevice(device), errorCounts)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedClocksEventReasons(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSupportedClocksEventReasons(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSupportedClocksThrottleReasons(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedEventTypes(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSupportedEventTypes(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetSupportedGraphicsClocks(s.unwrapDevice(device), memoryClockMHz)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedMemoryClocks(device Device) (int, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetSupportedMemoryClocks(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedPerformanceStates(device Device) ([]Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSupportedPerformanceStates(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetSupportedVgpus(device Device) ([]VgpuTypeId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetSupportedVgpus(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTargetFanSpeed(device Device, fan int) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTargetFanSpeed(s.unwrapDevice(device), fan)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTemperature(device Device, sensorType TemperatureSensors) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTemperature(s.unwrapDevice(device), sensorType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTemperatureThreshold(device Device, thresholdType TemperatureThresholds) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTemperatureThreshold(s.unwrapDevice(device), thresholdType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTemperatureV(device Device) TemperatureHandler {
+	return s.lib.DeviceGetTemperatureV(s.unwrapDevice(device))
+}
+
+func (s *supervisor) DeviceGetThermalSettings(device Device, sensorIndex uint32) (GpuThermalSettings, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetThermalSettings(s.unwrapDevice(device), sensorIndex)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTopologyCommonAncestor(device1 Device, device2 Device) (GpuTopologyLevel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTopologyCommonAncestor(s.unwrapDevice(device1), s.unwrapDevice(device2))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTopologyNearestGpus(device Device, level GpuTopologyLevel) ([]Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTopologyNearestGpus(s.unwrapDevice(device), level)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevices(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTotalEccErrors(device Device, errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTotalEccErrors(s.unwrapDevice(device), errorType, counterType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetTotalEnergyConsumption(device Device) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetTotalEnergyConsumption(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetUUID(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetUUID(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetUtilizationRates(device Device) (Utilization, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetUtilizationRates(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVbiosVersion(device Device) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVbiosVersion(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuCapabilities(device Device, capability DeviceVgpuCapability) (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuCapabilities(s.unwrapDevice(device), capability)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuHeterogeneousMode(device Device) (VgpuHeterogeneousMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuHeterogeneousMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuInstancesUtilizationInfo(device Device) (VgpuInstancesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuInstancesUtilizationInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuMetadata(device Device) (VgpuPgpuMetadata, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuMetadata(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuProcessUtilization(device Device, lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuProcessUtilization(s.unwrapDevice(device), lastSeenTimestamp)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuProcessesUtilizationInfo(device Device) (VgpuProcessesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuProcessesUtilizationInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuSchedulerCapabilities(device Device) (VgpuSchedulerCapabilities, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuSchedulerCapabilities(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuSchedulerLog(device Device) (VgpuSchedulerLog, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuSchedulerLog(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuSchedulerState(device Device) (VgpuSchedulerGetState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuSchedulerState(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuTypeCreatablePlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuTypeCreatablePlacements(s.unwrapDevice(device), vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuTypeSupportedPlacements(device Device, vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVgpuTypeSupportedPlacements(s.unwrapDevice(device), vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.DeviceGetVgpuUtilization(s.unwrapDevice(device), lastSeenTimestamp)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetViolationStatus(device Device, perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetViolationStatus(s.unwrapDevice(device), perfPolicyType)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceGetVirtualizationMode(device Device) (GpuVirtualizationMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceGetVirtualizationMode(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceIsMigDeviceHandle(device Device) (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceIsMigDeviceHandle(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceModifyDrainState(pciInfo *PciInfo, newState EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceModifyDrainState(pciInfo, newState)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceOnSameBoard(device1 Device, device2 Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceOnSameBoard(s.unwrapDevice(device1), s.unwrapDevice(device2))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DevicePowerSmoothingActivatePresetProfile(device Device, profile *PowerSmoothingProfile) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DevicePowerSmoothingActivatePresetProfile(s.unwrapDevice(device), profile)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DevicePowerSmoothingSetState(device Device, state *PowerSmoothingState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DevicePowerSmoothingSetState(s.unwrapDevice(device), state)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DevicePowerSmoothingUpdatePresetProfileParam(device Device, profile *PowerSmoothingProfile) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DevicePowerSmoothingUpdatePresetProfileParam(s.unwrapDevice(device), profile)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceQueryDrainState(pciInfo *PciInfo) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceQueryDrainState(pciInfo)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceReadWritePRM_v1(device Device, buffer *PRMTLV_v1) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceReadWritePRM_v1(s.unwrapDevice(device), buffer)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceRegisterEvents(device Device, eventTypes uint64, set EventSet) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceRegisterEvents(s.unwrapDevice(device), eventTypes, set)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceRemoveGpu(pciInfo *PciInfo) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceRemoveGpu(pciInfo)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceRemoveGpu_v2(pciInfo *PciInfo, gpuState DetachGpuState, linkState PcieLinkState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceRemoveGpu_v2(pciInfo, gpuState, linkState)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceResetApplicationsClocks(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceResetApplicationsClocks(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceResetGpuLockedClocks(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceResetGpuLockedClocks(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceResetMemoryLockedClocks(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceResetMemoryLockedClocks(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceResetNvLinkErrorCounters(device Device, link int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceResetNvLinkErrorCounters(s.unwrapDevice(device), link)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceResetNvLinkUtilizationCounter(device Device, link int, counter int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceResetNvLinkUtilizationCounter(s.unwrapDevice(device), link, counter)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetAPIRestriction(device Device, apiType RestrictedAPI, isRestricted EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetAPIRestriction(s.unwrapDevice(device), apiType, isRestricted)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetAccountingMode(device Device, mode EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetAccountingMode(s.unwrapDevice(device), mode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetApplicationsClocks(device Device, memClockMHz uint32, graphicsClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetApplicationsClocks(s.unwrapDevice(device), memClockMHz, graphicsClockMHz)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetAutoBoostedClocksEnabled(device Device, enabled EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetAutoBoostedClocksEnabled(s.unwrapDevice(device), enabled)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetClockOffsets(device Device, info ClockOffset) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetClockOffsets(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetComputeMode(device Device, mode ComputeMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetComputeMode(s.unwrapDevice(device), mode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetConfComputeUnprotectedMemSize(device Device, sizeKiB uint64) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetConfComputeUnprotectedMemSize(s.unwrapDevice(device), sizeKiB)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetCpuAffinity(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetCpuAffinity(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetDefaultAutoBoostedClocksEnabled(device Device, enabled EnableState, flags uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetDefaultAutoBoostedClocksEnabled(s.unwrapDevice(device), enabled, flags)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetDefaultFanSpeed_v2(device Device, fan int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetDefaultFanSpeed_v2(s.unwrapDevice(device), fan)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetDramEncryptionMode(device Device, dramEncryption *DramEncryptionInfo) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetDramEncryptionMode(s.unwrapDevice(device), dramEncryption)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetDriverModel(device Device, driverModel DriverModel, flags uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetDriverModel(s.unwrapDevice(device), driverModel, flags)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetEccMode(device Device, ecc EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetEccMode(s.unwrapDevice(device), ecc)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetFanControlPolicy(device Device, fan int, policy FanControlPolicy) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetFanControlPolicy(s.unwrapDevice(device), fan, policy)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetFanSpeed_v2(device Device, fan int, speed int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetFanSpeed_v2(s.unwrapDevice(device), fan, speed)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetGpcClkVfOffset(device Device, offset int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetGpcClkVfOffset(s.unwrapDevice(device), offset)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetGpuLockedClocks(s.unwrapDevice(device), minGpuClockMHz, maxGpuClockMHz)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetGpuOperationMode(device Device, mode GpuOperationMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetGpuOperationMode(s.unwrapDevice(device), mode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetMemClkVfOffset(device Device, offset int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetMemClkVfOffset(s.unwrapDevice(device), offset)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetMemoryLockedClocks(device Device, minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetMemoryLockedClocks(s.unwrapDevice(device), minMemClockMHz, maxMemClockMHz)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetMigMode(device Device, mode int) (Return, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceSetMigMode(s.unwrapDevice(device), mode)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetNvLinkDeviceLowPowerThreshold(device Device, info *NvLinkPowerThres) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetNvLinkDeviceLowPowerThreshold(s.unwrapDevice(device), info)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetNvLinkUtilizationControl(device Device, link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetNvLinkUtilizationControl(s.unwrapDevice(device), link, counter, control, reset)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetNvlinkBwMode(device Device, setBwMode *NvlinkSetBwMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetNvlinkBwMode(s.unwrapDevice(device), setBwMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetPersistenceMode(device Device, mode EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetPersistenceMode(s.unwrapDevice(device), mode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetPowerManagementLimit(device Device, limit uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetPowerManagementLimit(s.unwrapDevice(device), limit)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetPowerManagementLimit_v2(device Device, powerValue *PowerValue_v2) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetPowerManagementLimit_v2(s.unwrapDevice(device), powerValue)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetPowerMizerMode_v1(device Device, powerMizerMode *DevicePowerMizerModes_v1) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetPowerMizerMode_v1(s.unwrapDevice(device), powerMizerMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetTemperatureThreshold(device Device, thresholdType TemperatureThresholds, temp int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetTemperatureThreshold(s.unwrapDevice(device), thresholdType, temp)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetVgpuCapabilities(device Device, capability DeviceVgpuCapability, state EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetVgpuCapabilities(s.unwrapDevice(device), capability, state)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetVgpuHeterogeneousMode(device Device, heterogeneousMode VgpuHeterogeneousMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetVgpuHeterogeneousMode(s.unwrapDevice(device), heterogeneousMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetVgpuSchedulerState(device Device, pSchedulerState *VgpuSchedulerSetState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetVgpuSchedulerState(s.unwrapDevice(device), pSchedulerState)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceSetVirtualizationMode(device Device, virtualMode GpuVirtualizationMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceSetVirtualizationMode(s.unwrapDevice(device), virtualMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceValidateInforom(device Device) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceValidateInforom(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceWorkloadPowerProfileClearRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceWorkloadPowerProfileClearRequestedProfiles(s.unwrapDevice(device), requestedProfiles)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceWorkloadPowerProfileGetCurrentProfiles(device Device) (WorkloadPowerProfileCurrentProfiles, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceWorkloadPowerProfileGetCurrentProfiles(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceWorkloadPowerProfileGetProfilesInfo(device Device) (WorkloadPowerProfileProfilesInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.DeviceWorkloadPowerProfileGetProfilesInfo(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) DeviceWorkloadPowerProfileSetRequestedProfiles(device Device, requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.DeviceWorkloadPowerProfileSetRequestedProfiles(s.unwrapDevice(device), requestedProfiles)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) ErrorString(r Return) string {
+	return s.lib.ErrorString(r)
+}
+
+func (s *supervisor) EventSetCreate() (EventSet, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.EventSetCreate()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) EventSetFree(set EventSet) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.EventSetFree(set)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) EventSetWait(set EventSet, timeoutms uint32) (EventData, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.EventSetWait(set, timeoutms)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) Extensions() ExtendedInterface {
+	return s.lib.Extensions()
+}
+
+func (s *supervisor) GetExcludedDeviceCount() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GetExcludedDeviceCount()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GetExcludedDeviceInfoByIndex(index int) (ExcludedDeviceInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GetExcludedDeviceInfoByIndex(index)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GetVgpuCompatibility(vgpuMetadata *VgpuMetadata, pgpuMetadata *VgpuPgpuMetadata) (VgpuPgpuCompatibility, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GetVgpuCompatibility(vgpuMetadata, pgpuMetadata)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GetVgpuDriverCapabilities(capability VgpuDriverCapability) (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GetVgpuDriverCapabilities(capability)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GetVgpuVersion() (VgpuVersion, VgpuVersion, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.GetVgpuVersion()
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) GpmMetricsGet(metricsGet *GpmMetricsGetType) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpmMetricsGet(metricsGet)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpmMetricsGetV(metricsGet *GpmMetricsGetType) GpmMetricsGetVType {
+	return s.lib.GpmMetricsGetV(metricsGet)
+}
+
+func (s *supervisor) GpmMigSampleGet(device Device, gpuInstanceId int, gpmSample GpmSample) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpmMigSampleGet(s.unwrapDevice(device), gpuInstanceId, gpmSample)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpmQueryDeviceSupport(device Device) (GpmSupport, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpmQueryDeviceSupport(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpmQueryDeviceSupportV(device Device) GpmSupportV {
+	return s.lib.GpmQueryDeviceSupportV(s.unwrapDevice(device))
+}
+
+func (s *supervisor) GpmQueryIfStreamingEnabled(device Device) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpmQueryIfStreamingEnabled(s.unwrapDevice(device))
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpmSampleAlloc() (GpmSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpmSampleAlloc()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpmSampleFree(gpmSample GpmSample) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpmSampleFree(gpmSample)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpmSampleGet(device Device, gpmSample GpmSample) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpmSampleGet(s.unwrapDevice(device), gpmSample)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpmSetStreamingEnabled(device Device, state uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpmSetStreamingEnabled(s.unwrapDevice(device), state)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceCreateComputeInstance(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceCreateComputeInstance(gpuInstance, info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo, placement *ComputeInstancePlacement) (ComputeInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceCreateComputeInstanceWithPlacement(gpuInstance, info, placement)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceDestroy(gpuInstance GpuInstance) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpuInstanceDestroy(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetActiveVgpus(gpuInstance GpuInstance) (ActiveVgpuInstanceInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetActiveVgpus(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetComputeInstanceById(gpuInstance, id)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetComputeInstancePossiblePlacements(gpuInstance, info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstance, profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetComputeInstanceProfileInfo(gpuInstance, profile, engProfile)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) ComputeInstanceProfileInfoHandler {
+	return s.lib.GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance, profile, engProfile)
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetComputeInstanceRemainingCapacity(gpuInstance, info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetComputeInstances(gpuInstance GpuInstance, info *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetComputeInstances(gpuInstance, info)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetCreatableVgpus(gpuInstance GpuInstance) (VgpuTypeIdInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetCreatableVgpus(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetInfo(gpuInstance GpuInstance) (GpuInstanceInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetInfo(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetVgpuHeterogeneousMode(gpuInstance GpuInstance) (VgpuHeterogeneousMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetVgpuHeterogeneousMode(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetVgpuSchedulerLog(gpuInstance GpuInstance) (VgpuSchedulerLogInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetVgpuSchedulerLog(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetVgpuSchedulerState(gpuInstance GpuInstance) (VgpuSchedulerStateInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetVgpuSchedulerState(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance GpuInstance) (VgpuCreatablePlacementInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.GpuInstanceGetVgpuTypeCreatablePlacements(gpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceSetVgpuHeterogeneousMode(gpuInstance GpuInstance, heterogeneousMode *VgpuHeterogeneousMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpuInstanceSetVgpuHeterogeneousMode(gpuInstance, heterogeneousMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) GpuInstanceSetVgpuSchedulerState(gpuInstance GpuInstance, scheduler *VgpuSchedulerState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.GpuInstanceSetVgpuSchedulerState(gpuInstance, scheduler)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SetVgpuVersion(vgpuVersion *VgpuVersion) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SetVgpuVersion(vgpuVersion)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemEventSetCreate(request *SystemEventSetCreateRequest) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemEventSetCreate(request)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemEventSetFree(request *SystemEventSetFreeRequest) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemEventSetFree(request)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemEventSetWait(request *SystemEventSetWaitRequest) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemEventSetWait(request)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetConfComputeCapabilities() (ConfComputeSystemCaps, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetConfComputeCapabilities()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetConfComputeGpusReadyState() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetConfComputeGpusReadyState()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetConfComputeKeyRotationThresholdInfo()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetConfComputeSettings() (SystemConfComputeSettings, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetConfComputeSettings()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetConfComputeState() (ConfComputeSystemState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetConfComputeState()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetCudaDriverVersion() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetCudaDriverVersion()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetCudaDriverVersion_v2() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetCudaDriverVersion_v2()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetDriverBranch() (SystemDriverBranchInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetDriverBranch()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetDriverVersion() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetDriverVersion()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetHicVersion() ([]HwbcEntry, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetHicVersion()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetNVMLVersion() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetNVMLVersion()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetNvlinkBwMode() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetNvlinkBwMode()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetProcessName(pid int) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetProcessName(pid)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.SystemGetTopologyGpuSet(cpuNumber)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevices(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) SystemRegisterEvents(request *SystemRegisterEventRequest) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemRegisterEvents(request)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemSetConfComputeGpusReadyState(isAcceptingWork)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo ConfComputeSetKeyRotationThresholdInfo) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemSetConfComputeKeyRotationThresholdInfo(keyRotationThresholdInfo)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) SystemSetNvlinkBwMode(nvlinkBwMode uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.SystemSetNvlinkBwMode(nvlinkBwMode)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetCount() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetCount()
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetDevices(unit Unit) ([]Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetDevices(unit)
+		if !s.retry(ret, generation, attempt) {
+			return s.wrapDevices(r0, ret, generation), ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetFanSpeedInfo(unit Unit) (UnitFanSpeeds, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetFanSpeedInfo(unit)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetHandleByIndex(index int) (Unit, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetHandleByIndex(index)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetLedState(unit Unit) (LedState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetLedState(unit)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetPsuInfo(unit Unit) (PSUInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetPsuInfo(unit)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetTemperature(unit Unit, ttype int) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetTemperature(unit, ttype)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitGetUnitInfo(unit Unit) (UnitInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.UnitGetUnitInfo(unit)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) UnitSetLedState(unit Unit, color LedColor) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.UnitSetLedState(unit, color)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceClearAccountingPids(vgpuInstance VgpuInstance) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.VgpuInstanceClearAccountingPids(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetAccountingMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetAccountingMode(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetAccountingPids(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (AccountingStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetAccountingStats(vgpuInstance, pid)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetEccMode(vgpuInstance VgpuInstance) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetEccMode(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetEncoderCapacity(vgpuInstance VgpuInstance) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetEncoderCapacity(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetEncoderSessions(vgpuInstance VgpuInstance) (int, EncoderSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.VgpuInstanceGetEncoderSessions(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetEncoderStats(vgpuInstance VgpuInstance) (int, uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, r2, ret := s.lib.VgpuInstanceGetEncoderStats(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, r2, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetFBCSessions(vgpuInstance VgpuInstance) (int, FBCSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.VgpuInstanceGetFBCSessions(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetFBCStats(vgpuInstance VgpuInstance) (FBCStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetFBCStats(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetFbUsage(vgpuInstance VgpuInstance) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetFbUsage(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetFrameRateLimit(vgpuInstance VgpuInstance) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetFrameRateLimit(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetGpuInstanceId(vgpuInstance VgpuInstance) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetGpuInstanceId(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetGpuPciId(vgpuInstance VgpuInstance) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetGpuPciId(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetLicenseInfo(vgpuInstance VgpuInstance) (VgpuLicenseInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetLicenseInfo(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetLicenseStatus(vgpuInstance VgpuInstance) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetLicenseStatus(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetMdevUUID(vgpuInstance VgpuInstance) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetMdevUUID(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetMetadata(vgpuInstance VgpuInstance) (VgpuMetadata, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetMetadata(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetPlacementId(vgpuInstance VgpuInstance) (VgpuPlacementId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetPlacementId(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetRuntimeStateSize(vgpuInstance VgpuInstance) (VgpuRuntimeState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetRuntimeStateSize(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetType(vgpuInstance VgpuInstance) (VgpuTypeId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetType(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetUUID(vgpuInstance VgpuInstance) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetUUID(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetVmDriverVersion(vgpuInstance VgpuInstance) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuInstanceGetVmDriverVersion(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, VgpuVmIdType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.VgpuInstanceGetVmID(vgpuInstance)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuInstanceSetEncoderCapacity(vgpuInstance VgpuInstance, encoderCapacity int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.VgpuInstanceSetEncoderCapacity(vgpuInstance, encoderCapacity)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetBAR1Info(vgpuTypeId VgpuTypeId) (VgpuTypeBar1Info, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetBAR1Info(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetCapabilities(vgpuTypeId VgpuTypeId, capability VgpuCapability) (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetCapabilities(vgpuTypeId, capability)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetClass(vgpuTypeId VgpuTypeId) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetClass(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetDeviceID(vgpuTypeId VgpuTypeId) (uint64, uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.VgpuTypeGetDeviceID(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetFbReservation(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetFbReservation(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetFrameRateLimit(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetFrameRateLimit(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetFramebufferSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetFramebufferSize(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetGpuInstanceProfileId(vgpuTypeId VgpuTypeId) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetGpuInstanceProfileId(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetGspHeapSize(vgpuTypeId VgpuTypeId) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetGspHeapSize(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetLicense(vgpuTypeId VgpuTypeId) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetLicense(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetMaxInstances(device Device, vgpuTypeId VgpuTypeId) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetMaxInstances(s.unwrapDevice(device), vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance *VgpuTypeMaxInstance) Return {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		ret := s.lib.VgpuTypeGetMaxInstancesPerGpuInstance(maxInstance)
+		if !s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetMaxInstancesPerVm(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetName(vgpuTypeId VgpuTypeId) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetName(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetNumDisplayHeads(vgpuTypeId VgpuTypeId) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, ret := s.lib.VgpuTypeGetNumDisplayHeads(vgpuTypeId)
+		if !s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (s *supervisor) VgpuTypeGetResolution(vgpuTypeId VgpuTypeId, displayIndex int) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := s.generation.Load()
+		r0, r1, ret := s.lib.VgpuTypeGetResolution(vgpuTypeId, displayIndex)
+		if !s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+var _ Device = (*supervisedDevice)(nil)
+
+func (d *supervisedDevice) ClearAccountingPids() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ClearAccountingPids()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ClearCpuAffinity() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ClearCpuAffinity()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ClearEccErrorCounts(counterType EccCounterType) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ClearEccErrorCounts(counterType)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ClearFieldValues(values []FieldValue) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ClearFieldValues(values)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) CreateGpuInstance(info *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().CreateGpuInstance(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) CreateGpuInstanceWithPlacement(info *GpuInstanceProfileInfo, placement *GpuInstancePlacement) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().CreateGpuInstanceWithPlacement(info, placement)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) FreezeNvLinkUtilizationCounter(link int, counter int, freeze EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().FreezeNvLinkUtilizationCounter(link, counter, freeze)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAPIRestriction(apiType RestrictedAPI) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAPIRestriction(apiType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAccountingBufferSize() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAccountingBufferSize()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAccountingMode() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAccountingMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAccountingPids() ([]int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAccountingPids()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAccountingStats(pid uint32) (AccountingStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAccountingStats(pid)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetActiveVgpus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAdaptiveClockInfoStatus() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAdaptiveClockInfoStatus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAddressingMode() (DeviceAddressingMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAddressingMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetApplicationsClock(clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetApplicationsClock(clockType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetArchitecture() (DeviceArchitecture, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetArchitecture()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAttributes() (DeviceAttributes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetAttributes()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetAutoBoostedClocksEnabled() (EnableState, EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetAutoBoostedClocksEnabled()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBAR1MemoryInfo() (BAR1Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBAR1MemoryInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBoardId() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBoardId()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBoardPartNumber() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBoardPartNumber()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBrand() (BrandType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBrand()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBridgeChipInfo() (BridgeChipHierarchy, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBridgeChipInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetBusType() (BusType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetBusType()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetC2cModeInfoV() C2cModeInfoHandler {
+	return d.handle().GetC2cModeInfoV()
+}
+
+func (d *supervisedDevice) GetCapabilities() (DeviceCapabilities, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCapabilities()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetClkMonStatus() (ClkMonStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetClkMonStatus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetClock(clockType ClockType, clockId ClockId) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetClock(clockType, clockId)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetClockInfo(clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetClockInfo(clockType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetClockOffsets() (ClockOffset, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetClockOffsets()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetComputeInstanceId() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetComputeInstanceId()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetComputeMode() (ComputeMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetComputeMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetComputeRunningProcesses() ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetComputeRunningProcesses()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetConfComputeGpuAttestationReport(gpuAtstReport *ConfComputeGpuAttestationReport) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GetConfComputeGpuAttestationReport(gpuAtstReport)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetConfComputeGpuCertificate() (ConfComputeGpuCertificate, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetConfComputeGpuCertificate()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetConfComputeMemSizeInfo() (ConfComputeMemSizeInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetConfComputeMemSizeInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetConfComputeProtectedMemoryUsage() (Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetConfComputeProtectedMemoryUsage()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCoolerInfo() (CoolerInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCoolerInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCpuAffinity(numCPUs int) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCpuAffinity(numCPUs)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCpuAffinityWithinScope(numCPUs int, scope AffinityScope) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCpuAffinityWithinScope(numCPUs, scope)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCreatableVgpus() ([]VgpuTypeId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCreatableVgpus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCudaComputeCapability() (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetCudaComputeCapability()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCurrPcieLinkGeneration() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCurrPcieLinkGeneration()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCurrPcieLinkWidth() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCurrPcieLinkWidth()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCurrentClockFreqs() (DeviceCurrentClockFreqs, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCurrentClockFreqs()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCurrentClocksEventReasons() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCurrentClocksEventReasons()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetCurrentClocksThrottleReasons() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetCurrentClocksThrottleReasons()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDecoderUtilization() (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetDecoderUtilization()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDefaultApplicationsClock(clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDefaultApplicationsClock(clockType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDefaultEccMode() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDefaultEccMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDetailedEccErrors(errorType MemoryErrorType, counterType EccCounterType) (EccErrorCounts, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDetailedEccErrors(errorType, counterType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDeviceHandleFromMigDeviceHandle() (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDeviceHandleFromMigDeviceHandle()
+		if !d.s.retry(ret, generation, attempt) {
+			return d.s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDisplayActive() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDisplayActive()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDisplayMode() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDisplayMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDramEncryptionMode() (DramEncryptionInfo, DramEncryptionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetDramEncryptionMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDriverModel() (DriverModel, DriverModel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetDriverModel()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDriverModel_v2() (DriverModel, DriverModel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetDriverModel_v2()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetDynamicPstatesInfo() (GpuDynamicPstatesInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetDynamicPstatesInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEccMode() (EnableState, EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetEccMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEncoderCapacity(encoderQueryType EncoderType) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetEncoderCapacity(encoderQueryType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEncoderSessions() ([]EncoderSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetEncoderSessions()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEncoderStats() (int, uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, r2, ret := d.handle().GetEncoderStats()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, r2, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEncoderUtilization() (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetEncoderUtilization()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetEnforcedPowerLimit() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetEnforcedPowerLimit()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFBCSessions() ([]FBCSessionInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFBCSessions()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFBCStats() (FBCStats, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFBCStats()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFanControlPolicy_v2(fan int) (FanControlPolicy, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFanControlPolicy_v2(fan)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFanSpeed() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFanSpeed()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFanSpeedRPM() (FanSpeedInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFanSpeedRPM()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFanSpeed_v2(fan int) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetFanSpeed_v2(fan)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetFieldValues(values []FieldValue) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GetFieldValues(values)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpcClkMinMaxVfOffset() (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetGpcClkMinMaxVfOffset()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpcClkVfOffset() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpcClkVfOffset()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuFabricInfo() (GpuFabricInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuFabricInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuFabricInfoV() GpuFabricInfoHandler {
+	return d.handle().GetGpuFabricInfoV()
+}
+
+func (d *supervisedDevice) GetGpuInstanceById(id int) (GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstanceById(id)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuInstanceId() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstanceId()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuInstancePossiblePlacements(info *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstancePossiblePlacements(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuInstanceProfileInfo(profile int) (GpuInstanceProfileInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstanceProfileInfo(profile)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuInstanceProfileInfoByIdV(profileId int) GpuInstanceProfileInfoByIdHandler {
+	return d.handle().GetGpuInstanceProfileInfoByIdV(profileId)
+}
+
+func (d *supervisedDevice) GetGpuInstanceProfileInfoV(profile int) GpuInstanceProfileInfoHandler {
+	return d.handle().GetGpuInstanceProfileInfoV(profile)
+}
+
+func (d *supervisedDevice) GetGpuInstanceRemainingCapacity(info *GpuInstanceProfileInfo) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstanceRemainingCapacity(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuInstances(info *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuInstances(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuMaxPcieLinkGeneration() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGpuMaxPcieLinkGeneration()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGpuOperationMode() (GpuOperationMode, GpuOperationMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetGpuOperationMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGraphicsRunningProcesses() ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGraphicsRunningProcesses()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGridLicensableFeatures() (GridLicensableFeatures, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGridLicensableFeatures()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGspFirmwareMode() (bool, bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetGspFirmwareMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetGspFirmwareVersion() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetGspFirmwareVersion()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetHostVgpuMode() (HostVgpuMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetHostVgpuMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetIndex() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetIndex()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetInforomConfigurationChecksum() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetInforomConfigurationChecksum()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetInforomImageVersion() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetInforomImageVersion()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetInforomVersion(object InforomObject) (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetInforomVersion(object)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetIrqNum() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetIrqNum()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetJpgUtilization() (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetJpgUtilization()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetLastBBXFlushTime() (uint64, uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetLastBBXFlushTime()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMPSComputeRunningProcesses() ([]ProcessInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMPSComputeRunningProcesses()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMarginTemperature() (MarginTemperature, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMarginTemperature()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMaxClockInfo(clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMaxClockInfo(clockType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMaxCustomerBoostClock(clockType ClockType) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMaxCustomerBoostClock(clockType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMaxMigDeviceCount() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMaxMigDeviceCount()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMaxPcieLinkGeneration() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMaxPcieLinkGeneration()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMaxPcieLinkWidth() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMaxPcieLinkWidth()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemClkMinMaxVfOffset() (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetMemClkMinMaxVfOffset()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemClkVfOffset() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemClkVfOffset()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemoryAffinity(numNodes int, scope AffinityScope) ([]uint, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemoryAffinity(numNodes, scope)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemoryBusWidth() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemoryBusWidth()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemoryErrorCounter(errorType MemoryErrorType, counterType EccCounterType, locationType MemoryLocation) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemoryErrorCounter(errorType, counterType, locationType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemoryInfo() (Memory, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemoryInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMemoryInfo_v2() (Memory_v2, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMemoryInfo_v2()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMigDeviceHandleByIndex(index int) (Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMigDeviceHandleByIndex(index)
+		if !d.s.retry(ret, generation, attempt) {
+			return d.s.wrapDevice(r0, ret, generation), ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMigMode() (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetMigMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMinMaxClockOfPState(clockType ClockType, pstate Pstates) (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetMinMaxClockOfPState(clockType, pstate)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMinMaxFanSpeed() (int, int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetMinMaxFanSpeed()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMinorNumber() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMinorNumber()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetModuleId() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetModuleId()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetMultiGpuBoard() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetMultiGpuBoard()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetName() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetName()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNumFans() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNumFans()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNumGpuCores() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNumGpuCores()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNumaNodeId() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNumaNodeId()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkCapability(link int, capability NvLinkCapability) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkCapability(link, capability)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkErrorCounter(link int, counter NvLinkErrorCounter) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkErrorCounter(link, counter)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkInfo() NvLinkInfoHandler {
+	return d.handle().GetNvLinkInfo()
+}
+
+func (d *supervisedDevice) GetNvLinkRemoteDeviceType(link int) (IntNvLinkDeviceType, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkRemoteDeviceType(link)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkRemotePciInfo(link int) (PciInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkRemotePciInfo(link)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkState(link int) (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkState(link)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkUtilizationControl(link int, counter int) (NvLinkUtilizationControl, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkUtilizationControl(link, counter)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkUtilizationCounter(link int, counter int) (uint64, uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetNvLinkUtilizationCounter(link, counter)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvLinkVersion(link int) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvLinkVersion(link)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvlinkBwMode() (NvlinkGetBwMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvlinkBwMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetNvlinkSupportedBwModes() (NvlinkSupportedBwModes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetNvlinkSupportedBwModes()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetOfaUtilization() (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetOfaUtilization()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetP2PStatus(device2 Device, p2pIndex GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetP2PStatus(d.s.unwrapDevice(device2), p2pIndex)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPciInfo() (PciInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPciInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPciInfoExt() (PciInfoExt, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPciInfoExt()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPcieLinkMaxSpeed() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPcieLinkMaxSpeed()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPcieReplayCounter() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPcieReplayCounter()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPcieSpeed() (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPcieSpeed()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPcieThroughput(counter PcieUtilCounter) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPcieThroughput(counter)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPdi() (Pdi, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPdi()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPerformanceModes() (DevicePerfModes, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPerformanceModes()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPerformanceState() (Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPerformanceState()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPersistenceMode() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPersistenceMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPgpuMetadataString() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPgpuMetadataString()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPlatformInfo() (PlatformInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPlatformInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerManagementDefaultLimit() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerManagementDefaultLimit()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerManagementLimit() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerManagementLimit()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerManagementLimitConstraints() (uint32, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetPowerManagementLimitConstraints()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerManagementMode() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerManagementMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerMizerMode_v1() (DevicePowerMizerModes_v1, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerMizerMode_v1()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerSource() (PowerSource, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerSource()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerState() (Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerState()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetPowerUsage() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetPowerUsage()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetProcessUtilization(lastSeenTimestamp uint64) ([]ProcessUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetProcessUtilization(lastSeenTimestamp)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetProcessesUtilizationInfo() (ProcessesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetProcessesUtilizationInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRemappedRows() (int, int, bool, bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, r2, r3, ret := d.handle().GetRemappedRows()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, r2, r3, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRepairStatus() (RepairStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetRepairStatus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRetiredPages(cause PageRetirementCause) ([]uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetRetiredPages(cause)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRetiredPagesPendingStatus() (EnableState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetRetiredPagesPendingStatus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRetiredPages_v2(cause PageRetirementCause) ([]uint64, []uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetRetiredPages_v2(cause)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRowRemapperHistogram() (RowRemapperHistogramValues, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetRowRemapperHistogram()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetRunningProcessDetailList() (ProcessDetailList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetRunningProcessDetailList()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSamples(samplingType SamplingType, lastSeenTimestamp uint64) (ValueType, []Sample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetSamples(samplingType, lastSeenTimestamp)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSerial() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSerial()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSramEccErrorStatus() (EccSramErrorStatus, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSramEccErrorStatus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSramUniqueUncorrectedEccErrorCounts(errorCounts *EccSramUniqueUncorrectedErrorCounts) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GetSramUniqueUncorrectedEccErrorCounts(errorCounts)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedClocksEventReasons() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSupportedClocksEventReasons()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedClocksThrottleReasons() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSupportedClocksThrottleReasons()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedEventTypes() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSupportedEventTypes()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetSupportedGraphicsClocks(memoryClockMHz)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedMemoryClocks() (int, uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetSupportedMemoryClocks()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedPerformanceStates() ([]Pstates, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSupportedPerformanceStates()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetSupportedVgpus() ([]VgpuTypeId, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetSupportedVgpus()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTargetFanSpeed(fan int) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTargetFanSpeed(fan)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTemperature(sensorType TemperatureSensors) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTemperature(sensorType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTemperatureThreshold(thresholdType TemperatureThresholds) (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTemperatureThreshold(thresholdType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTemperatureV() TemperatureHandler {
+	return d.handle().GetTemperatureV()
+}
+
+func (d *supervisedDevice) GetThermalSettings(sensorIndex uint32) (GpuThermalSettings, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetThermalSettings(sensorIndex)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTopologyCommonAncestor(device2 Device) (GpuTopologyLevel, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTopologyCommonAncestor(d.s.unwrapDevice(device2))
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTopologyNearestGpus(level GpuTopologyLevel) ([]Device, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTopologyNearestGpus(level)
+		if !d.s.retry(ret, generation, attempt) {
+			return d.s.wrapDevices(r0, ret, generation), ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTotalEccErrors(errorType MemoryErrorType, counterType EccCounterType) (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTotalEccErrors(errorType, counterType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetTotalEnergyConsumption() (uint64, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetTotalEnergyConsumption()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetUUID() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetUUID()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetUtilizationRates() (Utilization, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetUtilizationRates()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVbiosVersion() (string, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVbiosVersion()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuCapabilities(capability DeviceVgpuCapability) (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuCapabilities(capability)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuHeterogeneousMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuInstancesUtilizationInfo() (VgpuInstancesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuInstancesUtilizationInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuMetadata() (VgpuPgpuMetadata, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuMetadata()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]VgpuProcessUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuProcessUtilization(lastSeenTimestamp)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuProcessesUtilizationInfo() (VgpuProcessesUtilizationInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuProcessesUtilizationInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuSchedulerCapabilities() (VgpuSchedulerCapabilities, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuSchedulerCapabilities()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuSchedulerLog() (VgpuSchedulerLog, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuSchedulerLog()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuSchedulerState() (VgpuSchedulerGetState, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuSchedulerState()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuTypeCreatablePlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuTypeCreatablePlacements(vgpuTypeId)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuTypeSupportedPlacements(vgpuTypeId VgpuTypeId) (VgpuPlacementList, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVgpuTypeSupportedPlacements(vgpuTypeId)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVgpuUtilization(lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, r1, ret := d.handle().GetVgpuUtilization(lastSeenTimestamp)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, r1, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetViolationStatus(perfPolicyType PerfPolicyType) (ViolationTime, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetViolationStatus(perfPolicyType)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GetVirtualizationMode() (GpuVirtualizationMode, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GetVirtualizationMode()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GpmMigSampleGet(gpuInstanceId int, gpmSample GpmSample) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GpmMigSampleGet(gpuInstanceId, gpmSample)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GpmQueryDeviceSupport() (GpmSupport, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GpmQueryDeviceSupport()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GpmQueryDeviceSupportV() GpmSupportV {
+	return d.handle().GpmQueryDeviceSupportV()
+}
+
+func (d *supervisedDevice) GpmQueryIfStreamingEnabled() (uint32, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().GpmQueryIfStreamingEnabled()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GpmSampleGet(gpmSample GpmSample) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GpmSampleGet(gpmSample)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) GpmSetStreamingEnabled(state uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().GpmSetStreamingEnabled(state)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) IsMigDeviceHandle() (bool, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().IsMigDeviceHandle()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) OnSameBoard(device2 Device) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().OnSameBoard(d.s.unwrapDevice(device2))
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) PowerSmoothingActivatePresetProfile(profile *PowerSmoothingProfile) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().PowerSmoothingActivatePresetProfile(profile)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) PowerSmoothingSetState(state *PowerSmoothingState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().PowerSmoothingSetState(state)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) PowerSmoothingUpdatePresetProfileParam(profile *PowerSmoothingProfile) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().PowerSmoothingUpdatePresetProfileParam(profile)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ReadWritePRM_v1(buffer *PRMTLV_v1) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ReadWritePRM_v1(buffer)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) RegisterEvents(eventTypes uint64, set EventSet) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().RegisterEvents(eventTypes, set)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ResetApplicationsClocks() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ResetApplicationsClocks()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ResetGpuLockedClocks() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ResetGpuLockedClocks()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ResetMemoryLockedClocks() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ResetMemoryLockedClocks()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ResetNvLinkErrorCounters(link int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ResetNvLinkErrorCounters(link)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ResetNvLinkUtilizationCounter(link int, counter int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ResetNvLinkUtilizationCounter(link, counter)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetAPIRestriction(apiType RestrictedAPI, isRestricted EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetAPIRestriction(apiType, isRestricted)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetAccountingMode(mode EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetAccountingMode(mode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetApplicationsClocks(memClockMHz uint32, graphicsClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetAutoBoostedClocksEnabled(enabled EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetAutoBoostedClocksEnabled(enabled)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetClockOffsets(info ClockOffset) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetClockOffsets(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetComputeMode(mode ComputeMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetComputeMode(mode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetConfComputeUnprotectedMemSize(sizeKiB uint64) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetConfComputeUnprotectedMemSize(sizeKiB)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetCpuAffinity() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetCpuAffinity()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetDefaultAutoBoostedClocksEnabled(enabled EnableState, flags uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetDefaultAutoBoostedClocksEnabled(enabled, flags)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetDefaultFanSpeed_v2(fan int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetDefaultFanSpeed_v2(fan)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetDramEncryptionMode(dramEncryption *DramEncryptionInfo) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetDramEncryptionMode(dramEncryption)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetDriverModel(driverModel DriverModel, flags uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetDriverModel(driverModel, flags)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetEccMode(ecc EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetEccMode(ecc)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetFanControlPolicy(fan int, policy FanControlPolicy) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetFanControlPolicy(fan, policy)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetFanSpeed_v2(fan int, speed int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetFanSpeed_v2(fan, speed)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetGpcClkVfOffset(offset int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetGpcClkVfOffset(offset)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetGpuLockedClocks(minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetGpuOperationMode(mode GpuOperationMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetGpuOperationMode(mode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetMemClkVfOffset(offset int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetMemClkVfOffset(offset)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetMemoryLockedClocks(minMemClockMHz uint32, maxMemClockMHz uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetMemoryLockedClocks(minMemClockMHz, maxMemClockMHz)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetMigMode(mode int) (Return, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().SetMigMode(mode)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetNvLinkDeviceLowPowerThreshold(info *NvLinkPowerThres) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetNvLinkDeviceLowPowerThreshold(info)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetNvLinkUtilizationControl(link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetNvLinkUtilizationControl(link, counter, control, reset)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetNvlinkBwMode(setBwMode *NvlinkSetBwMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetNvlinkBwMode(setBwMode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetPersistenceMode(mode EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetPersistenceMode(mode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetPowerManagementLimit(limit uint32) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetPowerManagementLimit(limit)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetPowerManagementLimit_v2(powerValue *PowerValue_v2) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetPowerManagementLimit_v2(powerValue)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetPowerMizerMode_v1(powerMizerMode *DevicePowerMizerModes_v1) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetPowerMizerMode_v1(powerMizerMode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetTemperatureThreshold(thresholdType TemperatureThresholds, temp int) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetTemperatureThreshold(thresholdType, temp)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetVgpuCapabilities(capability DeviceVgpuCapability, state EnableState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetVgpuCapabilities(capability, state)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetVgpuHeterogeneousMode(heterogeneousMode VgpuHeterogeneousMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetVgpuHeterogeneousMode(heterogeneousMode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetVgpuSchedulerState(pSchedulerState *VgpuSchedulerSetState) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetVgpuSchedulerState(pSchedulerState)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) SetVirtualizationMode(virtualMode GpuVirtualizationMode) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().SetVirtualizationMode(virtualMode)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) ValidateInforom() Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().ValidateInforom()
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) VgpuTypeGetMaxInstances(vgpuTypeId VgpuTypeId) (int, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().VgpuTypeGetMaxInstances(vgpuTypeId)
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) WorkloadPowerProfileClearRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().WorkloadPowerProfileClearRequestedProfiles(requestedProfiles)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
+
+func (d *supervisedDevice) WorkloadPowerProfileGetCurrentProfiles() (WorkloadPowerProfileCurrentProfiles, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().WorkloadPowerProfileGetCurrentProfiles()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) WorkloadPowerProfileGetProfilesInfo() (WorkloadPowerProfileProfilesInfo, Return) {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		r0, ret := d.handle().WorkloadPowerProfileGetProfilesInfo()
+		if !d.s.retry(ret, generation, attempt) {
+			return r0, ret
+		}
+	}
+}
+
+func (d *supervisedDevice) WorkloadPowerProfileSetRequestedProfiles(requestedProfiles *WorkloadPowerProfileRequestedProfiles) Return {
+	for attempt := 0; ; attempt++ {
+		generation := d.s.generation.Load()
+		ret := d.handle().WorkloadPowerProfileSetRequestedProfiles(requestedProfiles)
+		if !d.s.retry(ret, generation, attempt) {
+			return ret
+		}
+	}
+}
@@ -0,0 +1,183 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// returnInfos describes the Return values defined in nvml.h.
+var returnInfos = map[Return]returnInfo{
+	SUCCESS: {
+		name:        "SUCCESS",
+		description: "The operation was successful",
+		class:       ReturnClassSuccess,
+	},
+	ERROR_UNINITIALIZED: {
+		name:        "ERROR_UNINITIALIZED",
+		description: "NVML was not first initialized with nvmlInit()",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_INVALID_ARGUMENT: {
+		name:        "ERROR_INVALID_ARGUMENT",
+		description: "A supplied argument is invalid",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_NOT_SUPPORTED: {
+		name:        "ERROR_NOT_SUPPORTED",
+		description: "The requested operation is not available on target device",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_NO_PERMISSION: {
+		name:        "ERROR_NO_PERMISSION",
+		description: "The current user does not have permission for operation",
+		class:       ReturnClassPermission,
+	},
+	ERROR_ALREADY_INITIALIZED: {
+		name:        "ERROR_ALREADY_INITIALIZED",
+		description: "Deprecated: Multiple initializations are now allowed through ref counting",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_NOT_FOUND: {
+		name:        "ERROR_NOT_FOUND",
+		description: "A query to find an object was unsuccessful",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_INSUFFICIENT_SIZE: {
+		name:        "ERROR_INSUFFICIENT_SIZE",
+		description: "An input argument is not large enough",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_INSUFFICIENT_POWER: {
+		name:        "ERROR_INSUFFICIENT_POWER",
+		description: "A device's external power cables are not properly attached",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_DRIVER_NOT_LOADED: {
+		name:        "ERROR_DRIVER_NOT_LOADED",
+		description: "NVIDIA driver is not loaded",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_TIMEOUT: {
+		name:        "ERROR_TIMEOUT",
+		description: "User provided timeout passed",
+		class:       ReturnClassTransient,
+	},
+	ERROR_IRQ_ISSUE: {
+		name:        "ERROR_IRQ_ISSUE",
+		description: "NVIDIA Kernel detected an interrupt issue with a GPU",
+		class:       ReturnClassNeedsReset,
+	},
+	ERROR_LIBRARY_NOT_FOUND: {
+		name:        "ERROR_LIBRARY_NOT_FOUND",
+		description: "NVML Shared Library couldn't be found or loaded",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_FUNCTION_NOT_FOUND: {
+		name:        "ERROR_FUNCTION_NOT_FOUND",
+		description: "Local version of NVML doesn't implement this function",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_CORRUPTED_INFOROM: {
+		name:        "ERROR_CORRUPTED_INFOROM",
+		description: "infoROM is corrupted",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_GPU_IS_LOST: {
+		name:        "ERROR_GPU_IS_LOST",
+		description: "The GPU has fallen off the bus or has otherwise become inaccessible",
+		class:       ReturnClassNeedsReset,
+	},
+	ERROR_RESET_REQUIRED: {
+		name:        "ERROR_RESET_REQUIRED",
+		description: "The GPU requires a reset before it can be used again",
+		class:       ReturnClassNeedsReset,
+	},
+	ERROR_OPERATING_SYSTEM: {
+		name:        "ERROR_OPERATING_SYSTEM",
+		description: "The GPU control device has been blocked by the operating system/cgroups",
+		class:       ReturnClassPermission,
+	},
+	ERROR_LIB_RM_VERSION_MISMATCH: {
+		name:        "ERROR_LIB_RM_VERSION_MISMATCH",
+		description: "RM detects a driver/library version mismatch",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_IN_USE: {
+		name:        "ERROR_IN_USE",
+		description: "An operation cannot be performed because the GPU is currently in use",
+		class:       ReturnClassTransient,
+	},
+	ERROR_MEMORY: {
+		name:        "ERROR_MEMORY",
+		description: "Insufficient memory",
+		class:       ReturnClassTransient,
+	},
+	ERROR_NO_DATA: {
+		name:        "ERROR_NO_DATA",
+		description: "No data",
+		class:       ReturnClassTransient,
+	},
+	ERROR_VGPU_ECC_NOT_SUPPORTED: {
+		name:        "ERROR_VGPU_ECC_NOT_SUPPORTED",
+		description: "The requested vgpu operation is not available on target device, becasue ECC is enabled",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_INSUFFICIENT_RESOURCES: {
+		name:        "ERROR_INSUFFICIENT_RESOURCES",
+		description: "Ran out of critical resources, other than memory",
+		class:       ReturnClassTransient,
+	},
+	ERROR_FREQ_NOT_SUPPORTED: {
+		name:        "ERROR_FREQ_NOT_SUPPORTED",
+		description: "Ran out of critical resources, other than memory",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_ARGUMENT_VERSION_MISMATCH: {
+		name:        "ERROR_ARGUMENT_VERSION_MISMATCH",
+		description: "The provided version is invalid/unsupported",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_DEPRECATED: {
+		name:        "ERROR_DEPRECATED",
+		description: "The requested functionality has been deprecated",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_NOT_READY: {
+		name:        "ERROR_NOT_READY",
+		description: "The system is not ready for the request",
+		class:       ReturnClassTransient,
+	},
+	ERROR_GPU_NOT_FOUND: {
+		name:        "ERROR_GPU_NOT_FOUND",
+		description: "No GPUs were found",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_INVALID_STATE: {
+		name:        "ERROR_INVALID_STATE",
+		description: "Resource not in correct state to perform requested operation",
+		class:       ReturnClassTransient,
+	},
+	ERROR_RESET_TYPE_NOT_SUPPORTED: {
+		name:        "ERROR_RESET_TYPE_NOT_SUPPORTED",
+		description: "Reset not supported for given device/parameters",
+		class:       ReturnClassPermanent,
+	},
+	ERROR_UNKNOWN: {
+		name:        "ERROR_UNKNOWN",
+		description: "An internal driver error occurred",
+		class:       ReturnClassUnknown,
+	},
+}
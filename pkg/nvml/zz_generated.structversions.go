@@ -0,0 +1,1065 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+import "unsafe"
+
+// The versions of the versioned structs, as defined by the corresponding
+// macros in nvml.h. These are computed from the size of the structs at
+// compile time.
+const (
+	// PciInfoExt_v1Version is the version of PciInfoExt_v1 (nvmlPciInfoExt_v1).
+	PciInfoExt_v1Version uint32 = uint32(unsafe.Sizeof(PciInfoExt_v1{})) | 1<<24
+
+	// PciInfoExtVersion is the version of PciInfoExt (nvmlPciInfoExt_v1).
+	PciInfoExtVersion uint32 = uint32(unsafe.Sizeof(PciInfoExt{})) | 1<<24
+
+	// Memory_v2Version is the version of Memory_v2 (nvmlMemory_v2).
+	Memory_v2Version uint32 = uint32(unsafe.Sizeof(Memory_v2{})) | 2<<24
+
+	// ProcessDetailList_v1Version is the version of ProcessDetailList_v1 (nvmlProcessDetailList_v1).
+	ProcessDetailList_v1Version uint32 = uint32(unsafe.Sizeof(ProcessDetailList_v1{})) | 1<<24
+
+	// ProcessDetailListVersion is the version of ProcessDetailList (nvmlProcessDetailList_v1).
+	ProcessDetailListVersion uint32 = uint32(unsafe.Sizeof(ProcessDetailList{})) | 1<<24
+
+	// C2cModeInfo_v1Version is the version of C2cModeInfo_v1 (nvmlC2cModeInfo_v1).
+	C2cModeInfo_v1Version uint32 = uint32(unsafe.Sizeof(C2cModeInfo_v1{})) | 1<<24
+
+	// DeviceAddressingMode_v1Version is the version of DeviceAddressingMode_v1 (nvmlDeviceAddressingMode_v1).
+	DeviceAddressingMode_v1Version uint32 = uint32(unsafe.Sizeof(DeviceAddressingMode_v1{})) | 1<<24
+
+	// DeviceAddressingModeVersion is the version of DeviceAddressingMode (nvmlDeviceAddressingMode_v1).
+	DeviceAddressingModeVersion uint32 = uint32(unsafe.Sizeof(DeviceAddressingMode{})) | 1<<24
+
+	// RepairStatus_v1Version is the version of RepairStatus_v1 (nvmlRepairStatus_v1).
+	RepairStatus_v1Version uint32 = uint32(unsafe.Sizeof(RepairStatus_v1{})) | 1<<24
+
+	// RepairStatusVersion is the version of RepairStatus (nvmlRepairStatus_v1).
+	RepairStatusVersion uint32 = uint32(unsafe.Sizeof(RepairStatus{})) | 1<<24
+
+	// CoolerInfo_v1Version is the version of CoolerInfo_v1 (nvmlCoolerInfo_v1).
+	CoolerInfo_v1Version uint32 = uint32(unsafe.Sizeof(CoolerInfo_v1{})) | 1<<24
+
+	// CoolerInfoVersion is the version of CoolerInfo (nvmlCoolerInfo_v1).
+	CoolerInfoVersion uint32 = uint32(unsafe.Sizeof(CoolerInfo{})) | 1<<24
+
+	// UUID_v1Version is the version of UUID_v1 (nvmlUUID_v1).
+	UUID_v1Version uint32 = uint32(unsafe.Sizeof(UUID_v1{})) | 1<<24
+
+	// UUIDVersion is the version of UUID (nvmlUUID_v1).
+	UUIDVersion uint32 = uint32(unsafe.Sizeof(UUID{})) | 1<<24
+
+	// Pdi_v1Version is the version of Pdi_v1 (nvmlPdi_v1).
+	Pdi_v1Version uint32 = uint32(unsafe.Sizeof(Pdi_v1{})) | 1<<24
+
+	// PdiVersion is the version of Pdi (nvmlPdi_v1).
+	PdiVersion uint32 = uint32(unsafe.Sizeof(Pdi{})) | 1<<24
+
+	// DramEncryptionInfo_v1Version is the version of DramEncryptionInfo_v1 (nvmlDramEncryptionInfo_v1).
+	DramEncryptionInfo_v1Version uint32 = uint32(unsafe.Sizeof(DramEncryptionInfo_v1{})) | 1<<24
+
+	// DramEncryptionInfoVersion is the version of DramEncryptionInfo (nvmlDramEncryptionInfo_v1).
+	DramEncryptionInfoVersion uint32 = uint32(unsafe.Sizeof(DramEncryptionInfo{})) | 1<<24
+
+	// MarginTemperature_v1Version is the version of MarginTemperature_v1 (nvmlMarginTemperature_v1).
+	MarginTemperature_v1Version uint32 = uint32(unsafe.Sizeof(MarginTemperature_v1{})) | 1<<24
+
+	// MarginTemperatureVersion is the version of MarginTemperature (nvmlMarginTemperature_v1).
+	MarginTemperatureVersion uint32 = uint32(unsafe.Sizeof(MarginTemperature{})) | 1<<24
+
+	// ClockOffset_v1Version is the version of ClockOffset_v1 (nvmlClockOffset_v1).
+	ClockOffset_v1Version uint32 = uint32(unsafe.Sizeof(ClockOffset_v1{})) | 1<<24
+
+	// ClockOffsetVersion is the version of ClockOffset (nvmlClockOffset_v1).
+	ClockOffsetVersion uint32 = uint32(unsafe.Sizeof(ClockOffset{})) | 1<<24
+
+	// FanSpeedInfo_v1Version is the version of FanSpeedInfo_v1 (nvmlFanSpeedInfo_v1).
+	FanSpeedInfo_v1Version uint32 = uint32(unsafe.Sizeof(FanSpeedInfo_v1{})) | 1<<24
+
+	// FanSpeedInfoVersion is the version of FanSpeedInfo (nvmlFanSpeedInfo_v1).
+	FanSpeedInfoVersion uint32 = uint32(unsafe.Sizeof(FanSpeedInfo{})) | 1<<24
+
+	// DevicePerfModes_v1Version is the version of DevicePerfModes_v1 (nvmlDevicePerfModes_v1).
+	DevicePerfModes_v1Version uint32 = uint32(unsafe.Sizeof(DevicePerfModes_v1{})) | 1<<24
+
+	// DevicePerfModesVersion is the version of DevicePerfModes (nvmlDevicePerfModes_v1).
+	DevicePerfModesVersion uint32 = uint32(unsafe.Sizeof(DevicePerfModes{})) | 1<<24
+
+	// DeviceCurrentClockFreqs_v1Version is the version of DeviceCurrentClockFreqs_v1 (nvmlDeviceCurrentClockFreqs_v1).
+	DeviceCurrentClockFreqs_v1Version uint32 = uint32(unsafe.Sizeof(DeviceCurrentClockFreqs_v1{})) | 1<<24
+
+	// DeviceCurrentClockFreqsVersion is the version of DeviceCurrentClockFreqs (nvmlDeviceCurrentClockFreqs_v1).
+	DeviceCurrentClockFreqsVersion uint32 = uint32(unsafe.Sizeof(DeviceCurrentClockFreqs{})) | 1<<24
+
+	// ProcessesUtilizationInfo_v1Version is the version of ProcessesUtilizationInfo_v1 (nvmlProcessesUtilizationInfo_v1).
+	ProcessesUtilizationInfo_v1Version uint32 = uint32(unsafe.Sizeof(ProcessesUtilizationInfo_v1{})) | 1<<24
+
+	// ProcessesUtilizationInfoVersion is the version of ProcessesUtilizationInfo (nvmlProcessesUtilizationInfo_v1).
+	ProcessesUtilizationInfoVersion uint32 = uint32(unsafe.Sizeof(ProcessesUtilizationInfo{})) | 1<<24
+
+	// EccSramErrorStatus_v1Version is the version of EccSramErrorStatus_v1 (nvmlEccSramErrorStatus_v1).
+	EccSramErrorStatus_v1Version uint32 = uint32(unsafe.Sizeof(EccSramErrorStatus_v1{})) | 1<<24
+
+	// EccSramErrorStatusVersion is the version of EccSramErrorStatus (nvmlEccSramErrorStatus_v1).
+	EccSramErrorStatusVersion uint32 = uint32(unsafe.Sizeof(EccSramErrorStatus{})) | 1<<24
+
+	// PlatformInfo_v1Version is the version of PlatformInfo_v1 (nvmlPlatformInfo_v1).
+	PlatformInfo_v1Version uint32 = uint32(unsafe.Sizeof(PlatformInfo_v1{})) | 1<<24
+
+	// PlatformInfo_v2Version is the version of PlatformInfo_v2 (nvmlPlatformInfo_v2).
+	PlatformInfo_v2Version uint32 = uint32(unsafe.Sizeof(PlatformInfo_v2{})) | 2<<24
+
+	// PlatformInfoVersion is the version of PlatformInfo (nvmlPlatformInfo_v2).
+	PlatformInfoVersion uint32 = uint32(unsafe.Sizeof(PlatformInfo{})) | 2<<24
+
+	// EccSramUniqueUncorrectedErrorCounts_v1Version is the version of EccSramUniqueUncorrectedErrorCounts_v1 (nvmlEccSramUniqueUncorrectedErrorCounts_v1).
+	EccSramUniqueUncorrectedErrorCounts_v1Version uint32 = uint32(unsafe.Sizeof(EccSramUniqueUncorrectedErrorCounts_v1{})) | 1<<24
+
+	// EccSramUniqueUncorrectedErrorCountsVersion is the version of EccSramUniqueUncorrectedErrorCounts (nvmlEccSramUniqueUncorrectedErrorCounts_v1).
+	EccSramUniqueUncorrectedErrorCountsVersion uint32 = uint32(unsafe.Sizeof(EccSramUniqueUncorrectedErrorCounts{})) | 1<<24
+
+	// PowerValue_v2Version is the version of PowerValue_v2 (nvmlPowerValue_v2).
+	PowerValue_v2Version uint32 = uint32(unsafe.Sizeof(PowerValue_v2{})) | 2<<24
+
+	// VgpuHeterogeneousMode_v1Version is the version of VgpuHeterogeneousMode_v1 (nvmlVgpuHeterogeneousMode_v1).
+	VgpuHeterogeneousMode_v1Version uint32 = uint32(unsafe.Sizeof(VgpuHeterogeneousMode_v1{})) | 1<<24
+
+	// VgpuHeterogeneousModeVersion is the version of VgpuHeterogeneousMode (nvmlVgpuHeterogeneousMode_v1).
+	VgpuHeterogeneousModeVersion uint32 = uint32(unsafe.Sizeof(VgpuHeterogeneousMode{})) | 1<<24
+
+	// VgpuPlacementId_v1Version is the version of VgpuPlacementId_v1 (nvmlVgpuPlacementId_v1).
+	VgpuPlacementId_v1Version uint32 = uint32(unsafe.Sizeof(VgpuPlacementId_v1{})) | 1<<24
+
+	// VgpuPlacementIdVersion is the version of VgpuPlacementId (nvmlVgpuPlacementId_v1).
+	VgpuPlacementIdVersion uint32 = uint32(unsafe.Sizeof(VgpuPlacementId{})) | 1<<24
+
+	// VgpuPlacementList_v1Version is the version of VgpuPlacementList_v1 (nvmlVgpuPlacementList_v1).
+	VgpuPlacementList_v1Version uint32 = uint32(unsafe.Sizeof(VgpuPlacementList_v1{})) | 1<<24
+
+	// VgpuPlacementList_v2Version is the version of VgpuPlacementList_v2 (nvmlVgpuPlacementList_v2).
+	VgpuPlacementList_v2Version uint32 = uint32(unsafe.Sizeof(VgpuPlacementList_v2{})) | 2<<24
+
+	// VgpuPlacementListVersion is the version of VgpuPlacementList (nvmlVgpuPlacementList_v2).
+	VgpuPlacementListVersion uint32 = uint32(unsafe.Sizeof(VgpuPlacementList{})) | 2<<24
+
+	// VgpuTypeBar1Info_v1Version is the version of VgpuTypeBar1Info_v1 (nvmlVgpuTypeBar1Info_v1).
+	VgpuTypeBar1Info_v1Version uint32 = uint32(unsafe.Sizeof(VgpuTypeBar1Info_v1{})) | 1<<24
+
+	// VgpuTypeBar1InfoVersion is the version of VgpuTypeBar1Info (nvmlVgpuTypeBar1Info_v1).
+	VgpuTypeBar1InfoVersion uint32 = uint32(unsafe.Sizeof(VgpuTypeBar1Info{})) | 1<<24
+
+	// VgpuInstancesUtilizationInfo_v1Version is the version of VgpuInstancesUtilizationInfo_v1 (nvmlVgpuInstancesUtilizationInfo_v1).
+	VgpuInstancesUtilizationInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuInstancesUtilizationInfo_v1{})) | 1<<24
+
+	// VgpuInstancesUtilizationInfoVersion is the version of VgpuInstancesUtilizationInfo (nvmlVgpuInstancesUtilizationInfo_v1).
+	VgpuInstancesUtilizationInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuInstancesUtilizationInfo{})) | 1<<24
+
+	// VgpuProcessesUtilizationInfo_v1Version is the version of VgpuProcessesUtilizationInfo_v1 (nvmlVgpuProcessesUtilizationInfo_v1).
+	VgpuProcessesUtilizationInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuProcessesUtilizationInfo_v1{})) | 1<<24
+
+	// VgpuProcessesUtilizationInfoVersion is the version of VgpuProcessesUtilizationInfo (nvmlVgpuProcessesUtilizationInfo_v1).
+	VgpuProcessesUtilizationInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuProcessesUtilizationInfo{})) | 1<<24
+
+	// VgpuRuntimeState_v1Version is the version of VgpuRuntimeState_v1 (nvmlVgpuRuntimeState_v1).
+	VgpuRuntimeState_v1Version uint32 = uint32(unsafe.Sizeof(VgpuRuntimeState_v1{})) | 1<<24
+
+	// VgpuRuntimeStateVersion is the version of VgpuRuntimeState (nvmlVgpuRuntimeState_v1).
+	VgpuRuntimeStateVersion uint32 = uint32(unsafe.Sizeof(VgpuRuntimeState{})) | 1<<24
+
+	// VgpuTypeIdInfo_v1Version is the version of VgpuTypeIdInfo_v1 (nvmlVgpuTypeIdInfo_v1).
+	VgpuTypeIdInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuTypeIdInfo_v1{})) | 1<<24
+
+	// VgpuTypeIdInfoVersion is the version of VgpuTypeIdInfo (nvmlVgpuTypeIdInfo_v1).
+	VgpuTypeIdInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuTypeIdInfo{})) | 1<<24
+
+	// VgpuTypeMaxInstance_v1Version is the version of VgpuTypeMaxInstance_v1 (nvmlVgpuTypeMaxInstance_v1).
+	VgpuTypeMaxInstance_v1Version uint32 = uint32(unsafe.Sizeof(VgpuTypeMaxInstance_v1{})) | 1<<24
+
+	// VgpuTypeMaxInstanceVersion is the version of VgpuTypeMaxInstance (nvmlVgpuTypeMaxInstance_v1).
+	VgpuTypeMaxInstanceVersion uint32 = uint32(unsafe.Sizeof(VgpuTypeMaxInstance{})) | 1<<24
+
+	// ActiveVgpuInstanceInfo_v1Version is the version of ActiveVgpuInstanceInfo_v1 (nvmlActiveVgpuInstanceInfo_v1).
+	ActiveVgpuInstanceInfo_v1Version uint32 = uint32(unsafe.Sizeof(ActiveVgpuInstanceInfo_v1{})) | 1<<24
+
+	// ActiveVgpuInstanceInfoVersion is the version of ActiveVgpuInstanceInfo (nvmlActiveVgpuInstanceInfo_v1).
+	ActiveVgpuInstanceInfoVersion uint32 = uint32(unsafe.Sizeof(ActiveVgpuInstanceInfo{})) | 1<<24
+
+	// VgpuSchedulerState_v1Version is the version of VgpuSchedulerState_v1 (nvmlVgpuSchedulerState_v1).
+	VgpuSchedulerState_v1Version uint32 = uint32(unsafe.Sizeof(VgpuSchedulerState_v1{})) | 1<<24
+
+	// VgpuSchedulerStateVersion is the version of VgpuSchedulerState (nvmlVgpuSchedulerState_v1).
+	VgpuSchedulerStateVersion uint32 = uint32(unsafe.Sizeof(VgpuSchedulerState{})) | 1<<24
+
+	// VgpuSchedulerStateInfo_v1Version is the version of VgpuSchedulerStateInfo_v1 (nvmlVgpuSchedulerStateInfo_v1).
+	VgpuSchedulerStateInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuSchedulerStateInfo_v1{})) | 1<<24
+
+	// VgpuSchedulerStateInfoVersion is the version of VgpuSchedulerStateInfo (nvmlVgpuSchedulerStateInfo_v1).
+	VgpuSchedulerStateInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuSchedulerStateInfo{})) | 1<<24
+
+	// VgpuSchedulerLogInfo_v1Version is the version of VgpuSchedulerLogInfo_v1 (nvmlVgpuSchedulerLogInfo_v1).
+	VgpuSchedulerLogInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuSchedulerLogInfo_v1{})) | 1<<24
+
+	// VgpuSchedulerLogInfoVersion is the version of VgpuSchedulerLogInfo (nvmlVgpuSchedulerLogInfo_v1).
+	VgpuSchedulerLogInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuSchedulerLogInfo{})) | 1<<24
+
+	// VgpuCreatablePlacementInfo_v1Version is the version of VgpuCreatablePlacementInfo_v1 (nvmlVgpuCreatablePlacementInfo_v1).
+	VgpuCreatablePlacementInfo_v1Version uint32 = uint32(unsafe.Sizeof(VgpuCreatablePlacementInfo_v1{})) | 1<<24
+
+	// VgpuCreatablePlacementInfoVersion is the version of VgpuCreatablePlacementInfo (nvmlVgpuCreatablePlacementInfo_v1).
+	VgpuCreatablePlacementInfoVersion uint32 = uint32(unsafe.Sizeof(VgpuCreatablePlacementInfo{})) | 1<<24
+
+	// SystemEventSetCreateRequest_v1Version is the version of SystemEventSetCreateRequest_v1 (nvmlSystemEventSetCreateRequest_v1).
+	SystemEventSetCreateRequest_v1Version uint32 = uint32(unsafe.Sizeof(SystemEventSetCreateRequest_v1{})) | 1<<24
+
+	// SystemEventSetCreateRequestVersion is the version of SystemEventSetCreateRequest (nvmlSystemEventSetCreateRequest_v1).
+	SystemEventSetCreateRequestVersion uint32 = uint32(unsafe.Sizeof(SystemEventSetCreateRequest{})) | 1<<24
+
+	// SystemEventSetFreeRequest_v1Version is the version of SystemEventSetFreeRequest_v1 (nvmlSystemEventSetFreeRequest_v1).
+	SystemEventSetFreeRequest_v1Version uint32 = uint32(unsafe.Sizeof(SystemEventSetFreeRequest_v1{})) | 1<<24
+
+	// SystemEventSetFreeRequestVersion is the version of SystemEventSetFreeRequest (nvmlSystemEventSetFreeRequest_v1).
+	SystemEventSetFreeRequestVersion uint32 = uint32(unsafe.Sizeof(SystemEventSetFreeRequest{})) | 1<<24
+
+	// SystemRegisterEventRequest_v1Version is the version of SystemRegisterEventRequest_v1 (nvmlSystemRegisterEventRequest_v1).
+	SystemRegisterEventRequest_v1Version uint32 = uint32(unsafe.Sizeof(SystemRegisterEventRequest_v1{})) | 1<<24
+
+	// SystemRegisterEventRequestVersion is the version of SystemRegisterEventRequest (nvmlSystemRegisterEventRequest_v1).
+	SystemRegisterEventRequestVersion uint32 = uint32(unsafe.Sizeof(SystemRegisterEventRequest{})) | 1<<24
+
+	// SystemEventSetWaitRequest_v1Version is the version of SystemEventSetWaitRequest_v1 (nvmlSystemEventSetWaitRequest_v1).
+	SystemEventSetWaitRequest_v1Version uint32 = uint32(unsafe.Sizeof(SystemEventSetWaitRequest_v1{})) | 1<<24
+
+	// SystemEventSetWaitRequestVersion is the version of SystemEventSetWaitRequest (nvmlSystemEventSetWaitRequest_v1).
+	SystemEventSetWaitRequestVersion uint32 = uint32(unsafe.Sizeof(SystemEventSetWaitRequest{})) | 1<<24
+
+	// SystemConfComputeSettings_v1Version is the version of SystemConfComputeSettings_v1 (nvmlSystemConfComputeSettings_v1).
+	SystemConfComputeSettings_v1Version uint32 = uint32(unsafe.Sizeof(SystemConfComputeSettings_v1{})) | 1<<24
+
+	// SystemConfComputeSettingsVersion is the version of SystemConfComputeSettings (nvmlSystemConfComputeSettings_v1).
+	SystemConfComputeSettingsVersion uint32 = uint32(unsafe.Sizeof(SystemConfComputeSettings{})) | 1<<24
+
+	// ConfComputeSetKeyRotationThresholdInfo_v1Version is the version of ConfComputeSetKeyRotationThresholdInfo_v1 (nvmlConfComputeSetKeyRotationThresholdInfo_v1).
+	ConfComputeSetKeyRotationThresholdInfo_v1Version uint32 = uint32(unsafe.Sizeof(ConfComputeSetKeyRotationThresholdInfo_v1{})) | 1<<24
+
+	// ConfComputeSetKeyRotationThresholdInfoVersion is the version of ConfComputeSetKeyRotationThresholdInfo (nvmlConfComputeSetKeyRotationThresholdInfo_v1).
+	ConfComputeSetKeyRotationThresholdInfoVersion uint32 = uint32(unsafe.Sizeof(ConfComputeSetKeyRotationThresholdInfo{})) | 1<<24
+
+	// ConfComputeGetKeyRotationThresholdInfo_v1Version is the version of ConfComputeGetKeyRotationThresholdInfo_v1 (nvmlConfComputeGetKeyRotationThresholdInfo_v1).
+	ConfComputeGetKeyRotationThresholdInfo_v1Version uint32 = uint32(unsafe.Sizeof(ConfComputeGetKeyRotationThresholdInfo_v1{})) | 1<<24
+
+	// ConfComputeGetKeyRotationThresholdInfoVersion is the version of ConfComputeGetKeyRotationThresholdInfo (nvmlConfComputeGetKeyRotationThresholdInfo_v1).
+	ConfComputeGetKeyRotationThresholdInfoVersion uint32 = uint32(unsafe.Sizeof(ConfComputeGetKeyRotationThresholdInfo{})) | 1<<24
+
+	// GpuFabricInfo_v2Version is the version of GpuFabricInfo_v2 (nvmlGpuFabricInfo_v2).
+	GpuFabricInfo_v2Version uint32 = uint32(unsafe.Sizeof(GpuFabricInfo_v2{})) | 2<<24
+
+	// GpuFabricInfo_v3Version is the version of GpuFabricInfo_v3 (nvmlGpuFabricInfo_v3).
+	GpuFabricInfo_v3Version uint32 = uint32(unsafe.Sizeof(GpuFabricInfo_v3{})) | 3<<24
+
+	// GpuFabricInfoVVersion is the version of GpuFabricInfoV (nvmlGpuFabricInfo_v3).
+	GpuFabricInfoVVersion uint32 = uint32(unsafe.Sizeof(GpuFabricInfoV{})) | 3<<24
+
+	// SystemDriverBranchInfo_v1Version is the version of SystemDriverBranchInfo_v1 (nvmlSystemDriverBranchInfo_v1).
+	SystemDriverBranchInfo_v1Version uint32 = uint32(unsafe.Sizeof(SystemDriverBranchInfo_v1{})) | 1<<24
+
+	// SystemDriverBranchInfoVersion is the version of SystemDriverBranchInfo (nvmlSystemDriverBranchInfo_v1).
+	SystemDriverBranchInfoVersion uint32 = uint32(unsafe.Sizeof(SystemDriverBranchInfo{})) | 1<<24
+
+	// Temperature_v1Version is the version of Temperature_v1 (nvmlTemperature_v1).
+	Temperature_v1Version uint32 = uint32(unsafe.Sizeof(Temperature_v1{})) | 1<<24
+
+	// TemperatureVersion is the version of Temperature (nvmlTemperature_v1).
+	TemperatureVersion uint32 = uint32(unsafe.Sizeof(Temperature{})) | 1<<24
+
+	// NvlinkSupportedBwModes_v1Version is the version of NvlinkSupportedBwModes_v1 (nvmlNvlinkSupportedBwModes_v1).
+	NvlinkSupportedBwModes_v1Version uint32 = uint32(unsafe.Sizeof(NvlinkSupportedBwModes_v1{})) | 1<<24
+
+	// NvlinkSupportedBwModesVersion is the version of NvlinkSupportedBwModes (nvmlNvlinkSupportedBwModes_v1).
+	NvlinkSupportedBwModesVersion uint32 = uint32(unsafe.Sizeof(NvlinkSupportedBwModes{})) | 1<<24
+
+	// NvlinkGetBwMode_v1Version is the version of NvlinkGetBwMode_v1 (nvmlNvlinkGetBwMode_v1).
+	NvlinkGetBwMode_v1Version uint32 = uint32(unsafe.Sizeof(NvlinkGetBwMode_v1{})) | 1<<24
+
+	// NvlinkGetBwModeVersion is the version of NvlinkGetBwMode (nvmlNvlinkGetBwMode_v1).
+	NvlinkGetBwModeVersion uint32 = uint32(unsafe.Sizeof(NvlinkGetBwMode{})) | 1<<24
+
+	// NvlinkSetBwMode_v1Version is the version of NvlinkSetBwMode_v1 (nvmlNvlinkSetBwMode_v1).
+	NvlinkSetBwMode_v1Version uint32 = uint32(unsafe.Sizeof(NvlinkSetBwMode_v1{})) | 1<<24
+
+	// NvlinkSetBwModeVersion is the version of NvlinkSetBwMode (nvmlNvlinkSetBwMode_v1).
+	NvlinkSetBwModeVersion uint32 = uint32(unsafe.Sizeof(NvlinkSetBwMode{})) | 1<<24
+
+	// NvLinkInfo_v1Version is the version of NvLinkInfo_v1 (nvmlNvLinkInfo_v1).
+	NvLinkInfo_v1Version uint32 = uint32(unsafe.Sizeof(NvLinkInfo_v1{})) | 1<<24
+
+	// NvLinkInfo_v2Version is the version of NvLinkInfo_v2 (nvmlNvLinkInfo_v2).
+	NvLinkInfo_v2Version uint32 = uint32(unsafe.Sizeof(NvLinkInfo_v2{})) | 2<<24
+
+	// NvLinkInfoVersion is the version of NvLinkInfo (nvmlNvLinkInfo_v2).
+	NvLinkInfoVersion uint32 = uint32(unsafe.Sizeof(NvLinkInfo{})) | 2<<24
+
+	// GpuInstanceProfileInfo_v2Version is the version of GpuInstanceProfileInfo_v2 (nvmlGpuInstanceProfileInfo_v2).
+	GpuInstanceProfileInfo_v2Version uint32 = uint32(unsafe.Sizeof(GpuInstanceProfileInfo_v2{})) | 2<<24
+
+	// GpuInstanceProfileInfo_v3Version is the version of GpuInstanceProfileInfo_v3 (nvmlGpuInstanceProfileInfo_v3).
+	GpuInstanceProfileInfo_v3Version uint32 = uint32(unsafe.Sizeof(GpuInstanceProfileInfo_v3{})) | 3<<24
+
+	// ComputeInstanceProfileInfo_v2Version is the version of ComputeInstanceProfileInfo_v2 (nvmlComputeInstanceProfileInfo_v2).
+	ComputeInstanceProfileInfo_v2Version uint32 = uint32(unsafe.Sizeof(ComputeInstanceProfileInfo_v2{})) | 2<<24
+
+	// ComputeInstanceProfileInfo_v3Version is the version of ComputeInstanceProfileInfo_v3 (nvmlComputeInstanceProfileInfo_v3).
+	ComputeInstanceProfileInfo_v3Version uint32 = uint32(unsafe.Sizeof(ComputeInstanceProfileInfo_v3{})) | 3<<24
+
+	// DeviceCapabilities_v1Version is the version of DeviceCapabilities_v1 (nvmlDeviceCapabilities_v1).
+	DeviceCapabilities_v1Version uint32 = uint32(unsafe.Sizeof(DeviceCapabilities_v1{})) | 1<<24
+
+	// DeviceCapabilitiesVersion is the version of DeviceCapabilities (nvmlDeviceCapabilities_v1).
+	DeviceCapabilitiesVersion uint32 = uint32(unsafe.Sizeof(DeviceCapabilities{})) | 1<<24
+
+	// WorkloadPowerProfileInfo_v1Version is the version of WorkloadPowerProfileInfo_v1 (nvmlWorkloadPowerProfileInfo_v1).
+	WorkloadPowerProfileInfo_v1Version uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileInfo_v1{})) | 1<<24
+
+	// WorkloadPowerProfileInfoVersion is the version of WorkloadPowerProfileInfo (nvmlWorkloadPowerProfileInfo_v1).
+	WorkloadPowerProfileInfoVersion uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileInfo{})) | 1<<24
+
+	// WorkloadPowerProfileProfilesInfo_v1Version is the version of WorkloadPowerProfileProfilesInfo_v1 (nvmlWorkloadPowerProfileProfilesInfo_v1).
+	WorkloadPowerProfileProfilesInfo_v1Version uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileProfilesInfo_v1{})) | 1<<24
+
+	// WorkloadPowerProfileProfilesInfoVersion is the version of WorkloadPowerProfileProfilesInfo (nvmlWorkloadPowerProfileProfilesInfo_v1).
+	WorkloadPowerProfileProfilesInfoVersion uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileProfilesInfo{})) | 1<<24
+
+	// WorkloadPowerProfileCurrentProfiles_v1Version is the version of WorkloadPowerProfileCurrentProfiles_v1 (nvmlWorkloadPowerProfileCurrentProfiles_v1).
+	WorkloadPowerProfileCurrentProfiles_v1Version uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileCurrentProfiles_v1{})) | 1<<24
+
+	// WorkloadPowerProfileCurrentProfilesVersion is the version of WorkloadPowerProfileCurrentProfiles (nvmlWorkloadPowerProfileCurrentProfiles_v1).
+	WorkloadPowerProfileCurrentProfilesVersion uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileCurrentProfiles{})) | 1<<24
+
+	// WorkloadPowerProfileRequestedProfiles_v1Version is the version of WorkloadPowerProfileRequestedProfiles_v1 (nvmlWorkloadPowerProfileRequestedProfiles_v1).
+	WorkloadPowerProfileRequestedProfiles_v1Version uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileRequestedProfiles_v1{})) | 1<<24
+
+	// WorkloadPowerProfileRequestedProfilesVersion is the version of WorkloadPowerProfileRequestedProfiles (nvmlWorkloadPowerProfileRequestedProfiles_v1).
+	WorkloadPowerProfileRequestedProfilesVersion uint32 = uint32(unsafe.Sizeof(WorkloadPowerProfileRequestedProfiles{})) | 1<<24
+
+	// PowerSmoothingProfile_v1Version is the version of PowerSmoothingProfile_v1 (nvmlPowerSmoothingProfile_v1).
+	PowerSmoothingProfile_v1Version uint32 = uint32(unsafe.Sizeof(PowerSmoothingProfile_v1{})) | 1<<24
+
+	// PowerSmoothingProfileVersion is the version of PowerSmoothingProfile (nvmlPowerSmoothingProfile_v1).
+	PowerSmoothingProfileVersion uint32 = uint32(unsafe.Sizeof(PowerSmoothingProfile{})) | 1<<24
+
+	// PowerSmoothingState_v1Version is the version of PowerSmoothingState_v1 (nvmlPowerSmoothingState_v1).
+	PowerSmoothingState_v1Version uint32 = uint32(unsafe.Sizeof(PowerSmoothingState_v1{})) | 1<<24
+
+	// PowerSmoothingStateVersion is the version of PowerSmoothingState (nvmlPowerSmoothingState_v1).
+	PowerSmoothingStateVersion uint32 = uint32(unsafe.Sizeof(PowerSmoothingState{})) | 1<<24
+)
+
+// NewPciInfoExt_v1 returns a PciInfoExt_v1 with its Version set to PciInfoExt_v1Version.
+func NewPciInfoExt_v1() PciInfoExt_v1 {
+	return PciInfoExt_v1{Version: PciInfoExt_v1Version}
+}
+
+// NewPciInfoExt returns a PciInfoExt with its Version set to PciInfoExtVersion.
+func NewPciInfoExt() PciInfoExt {
+	return PciInfoExt{Version: PciInfoExtVersion}
+}
+
+// NewMemory_v2 returns a Memory_v2 with its Version set to Memory_v2Version.
+func NewMemory_v2() Memory_v2 {
+	return Memory_v2{Version: Memory_v2Version}
+}
+
+// NewProcessDetailList_v1 returns a ProcessDetailList_v1 with its Version set to ProcessDetailList_v1Version.
+func NewProcessDetailList_v1() ProcessDetailList_v1 {
+	return ProcessDetailList_v1{Version: ProcessDetailList_v1Version}
+}
+
+// NewProcessDetailList returns a ProcessDetailList with its Version set to ProcessDetailListVersion.
+func NewProcessDetailList() ProcessDetailList {
+	return ProcessDetailList{Version: ProcessDetailListVersion}
+}
+
+// NewDeviceAddressingMode_v1 returns a DeviceAddressingMode_v1 with its Version set to DeviceAddressingMode_v1Version.
+func NewDeviceAddressingMode_v1() DeviceAddressingMode_v1 {
+	return DeviceAddressingMode_v1{Version: DeviceAddressingMode_v1Version}
+}
+
+// NewDeviceAddressingMode returns a DeviceAddressingMode with its Version set to DeviceAddressingModeVersion.
+func NewDeviceAddressingMode() DeviceAddressingMode {
+	return DeviceAddressingMode{Version: DeviceAddressingModeVersion}
+}
+
+// NewRepairStatus_v1 returns a RepairStatus_v1 with its Version set to RepairStatus_v1Version.
+func NewRepairStatus_v1() RepairStatus_v1 {
+	return RepairStatus_v1{Version: RepairStatus_v1Version}
+}
+
+// NewRepairStatus returns a RepairStatus with its Version set to RepairStatusVersion.
+func NewRepairStatus() RepairStatus {
+	return RepairStatus{Version: RepairStatusVersion}
+}
+
+// NewCoolerInfo_v1 returns a CoolerInfo_v1 with its Version set to CoolerInfo_v1Version.
+func NewCoolerInfo_v1() CoolerInfo_v1 {
+	return CoolerInfo_v1{Version: CoolerInfo_v1Version}
+}
+
+// NewCoolerInfo returns a CoolerInfo with its Version set to CoolerInfoVersion.
+func NewCoolerInfo() CoolerInfo {
+	return CoolerInfo{Version: CoolerInfoVersion}
+}
+
+// NewUUID_v1 returns a UUID_v1 with its Version set to UUID_v1Version.
+func NewUUID_v1() UUID_v1 {
+	return UUID_v1{Version: UUID_v1Version}
+}
+
+// NewUUID returns a UUID with its Version set to UUIDVersion.
+func NewUUID() UUID {
+	return UUID{Version: UUIDVersion}
+}
+
+// NewPdi_v1 returns a Pdi_v1 with its Version set to Pdi_v1Version.
+func NewPdi_v1() Pdi_v1 {
+	return Pdi_v1{Version: Pdi_v1Version}
+}
+
+// NewPdi returns a Pdi with its Version set to PdiVersion.
+func NewPdi() Pdi {
+	return Pdi{Version: PdiVersion}
+}
+
+// NewDramEncryptionInfo_v1 returns a DramEncryptionInfo_v1 with its Version set to DramEncryptionInfo_v1Version.
+func NewDramEncryptionInfo_v1() DramEncryptionInfo_v1 {
+	return DramEncryptionInfo_v1{Version: DramEncryptionInfo_v1Version}
+}
+
+// NewDramEncryptionInfo returns a DramEncryptionInfo with its Version set to DramEncryptionInfoVersion.
+func NewDramEncryptionInfo() DramEncryptionInfo {
+	return DramEncryptionInfo{Version: DramEncryptionInfoVersion}
+}
+
+// NewMarginTemperature_v1 returns a MarginTemperature_v1 with its Version set to MarginTemperature_v1Version.
+func NewMarginTemperature_v1() MarginTemperature_v1 {
+	return MarginTemperature_v1{Version: MarginTemperature_v1Version}
+}
+
+// NewMarginTemperature returns a MarginTemperature with its Version set to MarginTemperatureVersion.
+func NewMarginTemperature() MarginTemperature {
+	return MarginTemperature{Version: MarginTemperatureVersion}
+}
+
+// NewClockOffset_v1 returns a ClockOffset_v1 with its Version set to ClockOffset_v1Version.
+func NewClockOffset_v1() ClockOffset_v1 {
+	return ClockOffset_v1{Version: ClockOffset_v1Version}
+}
+
+// NewClockOffset returns a ClockOffset with its Version set to ClockOffsetVersion.
+func NewClockOffset() ClockOffset {
+	return ClockOffset{Version: ClockOffsetVersion}
+}
+
+// NewFanSpeedInfo_v1 returns a FanSpeedInfo_v1 with its Version set to FanSpeedInfo_v1Version.
+func NewFanSpeedInfo_v1() FanSpeedInfo_v1 {
+	return FanSpeedInfo_v1{Version: FanSpeedInfo_v1Version}
+}
+
+// NewFanSpeedInfo returns a FanSpeedInfo with its Version set to FanSpeedInfoVersion.
+func NewFanSpeedInfo() FanSpeedInfo {
+	return FanSpeedInfo{Version: FanSpeedInfoVersion}
+}
+
+// NewDevicePerfModes_v1 returns a DevicePerfModes_v1 with its Version set to DevicePerfModes_v1Version.
+func NewDevicePerfModes_v1() DevicePerfModes_v1 {
+	return DevicePerfModes_v1{Version: DevicePerfModes_v1Version}
+}
+
+// NewDevicePerfModes returns a DevicePerfModes with its Version set to DevicePerfModesVersion.
+func NewDevicePerfModes() DevicePerfModes {
+	return DevicePerfModes{Version: DevicePerfModesVersion}
+}
+
+// NewDeviceCurrentClockFreqs_v1 returns a DeviceCurrentClockFreqs_v1 with its Version set to DeviceCurrentClockFreqs_v1Version.
+func NewDeviceCurrentClockFreqs_v1() DeviceCurrentClockFreqs_v1 {
+	return DeviceCurrentClockFreqs_v1{Version: DeviceCurrentClockFreqs_v1Version}
+}
+
+// NewDeviceCurrentClockFreqs returns a DeviceCurrentClockFreqs with its Version set to DeviceCurrentClockFreqsVersion.
+func NewDeviceCurrentClockFreqs() DeviceCurrentClockFreqs {
+	return DeviceCurrentClockFreqs{Version: DeviceCurrentClockFreqsVersion}
+}
+
+// NewProcessesUtilizationInfo_v1 returns a ProcessesUtilizationInfo_v1 with its Version set to ProcessesUtilizationInfo_v1Version.
+func NewProcessesUtilizationInfo_v1() ProcessesUtilizationInfo_v1 {
+	return ProcessesUtilizationInfo_v1{Version: ProcessesUtilizationInfo_v1Version}
+}
+
+// NewProcessesUtilizationInfo returns a ProcessesUtilizationInfo with its Version set to ProcessesUtilizationInfoVersion.
+func NewProcessesUtilizationInfo() ProcessesUtilizationInfo {
+	return ProcessesUtilizationInfo{Version: ProcessesUtilizationInfoVersion}
+}
+
+// NewEccSramErrorStatus_v1 returns a EccSramErrorStatus_v1 with its Version set to EccSramErrorStatus_v1Version.
+func NewEccSramErrorStatus_v1() EccSramErrorStatus_v1 {
+	return EccSramErrorStatus_v1{Version: EccSramErrorStatus_v1Version}
+}
+
+// NewEccSramErrorStatus returns a EccSramErrorStatus with its Version set to EccSramErrorStatusVersion.
+func NewEccSramErrorStatus() EccSramErrorStatus {
+	return EccSramErrorStatus{Version: EccSramErrorStatusVersion}
+}
+
+// NewPlatformInfo_v1 returns a PlatformInfo_v1 with its Version set to PlatformInfo_v1Version.
+func NewPlatformInfo_v1() PlatformInfo_v1 {
+	return PlatformInfo_v1{Version: PlatformInfo_v1Version}
+}
+
+// NewPlatformInfo_v2 returns a PlatformInfo_v2 with its Version set to PlatformInfo_v2Version.
+func NewPlatformInfo_v2() PlatformInfo_v2 {
+	return PlatformInfo_v2{Version: PlatformInfo_v2Version}
+}
+
+// NewPlatformInfo returns a PlatformInfo with its Version set to PlatformInfoVersion.
+func NewPlatformInfo() PlatformInfo {
+	return PlatformInfo{Version: PlatformInfoVersion}
+}
+
+// NewEccSramUniqueUncorrectedErrorCounts_v1 returns a EccSramUniqueUncorrectedErrorCounts_v1 with its Version set to EccSramUniqueUncorrectedErrorCounts_v1Version.
+func NewEccSramUniqueUncorrectedErrorCounts_v1() EccSramUniqueUncorrectedErrorCounts_v1 {
+	return EccSramUniqueUncorrectedErrorCounts_v1{Version: EccSramUniqueUncorrectedErrorCounts_v1Version}
+}
+
+// NewEccSramUniqueUncorrectedErrorCounts returns a EccSramUniqueUncorrectedErrorCounts with its Version set to EccSramUniqueUncorrectedErrorCountsVersion.
+func NewEccSramUniqueUncorrectedErrorCounts() EccSramUniqueUncorrectedErrorCounts {
+	return EccSramUniqueUncorrectedErrorCounts{Version: EccSramUniqueUncorrectedErrorCountsVersion}
+}
+
+// NewPowerValue_v2 returns a PowerValue_v2 with its Version set to PowerValue_v2Version.
+func NewPowerValue_v2() PowerValue_v2 {
+	return PowerValue_v2{Version: PowerValue_v2Version}
+}
+
+// NewVgpuHeterogeneousMode_v1 returns a VgpuHeterogeneousMode_v1 with its Version set to VgpuHeterogeneousMode_v1Version.
+func NewVgpuHeterogeneousMode_v1() VgpuHeterogeneousMode_v1 {
+	return VgpuHeterogeneousMode_v1{Version: VgpuHeterogeneousMode_v1Version}
+}
+
+// NewVgpuHeterogeneousMode returns a VgpuHeterogeneousMode with its Version set to VgpuHeterogeneousModeVersion.
+func NewVgpuHeterogeneousMode() VgpuHeterogeneousMode {
+	return VgpuHeterogeneousMode{Version: VgpuHeterogeneousModeVersion}
+}
+
+// NewVgpuPlacementId_v1 returns a VgpuPlacementId_v1 with its Version set to VgpuPlacementId_v1Version.
+func NewVgpuPlacementId_v1() VgpuPlacementId_v1 {
+	return VgpuPlacementId_v1{Version: VgpuPlacementId_v1Version}
+}
+
+// NewVgpuPlacementId returns a VgpuPlacementId with its Version set to VgpuPlacementIdVersion.
+func NewVgpuPlacementId() VgpuPlacementId {
+	return VgpuPlacementId{Version: VgpuPlacementIdVersion}
+}
+
+// NewVgpuPlacementList_v1 returns a VgpuPlacementList_v1 with its Version set to VgpuPlacementList_v1Version.
+func NewVgpuPlacementList_v1() VgpuPlacementList_v1 {
+	return VgpuPlacementList_v1{Version: VgpuPlacementList_v1Version}
+}
+
+// NewVgpuPlacementList_v2 returns a VgpuPlacementList_v2 with its Version set to VgpuPlacementList_v2Version.
+func NewVgpuPlacementList_v2() VgpuPlacementList_v2 {
+	return VgpuPlacementList_v2{Version: VgpuPlacementList_v2Version}
+}
+
+// NewVgpuPlacementList returns a VgpuPlacementList with its Version set to VgpuPlacementListVersion.
+func NewVgpuPlacementList() VgpuPlacementList {
+	return VgpuPlacementList{Version: VgpuPlacementListVersion}
+}
+
+// NewVgpuTypeBar1Info_v1 returns a VgpuTypeBar1Info_v1 with its Version set to VgpuTypeBar1Info_v1Version.
+func NewVgpuTypeBar1Info_v1() VgpuTypeBar1Info_v1 {
+	return VgpuTypeBar1Info_v1{Version: VgpuTypeBar1Info_v1Version}
+}
+
+// NewVgpuTypeBar1Info returns a VgpuTypeBar1Info with its Version set to VgpuTypeBar1InfoVersion.
+func NewVgpuTypeBar1Info() VgpuTypeBar1Info {
+	return VgpuTypeBar1Info{Version: VgpuTypeBar1InfoVersion}
+}
+
+// NewVgpuInstancesUtilizationInfo_v1 returns a VgpuInstancesUtilizationInfo_v1 with its Version set to VgpuInstancesUtilizationInfo_v1Version.
+func NewVgpuInstancesUtilizationInfo_v1() VgpuInstancesUtilizationInfo_v1 {
+	return VgpuInstancesUtilizationInfo_v1{Version: VgpuInstancesUtilizationInfo_v1Version}
+}
+
+// NewVgpuInstancesUtilizationInfo returns a VgpuInstancesUtilizationInfo with its Version set to VgpuInstancesUtilizationInfoVersion.
+func NewVgpuInstancesUtilizationInfo() VgpuInstancesUtilizationInfo {
+	return VgpuInstancesUtilizationInfo{Version: VgpuInstancesUtilizationInfoVersion}
+}
+
+// NewVgpuProcessesUtilizationInfo_v1 returns a VgpuProcessesUtilizationInfo_v1 with its Version set to VgpuProcessesUtilizationInfo_v1Version.
+func NewVgpuProcessesUtilizationInfo_v1() VgpuProcessesUtilizationInfo_v1 {
+	return VgpuProcessesUtilizationInfo_v1{Version: VgpuProcessesUtilizationInfo_v1Version}
+}
+
+// NewVgpuProcessesUtilizationInfo returns a VgpuProcessesUtilizationInfo with its Version set to VgpuProcessesUtilizationInfoVersion.
+func NewVgpuProcessesUtilizationInfo() VgpuProcessesUtilizationInfo {
+	return VgpuProcessesUtilizationInfo{Version: VgpuProcessesUtilizationInfoVersion}
+}
+
+// NewVgpuRuntimeState_v1 returns a VgpuRuntimeState_v1 with its Version set to VgpuRuntimeState_v1Version.
+func NewVgpuRuntimeState_v1() VgpuRuntimeState_v1 {
+	return VgpuRuntimeState_v1{Version: VgpuRuntimeState_v1Version}
+}
+
+// NewVgpuRuntimeState returns a VgpuRuntimeState with its Version set to VgpuRuntimeStateVersion.
+func NewVgpuRuntimeState() VgpuRuntimeState {
+	return VgpuRuntimeState{Version: VgpuRuntimeStateVersion}
+}
+
+// NewVgpuTypeIdInfo_v1 returns a VgpuTypeIdInfo_v1 with its Version set to VgpuTypeIdInfo_v1Version.
+func NewVgpuTypeIdInfo_v1() VgpuTypeIdInfo_v1 {
+	return VgpuTypeIdInfo_v1{Version: VgpuTypeIdInfo_v1Version}
+}
+
+// NewVgpuTypeIdInfo returns a VgpuTypeIdInfo with its Version set to VgpuTypeIdInfoVersion.
+func NewVgpuTypeIdInfo() VgpuTypeIdInfo {
+	return VgpuTypeIdInfo{Version: VgpuTypeIdInfoVersion}
+}
+
+// NewVgpuTypeMaxInstance_v1 returns a VgpuTypeMaxInstance_v1 with its Version set to VgpuTypeMaxInstance_v1Version.
+func NewVgpuTypeMaxInstance_v1() VgpuTypeMaxInstance_v1 {
+	return VgpuTypeMaxInstance_v1{Version: VgpuTypeMaxInstance_v1Version}
+}
+
+// NewVgpuTypeMaxInstance returns a VgpuTypeMaxInstance with its Version set to VgpuTypeMaxInstanceVersion.
+func NewVgpuTypeMaxInstance() VgpuTypeMaxInstance {
+	return VgpuTypeMaxInstance{Version: VgpuTypeMaxInstanceVersion}
+}
+
+// NewActiveVgpuInstanceInfo_v1 returns a ActiveVgpuInstanceInfo_v1 with its Version set to ActiveVgpuInstanceInfo_v1Version.
+func NewActiveVgpuInstanceInfo_v1() ActiveVgpuInstanceInfo_v1 {
+	return ActiveVgpuInstanceInfo_v1{Version: ActiveVgpuInstanceInfo_v1Version}
+}
+
+// NewActiveVgpuInstanceInfo returns a ActiveVgpuInstanceInfo with its Version set to ActiveVgpuInstanceInfoVersion.
+func NewActiveVgpuInstanceInfo() ActiveVgpuInstanceInfo {
+	return ActiveVgpuInstanceInfo{Version: ActiveVgpuInstanceInfoVersion}
+}
+
+// NewVgpuSchedulerState_v1 returns a VgpuSchedulerState_v1 with its Version set to VgpuSchedulerState_v1Version.
+func NewVgpuSchedulerState_v1() VgpuSchedulerState_v1 {
+	return VgpuSchedulerState_v1{Version: VgpuSchedulerState_v1Version}
+}
+
+// NewVgpuSchedulerState returns a VgpuSchedulerState with its Version set to VgpuSchedulerStateVersion.
+func NewVgpuSchedulerState() VgpuSchedulerState {
+	return VgpuSchedulerState{Version: VgpuSchedulerStateVersion}
+}
+
+// NewVgpuSchedulerStateInfo_v1 returns a VgpuSchedulerStateInfo_v1 with its Version set to VgpuSchedulerStateInfo_v1Version.
+func NewVgpuSchedulerStateInfo_v1() VgpuSchedulerStateInfo_v1 {
+	return VgpuSchedulerStateInfo_v1{Version: VgpuSchedulerStateInfo_v1Version}
+}
+
+// NewVgpuSchedulerStateInfo returns a VgpuSchedulerStateInfo with its Version set to VgpuSchedulerStateInfoVersion.
+func NewVgpuSchedulerStateInfo() VgpuSchedulerStateInfo {
+	return VgpuSchedulerStateInfo{Version: VgpuSchedulerStateInfoVersion}
+}
+
+// NewVgpuSchedulerLogInfo_v1 returns a VgpuSchedulerLogInfo_v1 with its Version set to VgpuSchedulerLogInfo_v1Version.
+func NewVgpuSchedulerLogInfo_v1() VgpuSchedulerLogInfo_v1 {
+	return VgpuSchedulerLogInfo_v1{Version: VgpuSchedulerLogInfo_v1Version}
+}
+
+// NewVgpuSchedulerLogInfo returns a VgpuSchedulerLogInfo with its Version set to VgpuSchedulerLogInfoVersion.
+func NewVgpuSchedulerLogInfo() VgpuSchedulerLogInfo {
+	return VgpuSchedulerLogInfo{Version: VgpuSchedulerLogInfoVersion}
+}
+
+// NewVgpuCreatablePlacementInfo_v1 returns a VgpuCreatablePlacementInfo_v1 with its Version set to VgpuCreatablePlacementInfo_v1Version.
+func NewVgpuCreatablePlacementInfo_v1() VgpuCreatablePlacementInfo_v1 {
+	return VgpuCreatablePlacementInfo_v1{Version: VgpuCreatablePlacementInfo_v1Version}
+}
+
+// NewVgpuCreatablePlacementInfo returns a VgpuCreatablePlacementInfo with its Version set to VgpuCreatablePlacementInfoVersion.
+func NewVgpuCreatablePlacementInfo() VgpuCreatablePlacementInfo {
+	return VgpuCreatablePlacementInfo{Version: VgpuCreatablePlacementInfoVersion}
+}
+
+// NewSystemEventSetCreateRequest_v1 returns a SystemEventSetCreateRequest_v1 with its Version set to SystemEventSetCreateRequest_v1Version.
+func NewSystemEventSetCreateRequest_v1() SystemEventSetCreateRequest_v1 {
+	return SystemEventSetCreateRequest_v1{Version: SystemEventSetCreateRequest_v1Version}
+}
+
+// NewSystemEventSetCreateRequest returns a SystemEventSetCreateRequest with its Version set to SystemEventSetCreateRequestVersion.
+func NewSystemEventSetCreateRequest() SystemEventSetCreateRequest {
+	return SystemEventSetCreateRequest{Version: SystemEventSetCreateRequestVersion}
+}
+
+// NewSystemEventSetFreeRequest_v1 returns a SystemEventSetFreeRequest_v1 with its Version set to SystemEventSetFreeRequest_v1Version.
+func NewSystemEventSetFreeRequest_v1() SystemEventSetFreeRequest_v1 {
+	return SystemEventSetFreeRequest_v1{Version: SystemEventSetFreeRequest_v1Version}
+}
+
+// NewSystemEventSetFreeRequest returns a SystemEventSetFreeRequest with its Version set to SystemEventSetFreeRequestVersion.
+func NewSystemEventSetFreeRequest() SystemEventSetFreeRequest {
+	return SystemEventSetFreeRequest{Version: SystemEventSetFreeRequestVersion}
+}
+
+// NewSystemRegisterEventRequest_v1 returns a SystemRegisterEventRequest_v1 with its Version set to SystemRegisterEventRequest_v1Version.
+func NewSystemRegisterEventRequest_v1() SystemRegisterEventRequest_v1 {
+	return SystemRegisterEventRequest_v1{Version: SystemRegisterEventRequest_v1Version}
+}
+
+// NewSystemRegisterEventRequest returns a SystemRegisterEventRequest with its Version set to SystemRegisterEventRequestVersion.
+func NewSystemRegisterEventRequest() SystemRegisterEventRequest {
+	return SystemRegisterEventRequest{Version: SystemRegisterEventRequestVersion}
+}
+
+// NewSystemEventSetWaitRequest_v1 returns a SystemEventSetWaitRequest_v1 with its Version set to SystemEventSetWaitRequest_v1Version.
+func NewSystemEventSetWaitRequest_v1() SystemEventSetWaitRequest_v1 {
+	return SystemEventSetWaitRequest_v1{Version: SystemEventSetWaitRequest_v1Version}
+}
+
+// NewSystemEventSetWaitRequest returns a SystemEventSetWaitRequest with its Version set to SystemEventSetWaitRequestVersion.
+func NewSystemEventSetWaitRequest() SystemEventSetWaitRequest {
+	return SystemEventSetWaitRequest{Version: SystemEventSetWaitRequestVersion}
+}
+
+// NewSystemConfComputeSettings_v1 returns a SystemConfComputeSettings_v1 with its Version set to SystemConfComputeSettings_v1Version.
+func NewSystemConfComputeSettings_v1() SystemConfComputeSettings_v1 {
+	return SystemConfComputeSettings_v1{Version: SystemConfComputeSettings_v1Version}
+}
+
+// NewSystemConfComputeSettings returns a SystemConfComputeSettings with its Version set to SystemConfComputeSettingsVersion.
+func NewSystemConfComputeSettings() SystemConfComputeSettings {
+	return SystemConfComputeSettings{Version: SystemConfComputeSettingsVersion}
+}
+
+// NewConfComputeSetKeyRotationThresholdInfo_v1 returns a ConfComputeSetKeyRotationThresholdInfo_v1 with its Version set to ConfComputeSetKeyRotationThresholdInfo_v1Version.
+func NewConfComputeSetKeyRotationThresholdInfo_v1() ConfComputeSetKeyRotationThresholdInfo_v1 {
+	return ConfComputeSetKeyRotationThresholdInfo_v1{Version: ConfComputeSetKeyRotationThresholdInfo_v1Version}
+}
+
+// NewConfComputeSetKeyRotationThresholdInfo returns a ConfComputeSetKeyRotationThresholdInfo with its Version set to ConfComputeSetKeyRotationThresholdInfoVersion.
+func NewConfComputeSetKeyRotationThresholdInfo() ConfComputeSetKeyRotationThresholdInfo {
+	return ConfComputeSetKeyRotationThresholdInfo{Version: ConfComputeSetKeyRotationThresholdInfoVersion}
+}
+
+// NewConfComputeGetKeyRotationThresholdInfo_v1 returns a ConfComputeGetKeyRotationThresholdInfo_v1 with its Version set to ConfComputeGetKeyRotationThresholdInfo_v1Version.
+func NewConfComputeGetKeyRotationThresholdInfo_v1() ConfComputeGetKeyRotationThresholdInfo_v1 {
+	return ConfComputeGetKeyRotationThresholdInfo_v1{Version: ConfComputeGetKeyRotationThresholdInfo_v1Version}
+}
+
+// NewConfComputeGetKeyRotationThresholdInfo returns a ConfComputeGetKeyRotationThresholdInfo with its Version set to ConfComputeGetKeyRotationThresholdInfoVersion.
+func NewConfComputeGetKeyRotationThresholdInfo() ConfComputeGetKeyRotationThresholdInfo {
+	return ConfComputeGetKeyRotationThresholdInfo{Version: ConfComputeGetKeyRotationThresholdInfoVersion}
+}
+
+// NewGpuFabricInfo_v2 returns a GpuFabricInfo_v2 with its Version set to GpuFabricInfo_v2Version.
+func NewGpuFabricInfo_v2() GpuFabricInfo_v2 {
+	return GpuFabricInfo_v2{Version: GpuFabricInfo_v2Version}
+}
+
+// NewGpuFabricInfo_v3 returns a GpuFabricInfo_v3 with its Version set to GpuFabricInfo_v3Version.
+func NewGpuFabricInfo_v3() GpuFabricInfo_v3 {
+	return GpuFabricInfo_v3{Version: GpuFabricInfo_v3Version}
+}
+
+// NewGpuFabricInfoV returns a GpuFabricInfoV with its Version set to GpuFabricInfoVVersion.
+func NewGpuFabricInfoV() GpuFabricInfoV {
+	return GpuFabricInfoV{Version: GpuFabricInfoVVersion}
+}
+
+// NewSystemDriverBranchInfo_v1 returns a SystemDriverBranchInfo_v1 with its Version set to SystemDriverBranchInfo_v1Version.
+func NewSystemDriverBranchInfo_v1() SystemDriverBranchInfo_v1 {
+	return SystemDriverBranchInfo_v1{Version: SystemDriverBranchInfo_v1Version}
+}
+
+// NewSystemDriverBranchInfo returns a SystemDriverBranchInfo with its Version set to SystemDriverBranchInfoVersion.
+func NewSystemDriverBranchInfo() SystemDriverBranchInfo {
+	return SystemDriverBranchInfo{Version: SystemDriverBranchInfoVersion}
+}
+
+// NewTemperature_v1 returns a Temperature_v1 with its Version set to Temperature_v1Version.
+func NewTemperature_v1() Temperature_v1 {
+	return Temperature_v1{Version: Temperature_v1Version}
+}
+
+// NewTemperature returns a Temperature with its Version set to TemperatureVersion.
+func NewTemperature() Temperature {
+	return Temperature{Version: TemperatureVersion}
+}
+
+// NewNvlinkSupportedBwModes_v1 returns a NvlinkSupportedBwModes_v1 with its Version set to NvlinkSupportedBwModes_v1Version.
+func NewNvlinkSupportedBwModes_v1() NvlinkSupportedBwModes_v1 {
+	return NvlinkSupportedBwModes_v1{Version: NvlinkSupportedBwModes_v1Version}
+}
+
+// NewNvlinkSupportedBwModes returns a NvlinkSupportedBwModes with its Version set to NvlinkSupportedBwModesVersion.
+func NewNvlinkSupportedBwModes() NvlinkSupportedBwModes {
+	return NvlinkSupportedBwModes{Version: NvlinkSupportedBwModesVersion}
+}
+
+// NewNvlinkGetBwMode_v1 returns a NvlinkGetBwMode_v1 with its Version set to NvlinkGetBwMode_v1Version.
+func NewNvlinkGetBwMode_v1() NvlinkGetBwMode_v1 {
+	return NvlinkGetBwMode_v1{Version: NvlinkGetBwMode_v1Version}
+}
+
+// NewNvlinkGetBwMode returns a NvlinkGetBwMode with its Version set to NvlinkGetBwModeVersion.
+func NewNvlinkGetBwMode() NvlinkGetBwMode {
+	return NvlinkGetBwMode{Version: NvlinkGetBwModeVersion}
+}
+
+// NewNvlinkSetBwMode_v1 returns a NvlinkSetBwMode_v1 with its Version set to NvlinkSetBwMode_v1Version.
+func NewNvlinkSetBwMode_v1() NvlinkSetBwMode_v1 {
+	return NvlinkSetBwMode_v1{Version: NvlinkSetBwMode_v1Version}
+}
+
+// NewNvlinkSetBwMode returns a NvlinkSetBwMode with its Version set to NvlinkSetBwModeVersion.
+func NewNvlinkSetBwMode() NvlinkSetBwMode {
+	return NvlinkSetBwMode{Version: NvlinkSetBwModeVersion}
+}
+
+// NewNvLinkInfo_v1 returns a NvLinkInfo_v1 with its Version set to NvLinkInfo_v1Version.
+func NewNvLinkInfo_v1() NvLinkInfo_v1 {
+	return NvLinkInfo_v1{Version: NvLinkInfo_v1Version}
+}
+
+// NewNvLinkInfo_v2 returns a NvLinkInfo_v2 with its Version set to NvLinkInfo_v2Version.
+func NewNvLinkInfo_v2() NvLinkInfo_v2 {
+	return NvLinkInfo_v2{Version: NvLinkInfo_v2Version}
+}
+
+// NewNvLinkInfo returns a NvLinkInfo with its Version set to NvLinkInfoVersion.
+func NewNvLinkInfo() NvLinkInfo {
+	return NvLinkInfo{Version: NvLinkInfoVersion}
+}
+
+// NewGpuInstanceProfileInfo_v2 returns a GpuInstanceProfileInfo_v2 with its Version set to GpuInstanceProfileInfo_v2Version.
+func NewGpuInstanceProfileInfo_v2() GpuInstanceProfileInfo_v2 {
+	return GpuInstanceProfileInfo_v2{Version: GpuInstanceProfileInfo_v2Version}
+}
+
+// NewGpuInstanceProfileInfo_v3 returns a GpuInstanceProfileInfo_v3 with its Version set to GpuInstanceProfileInfo_v3Version.
+func NewGpuInstanceProfileInfo_v3() GpuInstanceProfileInfo_v3 {
+	return GpuInstanceProfileInfo_v3{Version: GpuInstanceProfileInfo_v3Version}
+}
+
+// NewComputeInstanceProfileInfo_v2 returns a ComputeInstanceProfileInfo_v2 with its Version set to ComputeInstanceProfileInfo_v2Version.
+func NewComputeInstanceProfileInfo_v2() ComputeInstanceProfileInfo_v2 {
+	return ComputeInstanceProfileInfo_v2{Version: ComputeInstanceProfileInfo_v2Version}
+}
+
+// NewComputeInstanceProfileInfo_v3 returns a ComputeInstanceProfileInfo_v3 with its Version set to ComputeInstanceProfileInfo_v3Version.
+func NewComputeInstanceProfileInfo_v3() ComputeInstanceProfileInfo_v3 {
+	return ComputeInstanceProfileInfo_v3{Version: ComputeInstanceProfileInfo_v3Version}
+}
+
+// NewDeviceCapabilities_v1 returns a DeviceCapabilities_v1 with its Version set to DeviceCapabilities_v1Version.
+func NewDeviceCapabilities_v1() DeviceCapabilities_v1 {
+	return DeviceCapabilities_v1{Version: DeviceCapabilities_v1Version}
+}
+
+// NewDeviceCapabilities returns a DeviceCapabilities with its Version set to DeviceCapabilitiesVersion.
+func NewDeviceCapabilities() DeviceCapabilities {
+	return DeviceCapabilities{Version: DeviceCapabilitiesVersion}
+}
+
+// NewWorkloadPowerProfileInfo_v1 returns a WorkloadPowerProfileInfo_v1 with its Version set to WorkloadPowerProfileInfo_v1Version.
+func NewWorkloadPowerProfileInfo_v1() WorkloadPowerProfileInfo_v1 {
+	return WorkloadPowerProfileInfo_v1{Version: WorkloadPowerProfileInfo_v1Version}
+}
+
+// NewWorkloadPowerProfileInfo returns a WorkloadPowerProfileInfo with its Version set to WorkloadPowerProfileInfoVersion.
+func NewWorkloadPowerProfileInfo() WorkloadPowerProfileInfo {
+	return WorkloadPowerProfileInfo{Version: WorkloadPowerProfileInfoVersion}
+}
+
+// NewWorkloadPowerProfileProfilesInfo_v1 returns a WorkloadPowerProfileProfilesInfo_v1 with its Version set to WorkloadPowerProfileProfilesInfo_v1Version.
+func NewWorkloadPowerProfileProfilesInfo_v1() WorkloadPowerProfileProfilesInfo_v1 {
+	return WorkloadPowerProfileProfilesInfo_v1{Version: WorkloadPowerProfileProfilesInfo_v1Version}
+}
+
+// NewWorkloadPowerProfileProfilesInfo returns a WorkloadPowerProfileProfilesInfo with its Version set to WorkloadPowerProfileProfilesInfoVersion.
+func NewWorkloadPowerProfileProfilesInfo() WorkloadPowerProfileProfilesInfo {
+	return WorkloadPowerProfileProfilesInfo{Version: WorkloadPowerProfileProfilesInfoVersion}
+}
+
+// NewWorkloadPowerProfileCurrentProfiles_v1 returns a WorkloadPowerProfileCurrentProfiles_v1 with its Version set to WorkloadPowerProfileCurrentProfiles_v1Version.
+func NewWorkloadPowerProfileCurrentProfiles_v1() WorkloadPowerProfileCurrentProfiles_v1 {
+	return WorkloadPowerProfileCurrentProfiles_v1{Version: WorkloadPowerProfileCurrentProfiles_v1Version}
+}
+
+// NewWorkloadPowerProfileCurrentProfiles returns a WorkloadPowerProfileCurrentProfiles with its Version set to WorkloadPowerProfileCurrentProfilesVersion.
+func NewWorkloadPowerProfileCurrentProfiles() WorkloadPowerProfileCurrentProfiles {
+	return WorkloadPowerProfileCurrentProfiles{Version: WorkloadPowerProfileCurrentProfilesVersion}
+}
+
+// NewWorkloadPowerProfileRequestedProfiles_v1 returns a WorkloadPowerProfileRequestedProfiles_v1 with its Version set to WorkloadPowerProfileRequestedProfiles_v1Version.
+func NewWorkloadPowerProfileRequestedProfiles_v1() WorkloadPowerProfileRequestedProfiles_v1 {
+	return WorkloadPowerProfileRequestedProfiles_v1{Version: WorkloadPowerProfileRequestedProfiles_v1Version}
+}
+
+// NewWorkloadPowerProfileRequestedProfiles returns a WorkloadPowerProfileRequestedProfiles with its Version set to WorkloadPowerProfileRequestedProfilesVersion.
+func NewWorkloadPowerProfileRequestedProfiles() WorkloadPowerProfileRequestedProfiles {
+	return WorkloadPowerProfileRequestedProfiles{Version: WorkloadPowerProfileRequestedProfilesVersion}
+}
+
+// NewPowerSmoothingProfile_v1 returns a PowerSmoothingProfile_v1 with its Version set to PowerSmoothingProfile_v1Version.
+func NewPowerSmoothingProfile_v1() PowerSmoothingProfile_v1 {
+	return PowerSmoothingProfile_v1{Version: PowerSmoothingProfile_v1Version}
+}
+
+// NewPowerSmoothingProfile returns a PowerSmoothingProfile with its Version set to PowerSmoothingProfileVersion.
+func NewPowerSmoothingProfile() PowerSmoothingProfile {
+	return PowerSmoothingProfile{Version: PowerSmoothingProfileVersion}
+}
+
+// NewPowerSmoothingState_v1 returns a PowerSmoothingState_v1 with its Version set to PowerSmoothingState_v1Version.
+func NewPowerSmoothingState_v1() PowerSmoothingState_v1 {
+	return PowerSmoothingState_v1{Version: PowerSmoothingState_v1Version}
+}
+
+// NewPowerSmoothingState returns a PowerSmoothingState with its Version set to PowerSmoothingStateVersion.
+func NewPowerSmoothingState() PowerSmoothingState {
+	return PowerSmoothingState{Version: PowerSmoothingStateVersion}
+}
+
+// structVersions lists the version constants along with the macros that
+// define these in nvml.h.
+var structVersions = []struct {
+	name    string
+	macro   string
+	version uint32
+}{
+	{"PciInfoExt_v1Version", "nvmlPciInfoExt_v1", PciInfoExt_v1Version},
+	{"PciInfoExtVersion", "nvmlPciInfoExt_v1", PciInfoExtVersion},
+	{"Memory_v2Version", "nvmlMemory_v2", Memory_v2Version},
+	{"ProcessDetailList_v1Version", "nvmlProcessDetailList_v1", ProcessDetailList_v1Version},
+	{"ProcessDetailListVersion", "nvmlProcessDetailList_v1", ProcessDetailListVersion},
+	{"C2cModeInfo_v1Version", "nvmlC2cModeInfo_v1", C2cModeInfo_v1Version},
+	{"DeviceAddressingMode_v1Version", "nvmlDeviceAddressingMode_v1", DeviceAddressingMode_v1Version},
+	{"DeviceAddressingModeVersion", "nvmlDeviceAddressingMode_v1", DeviceAddressingModeVersion},
+	{"RepairStatus_v1Version", "nvmlRepairStatus_v1", RepairStatus_v1Version},
+	{"RepairStatusVersion", "nvmlRepairStatus_v1", RepairStatusVersion},
+	{"CoolerInfo_v1Version", "nvmlCoolerInfo_v1", CoolerInfo_v1Version},
+	{"CoolerInfoVersion", "nvmlCoolerInfo_v1", CoolerInfoVersion},
+	{"UUID_v1Version", "nvmlUUID_v1", UUID_v1Version},
+	{"UUIDVersion", "nvmlUUID_v1", UUIDVersion},
+	{"Pdi_v1Version", "nvmlPdi_v1", Pdi_v1Version},
+	{"PdiVersion", "nvmlPdi_v1", PdiVersion},
+	{"DramEncryptionInfo_v1Version", "nvmlDramEncryptionInfo_v1", DramEncryptionInfo_v1Version},
+	{"DramEncryptionInfoVersion", "nvmlDramEncryptionInfo_v1", DramEncryptionInfoVersion},
+	{"MarginTemperature_v1Version", "nvmlMarginTemperature_v1", MarginTemperature_v1Version},
+	{"MarginTemperatureVersion", "nvmlMarginTemperature_v1", MarginTemperatureVersion},
+	{"ClockOffset_v1Version", "nvmlClockOffset_v1", ClockOffset_v1Version},
+	{"ClockOffsetVersion", "nvmlClockOffset_v1", ClockOffsetVersion},
+	{"FanSpeedInfo_v1Version", "nvmlFanSpeedInfo_v1", FanSpeedInfo_v1Version},
+	{"FanSpeedInfoVersion", "nvmlFanSpeedInfo_v1", FanSpeedInfoVersion},
+	{"DevicePerfModes_v1Version", "nvmlDevicePerfModes_v1", DevicePerfModes_v1Version},
+	{"DevicePerfModesVersion", "nvmlDevicePerfModes_v1", DevicePerfModesVersion},
+	{"DeviceCurrentClockFreqs_v1Version", "nvmlDeviceCurrentClockFreqs_v1", DeviceCurrentClockFreqs_v1Version},
+	{"DeviceCurrentClockFreqsVersion", "nvmlDeviceCurrentClockFreqs_v1", DeviceCurrentClockFreqsVersion},
+	{"ProcessesUtilizationInfo_v1Version", "nvmlProcessesUtilizationInfo_v1", ProcessesUtilizationInfo_v1Version},
+	{"ProcessesUtilizationInfoVersion", "nvmlProcessesUtilizationInfo_v1", ProcessesUtilizationInfoVersion},
+	{"EccSramErrorStatus_v1Version", "nvmlEccSramErrorStatus_v1", EccSramErrorStatus_v1Version},
+	{"EccSramErrorStatusVersion", "nvmlEccSramErrorStatus_v1", EccSramErrorStatusVersion},
+	{"PlatformInfo_v1Version", "nvmlPlatformInfo_v1", PlatformInfo_v1Version},
+	{"PlatformInfo_v2Version", "nvmlPlatformInfo_v2", PlatformInfo_v2Version},
+	{"PlatformInfoVersion", "nvmlPlatformInfo_v2", PlatformInfoVersion},
+	{"EccSramUniqueUncorrectedErrorCounts_v1Version", "nvmlEccSramUniqueUncorrectedErrorCounts_v1", EccSramUniqueUncorrectedErrorCounts_v1Version},
+	{"EccSramUniqueUncorrectedErrorCountsVersion", "nvmlEccSramUniqueUncorrectedErrorCounts_v1", EccSramUniqueUncorrectedErrorCountsVersion},
+	{"PowerValue_v2Version", "nvmlPowerValue_v2", PowerValue_v2Version},
+	{"VgpuHeterogeneousMode_v1Version", "nvmlVgpuHeterogeneousMode_v1", VgpuHeterogeneousMode_v1Version},
+	{"VgpuHeterogeneousModeVersion", "nvmlVgpuHeterogeneousMode_v1", VgpuHeterogeneousModeVersion},
+	{"VgpuPlacementId_v1Version", "nvmlVgpuPlacementId_v1", VgpuPlacementId_v1Version},
+	{"VgpuPlacementIdVersion", "nvmlVgpuPlacementId_v1", VgpuPlacementIdVersion},
+	{"VgpuPlacementList_v1Version", "nvmlVgpuPlacementList_v1", VgpuPlacementList_v1Version},
+	{"VgpuPlacementList_v2Version", "nvmlVgpuPlacementList_v2", VgpuPlacementList_v2Version},
+	{"VgpuPlacementListVersion", "nvmlVgpuPlacementList_v2", VgpuPlacementListVersion},
+	{"VgpuTypeBar1Info_v1Version", "nvmlVgpuTypeBar1Info_v1", VgpuTypeBar1Info_v1Version},
+	{"VgpuTypeBar1InfoVersion", "nvmlVgpuTypeBar1Info_v1", VgpuTypeBar1InfoVersion},
+	{"VgpuInstancesUtilizationInfo_v1Version", "nvmlVgpuInstancesUtilizationInfo_v1", VgpuInstancesUtilizationInfo_v1Version},
+	{"VgpuInstancesUtilizationInfoVersion", "nvmlVgpuInstancesUtilizationInfo_v1", VgpuInstancesUtilizationInfoVersion},
+	{"VgpuProcessesUtilizationInfo_v1Version", "nvmlVgpuProcessesUtilizationInfo_v1", VgpuProcessesUtilizationInfo_v1Version},
+	{"VgpuProcessesUtilizationInfoVersion", "nvmlVgpuProcessesUtilizationInfo_v1", VgpuProcessesUtilizationInfoVersion},
+	{"VgpuRuntimeState_v1Version", "nvmlVgpuRuntimeState_v1", VgpuRuntimeState_v1Version},
+	{"VgpuRuntimeStateVersion", "nvmlVgpuRuntimeState_v1", VgpuRuntimeStateVersion},
+	{"VgpuTypeIdInfo_v1Version", "nvmlVgpuTypeIdInfo_v1", VgpuTypeIdInfo_v1Version},
+	{"VgpuTypeIdInfoVersion", "nvmlVgpuTypeIdInfo_v1", VgpuTypeIdInfoVersion},
+	{"VgpuTypeMaxInstance_v1Version", "nvmlVgpuTypeMaxInstance_v1", VgpuTypeMaxInstance_v1Version},
+	{"VgpuTypeMaxInstanceVersion", "nvmlVgpuTypeMaxInstance_v1", VgpuTypeMaxInstanceVersion},
+	{"ActiveVgpuInstanceInfo_v1Version", "nvmlActiveVgpuInstanceInfo_v1", ActiveVgpuInstanceInfo_v1Version},
+	{"ActiveVgpuInstanceInfoVersion", "nvmlActiveVgpuInstanceInfo_v1", ActiveVgpuInstanceInfoVersion},
+	{"VgpuSchedulerState_v1Version", "nvmlVgpuSchedulerState_v1", VgpuSchedulerState_v1Version},
+	{"VgpuSchedulerStateVersion", "nvmlVgpuSchedulerState_v1", VgpuSchedulerStateVersion},
+	{"VgpuSchedulerStateInfo_v1Version", "nvmlVgpuSchedulerStateInfo_v1", VgpuSchedulerStateInfo_v1Version},
+	{"VgpuSchedulerStateInfoVersion", "nvmlVgpuSchedulerStateInfo_v1", VgpuSchedulerStateInfoVersion},
+	{"VgpuSchedulerLogInfo_v1Version", "nvmlVgpuSchedulerLogInfo_v1", VgpuSchedulerLogInfo_v1Version},
+	{"VgpuSchedulerLogInfoVersion", "nvmlVgpuSchedulerLogInfo_v1", VgpuSchedulerLogInfoVersion},
+	{"VgpuCreatablePlacementInfo_v1Version", "nvmlVgpuCreatablePlacementInfo_v1", VgpuCreatablePlacementInfo_v1Version},
+	{"VgpuCreatablePlacementInfoVersion", "nvmlVgpuCreatablePlacementInfo_v1", VgpuCreatablePlacementInfoVersion},
+	{"SystemEventSetCreateRequest_v1Version", "nvmlSystemEventSetCreateRequest_v1", SystemEventSetCreateRequest_v1Version},
+	{"SystemEventSetCreateRequestVersion", "nvmlSystemEventSetCreateRequest_v1", SystemEventSetCreateRequestVersion},
+	{"SystemEventSetFreeRequest_v1Version", "nvmlSystemEventSetFreeRequest_v1", SystemEventSetFreeRequest_v1Version},
+	{"SystemEventSetFreeRequestVersion", "nvmlSystemEventSetFreeRequest_v1", SystemEventSetFreeRequestVersion},
+	{"SystemRegisterEventRequest_v1Version", "nvmlSystemRegisterEventRequest_v1", SystemRegisterEventRequest_v1Version},
+	{"SystemRegisterEventRequestVersion", "nvmlSystemRegisterEventRequest_v1", SystemRegisterEventRequestVersion},
+	{"SystemEventSetWaitRequest_v1Version", "nvmlSystemEventSetWaitRequest_v1", SystemEventSetWaitRequest_v1Version},
+	{"SystemEventSetWaitRequestVersion", "nvmlSystemEventSetWaitRequest_v1", SystemEventSetWaitRequestVersion},
+	{"SystemConfComputeSettings_v1Version", "nvmlSystemConfComputeSettings_v1", SystemConfComputeSettings_v1Version},
+	{"SystemConfComputeSettingsVersion", "nvmlSystemConfComputeSettings_v1", SystemConfComputeSettingsVersion},
+	{"ConfComputeSetKeyRotationThresholdInfo_v1Version", "nvmlConfComputeSetKeyRotationThresholdInfo_v1", ConfComputeSetKeyRotationThresholdInfo_v1Version},
+	{"ConfComputeSetKeyRotationThresholdInfoVersion", "nvmlConfComputeSetKeyRotationThresholdInfo_v1", ConfComputeSetKeyRotationThresholdInfoVersion},
+	{"ConfComputeGetKeyRotationThresholdInfo_v1Version", "nvmlConfComputeGetKeyRotationThresholdInfo_v1", ConfComputeGetKeyRotationThresholdInfo_v1Version},
+	{"ConfComputeGetKeyRotationThresholdInfoVersion", "nvmlConfComputeGetKeyRotationThresholdInfo_v1", ConfComputeGetKeyRotationThresholdInfoVersion},
+	{"GpuFabricInfo_v2Version", "nvmlGpuFabricInfo_v2", GpuFabricInfo_v2Version},
+	{"GpuFabricInfo_v3Version", "nvmlGpuFabricInfo_v3", GpuFabricInfo_v3Version},
+	{"GpuFabricInfoVVersion", "nvmlGpuFabricInfo_v3", GpuFabricInfoVVersion},
+	{"SystemDriverBranchInfo_v1Version", "nvmlSystemDriverBranchInfo_v1", SystemDriverBranchInfo_v1Version},
+	{"SystemDriverBranchInfoVersion", "nvmlSystemDriverBranchInfo_v1", SystemDriverBranchInfoVersion},
+	{"Temperature_v1Version", "nvmlTemperature_v1", Temperature_v1Version},
+	{"TemperatureVersion", "nvmlTemperature_v1", TemperatureVersion},
+	{"NvlinkSupportedBwModes_v1Version", "nvmlNvlinkSupportedBwModes_v1", NvlinkSupportedBwModes_v1Version},
+	{"NvlinkSupportedBwModesVersion", "nvmlNvlinkSupportedBwModes_v1", NvlinkSupportedBwModesVersion},
+	{"NvlinkGetBwMode_v1Version", "nvmlNvlinkGetBwMode_v1", NvlinkGetBwMode_v1Version},
+	{"NvlinkGetBwModeVersion", "nvmlNvlinkGetBwMode_v1", NvlinkGetBwModeVersion},
+	{"NvlinkSetBwMode_v1Version", "nvmlNvlinkSetBwMode_v1", NvlinkSetBwMode_v1Version},
+	{"NvlinkSetBwModeVersion", "nvmlNvlinkSetBwMode_v1", NvlinkSetBwModeVersion},
+	{"NvLinkInfo_v1Version", "nvmlNvLinkInfo_v1", NvLinkInfo_v1Version},
+	{"NvLinkInfo_v2Version", "nvmlNvLinkInfo_v2", NvLinkInfo_v2Version},
+	{"NvLinkInfoVersion", "nvmlNvLinkInfo_v2", NvLinkInfoVersion},
+	{"GpuInstanceProfileInfo_v2Version", "nvmlGpuInstanceProfileInfo_v2", GpuInstanceProfileInfo_v2Version},
+	{"GpuInstanceProfileInfo_v3Version", "nvmlGpuInstanceProfileInfo_v3", GpuInstanceProfileInfo_v3Version},
+	{"ComputeInstanceProfileInfo_v2Version", "nvmlComputeInstanceProfileInfo_v2", ComputeInstanceProfileInfo_v2Version},
+	{"ComputeInstanceProfileInfo_v3Version", "nvmlComputeInstanceProfileInfo_v3", ComputeInstanceProfileInfo_v3Version},
+	{"DeviceCapabilities_v1Version", "nvmlDeviceCapabilities_v1", DeviceCapabilities_v1Version},
+	{"DeviceCapabilitiesVersion", "nvmlDeviceCapabilities_v1", DeviceCapabilitiesVersion},
+	{"WorkloadPowerProfileInfo_v1Version", "nvmlWorkloadPowerProfileInfo_v1", WorkloadPowerProfileInfo_v1Version},
+	{"WorkloadPowerProfileInfoVersion", "nvmlWorkloadPowerProfileInfo_v1", WorkloadPowerProfileInfoVersion},
+	{"WorkloadPowerProfileProfilesInfo_v1Version", "nvmlWorkloadPowerProfileProfilesInfo_v1", WorkloadPowerProfileProfilesInfo_v1Version},
+	{"WorkloadPowerProfileProfilesInfoVersion", "nvmlWorkloadPowerProfileProfilesInfo_v1", WorkloadPowerProfileProfilesInfoVersion},
+	{"WorkloadPowerProfileCurrentProfiles_v1Version", "nvmlWorkloadPowerProfileCurrentProfiles_v1", WorkloadPowerProfileCurrentProfiles_v1Version},
+	{"WorkloadPowerProfileCurrentProfilesVersion", "nvmlWorkloadPowerProfileCurrentProfiles_v1", WorkloadPowerProfileCurrentProfilesVersion},
+	{"WorkloadPowerProfileRequestedProfiles_v1Version", "nvmlWorkloadPowerProfileRequestedProfiles_v1", WorkloadPowerProfileRequestedProfiles_v1Version},
+	{"WorkloadPowerProfileRequestedProfilesVersion", "nvmlWorkloadPowerProfileRequestedProfiles_v1", WorkloadPowerProfileRequestedProfilesVersion},
+	{"PowerSmoothingProfile_v1Version", "nvmlPowerSmoothingProfile_v1", PowerSmoothingProfile_v1Version},
+	{"PowerSmoothingProfileVersion", "nvmlPowerSmoothingProfile_v1", PowerSmoothingProfileVersion},
+	{"PowerSmoothingState_v1Version", "nvmlPowerSmoothingState_v1", PowerSmoothingState_v1Version},
+	{"PowerSmoothingStateVersion", "nvmlPowerSmoothingState_v1", PowerSmoothingStateVersion},
+}
@@ -0,0 +1,419 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// nvmlSymbols lists the NVML symbols that are referenced by the bindings.
+var nvmlSymbols = []string{
+	"nvmlComputeInstanceDestroy",
+	"nvmlComputeInstanceGetInfo",
+	"nvmlComputeInstanceGetInfo_v2",
+	"nvmlDeviceClearAccountingPids",
+	"nvmlDeviceClearCpuAffinity",
+	"nvmlDeviceClearEccErrorCounts",
+	"nvmlDeviceClearFieldValues",
+	"nvmlDeviceCreateGpuInstance",
+	"nvmlDeviceCreateGpuInstanceWithPlacement",
+	"nvmlDeviceDiscoverGpus",
+	"nvmlDeviceFreezeNvLinkUtilizationCounter",
+	"nvmlDeviceGetAPIRestriction",
+	"nvmlDeviceGetAccountingBufferSize",
+	"nvmlDeviceGetAccountingMode",
+	"nvmlDeviceGetAccountingPids",
+	"nvmlDeviceGetAccountingStats",
+	"nvmlDeviceGetActiveVgpus",
+	"nvmlDeviceGetAdaptiveClockInfoStatus",
+	"nvmlDeviceGetAddressingMode",
+	"nvmlDeviceGetApplicationsClock",
+	"nvmlDeviceGetArchitecture",
+	"nvmlDeviceGetAttributes",
+	"nvmlDeviceGetAttributes_v2",
+	"nvmlDeviceGetAutoBoostedClocksEnabled",
+	"nvmlDeviceGetBAR1MemoryInfo",
+	"nvmlDeviceGetBoardId",
+	"nvmlDeviceGetBoardPartNumber",
+	"nvmlDeviceGetBrand",
+	"nvmlDeviceGetBridgeChipInfo",
+	"nvmlDeviceGetBusType",
+	"nvmlDeviceGetC2cModeInfoV",
+	"nvmlDeviceGetCapabilities",
+	"nvmlDeviceGetClkMonStatus",
+	"nvmlDeviceGetClock",
+	"nvmlDeviceGetClockInfo",
+	"nvmlDeviceGetClockOffsets",
+	"nvmlDeviceGetComputeInstanceId",
+	"nvmlDeviceGetComputeMode",
+	"nvmlDeviceGetComputeRunningProcesses",
+	"nvmlDeviceGetComputeRunningProcesses_v2",
+	"nvmlDeviceGetComputeRunningProcesses_v3",
+	"nvmlDeviceGetConfComputeGpuAttestationReport",
+	"nvmlDeviceGetConfComputeGpuCertificate",
+	"nvmlDeviceGetConfComputeMemSizeInfo",
+	"nvmlDeviceGetConfComputeProtectedMemoryUsage",
+	"nvmlDeviceGetCoolerInfo",
+	"nvmlDeviceGetCount",
+	"nvmlDeviceGetCount_v2",
+	"nvmlDeviceGetCpuAffinity",
+	"nvmlDeviceGetCpuAffinityWithinScope",
+	"nvmlDeviceGetCreatableVgpus",
+	"nvmlDeviceGetCudaComputeCapability",
+	"nvmlDeviceGetCurrPcieLinkGeneration",
+	"nvmlDeviceGetCurrPcieLinkWidth",
+	"nvmlDeviceGetCurrentClockFreqs",
+	"nvmlDeviceGetCurrentClocksEventReasons",
+	"nvmlDeviceGetCurrentClocksThrottleReasons",
+	"nvmlDeviceGetDecoderUtilization",
+	"nvmlDeviceGetDefaultApplicationsClock",
+	"nvmlDeviceGetDefaultEccMode",
+	"nvmlDeviceGetDetailedEccErrors",
+	"nvmlDeviceGetDeviceHandleFromMigDeviceHandle",
+	"nvmlDeviceGetDisplayActive",
+	"nvmlDeviceGetDisplayMode",
+	"nvmlDeviceGetDramEncryptionMode",
+	"nvmlDeviceGetDriverModel",
+	"nvmlDeviceGetDriverModel_v2",
+	"nvmlDeviceGetDynamicPstatesInfo",
+	"nvmlDeviceGetEccMode",
+	"nvmlDeviceGetEncoderCapacity",
+	"nvmlDeviceGetEncoderSessions",
+	"nvmlDeviceGetEncoderStats",
+	"nvmlDeviceGetEncoderUtilization",
+	"nvmlDeviceGetEnforcedPowerLimit",
+	"nvmlDeviceGetFBCSessions",
+	"nvmlDeviceGetFBCStats",
+	"nvmlDeviceGetFanControlPolicy_v2",
+	"nvmlDeviceGetFanSpeed",
+	"nvmlDeviceGetFanSpeedRPM",
+	"nvmlDeviceGetFanSpeed_v2",
+	"nvmlDeviceGetFieldValues",
+	"nvmlDeviceGetGpcClkMinMaxVfOffset",
+	"nvmlDeviceGetGpcClkVfOffset",
+	"nvmlDeviceGetGpuFabricInfo",
+	"nvmlDeviceGetGpuFabricInfoV",
+	"nvmlDeviceGetGpuInstanceById",
+	"nvmlDeviceGetGpuInstanceId",
+	"nvmlDeviceGetGpuInstancePossiblePlacements",
+	"nvmlDeviceGetGpuInstancePossiblePlacements_v2",
+	"nvmlDeviceGetGpuInstanceProfileInfo",
+	"nvmlDeviceGetGpuInstanceProfileInfoByIdV",
+	"nvmlDeviceGetGpuInstanceProfileInfoV",
+	"nvmlDeviceGetGpuInstanceRemainingCapacity",
+	"nvmlDeviceGetGpuInstances",
+	"nvmlDeviceGetGpuMaxPcieLinkGeneration",
+	"nvmlDeviceGetGpuOperationMode",
+	"nvmlDeviceGetGraphicsRunningProcesses",
+	"nvmlDeviceGetGraphicsRunningProcesses_v2",
+	"nvmlDeviceGetGraphicsRunningProcesses_v3",
+	"nvmlDeviceGetGridLicensableFeatures",
+	"nvmlDeviceGetGridLicensableFeatures_v2",
+	"nvmlDeviceGetGridLicensableFeatures_v3",
+	"nvmlDeviceGetGridLicensableFeatures_v4",
+	"nvmlDeviceGetGspFirmwareMode",
+	"nvmlDeviceGetGspFirmwareVersion",
+	"nvmlDeviceGetHandleByIndex",
+	"nvmlDeviceGetHandleByIndex_v2",
+	"nvmlDeviceGetHandleByPciBusId",
+	"nvmlDeviceGetHandleByPciBusId_v2",
+	"nvmlDeviceGetHandleBySerial",
+	"nvmlDeviceGetHandleByUUID",
+	"nvmlDeviceGetHandleByUUIDV",
+	"nvmlDeviceGetHostVgpuMode",
+	"nvmlDeviceGetIndex",
+	"nvmlDeviceGetInforomConfigurationChecksum",
+	"nvmlDeviceGetInforomImageVersion",
+	"nvmlDeviceGetInforomVersion",
+	"nvmlDeviceGetIrqNum",
+	"nvmlDeviceGetJpgUtilization",
+	"nvmlDeviceGetLastBBXFlushTime",
+	"nvmlDeviceGetMPSComputeRunningProcesses",
+	"nvmlDeviceGetMPSComputeRunningProcesses_v2",
+	"nvmlDeviceGetMPSComputeRunningProcesses_v3",
+	"nvmlDeviceGetMarginTemperature",
+	"nvmlDeviceGetMaxClockInfo",
+	"nvmlDeviceGetMaxCustomerBoostClock",
+	"nvmlDeviceGetMaxMigDeviceCount",
+	"nvmlDeviceGetMaxPcieLinkGeneration",
+	"nvmlDeviceGetMaxPcieLinkWidth",
+	"nvmlDeviceGetMemClkMinMaxVfOffset",
+	"nvmlDeviceGetMemClkVfOffset",
+	"nvmlDeviceGetMemoryAffinity",
+	"nvmlDeviceGetMemoryBusWidth",
+	"nvmlDeviceGetMemoryErrorCounter",
+	"nvmlDeviceGetMemoryInfo",
+	"nvmlDeviceGetMemoryInfo_v2",
+	"nvmlDeviceGetMigDeviceHandleByIndex",
+	"nvmlDeviceGetMigMode",
+	"nvmlDeviceGetMinMaxClockOfPState",
+	"nvmlDeviceGetMinMaxFanSpeed",
+	"nvmlDeviceGetMinorNumber",
+	"nvmlDeviceGetModuleId",
+	"nvmlDeviceGetMultiGpuBoard",
+	"nvmlDeviceGetName",
+	"nvmlDeviceGetNumFans",
+	"nvmlDeviceGetNumGpuCores",
+	"nvmlDeviceGetNumaNodeId",
+	"nvmlDeviceGetNvLinkCapability",
+	"nvmlDeviceGetNvLinkErrorCounter",
+	"nvmlDeviceGetNvLinkInfo",
+	"nvmlDeviceGetNvLinkRemoteDeviceType",
+	"nvmlDeviceGetNvLinkRemotePciInfo",
+	"nvmlDeviceGetNvLinkRemotePciInfo_v2",
+	"nvmlDeviceGetNvLinkState",
+	"nvmlDeviceGetNvLinkUtilizationControl",
+	"nvmlDeviceGetNvLinkUtilizationCounter",
+	"nvmlDeviceGetNvLinkVersion",
+	"nvmlDeviceGetNvlinkBwMode",
+	"nvmlDeviceGetNvlinkSupportedBwModes",
+	"nvmlDeviceGetOfaUtilization",
+	"nvmlDeviceGetP2PStatus",
+	"nvmlDeviceGetPciInfo",
+	"nvmlDeviceGetPciInfoExt",
+	"nvmlDeviceGetPciInfo_v2",
+	"nvmlDeviceGetPciInfo_v3",
+	"nvmlDeviceGetPcieLinkMaxSpeed",
+	"nvmlDeviceGetPcieReplayCounter",
+	"nvmlDeviceGetPcieSpeed",
+	"nvmlDeviceGetPcieThroughput",
+	"nvmlDeviceGetPdi",
+	"nvmlDeviceGetPerformanceModes",
+	"nvmlDeviceGetPerformanceState",
+	"nvmlDeviceGetPersistenceMode",
+	"nvmlDeviceGetPgpuMetadataString",
+	"nvmlDeviceGetPlatformInfo",
+	"nvmlDeviceGetPowerManagementDefaultLimit",
+	"nvmlDeviceGetPowerManagementLimit",
+	"nvmlDeviceGetPowerManagementLimitConstraints",
+	"nvmlDeviceGetPowerManagementMode",
+	"nvmlDeviceGetPowerMizerMode_v1",
+	"nvmlDeviceGetPowerSource",
+	"nvmlDeviceGetPowerState",
+	"nvmlDeviceGetPowerUsage",
+	"nvmlDeviceGetProcessUtilization",
+	"nvmlDeviceGetProcessesUtilizationInfo",
+	"nvmlDeviceGetRemappedRows",
+	"nvmlDeviceGetRepairStatus",
+	"nvmlDeviceGetRetiredPages",
+	"nvmlDeviceGetRetiredPagesPendingStatus",
+	"nvmlDeviceGetRetiredPages_v2",
+	"nvmlDeviceGetRowRemapperHistogram",
+	"nvmlDeviceGetRunningProcessDetailList",
+	"nvmlDeviceGetSamples",
+	"nvmlDeviceGetSerial",
+	"nvmlDeviceGetSramEccErrorStatus",
+	"nvmlDeviceGetSramUniqueUncorrectedEccErrorCounts",
+	"nvmlDeviceGetSupportedClocksEventReasons",
+	"nvmlDeviceGetSupportedClocksThrottleReasons",
+	"nvmlDeviceGetSupportedEventTypes",
+	"nvmlDeviceGetSupportedGraphicsClocks",
+	"nvmlDeviceGetSupportedMemoryClocks",
+	"nvmlDeviceGetSupportedPerformanceStates",
+	"nvmlDeviceGetSupportedVgpus",
+	"nvmlDeviceGetTargetFanSpeed",
+	"nvmlDeviceGetTemperature",
+	"nvmlDeviceGetTemperatureThreshold",
+	"nvmlDeviceGetTemperatureV",
+	"nvmlDeviceGetThermalSettings",
+	"nvmlDeviceGetTopologyCommonAncestor",
+	"nvmlDeviceGetTopologyNearestGpus",
+	"nvmlDeviceGetTotalEccErrors",
+	"nvmlDeviceGetTotalEnergyConsumption",
+	"nvmlDeviceGetUUID",
+	"nvmlDeviceGetUtilizationRates",
+	"nvmlDeviceGetVbiosVersion",
+	"nvmlDeviceGetVgpuCapabilities",
+	"nvmlDeviceGetVgpuHeterogeneousMode",
+	"nvmlDeviceGetVgpuInstancesUtilizationInfo",
+	"nvmlDeviceGetVgpuMetadata",
+	"nvmlDeviceGetVgpuProcessUtilization",
+	"nvmlDeviceGetVgpuProcessesUtilizationInfo",
+	"nvmlDeviceGetVgpuSchedulerCapabilities",
+	"nvmlDeviceGetVgpuSchedulerLog",
+	"nvmlDeviceGetVgpuSchedulerState",
+	"nvmlDeviceGetVgpuTypeCreatablePlacements",
+	"nvmlDeviceGetVgpuTypeSupportedPlacements",
+	"nvmlDeviceGetVgpuUtilization",
+	"nvmlDeviceGetViolationStatus",
+	"nvmlDeviceGetVirtualizationMode",
+	"nvmlDeviceIsMigDeviceHandle",
+	"nvmlDeviceModifyDrainState",
+	"nvmlDeviceOnSameBoard",
+	"nvmlDevicePowerSmoothingActivatePresetProfile",
+	"nvmlDevicePowerSmoothingSetState",
+	"nvmlDevicePowerSmoothingUpdatePresetProfileParam",
+	"nvmlDeviceQueryDrainState",
+	"nvmlDeviceReadWritePRM_v1",
+	"nvmlDeviceRegisterEvents",
+	"nvmlDeviceRemoveGpu",
+	"nvmlDeviceRemoveGpu_v2",
+	"nvmlDeviceResetApplicationsClocks",
+	"nvmlDeviceResetGpuLockedClocks",
+	"nvmlDeviceResetMemoryLockedClocks",
+	"nvmlDeviceResetNvLinkErrorCounters",
+	"nvmlDeviceResetNvLinkUtilizationCounter",
+	"nvmlDeviceSetAPIRestriction",
+	"nvmlDeviceSetAccountingMode",
+	"nvmlDeviceSetApplicationsClocks",
+	"nvmlDeviceSetAutoBoostedClocksEnabled",
+	"nvmlDeviceSetClockOffsets",
+	"nvmlDeviceSetComputeMode",
+	"nvmlDeviceSetConfComputeUnprotectedMemSize",
+	"nvmlDeviceSetCpuAffinity",
+	"nvmlDeviceSetDefaultAutoBoostedClocksEnabled",
+	"nvmlDeviceSetDefaultFanSpeed_v2",
+	"nvmlDeviceSetDramEncryptionMode",
+	"nvmlDeviceSetDriverModel",
+	"nvmlDeviceSetEccMode",
+	"nvmlDeviceSetFanControlPolicy",
+	"nvmlDeviceSetFanSpeed_v2",
+	"nvmlDeviceSetGpcClkVfOffset",
+	"nvmlDeviceSetGpuLockedClocks",
+	"nvmlDeviceSetGpuOperationMode",
+	"nvmlDeviceSetMemClkVfOffset",
+	"nvmlDeviceSetMemoryLockedClocks",
+	"nvmlDeviceSetMigMode",
+	"nvmlDeviceSetNvLinkDeviceLowPowerThreshold",
+	"nvmlDeviceSetNvLinkUtilizationControl",
+	"nvmlDeviceSetNvlinkBwMode",
+	"nvmlDeviceSetPersistenceMode",
+	"nvmlDeviceSetPowerManagementLimit",
+	"nvmlDeviceSetPowerManagementLimit_v2",
+	"nvmlDeviceSetPowerMizerMode_v1",
+	"nvmlDeviceSetTemperatureThreshold",
+	"nvmlDeviceSetVgpuCapabilities",
+	"nvmlDeviceSetVgpuHeterogeneousMode",
+	"nvmlDeviceSetVgpuSchedulerState",
+	"nvmlDeviceSetVirtualizationMode",
+	"nvmlDeviceValidateInforom",
+	"nvmlDeviceWorkloadPowerProfileClearRequestedProfiles",
+	"nvmlDeviceWorkloadPowerProfileGetCurrentProfiles",
+	"nvmlDeviceWorkloadPowerProfileGetProfilesInfo",
+	"nvmlDeviceWorkloadPowerProfileSetRequestedProfiles",
+	"nvmlErrorString",
+	"nvmlEventSetCreate",
+	"nvmlEventSetFree",
+	"nvmlEventSetWait",
+	"nvmlEventSetWait_v2",
+	"nvmlGetExcludedDeviceCount",
+	"nvmlGetExcludedDeviceInfoByIndex",
+	"nvmlGetVgpuCompatibility",
+	"nvmlGetVgpuDriverCapabilities",
+	"nvmlGetVgpuVersion",
+	"nvmlGpmMetricsGet",
+	"nvmlGpmMigSampleGet",
+	"nvmlGpmQueryDeviceSupport",
+	"nvmlGpmQueryIfStreamingEnabled",
+	"nvmlGpmSampleAlloc",
+	"nvmlGpmSampleFree",
+	"nvmlGpmSampleGet",
+	"nvmlGpmSetStreamingEnabled",
+	"nvmlGpuInstanceCreateComputeInstance",
+	"nvmlGpuInstanceCreateComputeInstanceWithPlacement",
+	"nvmlGpuInstanceDestroy",
+	"nvmlGpuInstanceGetActiveVgpus",
+	"nvmlGpuInstanceGetComputeInstanceById",
+	"nvmlGpuInstanceGetComputeInstancePossiblePlacements",
+	"nvmlGpuInstanceGetComputeInstanceProfileInfo",
+	"nvmlGpuInstanceGetComputeInstanceProfileInfoV",
+	"nvmlGpuInstanceGetComputeInstanceRemainingCapacity",
+	"nvmlGpuInstanceGetComputeInstances",
+	"nvmlGpuInstanceGetCreatableVgpus",
+	"nvmlGpuInstanceGetInfo",
+	"nvmlGpuInstanceGetVgpuHeterogeneousMode",
+	"nvmlGpuInstanceGetVgpuSchedulerLog",
+	"nvmlGpuInstanceGetVgpuSchedulerState",
+	"nvmlGpuInstanceGetVgpuTypeCreatablePlacements",
+	"nvmlGpuInstanceSetVgpuHeterogeneousMode",
+	"nvmlGpuInstanceSetVgpuSchedulerState",
+	"nvmlInit",
+	"nvmlInitWithFlags",
+	"nvmlInit_v2",
+	"nvmlSetVgpuVersion",
+	"nvmlShutdown",
+	"nvmlSystemEventSetCreate",
+	"nvmlSystemEventSetFree",
+	"nvmlSystemEventSetWait",
+	"nvmlSystemGetConfComputeCapabilities",
+	"nvmlSystemGetConfComputeGpusReadyState",
+	"nvmlSystemGetConfComputeKeyRotationThresholdInfo",
+	"nvmlSystemGetConfComputeSettings",
+	"nvmlSystemGetConfComputeState",
+	"nvmlSystemGetCudaDriverVersion",
+	"nvmlSystemGetCudaDriverVersion_v2",
+	"nvmlSystemGetDriverBranch",
+	"nvmlSystemGetDriverVersion",
+	"nvmlSystemGetHicVersion",
+	"nvmlSystemGetNVMLVersion",
+	"nvmlSystemGetNvlinkBwMode",
+	"nvmlSystemGetProcessName",
+	"nvmlSystemGetTopologyGpuSet",
+	"nvmlSystemRegisterEvents",
+	"nvmlSystemSetConfComputeGpusReadyState",
+	"nvmlSystemSetConfComputeKeyRotationThresholdInfo",
+	"nvmlSystemSetNvlinkBwMode",
+	"nvmlUnitGetCount",
+	"nvmlUnitGetDevices",
+	"nvmlUnitGetFanSpeedInfo",
+	"nvmlUnitGetHandleByIndex",
+	"nvmlUnitGetLedState",
+	"nvmlUnitGetPsuInfo",
+	"nvmlUnitGetTemperature",
+	"nvmlUnitGetUnitInfo",
+	"nvmlUnitSetLedState",
+	"nvmlVgpuInstanceClearAccountingPids",
+	"nvmlVgpuInstanceGetAccountingMode",
+	"nvmlVgpuInstanceGetAccountingPids",
+	"nvmlVgpuInstanceGetAccountingStats",
+	"nvmlVgpuInstanceGetEccMode",
+	"nvmlVgpuInstanceGetEncoderCapacity",
+	"nvmlVgpuInstanceGetEncoderSessions",
+	"nvmlVgpuInstanceGetEncoderStats",
+	"nvmlVgpuInstanceGetFBCSessions",
+	"nvmlVgpuInstanceGetFBCStats",
+	"nvmlVgpuInstanceGetFbUsage",
+	"nvmlVgpuInstanceGetFrameRateLimit",
+	"nvmlVgpuInstanceGetGpuInstanceId",
+	"nvmlVgpuInstanceGetGpuPciId",
+	"nvmlVgpuInstanceGetLicenseInfo",
+	"nvmlVgpuInstanceGetLicenseInfo_v2",
+	"nvmlVgpuInstanceGetLicenseStatus",
+	"nvmlVgpuInstanceGetMdevUUID",
+	"nvmlVgpuInstanceGetMetadata",
+	"nvmlVgpuInstanceGetPlacementId",
+	"nvmlVgpuInstanceGetRuntimeStateSize",
+	"nvmlVgpuInstanceGetType",
+	"nvmlVgpuInstanceGetUUID",
+	"nvmlVgpuInstanceGetVmDriverVersion",
+	"nvmlVgpuInstanceGetVmID",
+	"nvmlVgpuInstanceSetEncoderCapacity",
+	"nvmlVgpuTypeGetBAR1Info",
+	"nvmlVgpuTypeGetCapabilities",
+	"nvmlVgpuTypeGetClass",
+	"nvmlVgpuTypeGetDeviceID",
+	"nvmlVgpuTypeGetFbReservation",
+	"nvmlVgpuTypeGetFrameRateLimit",
+	"nvmlVgpuTypeGetFramebufferSize",
+	"nvmlVgpuTypeGetGpuInstanceProfileId",
+	"nvmlVgpuTypeGetGspHeapSize",
+	"nvmlVgpuTypeGetLicense",
+	"nvmlVgpuTypeGetMaxInstances",
+	"nvmlVgpuTypeGetMaxInstancesPerGpuInstance",
+	"nvmlVgpuTypeGetMaxInstancesPerVm",
+	"nvmlVgpuTypeGetName",
+	"nvmlVgpuTypeGetNumDisplayHeads",
+	"nvmlVgpuTypeGetResolution",
+}
@@ -0,0 +1,186 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+// versionedSymbols holds the versioned APIs that are bound for a library.
+// Each library instance maintains its own set so that loading one instance
+// does not rebind the symbols used by another.
+type versionedSymbols struct {
+	nvmlInit                                   func() Return
+	nvmlDeviceGetPciInfo                       func(nvmlDevice, *PciInfo) Return
+	nvmlDeviceGetCount                         func(*uint32) Return
+	nvmlDeviceGetHandleByIndex                 func(uint32, *nvmlDevice) Return
+	nvmlDeviceGetHandleByPciBusId              func(string, *nvmlDevice) Return
+	nvmlDeviceGetNvLinkRemotePciInfo           func(nvmlDevice, uint32, *PciInfo) Return
+	nvmlDeviceRemoveGpu                        func(*PciInfo) Return
+	nvmlDeviceGetGridLicensableFeatures        func(nvmlDevice, *GridLicensableFeatures) Return
+	nvmlEventSetWait                           func(nvmlEventSet, *nvmlEventData, uint32) Return
+	nvmlDeviceGetAttributes                    func(nvmlDevice, *DeviceAttributes) Return
+	nvmlComputeInstanceGetInfo                 func(nvmlComputeInstance, *nvmlComputeInstanceInfo) Return
+	deviceGetComputeRunningProcesses           func(nvmlDevice) ([]ProcessInfo, Return)
+	deviceGetGraphicsRunningProcesses          func(nvmlDevice) ([]ProcessInfo, Return)
+	deviceGetMPSComputeRunningProcesses        func(nvmlDevice) ([]ProcessInfo, Return)
+	nvmlDeviceGetGpuInstancePossiblePlacements func(nvmlDevice, uint32, *GpuInstancePlacement, *uint32) Return
+	nvmlVgpuInstanceGetLicenseInfo             func(nvmlVgpuInstance, *VgpuLicenseInfo) Return
+	nvmlDeviceGetDriverModel                   func(nvmlDevice, *DriverModel, *DriverModel) Return
+
+	// bound maps the unversioned name of an NVML symbol to the versioned
+	// variant that was bound if this is not the v1 variant.
+	bound map[string]string
+}
+
+// Default all versioned APIs to v1 (to infer the types)
+var defaultVersionedSymbols = versionedSymbols{
+	nvmlInit:                                   nvmlInit_v1,
+	nvmlDeviceGetPciInfo:                       nvmlDeviceGetPciInfo_v1,
+	nvmlDeviceGetCount:                         nvmlDeviceGetCount_v1,
+	nvmlDeviceGetHandleByIndex:                 nvmlDeviceGetHandleByIndex_v1,
+	nvmlDeviceGetHandleByPciBusId:              nvmlDeviceGetHandleByPciBusId_v1,
+	nvmlDeviceGetNvLinkRemotePciInfo:           nvmlDeviceGetNvLinkRemotePciInfo_v1,
+	nvmlDeviceRemoveGpu:                        nvmlDeviceRemoveGpu_v1,
+	nvmlDeviceGetGridLicensableFeatures:        nvmlDeviceGetGridLicensableFeatures_v1,
+	nvmlEventSetWait:                           nvmlEventSetWait_v1,
+	nvmlDeviceGetAttributes:                    nvmlDeviceGetAttributes_v1,
+	nvmlComputeInstanceGetInfo:                 nvmlComputeInstanceGetInfo_v1,
+	deviceGetComputeRunningProcesses:           deviceGetComputeRunningProcesses_v1,
+	deviceGetGraphicsRunningProcesses:          deviceGetGraphicsRunningProcesses_v1,
+	deviceGetMPSComputeRunningProcesses:        deviceGetMPSComputeRunningProcesses_v1,
+	nvmlDeviceGetGpuInstancePossiblePlacements: nvmlDeviceGetGpuInstancePossiblePlacements_v1,
+	nvmlVgpuInstanceGetLicenseInfo:             nvmlVgpuInstanceGetLicenseInfo_v1,
+	nvmlDeviceGetDriverModel:                   nvmlDeviceGetDriverModel_v1,
+}
+
+// updateVersionedSymbols checks for versioned symbols in the loaded dynamic library.
+// If newer versioned symbols exist, these replace the default `v1` symbols initialized above.
+func (l *library) updateVersionedSymbols() {
+	l.symbols = defaultVersionedSymbols
+	l.symbols.bound = make(map[string]string)
+
+	if err := l.dl.Lookup("nvmlInit_v2"); err == nil {
+		l.symbols.nvmlInit = nvmlInit_v2
+		l.symbols.bound["nvmlInit"] = "nvmlInit_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetPciInfo_v2"); err == nil {
+		l.symbols.nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v2
+		l.symbols.bound["nvmlDeviceGetPciInfo"] = "nvmlDeviceGetPciInfo_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetPciInfo_v3"); err == nil {
+		l.symbols.nvmlDeviceGetPciInfo = nvmlDeviceGetPciInfo_v3
+		l.symbols.bound["nvmlDeviceGetPciInfo"] = "nvmlDeviceGetPciInfo_v3"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetCount_v2"); err == nil {
+		l.symbols.nvmlDeviceGetCount = nvmlDeviceGetCount_v2
+		l.symbols.bound["nvmlDeviceGetCount"] = "nvmlDeviceGetCount_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetHandleByIndex_v2"); err == nil {
+		l.symbols.nvmlDeviceGetHandleByIndex = nvmlDeviceGetHandleByIndex_v2
+		l.symbols.bound["nvmlDeviceGetHandleByIndex"] = "nvmlDeviceGetHandleByIndex_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetHandleByPciBusId_v2"); err == nil {
+		l.symbols.nvmlDeviceGetHandleByPciBusId = nvmlDeviceGetHandleByPciBusId_v2
+		l.symbols.bound["nvmlDeviceGetHandleByPciBusId"] = "nvmlDeviceGetHandleByPciBusId_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetNvLinkRemotePciInfo_v2"); err == nil {
+		l.symbols.nvmlDeviceGetNvLinkRemotePciInfo = nvmlDeviceGetNvLinkRemotePciInfo_v2
+		l.symbols.bound["nvmlDeviceGetNvLinkRemotePciInfo"] = "nvmlDeviceGetNvLinkRemotePciInfo_v2"
+	}
+
+	// nvmlDeviceRemoveGpu_v2 is not bound since the v2 function takes a different set of parameters than the v1 function.
+
+	if err := l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v2"); err == nil {
+		l.symbols.nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v2
+		l.symbols.bound["nvmlDeviceGetGridLicensableFeatures"] = "nvmlDeviceGetGridLicensableFeatures_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v3"); err == nil {
+		l.symbols.nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v3
+		l.symbols.bound["nvmlDeviceGetGridLicensableFeatures"] = "nvmlDeviceGetGridLicensableFeatures_v3"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetGridLicensableFeatures_v4"); err == nil {
+		l.symbols.nvmlDeviceGetGridLicensableFeatures = nvmlDeviceGetGridLicensableFeatures_v4
+		l.symbols.bound["nvmlDeviceGetGridLicensableFeatures"] = "nvmlDeviceGetGridLicensableFeatures_v4"
+	}
+
+	if err := l.dl.Lookup("nvmlEventSetWait_v2"); err == nil {
+		l.symbols.nvmlEventSetWait = nvmlEventSetWait_v2
+		l.symbols.bound["nvmlEventSetWait"] = "nvmlEventSetWait_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetAttributes_v2"); err == nil {
+		l.symbols.nvmlDeviceGetAttributes = nvmlDeviceGetAttributes_v2
+		l.symbols.bound["nvmlDeviceGetAttributes"] = "nvmlDeviceGetAttributes_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlComputeInstanceGetInfo_v2"); err == nil {
+		l.symbols.nvmlComputeInstanceGetInfo = nvmlComputeInstanceGetInfo_v2
+		l.symbols.bound["nvmlComputeInstanceGetInfo"] = "nvmlComputeInstanceGetInfo_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v2"); err == nil {
+		l.symbols.deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v2
+		l.symbols.bound["nvmlDeviceGetComputeRunningProcesses"] = "nvmlDeviceGetComputeRunningProcesses_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetComputeRunningProcesses_v3"); err == nil {
+		l.symbols.deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v3
+		l.symbols.bound["nvmlDeviceGetComputeRunningProcesses"] = "nvmlDeviceGetComputeRunningProcesses_v3"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v2"); err == nil {
+		l.symbols.deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v2
+		l.symbols.bound["nvmlDeviceGetGraphicsRunningProcesses"] = "nvmlDeviceGetGraphicsRunningProcesses_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetGraphicsRunningProcesses_v3"); err == nil {
+		l.symbols.deviceGetGraphicsRunningProcesses = deviceGetGraphicsRunningProcesses_v3
+		l.symbols.bound["nvmlDeviceGetGraphicsRunningProcesses"] = "nvmlDeviceGetGraphicsRunningProcesses_v3"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v2"); err == nil {
+		l.symbols.deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v2
+		l.symbols.bound["nvmlDeviceGetMPSComputeRunningProcesses"] = "nvmlDeviceGetMPSComputeRunningProcesses_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetMPSComputeRunningProcesses_v3"); err == nil {
+		l.symbols.deviceGetMPSComputeRunningProcesses = deviceGetMPSComputeRunningProcesses_v3
+		l.symbols.bound["nvmlDeviceGetMPSComputeRunningProcesses"] = "nvmlDeviceGetMPSComputeRunningProcesses_v3"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetGpuInstancePossiblePlacements_v2"); err == nil {
+		l.symbols.nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v2
+		l.symbols.bound["nvmlDeviceGetGpuInstancePossiblePlacements"] = "nvmlDeviceGetGpuInstancePossiblePlacements_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlVgpuInstanceGetLicenseInfo_v2"); err == nil {
+		l.symbols.nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v2
+		l.symbols.bound["nvmlVgpuInstanceGetLicenseInfo"] = "nvmlVgpuInstanceGetLicenseInfo_v2"
+	}
+
+	if err := l.dl.Lookup("nvmlDeviceGetDriverModel_v2"); err == nil {
+		l.symbols.nvmlDeviceGetDriverModel = nvmlDeviceGetDriverModel_v2
+		l.symbols.bound["nvmlDeviceGetDriverModel"] = "nvmlDeviceGetDriverModel_v2"
+	}
+}